@@ -16,6 +16,7 @@ var (
 
 // @title API Frog-Go
 // @version 1.0
+// @description Para acessar os lançamentos de um livro compartilhado, envie o header X-Ledger-ID com o ID do livro.
 
 // @securityDefinitions.apikey BearerAuth
 // @in header
@@ -41,7 +42,7 @@ func startAPIServer() {
 	defer boot.Repo.Close()
 	defer boot.Mbus.Close()
 
-	router := routes.NewRouter(log, boot.Repo, boot.Mbus)
+	router := routes.NewRouter(log, boot.Repo, boot.Mbus, boot.Blobs)
 	r := router.Setup(debug)
 
 	log.Start("Starting API server on port %s | env: %s | Debug mode: %v", port, envPath, debug)
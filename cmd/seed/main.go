@@ -8,9 +8,7 @@ import (
 	"frog-go/internal/adapters/repository/postgresql"
 	"frog-go/internal/config"
 	"frog-go/internal/core/domain"
-	"frog-go/internal/ent/category"
 	"frog-go/internal/ent/user"
-	"frog-go/internal/utils"
 	"frog-go/internal/utils/logger"
 	"os"
 
@@ -21,6 +19,12 @@ var (
 	envPath string
 )
 
+const (
+	adminUsername = "admin"
+	adminEmail    = "admin@example.com"
+	adminPassword = "admin123"
+)
+
 func main() {
 	flag.StringVar(&envPath, "env", ".env", "Path to .env file")
 	flag.Parse()
@@ -56,14 +60,14 @@ func startSeed() {
 		log.Fatal("❌ Type assertion failed: repo is not *postgresql.PostgreSQL")
 	}
 
-	if err := seedCategories(ctx, postgresRepo, log); err != nil {
-		log.Fatal("Error seeding categories: %v", err)
-	}
-
 	if err := seedAdminUser(ctx, postgresRepo, log); err != nil {
 		log.Fatal("Error create adminUser: %v", err)
 	}
 
+	if err := seedCategories(ctx, postgresRepo, log); err != nil {
+		log.Fatal("Error seeding categories: %v", err)
+	}
+
 	if cfg.SeedPath != "" {
 		if err := seedTransactions(ctx, postgresRepo, log, cfg.SeedPath); err != nil {
 			log.Fatal("Error aseeding transactions: %v", err)
@@ -74,82 +78,16 @@ func startSeed() {
 }
 
 func seedCategories(ctx context.Context, repo *postgresql.PostgreSQL, lg *logger.Logger) error {
-	categories := []struct {
-		Name                string
-		Description         string
-		Color               string
-		SuggestedPercentage *int
-	}{
-		{
-			"Assinaturas",
-			"Serviços recorrentes como streaming, apps e plataformas.",
-			"#FF6B6B",
-			utils.IntPtr(5),
-		},
-		{
-			"Alimentação e delivery",
-			"Mercado, restaurantes, delivery, cafés, padarias",
-			"#FFA94D",
-			utils.IntPtr(20),
-		},
-		{
-			"Saúde e bem-estar",
-			"Farmácia, plano de saúde, terapias e autocuidado.",
-			"#20C997",
-			utils.IntPtr(5),
-		},
-		{
-			"Compras pessoais",
-			"Produtos online, marketplaces, roupas, estética e cuidados pessoais.",
-			"#845EF7",
-			utils.IntPtr(10),
-		},
-		{
-			"Transporte",
-			"Uber, 99, combustível e transporte em geral.",
-			"#339AF0",
-			utils.IntPtr(5),
-		},
-		{
-			"Lazer",
-			"Bares, festas, eventos, shows, cinema e entretenimento.",
-			"#DA77F2",
-			utils.IntPtr(5),
-		},
-		{
-			"Moradia",
-			"Aluguel, condomínio, luz, água, gás e contas da casa.",
-			"#FFB3C1",
-			utils.IntPtr(30),
-		},
-		{
-			"Sem categoria",
-			"Gastos não classificados ou indefinidos.",
-			"#CBD5E1",
-			nil,
-		},
-	}
-
-	for _, c := range categories {
-		exists, err := repo.Client.Category.Query().Where(category.NameEQ(c.Name)).Exist(ctx)
-		if err != nil {
-			return err
-		}
-		if exists {
-			continue
-		}
+	ledgers, err := repo.Client.Ledger.Query().All(ctx)
+	if err != nil {
+		return err
+	}
 
-		_, err = repo.Client.Category.
-			Create().
-			SetName(c.Name).
-			SetDescription(c.Description).
-			SetColor(c.Color).
-			SetNillableSuggestedPercentage(c.SuggestedPercentage).
-			Save(ctx)
-		if err != nil {
+	for _, l := range ledgers {
+		if err := repo.EnsureDefaultCategories(ctx, l.ID); err != nil {
 			return err
 		}
-		lg.Info("✅ Category created: %s", c.Name)
+		lg.Info("✅ Default categories ensured for ledger: %s", l.Name)
 	}
 	return nil
 }
@@ -165,9 +103,21 @@ func seedTransactions(ctx context.Context, db *postgresql.PostgreSQL, lg *logger
 		return fmt.Errorf("erro ao parsear JSON: %w", err)
 	}
 
+	admin, err := db.Client.User.Query().Where(user.UsernameEQ(adminUsername)).Only(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar usuário admin: %w", err)
+	}
+
+	access, err := db.GetPersonalLedgerAccess(ctx, admin.ID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar livro pessoal do admin: %w", err)
+	}
+
 	for _, d := range transactions {
 		_, err := db.Client.Transaction.
 			Create().
+			SetLedgerID(access.LedgerID).
+			SetCreatedByID(admin.ID).
 			SetTitle(d.Title).
 			SetAmount(d.Amount).
 			SetRecordDate(d.RecordDate).
@@ -181,12 +131,6 @@ func seedTransactions(ctx context.Context, db *postgresql.PostgreSQL, lg *logger
 }
 
 func seedAdminUser(ctx context.Context, repo *postgresql.PostgreSQL, lg *logger.Logger) error {
-	const (
-		adminUsername = "admin"
-		adminEmail    = "admin@example.com"
-		adminPassword = "admin123"
-	)
-
 	exists, err := repo.Client.User.Query().Where(user.UsernameEQ(adminUsername)).Exist(ctx)
 	if err != nil {
 		return err
@@ -202,15 +146,14 @@ func seedAdminUser(ctx context.Context, repo *postgresql.PostgreSQL, lg *logger.
 		return fmt.Errorf("failed to hash admin password: %w", err)
 	}
 
-	// Cria o admin
-	_, err = repo.Client.User.
-		Create().
-		SetName("Administrator").
-		SetUsername(adminUsername).
-		SetEmail(adminEmail).
-		SetPasswordHash(string(hashedPassword)).
-		SetIsActive(true).
-		Save(ctx)
+	// Cria o admin, junto com o livro pessoal dele
+	_, err = repo.CreateUser(ctx, domain.User{
+		Name:         "Administrator",
+		Username:     adminUsername,
+		Email:        adminEmail,
+		PasswordHash: string(hashedPassword),
+		IsActive:     true,
+	})
 	if err != nil {
 		return err
 	}
@@ -4,23 +4,27 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"frog-go/internal/config/bootstrap"
 	"frog-go/internal/core/service/consumers"
+	"frog-go/internal/core/service/jobs"
 	"frog-go/internal/utils/logger"
 	"frog-go/internal/worker"
 )
 
 var (
-	limit   int
-	timeout int
-	queue   string
-	envPath string
+	limit    int
+	timeout  int
+	interval int
+	queue    string
+	envPath  string
 )
 
 func init() {
 	flag.IntVar(&limit, "limit", 5, "Número máximo de mensagens processadas simultaneamente (concorrência)")
 	flag.IntVar(&timeout, "timeout", 30, "Timeout em segundos para processamento de cada mensagem")
+	flag.IntVar(&interval, "interval", 3600, "Intervalo em segundos entre execuções de um job agendado")
 	flag.StringVar(&queue, "queue", "development", "Nome da fila a ser processada")
 	flag.StringVar(&envPath, "env", ".env", "Caminho para o arquivo .env")
 }
@@ -34,7 +38,13 @@ func main() {
 		os.Exit(1)
 	}
 
-	startConsumer(args[0])
+	resource := args[0]
+	if _, ok := jobs.Registry[resource]; ok {
+		startJob(resource)
+		return
+	}
+
+	startConsumer(resource)
 }
 
 func startConsumer(resource string) {
@@ -58,3 +68,20 @@ func startConsumer(resource string) {
 	w := worker.NewWorker(consumer, log, boot.Mbus, stopChan)
 	w.Start(resource, limit, timeout)
 }
+
+func startJob(name string) {
+	log := logger.NewLogger("Scheduler")
+
+	boot, err := bootstrap.InitWorker(envPath)
+	if err != nil {
+		log.Fatal("%v", err)
+	}
+	defer boot.Repo.Close()
+	defer boot.Mbus.Close()
+
+	job := jobs.Registry[name](boot)
+	stopChan := make(chan struct{})
+
+	s := worker.NewScheduler(job, log, stopChan)
+	s.Start(name, time.Duration(interval)*time.Second, timeout)
+}
@@ -15,48 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/auth/login": {
-            "post": {
-                "description": "Autentica o usuário pelo username **ou** email e senha, retornando um token JWT",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Login",
-                "parameters": [
-                    {
-                        "description": "Credenciais de login (identifier = username or email)",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.LoginResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/categories": {
+        "/api/v1/accounts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todas as categorias aplicando filtros e paginação",
+                "description": "Lista todas as contas do usuário aplicando filtros e paginação",
                 "consumes": [
                     "application/json"
                 ],
@@ -64,9 +30,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categorias"
+                    "Contas"
                 ],
-                "summary": "Lista categorias com filtros e paginação",
+                "summary": "Lista contas com filtros e paginação",
                 "parameters": [
                     {
                         "type": "integer",
@@ -99,7 +65,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.CategoryResponse"
+                                "$ref": "#/definitions/dto.AccountResponse"
                             }
                         }
                     }
@@ -111,7 +77,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova categoria com os dados fornecidos no corpo da requisição",
+                "description": "Cria uma nova conta (corrente, poupança, cartão, etc.) com os dados fornecidos no corpo da requisição",
                 "consumes": [
                     "application/json"
                 ],
@@ -119,17 +85,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categorias"
+                    "Contas"
                 ],
-                "summary": "Cria uma nova categoria",
+                "summary": "Cria uma nova conta",
                 "parameters": [
                     {
-                        "description": "Dados da categoria",
+                        "description": "Dados da conta",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CategoryRequest"
+                            "$ref": "#/definitions/dto.AccountRequest"
                         }
                     }
                 ],
@@ -137,34 +103,30 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CategoryResponse"
+                            "$ref": "#/definitions/dto.AccountResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/categories/{id}": {
+        "/api/v1/accounts/{id}/valuations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados de uma categoria com base no ID fornecido",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Categorias"
+                    "Patrimônio"
                 ],
-                "summary": "Busca uma categoria por ID",
+                "summary": "Lista os saldos registrados de uma conta",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da categoria",
+                        "description": "ID da conta",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -174,18 +136,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.CategoryResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ValuationResponse"
+                            }
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza os dados de uma categoria com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "description": "Grava o saldo da conta na data, na moeda da conta, substituindo o saldo já registrado para a mesma data. Saldos devedores, como o de um cartão de crédito, são informados negativos",
                 "consumes": [
                     "application/json"
                 ],
@@ -193,60 +158,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categorias"
+                    "Patrimônio"
                 ],
-                "summary": "Atualiza uma categoria existente",
+                "summary": "Registra o saldo de uma conta",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da categoria",
+                        "description": "ID da conta",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados atualizados da categoria",
+                        "description": "Data e saldo",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CategoryRequest"
+                            "$ref": "#/definitions/dto.ValuationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.CategoryResponse"
+                            "$ref": "#/definitions/dto.ValuationResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/accounts/{id}/valuations/{valuation_id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Exclui uma categoria com base no ID fornecido",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Categorias"
+                    "Patrimônio"
                 ],
-                "summary": "Remove uma categoria",
+                "summary": "Remove um saldo registrado de uma conta",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da categoria",
+                        "description": "ID da conta",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do saldo",
+                        "name": "valuation_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -256,25 +223,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/invoices": {
+        "/api/v1/assets": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todas as faturas aplicando filtros e paginação",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Faturas"
+                    "Patrimônio"
                 ],
-                "summary": "Lista faturas com filtros e paginação",
+                "summary": "Lista bens e dívidas com paginação",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Buscar pelo nome",
+                        "name": "search",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Número da página",
@@ -289,7 +258,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Campo de ordenação (ex: due_date)",
+                        "description": "Campo de ordenação (ex: name)",
                         "name": "order_by",
                         "in": "query"
                     },
@@ -298,42 +267,6 @@ const docTemplate = `{
                         "description": "Ordem (asc, desc)",
                         "name": "order",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filtro por título",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filtro por status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Valor mínimo",
-                        "name": "min_amount",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Valor máximo",
-                        "name": "max_amount",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data de vencimento inicial",
-                        "name": "due_date_start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data de vencimento final",
-                        "name": "due_date_end",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -342,7 +275,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.InvoiceResponse"
+                                "$ref": "#/definitions/dto.AssetResponse"
                             }
                         }
                     }
@@ -354,7 +287,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova fatura com os dados fornecidos no corpo da requisição",
+                "description": "Cadastra um item do patrimônio fora das contas. class é asset (bem) ou liability (dívida); kind é property, vehicle, investment, loan ou other. Sem moeda, o item assume a moeda base",
                 "consumes": [
                     "application/json"
                 ],
@@ -362,17 +295,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Faturas"
+                    "Patrimônio"
                 ],
-                "summary": "Cria uma nova fatura",
+                "summary": "Cria um bem ou uma dívida",
                 "parameters": [
                     {
-                        "description": "Dados da fatura",
+                        "description": "Dados do item",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.InvoiceRequest"
+                            "$ref": "#/definitions/dto.AssetRequest"
                         }
                     }
                 ],
@@ -380,34 +313,30 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.InvoiceResponse"
+                            "$ref": "#/definitions/dto.AssetResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/invoices/{id}": {
+        "/api/v1/assets/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados de uma fatura com base no ID fornecido",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Faturas"
+                    "Patrimônio"
                 ],
-                "summary": "Busca uma fatura por ID",
+                "summary": "Busca um bem ou dívida por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da fatura",
+                        "description": "ID do item",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -417,7 +346,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.InvoiceResponse"
+                            "$ref": "#/definitions/dto.AssetResponse"
                         }
                     }
                 }
@@ -428,7 +357,6 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza os dados de uma fatura com base no ID fornecido e nos dados enviados no corpo da requisição",
                 "consumes": [
                     "application/json"
                 ],
@@ -436,24 +364,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Faturas"
+                    "Patrimônio"
                 ],
-                "summary": "Atualiza uma fatura existente",
+                "summary": "Atualiza um bem ou dívida",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da fatura",
+                        "description": "ID do item",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados atualizados da fatura",
+                        "description": "Dados atualizados do item",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.InvoiceRequest"
+                            "$ref": "#/definitions/dto.AssetRequest"
                         }
                     }
                 ],
@@ -461,7 +389,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.InvoiceResponse"
+                            "$ref": "#/definitions/dto.AssetResponse"
                         }
                     }
                 }
@@ -472,21 +400,15 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Exclui uma fatura com base no ID fornecido",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Remove o item e todas as suas avaliações",
                 "tags": [
-                    "Faturas"
+                    "Patrimônio"
                 ],
-                "summary": "Remove uma fatura",
+                "summary": "Remove um bem ou dívida",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da fatura",
+                        "description": "ID do item",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -499,91 +421,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/invoices/{id}/debts": {
+        "/api/v1/assets/{id}/valuations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todos os débitos (transações) vinculados a uma fatura específica, com filtros e paginação",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Faturas"
+                    "Patrimônio"
                 ],
-                "summary": "Lista débitos associados a uma fatura",
+                "summary": "Lista as avaliações de um bem ou dívida",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da fatura",
+                        "description": "ID do item",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Número da página",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limite por página",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Campo de ordenação (ex: record_date)",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Ordem (asc, desc)",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filtro por título da transação",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filtro por status da transação",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Tipo da transação (income, expense)",
-                        "name": "record_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID da categoria",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data inicial de registro",
-                        "name": "record_date_start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data final de registro",
-                        "name": "record_date_end",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -592,21 +450,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.TransactionResponse"
+                                "$ref": "#/definitions/dto.ValuationResponse"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/transactions": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lista todas as transações aplicando filtros e paginação",
+                "description": "Grava o valor do item na data, na moeda do item, substituindo a avaliação já existente para a mesma data. Bens e dívidas são avaliados com valores positivos",
                 "consumes": [
                     "application/json"
                 ],
@@ -614,30 +470,120 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transações"
+                    "Patrimônio"
                 ],
-                "summary": "Lista transações com filtros e paginação",
+                "summary": "Registra o valor de um bem ou dívida",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filtrar por status",
-                        "name": "status",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ID do item",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Filtrar por tipos de transação (income, expense)",
-                        "name": "record_types",
-                        "in": "query"
+                        "description": "Data e valor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValuationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValuationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/assets/{id}/valuations/{valuation_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Patrimônio"
+                ],
+                "summary": "Remove uma avaliação de um bem ou dívida",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do item",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "ID da avaliação",
+                        "name": "valuation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Autentica o usuário pelo username **ou** email e senha, retornando um token JWT",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Credenciais de login (identifier = username or email)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/budgets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orçamentos"
+                ],
+                "summary": "Lista orçamentos com filtros e paginação",
+                "parameters": [
                     {
                         "type": "array",
                         "items": {
@@ -645,31 +591,13 @@ const docTemplate = `{
                         },
                         "collectionFormat": "csv",
                         "description": "Filtrar por categorias",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Valor mínimo",
-                        "name": "min_amount",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Valor máximo",
-                        "name": "max_amount",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data inicial",
-                        "name": "start_date",
+                        "name": "category_ids",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Data final",
-                        "name": "end_date",
+                        "description": "Filtrar pelo mês (ex: 2026-10)",
+                        "name": "month",
                         "in": "query"
                     },
                     {
@@ -686,7 +614,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Campo de ordenação",
+                        "description": "Campo de ordenação (ex: month)",
                         "name": "order_by",
                         "in": "query"
                     },
@@ -703,7 +631,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.TransactionResponse"
+                                "$ref": "#/definitions/dto.BudgetResponse"
                             }
                         }
                     }
@@ -715,7 +643,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova transação com os dados fornecidos no corpo da requisição",
+                "description": "Cria o orçamento de uma categoria para um mês (month no formato 2026-10) ou, sem month, recorrente para todos os meses. O limite é um valor fixo (amount) ou um percentual da receita do mês (percentage); sem nenhum dos dois, vale o percentual sugerido da categoria",
                 "consumes": [
                     "application/json"
                 ],
@@ -723,17 +651,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transações"
+                    "Orçamentos"
                 ],
-                "summary": "Cria uma nova transação",
+                "summary": "Cria um orçamento",
                 "parameters": [
                     {
-                        "description": "Dados da transação",
+                        "description": "Dados do orçamento",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.TransactionRequest"
+                            "$ref": "#/definitions/dto.BudgetRequest"
                         }
                     }
                 ],
@@ -741,103 +669,32 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.TransactionResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/transactions/stats": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Fornece dados estatísticos agregados das transações com base nos filtros aplicados",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Transações"
-                ],
-                "summary": "Retorna estatísticas gerais das transações",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Data inicial (YYYY-MM-DD)",
-                        "name": "start_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data final (YYYY-MM-DD)",
-                        "name": "end_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Tipos de transação (income, expense)",
-                        "name": "record_types",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.TransactionStatsSummary"
+                            "$ref": "#/definitions/dto.BudgetResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/transactions/summary": {
+        "/api/v1/budgets/status": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gera um resumo estatístico das transações baseado nos filtros fornecidos",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Para cada orçamento do mês (o do próprio mês prevalece sobre o recorrente) mostra o valor orçado, o gasto da categoria e de suas subcategorias, o saldo restante e a projeção de gasto no fim do mês pelo ritmo diário atual. Transações canceladas não contam",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transações"
+                    "Orçamentos"
                 ],
-                "summary": "Retorna resumo de transações",
+                "summary": "Situação dos orçamentos no mês",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Data inicial (YYYY-MM-DD)",
-                        "name": "start_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data final (YYYY-MM-DD)",
-                        "name": "end_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Tipos de transação (income, expense)",
-                        "name": "record_types",
+                        "description": "Mês (ex: 2026-10); padrão é o mês atual",
+                        "name": "month",
                         "in": "query"
                     }
                 ],
@@ -845,34 +702,33 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SummaryByDate"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BudgetStatusResponse"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/v1/transactions/{id}": {
+        "/api/v1/budgets/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados de uma transação com base no ID fornecido",
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Transações"
+                    "Orçamentos"
                 ],
-                "summary": "Busca uma transação por ID",
+                "summary": "Busca um orçamento por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da transação",
+                        "description": "ID do orçamento",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -882,7 +738,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TransactionResponse"
+                            "$ref": "#/definitions/dto.BudgetResponse"
                         }
                     }
                 }
@@ -893,7 +749,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza os dados de uma transação com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "description": "Substitui os dados do orçamento; os alertas do mês recomeçam",
                 "consumes": [
                     "application/json"
                 ],
@@ -901,24 +757,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transações"
+                    "Orçamentos"
                 ],
-                "summary": "Atualiza uma transação existente",
+                "summary": "Atualiza um orçamento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da transação",
+                        "description": "ID do orçamento",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados atualizados da transação",
+                        "description": "Dados atualizados do orçamento",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.TransactionRequest"
+                            "$ref": "#/definitions/dto.BudgetRequest"
                         }
                     }
                 ],
@@ -926,7 +782,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TransactionResponse"
+                            "$ref": "#/definitions/dto.BudgetResponse"
                         }
                     }
                 }
@@ -937,21 +793,14 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Exclui uma transação com base no ID fornecido",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Transações"
+                    "Orçamentos"
                 ],
-                "summary": "Remove uma transação",
+                "summary": "Remove um orçamento",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da transação",
+                        "description": "ID do orçamento",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -964,66 +813,305 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/upload": {
-            "post": {
+        "/api/v1/cash-flow/forecast": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Recebe um arquivo e os parâmetros necessários para processamento assíncrono.",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Projeta o saldo diário de cada conta e o total, na moeda base, de hoje até days dias à frente (padrão 90, máximo 365). Parte da avaliação mais recente de cada conta e considera as transações pendentes, as parcelas de empréstimos, as faturas em aberto ou vencidas no vencimento, as ocorrências das regras recorrentes ainda não lançadas, as parcelas futuras das compras parceladas no cartão e a média diária dos gastos pagos por categoria nos últimos lookback_days dias (padrão 90), sem as compras no cartão e as ocorrências de regras recorrentes. Itens sem conta e os gastos estimados entram só no total. first_negative_date aponta o primeiro dia em que algum saldo fica negativo",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Upload"
+                    "Fluxo de caixa"
                 ],
-                "summary": "Processar arquivo",
+                "summary": "Previsão de fluxo de caixa",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Arquivo para importação (ex: .csv, .xlsx)",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Dias de previsão",
+                        "name": "days",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "ID da fatura (opcional)",
-                        "name": "invoice_id",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Dias usados na média de gastos variáveis",
+                        "name": "lookback_days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CashFlowForecastResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Cotação não encontrada para a moeda de uma conta",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as categorias aplicando filtros e paginação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Lista categorias com filtros e paginação",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Nome do modelo alvo (ex: Nubank)",
-                        "name": "model",
-                        "in": "formData",
-                        "required": true
+                        "description": "Campo de ordenação (ex: name)",
+                        "name": "order_by",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Ação desejada (ex: create)",
-                        "name": "action",
-                        "in": "formData",
-                        "required": true
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Arquivo recebido, processamento em andamento",
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategoryResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova categoria com os dados fornecidos no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Cria uma nova categoria",
+                "parameters": [
+                    {
+                        "description": "Dados da categoria",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Categoria pai inválida",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/categories/suggest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Aplica as regras do usuário, o classificador treinado com o histórico e as palavras-chave, retornando a categoria escolhida e as alternativas mais prováveis",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Sugere uma categoria para um título",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Título da transação",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Valor da transação",
+                        "name": "amount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategorySuggestionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/categories/tree": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna todas as categorias do usuário aninhadas a partir das categorias raiz",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Lista categorias em árvore",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategoryResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/categories/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de uma categoria com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Busca uma categoria por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma categoria com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Atualiza uma categoria existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da categoria",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryResponse"
+                        }
                     },
-                    "400": {
-                        "description": "Erro nos parâmetros ou no upload",
+                    "422": {
+                        "description": "Categoria pai inválida ou ciclo na hierarquia",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1032,160 +1120,8189 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.CategoryRequest": {
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui uma categoria com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categorias"
+                ],
+                "summary": "Remove uma categoria",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/debts/plan": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Simula mês a mês a quitação das faturas em aberto e dos empréstimos com o orçamento mensal informado. Todo mês os mínimos são pagos e o restante vai para a dívida prioritária: menor saldo no snowball, maior taxa no avalanche e a ordem de custom_order na estratégia custom. card_interest_rate é o percentual ao mês sobre o saldo das faturas e card_minimum_percentage o mínimo pago delas (padrão 15%). Retorna a data de quitação de cada dívida, os juros totais e a comparação entre as estratégias",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Planejamento de dívidas"
+                ],
+                "summary": "Planeja a quitação das dívidas",
+                "parameters": [
+                    {
+                        "description": "Parâmetros do planejamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DebtPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DebtPlanResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Orçamento insuficiente para os mínimos ou para quitar as dívidas no prazo máximo, ou cotação ausente para a moeda",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/envelopes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Orçamento base zero: para cada categoria mostra o saldo vindo dos meses anteriores, o valor atribuído no mês, o gasto e o disponível. to_be_assigned é a receita acumulada que ainda não foi atribuída a nenhum envelope. Transações canceladas não contam",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Envelopes"
+                ],
+                "summary": "Envelopes do mês",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mês (ex: 2026-10); padrão é o mês atual",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvelopeMonthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/envelopes/allocations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Envelopes"
+                ],
+                "summary": "Lista o histórico de atribuições dos envelopes",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por categorias",
+                        "name": "category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por tipo (assign, move)",
+                        "name": "kinds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtrar pelo mês (ex: 2026-10)",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: month)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.EnvelopeAllocationResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atribui (valor positivo) ou retira (valor negativo) dinheiro do envelope de uma categoria no mês informado (ex: 2026-10)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Envelopes"
+                ],
+                "summary": "Atribui dinheiro a um envelope",
+                "parameters": [
+                    {
+                        "description": "Dados da atribuição",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvelopeAllocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvelopeAllocationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/envelopes/allocations/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Numa movimentação entre envelopes, os dois lançamentos são removidos juntos",
+                "tags": [
+                    "Envelopes"
+                ],
+                "summary": "Remove uma atribuição",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da atribuição",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/envelopes/moves": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retira o valor do envelope de origem e o atribui ao de destino no mesmo mês. Os dois lançamentos ficam ligados pelo mesmo transfer_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Envelopes"
+                ],
+                "summary": "Move dinheiro entre envelopes",
+                "parameters": [
+                    {
+                        "description": "Dados da movimentação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnvelopeMoveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.EnvelopeAllocationResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/exchange-rates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cotações"
+                ],
+                "summary": "Lista cotações com filtros e paginação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Moeda de origem",
+                        "name": "from_currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moeda de destino",
+                        "name": "to_currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: rate_date)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ExchangeRateResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra quanto 1 unidade de from_currency vale em to_currency na data. Uma cotação já existente para o mesmo par e data é substituída. Transações já lançadas mantêm a cotação com que foram gravadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cotações"
+                ],
+                "summary": "Registra uma cotação",
+                "parameters": [
+                    {
+                        "description": "Par de moedas, cotação e data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExchangeRateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExchangeRateResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/exchange-rates/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Importa cotações de um CSV com as colunas rate_date, from_currency, to_currency e rate. Nada é gravado se alguma linha for inválida",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cotações"
+                ],
+                "summary": "Importa cotações de um CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExchangeRateImportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/exchange-rates/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Cotações"
+                ],
+                "summary": "Remove uma cotação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da cotação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/goals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Lista metas com paginação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Buscar pelo nome",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: target_date)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.GoalResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma meta com valor e data alvo, vinculada a uma conta, a uma categoria ou a uma tag (basta um dos três). Os aportes são as transferências para a conta vinculada (transações com transfer_account_id igual a account_id) e as transações da categoria vinculada (e de suas subcategorias) ou com a tag vinculada, onde despesas somam e receitas contam como resgate. initial_amount é o que já estava guardado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Cria uma meta de economia",
+                "parameters": [
+                    {
+                        "description": "Dados da meta",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/goals/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Para cada meta mostra o valor guardado, o percentual concluído, o aporte mensal necessário para chegar ao valor na data alvo e a data prevista de conclusão pelo ritmo de aportes dos últimos 3 meses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Andamento de todas as metas",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.GoalProgressResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/goals/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Busca uma meta por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da meta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Atualiza uma meta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da meta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da meta",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Remove uma meta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da meta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/goals/{id}/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mostra o valor guardado, o percentual concluído, o aporte mensal necessário para chegar ao valor na data alvo e a data prevista de conclusão pelo ritmo de aportes dos últimos 3 meses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metas"
+                ],
+                "summary": "Andamento de uma meta",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da meta",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GoalProgressResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Lista investimentos com paginação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Buscar pelo nome ou ticker",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: ticker)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.HoldingResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra um investimento da carteira. asset_class é treasury, fixed_income, stock, reit, etf, crypto ou other; o ticker é único por usuário. Sem moeda, o investimento assume a moeda base",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Cria um investimento",
+                "parameters": [
+                    {
+                        "description": "Dados do investimento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HoldingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HoldingResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ticker já cadastrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Busca um investimento por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HoldingResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Atualiza um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados do investimento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HoldingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HoldingResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Ticker já cadastrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o investimento com as operações, cotações e proventos. As receitas já lançadas pelos proventos são mantidas",
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Remove um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}/incomes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Lista os proventos de um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.InvestmentIncomeResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra dividendos (dividend), cupom (coupon) ou JCP (jcp) recebidos e lança a receita paga correspondente na moeda do investimento, com título como \"Dividendos PETR4\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Registra um provento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do provento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentIncomeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentIncomeResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Categoria não encontrada ou cotação de câmbio ausente",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}/incomes/{income_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o provento e a receita lançada por ele",
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Remove um provento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do provento",
+                        "name": "income_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}/quotes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Lista as cotações de um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.InvestmentQuoteResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grava o preço unitário do investimento na data, substituindo a cotação já existente para a mesma data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Registra a cotação de um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data e preço",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentQuoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentQuoteResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}/quotes/{quote_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Remove uma cotação de um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da cotação",
+                        "name": "quote_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}/trades": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Lista as operações de um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.InvestmentTradeResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra um lote de compra (buy) ou venda (sell) com a quantidade, o preço unitário e as taxas na moeda do investimento. Vendas acima da quantidade em carteira na data são recusadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Registra uma compra ou venda",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da operação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentTradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentTradeResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Quantidade insuficiente para a venda",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/holdings/{id}/trades/{trade_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a operação desde que as vendas restantes continuem cobertas pelas compras",
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Remove uma operação de um investimento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do investimento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da operação",
+                        "name": "trade_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    },
+                    "422": {
+                        "description": "Uma venda ficaria sem quantidade suficiente",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/portfolio": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna a posição de cada investimento pelo custo médio, avaliada pela cotação mais recente gravada (ou, sem cotações, pelo preço da última operação), com ganhos realizados e não realizados, proventos e a alocação por classe. Os totais vêm na moeda base, convertidos pela cotação de câmbio do dia",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Resumo da carteira de investimentos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PortfolioResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Cotação de câmbio não encontrada para a moeda de um investimento",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/investments/quotes/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Importa cotações de um CSV com as colunas ticker, quote_date e price. Nada é gravado se alguma linha for inválida ou citar um ticker que não está na carteira",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Investimentos"
+                ],
+                "summary": "Importa cotações de investimentos de um CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Arquivo CSV",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvestmentQuoteImportResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Ticker não cadastrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as faturas aplicando filtros e paginação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Lista faturas com filtros e paginação",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: due_date)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtro por título",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtro por status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Valor mínimo",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Valor máximo",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de vencimento inicial",
+                        "name": "due_date_start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data de vencimento final",
+                        "name": "due_date_end",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.InvoiceResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova fatura com os dados fornecidos no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Cria uma nova fatura",
+                "parameters": [
+                    {
+                        "description": "Dados da fatura",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de uma fatura com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Busca uma fatura por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma fatura com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Atualiza uma fatura existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da fatura",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui uma fatura com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Remove uma fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Lista os anexos da fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AttachmentResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Envia o arquivo da fatura ou do extrato (PDF, CSV, XLSX ou OFX). O tipo é identificado pelo conteúdo e o tamanho máximo é de 10 MB",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Anexa um arquivo à fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo a ser anexado",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AttachmentResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo maior que o permitido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Tipo de arquivo não permitido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/attachments/{attachment_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Baixa um anexo da fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Remove um anexo da fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/close": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fecha uma fatura aberta, congelando o valor e impedindo o lançamento de novas transações.\nUma fatura que venceu antes de ser fechada também pode ser fechada e continua vencida.\nCom rollover, o saldo restante é lançado na próxima fatura aberta e a fatura fechada é quitada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Fecha uma fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Opções de fechamento",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceCloseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A fatura já foi fechada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Nenhuma fatura aberta para receber o saldo",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/debts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todos os débitos (transações) vinculados a uma fatura específica, com filtros e paginação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Lista débitos associados a uma fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: record_date)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtro por título da transação",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtro por status da transação",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo da transação (income, expense)",
+                        "name": "record_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da categoria",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial de registro",
+                        "name": "record_date_start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final de registro",
+                        "name": "record_date_end",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TransactionResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/pay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Quita o saldo restante de uma fatura fechada ou vencida e marca todas as suas transações como pagas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Paga uma fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data e conta do pagamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoicePayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoiceResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A fatura não pode ser paga",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os pagamentos parciais e de rollover registrados na fatura",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Lista os pagamentos de uma fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.InvoicePaymentResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra um pagamento na fatura. Se o saldo de uma fatura fechada for zerado, ela é marcada como paga",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Registra um pagamento parcial",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Valor, data e conta do pagamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoicePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InvoicePaymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Valor maior que o saldo em aberto",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "A fatura não aceita pagamentos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}/payments/{payment_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um pagamento parcial. Se a fatura estava paga, ela volta a ter saldo em aberto",
+                "tags": [
+                    "Faturas"
+                ],
+                "summary": "Remove um pagamento da fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da fatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do pagamento",
+                        "name": "payment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os livros dos quais o usuário autenticado é membro, com o seu papel em cada um",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Lista os livros do usuário",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LedgerResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um livro vazio, com as categorias padrão, do qual o usuário autenticado é o dono (owner). Os lançamentos pertencem ao livro: o usuário e os membros convidados acessam o livro enviando o header X-Ledger-ID com o ID dele; sem o header, vale o livro pessoal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Cria um livro compartilhado",
+                "parameters": [
+                    {
+                        "description": "Dados do livro",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os convites pendentes e ainda válidos do usuário autenticado",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Lista os convites recebidos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LedgerInvitationResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/invitations/{invitation_id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "O usuário entra no livro com o papel do convite",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Aceita um convite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do convite",
+                        "name": "invitation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerInvitationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/invitations/{invitation_id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Recusa um convite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do convite",
+                        "name": "invitation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerInvitationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Renomeia um livro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do livro",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/{id}/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Lista os convites enviados pelo livro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LedgerInvitationResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Convida um usuário pelo username com o papel informado: editor (padrão) ou viewer. O convite vale por 7 dias",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Convida um usuário para o livro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do convite",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerInvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerInvitationResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "O usuário já é membro ou tem convite pendente",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/{id}/invitations/{invitation_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Cancela um convite pendente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do convite",
+                        "name": "invitation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/{id}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Lista os membros de um livro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LedgerMemberResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ledgers/{id}/members/{user_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Papéis: editor (altera lançamentos) e viewer (somente leitura). O papel owner é só do dono do livro: não pode ser dado a outro membro e o dono não o perde",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Altera o papel de um membro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário membro",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo papel",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LedgerMemberResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Donos removem qualquer membro, exceto o dono dos lançamentos; os demais membros só podem sair do livro",
+                "tags": [
+                    "Livros"
+                ],
+                "summary": "Remove um membro do livro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do livro",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário membro",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/loans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Lista empréstimos com paginação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Buscar pelo nome",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: name)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LoanResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra o empréstimo e gera o cronograma completo pelo sistema SAC (sac) ou Price (price). interest_rate é o percentual ao mês ou ao ano, conforme rate_period (monthly, yearly). Cada parcela vira uma despesa pendente dividida entre amortização e juros, nas categorias informadas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Cria um empréstimo ou financiamento",
+                "parameters": [
+                    {
+                        "description": "Dados do empréstimo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Categoria não encontrada ou cotação ausente para a moeda",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/loans/simulate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Calcula o cronograma de parcelas com os mesmos dados da criação, sem gravar nada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Simula um empréstimo",
+                "parameters": [
+                    {
+                        "description": "Dados do empréstimo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanSimulationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/loans/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Busca um empréstimo por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera o nome e a conta do empréstimo. As condições financeiras não mudam depois da criação e as transações já lançadas não são alteradas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Atualiza um empréstimo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados do empréstimo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o empréstimo e as despesas das parcelas ainda não pagas. Parcelas pagas e amortizações extras continuam nas transações",
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Remove um empréstimo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/loans/{id}/prepayments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Lista as amortizações extras de um empréstimo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.LoanPrepaymentResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Abate o valor do saldo devedor na data e recalcula as parcelas que vencem depois dela, reduzindo o prazo (reduce_term, padrão) ou a parcela (reduce_payment). As despesas pendentes dessas parcelas são substituídas, a amortização é lançada como despesa paga e a resposta traz os juros economizados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Registra uma amortização extra",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Data, valor e efeito da amortização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanPrepaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanPrepaymentResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Há amortização posterior ou parcela seguinte já paga",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Valor maior que o saldo devedor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/loans/{id}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as parcelas com juros, amortização e saldo devedor, a situação de cada uma pela transação vinculada, o saldo devedor atual e os juros pagos, previstos e economizados com amortizações extras",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Empréstimos"
+                ],
+                "summary": "Cronograma de um empréstimo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do empréstimo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoanScheduleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/networth": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o patrimônio no fim de cada período, na moeda base: saldos das contas, bens e dívidas, cada um pela avaliação mais recente até a data e convertido pela cotação dessa data. Sem filtros, mostra os últimos 12 meses mês a mês",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patrimônio"
+                ],
+                "summary": "Evolução do patrimônio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Período (daily, weekly, monthly, yearly)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.NetWorthByDate"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Cotação não encontrada para a moeda de um item",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os avisos do app, dos mais recentes para os mais antigos. Os avisos são gerados quando uma importação termina, um orçamento passa do limite, uma fatura vence sem pagamento e pelos lembretes de vencimento",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notificações"
+                ],
+                "summary": "Lista os avisos da caixa de entrada",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "true para só os não lidos, false para só os lidos",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo do aviso (invoice_due, invoice_overdue, transaction_due, budget_alert, import_completed, anomaly)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: created_at)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.NotificationResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notifications/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna a antecedência dos lembretes, o horário de silêncio e os canais ativos. Quem nunca salvou preferências recebe os avisos só na caixa de entrada do app, 3 dias antes do vencimento",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notificações"
+                ],
+                "summary": "Retorna as preferências de avisos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Substitui as preferências. lead_days (0 a 30) é com quantos dias de antecedência chegam os lembretes de faturas e transações pendentes. Entre quiet_start e quiet_end (HH:MM no fuso timezone, podendo atravessar a meia-noite) e-mail e webhook esperam; a caixa de entrada do app recebe na hora. O e-mail vai para o endereço cadastrado no usuário. O webhook recebe um POST em JSON, assinado com HMAC-SHA256 em X-Frog-Signature quando há webhook_secret; sem webhook_secret o segredo atual é mantido enquanto a URL não muda",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notificações"
+                ],
+                "summary": "Salva as preferências de avisos",
+                "parameters": [
+                    {
+                        "description": "Preferências de avisos",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notificações"
+                ],
+                "summary": "Marca todos os avisos como lidos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationReadAllResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notificações"
+                ],
+                "summary": "Conta os avisos não lidos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationUnreadCountResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Um aviso já lido mantém a data da primeira leitura",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notificações"
+                ],
+                "summary": "Marca um aviso como lido",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do aviso",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payees": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os favorecidos do usuário com a quantidade de transações, receitas e despesas no período; por padrão os que têm mais despesas aparecem primeiro",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorecidos"
+                ],
+                "summary": "Lista favorecidos com totais",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial dos totais",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final dos totais",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: expense, transactions, name)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PayeeResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um favorecido com nome e apelidos; favorecidos cujo nome normalizado é informado como apelido são incorporados a ele",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorecidos"
+                ],
+                "summary": "Cria um novo favorecido",
+                "parameters": [
+                    {
+                        "description": "Dados do favorecido",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PayeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PayeeResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payees/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de um favorecido e os totais de suas transações no período informado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorecidos"
+                ],
+                "summary": "Busca um favorecido por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do favorecido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial dos totais",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final dos totais",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PayeeResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renomeia o favorecido e redefine seus apelidos; o nome normalizado anterior é mantido como apelido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorecidos"
+                ],
+                "summary": "Atualiza um favorecido existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do favorecido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados do favorecido",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PayeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PayeeResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui um favorecido com base no ID fornecido; suas transações ficam sem favorecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Favorecidos"
+                ],
+                "summary": "Remove um favorecido",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do favorecido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras recorrentes"
+                ],
+                "summary": "Lista regras recorrentes com paginação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Buscar pelo título",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: start_date)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RecurringRuleResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra um lançamento que se repete (weekly, monthly ou yearly) a partir de start_date até end_date, se houver. Nas regras mensais e anuais o dia que não existe no mês cai no último dia do mês. A previsão de fluxo de caixa projeta cada ocorrência na conta da regra; as transações lançadas com recurring_rule_id contam como ocorrências já realizadas. currency ausente assume a moeda base",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras recorrentes"
+                ],
+                "summary": "Cria uma regra recorrente",
+                "parameters": [
+                    {
+                        "description": "Dados da regra",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringRuleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-rules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras recorrentes"
+                ],
+                "summary": "Busca uma regra recorrente por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringRuleResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras recorrentes"
+                ],
+                "summary": "Atualiza uma regra recorrente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da regra",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringRuleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Regras recorrentes"
+                ],
+                "summary": "Remove uma regra recorrente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as regras do usuário aplicando filtros e paginação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras"
+                ],
+                "summary": "Lista regras com filtros e paginação",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: priority)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RuleResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma regra de categorização. As regras são avaliadas por prioridade (menor primeiro) na criação de transações e a primeira que atender às condições aplica suas ações",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras"
+                ],
+                "summary": "Cria uma nova regra",
+                "parameters": [
+                    {
+                        "description": "Dados da regra",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RuleResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Categoria ou fatura não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/rules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de uma regra com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras"
+                ],
+                "summary": "Busca uma regra por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RuleResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma regra com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras"
+                ],
+                "summary": "Atualiza uma regra existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da regra",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RuleResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Categoria ou fatura não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui uma regra com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Regras"
+                ],
+                "summary": "Remove uma regra",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da regra",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/settlements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Lista os acertos com paginação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Acertos pagos ou recebidos pelo usuário",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: settled_at)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SettlementResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra que from_user_id pagou amount (na moeda base) a to_user_id, abatendo a dívida entre os dois",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Registra um acerto entre membros do livro",
+                "parameters": [
+                    {
+                        "description": "Dados do acerto",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SettlementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SettlementResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Usuário fora do livro",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settlements/balances": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mostra, na moeda base, quanto cada membro pagou e consumiu nas despesas divididas não canceladas e os acertos já registrados. net positivo é o que o membro tem a receber; payments é o menor conjunto de pagamentos que zera os saldos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Saldos entre os membros do livro",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SharedBalancesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settlements/settle-up": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra como acertos os pagamentos sugeridos em /settlements/balances, zerando os saldos. Sem dívidas em aberto, nada é registrado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Acerta as contas entre os membros do livro",
+                "parameters": [
+                    {
+                        "description": "Observação dos acertos",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SettleUpRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SettlementResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settlements/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Remove um acerto",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do acerto",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as tags do usuário aplicando filtros e paginação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Lista tags com filtros e paginação",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (ex: name)",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TagResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma tag para marcar transações independentemente da categoria",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Cria uma nova tag",
+                "parameters": [
+                    {
+                        "description": "Dados da tag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tags/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de uma tag com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Busca uma tag por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da tag",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma tag com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Atualiza uma tag existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da tag",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da tag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TagResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui uma tag com base no ID fornecido; ela é retirada das transações",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Remove uma tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da tag",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/tax-report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resume o ano-calendário com os lançamentos pagos, na moeda base: rendimentos tributáveis e isentos e imposto retido (lançamentos do tipo tax) por fonte pagadora, e despesas dedutíveis (saúde, instrução e previdência) por grupo, categoria e favorecido, com o CPF/CNPJ cadastrado. O grupo vem do tax_group da categoria ou do ancestral mais próximo; receitas sem grupo contam como tributáveis. Sem ano, usa o ano anterior",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Imposto de renda"
+                ],
+                "summary": "Relatório do imposto de renda",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ano-calendário",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaxReportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tax-report/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera o relatório do ano em xlsx (padrão, com abas de resumo e das fichas da declaração) ou csv (separado por ponto e vírgula, com vírgula decimal), com uma linha por valor a digitar no programa da Receita Federal",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Imposto de renda"
+                ],
+                "summary": "Exporta o relatório do imposto de renda",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ano-calendário",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Formato do arquivo (xlsx, csv)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista todas as transações aplicando filtros e paginação",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Lista transações com filtros e paginação",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por tipos de transação (income, expense)",
+                        "name": "record_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por categorias",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por favorecidos",
+                        "name": "payee_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Filtrar por tags",
+                        "name": "tag_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Combinação das tags: any (qualquer uma, padrão) ou all (todas)",
+                        "name": "tag_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Valor mínimo",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Valor máximo",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordem (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TransactionResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova transação com os dados fornecidos no corpo da requisição. transfer_account_id marca a transação como transferência para uma conta do livro, contada como aporte nas metas dessa conta",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Cria uma nova transação",
+                "parameters": [
+                    {
+                        "description": "Dados da transação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fornece dados estatísticos agregados das transações com base nos filtros aplicados",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Retorna estatísticas gerais das transações",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Tipos de transação (income, expense)",
+                        "name": "record_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Profundidade da hierarquia; subcategorias mais profundas são somadas no ancestral (0 = raízes)",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Agrupamento adicional: tag inclui os totais por tag (uma transação conta em cada uma de suas tags)",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionStatsSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera um resumo estatístico das transações baseado nos filtros fornecidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Retorna resumo de transações",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Data inicial (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Tipos de transação (income, expense)",
+                        "name": "record_types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Profundidade da hierarquia; subcategorias mais profundas são somadas no ancestral (0 = raízes)",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Agrupamento adicional: tag inclui os totais por tag (uma transação conta em cada uma de suas tags)",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SummaryByDate"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de uma transação com base no ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Busca uma transação por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma transação com base no ID fornecido e nos dados enviados no corpo da requisição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Atualiza uma transação existente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da transação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exclui uma transação com base no ID fornecido. O valor dela sai da fatura; transações de faturas fechadas ou pagas não podem ser excluídas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transações"
+                ],
+                "summary": "Remove uma transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    },
+                    "409": {
+                        "description": "Fatura fechada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Lista os anexos da transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AttachmentResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Envia um comprovante (PDF ou foto) para a transação. O tipo é identificado pelo conteúdo e o tamanho máximo é de 10 MB",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Anexa um arquivo à transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo a ser anexado",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AttachmentResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo maior que o permitido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Tipo de arquivo não permitido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/attachments/{attachment_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Baixa um anexo da transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Anexos"
+                ],
+                "summary": "Remove um anexo da transação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/participants": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Mostra a divisão de uma despesa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionParticipantsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define quem pagou a despesa (paid_by_id, padrão: o usuário autenticado) e como ela é dividida. Em mode=equal o valor é dividido igualmente, em percentage cada participante informa percentage (a soma deve ser 100) e em exact cada participante informa amount (a soma deve ser o valor da transação). As partes ficam na moeda da transação e a divisão anterior é substituída",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Divide uma despesa entre membros do livro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Divisão da despesa",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionParticipantsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransactionParticipantsResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Partes não somam o valor, transação não é despesa ou participante fora do livro",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Divisão de despesas"
+                ],
+                "summary": "Desfaz a divisão de uma despesa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da transação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Sem conteúdo"
+                    }
+                }
+            }
+        },
+        "/api/v1/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Recebe um arquivo e os parâmetros necessários para processamento assíncrono.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Processar arquivo",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Arquivo para importação (ex: .csv, .xlsx)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da fatura (opcional)",
+                        "name": "invoice_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do modelo alvo (ex: Nubank)",
+                        "name": "model",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ação desejada (ex: create)",
+                        "name": "action",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Arquivo recebido, processamento em andamento. Retorna o job_id da importação",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Erro nos parâmetros ou no upload",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo maior que o permitido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/upload/{job_id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o arquivo enviado para o job de importação, arquivado no momento do upload",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Lista o arquivo original de uma importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do job de importação",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AttachmentResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/upload/{job_id}/attachments/{attachment_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Upload"
+                ],
+                "summary": "Baixa o arquivo original de uma importação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do job de importação",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ainda não implementado: o token vale até expirar e basta descartá-lo no cliente",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Encerra a sessão",
+                "responses": {
+                    "501": {
+                        "description": "Não implementado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados do usuário, incluindo a moeda base usada nos totais",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Retorna o usuário autenticado",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Campos vazios mantêm o valor atual",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Atualiza o nome e o username",
+                "parameters": [
+                    {
+                        "description": "Nome e username",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Username já está em uso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ainda não implementado: depende de revogar os tokens já emitidos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Desativa a conta",
+                "responses": {
+                    "501": {
+                        "description": "Não implementado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/base-currency": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define a moeda para a qual estatísticas, resumos e faturas são convertidos. Só pode ser alterada antes do primeiro lançamento",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Define a moeda base do usuário",
+                "parameters": [
+                    {
+                        "description": "Código ISO 4217 da moeda (ex: BRL, USD)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BaseCurrencyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Já existem transações ou faturas na moeda atual",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/email": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "O novo e-mail passa a receber os avisos por e-mail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Troca o e-mail",
+                "parameters": [
+                    {
+                        "description": "Novo e-mail",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "E-mail já está em uso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuários"
+                ],
+                "summary": "Troca a senha",
+                "parameters": [
+                    {
+                        "description": "Senha atual e nova senha",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Senha atual incorreta",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AccountRequest": {
+            "type": "object",
+            "properties": {
+                "account_type": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AccountResponse": {
+            "type": "object",
+            "properties": {
+                "account_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by_id": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssetRequest": {
+            "type": "object",
+            "properties": {
+                "class": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssetResponse": {
+            "type": "object",
+            "properties": {
+                "class": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "import_job_id": {
+                    "type": "string"
+                },
+                "invoice_id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BaseCurrencyRequest": {
+            "type": "object",
+            "required": [
+                "base_currency"
+            ],
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BudgetRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.BudgetResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BudgetStatusResponse": {
+            "type": "object",
+            "properties": {
+                "budget_id": {
+                    "type": "string"
+                },
+                "budgeted": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "projected": {
+                    "type": "number"
+                },
+                "recurring": {
+                    "type": "boolean"
+                },
+                "remaining": {
+                    "type": "number"
+                },
+                "spent": {
+                    "type": "number"
+                },
+                "threshold": {
+                    "type": "integer"
+                },
+                "used_percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CashFlowAccountResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projection": {
+                    "$ref": "#/definitions/dto.CashFlowProjectionResponse"
+                }
+            }
+        },
+        "dto.CashFlowDayResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "type": "number"
+                },
+                "inflow": {
+                    "type": "number"
+                },
+                "outflow": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CashFlowEstimateResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "daily_amount": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CashFlowForecastResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CashFlowAccountResponse"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "estimates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CashFlowEstimateResponse"
+                    }
+                },
+                "first_negative_date": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CashFlowItemResponse"
+                    }
+                },
+                "lookback_days": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/dto.CashFlowProjectionResponse"
+                }
+            }
+        },
+        "dto.CashFlowItemResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "source_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CashFlowProjectionResponse": {
+            "type": "object",
+            "properties": {
+                "closing_balance": {
+                    "type": "number"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CashFlowDayResponse"
+                    }
+                },
+                "first_negative_date": {
+                    "type": "string"
+                },
+                "lowest_balance": {
+                    "type": "number"
+                },
+                "opening_balance": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CategoryCandidateResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "confidence": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CategoryRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "suggested_percentage": {
+                    "type": "integer"
+                },
+                "tax_group": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryResponse"
+                    }
+                },
+                "color": {
+                    "type": "string"
+                },
+                "created_by_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "suggested_percentage": {
+                    "type": "integer"
+                },
+                "tax_group": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CategorySuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "candidates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryCandidateResponse"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "confidence": {
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CategorySummary": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "depth": {
+                    "type": "integer"
+                },
+                "expense": {
+                    "type": "number"
+                },
+                "expense_transactions": {
+                    "type": "integer"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "income_transactions": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "tax": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.DebtPayoffResponse": {
+            "type": "object",
+            "properties": {
+                "debt": {
+                    "$ref": "#/definitions/dto.DebtResponse"
+                },
+                "interest_paid": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "payoff_date": {
+                    "type": "string"
+                },
+                "total_paid": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.DebtPlanRequest": {
+            "type": "object",
+            "properties": {
+                "card_interest_rate": {
+                    "type": "number"
+                },
+                "card_minimum_percentage": {
+                    "type": "number"
+                },
+                "custom_order": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "monthly_budget": {
+                    "type": "number"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DebtPlanResponse": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "debts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DebtResponse"
+                    }
+                },
+                "interest_saved": {
+                    "type": "number"
+                },
+                "minimum_payment": {
+                    "type": "number"
+                },
+                "monthly_budget": {
+                    "type": "number"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "strategies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DebtStrategyResponse"
+                    }
+                }
+            }
+        },
+        "dto.DebtResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "minimum_payment": {
+                    "type": "number"
+                },
+                "minimum_percentage": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DebtStrategyResponse": {
+            "type": "object",
+            "properties": {
+                "extra_interest": {
+                    "type": "number"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "payoff_date": {
+                    "type": "string"
+                },
+                "payoffs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DebtPayoffResponse"
+                    }
+                },
+                "strategy": {
+                    "type": "string"
+                },
+                "total_interest": {
+                    "type": "number"
+                },
+                "total_paid": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.EnvelopeAllocationRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EnvelopeAllocationResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "transfer_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EnvelopeMonthResponse": {
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "type": "number"
+                },
+                "envelopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EnvelopeResponse"
+                    }
+                },
+                "income": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "to_be_assigned": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.EnvelopeMoveRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "from_category_id": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "to_category_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EnvelopeResponse": {
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "type": "number"
+                },
+                "available": {
+                    "type": "number"
+                },
+                "carried_over": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "spent": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ExchangeRateImportResponse": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ExchangeRateRequest": {
+            "type": "object",
+            "properties": {
+                "from_currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "rate_date": {
+                    "type": "string"
+                },
+                "to_currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "from_currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "rate_date": {
+                    "type": "string"
+                },
+                "to_currency": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GoalProgressResponse": {
+            "type": "object",
+            "properties": {
+                "achieved": {
+                    "type": "boolean"
+                },
+                "goal": {
+                    "$ref": "#/definitions/dto.GoalResponse"
+                },
+                "monthly_needed": {
+                    "type": "number"
+                },
+                "monthly_pace": {
+                    "type": "number"
+                },
+                "months_left": {
+                    "type": "integer"
+                },
+                "on_track": {
+                    "type": "boolean"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "projected_date": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "number"
+                },
+                "saved": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.GoalRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "initial_amount": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "string"
+                },
+                "target_amount": {
+                    "type": "number"
+                },
+                "target_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GoalResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "initial_amount": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tag": {
+                    "$ref": "#/definitions/dto.TransactionTagResponse"
+                },
+                "target_amount": {
+                    "type": "number"
+                },
+                "target_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.HoldingRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "asset_class": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.HoldingResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "asset_class": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvestmentIncomeRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "payment_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvestmentIncomeResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "holding_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "payment_date": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvestmentQuoteImportResponse": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.InvestmentQuoteRequest": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "number"
+                },
+                "quote_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvestmentQuoteResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "holding_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quote_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvestmentTradeRequest": {
+            "type": "object",
+            "properties": {
+                "fees": {
+                    "type": "number"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "trade_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvestmentTradeResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "fees": {
+                    "type": "number"
+                },
+                "holding_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "trade_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvoiceCloseRequest": {
+            "type": "object",
+            "properties": {
+                "next_invoice_id": {
+                    "type": "string"
+                },
+                "rollover": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.InvoicePayRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "paid_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvoicePaymentRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "paid_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvoicePaymentResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "paid_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvoiceRequest": {
+            "type": "object",
+            "properties": {
+                "due_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by_id": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "outstanding_amount": {
+                    "type": "number"
+                },
+                "paid_amount": {
+                    "type": "number"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "payment_account_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LedgerInvitationRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LedgerInvitationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                },
+                "invitee": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                },
+                "ledger_id": {
+                    "type": "string"
+                },
+                "ledger_name": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LedgerMemberRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LedgerMemberResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                }
+            }
+        },
+        "dto.LedgerRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LedgerResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                },
+                "personal": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LedgerUserResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoanInstallmentResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoanPrepaymentRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "payment_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoanPrepaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interest_saved": {
+                    "type": "number"
+                },
+                "loan_id": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "payment_date": {
+                    "type": "string"
+                },
+                "remaining_installments": {
+                    "type": "integer"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoanRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "first_payment_date": {
+                    "type": "string"
+                },
+                "interest_category_id": {
+                    "type": "string"
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "principal_category_id": {
+                    "type": "string"
+                },
+                "rate_period": {
+                    "type": "string"
+                },
+                "system": {
+                    "type": "string"
+                },
+                "term_months": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LoanResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "first_payment_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interest_category_id": {
+                    "type": "string"
+                },
+                "interest_rate": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "principal_category_id": {
+                    "type": "string"
+                },
+                "rate_period": {
+                    "type": "string"
+                },
+                "system": {
+                    "type": "string"
+                },
+                "term_months": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoanScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number"
+                },
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LoanInstallmentResponse"
+                    }
+                },
+                "interest_paid": {
+                    "type": "number"
+                },
+                "interest_saved": {
+                    "type": "number"
+                },
+                "loan": {
+                    "$ref": "#/definitions/dto.LoanResponse"
+                },
+                "paid_installments": {
+                    "type": "integer"
+                },
+                "remaining_installments": {
+                    "type": "integer"
+                },
+                "total_interest": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.LoanSimulationResponse": {
+            "type": "object",
+            "properties": {
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LoanInstallmentResponse"
+                    }
+                },
+                "total_interest": {
+                    "type": "number"
+                },
+                "total_payment": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.LoanUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "identifier",
+                "password"
+            ],
+            "properties": {
+                "identifier": {
+                    "description": "username or email",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NetWorthByDate": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "number"
+                },
+                "assets": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "liabilities": {
+                    "type": "number"
+                },
+                "net_worth": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.NotificationPreferenceRequest": {
+            "type": "object",
+            "properties": {
+                "email_enabled": {
+                    "type": "boolean"
+                },
+                "inapp_enabled": {
+                    "type": "boolean"
+                },
+                "lead_days": {
+                    "type": "integer"
+                },
+                "quiet_end": {
+                    "type": "string",
+                    "example": "07:00"
+                },
+                "quiet_start": {
+                    "type": "string",
+                    "example": "22:00"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                },
+                "webhook_secret": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationPreferenceResponse": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "email_enabled": {
+                    "type": "boolean"
+                },
+                "has_webhook_secret": {
+                    "type": "boolean"
+                },
+                "inapp_enabled": {
+                    "type": "boolean"
+                },
+                "lead_days": {
+                    "type": "integer"
+                },
+                "quiet_end": {
+                    "type": "string"
+                },
+                "quiet_start": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationReadAllResponse": {
+            "type": "object",
+            "properties": {
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.NotificationResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationUnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PayeeRequest": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "document": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PayeeResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "document": {
+                    "type": "string"
+                },
+                "expense": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "last_record_date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "normalized_name": {
+                    "type": "string"
+                },
+                "transactions": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PortfolioAllocationResponse": {
+            "type": "object",
+            "properties": {
+                "asset_class": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.PortfolioHoldingResponse": {
+            "type": "object",
+            "properties": {
+                "average_cost": {
+                    "type": "number"
+                },
+                "cost_basis": {
+                    "type": "number"
+                },
+                "holding": {
+                    "$ref": "#/definitions/dto.HoldingResponse"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "price_date": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "realized_gain": {
+                    "type": "number"
+                },
+                "unrealized_gain": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "value_base": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.PortfolioResponse": {
+            "type": "object",
+            "properties": {
+                "allocation": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PortfolioAllocationResponse"
+                    }
+                },
+                "cost_basis": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "holdings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PortfolioHoldingResponse"
+                    }
+                },
+                "income": {
+                    "type": "number"
+                },
+                "realized_gain": {
+                    "type": "number"
+                },
+                "unrealized_gain": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.RecurringRuleRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RecurringRuleResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RuleActionsRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "skip": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RuleActionsResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "skip": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RuleConditionsRequest": {
+            "type": "object",
+            "properties": {
+                "amount_max": {
+                    "type": "number"
+                },
+                "amount_min": {
+                    "type": "number"
+                },
+                "invoice_id": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "title_contains": {
+                    "type": "string"
+                },
+                "title_regex": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RuleConditionsResponse": {
+            "type": "object",
+            "properties": {
+                "amount_max": {
+                    "type": "number"
+                },
+                "amount_min": {
+                    "type": "number"
+                },
+                "invoice_id": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "title_contains": {
+                    "type": "string"
+                },
+                "title_regex": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RuleRequest": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/dto.RuleActionsRequest"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/dto.RuleConditionsRequest"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.RuleResponse": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/dto.RuleActionsResponse"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/dto.RuleConditionsResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SettleUpRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SettlementPaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "from": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                },
+                "to": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                }
+            }
+        },
+        "dto.SettlementRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "from_user_id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "to_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SettlementResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "settled_at": {
+                    "type": "string"
+                },
+                "to": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                }
+            }
+        },
+        "dto.SharedBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "net": {
+                    "type": "number"
+                },
+                "paid": {
+                    "type": "number"
+                },
+                "received": {
+                    "type": "number"
+                },
+                "sent": {
+                    "type": "number"
+                },
+                "share": {
+                    "type": "number"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                }
+            }
+        },
+        "dto.SharedBalancesResponse": {
+            "type": "object",
+            "properties": {
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SharedBalanceResponse"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SettlementPaymentResponse"
+                    }
+                }
+            }
+        },
+        "dto.SummaryByDate": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategorySummary"
+                    }
+                },
+                "date": {
+                    "type": "string"
+                },
+                "expense": {
+                    "type": "number"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagSummary"
+                    }
+                },
+                "tax": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TagRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TagResponse": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TagSummary": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "expense": {
+                    "type": "number"
+                },
+                "expense_transactions": {
+                    "type": "integer"
+                },
+                "income": {
+                    "type": "number"
+                },
+                "income_transactions": {
+                    "type": "integer"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "string"
+                },
+                "tax": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TaxCategoryTotalResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TaxGroupTotalResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaxCategoryTotalResponse"
+                    }
+                },
+                "group": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TaxIncomeSourceResponse": {
+            "type": "object",
+            "properties": {
+                "document": {
+                    "type": "string"
+                },
+                "exempt_income": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "type": "string"
+                },
+                "taxable_income": {
+                    "type": "number"
+                },
+                "withheld_tax": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TaxPaymentResponse": {
+            "type": "object",
+            "properties": {
+                "document": {
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.TaxReportResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "deductible_total": {
+                    "type": "number"
+                },
+                "deductions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaxGroupTotalResponse"
+                    }
+                },
+                "exempt_income": {
+                    "type": "number"
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaxPaymentResponse"
+                    }
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaxIncomeSourceResponse"
+                    }
+                },
+                "taxable_income": {
+                    "type": "number"
+                },
+                "withheld_tax": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.TransactionCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TransactionInvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TransactionParticipantRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TransactionParticipantResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
+                }
+            }
+        },
+        "dto.TransactionParticipantsRequest": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "type": "string"
+                },
+                "paid_by_id": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TransactionParticipantRequest"
+                    }
+                }
+            }
+        },
+        "dto.TransactionParticipantsResponse": {
             "type": "object",
             "properties": {
-                "color": {
+                "currency": {
                     "type": "string"
                 },
-                "description": {
+                "mode": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "paid_by": {
+                    "$ref": "#/definitions/dto.LedgerUserResponse"
                 },
-                "suggested_percentage": {
-                    "type": "integer"
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TransactionParticipantResponse"
+                    }
+                },
+                "transaction_id": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CategoryResponse": {
+        "dto.TransactionPayeeResponse": {
             "type": "object",
             "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
-                },
-                "suggested_percentage": {
-                    "type": "integer"
                 }
             }
         },
-        "dto.CategorySummary": {
+        "dto.TransactionRequest": {
             "type": "object",
+            "required": [
+                "record_type",
+                "status"
+            ],
             "properties": {
-                "category": {
+                "amount": {
+                    "type": "number"
+                },
+                "category_id": {
                     "type": "string"
                 },
-                "expense": {
-                    "type": "number"
+                "currency": {
+                    "type": "string"
                 },
-                "expense_transactions": {
+                "installment_count": {
                     "type": "integer"
                 },
-                "income": {
-                    "type": "number"
-                },
-                "income_transactions": {
+                "installment_number": {
                     "type": "integer"
                 },
-                "tax": {
-                    "type": "number"
-                }
-            }
-        },
-        "dto.InvoiceRequest": {
-            "type": "object",
-            "properties": {
-                "due_date": {
+                "invoice_id": {
                     "type": "string"
                 },
-                "status": {
+                "record_date": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string",
+                    "enum": [
+                        "income",
+                        "expense"
+                    ]
+                },
+                "recurring_rule_id": {
                     "type": "string"
                 },
+                "splits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TransactionSplitRequest"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "canceled"
+                    ]
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "title": {
                     "type": "string"
+                },
+                "transfer_account_id": {
+                    "type": "string"
                 }
             }
         },
-        "dto.InvoiceResponse": {
+        "dto.TransactionResponse": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "number"
                 },
+                "base_amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "due_date": {
+                "created_by_id": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string"
+                },
+                "exchange_rate": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "string"
                 },
+                "installment_count": {
+                    "type": "integer"
+                },
+                "installment_number": {
+                    "type": "integer"
+                },
+                "invoice": {
+                    "$ref": "#/definitions/dto.TransactionInvoiceResponse"
+                },
+                "payee": {
+                    "$ref": "#/definitions/dto.TransactionPayeeResponse"
+                },
+                "record_date": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "recurring_rule_id": {
+                    "type": "string"
+                },
+                "splits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TransactionSplitResponse"
+                    }
+                },
                 "status": {
                     "type": "string"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TransactionTagResponse"
+                    }
+                },
                 "title": {
                     "type": "string"
                 },
+                "transfer_account_id": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.TransactionSplitRequest": {
             "type": "object",
-            "required": [
-                "identifier",
-                "password"
-            ],
             "properties": {
-                "identifier": {
-                    "description": "username or email",
+                "amount": {
+                    "type": "number"
+                },
+                "category_id": {
                     "type": "string"
                 },
-                "password": {
+                "note": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginResponse": {
+        "dto.TransactionSplitResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
                     "type": "string"
                 }
             }
         },
-        "dto.SummaryByDate": {
+        "dto.TransactionStatsSummary": {
             "type": "object",
             "properties": {
+                "balance": {
+                    "type": "number"
+                },
                 "categories": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/dto.CategorySummary"
                     }
                 },
-                "date": {
-                    "type": "string"
-                },
                 "expense": {
                     "type": "number"
                 },
+                "expense_transactions": {
+                    "type": "integer"
+                },
                 "income": {
                     "type": "number"
                 },
+                "income_transactions": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TagSummary"
+                    }
+                },
                 "tax": {
                     "type": "number"
                 }
             }
         },
-        "dto.TransactionCategoryResponse": {
+        "dto.TransactionTagResponse": {
             "type": "object",
             "properties": {
+                "color": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -1194,111 +9311,116 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.TransactionInvoiceResponse": {
+        "dto.UserEmailRequest": {
             "type": "object",
+            "required": [
+                "new_email"
+            ],
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
+                "new_email": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "dto.TransactionRequest": {
+        "dto.UserPasswordRequest": {
             "type": "object",
             "required": [
-                "record_type",
-                "status"
+                "new_password",
+                "old_password"
             ],
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "category_id": {
-                    "type": "string"
-                },
-                "invoice_id": {
-                    "type": "string"
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
                 },
-                "record_date": {
+                "old_password": {
                     "type": "string"
-                },
-                "record_type": {
+                }
+            }
+        },
+        "dto.UserProfileRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "income",
-                        "expense"
-                    ]
+                    "maxLength": 255
                 },
-                "status": {
+                "username": {
                     "type": "string",
-                    "enum": [
-                        "pending",
-                        "paid",
-                        "canceled"
-                    ]
-                },
-                "title": {
-                    "type": "string"
+                    "maxLength": 255
                 }
             }
         },
-        "dto.TransactionResponse": {
+        "dto.UserResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "category": {
-                    "$ref": "#/definitions/dto.TransactionCategoryResponse"
+                "base_currency": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
+                "email": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "invoice": {
-                    "$ref": "#/definitions/dto.TransactionInvoiceResponse"
+                "is_active": {
+                    "type": "boolean"
                 },
-                "record_date": {
+                "name": {
                     "type": "string"
                 },
-                "record_type": {
+                "updated_at": {
                     "type": "string"
                 },
-                "status": {
+                "username": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.ValuationRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
                 },
-                "title": {
+                "note": {
                     "type": "string"
                 },
-                "updated_at": {
+                "value_date": {
                     "type": "string"
                 }
             }
         },
-        "dto.TransactionStatsSummary": {
+        "dto.ValuationResponse": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "type": "number"
+                "account_id": {
+                    "type": "string"
                 },
-                "expense": {
+                "amount": {
                     "type": "number"
                 },
-                "expense_transactions": {
-                    "type": "integer"
+                "asset_id": {
+                    "type": "string"
                 },
-                "income": {
-                    "type": "number"
+                "created_at": {
+                    "type": "string"
                 },
-                "income_transactions": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "tax": {
-                    "type": "number"
+                "note": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value_date": {
+                    "type": "string"
                 }
             }
         }
@@ -1320,7 +9442,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "",
 	Schemes:          []string{},
 	Title:            "API Frog-Go",
-	Description:      "",
+	Description:      "Para acessar os lançamentos de um livro compartilhado, envie o header X-Ledger-ID com o ID do livro.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -0,0 +1,108 @@
+package localfs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/outbound/blobstore"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const tmpDir = "tmp"
+
+// LocalFS grava os blobs no sistema de arquivos local, em <root>/ab/cd/<sha256>, onde
+// "ab" e "cd" são os primeiros caracteres da chave para não concentrar tudo num diretório.
+type LocalFS struct {
+	root string
+}
+
+func NewLocalFS(root string) (blobstore.BlobStore, error) {
+	if root == "" {
+		return nil, fmt.Errorf("blob storage path is required")
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, tmpDir), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob storage: %w", err)
+	}
+
+	return &LocalFS{root: root}, nil
+}
+
+// Put grava o conteúdo num arquivo temporário enquanto calcula o SHA-256 e depois o move
+// para o caminho definitivo. Se o blob já existir, o temporário é descartado.
+func (l *LocalFS) Put(ctx context.Context, r io.Reader) (string, int64, error) {
+	tmp, err := os.CreateTemp(filepath.Join(l.root, tmpDir), "blob-*")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create temporary blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	key := hex.EncodeToString(hash.Sum(nil))
+	path := l.path(key)
+
+	if _, err := os.Stat(path); err == nil {
+		return key, size, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", 0, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	return key, size, nil
+}
+
+func (l *LocalFS) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	if !validKey(key) {
+		return nil, appError.ErrBlobNotFound
+	}
+
+	file, err := os.Open(l.path(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, appError.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return file, nil
+}
+
+func (l *LocalFS) Delete(ctx context.Context, key string) error {
+	if !validKey(key) {
+		return appError.ErrBlobNotFound
+	}
+
+	if err := os.Remove(l.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+func (l *LocalFS) path(key string) string {
+	return filepath.Join(l.root, key[:2], key[2:4], key)
+}
+
+// validKey impede que uma chave adulterada escape do diretório raiz.
+func validKey(key string) bool {
+	if len(key) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(key)
+	return err == nil
+}
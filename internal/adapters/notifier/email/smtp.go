@@ -0,0 +1,138 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/ports/outbound/notifier"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+const dialTimeout = 10 * time.Second
+
+// SMTP envia os avisos por e-mail, em texto puro, para o e-mail cadastrado no usuário. Usa
+// STARTTLS quando o servidor oferece e só autentica se houver usuário configurado, o que
+// permite apontar para um servidor local de testes como o Mailpit.
+type SMTP struct {
+	addr string
+	host string
+	auth smtp.Auth
+	from *mail.Address
+}
+
+func NewSMTP(host, port, username, password, from string) (notifier.Channel, error) {
+	if host == "" {
+		return nil, fmt.Errorf("smtp host is required")
+	}
+
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp sender %q: %w", from, err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{
+		addr: net.JoinHostPort(host, port),
+		host: host,
+		auth: auth,
+		from: sender,
+	}, nil
+}
+
+func (s *SMTP) Send(ctx context.Context, delivery domain.NotificationDelivery) error {
+	if delivery.Email == nil {
+		return fmt.Errorf("user has no email")
+	}
+
+	message, err := s.message(*delivery.Email, delivery)
+	if err != nil {
+		return err
+	}
+
+	conn, err := (&net.Dialer{Timeout: dialTimeout}).DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+	if s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(*delivery.Email); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s *SMTP) message(to string, delivery domain.NotificationDelivery) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	headers := [][2]string{
+		{"From", s.from.String()},
+		{"To", (&mail.Address{Address: to}).String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", delivery.Reminder.Title)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@frog-go>", delivery.ID)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buffer, "%s: %s\r\n", header[0], header[1])
+	}
+	buffer.WriteString("\r\n")
+
+	writer := quotedprintable.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(delivery.Reminder.Body + "\r\n")); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
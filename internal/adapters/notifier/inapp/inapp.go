@@ -0,0 +1,28 @@
+package inapp
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/ports/outbound/notifier"
+	"frog-go/internal/core/ports/outbound/repository"
+)
+
+// InApp guarda os avisos na caixa de entrada do app.
+type InApp struct {
+	repo repository.Repository
+}
+
+func NewInApp(repo repository.Repository) notifier.Channel {
+	return &InApp{repo: repo}
+}
+
+func (i *InApp) Send(ctx context.Context, delivery domain.NotificationDelivery) error {
+	return i.repo.CreateNotification(ctx, domain.Notification{
+		UserID:  delivery.Reminder.UserID,
+		Key:     delivery.Reminder.Key,
+		Type:    delivery.Reminder.Kind,
+		Title:   delivery.Reminder.Title,
+		Body:    delivery.Reminder.Body,
+		Payload: delivery.Reminder.Payload,
+	})
+}
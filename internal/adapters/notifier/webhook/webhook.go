@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/ports/outbound/notifier"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	eventHeader     = "X-Frog-Event"
+	deliveryHeader  = "X-Frog-Delivery"
+	signatureHeader = "X-Frog-Signature"
+)
+
+// Webhook envia os avisos em JSON, por POST, para a URL cadastrada nas preferências. Com um
+// segredo cadastrado, o corpo é assinado com HMAC-SHA256 no cabeçalho X-Frog-Signature
+// (sha256=<hex>). Qualquer resposta fora da faixa 2xx conta como falha, inclusive redirecionamentos,
+// que não são seguidos. Endereços internos (loopback, rede privada, link-local) são recusados na
+// conexão, depois da resolução de DNS, para que a URL não seja usada para alcançar a rede interna.
+type Webhook struct {
+	client *http.Client
+}
+
+type payload struct {
+	ID          uuid.UUID      `json:"id"`
+	Kind        string         `json:"kind"`
+	Key         string         `json:"key"`
+	ReferenceID *uuid.UUID     `json:"reference_id"`
+	Title       string         `json:"title"`
+	Body        string         `json:"body"`
+	Data        map[string]any `json:"data"`
+	CreatedAt   string         `json:"created_at"`
+}
+
+func NewWebhook(timeout time.Duration) notifier.Channel {
+	dialer := &net.Dialer{Timeout: timeout, Control: rejectInternalAddress}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Webhook{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// rejectInternalAddress recusa a conexão com endereços que não são públicos. Roda para o IP já
+// resolvido, então um nome que aponta para a rede interna também é bloqueado.
+func rejectInternalAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("webhook address %q is not an IP", host)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return fmt.Errorf("webhook address %s is not public", ip)
+	}
+	return nil
+}
+
+func (w *Webhook) Send(ctx context.Context, delivery domain.NotificationDelivery) error {
+	if delivery.Preference.WebhookURL == nil {
+		return fmt.Errorf("user has no webhook url")
+	}
+	// Preferências salvas antes da exigência de https não recebem mais avisos
+	if target, err := url.Parse(*delivery.Preference.WebhookURL); err != nil || target.Scheme != "https" {
+		return fmt.Errorf("webhook url must be https")
+	}
+
+	body, err := json.Marshal(payload{
+		ID:          delivery.ID,
+		Kind:        string(delivery.Reminder.Kind),
+		Key:         delivery.Reminder.Key,
+		ReferenceID: delivery.Reminder.ReferenceID,
+		Title:       delivery.Reminder.Title,
+		Body:        delivery.Reminder.Body,
+		Data:        delivery.Reminder.Payload,
+		CreatedAt:   delivery.CreatedAt.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to serialize webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *delivery.Preference.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "frog-go-webhook")
+	req.Header.Set(eventHeader, string(delivery.Reminder.Kind))
+	req.Header.Set(deliveryHeader, delivery.ID.String())
+
+	if secret := delivery.Preference.WebhookSecret; secret != nil {
+		mac := hmac.New(sha256.New, []byte(*secret))
+		mac.Write(body)
+		req.Header.Set(signatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+const accountEntity = "accounts"
+
+func (p *PostgreSQL) CreateAccount(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Account) (*dto.AccountResponse, error) {
+	currency, err := p.currencyOrBase(ctx, ledgerID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := p.Client.Account.
+		Create().
+		SetLedgerID(ledgerID).
+		SetCreatedByID(createdByID).
+		SetName(input.Name).
+		SetAccountType(string(input.AccountType)).
+		SetCurrency(currency).
+		Save(ctx)
+
+	if err != nil {
+		return nil, appError.FailedToSave(accountEntity, err)
+	}
+
+	return newAccountResponse(row), nil
+}
+
+func (p *PostgreSQL) ListAccounts(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AccountResponse, error) {
+	query := p.Client.Account.Query().
+		Where(account.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyAccountFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(account.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(account.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.AccountResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newAccountResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountAccounts(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Account.Query().
+		Where(account.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyAccountFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func newAccountResponse(row *ent.Account) *dto.AccountResponse {
+	return &dto.AccountResponse{
+		ID:          row.ID,
+		Name:        row.Name,
+		AccountType: row.AccountType,
+		Currency:    row.Currency,
+		CreatedByID: row.CreatedByID,
+		CreatedAt:   utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:   utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func applyAccountFilters(query *ent.AccountQuery, pgn *pagination.Pagination) *ent.AccountQuery {
+	if pgn.Search != "" {
+		query = query.Where(account.NameContainsFold(pgn.Search))
+	}
+	return query
+}
@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+const assetEntity = "assets"
+
+func (p *PostgreSQL) GetAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.AssetResponse, error) {
+	row, err := p.Client.Asset.Query().
+		Where(asset.IDEQ(id)).
+		Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(assetEntity, err)
+	}
+	return newAssetResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateAsset(ctx context.Context, ledgerID uuid.UUID, input domain.Asset) (*dto.AssetResponse, error) {
+	currency, err := p.currencyOrBase(ctx, ledgerID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := p.Client.Asset.
+		Create().
+		SetLedgerID(ledgerID).
+		SetName(input.Name).
+		SetClass(string(input.Class)).
+		SetKind(string(input.Kind)).
+		SetCurrency(currency).
+		Save(ctx)
+
+	if err != nil {
+		return nil, appError.FailedToSave(assetEntity, err)
+	}
+
+	return newAssetResponse(row), nil
+}
+
+func (p *PostgreSQL) UpdateAsset(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Asset) (*dto.AssetResponse, error) {
+	update := p.Client.Asset.
+		UpdateOneID(id).
+		Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		SetName(input.Name).
+		SetClass(string(input.Class)).
+		SetKind(string(input.Kind))
+
+	if input.Currency != "" {
+		update = update.SetCurrency(input.Currency)
+	}
+
+	row, err := update.Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToUpdate(assetEntity, err)
+	}
+
+	return newAssetResponse(row), nil
+}
+
+func (p *PostgreSQL) DeleteAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Asset.DeleteOneID(id).
+		Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(assetEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListAssets(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AssetResponse, error) {
+	query := p.Client.Asset.Query().
+		Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyAssetFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(asset.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(asset.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.AssetResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newAssetResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountAssets(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Asset.Query().
+		Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyAssetFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func newAssetResponse(row *ent.Asset) *dto.AssetResponse {
+	return &dto.AssetResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Class:     row.Class,
+		Kind:      row.Kind,
+		Currency:  row.Currency,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func applyAssetFilters(query *ent.AssetQuery, pgn *pagination.Pagination) *ent.AssetQuery {
+	if pgn.Search != "" {
+		query = query.Where(asset.NameContainsFold(pgn.Search))
+	}
+	return query
+}
@@ -0,0 +1,211 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/attachment"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+const attachmentEntity = "attachments"
+
+func (p *PostgreSQL) GetAttachment(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) (*domain.Attachment, error) {
+	row, err := p.Client.Attachment.Query().
+		Where(attachment.IDEQ(id)).
+		Where(attachment.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(attachmentTargetPredicate(target)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(attachmentEntity, err)
+	}
+	return toAttachment(row), nil
+}
+
+func (p *PostgreSQL) CreateAttachment(ctx context.Context, ledgerID uuid.UUID, input domain.Attachment) (*dto.AttachmentResponse, error) {
+	if err := p.ensureAttachmentTarget(ctx, ledgerID, input.AttachmentTarget); err != nil {
+		return nil, err
+	}
+
+	row, err := p.Client.Attachment.
+		Create().
+		SetLedgerID(ledgerID).
+		SetFilename(input.Filename).
+		SetContentType(input.ContentType).
+		SetSize(input.Size).
+		SetBlobKey(input.BlobKey).
+		SetNillableTransactionID(input.TransactionID).
+		SetNillableInvoiceID(input.InvoiceID).
+		SetNillableImportJobID(input.ImportJobID).
+		Save(ctx)
+
+	if err != nil {
+		return nil, appError.FailedToSave(attachmentEntity, err)
+	}
+
+	return newAttachmentResponse(row), nil
+}
+
+func (p *PostgreSQL) DeleteAttachmentByID(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) error {
+	deleted, err := p.Client.Attachment.Delete().
+		Where(attachment.IDEQ(id)).
+		Where(attachment.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(attachmentTargetPredicate(target)).
+		Exec(ctx)
+
+	if err != nil {
+		return appError.FailedToDelete(attachmentEntity, err)
+	}
+	if deleted == 0 {
+		return appError.ErrNotFound
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListAttachments(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget) ([]dto.AttachmentResponse, error) {
+	if err := p.ensureAttachmentTarget(ctx, ledgerID, target); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.Attachment.Query().
+		Where(attachment.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(attachmentTargetPredicate(target)).
+		Order(ent.Asc(attachment.FieldCreatedAt), ent.Asc(attachment.FieldID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, appError.FailedToFind(attachmentEntity, err)
+	}
+
+	response := make([]dto.AttachmentResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newAttachmentResponse(row))
+	}
+	return response, nil
+}
+
+// CountAttachmentsByBlobKey conta os anexos, de qualquer livro, que apontam para o blob.
+// Como o armazenamento é endereçado pelo conteúdo, o blob só pode ser removido sem referências.
+func (p *PostgreSQL) CountAttachmentsByBlobKey(ctx context.Context, key string) (int, error) {
+	total, err := p.Client.Attachment.Query().
+		Where(attachment.BlobKeyEQ(key)).
+		Count(ctx)
+	if err != nil {
+		return 0, appError.FailedToFind(attachmentEntity, err)
+	}
+	return total, nil
+}
+
+// WithBlobLock executa fn segurando um advisory lock do Postgres sobre a chave do blob, para
+// que a contagem de referências e a remoção do blob não se intercalem com o registro de um
+// novo anexo do mesmo conteúdo. O lock é liberado ao fim da transação que o obteve.
+func (p *PostgreSQL) WithBlobLock(ctx context.Context, key string, fn func() error) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, key); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to lock blob %s: %w", key, err)
+	}
+
+	if err := fn(); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ensureAttachmentTarget garante que a transação ou a fatura do anexo pertence ao livro.
+// Jobs de importação não têm registro próprio e não são verificados.
+func (p *PostgreSQL) ensureAttachmentTarget(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget) error {
+	var exists bool
+	var err error
+
+	switch {
+	case target.TransactionID != nil:
+		exists, err = p.Client.Transaction.Query().
+			Where(transaction.IDEQ(*target.TransactionID)).
+			Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exist(ctx)
+	case target.InvoiceID != nil:
+		exists, err = p.Client.Invoice.Query().
+			Where(invoice.IDEQ(*target.InvoiceID)).
+			Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exist(ctx)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return appError.FailedToFind(attachmentEntity, err)
+	}
+	if !exists {
+		return appError.ErrNotFound
+	}
+	return nil
+}
+
+func attachmentTargetPredicate(target domain.AttachmentTarget) predicate.Attachment {
+	switch {
+	case target.TransactionID != nil:
+		return attachment.TransactionIDEQ(*target.TransactionID)
+	case target.InvoiceID != nil:
+		return attachment.InvoiceIDEQ(*target.InvoiceID)
+	case target.ImportJobID != nil:
+		return attachment.ImportJobIDEQ(*target.ImportJobID)
+	default:
+		return attachment.And(
+			attachment.TransactionIDIsNil(),
+			attachment.InvoiceIDIsNil(),
+			attachment.ImportJobIDIsNil(),
+		)
+	}
+}
+
+func toAttachment(row *ent.Attachment) *domain.Attachment {
+	return &domain.Attachment{
+		ID:          row.ID,
+		Filename:    row.Filename,
+		ContentType: row.ContentType,
+		Size:        row.Size,
+		BlobKey:     row.BlobKey,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+		AttachmentTarget: domain.AttachmentTarget{
+			TransactionID: row.TransactionID,
+			InvoiceID:     row.InvoiceID,
+			ImportJobID:   row.ImportJobID,
+		},
+	}
+}
+
+func newAttachmentResponse(row *ent.Attachment) *dto.AttachmentResponse {
+	return &dto.AttachmentResponse{
+		ID:            row.ID,
+		Filename:      row.Filename,
+		ContentType:   row.ContentType,
+		Size:          row.Size,
+		TransactionID: row.TransactionID,
+		InvoiceID:     row.InvoiceID,
+		ImportJobID:   row.ImportJobID,
+		CreatedAt:     utils.ToDateTimeString(row.CreatedAt),
+	}
+}
@@ -0,0 +1,436 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/budget"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const budgetEntity = "budgets"
+
+func (p *PostgreSQL) GetBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.BudgetResponse, error) {
+	row, err := p.Client.Budget.Query().
+		Where(budget.IDEQ(id)).
+		Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(budgetEntity, err)
+	}
+	return newBudgetResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateBudget(ctx context.Context, ledgerID uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error) {
+	var id uuid.UUID
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := p.prepareBudget(ctx, tx.Client(), ledgerID, nil, &input); err != nil {
+			return err
+		}
+
+		row, err := tx.Budget.
+			Create().
+			SetLedgerID(ledgerID).
+			SetCategoryID(input.CategoryID).
+			SetNillableMonth(input.Month).
+			SetNillableAmount(input.Amount).
+			SetNillablePercentage(input.Percentage).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return appError.ErrBudgetConflict
+			}
+			return appError.FailedToSave(budgetEntity, err)
+		}
+		id = row.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetBudgetByID(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) UpdateBudget(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := p.prepareBudget(ctx, tx.Client(), ledgerID, &id, &input); err != nil {
+			return err
+		}
+
+		// Mudar o limite ou o mês recomeça os alertas do orçamento
+		update := tx.Budget.
+			UpdateOneID(id).
+			Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			SetCategoryID(input.CategoryID).
+			SetAlertedThreshold(0).
+			ClearAlertedMonth()
+
+		if input.Month != nil {
+			update = update.SetMonth(*input.Month)
+		} else {
+			update = update.ClearMonth()
+		}
+
+		if input.Amount != nil {
+			update = update.SetAmount(*input.Amount).ClearPercentage()
+		} else {
+			update = update.SetPercentage(*input.Percentage).ClearAmount()
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			if ent.IsConstraintError(err) {
+				return appError.ErrBudgetConflict
+			}
+			return appError.FailedToUpdate(budgetEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetBudgetByID(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) DeleteBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Budget.DeleteOneID(id).
+		Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(budgetEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListBudgets(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetFilters, pgn *pagination.Pagination) ([]dto.BudgetResponse, error) {
+	query := p.Client.Budget.Query().
+		Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory()
+
+	query = applyBudgetFilters(query, flt)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(budget.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(budget.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.BudgetResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newBudgetResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountBudgets(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetFilters) (int, error) {
+	query := p.Client.Budget.Query().
+		Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyBudgetFilters(query, flt)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// BudgetsStatus compara o orçado com o gasto de cada categoria no mês. O gasto de uma
+// categoria inclui o das suas subcategorias e ignora transações canceladas.
+func (p *PostgreSQL) BudgetsStatus(ctx context.Context, ledgerID uuid.UUID, month time.Time, now time.Time) ([]dto.BudgetStatusResponse, error) {
+	usages, err := p.budgetUsages(ctx, ledgerID, month, now)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.BudgetStatusResponse, 0, len(usages))
+	for _, usage := range usages {
+		response = append(response, usage.status)
+	}
+	return response, nil
+}
+
+// ListBudgetAlerts retorna, para todos os livros, os orçamentos do mês de now que
+// atingiram um limite de alerta ainda não avisado. O aviso vai para o dono do livro.
+func (p *PostgreSQL) ListBudgetAlerts(ctx context.Context, now time.Time) ([]dto.BudgetAlertEvent, error) {
+	ledgers, err := p.Client.Budget.Query().
+		QueryLedger().
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerEntity, err)
+	}
+
+	month := domain.MonthStart(now)
+	alerts := []dto.BudgetAlertEvent{}
+	for _, row := range ledgers {
+		usages, err := p.budgetUsages(ctx, row.ID, month, now)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, usage := range usages {
+			alerted := 0
+			if usage.row.AlertedMonth != nil && usage.row.AlertedMonth.Equal(month) {
+				alerted = usage.row.AlertedThreshold
+			}
+			if usage.status.Threshold <= alerted {
+				continue
+			}
+
+			alerts = append(alerts, dto.BudgetAlertEvent{
+				UserID:    row.Edges.Owner.ID,
+				BudgetID:  usage.row.ID,
+				Category:  usage.status.Category,
+				Month:     usage.status.Month,
+				Threshold: usage.status.Threshold,
+				Budgeted:  usage.status.Budgeted,
+				Spent:     usage.status.Spent,
+			})
+		}
+	}
+	return alerts, nil
+}
+
+// MarkBudgetAlerted registra o último limite avisado do orçamento no mês.
+func (p *PostgreSQL) MarkBudgetAlerted(ctx context.Context, id uuid.UUID, month time.Time, threshold int) error {
+	err := p.Client.Budget.
+		UpdateOneID(id).
+		SetAlertedMonth(domain.MonthStart(month)).
+		SetAlertedThreshold(threshold).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToUpdate(budgetEntity, err)
+	}
+	return nil
+}
+
+// prepareBudget confere a categoria, aplica o percentual sugerido da categoria quando o
+// orçamento não informa limite e impede dois orçamentos da categoria para o mesmo mês
+// (inclusive dois recorrentes, que o índice único não barra por terem month nulo).
+func (p *PostgreSQL) prepareBudget(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, id *uuid.UUID, input *domain.Budget) error {
+	row, err := client.Category.Query().
+		Where(category.IDEQ(input.CategoryID)).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrCategoryNotFound
+		}
+		return appError.FailedToFind(categoryEntity, err)
+	}
+
+	if input.Amount == nil && input.Percentage == nil {
+		if row.SuggestedPercentage == nil || *row.SuggestedPercentage <= 0 {
+			return appError.ErrBudgetAmountRequired
+		}
+		input.Percentage = row.SuggestedPercentage
+	}
+
+	query := client.Budget.Query().
+		Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(budget.CategoryIDEQ(input.CategoryID))
+	if input.Month != nil {
+		query = query.Where(budget.MonthEQ(*input.Month))
+	} else {
+		query = query.Where(budget.MonthIsNil())
+	}
+	if id != nil {
+		query = query.Where(budget.IDNEQ(*id))
+	}
+
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return appError.FailedToFind(budgetEntity, err)
+	}
+	if exists {
+		return appError.ErrBudgetConflict
+	}
+	return nil
+}
+
+type budgetUsage struct {
+	row    *ent.Budget
+	status dto.BudgetStatusResponse
+}
+
+// budgetUsages calcula o uso dos orçamentos do livro no mês. O orçamento do próprio mês
+// prevalece sobre o recorrente da mesma categoria.
+func (p *PostgreSQL) budgetUsages(ctx context.Context, ledgerID uuid.UUID, month time.Time, now time.Time) ([]budgetUsage, error) {
+	start := domain.MonthStart(month)
+	end := start.AddDate(0, 1, 0)
+
+	rows, err := p.Client.Budget.Query().
+		Where(budget.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(budget.Or(budget.MonthIsNil(), budget.MonthEQ(start))).
+		WithCategory().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(budgetEntity, err)
+	}
+
+	byCategory := map[uuid.UUID]*ent.Budget{}
+	for _, row := range rows {
+		if current, ok := byCategory[row.CategoryID]; ok && current.Month != nil {
+			continue
+		}
+		byCategory[row.CategoryID] = row
+	}
+	if len(byCategory) == 0 {
+		return []budgetUsage{}, nil
+	}
+
+	tree, err := p.loadCategoryTree(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT t.category_id,
+			COALESCE(SUM(CASE WHEN t.record_type = 'expense' THEN t.amount ELSE 0 END), 0) AS expense,
+			COALESCE(SUM(CASE WHEN t.record_type = 'income' THEN t.amount ELSE 0 END), 0) AS income
+		FROM (%s) AS t
+		WHERE t.status <> 'canceled'
+		AND t.record_date >= $2 AND t.record_date < $3
+		GROUP BY t.category_id
+	`, transactionLinesSQL("$1"))
+
+	result, err := p.db.QueryContext(ctx, query, ledgerID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var income domain.Money
+	spent := map[uuid.UUID]domain.Money{}
+	for result.Next() {
+		var categoryID uuid.NullUUID
+		var expense, categoryIncome domain.Money
+		if err := result.Scan(&categoryID, &expense, &categoryIncome); err != nil {
+			return nil, err
+		}
+
+		income += categoryIncome
+		if !categoryID.Valid || expense == 0 {
+			continue
+		}
+
+		// O gasto conta para a categoria e para todos os seus ancestrais
+		visited := map[uuid.UUID]bool{}
+		for id := &categoryID.UUID; id != nil && !visited[*id]; id = tree[*id] {
+			visited[*id] = true
+			spent[*id] += expense
+		}
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	usages := make([]budgetUsage, 0, len(byCategory))
+	for categoryID, row := range byCategory {
+		budgeted := domain.Budget{Amount: row.Amount, Percentage: row.Percentage}.BudgetLimit(income)
+		used := spent[categoryID]
+
+		var percentage float64
+		if budgeted > 0 {
+			percentage = math.Round(float64(used)*10000/float64(budgeted)) / 100
+		}
+
+		usages = append(usages, budgetUsage{
+			row: row,
+			status: dto.BudgetStatusResponse{
+				BudgetID:       row.ID,
+				Category:       budgetCategoryResponse(row),
+				Month:          start.Format(dto.BudgetMonthFormat),
+				Recurring:      row.Month == nil,
+				Budgeted:       budgeted,
+				Spent:          used,
+				Remaining:      budgeted - used,
+				Projected:      domain.ProjectSpending(used, start, now),
+				UsedPercentage: percentage,
+				Threshold:      domain.BudgetThreshold(used, budgeted),
+			},
+		})
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].status.Category.Name < usages[j].status.Category.Name
+	})
+
+	return usages, nil
+}
+
+func applyBudgetFilters(query *ent.BudgetQuery, flt dto.BudgetFilters) *ent.BudgetQuery {
+	if flt.CategoryIDs != nil && len(*flt.CategoryIDs) > 0 {
+		query = query.Where(budget.CategoryIDIn(utils.ToUUIDSlice(*flt.CategoryIDs)...))
+	}
+
+	if flt.Month != nil && *flt.Month != "" {
+		if month, err := dto.ParseBudgetMonth(*flt.Month); err == nil {
+			query = query.Where(budget.MonthEQ(month))
+		}
+	}
+
+	return query
+}
+
+func budgetCategoryResponse(row *ent.Budget) dto.TransactionCategoryResponse {
+	response := dto.TransactionCategoryResponse{ID: row.CategoryID}
+	if row.Edges.Category != nil {
+		response.Name = row.Edges.Category.Name
+	}
+	return response
+}
+
+func newBudgetResponse(row *ent.Budget) *dto.BudgetResponse {
+	var month *string
+	if row.Month != nil {
+		value := row.Month.Format(dto.BudgetMonthFormat)
+		month = &value
+	}
+
+	return &dto.BudgetResponse{
+		ID:         row.ID,
+		Category:   budgetCategoryResponse(row),
+		Month:      month,
+		Amount:     row.Amount,
+		Percentage: row.Percentage,
+		CreatedAt:  utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:  utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
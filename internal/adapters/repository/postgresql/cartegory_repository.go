@@ -2,36 +2,48 @@ package postgresql
 
 import (
 	"context"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
 	"frog-go/internal/config"
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/ent"
 	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/utils/pagination"
+	"slices"
 
 	"github.com/google/uuid"
 )
 
 const categoryEntity = "categories"
 
-func (p *PostgreSQL) GetCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error) {
-	row, err := p.Client.Category.Get(ctx, id)
+// categorySuggestionCandidates limita quantas categorias do classificador são devolvidas como alternativas.
+const categorySuggestionCandidates = 3
+
+func (p *PostgreSQL) GetCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.CategoryResponse, error) {
+	row, err := p.Client.Category.Query().
+		Where(category.IDEQ(id)).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, appError.ErrNotFound
 		}
 		return nil, appError.FailedToFind(categoryEntity, err)
 	}
-	return dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage), nil
+	return dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage, row.ParentID, row.TaxGroup, row.CreatedByID), nil
 }
 
-func (p *PostgreSQL) GetCategoryIDByName(ctx context.Context, name *string) (*uuid.UUID, error) {
+func (p *PostgreSQL) GetCategoryIDByName(ctx context.Context, ledgerID uuid.UUID, name *string) (*uuid.UUID, error) {
 	if name == nil {
 		return nil, nil
 	}
 
-	data, err := p.Client.Category.Query().Where(category.NameEQ(*name)).Only(ctx)
+	data, err := p.Client.Category.Query().
+		Where(category.NameEQ(*name)).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, appError.ErrNotFound
@@ -43,30 +55,74 @@ func (p *PostgreSQL) GetCategoryIDByName(ctx context.Context, name *string) (*uu
 	return &id, nil
 }
 
-func (p *PostgreSQL) CreateCategory(ctx context.Context, input domain.Category) (*dto.CategoryResponse, error) {
+func (p *PostgreSQL) CreateCategory(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Category) (*dto.CategoryResponse, error) {
+	if input.ParentID != nil {
+		tree, err := p.loadCategoryTree(ctx, ledgerID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tree[*input.ParentID]; !ok {
+			return nil, appError.InvalidParam("parent_id", appError.ErrCategoryNotFound)
+		}
+	}
+
+	var taxGroup *string
+	if input.TaxGroup != nil {
+		value := string(*input.TaxGroup)
+		taxGroup = &value
+	}
+
 	row, err := p.Client.Category.
 		Create().
+		SetLedgerID(ledgerID).
+		SetCreatedByID(createdByID).
 		SetName(input.Name).
 		SetNillableDescription(input.Description).
 		SetNillableColor(input.Color).
 		SetNillableSuggestedPercentage(input.SuggestedPercentage).
+		SetNillableParentID(input.ParentID).
+		SetNillableTaxGroup(taxGroup).
 		Save(ctx)
 
 	if err != nil {
 		return nil, appError.FailedToSave(categoryEntity, err)
 	}
 
-	return dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage), nil
+	return dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage, row.ParentID, row.TaxGroup, row.CreatedByID), nil
 }
 
-func (p *PostgreSQL) UpdateCategory(ctx context.Context, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error) {
-	row, err := p.Client.Category.
+func (p *PostgreSQL) UpdateCategory(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error) {
+	update := p.Client.Category.
 		UpdateOneID(id).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
 		SetName(input.Name).
 		SetNillableDescription(input.Description).
 		SetNillableColor(input.Color).
-		SetNillableSuggestedPercentage(input.SuggestedPercentage).
-		Save(ctx)
+		SetNillableSuggestedPercentage(input.SuggestedPercentage)
+
+	if input.TaxGroup != nil {
+		update = update.SetTaxGroup(string(*input.TaxGroup))
+	} else {
+		update = update.ClearTaxGroup()
+	}
+
+	if input.ParentID != nil {
+		tree, err := p.loadCategoryTree(ctx, ledgerID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tree[*input.ParentID]; !ok {
+			return nil, appError.InvalidParam("parent_id", appError.ErrCategoryNotFound)
+		}
+		if tree.CreatesCycle(id, *input.ParentID) {
+			return nil, appError.ErrCategoryCycle
+		}
+		update = update.SetParentID(*input.ParentID)
+	} else {
+		update = update.ClearParentID()
+	}
+
+	row, err := update.Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -75,11 +131,13 @@ func (p *PostgreSQL) UpdateCategory(ctx context.Context, id uuid.UUID, input dom
 		return nil, appError.FailedToUpdate(categoryEntity, err)
 	}
 
-	return dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage), nil
+	return dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage, row.ParentID, row.TaxGroup, row.CreatedByID), nil
 }
 
-func (p *PostgreSQL) DeleteCategoryByID(ctx context.Context, id uuid.UUID) error {
-	err := p.Client.Category.DeleteOneID(id).Exec(ctx)
+func (p *PostgreSQL) DeleteCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Category.DeleteOneID(id).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return appError.ErrNotFound
@@ -89,8 +147,9 @@ func (p *PostgreSQL) DeleteCategoryByID(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
-func (p *PostgreSQL) ListCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, error) {
-	query := p.Client.Category.Query()
+func (p *PostgreSQL) ListCategories(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.CategoryResponse, error) {
+	query := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID)))
 	query = applyCategoryFilters(query, pgn)
 
 	if pgn.OrderDirection == config.OrderAsc {
@@ -108,14 +167,44 @@ func (p *PostgreSQL) ListCategories(ctx context.Context, pgn *pagination.Paginat
 
 	response := make([]dto.CategoryResponse, 0, len(rows))
 	for _, row := range rows {
-		response = append(response, *dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage))
+		response = append(response, *dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage, row.ParentID, row.TaxGroup, row.CreatedByID))
 	}
 	return response, nil
 
 }
 
-func (p *PostgreSQL) CountCategories(ctx context.Context, pgn *pagination.Pagination) (int, error) {
-	query := p.Client.Category.Query()
+func (p *PostgreSQL) ListCategoryTree(ctx context.Context, ledgerID uuid.UUID) ([]*dto.CategoryResponse, error) {
+	rows, err := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Order(ent.Asc(category.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	nodes := make(map[uuid.UUID]*dto.CategoryResponse, len(rows))
+	for _, row := range rows {
+		nodes[row.ID] = dto.NewCategoryResponse(row.ID, row.Name, row.Description, row.Color, row.SuggestedPercentage, row.ParentID, row.TaxGroup, row.CreatedByID)
+	}
+
+	roots := []*dto.CategoryResponse{}
+	for _, row := range rows {
+		var parent *dto.CategoryResponse
+		if row.ParentID != nil {
+			parent = nodes[*row.ParentID]
+		}
+		if parent == nil {
+			roots = append(roots, nodes[row.ID])
+			continue
+		}
+		parent.Children = append(parent.Children, nodes[row.ID])
+	}
+	return roots, nil
+}
+
+func (p *PostgreSQL) CountCategories(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID)))
 	query = applyCategoryFilters(query, pgn)
 
 	total, err := query.Count(ctx)
@@ -125,6 +214,82 @@ func (p *PostgreSQL) CountCategories(ctx context.Context, pgn *pagination.Pagina
 	return total, nil
 }
 
+// loadCategoryTree carrega a hierarquia de categorias do livro.
+func (p *PostgreSQL) loadCategoryTree(ctx context.Context, ledgerID uuid.UUID) (domain.CategoryTree, error) {
+	rows, err := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Select(category.FieldID, category.FieldParentID).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	tree := make(domain.CategoryTree, len(rows))
+	for _, row := range rows {
+		tree[row.ID] = row.ParentID
+	}
+	return tree, nil
+}
+
+// createDefaultCategories cria para o livro as categorias padrão que ele ainda não possui.
+func createDefaultCategories(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) error {
+	existing, err := client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Select(category.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return appError.FailedToFind(categoryEntity, err)
+	}
+
+	builders := []*ent.CategoryCreate{}
+	for _, c := range domain.DefaultCategories() {
+		if slices.Contains(existing, c.Name) {
+			continue
+		}
+		builders = append(builders, client.Category.
+			Create().
+			SetLedgerID(ledgerID).
+			SetName(c.Name).
+			SetNillableDescription(c.Description).
+			SetNillableColor(c.Color).
+			SetNillableSuggestedPercentage(c.SuggestedPercentage))
+	}
+
+	if len(builders) == 0 {
+		return nil
+	}
+
+	if err := client.Category.CreateBulk(builders...).Exec(ctx); err != nil {
+		return appError.FailedToSave(categoryEntity, err)
+	}
+	return nil
+}
+
+// ensureLedgerCategories garante que todas as categorias informadas pertencem ao livro.
+func ensureLedgerCategories(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, ids []uuid.UUID) error {
+	unique := []uuid.UUID{}
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+
+	total, err := client.Category.Query().
+		Where(category.IDIn(unique...)).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Count(ctx)
+	if err != nil {
+		return appError.FailedToFind(categoryEntity, err)
+	}
+	if total != len(unique) {
+		return appError.ErrCategoryNotFound
+	}
+	return nil
+}
+
 func applyCategoryFilters(query *ent.CategoryQuery, pgn *pagination.Pagination) *ent.CategoryQuery {
 	if pgn.Search != "" {
 		query = query.Where(
@@ -136,3 +301,64 @@ func applyCategoryFilters(query *ent.CategoryQuery, pgn *pagination.Pagination)
 	}
 	return query
 }
+
+func (p *PostgreSQL) SuggestCategory(ctx context.Context, ledgerID uuid.UUID, flt dto.CategorySuggestFilters) (*dto.CategorySuggestionResponse, error) {
+	input := domain.Transaction{
+		Title:      flt.Title,
+		Amount:     flt.Amount,
+		RecordType: domain.TypeExpense,
+	}
+
+	suggestion, err := hooks.SuggestCategory(ctx, p.Client, p.categorizer, p.learner, ledgerID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked, err := p.learner.Rank(ctx, p.Client, ledgerID, flt.Title, flt.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if len(ranked) > categorySuggestionCandidates {
+		ranked = ranked[:categorySuggestionCandidates]
+	}
+
+	rows, err := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	names := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		names[row.ID] = row.Name
+	}
+
+	response := &dto.CategorySuggestionResponse{
+		Candidates: []dto.CategoryCandidateResponse{},
+	}
+
+	if suggestion != nil {
+		if name, ok := names[suggestion.CategoryID]; ok {
+			source := string(suggestion.Source)
+			response.CategoryID = &suggestion.CategoryID
+			response.Category = &name
+			response.Source = &source
+			response.Confidence = suggestion.Confidence
+		}
+	}
+
+	for _, candidate := range ranked {
+		name, ok := names[candidate.CategoryID]
+		if !ok {
+			continue
+		}
+		response.Candidates = append(response.Candidates, dto.CategoryCandidateResponse{
+			CategoryID: candidate.CategoryID,
+			Category:   name,
+			Confidence: candidate.Confidence,
+		})
+	}
+
+	return response, nil
+}
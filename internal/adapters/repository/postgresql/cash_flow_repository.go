@@ -0,0 +1,440 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/valuation"
+	"frog-go/internal/utils"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CashFlowForecast projeta os saldos de start a end. O saldo inicial de cada conta é a
+// avaliação mais recente até start; as entradas e saídas previstas são as transações
+// pendentes (as parcelas de empréstimo saem da conta do empréstimo), as faturas em aberto ou
+// vencidas (na conta de pagamento), as ocorrências das regras recorrentes, as parcelas futuras
+// das compras parceladas no cartão e a média diária dos gastos pagos por categoria nos
+// lookback dias anteriores. A média deixa de fora as compras no cartão, que saem pela fatura,
+// e as ocorrências de regras recorrentes, que já são projetadas pela regra.
+func (p *PostgreSQL) CashFlowForecast(ctx context.Context, ledgerID uuid.UUID, start time.Time, end time.Time, lookback int) (*dto.CashFlowForecastResponse, error) {
+	base, err := p.baseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := p.Client.Account.Query().
+		Where(account.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Order(ent.Asc(account.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(accountEntity, err)
+	}
+
+	valuations, err := p.Client.Valuation.Query().
+		Where(valuation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(valuation.AccountIDNotNil()).
+		Where(valuation.ValueDateLTE(start)).
+		Order(ent.Asc(valuation.FieldValueDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(valuationEntity, err)
+	}
+
+	latest := map[uuid.UUID]domain.Money{}
+	for _, row := range valuations {
+		latest[*row.AccountID] = row.Amount
+	}
+
+	items, err := p.pendingCashFlowItems(ctx, ledgerID, end)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := p.Client.Invoice.Query().
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(invoice.StatusIn(domain.UnpaidInvoiceStatus()...)).
+		Where(invoice.DueDateLTE(end)).
+		WithPayments().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind("invoices", err)
+	}
+
+	for _, row := range invoices {
+		outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+		if outstanding <= 0 {
+			continue
+		}
+
+		items = append(items, domain.CashFlowItem{
+			Date:      time.Date(row.DueDate.Year(), row.DueDate.Month(), row.DueDate.Day(), 0, 0, 0, 0, time.UTC),
+			Kind:      domain.CashFlowInvoice,
+			SourceID:  row.ID,
+			Title:     row.Title,
+			AccountID: row.PaymentAccountID,
+			Amount:    -outstanding,
+		})
+	}
+
+	recurring, err := p.recurringCashFlowItems(ctx, ledgerID, base, start, end)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, recurring...)
+
+	installments, err := p.cardInstallmentCashFlowItems(ctx, ledgerID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, installments...)
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Date.Before(items[j].Date)
+	})
+
+	estimates, err := p.cashFlowEstimates(ctx, ledgerID, start.AddDate(0, 0, -lookback), start, lookback)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	categoryNames := map[uuid.UUID]string{}
+	for _, row := range categories {
+		categoryNames[row.ID] = row.Name
+	}
+
+	response := &dto.CashFlowForecastResponse{
+		Currency:     base,
+		StartDate:    start.Format(time.DateOnly),
+		EndDate:      end.Format(time.DateOnly),
+		LookbackDays: lookback,
+		Accounts:     make([]dto.CashFlowAccountResponse, 0, len(accounts)),
+		Items:        make([]dto.CashFlowItemResponse, 0, len(items)),
+		Estimates:    make([]dto.CashFlowEstimateResponse, 0, len(estimates)),
+	}
+
+	var dailyEstimate float64
+	for _, estimate := range estimates {
+		dailyEstimate += estimate.DailyAmount
+
+		var name *string
+		if estimate.CategoryID != nil {
+			if value, ok := categoryNames[*estimate.CategoryID]; ok {
+				name = &value
+			}
+		}
+		response.Estimates = append(response.Estimates, dto.NewCashFlowEstimateResponse(estimate, name))
+	}
+
+	for _, item := range items {
+		response.Items = append(response.Items, dto.NewCashFlowItemResponse(item))
+	}
+
+	var opening domain.Money
+	projections := []domain.CashFlowProjection{}
+	for _, row := range accounts {
+		balance := latest[row.ID]
+		if row.Currency != base && balance != 0 {
+			rate, err := hooks.ExchangeRateOn(ctx, p.Client, ledgerID, row.Currency, base, start)
+			if err != nil {
+				return nil, err
+			}
+			balance = balance.Convert(rate)
+		}
+		opening += balance
+
+		accountItems := []domain.CashFlowItem{}
+		for _, item := range items {
+			if item.AccountID != nil && *item.AccountID == row.ID {
+				accountItems = append(accountItems, item)
+			}
+		}
+
+		projection := domain.ProjectCashFlow(start, end, balance, accountItems, 0)
+		projections = append(projections, projection)
+
+		response.Accounts = append(response.Accounts, dto.CashFlowAccountResponse{
+			AccountID:  row.ID,
+			Name:       row.Name,
+			Projection: dto.NewCashFlowProjectionResponse(projection),
+		})
+	}
+
+	total := domain.ProjectCashFlow(start, end, opening, items, dailyEstimate)
+	response.Total = dto.NewCashFlowProjectionResponse(total)
+
+	var firstNegative *time.Time
+	for _, projection := range append(projections, total) {
+		if date := projection.FirstNegativeDate; date != nil && (firstNegative == nil || date.Before(*firstNegative)) {
+			firstNegative = date
+		}
+	}
+	if firstNegative != nil {
+		response.FirstNegativeDate = utils.StringPtr(firstNegative.Format(time.DateOnly))
+	}
+
+	return response, nil
+}
+
+// pendingCashFlowItems busca as transações pendentes até end, fora das faturas, na moeda base.
+// As parcelas de empréstimo levam a conta do empréstimo.
+func (p *PostgreSQL) pendingCashFlowItems(ctx context.Context, ledgerID uuid.UUID, end time.Time) ([]domain.CashFlowItem, error) {
+	query := `
+		SELECT t.id, t.title, t.record_type, t.record_date,
+			ROUND(t.amount * t.exchange_rate, 2) AS amount,
+			l.account_id, li.id IS NOT NULL AS is_installment
+		FROM transactions AS t
+			LEFT JOIN loan_installments AS li ON li.transaction_id = t.id
+			LEFT JOIN loans AS l ON l.id = li.loan_id
+		WHERE t.ledger_id = $1
+		AND t.status = $2
+		AND t.invoice_id IS NULL
+		AND t.record_date <= $3
+		ORDER BY t.record_date, t.id
+	`
+
+	rows, err := p.db.QueryContext(ctx, query, ledgerID, string(domain.StatusPending), end)
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+	defer rows.Close()
+
+	items := []domain.CashFlowItem{}
+	for rows.Next() {
+		var item domain.CashFlowItem
+		var recordType string
+		var accountID uuid.NullUUID
+		var isInstallment bool
+
+		if err := rows.Scan(&item.SourceID, &item.Title, &recordType, &item.Date, &item.Amount, &accountID, &isInstallment); err != nil {
+			return nil, err
+		}
+
+		item.Date = time.Date(item.Date.Year(), item.Date.Month(), item.Date.Day(), 0, 0, 0, 0, time.UTC)
+		item.Kind = domain.CashFlowTransaction
+		if isInstallment {
+			item.Kind = domain.CashFlowLoanInstallment
+		}
+		if accountID.Valid {
+			item.AccountID = &accountID.UUID
+		}
+		if recordType != string(domain.TypeIncome) {
+			item.Amount = -item.Amount
+		}
+
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// recurringCashFlowItems projeta as ocorrências das regras recorrentes entre start e end, na
+// moeda base e na conta da regra. As ocorrências que já têm transação vinculada ficam de fora:
+// as pendentes entram como transação e as pagas já estão no saldo.
+func (p *PostgreSQL) recurringCashFlowItems(ctx context.Context, ledgerID uuid.UUID, base string, start time.Time, end time.Time) ([]domain.CashFlowItem, error) {
+	rules, err := p.Client.RecurringRule.Query().
+		Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(recurringrule.StartDateLTE(end)).
+		Where(recurringrule.Or(recurringrule.EndDateIsNil(), recurringrule.EndDateGTE(start))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(recurringRuleEntity, err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	linked, err := p.Client.Transaction.Query().
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(transaction.RecurringRuleIDNotNil()).
+		Where(transaction.RecordDateGTE(start.AddDate(-1, 0, 0))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+
+	recorded := map[uuid.UUID][]time.Time{}
+	for _, row := range linked {
+		recorded[*row.RecurringRuleID] = append(recorded[*row.RecurringRuleID], row.RecordDate)
+	}
+
+	items := []domain.CashFlowItem{}
+	for _, row := range rules {
+		rule := newRecurringRuleDomain(row)
+
+		dates := rule.Occurrences(start, end, recorded[row.ID])
+		if len(dates) == 0 {
+			continue
+		}
+
+		rate, err := hooks.ExchangeRateOn(ctx, p.Client, ledgerID, rule.Currency, base, start)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := rule.Amount.Convert(rate)
+		if rule.RecordType != domain.TypeIncome {
+			amount = -amount
+		}
+
+		for _, date := range dates {
+			items = append(items, domain.CashFlowItem{
+				Date:      date,
+				Kind:      domain.CashFlowRecurring,
+				SourceID:  row.ID,
+				Title:     row.Title,
+				AccountID: row.AccountID,
+				Amount:    amount,
+			})
+		}
+	}
+
+	return items, nil
+}
+
+// cardInstallmentCashFlowItems projeta as parcelas ainda não lançadas das compras parceladas no
+// cartão. Parte da última parcela lançada de cada compra (mesmo título, valor e número de
+// parcelas) e coloca as seguintes, uma por mês, no vencimento das faturas seguintes, na conta
+// de pagamento da fatura.
+func (p *PostgreSQL) cardInstallmentCashFlowItems(ctx context.Context, ledgerID uuid.UUID, start time.Time, end time.Time) ([]domain.CashFlowItem, error) {
+	query := `
+		SELECT t.id, t.title, t.record_type, t.installment_number, t.installment_count,
+			ROUND(t.amount * t.exchange_rate, 2) AS amount,
+			i.due_date, i.payment_account_id
+		FROM transactions AS t
+			JOIN invoices AS i ON i.id = t.invoice_id
+		WHERE t.ledger_id = $1
+		AND t.status <> $2
+		AND t.installment_number < t.installment_count
+		AND NOT EXISTS (
+			SELECT 1 FROM transactions AS n
+			WHERE n.ledger_id = t.ledger_id
+			AND n.title = t.title
+			AND n.amount = t.amount
+			AND n.installment_count = t.installment_count
+			AND n.installment_number > t.installment_number
+		)
+		ORDER BY i.due_date, t.id
+	`
+
+	rows, err := p.db.QueryContext(ctx, query, ledgerID, string(domain.StatusCanceled))
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+	defer rows.Close()
+
+	items := []domain.CashFlowItem{}
+	for rows.Next() {
+		var id uuid.UUID
+		var title, recordType string
+		var number, count int
+		var amount domain.Money
+		var dueDate time.Time
+		var accountID uuid.NullUUID
+
+		if err := rows.Scan(&id, &title, &recordType, &number, &count, &amount, &dueDate, &accountID); err != nil {
+			return nil, err
+		}
+
+		if recordType != string(domain.TypeIncome) {
+			amount = -amount
+		}
+
+		dueDate = time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+		for next := number + 1; next <= count; next++ {
+			date := domain.AddMonths(dueDate, next-number)
+			if date.After(end) {
+				break
+			}
+			if date.Before(start) {
+				continue
+			}
+
+			item := domain.CashFlowItem{
+				Date:     date,
+				Kind:     domain.CashFlowCardInstallment,
+				SourceID: id,
+				Title:    fmt.Sprintf("%s (%d/%d)", title, next, count),
+				Amount:   amount,
+			}
+			if accountID.Valid {
+				item.AccountID = &accountID.UUID
+			}
+			items = append(items, item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// cashFlowEstimates calcula a média diária dos gastos pagos por categoria entre from e to,
+// sem as parcelas de empréstimo, que já entram pelo cronograma, as compras no cartão, que
+// saem pela fatura, e as ocorrências de regras recorrentes, projetadas pela própria regra.
+func (p *PostgreSQL) cashFlowEstimates(ctx context.Context, ledgerID uuid.UUID, from time.Time, to time.Time, days int) ([]domain.CashFlowEstimate, error) {
+	query := fmt.Sprintf(`
+		SELECT t.category_id, SUM(t.amount) AS total
+		FROM (%s) AS t
+		WHERE t.record_type = $2
+		AND t.status = $3
+		AND t.record_date >= $4
+		AND t.record_date < $5
+		AND t.invoice_id IS NULL
+		AND t.recurring_rule_id IS NULL
+		AND NOT EXISTS (SELECT 1 FROM loan_installments AS li WHERE li.transaction_id = t.id)
+		GROUP BY t.category_id
+		ORDER BY total DESC
+	`, transactionLinesSQL("$1"))
+
+	rows, err := p.db.QueryContext(ctx, query, ledgerID, string(domain.TypeExpense), string(domain.StatusPaid), from, to)
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+	defer rows.Close()
+
+	estimates := []domain.CashFlowEstimate{}
+	for rows.Next() {
+		var estimate domain.CashFlowEstimate
+		var categoryID uuid.NullUUID
+
+		if err := rows.Scan(&categoryID, &estimate.Total); err != nil {
+			return nil, err
+		}
+
+		if categoryID.Valid {
+			estimate.CategoryID = &categoryID.UUID
+		}
+		estimate.DailyAmount = float64(estimate.Total) / float64(days)
+
+		estimates = append(estimates, estimate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return estimates, nil
+}
@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListDebts reúne as dívidas em aberto do livro na moeda base: o saldo das faturas não pagas
+// e o saldo devedor dos empréstimos, com a taxa mensal e a próxima parcela como pagamento
+// mínimo. A taxa e o mínimo das faturas ficam a cargo do plano.
+func (p *PostgreSQL) ListDebts(ctx context.Context, ledgerID uuid.UUID, date time.Time) ([]domain.OpenDebt, error) {
+	base, err := p.baseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := p.Client.Invoice.Query().
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(invoice.StatusIn(domain.UnpaidInvoiceStatus()...)).
+		WithPayments().
+		Order(ent.Asc(invoice.FieldDueDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind("invoices", err)
+	}
+
+	loans, err := p.Client.Loan.Query().
+		Where(loan.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithInstallments(func(query *ent.LoanInstallmentQuery) {
+			query.WithTransaction().Order(ent.Asc(loaninstallment.FieldNumber))
+		}).
+		WithPrepayments().
+		Order(ent.Asc(loan.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(loanEntity, err)
+	}
+
+	debts := make([]domain.OpenDebt, 0, len(invoices)+len(loans))
+	for _, row := range invoices {
+		outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+		if outstanding <= 0 {
+			continue
+		}
+
+		debts = append(debts, domain.OpenDebt{
+			ID:      row.ID,
+			Kind:    domain.DebtInvoice,
+			Name:    row.Title,
+			Balance: outstanding,
+		})
+	}
+
+	rates := map[string]float64{}
+	for _, row := range loans {
+		balance := row.Principal
+		for _, prepayment := range row.Edges.Prepayments {
+			balance -= prepayment.Amount
+		}
+
+		var minimum domain.Money
+		for _, installment := range row.Edges.Installments {
+			if txn := installment.Edges.Transaction; txn != nil && txn.Status == string(domain.StatusPaid) {
+				balance -= installment.Principal
+			} else if minimum == 0 {
+				minimum = installment.Payment
+			}
+		}
+		if balance <= 0 {
+			continue
+		}
+
+		if row.Currency != base {
+			rate, ok := rates[row.Currency]
+			if !ok {
+				rate, err = hooks.ExchangeRateOn(ctx, p.Client, ledgerID, row.Currency, base, date)
+				if err != nil {
+					return nil, err
+				}
+				rates[row.Currency] = rate
+			}
+			balance = balance.Convert(rate)
+			minimum = minimum.Convert(rate)
+		}
+
+		debts = append(debts, domain.OpenDebt{
+			ID:             row.ID,
+			Kind:           domain.DebtLoan,
+			Name:           row.Name,
+			Balance:        balance,
+			InterestRate:   toDomainLoan(row).MonthlyRate() * 100,
+			MinimumPayment: minimum,
+		})
+	}
+
+	return debts, nil
+}
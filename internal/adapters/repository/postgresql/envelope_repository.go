@@ -0,0 +1,307 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/envelopeallocation"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const envelopeAllocationEntity = "envelope_allocations"
+
+func (p *PostgreSQL) CreateEnvelopeAllocation(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeAllocation) (*dto.EnvelopeAllocationResponse, error) {
+	data, err := p.createEnvelopeAllocations(ctx, ledgerID, []domain.EnvelopeAllocation{input})
+	if err != nil {
+		return nil, err
+	}
+	return &data[0], nil
+}
+
+func (p *PostgreSQL) MoveEnvelopeMoney(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeMove) ([]dto.EnvelopeAllocationResponse, error) {
+	return p.createEnvelopeAllocations(ctx, ledgerID, input.Allocations())
+}
+
+// DeleteEnvelopeAllocationByID remove o lançamento; numa movimentação, os dois lados saem juntos.
+func (p *PostgreSQL) DeleteEnvelopeAllocationByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.EnvelopeAllocation.Query().
+			Where(envelopeallocation.IDEQ(id)).
+			Where(envelopeallocation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToFind(envelopeAllocationEntity, err)
+		}
+
+		predicate := envelopeallocation.IDEQ(row.ID)
+		if row.TransferID != nil {
+			predicate = envelopeallocation.TransferIDEQ(*row.TransferID)
+		}
+
+		_, err = tx.EnvelopeAllocation.Delete().
+			Where(predicate).
+			Where(envelopeallocation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToDelete(envelopeAllocationEntity, err)
+		}
+		return nil
+	})
+}
+
+func (p *PostgreSQL) ListEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeAllocationFilters, pgn *pagination.Pagination) ([]dto.EnvelopeAllocationResponse, error) {
+	query := p.Client.EnvelopeAllocation.Query().
+		Where(envelopeallocation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory()
+
+	query = applyEnvelopeAllocationFilters(query, flt)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(envelopeallocation.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(envelopeallocation.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.EnvelopeAllocationResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newEnvelopeAllocationResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeAllocationFilters) (int, error) {
+	query := p.Client.EnvelopeAllocation.Query().
+		Where(envelopeallocation.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyEnvelopeAllocationFilters(query, flt)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// EnvelopesMonth monta o orçamento base zero do mês. A conta começa no primeiro mês com
+// lançamentos em envelopes: o saldo de cada envelope (positivo ou negativo) passa para o mês
+// seguinte e a receita ainda não atribuída a nenhum envelope fica em to_be_assigned.
+// Transações canceladas não contam e despesas e impostos saem do envelope da sua categoria.
+func (p *PostgreSQL) EnvelopesMonth(ctx context.Context, ledgerID uuid.UUID, month time.Time) (*dto.EnvelopeMonthResponse, error) {
+	start := domain.MonthStart(month)
+	end := start.AddDate(0, 1, 0)
+
+	allocations, err := p.Client.EnvelopeAllocation.Query().
+		Where(envelopeallocation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(envelopeallocation.MonthLT(end)).
+		Select(envelopeallocation.FieldCategoryID, envelopeallocation.FieldMonth, envelopeallocation.FieldAmount).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(envelopeAllocationEntity, err)
+	}
+
+	origin := start
+	envelopes := map[uuid.UUID]*domain.Envelope{}
+	envelopeOf := func(id uuid.UUID) *domain.Envelope {
+		if envelopes[id] == nil {
+			envelopes[id] = &domain.Envelope{}
+		}
+		return envelopes[id]
+	}
+
+	var income, assigned, assignedBefore domain.Money
+	for _, row := range allocations {
+		if row.Month.Before(origin) {
+			origin = domain.MonthStart(row.Month)
+		}
+		envelope := envelopeOf(row.CategoryID)
+		if row.Month.Before(start) {
+			envelope.CarriedOver += row.Amount
+			assignedBefore += row.Amount
+		} else {
+			envelope.Assigned += row.Amount
+			assigned += row.Amount
+		}
+	}
+
+	query := fmt.Sprintf(`
+		SELECT t.category_id,
+			COALESCE(SUM(CASE WHEN t.record_type <> 'income' AND t.record_date < $3 THEN t.amount ELSE 0 END), 0) AS spent_before,
+			COALESCE(SUM(CASE WHEN t.record_type <> 'income' AND t.record_date >= $3 THEN t.amount ELSE 0 END), 0) AS spent,
+			COALESCE(SUM(CASE WHEN t.record_type = 'income' THEN t.amount ELSE 0 END), 0) AS income
+		FROM (%s) AS t
+		WHERE t.status <> 'canceled'
+		AND t.record_date >= $2 AND t.record_date < $4
+		GROUP BY t.category_id
+	`, transactionLinesSQL("$1"))
+
+	rows, err := p.db.QueryContext(ctx, query, ledgerID, origin, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var categoryID uuid.NullUUID
+		var spentBefore, spent, categoryIncome domain.Money
+		if err := rows.Scan(&categoryID, &spentBefore, &spent, &categoryIncome); err != nil {
+			return nil, err
+		}
+
+		income += categoryIncome
+		if !categoryID.Valid || (spentBefore == 0 && spent == 0) {
+			continue
+		}
+
+		envelope := envelopeOf(categoryID.UUID)
+		envelope.CarriedOver -= spentBefore
+		envelope.Spent += spent
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(envelopes))
+	for id := range envelopes {
+		ids = append(ids, id)
+	}
+
+	categories, err := p.Client.Category.Query().
+		Where(category.IDIn(ids...)).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Select(category.FieldID, category.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	response := &dto.EnvelopeMonthResponse{
+		Month:        start.Format(dto.BudgetMonthFormat),
+		Income:       income,
+		Assigned:     assigned,
+		ToBeAssigned: income - assigned - assignedBefore,
+		Envelopes:    make([]dto.EnvelopeResponse, 0, len(categories)),
+	}
+
+	for _, row := range categories {
+		envelope := envelopes[row.ID]
+		response.Envelopes = append(response.Envelopes, dto.EnvelopeResponse{
+			Category:    dto.TransactionCategoryResponse{ID: row.ID, Name: row.Name},
+			CarriedOver: envelope.CarriedOver,
+			Assigned:    envelope.Assigned,
+			Spent:       envelope.Spent,
+			Available:   envelope.Available(),
+		})
+	}
+
+	sort.Slice(response.Envelopes, func(i, j int) bool {
+		return response.Envelopes[i].Category.Name < response.Envelopes[j].Category.Name
+	})
+
+	return response, nil
+}
+
+func (p *PostgreSQL) createEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, input []domain.EnvelopeAllocation) ([]dto.EnvelopeAllocationResponse, error) {
+	ids := make([]uuid.UUID, 0, len(input))
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		categoryIDs := make([]uuid.UUID, 0, len(input))
+		for _, allocation := range input {
+			categoryIDs = append(categoryIDs, allocation.CategoryID)
+		}
+		if err := ensureLedgerCategories(ctx, tx.Client(), ledgerID, categoryIDs); err != nil {
+			return err
+		}
+
+		for _, allocation := range input {
+			row, err := tx.EnvelopeAllocation.
+				Create().
+				SetLedgerID(ledgerID).
+				SetCategoryID(allocation.CategoryID).
+				SetMonth(allocation.Month).
+				SetAmount(allocation.Amount).
+				SetKind(string(allocation.Kind)).
+				SetNillableTransferID(allocation.TransferID).
+				SetNillableNote(allocation.Note).
+				Save(ctx)
+			if err != nil {
+				return appError.FailedToSave(envelopeAllocationEntity, err)
+			}
+			ids = append(ids, row.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.EnvelopeAllocation.Query().
+		Where(envelopeallocation.IDIn(ids...)).
+		WithCategory().
+		Order(ent.Asc(envelopeallocation.FieldAmount)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(envelopeAllocationEntity, err)
+	}
+
+	response := make([]dto.EnvelopeAllocationResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newEnvelopeAllocationResponse(row))
+	}
+	return response, nil
+}
+
+func applyEnvelopeAllocationFilters(query *ent.EnvelopeAllocationQuery, flt dto.EnvelopeAllocationFilters) *ent.EnvelopeAllocationQuery {
+	if flt.CategoryIDs != nil && len(*flt.CategoryIDs) > 0 {
+		query = query.Where(envelopeallocation.CategoryIDIn(utils.ToUUIDSlice(*flt.CategoryIDs)...))
+	}
+
+	if flt.Kinds != nil && len(*flt.Kinds) > 0 {
+		query = query.Where(envelopeallocation.KindIn(*flt.Kinds...))
+	}
+
+	if flt.Month != nil && *flt.Month != "" {
+		if month, err := dto.ParseBudgetMonth(*flt.Month); err == nil {
+			query = query.Where(envelopeallocation.MonthEQ(month))
+		}
+	}
+
+	return query
+}
+
+func newEnvelopeAllocationResponse(row *ent.EnvelopeAllocation) *dto.EnvelopeAllocationResponse {
+	response := &dto.EnvelopeAllocationResponse{
+		ID:         row.ID,
+		Category:   dto.TransactionCategoryResponse{ID: row.CategoryID},
+		Month:      row.Month.Format(dto.BudgetMonthFormat),
+		Amount:     row.Amount,
+		Kind:       row.Kind,
+		TransferID: row.TransferID,
+		Note:       row.Note,
+		CreatedAt:  utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:  utils.ToDateTimeString(row.UpdatedAt),
+	}
+	if row.Edges.Category != nil {
+		response.Category.Name = row.Edges.Category.Name
+	}
+	return response
+}
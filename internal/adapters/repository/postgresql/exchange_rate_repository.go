@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/exchangerate"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const exchangeRateEntity = "exchange_rates"
+
+// UpsertExchangeRates grava as cotações numa única transação, substituindo a cotação já
+// existente para o mesmo par de moedas e data. Cotações novas não alteram transações já
+// lançadas: a cotação de cada transação é definida quando ela é criada ou tem a data alterada.
+func (p *PostgreSQL) UpsertExchangeRates(ctx context.Context, ledgerID uuid.UUID, input []domain.ExchangeRate) ([]dto.ExchangeRateResponse, error) {
+	response := make([]dto.ExchangeRateResponse, 0, len(input))
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		for _, rate := range input {
+			current, err := tx.ExchangeRate.Query().
+				Where(exchangerate.HasLedgerWith(ledger.IDEQ(ledgerID))).
+				Where(exchangerate.FromCurrencyEQ(rate.FromCurrency)).
+				Where(exchangerate.ToCurrencyEQ(rate.ToCurrency)).
+				Where(exchangerate.RateDateEQ(rate.RateDate)).
+				Only(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return appError.FailedToFind(exchangeRateEntity, err)
+			}
+
+			var row *ent.ExchangeRate
+			if current != nil {
+				row, err = current.Update().SetRate(rate.Rate).Save(ctx)
+			} else {
+				row, err = tx.ExchangeRate.
+					Create().
+					SetLedgerID(ledgerID).
+					SetFromCurrency(rate.FromCurrency).
+					SetToCurrency(rate.ToCurrency).
+					SetRate(rate.Rate).
+					SetRateDate(rate.RateDate).
+					Save(ctx)
+			}
+			if err != nil {
+				return appError.FailedToSave(exchangeRateEntity, err)
+			}
+
+			response = append(response, *newExchangeRateResponse(row))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) DeleteExchangeRateByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.ExchangeRate.DeleteOneID(id).
+		Where(exchangerate.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(exchangeRateEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListExchangeRates(ctx context.Context, ledgerID uuid.UUID, flt dto.ExchangeRateFilters, pgn *pagination.Pagination) ([]dto.ExchangeRateResponse, error) {
+	query := p.Client.ExchangeRate.Query().
+		Where(exchangerate.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyExchangeRateFilters(query, flt)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(exchangerate.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(exchangerate.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.ExchangeRateResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newExchangeRateResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountExchangeRates(ctx context.Context, ledgerID uuid.UUID, flt dto.ExchangeRateFilters) (int, error) {
+	query := p.Client.ExchangeRate.Query().
+		Where(exchangerate.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyExchangeRateFilters(query, flt)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func applyExchangeRateFilters(query *ent.ExchangeRateQuery, flt dto.ExchangeRateFilters) *ent.ExchangeRateQuery {
+	if flt.FromCurrency != nil && *flt.FromCurrency != "" {
+		query = query.Where(exchangerate.FromCurrencyEQ(strings.ToUpper(*flt.FromCurrency)))
+	}
+
+	if flt.ToCurrency != nil && *flt.ToCurrency != "" {
+		query = query.Where(exchangerate.ToCurrencyEQ(strings.ToUpper(*flt.ToCurrency)))
+	}
+
+	if t := utils.ToDateTimeUnsafe(flt.StartDate); t != nil {
+		query = query.Where(exchangerate.RateDateGTE(*t))
+	}
+
+	if t := utils.ToDateTimeUnsafe(flt.EndDate); t != nil {
+		query = query.Where(exchangerate.RateDateLTE(*t))
+	}
+
+	return query
+}
+
+func newExchangeRateResponse(row *ent.ExchangeRate) *dto.ExchangeRateResponse {
+	return &dto.ExchangeRateResponse{
+		ID:           row.ID,
+		FromCurrency: row.FromCurrency,
+		ToCurrency:   row.ToCurrency,
+		Rate:         row.Rate,
+		RateDate:     row.RateDate.Format(time.DateOnly),
+		CreatedAt:    utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:    utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,380 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/goal"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const goalEntity = "goals"
+
+func (p *PostgreSQL) GetGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.GoalResponse, error) {
+	row, err := p.findGoal(ctx, ledgerID, id)
+	if err != nil {
+		return nil, err
+	}
+	return newGoalResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateGoal(ctx context.Context, ledgerID uuid.UUID, input domain.Goal) (*dto.GoalResponse, error) {
+	var id uuid.UUID
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureGoalLinks(ctx, tx, ledgerID, input); err != nil {
+			return err
+		}
+
+		row, err := tx.Goal.
+			Create().
+			SetLedgerID(ledgerID).
+			SetName(input.Name).
+			SetTargetAmount(input.TargetAmount).
+			SetInitialAmount(input.InitialAmount).
+			SetTargetDate(input.TargetDate).
+			SetNillableAccountID(input.AccountID).
+			SetNillableCategoryID(input.CategoryID).
+			SetNillableTagID(input.TagID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(goalEntity, err)
+		}
+		id = row.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetGoalByID(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) UpdateGoal(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Goal) (*dto.GoalResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureGoalLinks(ctx, tx, ledgerID, input); err != nil {
+			return err
+		}
+
+		update := tx.Goal.
+			UpdateOneID(id).
+			Where(goal.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			SetName(input.Name).
+			SetTargetAmount(input.TargetAmount).
+			SetInitialAmount(input.InitialAmount).
+			SetTargetDate(input.TargetDate)
+
+		if input.AccountID != nil {
+			update = update.SetAccountID(*input.AccountID)
+		} else {
+			update = update.ClearAccountID()
+		}
+
+		if input.CategoryID != nil {
+			update = update.SetCategoryID(*input.CategoryID)
+		} else {
+			update = update.ClearCategoryID()
+		}
+
+		if input.TagID != nil {
+			update = update.SetTagID(*input.TagID)
+		} else {
+			update = update.ClearTagID()
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToUpdate(goalEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetGoalByID(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) DeleteGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Goal.DeleteOneID(id).
+		Where(goal.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(goalEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListGoals(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.GoalResponse, error) {
+	query := p.Client.Goal.Query().
+		Where(goal.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory().
+		WithTag()
+
+	query = applyGoalFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(goal.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(goal.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.GoalResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newGoalResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountGoals(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Goal.Query().
+		Where(goal.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyGoalFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (p *PostgreSQL) GoalProgress(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, now time.Time) (*dto.GoalProgressResponse, error) {
+	row, err := p.findGoal(ctx, ledgerID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := p.loadCategoryTree(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.goalProgress(ctx, ledgerID, row, tree, now)
+}
+
+// GoalsProgress mostra o andamento de todas as metas do livro, das mais próximas do prazo
+// para as mais distantes.
+func (p *PostgreSQL) GoalsProgress(ctx context.Context, ledgerID uuid.UUID, now time.Time) ([]dto.GoalProgressResponse, error) {
+	rows, err := p.Client.Goal.Query().
+		Where(goal.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory().
+		WithTag().
+		Order(ent.Asc(goal.FieldTargetDate), ent.Asc(goal.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(goalEntity, err)
+	}
+
+	tree, err := p.loadCategoryTree(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.GoalProgressResponse, 0, len(rows))
+	for _, row := range rows {
+		progress, err := p.goalProgress(ctx, ledgerID, row, tree, now)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, *progress)
+	}
+	return response, nil
+}
+
+// goalProgress soma os aportes da meta até now. Contam as transferências para a conta da meta,
+// as linhas (já convertidas para a moeda base) da categoria da meta ou de suas subcategorias e
+// as transações com a tag da meta, sem contar duas vezes as que atendem a mais de um critério.
+// Transferências para a conta são sempre dinheiro guardado; nos demais critérios despesas são
+// aportes e receitas, resgates. Transações canceladas não contam.
+func (p *PostgreSQL) goalProgress(ctx context.Context, ledgerID uuid.UUID, row *ent.Goal, tree domain.CategoryTree, now time.Time) (*dto.GoalProgressResponse, error) {
+	query := fmt.Sprintf(`
+		SELECT t.category_id,
+			t.id IN (SELECT tt.transaction_id FROM transaction_tags AS tt WHERE tt.tag_id = $2) AS tagged,
+			COALESCE(t.transfer_account_id = $5, false) AS transferred,
+			COALESCE(SUM(CASE WHEN t.transfer_account_id = $5 THEN t.amount
+				WHEN t.record_type = 'income' THEN -t.amount ELSE t.amount END), 0) AS total,
+			COALESCE(SUM(CASE WHEN t.record_date < $3 THEN 0
+				WHEN t.transfer_account_id = $5 THEN t.amount
+				WHEN t.record_type = 'income' THEN -t.amount ELSE t.amount END), 0) AS recent
+		FROM (%s) AS t
+		WHERE t.status <> 'canceled'
+		AND t.record_date <= $4
+		GROUP BY 1, 2, 3
+	`, transactionLinesSQL("$1"))
+
+	tagID := uuid.NullUUID{}
+	if row.TagID != nil {
+		tagID = uuid.NullUUID{UUID: *row.TagID, Valid: true}
+	}
+
+	accountID := uuid.NullUUID{}
+	if row.AccountID != nil {
+		accountID = uuid.NullUUID{UUID: *row.AccountID, Valid: true}
+	}
+
+	result, err := p.db.QueryContext(ctx, query, ledgerID, tagID, now.AddDate(0, -domain.GoalPaceMonths, 0), now, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var contributed, recent domain.Money
+	for result.Next() {
+		var categoryID uuid.NullUUID
+		var tagged, transferred bool
+		var total, recentTotal domain.Money
+		if err := result.Scan(&categoryID, &tagged, &transferred, &total, &recentTotal); err != nil {
+			return nil, err
+		}
+
+		if !transferred && !tagged && !goalCategoryMatches(tree, row.CategoryID, categoryID) {
+			continue
+		}
+		contributed += total
+		recent += recentTotal
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	progress := newGoalDomain(row).Progress(contributed, recent, now)
+
+	return &dto.GoalProgressResponse{
+		Goal:          *newGoalResponse(row),
+		Saved:         progress.Saved,
+		Remaining:     progress.Remaining,
+		Percentage:    progress.Percentage,
+		MonthsLeft:    progress.MonthsLeft,
+		MonthlyNeeded: progress.MonthlyNeeded,
+		MonthlyPace:   progress.MonthlyPace,
+		ProjectedDate: utils.ToNillableDateTimeString(progress.ProjectedDate),
+		Achieved:      progress.Achieved,
+		OnTrack:       progress.OnTrack,
+	}, nil
+}
+
+// goalCategoryMatches indica se a linha é da categoria da meta ou de uma de suas subcategorias.
+func goalCategoryMatches(tree domain.CategoryTree, goalCategoryID *uuid.UUID, categoryID uuid.NullUUID) bool {
+	if goalCategoryID == nil || !categoryID.Valid {
+		return false
+	}
+
+	visited := map[uuid.UUID]bool{}
+	for id := &categoryID.UUID; id != nil && !visited[*id]; id = tree[*id] {
+		if *id == *goalCategoryID {
+			return true
+		}
+		visited[*id] = true
+	}
+	return false
+}
+
+func (p *PostgreSQL) findGoal(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*ent.Goal, error) {
+	row, err := p.Client.Goal.Query().
+		Where(goal.IDEQ(id)).
+		Where(goal.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory().
+		WithTag().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(goalEntity, err)
+	}
+	return row, nil
+}
+
+// ensureGoalLinks garante que a conta, a categoria e a tag da meta pertencem ao livro.
+func ensureGoalLinks(ctx context.Context, tx *ent.Tx, ledgerID uuid.UUID, input domain.Goal) error {
+	if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+		return err
+	}
+
+	if input.CategoryID != nil {
+		if err := ensureLedgerCategories(ctx, tx.Client(), ledgerID, []uuid.UUID{*input.CategoryID}); err != nil {
+			return err
+		}
+	}
+
+	if input.TagID != nil {
+		if err := ensureLedgerTags(ctx, tx.Client(), ledgerID, []uuid.UUID{*input.TagID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyGoalFilters(query *ent.GoalQuery, pgn *pagination.Pagination) *ent.GoalQuery {
+	if pgn.Search != "" {
+		query = query.Where(goal.NameContainsFold(pgn.Search))
+	}
+	return query
+}
+
+func newGoalDomain(row *ent.Goal) domain.Goal {
+	return domain.Goal{
+		ID:            row.ID,
+		Name:          row.Name,
+		TargetAmount:  row.TargetAmount,
+		InitialAmount: row.InitialAmount,
+		TargetDate:    row.TargetDate,
+		AccountID:     row.AccountID,
+		CategoryID:    row.CategoryID,
+		TagID:         row.TagID,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+func newGoalResponse(row *ent.Goal) *dto.GoalResponse {
+	response := &dto.GoalResponse{
+		ID:            row.ID,
+		Name:          row.Name,
+		TargetAmount:  row.TargetAmount,
+		InitialAmount: row.InitialAmount,
+		TargetDate:    utils.ToDateTimeString(row.TargetDate),
+		AccountID:     row.AccountID,
+		CreatedAt:     utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:     utils.ToDateTimeString(row.UpdatedAt),
+	}
+
+	if row.Edges.Category != nil {
+		response.Category = &dto.TransactionCategoryResponse{
+			ID:   row.Edges.Category.ID,
+			Name: row.Edges.Category.Name,
+		}
+	}
+
+	if row.Edges.Tag != nil {
+		response.Tag = &dto.TransactionTagResponse{
+			ID:    row.Edges.Tag.ID,
+			Name:  row.Edges.Tag.Name,
+			Color: row.Edges.Tag.Color,
+		}
+	}
+	return response
+}
@@ -0,0 +1,353 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const holdingEntity = "holdings"
+
+func (p *PostgreSQL) GetHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.HoldingResponse, error) {
+	row, err := p.Client.Holding.Query().
+		Where(holding.IDEQ(id)).
+		Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(holdingEntity, err)
+	}
+	return newHoldingResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateHolding(ctx context.Context, ledgerID uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error) {
+	currency, err := p.currencyOrBase(ctx, ledgerID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *ent.Holding
+	err = p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+			return err
+		}
+
+		row, err = tx.Holding.
+			Create().
+			SetLedgerID(ledgerID).
+			SetName(input.Name).
+			SetTicker(input.Ticker).
+			SetAssetClass(string(input.AssetClass)).
+			SetCurrency(currency).
+			SetNillableAccountID(input.AccountID).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return appError.ErrHoldingConflict
+			}
+			return appError.FailedToSave(holdingEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newHoldingResponse(row), nil
+}
+
+func (p *PostgreSQL) UpdateHolding(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error) {
+	var row *ent.Holding
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+			return err
+		}
+
+		update := tx.Holding.
+			UpdateOneID(id).
+			Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			SetName(input.Name).
+			SetTicker(input.Ticker).
+			SetAssetClass(string(input.AssetClass))
+
+		if input.Currency != "" {
+			update = update.SetCurrency(input.Currency)
+		}
+
+		if input.AccountID != nil {
+			update = update.SetAccountID(*input.AccountID)
+		} else {
+			update = update.ClearAccountID()
+		}
+
+		var err error
+		row, err = update.Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			if ent.IsConstraintError(err) {
+				return appError.ErrHoldingConflict
+			}
+			return appError.FailedToUpdate(holdingEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newHoldingResponse(row), nil
+}
+
+// DeleteHoldingByID remove o investimento com as operações, cotações e proventos. As
+// transações de receita geradas pelos proventos são mantidas.
+func (p *PostgreSQL) DeleteHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Holding.DeleteOneID(id).
+		Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(holdingEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListHoldings(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.HoldingResponse, error) {
+	query := p.Client.Holding.Query().
+		Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyHoldingFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(holding.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(holding.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.HoldingResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newHoldingResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountHoldings(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Holding.Query().
+		Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyHoldingFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// Portfolio apura a posição de cada investimento pelo custo médio e a avalia pela cotação
+// mais recente gravada ou, sem cotações, pelo preço da última operação. Os totais e a
+// alocação por classe são convertidos para a moeda base pela cotação de câmbio do dia.
+func (p *PostgreSQL) Portfolio(ctx context.Context, ledgerID uuid.UUID) (*dto.PortfolioResponse, error) {
+	base, err := p.baseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings, err := p.Client.Holding.Query().
+		Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Order(ent.Asc(holding.FieldTicker)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(holdingEntity, err)
+	}
+
+	trades, err := p.Client.InvestmentTrade.Query().
+		Where(investmenttrade.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Order(ent.Asc(investmenttrade.FieldTradeDate), ent.Asc(investmenttrade.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(investmentTradeEntity, err)
+	}
+
+	incomes, err := p.Client.InvestmentIncome.Query().
+		Where(investmentincome.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(investmentIncomeEntity, err)
+	}
+
+	quotes, err := p.latestInvestmentQuotes(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	tradesByHolding := map[uuid.UUID][]domain.InvestmentTrade{}
+	for _, row := range trades {
+		tradesByHolding[row.HoldingID] = append(tradesByHolding[row.HoldingID], *toDomainInvestmentTrade(row))
+	}
+
+	incomeByHolding := map[uuid.UUID]domain.Money{}
+	for _, row := range incomes {
+		incomeByHolding[row.HoldingID] += row.Amount
+	}
+
+	today := time.Now().UTC()
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+	rates := map[string]float64{}
+	rateOf := func(currency string) (float64, error) {
+		rate, ok := rates[currency]
+		if !ok {
+			var err error
+			rate, err = hooks.ExchangeRateOn(ctx, p.Client, ledgerID, currency, base, today)
+			if err != nil {
+				return 0, err
+			}
+			rates[currency] = rate
+		}
+		return rate, nil
+	}
+
+	response := &dto.PortfolioResponse{
+		Currency:   base,
+		Holdings:   make([]dto.PortfolioHoldingResponse, 0, len(holdings)),
+		Allocation: []dto.PortfolioAllocationResponse{},
+	}
+	allocation := map[string]domain.Money{}
+
+	for _, row := range holdings {
+		holdingTrades := tradesByHolding[row.ID]
+
+		position, err := domain.NewPosition(holdingTrades)
+		if err != nil {
+			return nil, err
+		}
+
+		item := dto.PortfolioHoldingResponse{
+			Holding:      *newHoldingResponse(row),
+			Quantity:     position.Quantity,
+			AverageCost:  position.AverageCost,
+			CostBasis:    position.CostBasis,
+			RealizedGain: position.RealizedGain,
+			Income:       incomeByHolding[row.ID],
+		}
+
+		if quote, ok := quotes[row.ID]; ok {
+			item.Price = quote.Price
+			item.PriceDate = utils.StringPtr(quote.QuoteDate.Format(time.DateOnly))
+		} else if len(holdingTrades) > 0 {
+			last := holdingTrades[len(holdingTrades)-1]
+			item.Price = last.Price
+			item.PriceDate = utils.StringPtr(last.TradeDate.Format(time.DateOnly))
+		}
+
+		item.Value = position.Value(item.Price)
+		item.UnrealizedGain = item.Value - item.CostBasis
+
+		rate, err := rateOf(row.Currency)
+		if err != nil {
+			return nil, err
+		}
+		item.ValueBase = item.Value.Convert(rate)
+
+		response.Value += item.ValueBase
+		response.CostBasis += item.CostBasis.Convert(rate)
+		response.RealizedGain += item.RealizedGain.Convert(rate)
+		response.UnrealizedGain += item.UnrealizedGain.Convert(rate)
+		response.Income += item.Income.Convert(rate)
+		allocation[row.AssetClass] += item.ValueBase
+
+		response.Holdings = append(response.Holdings, item)
+	}
+
+	for class, value := range allocation {
+		if value <= 0 {
+			continue
+		}
+
+		var percentage float64
+		if response.Value > 0 {
+			percentage = math.Round(float64(value)*10000/float64(response.Value)) / 100
+		}
+
+		response.Allocation = append(response.Allocation, dto.PortfolioAllocationResponse{
+			AssetClass: class,
+			Value:      value,
+			Percentage: percentage,
+		})
+	}
+	sort.Slice(response.Allocation, func(i, j int) bool {
+		return response.Allocation[i].Value > response.Allocation[j].Value
+	})
+
+	return response, nil
+}
+
+// ensureHolding garante que o investimento pertence ao livro.
+func ensureHolding(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, id uuid.UUID) (*ent.Holding, error) {
+	row, err := client.Holding.Query().
+		Where(holding.IDEQ(id)).
+		Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(holdingEntity, err)
+	}
+	return row, nil
+}
+
+func newHoldingResponse(row *ent.Holding) *dto.HoldingResponse {
+	return &dto.HoldingResponse{
+		ID:         row.ID,
+		Name:       row.Name,
+		Ticker:     row.Ticker,
+		AssetClass: row.AssetClass,
+		Currency:   row.Currency,
+		AccountID:  row.AccountID,
+		CreatedAt:  utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:  utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func applyHoldingFilters(query *ent.HoldingQuery, pgn *pagination.Pagination) *ent.HoldingQuery {
+	if pgn.Search != "" {
+		query = query.Where(
+			holding.Or(
+				holding.NameContainsFold(pgn.Search),
+				holding.TickerContainsFold(pgn.Search),
+			),
+		)
+	}
+	return query
+}
@@ -2,7 +2,9 @@ package hooks
 
 import (
 	"encoding/json"
+	"frog-go/internal/core/domain"
 	"os"
+	"sort"
 	"strings"
 )
 
@@ -23,15 +25,23 @@ func NewCategorizer(path string) (*Categorizer, error) {
 func (c *Categorizer) Categorize(name string) string {
 	nameLower := strings.ToLower(strings.TrimSpace(name))
 
-	for category, keywords := range c.categories {
-		for _, keyword := range keywords {
+	// Percorre as categorias em ordem alfabética para que palavras-chave repetidas
+	// sempre resultem na mesma categoria
+	names := make([]string, 0, len(c.categories))
+	for category := range c.categories {
+		names = append(names, category)
+	}
+	sort.Strings(names)
+
+	for _, category := range names {
+		for _, keyword := range c.categories[category] {
 			if strings.Contains(nameLower, strings.ToLower(keyword)) {
 				return category
 			}
 		}
 	}
 
-	return "Sem categoria"
+	return domain.DefaultCategoryName
 }
 
 func loadCategoriesFromFile(path string) (map[string][]string, error) {
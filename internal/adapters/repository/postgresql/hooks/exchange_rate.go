@@ -0,0 +1,138 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/exchangerate"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// SetExchangeRateHook grava na transação a cotação da sua moeda para a moeda base do livro
+// na data do lançamento. A cotação é refeita quando a moeda ou a data mudam.
+func SetExchangeRateHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			dm, ok := m.(*ent.TransactionMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			client := dm.Client()
+
+			currency, hasCurrency := dm.Currency()
+			recordDate, hasRecordDate := dm.RecordDate()
+
+			var ledgerID uuid.UUID
+			switch {
+			case dm.Op().Is(ent.OpCreate):
+				id, ok := dm.LedgerID()
+				if !ok {
+					return nil, fmt.Errorf("ledger is required to convert currency")
+				}
+				ledgerID = id
+
+			case dm.Op().Is(ent.OpUpdateOne):
+				if !hasCurrency && !hasRecordDate {
+					return next.Mutate(ctx, m)
+				}
+
+				id, ok := dm.ID()
+				if !ok {
+					return nil, fmt.Errorf("missing transaction ID during update")
+				}
+				old, err := client.Transaction.Query().
+					Where(transaction.ID(id)).
+					WithLedger().
+					Only(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load old transaction: %w", err)
+				}
+				if !hasCurrency {
+					currency = old.Currency
+				}
+				if !hasRecordDate {
+					recordDate = old.RecordDate
+				}
+				if (currency == old.Currency && recordDate.Equal(old.RecordDate)) || old.Edges.Ledger == nil {
+					return next.Mutate(ctx, m)
+				}
+				ledgerID = old.Edges.Ledger.ID
+
+			default:
+				return next.Mutate(ctx, m)
+			}
+
+			base, err := LedgerBaseCurrency(ctx, client, ledgerID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load base currency: %w", err)
+			}
+
+			rate, err := ExchangeRateOn(ctx, client, ledgerID, currency, base, recordDate)
+			if err != nil {
+				return nil, err
+			}
+			dm.SetExchangeRate(rate)
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// LedgerBaseCurrency retorna a moeda base do livro, que é a moeda base do seu dono.
+func LedgerBaseCurrency(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) (string, error) {
+	return client.Ledger.Query().
+		Where(ledger.IDEQ(ledgerID)).
+		QueryOwner().
+		Select(user.FieldBaseCurrency).
+		String(ctx)
+}
+
+// ExchangeRateOn busca a cotação mais recente até a data informada. Sem a cotação direta,
+// usa o inverso da cotação da moeda base para a moeda da transação.
+func ExchangeRateOn(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, from, to string, date time.Time) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	rate, err := latestRate(ctx, client, ledgerID, from, to, date)
+	if err != nil {
+		return 0, err
+	}
+	if rate != nil {
+		return *rate, nil
+	}
+
+	inverse, err := latestRate(ctx, client, ledgerID, to, from, date)
+	if err != nil {
+		return 0, err
+	}
+	if inverse == nil {
+		return 0, fmt.Errorf("%w: %s to %s", appError.ErrExchangeRateNotFound, from, to)
+	}
+	return 1 / *inverse, nil
+}
+
+func latestRate(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, from, to string, date time.Time) (*float64, error) {
+	row, err := client.ExchangeRate.Query().
+		Where(exchangerate.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(exchangerate.FromCurrencyEQ(from)).
+		Where(exchangerate.ToCurrencyEQ(to)).
+		Where(exchangerate.RateDateLTE(date)).
+		Order(ent.Desc(exchangerate.FieldRateDate)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load exchange rate: %w", err)
+	}
+	return &row.Rate, nil
+}
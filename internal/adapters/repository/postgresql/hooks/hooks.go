@@ -4,11 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
 	"frog-go/internal/ent"
 	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/transaction"
+
+	"github.com/google/uuid"
 )
 
-func SetCategoryFromTitleHook(client *ent.Client, categorizer *Categorizer) ent.Hook {
+// SetCategoryFromTitleHook aplica as regras do livro, em ordem de prioridade, na criação
+// de transações. A primeira regra atendida aplica suas ações; se nenhuma definir a categoria,
+// usa o classificador treinado com o histórico do livro e, por fim, as palavras-chave.
+func SetCategoryFromTitleHook(client *ent.Client, categorizer *Categorizer, learner *Learner) ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			dm, ok := m.(*ent.TransactionMutation)
@@ -20,33 +30,93 @@ func SetCategoryFromTitleHook(client *ent.Client, categorizer *Categorizer) ent.
 				return next.Mutate(ctx, m)
 			}
 
-			if _, ok := dm.CategoryID(); ok {
-				return next.Mutate(ctx, m)
+			ledgerID, ok := dm.LedgerID()
+			if !ok {
+				return nil, fmt.Errorf("ledger is required to categorize transaction")
 			}
 
-			title, exists := dm.Title()
-			if !exists {
+			if _, ok := dm.Title(); !ok {
 				return nil, fmt.Errorf("title is required to categorize transaction")
 			}
 
-			categoryName := categorizer.Categorize(title)
+			if _, ok := dm.CategoryID(); ok {
+				dm.SetCategorySource(string(domain.CategorySourceManual))
+			}
 
-			data, err := client.Category.
-				Query().
-				Where(category.NameEQ(categoryName)).
-				Only(ctx)
+			rules, err := loadLedgerRules(ctx, client, ledgerID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := applyRules(dm, rules); err != nil {
+				return nil, err
+			}
+
+			if _, ok := dm.CategoryID(); ok {
+				return next.Mutate(ctx, dm)
+			}
+
+			suggestion, err := fallbackCategory(ctx, client, categorizer, learner, ledgerID, transactionFromMutation(dm))
 			if err != nil {
-				return nil, fmt.Errorf("failed to find category '%s': %w", categoryName, err)
+				return nil, err
 			}
 
-			dm.SetCategoryID(data.ID)
+			if suggestion != nil {
+				dm.SetCategoryID(suggestion.CategoryID)
+				dm.SetCategorySource(string(suggestion.Source))
+			}
 
 			return next.Mutate(ctx, dm)
 		})
 	}
 }
 
-func UpdateInvoiceAmountHook(client *ent.Client) ent.Hook {
+// LearnFromCorrectionsHook marca como manual a categoria escolhida pelo usuário e descarta o
+// modelo treinado do livro, para que a próxima categorização já considere a correção.
+func LearnFromCorrectionsHook(learner *Learner) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			dm, ok := m.(*ent.TransactionMutation)
+			if !ok || !dm.Op().Is(ent.OpUpdateOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			categoryID, hasCategory := dm.CategoryID()
+			id, hasID := dm.ID()
+			if !hasCategory || !hasID {
+				return next.Mutate(ctx, m)
+			}
+
+			old, err := dm.Client().Transaction.Query().
+				Where(transaction.ID(id)).
+				WithLedger().
+				WithCategory().
+				Only(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load old transaction: %w", err)
+			}
+
+			if old.Edges.Category != nil && old.Edges.Category.ID == categoryID {
+				return next.Mutate(ctx, m)
+			}
+
+			dm.SetCategorySource(string(domain.CategorySourceManual))
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil || old.Edges.Ledger == nil {
+				return value, err
+			}
+
+			if err := learner.Invalidate(ctx, dm.Client(), old.Edges.Ledger.ID); err != nil {
+				return nil, err
+			}
+			return value, nil
+		})
+	}
+}
+
+// ValidateCategoryOwnerHook impede que uma transação aponte para a categoria de outro livro.
+func ValidateCategoryOwnerHook() ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			dm, ok := m.(*ent.TransactionMutation)
@@ -54,49 +124,272 @@ func UpdateInvoiceAmountHook(client *ent.Client) ent.Hook {
 				return next.Mutate(ctx, m)
 			}
 
-			invoiceID, hasInvoice := dm.InvoiceID()
-			if !hasInvoice {
+			categoryID, hasCategory := dm.CategoryID()
+			if !hasCategory {
 				return next.Mutate(ctx, m)
 			}
 
-			newAmount, hasNewAmount := dm.Amount()
-			if !hasNewAmount {
+			client := dm.Client()
+			query := client.Category.Query().Where(category.IDEQ(categoryID))
+
+			switch {
+			case dm.Op().Is(ent.OpCreate):
+				ledgerID, ok := dm.LedgerID()
+				if !ok {
+					return nil, fmt.Errorf("missing ledger ID during create")
+				}
+				query = query.Where(category.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+			case dm.Op().Is(ent.OpUpdateOne):
+				id, ok := dm.ID()
+				if !ok {
+					return nil, fmt.Errorf("missing transaction ID during update")
+				}
+				query = query.Where(category.HasLedgerWith(ledger.HasTransactionsWith(transaction.ID(id))))
+
+			default:
 				return next.Mutate(ctx, m)
 			}
 
-			var delta float64
+			exists, err := query.Exist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load category: %w", err)
+			}
+			if !exists {
+				return nil, appError.ErrCategoryNotFound
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// ValidateInvoiceOpenHook impede que transações sejam lançadas em faturas fechadas
+// e que o valor de uma fatura fechada seja alterado por suas transações, inclusive ao tirá-las
+// da fatura ou apagá-las.
+func ValidateInvoiceOpenHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			dm, ok := m.(*ent.TransactionMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// Usa o client da mutação para enxergar (e participar de) uma transação em andamento
+			client := dm.Client()
+
+			invoiceID, hasInvoice := dm.InvoiceID()
 
 			switch {
 			case dm.Op().Is(ent.OpCreate):
-				delta = newAmount
+				if !hasInvoice {
+					return next.Mutate(ctx, m)
+				}
+				ledgerID, ok := dm.LedgerID()
+				if !ok {
+					return nil, fmt.Errorf("ledger is required to validate the invoice")
+				}
+				if err := ensureInvoiceIsOpen(ctx, client, ledgerID, invoiceID); err != nil {
+					return nil, err
+				}
 
-			case dm.Op().Is(ent.OpUpdateOne) || dm.Op().Is(ent.OpUpdate):
+			case dm.Op().Is(ent.OpDeleteOne):
+				id, ok := dm.ID()
+				if !ok {
+					return nil, fmt.Errorf("missing transaction ID during delete")
+				}
+
+				oldInvoice, err := client.Transaction.Query().
+					Where(transaction.ID(id)).
+					QueryInvoice().
+					Only(ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to load old invoice: %w", err)
+				}
+				if oldInvoice != nil && !domain.InvoiceStatus(oldInvoice.Status).AcceptsTransactions() {
+					return nil, appError.ErrInvoiceNotOpen
+				}
+
+			case dm.Op().Is(ent.OpUpdateOne):
 				id, ok := dm.ID()
 				if !ok {
 					return nil, fmt.Errorf("missing transaction ID during update")
 				}
-				oldTransaction, err := client.Transaction.Get(ctx, id)
-				if err != nil {
-					return nil, fmt.Errorf("failed to load old transaction: %w", err)
+
+				oldInvoice, err := client.Transaction.Query().
+					Where(transaction.ID(id)).
+					QueryInvoice().
+					Only(ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to load old invoice: %w", err)
+				}
+
+				invoiceChanged := dm.InvoiceCleared() || (hasInvoice && (oldInvoice == nil || oldInvoice.ID != invoiceID))
+
+				if oldInvoice != nil && !domain.InvoiceStatus(oldInvoice.Status).AcceptsTransactions() {
+					oldAmount, err := dm.OldAmount(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed to load old amount: %w", err)
+					}
+					oldRate, err := dm.OldExchangeRate(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed to load old exchange rate: %w", err)
+					}
+					newAmount, hasNewAmount := dm.Amount()
+					if !hasNewAmount {
+						newAmount = oldAmount
+					}
+					newRate, hasNewRate := dm.ExchangeRate()
+					if !hasNewRate {
+						newRate = oldRate
+					}
+					// O valor da fatura é a soma dos valores convertidos para a moeda base
+					if invoiceChanged || newAmount.Convert(newRate) != oldAmount.Convert(oldRate) {
+						return nil, appError.ErrInvoiceNotOpen
+					}
+				}
+
+				if invoiceChanged && hasInvoice {
+					ledgerID, err := client.Transaction.Query().
+						Where(transaction.ID(id)).
+						QueryLedger().
+						OnlyID(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed to load transaction ledger: %w", err)
+					}
+					if err := ensureInvoiceIsOpen(ctx, client, ledgerID, invoiceID); err != nil {
+						return nil, err
+					}
 				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// ensureInvoiceIsOpen confere se a fatura é do livro da transação e ainda aceita lançamentos.
+func ensureInvoiceIsOpen(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, invoiceID uuid.UUID) error {
+	data, err := client.Invoice.Query().
+		Where(invoice.IDEQ(invoiceID)).
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.InvalidParam("invoice_id", appError.ErrInvoiceNotFound)
+		}
+		return fmt.Errorf("failed to load invoice: %w", err)
+	}
+
+	if !domain.InvoiceStatus(data.Status).AcceptsTransactions() {
+		return appError.ErrInvoiceNotOpen
+	}
+	return nil
+}
+
+// UpdateInvoiceAmountHook mantém o valor de cada fatura igual à soma das suas transações,
+// convertidas para a moeda base. A criação soma na fatura; a edição tira o valor antigo da
+// fatura antiga e soma o valor novo na fatura nova (que pode ser a mesma); tirar a transação da
+// fatura ou apagá-la subtrai o valor antigo.
+func UpdateInvoiceAmountHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			dm, ok := m.(*ent.TransactionMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			client := dm.Client()
 
-				// Se o valor não mudou, ignora
-				if oldTransaction.Amount == newAmount {
+			invoiceID, hasInvoice := dm.InvoiceID()
+			newAmount, hasNewAmount := dm.Amount()
+			newRate, hasNewRate := dm.ExchangeRate()
+
+			if dm.Op().Is(ent.OpCreate) {
+				if !hasInvoice || !hasNewAmount {
 					return next.Mutate(ctx, m)
 				}
+				// Sem cotação na mutação, o valor está na moeda base
+				if !hasNewRate {
+					newRate = 1
+				}
+				if err := addInvoiceAmount(ctx, client, invoiceID, newAmount.Convert(newRate)); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			}
+
+			deleting := dm.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+			if !deleting && !hasInvoice && !dm.InvoiceCleared() && !hasNewAmount && !hasNewRate {
+				return next.Mutate(ctx, m)
+			}
 
-				delta = newAmount - oldTransaction.Amount
+			ids, err := dm.IDs(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load transaction IDs: %w", err)
 			}
 
-			err := client.Invoice.
-				UpdateOneID(invoiceID).
-				AddAmount(delta).
-				Exec(ctx)
+			olds, err := client.Transaction.Query().
+				Where(transaction.IDIn(ids...)).
+				WithInvoice().
+				All(ctx)
 			if err != nil {
-				return nil, fmt.Errorf("failed to update invoice amount: %w", err)
+				return nil, fmt.Errorf("failed to load old transactions: %w", err)
+			}
+
+			for _, old := range olds {
+				var oldInvoiceID *uuid.UUID
+				if old.Edges.Invoice != nil {
+					oldInvoiceID = &old.Edges.Invoice.ID
+				}
+
+				newInvoiceID := oldInvoiceID
+				switch {
+				case deleting || dm.InvoiceCleared():
+					newInvoiceID = nil
+				case hasInvoice:
+					newInvoiceID = &invoiceID
+				}
+
+				amount, rate := old.Amount, old.ExchangeRate
+				if hasNewAmount {
+					amount = newAmount
+				}
+				if hasNewRate {
+					rate = newRate
+				}
+				oldValue := old.Amount.Convert(old.ExchangeRate)
+				newValue := amount.Convert(rate)
+
+				if oldInvoiceID != nil && newInvoiceID != nil && *oldInvoiceID == *newInvoiceID {
+					if newValue != oldValue {
+						if err := addInvoiceAmount(ctx, client, *oldInvoiceID, newValue-oldValue); err != nil {
+							return nil, err
+						}
+					}
+					continue
+				}
+
+				if oldInvoiceID != nil {
+					if err := addInvoiceAmount(ctx, client, *oldInvoiceID, -oldValue); err != nil {
+						return nil, err
+					}
+				}
+				if newInvoiceID != nil {
+					if err := addInvoiceAmount(ctx, client, *newInvoiceID, newValue); err != nil {
+						return nil, err
+					}
+				}
 			}
 
 			return next.Mutate(ctx, m)
 		})
 	}
 }
+
+func addInvoiceAmount(ctx context.Context, client *ent.Client, invoiceID uuid.UUID, delta domain.Money) error {
+	if err := client.Invoice.UpdateOneID(invoiceID).AddAmount(delta).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update invoice amount: %w", err)
+	}
+	return nil
+}
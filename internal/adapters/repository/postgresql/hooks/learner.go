@@ -0,0 +1,147 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/transaction"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// learnerMinConfidence é a probabilidade mínima para a sugestão do classificador ser aplicada.
+	learnerMinConfidence = 0.6
+	// learnerMinSamples evita sugestões em livros com pouco histórico categorizado.
+	learnerMinSamples = 10
+	// learnerHistoryLimit limita quantas transações recentes entram no treino.
+	learnerHistoryLimit = 5000
+	// learnerModelTTL força o retreino periódico com as categorizações mais recentes.
+	learnerModelTTL = 15 * time.Minute
+)
+
+type learnedModel struct {
+	classifier *domain.CategoryClassifier
+	version    int64
+	trainedAt  time.Time
+}
+
+// Learner mantém um classificador por livro, treinado sob demanda com o histórico
+// de transações categorizadas. O cache é local ao processo; a versão do modelo gravada
+// no livro faz com que uma correção feita em outra instância também force o retreino.
+type Learner struct {
+	mu     sync.Mutex
+	models map[uuid.UUID]*learnedModel
+}
+
+func NewLearner() *Learner {
+	return &Learner{models: map[uuid.UUID]*learnedModel{}}
+}
+
+// Predict retorna a categoria sugerida quando a confiança atinge o mínimo exigido.
+func (l *Learner) Predict(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, title string, amount domain.Money) (*domain.CategoryPrediction, error) {
+	predictions, err := l.Rank(ctx, client, ledgerID, title, amount)
+	if err != nil || len(predictions) == 0 {
+		return nil, err
+	}
+
+	if predictions[0].Confidence < learnerMinConfidence {
+		return nil, nil
+	}
+	return &predictions[0], nil
+}
+
+// Rank retorna todas as categorias conhecidas ordenadas pela probabilidade.
+func (l *Learner) Rank(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, title string, amount domain.Money) ([]domain.CategoryPrediction, error) {
+	classifier, err := l.model(ctx, client, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if classifier.Samples() < learnerMinSamples {
+		return nil, nil
+	}
+	return classifier.Predict(title, amount), nil
+}
+
+// Invalidate incrementa a versão do modelo do livro, para que o próximo uso treine
+// novamente em qualquer processo, e descarta o modelo em cache deste processo.
+func (l *Learner) Invalidate(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) error {
+	err := client.Ledger.UpdateOneID(ledgerID).AddCategoryModelVersion(1).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bump category model version: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.models, ledgerID)
+	return nil
+}
+
+func (l *Learner) model(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) (*domain.CategoryClassifier, error) {
+	version, err := client.Ledger.Query().
+		Where(ledger.IDEQ(ledgerID)).
+		Select(ledger.FieldCategoryModelVersion).
+		Int(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load category model version: %w", err)
+	}
+
+	l.mu.Lock()
+	cached, ok := l.models[ledgerID]
+	l.mu.Unlock()
+
+	if ok && cached.version == int64(version) && time.Since(cached.trainedAt) < learnerModelTTL {
+		return cached.classifier, nil
+	}
+
+	classifier, err := trainClassifier(ctx, client, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	l.models[ledgerID] = &learnedModel{classifier: classifier, version: int64(version), trainedAt: time.Now()}
+	l.mu.Unlock()
+
+	return classifier, nil
+}
+
+// trainClassifier treina o classificador com o histórico recente do livro. Transações na
+// categoria padrão ficam de fora: ela só indica que nada se aplicou, e aprendê-la faria o
+// classificador sugerir "Sem categoria" para títulos parecidos. As de peso zero (ver
+// TrainingWeight) também são descartadas na consulta, para não ocuparem o limite do histórico.
+func trainClassifier(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) (*domain.CategoryClassifier, error) {
+	rows, err := client.Transaction.Query().
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(transaction.HasCategoryWith(category.NameNEQ(domain.DefaultCategoryName))).
+		Where(transaction.Or(
+			transaction.CategorySourceIsNil(),
+			transaction.CategorySourceNotIn(string(domain.CategorySourceLearned), string(domain.CategorySourceKeyword)),
+		)).
+		Order(ent.Desc(transaction.FieldRecordDate)).
+		Limit(learnerHistoryLimit).
+		WithCategory().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load categorized history: %w", err)
+	}
+
+	classifier := domain.NewCategoryClassifier()
+	for _, row := range rows {
+		if row.Edges.Category == nil {
+			continue
+		}
+
+		weight := (*domain.CategorySource)(row.CategorySource).TrainingWeight()
+		classifier.Train(row.Title, row.Amount, row.Edges.Category.ID, weight)
+	}
+	return classifier, nil
+}
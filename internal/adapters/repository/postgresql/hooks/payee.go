@@ -0,0 +1,136 @@
+package hooks
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+)
+
+// SetPayeeFromTitleHook vincula a transação ao favorecido obtido pela normalização do título,
+// criando o favorecido na primeira ocorrência. Quando o título muda, o vínculo é refeito.
+func SetPayeeFromTitleHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			dm, ok := m.(*ent.TransactionMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			title, hasTitle := dm.Title()
+			if _, hasPayee := dm.PayeeID(); hasPayee || dm.PayeeCleared() || !hasTitle {
+				return next.Mutate(ctx, m)
+			}
+
+			client := dm.Client()
+
+			var ledgerID uuid.UUID
+			switch {
+			case dm.Op().Is(ent.OpCreate):
+				id, ok := dm.LedgerID()
+				if !ok {
+					return nil, fmt.Errorf("ledger is required to link payee")
+				}
+				ledgerID = id
+
+			case dm.Op().Is(ent.OpUpdateOne):
+				id, ok := dm.ID()
+				if !ok {
+					return nil, fmt.Errorf("missing transaction ID during update")
+				}
+				old, err := client.Transaction.Query().
+					Where(transaction.ID(id)).
+					WithLedger().
+					Only(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load old transaction: %w", err)
+				}
+				if old.Title == title || old.Edges.Ledger == nil {
+					return next.Mutate(ctx, m)
+				}
+				ledgerID = old.Edges.Ledger.ID
+
+			default:
+				return next.Mutate(ctx, m)
+			}
+
+			payeeID, err := ResolvePayee(ctx, client, ledgerID, title)
+			if err != nil {
+				return nil, err
+			}
+			if payeeID != nil {
+				dm.SetPayeeID(*payeeID)
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// ResolvePayee encontra o favorecido do livro pelo nome normalizado ou por um de seus
+// apelidos e o cria quando ainda não existe. Títulos sem letras ou dígitos não têm favorecido.
+// Duas transações simultâneas com o mesmo título podem tentar criar o favorecido ao mesmo tempo:
+// a inserção ignora o conflito no índice único, sem abortar a transação do banco, e o favorecido
+// gravado pela outra é buscado de novo.
+func ResolvePayee(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, title string) (*uuid.UUID, error) {
+	normalized := domain.NormalizePayee(title)
+	if normalized == "" {
+		return nil, nil
+	}
+
+	id, err := findPayee(ctx, client, ledgerID, normalized)
+	if err != nil || id != nil {
+		return id, err
+	}
+
+	created, err := client.Payee.Create().
+		SetLedgerID(ledgerID).
+		SetName(domain.PayeeDisplayName(normalized)).
+		SetNormalizedName(normalized).
+		OnConflict(sql.ConflictColumns(payee.FieldNormalizedName, payee.LedgerColumn)).
+		DoNothing().
+		ID(ctx)
+	if err == nil {
+		return &created, nil
+	}
+	if !errors.Is(err, stdsql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to create payee '%s': %w", normalized, err)
+	}
+
+	id, err = findPayee(ctx, client, ledgerID, normalized)
+	if err == nil && id == nil {
+		err = fmt.Errorf("failed to create payee '%s': conflicting payee not found", normalized)
+	}
+	return id, err
+}
+
+// findPayee busca o favorecido do livro pelo nome normalizado ou por um de seus apelidos.
+func findPayee(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, normalized string) (*uuid.UUID, error) {
+	row, err := client.Payee.Query().
+		Where(payee.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(payee.Or(
+			payee.NormalizedNameEQ(normalized),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(payee.FieldAliases, normalized))
+			},
+		)).
+		Order(ent.Asc(payee.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payee '%s': %w", normalized, err)
+	}
+	return &row.ID, nil
+}
@@ -0,0 +1,94 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/rule"
+
+	"github.com/google/uuid"
+)
+
+// loadLedgerRules busca as regras ativas do livro na ordem em que devem ser avaliadas.
+func loadLedgerRules(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) ([]domain.Rule, error) {
+	rows, err := client.Rule.Query().
+		Where(rule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(rule.EnabledEQ(true)).
+		Order(ent.Asc(rule.FieldPriority), ent.Asc(rule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rules: %w", err)
+	}
+
+	rules := make([]domain.Rule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, domain.Rule{
+			ID:       row.ID,
+			Name:     row.Name,
+			Priority: row.Priority,
+			Enabled:  row.Enabled,
+			Conditions: domain.RuleConditions{
+				TitleContains: row.TitleContains,
+				TitleRegex:    row.TitleRegex,
+				AmountMin:     row.AmountMin,
+				AmountMax:     row.AmountMax,
+				RecordType:    (*domain.RecordType)(row.RecordType),
+				InvoiceID:     row.InvoiceID,
+			},
+			Actions: domain.RuleActions{
+				CategoryID: row.CategoryID,
+				RecordType: (*domain.RecordType)(row.ActionRecordType),
+				Status:     (*domain.TxnStatus)(row.ActionStatus),
+				Skip:       row.Skip,
+			},
+		})
+	}
+	return rules, nil
+}
+
+// applyRules aplica na mutação as ações da primeira regra atendida. A categoria
+// informada explicitamente na transação nunca é sobrescrita.
+func applyRules(dm *ent.TransactionMutation, rules []domain.Rule) error {
+	input := transactionFromMutation(dm)
+
+	for _, r := range rules {
+		if !r.Matches(input) {
+			continue
+		}
+
+		if r.Actions.Skip {
+			return appError.ErrTransactionSkipped
+		}
+		if r.Actions.RecordType != nil {
+			dm.SetRecordType(string(*r.Actions.RecordType))
+		}
+		if r.Actions.Status != nil {
+			dm.SetStatus(string(*r.Actions.Status))
+		}
+		if _, ok := dm.CategoryID(); !ok && r.Actions.CategoryID != nil {
+			dm.SetCategoryID(*r.Actions.CategoryID)
+			dm.SetCategorySource(string(domain.CategorySourceRule))
+		}
+		return nil
+	}
+	return nil
+}
+
+func transactionFromMutation(dm *ent.TransactionMutation) domain.Transaction {
+	var input domain.Transaction
+
+	input.Title, _ = dm.Title()
+	input.Amount, _ = dm.Amount()
+
+	if recordType, ok := dm.RecordType(); ok {
+		input.RecordType = domain.RecordType(recordType)
+	}
+	if invoiceID, ok := dm.InvoiceID(); ok {
+		input.InvoiceID = &invoiceID
+	}
+	return input
+}
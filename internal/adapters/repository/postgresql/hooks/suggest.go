@@ -0,0 +1,88 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+
+	"github.com/google/uuid"
+)
+
+// CategorySuggestion é a categoria escolhida para um título e a etapa que a definiu.
+type CategorySuggestion struct {
+	CategoryID uuid.UUID
+	Source     domain.CategorySource
+	Confidence float64
+}
+
+// SuggestCategory percorre as mesmas etapas usadas na criação de transações:
+// regras do livro, classificador treinado e, por fim, palavras-chave.
+func SuggestCategory(
+	ctx context.Context,
+	client *ent.Client,
+	categorizer *Categorizer,
+	learner *Learner,
+	ledgerID uuid.UUID,
+	input domain.Transaction,
+) (*CategorySuggestion, error) {
+	rules, err := loadLedgerRules(ctx, client, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rules {
+		if !r.Matches(input) {
+			continue
+		}
+		if r.Actions.CategoryID != nil {
+			return &CategorySuggestion{CategoryID: *r.Actions.CategoryID, Source: domain.CategorySourceRule, Confidence: 1}, nil
+		}
+		break
+	}
+
+	return fallbackCategory(ctx, client, categorizer, learner, ledgerID, input)
+}
+
+// fallbackCategory sugere a categoria quando nenhuma regra definiu uma: primeiro o
+// classificador treinado com o histórico do livro, depois as palavras-chave.
+func fallbackCategory(
+	ctx context.Context,
+	client *ent.Client,
+	categorizer *Categorizer,
+	learner *Learner,
+	ledgerID uuid.UUID,
+	input domain.Transaction,
+) (*CategorySuggestion, error) {
+	prediction, err := learner.Predict(ctx, client, ledgerID, input.Title, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if prediction != nil {
+		return &CategorySuggestion{
+			CategoryID: prediction.CategoryID,
+			Source:     domain.CategorySourceLearned,
+			Confidence: prediction.Confidence,
+		}, nil
+	}
+
+	categoryName := categorizer.Categorize(input.Title)
+
+	// As categorias pertencem ao livro; se a sugerida foi removida, não há sugestão
+	data, err := client.Category.
+		Query().
+		Where(category.NameEQ(categoryName)).
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find category '%s': %w", categoryName, err)
+	}
+
+	return &CategorySuggestion{CategoryID: data.ID, Source: domain.CategorySourceKeyword}, nil
+}
@@ -0,0 +1,135 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const investmentIncomeEntity = "investment_incomes"
+
+// CreateInvestmentIncome grava o provento e, na mesma transação, lança a receita paga
+// correspondente na moeda do investimento.
+func (p *PostgreSQL) CreateInvestmentIncome(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentIncome) (*dto.InvestmentIncomeResponse, error) {
+	if input.CategoryID != nil {
+		if err := ensureLedgerCategories(ctx, p.Client, ledgerID, []uuid.UUID{*input.CategoryID}); err != nil {
+			return nil, appError.InvalidParam("category_id", err)
+		}
+	}
+
+	var row *ent.InvestmentIncome
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		item, err := ensureHolding(ctx, client, ledgerID, input.HoldingID)
+		if err != nil {
+			return err
+		}
+
+		created, err := client.Transaction.
+			Create().
+			SetLedgerID(ledgerID).
+			SetTitle(input.Kind.Label() + " " + item.Ticker).
+			SetAmount(input.Amount).
+			SetCurrency(item.Currency).
+			SetRecordType(string(domain.TypeIncome)).
+			SetStatus(string(domain.StatusPaid)).
+			SetRecordDate(input.PaymentDate).
+			SetNillableCategoryID(input.CategoryID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(transactionEntity, err)
+		}
+
+		row, err = client.InvestmentIncome.
+			Create().
+			SetLedgerID(ledgerID).
+			SetHoldingID(input.HoldingID).
+			SetKind(string(input.Kind)).
+			SetPaymentDate(input.PaymentDate).
+			SetAmount(input.Amount).
+			SetNillableNote(input.Note).
+			SetTransactionID(created.ID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(investmentIncomeEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newInvestmentIncomeResponse(row), nil
+}
+
+func (p *PostgreSQL) ListInvestmentIncomes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentIncomeResponse, error) {
+	if _, err := ensureHolding(ctx, p.Client, ledgerID, holdingID); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.InvestmentIncome.Query().
+		Where(investmentincome.HoldingIDEQ(holdingID)).
+		Order(ent.Desc(investmentincome.FieldPaymentDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(investmentIncomeEntity, err)
+	}
+
+	response := make([]dto.InvestmentIncomeResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newInvestmentIncomeResponse(row))
+	}
+	return response, nil
+}
+
+// DeleteInvestmentIncomeByID remove o provento junto com a transação de receita gerada por ele.
+func (p *PostgreSQL) DeleteInvestmentIncomeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.InvestmentIncome.Query().
+			Where(investmentincome.IDEQ(id)).
+			Where(investmentincome.HoldingIDEQ(holdingID)).
+			Where(investmentincome.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToFind(investmentIncomeEntity, err)
+		}
+
+		if err := tx.InvestmentIncome.DeleteOne(row).Exec(ctx); err != nil {
+			return appError.FailedToDelete(investmentIncomeEntity, err)
+		}
+
+		if row.TransactionID != nil {
+			err := tx.Transaction.DeleteOneID(*row.TransactionID).Exec(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return appError.FailedToDelete(transactionEntity, err)
+			}
+		}
+		return nil
+	})
+}
+
+func newInvestmentIncomeResponse(row *ent.InvestmentIncome) *dto.InvestmentIncomeResponse {
+	return &dto.InvestmentIncomeResponse{
+		ID:            row.ID,
+		HoldingID:     row.HoldingID,
+		Kind:          row.Kind,
+		PaymentDate:   row.PaymentDate.Format(time.DateOnly),
+		Amount:        row.Amount,
+		Note:          row.Note,
+		TransactionID: row.TransactionID,
+		CreatedAt:     utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:     utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,168 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const investmentQuoteEntity = "investment_quotes"
+
+// UpsertInvestmentQuotes grava as cotações numa única transação, substituindo a cotação já
+// existente para o mesmo investimento e data. Cotações sem HoldingID são resolvidas pelo
+// ticker; um ticker desconhecido resulta em ErrHoldingNotFound.
+func (p *PostgreSQL) UpsertInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, input []domain.InvestmentQuote) ([]dto.InvestmentQuoteResponse, error) {
+	response := make([]dto.InvestmentQuoteResponse, 0, len(input))
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+		holdings := map[string]uuid.UUID{}
+
+		for _, quote := range input {
+			var holdingID uuid.UUID
+			if quote.HoldingID != nil {
+				if _, err := ensureHolding(ctx, client, ledgerID, *quote.HoldingID); err != nil {
+					return err
+				}
+				holdingID = *quote.HoldingID
+			} else {
+				id, ok := holdings[quote.Ticker]
+				if !ok {
+					row, err := client.Holding.Query().
+						Where(holding.HasLedgerWith(ledger.IDEQ(ledgerID))).
+						Where(holding.TickerEQ(quote.Ticker)).
+						Only(ctx)
+					if err != nil {
+						if ent.IsNotFound(err) {
+							return fmt.Errorf("%w: %s", appError.ErrHoldingNotFound, quote.Ticker)
+						}
+						return appError.FailedToFind(holdingEntity, err)
+					}
+					id = row.ID
+					holdings[quote.Ticker] = id
+				}
+				holdingID = id
+			}
+
+			current, err := client.InvestmentQuote.Query().
+				Where(investmentquote.HoldingIDEQ(holdingID)).
+				Where(investmentquote.QuoteDateEQ(quote.QuoteDate)).
+				Only(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return appError.FailedToFind(investmentQuoteEntity, err)
+			}
+
+			var row *ent.InvestmentQuote
+			if current != nil {
+				row, err = current.Update().SetPrice(quote.Price).Save(ctx)
+			} else {
+				row, err = client.InvestmentQuote.
+					Create().
+					SetLedgerID(ledgerID).
+					SetHoldingID(holdingID).
+					SetQuoteDate(quote.QuoteDate).
+					SetPrice(quote.Price).
+					Save(ctx)
+			}
+			if err != nil {
+				return appError.FailedToSave(investmentQuoteEntity, err)
+			}
+
+			response = append(response, *newInvestmentQuoteResponse(row))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) ListInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentQuoteResponse, error) {
+	if _, err := ensureHolding(ctx, p.Client, ledgerID, holdingID); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.InvestmentQuote.Query().
+		Where(investmentquote.HoldingIDEQ(holdingID)).
+		Order(ent.Desc(investmentquote.FieldQuoteDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(investmentQuoteEntity, err)
+	}
+
+	response := make([]dto.InvestmentQuoteResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newInvestmentQuoteResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) DeleteInvestmentQuoteByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error {
+	deleted, err := p.Client.InvestmentQuote.Delete().
+		Where(investmentquote.IDEQ(id)).
+		Where(investmentquote.HoldingIDEQ(holdingID)).
+		Where(investmentquote.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		return appError.FailedToDelete(investmentQuoteEntity, err)
+	}
+	if deleted == 0 {
+		return appError.ErrNotFound
+	}
+	return nil
+}
+
+// latestInvestmentQuotes retorna a cotação mais recente de cada investimento do livro.
+func (p *PostgreSQL) latestInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID) (map[uuid.UUID]domain.InvestmentQuote, error) {
+	query := `
+		SELECT DISTINCT ON (q.holding_id) q.holding_id, q.quote_date, q.price
+		FROM investment_quotes q
+		WHERE q.ledger_id = $1
+		ORDER BY q.holding_id, q.quote_date DESC
+	`
+
+	rows, err := p.db.QueryContext(ctx, query, ledgerID)
+	if err != nil {
+		return nil, appError.FailedToFind(investmentQuoteEntity, err)
+	}
+	defer rows.Close()
+
+	quotes := map[uuid.UUID]domain.InvestmentQuote{}
+	for rows.Next() {
+		var quote domain.InvestmentQuote
+		var holdingID uuid.UUID
+		if err := rows.Scan(&holdingID, &quote.QuoteDate, &quote.Price); err != nil {
+			return nil, err
+		}
+		quote.HoldingID = &holdingID
+		quotes[holdingID] = quote
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return quotes, nil
+}
+
+func newInvestmentQuoteResponse(row *ent.InvestmentQuote) *dto.InvestmentQuoteResponse {
+	return &dto.InvestmentQuoteResponse{
+		ID:        row.ID,
+		HoldingID: row.HoldingID,
+		QuoteDate: row.QuoteDate.Format(time.DateOnly),
+		Price:     row.Price,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
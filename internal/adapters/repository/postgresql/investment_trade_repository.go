@@ -0,0 +1,141 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const investmentTradeEntity = "investment_trades"
+
+// CreateInvestmentTrade grava a operação e confere a posição resultante: uma venda não pode
+// deixar a quantidade negativa em nenhuma data.
+func (p *PostgreSQL) CreateInvestmentTrade(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentTrade) (*dto.InvestmentTradeResponse, error) {
+	var row *ent.InvestmentTrade
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if _, err := ensureHolding(ctx, client, ledgerID, input.HoldingID); err != nil {
+			return err
+		}
+
+		var err error
+		row, err = client.InvestmentTrade.
+			Create().
+			SetLedgerID(ledgerID).
+			SetHoldingID(input.HoldingID).
+			SetKind(string(input.Kind)).
+			SetTradeDate(input.TradeDate).
+			SetQuantity(input.Quantity).
+			SetPrice(input.Price).
+			SetFees(input.Fees).
+			SetNillableNote(input.Note).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(investmentTradeEntity, err)
+		}
+
+		return checkHoldingPosition(ctx, client, input.HoldingID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newInvestmentTradeResponse(row), nil
+}
+
+func (p *PostgreSQL) ListInvestmentTrades(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentTradeResponse, error) {
+	if _, err := ensureHolding(ctx, p.Client, ledgerID, holdingID); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.InvestmentTrade.Query().
+		Where(investmenttrade.HoldingIDEQ(holdingID)).
+		Order(ent.Desc(investmenttrade.FieldTradeDate), ent.Desc(investmenttrade.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(investmentTradeEntity, err)
+	}
+
+	response := make([]dto.InvestmentTradeResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newInvestmentTradeResponse(row))
+	}
+	return response, nil
+}
+
+// DeleteInvestmentTradeByID remove a operação desde que as vendas seguintes continuem
+// cobertas pelas compras restantes.
+func (p *PostgreSQL) DeleteInvestmentTradeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		deleted, err := client.InvestmentTrade.Delete().
+			Where(investmenttrade.IDEQ(id)).
+			Where(investmenttrade.HoldingIDEQ(holdingID)).
+			Where(investmenttrade.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToDelete(investmentTradeEntity, err)
+		}
+		if deleted == 0 {
+			return appError.ErrNotFound
+		}
+
+		return checkHoldingPosition(ctx, client, holdingID)
+	})
+}
+
+func checkHoldingPosition(ctx context.Context, client *ent.Client, holdingID uuid.UUID) error {
+	rows, err := client.InvestmentTrade.Query().
+		Where(investmenttrade.HoldingIDEQ(holdingID)).
+		Order(ent.Asc(investmenttrade.FieldTradeDate), ent.Asc(investmenttrade.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return appError.FailedToFind(investmentTradeEntity, err)
+	}
+
+	trades := make([]domain.InvestmentTrade, 0, len(rows))
+	for _, row := range rows {
+		trades = append(trades, *toDomainInvestmentTrade(row))
+	}
+
+	_, err = domain.NewPosition(trades)
+	return err
+}
+
+func toDomainInvestmentTrade(row *ent.InvestmentTrade) *domain.InvestmentTrade {
+	return &domain.InvestmentTrade{
+		ID:        row.ID,
+		HoldingID: row.HoldingID,
+		Kind:      domain.TradeKind(row.Kind),
+		TradeDate: row.TradeDate,
+		Quantity:  row.Quantity,
+		Price:     row.Price,
+		Fees:      row.Fees,
+		Note:      row.Note,
+	}
+}
+
+func newInvestmentTradeResponse(row *ent.InvestmentTrade) *dto.InvestmentTradeResponse {
+	return &dto.InvestmentTradeResponse{
+		ID:        row.ID,
+		HoldingID: row.HoldingID,
+		Kind:      row.Kind,
+		TradeDate: row.TradeDate.Format(time.DateOnly),
+		Quantity:  row.Quantity,
+		Price:     row.Price,
+		Fees:      row.Fees,
+		Note:      row.Note,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,191 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const invoicePaymentEntity = "invoice_payments"
+
+func (d *PostgreSQL) ListInvoicePayments(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID) ([]dto.InvoicePaymentResponse, error) {
+	if _, err := d.GetInvoiceByID(ctx, ledgerID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	rows, err := d.Client.InvoicePayment.Query().
+		Where(invoicepayment.HasInvoiceWith(invoice.IDEQ(invoiceID))).
+		Order(ent.Asc(invoicepayment.FieldPaidAt), ent.Asc(invoicepayment.FieldID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, appError.FailedToFind(invoicePaymentEntity, err)
+	}
+
+	response := make([]dto.InvoicePaymentResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, mapInvoicePaymentToResponse(row))
+	}
+	return response, nil
+}
+
+func (d *PostgreSQL) CreateInvoicePayment(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, input domain.InvoicePayment) (*dto.InvoicePaymentResponse, error) {
+	var created *ent.InvoicePayment
+
+	err := d.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := findLedgerInvoice(ctx, tx, ledgerID, invoiceID)
+		if err != nil {
+			return err
+		}
+
+		status := domain.InvoiceStatus(row.Status)
+		if !status.CanReceivePayment() {
+			return appError.ErrInvalidStatusTransition
+		}
+
+		// Pagamento maior que o saldo deixaria a fatura com saldo negativo
+		if input.Amount > domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments)) {
+			return appError.InvalidParam("amount", appError.ErrPaymentExceedsBalance)
+		}
+
+		if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+			return err
+		}
+
+		created, err = tx.InvoicePayment.
+			Create().
+			SetInvoiceID(invoiceID).
+			SetAmount(input.Amount).
+			SetPaidAt(input.PaidAt).
+			SetKind(string(domain.PaymentKindPayment)).
+			SetNillableAccountID(input.AccountID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(invoicePaymentEntity, err)
+		}
+
+		// Fatura fechada quitada pelos pagamentos parciais passa a ser considerada paga
+		payments := append(toInvoicePayments(row.Edges.Payments), input)
+		if status.CanPay() && domain.OutstandingAmount(row.Amount, payments) <= 0 {
+			return settleInvoice(ctx, tx, row, input.PaidAt, input.AccountID)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapInvoicePaymentToResponse(created)
+	return &response, nil
+}
+
+func (d *PostgreSQL) DeleteInvoicePaymentByID(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, id uuid.UUID) error {
+	return d.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := findLedgerInvoice(ctx, tx, ledgerID, invoiceID)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := tx.InvoicePayment.Delete().
+			Where(invoicepayment.IDEQ(id)).
+			Where(invoicepayment.HasInvoiceWith(invoice.IDEQ(invoiceID))).
+			Where(invoicepayment.KindEQ(string(domain.PaymentKindPayment))).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToDelete(invoicePaymentEntity, err)
+		}
+		if deleted == 0 {
+			return appError.ErrNotFound
+		}
+
+		if domain.InvoiceStatus(row.Status) != domain.InvoicePaid {
+			return nil
+		}
+
+		// Sem o pagamento a fatura volta a ter saldo em aberto
+		return reopenPaidInvoice(ctx, tx, row)
+	})
+}
+
+// reopenPaidInvoice desfaz a quitação de uma fatura, voltando-a para fechada, ou aberta se
+// foi paga sem ser fechada, ou vencida se o vencimento já passou.
+func reopenPaidInvoice(ctx context.Context, tx *ent.Tx, row *ent.Invoice) error {
+	status := domain.InvoiceClosed
+	if row.ClosedAt == nil {
+		status = domain.InvoiceOpen
+	}
+	if row.DueDate.Before(time.Now()) {
+		status = domain.InvoiceOverdue
+	}
+
+	err := tx.Invoice.
+		UpdateOneID(row.ID).
+		SetStatus(string(status)).
+		ClearPaidAt().
+		ClearPaymentAccountID().
+		Exec(ctx)
+	if err != nil {
+		return appError.FailedToUpdate("invoices", err)
+	}
+
+	_, err = tx.Transaction.
+		Update().
+		Where(transaction.HasInvoiceWith(invoice.ID(row.ID))).
+		SetStatus(string(domain.StatusPending)).
+		Save(ctx)
+	if err != nil {
+		return appError.FailedToUpdate(transactionEntity, err)
+	}
+	return nil
+}
+
+func createInvoicePayment(ctx context.Context, tx *ent.Tx, invoiceID uuid.UUID, input domain.InvoicePayment) error {
+	err := tx.InvoicePayment.
+		Create().
+		SetInvoiceID(invoiceID).
+		SetAmount(input.Amount).
+		SetPaidAt(input.PaidAt).
+		SetKind(string(input.Kind)).
+		SetNillableAccountID(input.AccountID).
+		Exec(ctx)
+
+	if err != nil {
+		return appError.FailedToSave(invoicePaymentEntity, err)
+	}
+	return nil
+}
+
+func toInvoicePayments(rows []*ent.InvoicePayment) []domain.InvoicePayment {
+	payments := make([]domain.InvoicePayment, 0, len(rows))
+	for _, row := range rows {
+		payments = append(payments, domain.InvoicePayment{
+			ID:        row.ID,
+			Amount:    row.Amount,
+			PaidAt:    row.PaidAt,
+			AccountID: row.AccountID,
+			Kind:      domain.InvoicePaymentKind(row.Kind),
+		})
+	}
+	return payments
+}
+
+func mapInvoicePaymentToResponse(row *ent.InvoicePayment) dto.InvoicePaymentResponse {
+	return dto.InvoicePaymentResponse{
+		ID:        row.ID,
+		Amount:    row.Amount,
+		PaidAt:    utils.ToDateTimeString(row.PaidAt),
+		Kind:      row.Kind,
+		AccountID: row.AccountID,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+	}
+}
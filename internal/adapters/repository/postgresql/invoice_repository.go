@@ -2,24 +2,28 @@ package postgresql
 
 import (
 	"context"
+	"fmt"
 	"frog-go/internal/config"
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/ent"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/transaction"
-	"frog-go/internal/ent/user"
 	"frog-go/internal/utils"
 	"frog-go/internal/utils/pagination"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-func (d *PostgreSQL) GetInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error) {
+func (d *PostgreSQL) GetInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error) {
 	row, err := d.Client.Invoice.Query().
 		Where(invoice.IDEQ(id)).
-		Where(invoice.HasUserWith(user.IDEQ(userID))).
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithPayments().
 		Only(ctx)
 
 	if err != nil {
@@ -31,9 +35,9 @@ func (d *PostgreSQL) GetInvoiceByID(ctx context.Context, userID uuid.UUID, id uu
 	return newInvoiceResponse(row)
 }
 
-func (d *PostgreSQL) DeleteInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+func (d *PostgreSQL) DeleteInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
 	err := d.Client.Invoice.DeleteOneID(id).
-		Where(invoice.HasUserWith(user.IDEQ(userID))).
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
 		Exec(ctx)
 
 	if err != nil {
@@ -45,13 +49,13 @@ func (d *PostgreSQL) DeleteInvoiceByID(ctx context.Context, userID uuid.UUID, id
 	return nil
 }
 
-func (d *PostgreSQL) CreateInvoice(ctx context.Context, userID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
+func (d *PostgreSQL) CreateInvoice(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
 	created, err := d.Client.Invoice.
 		Create().
-		SetUserID(userID).
+		SetLedgerID(ledgerID).
+		SetCreatedByID(createdByID).
 		SetTitle(input.Title).
 		SetDueDate(input.DueDate).
-		SetStatus(string(input.Status)).
 		Save(ctx)
 
 	if err != nil {
@@ -61,6 +65,7 @@ func (d *PostgreSQL) CreateInvoice(ctx context.Context, userID uuid.UUID, input
 	row, err := d.Client.Invoice.
 		Query().
 		Where(invoice.ID(created.ID)).
+		WithPayments().
 		Only(ctx)
 
 	if err != nil {
@@ -70,13 +75,12 @@ func (d *PostgreSQL) CreateInvoice(ctx context.Context, userID uuid.UUID, input
 	return newInvoiceResponse(row)
 }
 
-func (d *PostgreSQL) UpdateInvoice(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
+func (d *PostgreSQL) UpdateInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
 	updated, err := d.Client.Invoice.
 		UpdateOneID(id).
-		Where(invoice.HasUserWith(user.IDEQ(userID))).
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
 		SetTitle(input.Title).
 		SetDueDate(input.DueDate).
-		SetStatus(string(input.Status)).
 		Save(ctx)
 
 	if err != nil {
@@ -86,18 +90,10 @@ func (d *PostgreSQL) UpdateInvoice(ctx context.Context, userID uuid.UUID, id uui
 		return nil, appError.FailedToSave("invoices", err)
 	}
 
-	_, err = d.Client.Transaction.
-		Update().
-		Where(transaction.HasInvoiceWith(invoice.ID(id))).
-		SetStatus(string(input.Status)).
-		Save(ctx)
-	if err != nil {
-		return nil, appError.FailedToSave("transactions", err)
-	}
-
 	row, err := d.Client.Invoice.
 		Query().
 		Where(invoice.ID(updated.ID)).
+		WithPayments().
 		Only(ctx)
 
 	if err != nil {
@@ -107,9 +103,10 @@ func (d *PostgreSQL) UpdateInvoice(ctx context.Context, userID uuid.UUID, id uui
 	return newInvoiceResponse(row)
 }
 
-func (d *PostgreSQL) ListInvoices(ctx context.Context, userID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error) {
+func (d *PostgreSQL) ListInvoices(ctx context.Context, ledgerID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error) {
 	query := d.Client.Invoice.Query().
-		Where(invoice.HasUserWith(user.IDEQ(userID)))
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithPayments()
 
 	query = applyInvoiceFilters(query, flt, pgn)
 	query = apllyInvoiceOrderBy(query, pgn)
@@ -123,9 +120,9 @@ func (d *PostgreSQL) ListInvoices(ctx context.Context, userID uuid.UUID, flt dto
 	return newInvoiceResponseList(data)
 }
 
-func (d *PostgreSQL) CountInvoices(ctx context.Context, userID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) (int, error) {
+func (d *PostgreSQL) CountInvoices(ctx context.Context, ledgerID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) (int, error) {
 	query := d.Client.Invoice.Query().
-		Where(invoice.HasUserWith(user.IDEQ(userID)))
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID)))
 
 	query = applyInvoiceFilters(query, flt, pgn)
 
@@ -136,15 +133,290 @@ func (d *PostgreSQL) CountInvoices(ctx context.Context, userID uuid.UUID, flt dt
 	return total, nil
 }
 
+func (d *PostgreSQL) CloseInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoiceClosing) (*dto.InvoiceResponse, error) {
+	err := d.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := findLedgerInvoice(ctx, tx, ledgerID, id)
+		if err != nil {
+			return err
+		}
+
+		if !domain.InvoiceStatus(row.Status).CanClose() || row.ClosedAt != nil {
+			return appError.ErrInvalidStatusTransition
+		}
+
+		now := time.Now()
+
+		// Uma fatura que venceu antes de ser fechada continua vencida, agora com o valor congelado
+		status := domain.InvoiceClosed
+		if domain.InvoiceStatus(row.Status) == domain.InvoiceOverdue {
+			status = domain.InvoiceOverdue
+		}
+
+		// O filtro por status garante que outra requisição não alterou a fatura nesse meio tempo
+		err = tx.Invoice.
+			UpdateOneID(id).
+			Where(invoice.StatusEQ(row.Status)).
+			Where(invoice.ClosedAtIsNil()).
+			SetStatus(string(status)).
+			SetClosedAt(now).
+			Exec(ctx)
+
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrInvalidStatusTransition
+			}
+			return appError.FailedToUpdate("invoices", err)
+		}
+
+		outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+		if !input.Rollover || outstanding <= 0 {
+			return nil
+		}
+
+		return rolloverInvoice(ctx, tx, ledgerID, row, input.NextInvoiceID, outstanding, now)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.GetInvoiceByID(ctx, ledgerID, id)
+}
+
+func (d *PostgreSQL) PayInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoicePayment) (*dto.InvoiceResponse, error) {
+	err := d.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := findLedgerInvoice(ctx, tx, ledgerID, id)
+		if err != nil {
+			return err
+		}
+
+		if !domain.InvoiceStatus(row.Status).CanPay() {
+			return appError.ErrInvalidStatusTransition
+		}
+
+		if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+			return err
+		}
+
+		outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+		if outstanding > 0 {
+			input.Amount = outstanding
+			if err := createInvoicePayment(ctx, tx, id, input); err != nil {
+				return err
+			}
+		}
+
+		return settleInvoice(ctx, tx, row, input.PaidAt, input.AccountID)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.GetInvoiceByID(ctx, ledgerID, id)
+}
+
+// MarkOverdueInvoices marca como vencidas as faturas não pagas cujo vencimento já passou e
+// retorna um aviso para o dono do livro de cada uma, com o saldo em aberto na moeda base.
+func (d *PostgreSQL) MarkOverdueInvoices(ctx context.Context, now time.Time) ([]domain.Reminder, error) {
+	rows, err := d.Client.Invoice.Query().
+		Where(invoice.StatusIn(domain.OverdueCandidateStatus()...)).
+		Where(invoice.DueDateLT(now)).
+		WithLedger(func(q *ent.LedgerQuery) {
+			q.WithOwner()
+		}).
+		WithPayments().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind("invoices", err)
+	}
+	if len(rows) == 0 {
+		return []domain.Reminder{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	_, err = d.Client.Invoice.
+		Update().
+		Where(invoice.IDIn(ids...)).
+		Where(invoice.StatusIn(domain.OverdueCandidateStatus()...)).
+		SetStatus(string(domain.InvoiceOverdue)).
+		Save(ctx)
+	if err != nil {
+		return nil, appError.FailedToUpdate("invoices", err)
+	}
+
+	reminders := make([]domain.Reminder, 0, len(rows))
+	for _, row := range rows {
+		owner := ledgerOwner(row.Edges.Ledger)
+		if owner == nil {
+			continue
+		}
+
+		outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+		dueDate := time.Date(row.DueDate.Year(), row.DueDate.Month(), row.DueDate.Day(), 0, 0, 0, 0, time.UTC)
+		reminders = append(reminders, domain.NewInvoiceOverdueReminder(owner.ID, row.ID, row.Title, dueDate, outstanding, owner.BaseCurrency))
+	}
+	return reminders, nil
+}
+
+// findLedgerInvoice busca a fatura do livro, já com os pagamentos, dentro da transação.
+func findLedgerInvoice(ctx context.Context, tx *ent.Tx, ledgerID uuid.UUID, id uuid.UUID) (*ent.Invoice, error) {
+	row, err := tx.Invoice.Query().
+		Where(invoice.IDEQ(id)).
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithPayments().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind("invoice", err)
+	}
+	return row, nil
+}
+
+// ensureLedgerInvoice garante que a fatura informada pertence ao livro.
+func ensureLedgerInvoice(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, id *uuid.UUID) error {
+	if id == nil {
+		return nil
+	}
+
+	exists, err := client.Invoice.Query().
+		Where(invoice.IDEQ(*id)).
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exist(ctx)
+	if err != nil {
+		return appError.FailedToFind("invoices", err)
+	}
+	if !exists {
+		return appError.InvalidParam("invoice_id", appError.ErrInvoiceNotFound)
+	}
+	return nil
+}
+
+func ensureLedgerAccount(ctx context.Context, tx *ent.Tx, ledgerID uuid.UUID, accountID *uuid.UUID) error {
+	if accountID == nil {
+		return nil
+	}
+
+	exists, err := tx.Account.Query().
+		Where(account.IDEQ(*accountID)).
+		Where(account.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exist(ctx)
+	if err != nil {
+		return appError.FailedToFind(accountEntity, err)
+	}
+	if !exists {
+		return appError.InvalidParam("account_id", appError.ErrNotFound)
+	}
+	return nil
+}
+
+// settleInvoice marca a fatura e todas as suas transações como pagas.
+func settleInvoice(ctx context.Context, tx *ent.Tx, row *ent.Invoice, paidAt time.Time, accountID *uuid.UUID) error {
+	err := tx.Invoice.
+		UpdateOneID(row.ID).
+		SetStatus(string(domain.InvoicePaid)).
+		SetPaidAt(paidAt).
+		SetNillablePaymentAccountID(accountID).
+		Exec(ctx)
+
+	if err != nil {
+		return appError.FailedToUpdate("invoices", err)
+	}
+
+	_, err = tx.Transaction.
+		Update().
+		Where(transaction.HasInvoiceWith(invoice.ID(row.ID))).
+		SetStatus(string(domain.StatusPaid)).
+		Save(ctx)
+	if err != nil {
+		return appError.FailedToUpdate(transactionEntity, err)
+	}
+
+	return nil
+}
+
+// rolloverInvoice leva o saldo restante da fatura fechada para a próxima fatura aberta,
+// registrando a quitação na fatura de origem e uma linha de saldo anterior no destino.
+func rolloverInvoice(
+	ctx context.Context,
+	tx *ent.Tx,
+	ledgerID uuid.UUID,
+	row *ent.Invoice,
+	nextInvoiceID *uuid.UUID,
+	outstanding domain.Money,
+	now time.Time,
+) error {
+	query := tx.Invoice.Query().
+		Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(invoice.IDNEQ(row.ID)).
+		Where(invoice.StatusEQ(string(domain.InvoiceOpen)))
+
+	if nextInvoiceID != nil {
+		query = query.Where(invoice.IDEQ(*nextInvoiceID))
+	} else {
+		query = query.
+			Where(invoice.DueDateGT(row.DueDate)).
+			Order(ent.Asc(invoice.FieldDueDate))
+	}
+
+	next, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNoRolloverTarget
+		}
+		return appError.FailedToFind("invoice", err)
+	}
+
+	err = tx.Transaction.
+		Create().
+		SetLedgerID(ledgerID).
+		SetTitle(fmt.Sprintf("Saldo anterior - %s", row.Title)).
+		SetAmount(outstanding).
+		SetRecordType(string(domain.TypeExpense)).
+		SetStatus(string(domain.StatusPending)).
+		SetRecordDate(now).
+		SetInvoiceID(next.ID).
+		Exec(ctx)
+	if err != nil {
+		return appError.FailedToSave(transactionEntity, err)
+	}
+
+	rollover := domain.InvoicePayment{
+		Amount: outstanding,
+		PaidAt: now,
+		Kind:   domain.PaymentKindRollover,
+	}
+	if err := createInvoicePayment(ctx, tx, row.ID, rollover); err != nil {
+		return err
+	}
+
+	return settleInvoice(ctx, tx, row, now, nil)
+}
+
 func mapInvoiceToResponse(row *ent.Invoice) dto.InvoiceResponse {
+	outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+
 	response := dto.InvoiceResponse{
-		ID:        row.ID,
-		Title:     row.Title,
-		Amount:    row.Amount,
-		Status:    row.Status,
-		DueDate:   utils.ToDateTimeString(row.DueDate),
-		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
-		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+		ID:                row.ID,
+		Title:             row.Title,
+		Amount:            row.Amount,
+		Status:            row.Status,
+		DueDate:           utils.ToDateTimeString(row.DueDate),
+		ClosedAt:          utils.ToNillableDateTimeString(row.ClosedAt),
+		PaidAt:            utils.ToNillableDateTimeString(row.PaidAt),
+		PaymentAccountID:  row.PaymentAccountID,
+		CreatedByID:       row.CreatedByID,
+		PaidAmount:        row.Amount - outstanding,
+		OutstandingAmount: outstanding,
+		CreatedAt:         utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:         utils.ToDateTimeString(row.UpdatedAt),
 	}
 
 	return response
@@ -0,0 +1,495 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/ledgermember"
+	"frog-go/internal/ent/user"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ledgerEntity           = "ledgers"
+	ledgerMemberEntity     = "ledger_members"
+	ledgerInvitationEntity = "ledger_invitations"
+)
+
+// GetLedgerAccess retorna o papel do usuário no livro; ErrNotFound se ele não é membro.
+func (p *PostgreSQL) GetLedgerAccess(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) (*domain.LedgerAccess, error) {
+	row, err := p.Client.LedgerMember.Query().
+		Where(ledgermember.LedgerIDEQ(ledgerID)).
+		Where(ledgermember.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(ledgerMemberEntity, err)
+	}
+
+	return &domain.LedgerAccess{
+		LedgerID: row.LedgerID,
+		Role:     domain.LedgerRole(row.Role),
+	}, nil
+}
+
+// GetPersonalLedgerAccess retorna o livro pessoal do usuário, do qual ele é o dono.
+func (p *PostgreSQL) GetPersonalLedgerAccess(ctx context.Context, userID uuid.UUID) (*domain.LedgerAccess, error) {
+	id, err := p.Client.Ledger.Query().
+		Where(ledger.HasOwnerWith(user.IDEQ(userID))).
+		Where(ledger.Personal(true)).
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(ledgerEntity, err)
+	}
+
+	return &domain.LedgerAccess{
+		LedgerID: id,
+		Role:     domain.LedgerOwner,
+	}, nil
+}
+
+// GetLedgerBaseCurrency retorna a moeda base do livro, que é a do seu dono.
+func (p *PostgreSQL) GetLedgerBaseCurrency(ctx context.Context, ledgerID uuid.UUID) (string, error) {
+	return p.baseCurrency(ctx, ledgerID)
+}
+
+// CreateLedger cria um livro vazio, com as categorias padrão, do qual o usuário é o dono.
+func (p *PostgreSQL) CreateLedger(ctx context.Context, userID uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error) {
+	var id uuid.UUID
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		input.Personal = false
+
+		row, err := createLedger(ctx, tx.Client(), userID, input)
+		if err != nil {
+			return err
+		}
+		id = row.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getLedger(ctx, userID, id)
+}
+
+func (p *PostgreSQL) UpdateLedger(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error) {
+	err := p.Client.Ledger.
+		UpdateOneID(id).
+		SetName(input.Name).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToUpdate(ledgerEntity, err)
+	}
+
+	return p.getLedger(ctx, userID, id)
+}
+
+// ListLedgers lista os livros dos quais o usuário é membro, com o papel dele em cada um.
+func (p *PostgreSQL) ListLedgers(ctx context.Context, userID uuid.UUID) ([]dto.LedgerResponse, error) {
+	rows, err := p.Client.LedgerMember.Query().
+		Where(ledgermember.UserIDEQ(userID)).
+		WithLedger(func(q *ent.LedgerQuery) {
+			q.WithOwner()
+		}).
+		Order(ent.Asc(ledgermember.FieldCreatedAt), ent.Asc(ledgermember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerMemberEntity, err)
+	}
+
+	response := make([]dto.LedgerResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newLedgerResponse(row.Edges.Ledger, row.Role))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) ListLedgerMembers(ctx context.Context, ledgerID uuid.UUID) ([]dto.LedgerMemberResponse, error) {
+	rows, err := p.Client.LedgerMember.Query().
+		Where(ledgermember.LedgerIDEQ(ledgerID)).
+		WithUser().
+		Order(ent.Asc(ledgermember.FieldCreatedAt), ent.Asc(ledgermember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerMemberEntity, err)
+	}
+
+	response := make([]dto.LedgerMemberResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newLedgerMemberResponse(row))
+	}
+	return response, nil
+}
+
+// UpdateLedgerMemberRole troca o papel do membro. O dono do livro continua sempre como owner.
+func (p *PostgreSQL) UpdateLedgerMemberRole(ctx context.Context, ledgerID uuid.UUID, memberID uuid.UUID, role domain.LedgerRole) (*dto.LedgerMemberResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := findLedgerMember(ctx, tx.Client(), ledgerID, memberID)
+		if err != nil {
+			return err
+		}
+
+		if row.Edges.Ledger.Edges.Owner.ID == memberID && role != domain.LedgerOwner {
+			return appError.ErrLedgerOwner
+		}
+
+		if err := tx.LedgerMember.UpdateOne(row).SetRole(string(role)).Exec(ctx); err != nil {
+			return appError.FailedToUpdate(ledgerMemberEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := p.Client.LedgerMember.Query().
+		Where(ledgermember.LedgerIDEQ(ledgerID)).
+		Where(ledgermember.UserIDEQ(memberID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerMemberEntity, err)
+	}
+	return newLedgerMemberResponse(row), nil
+}
+
+func (p *PostgreSQL) DeleteLedgerMember(ctx context.Context, ledgerID uuid.UUID, memberID uuid.UUID) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := findLedgerMember(ctx, tx.Client(), ledgerID, memberID)
+		if err != nil {
+			return err
+		}
+
+		if row.Edges.Ledger.Edges.Owner.ID == memberID {
+			return appError.ErrLedgerOwner
+		}
+
+		if err := tx.LedgerMember.DeleteOne(row).Exec(ctx); err != nil {
+			return appError.FailedToDelete(ledgerMemberEntity, err)
+		}
+		return nil
+	})
+}
+
+// CreateLedgerInvitation convida um usuário, pelo username, a entrar no livro. Membros
+// atuais e usuários com convite pendente não podem ser convidados de novo.
+func (p *PostgreSQL) CreateLedgerInvitation(ctx context.Context, ledgerID uuid.UUID, invitedByID uuid.UUID, input domain.LedgerInvitation, now time.Time) (*dto.LedgerInvitationResponse, error) {
+	var id uuid.UUID
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		invitee, err := tx.User.Query().
+			Where(user.UsernameEQ(input.Username)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.InvalidParam("username", appError.ErrInviteeNotFound)
+			}
+			return appError.FailedToFind(userEntity, err)
+		}
+
+		member, err := tx.LedgerMember.Query().
+			Where(ledgermember.LedgerIDEQ(ledgerID)).
+			Where(ledgermember.UserIDEQ(invitee.ID)).
+			Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind(ledgerMemberEntity, err)
+		}
+
+		pending, err := tx.LedgerInvitation.Query().
+			Where(ledgerinvitation.LedgerIDEQ(ledgerID)).
+			Where(ledgerinvitation.InviteeIDEQ(invitee.ID)).
+			Where(ledgerinvitation.StatusEQ(string(domain.InvitationPending))).
+			Where(ledgerinvitation.ExpiresAtGT(now)).
+			Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind(ledgerInvitationEntity, err)
+		}
+
+		if member || pending {
+			return appError.ErrLedgerMemberConflict
+		}
+
+		row, err := tx.LedgerInvitation.
+			Create().
+			SetLedgerID(ledgerID).
+			SetInviteeID(invitee.ID).
+			SetInvitedByID(invitedByID).
+			SetRole(string(input.Role)).
+			SetStatus(string(domain.InvitationPending)).
+			SetExpiresAt(now.Add(domain.LedgerInvitationTTL)).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(ledgerInvitationEntity, err)
+		}
+		id = row.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getLedgerInvitation(ctx, id)
+}
+
+func (p *PostgreSQL) ListLedgerInvitations(ctx context.Context, ledgerID uuid.UUID) ([]dto.LedgerInvitationResponse, error) {
+	rows, err := ledgerInvitationQuery(p.Client).
+		Where(ledgerinvitation.LedgerIDEQ(ledgerID)).
+		Order(ent.Desc(ledgerinvitation.FieldCreatedAt), ent.Asc(ledgerinvitation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerInvitationEntity, err)
+	}
+	return newLedgerInvitationResponses(rows), nil
+}
+
+// ListReceivedLedgerInvitations lista os convites pendentes e ainda válidos do usuário.
+func (p *PostgreSQL) ListReceivedLedgerInvitations(ctx context.Context, userID uuid.UUID, now time.Time) ([]dto.LedgerInvitationResponse, error) {
+	rows, err := ledgerInvitationQuery(p.Client).
+		Where(ledgerinvitation.InviteeIDEQ(userID)).
+		Where(ledgerinvitation.StatusEQ(string(domain.InvitationPending))).
+		Where(ledgerinvitation.ExpiresAtGT(now)).
+		Order(ent.Desc(ledgerinvitation.FieldCreatedAt), ent.Asc(ledgerinvitation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerInvitationEntity, err)
+	}
+	return newLedgerInvitationResponses(rows), nil
+}
+
+func (p *PostgreSQL) RevokeLedgerInvitation(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, now time.Time) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.LedgerInvitation.Query().
+			Where(ledgerinvitation.IDEQ(id)).
+			Where(ledgerinvitation.LedgerIDEQ(ledgerID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToFind(ledgerInvitationEntity, err)
+		}
+
+		if domain.InvitationStatus(row.Status) != domain.InvitationPending {
+			return appError.ErrInvitationNotPending
+		}
+
+		err = tx.LedgerInvitation.UpdateOne(row).
+			SetStatus(string(domain.InvitationRevoked)).
+			SetRespondedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToUpdate(ledgerInvitationEntity, err)
+		}
+		return nil
+	})
+}
+
+// RespondLedgerInvitation aceita ou recusa um convite recebido pelo usuário. Ao aceitar, ele
+// entra no livro com o papel do convite.
+func (p *PostgreSQL) RespondLedgerInvitation(ctx context.Context, userID uuid.UUID, id uuid.UUID, accept bool, now time.Time) (*dto.LedgerInvitationResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.LedgerInvitation.Query().
+			Where(ledgerinvitation.IDEQ(id)).
+			Where(ledgerinvitation.InviteeIDEQ(userID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToFind(ledgerInvitationEntity, err)
+		}
+
+		if domain.InvitationStatus(row.Status) != domain.InvitationPending || !row.ExpiresAt.After(now) {
+			return appError.ErrInvitationNotPending
+		}
+
+		status := domain.InvitationDeclined
+		if accept {
+			status = domain.InvitationAccepted
+
+			_, err = tx.LedgerMember.
+				Create().
+				SetLedgerID(row.LedgerID).
+				SetUserID(userID).
+				SetRole(row.Role).
+				Save(ctx)
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					return appError.ErrLedgerMemberConflict
+				}
+				return appError.FailedToSave(ledgerMemberEntity, err)
+			}
+		}
+
+		err = tx.LedgerInvitation.UpdateOne(row).
+			SetStatus(string(status)).
+			SetRespondedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToUpdate(ledgerInvitationEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getLedgerInvitation(ctx, id)
+}
+
+func (p *PostgreSQL) getLedger(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.LedgerResponse, error) {
+	row, err := p.Client.LedgerMember.Query().
+		Where(ledgermember.LedgerIDEQ(id)).
+		Where(ledgermember.UserIDEQ(userID)).
+		WithLedger(func(q *ent.LedgerQuery) {
+			q.WithOwner()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(ledgerEntity, err)
+	}
+	return newLedgerResponse(row.Edges.Ledger, row.Role), nil
+}
+
+func (p *PostgreSQL) getLedgerInvitation(ctx context.Context, id uuid.UUID) (*dto.LedgerInvitationResponse, error) {
+	row, err := ledgerInvitationQuery(p.Client).
+		Where(ledgerinvitation.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(ledgerInvitationEntity, err)
+	}
+	return newLedgerInvitationResponse(row), nil
+}
+
+// createLedger cria o livro com o dono como membro owner e as categorias padrão.
+func createLedger(ctx context.Context, client *ent.Client, ownerID uuid.UUID, input domain.Ledger) (*ent.Ledger, error) {
+	row, err := client.Ledger.
+		Create().
+		SetOwnerID(ownerID).
+		SetName(input.Name).
+		SetPersonal(input.Personal).
+		Save(ctx)
+	if err != nil {
+		return nil, appError.FailedToSave(ledgerEntity, err)
+	}
+
+	_, err = client.LedgerMember.
+		Create().
+		SetLedgerID(row.ID).
+		SetUserID(ownerID).
+		SetRole(string(domain.LedgerOwner)).
+		Save(ctx)
+	if err != nil {
+		return nil, appError.FailedToSave(ledgerMemberEntity, err)
+	}
+
+	if err := createDefaultCategories(ctx, client, row.ID); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func findLedgerMember(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, memberID uuid.UUID) (*ent.LedgerMember, error) {
+	row, err := client.LedgerMember.Query().
+		Where(ledgermember.LedgerIDEQ(ledgerID)).
+		Where(ledgermember.UserIDEQ(memberID)).
+		WithLedger(func(q *ent.LedgerQuery) {
+			q.WithOwner()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(ledgerMemberEntity, err)
+	}
+	return row, nil
+}
+
+// ledgerOwner retorna o dono de um livro carregado com WithOwner, ou nil se o livro não veio.
+func ledgerOwner(row *ent.Ledger) *ent.User {
+	if row == nil {
+		return nil
+	}
+	return row.Edges.Owner
+}
+
+func ledgerInvitationQuery(client *ent.Client) *ent.LedgerInvitationQuery {
+	return client.LedgerInvitation.Query().
+		WithLedger().
+		WithInvitee().
+		WithInvitedBy()
+}
+
+func newLedgerUserResponse(row *ent.User) dto.LedgerUserResponse {
+	return dto.LedgerUserResponse{
+		ID:       row.ID,
+		Username: row.Username,
+		Name:     row.Name,
+	}
+}
+
+func newLedgerResponse(row *ent.Ledger, role string) *dto.LedgerResponse {
+	return &dto.LedgerResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Owner:     newLedgerUserResponse(row.Edges.Owner),
+		Role:      role,
+		Personal:  row.Personal,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func newLedgerMemberResponse(row *ent.LedgerMember) *dto.LedgerMemberResponse {
+	return &dto.LedgerMemberResponse{
+		User:      newLedgerUserResponse(row.Edges.User),
+		Role:      row.Role,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func newLedgerInvitationResponse(row *ent.LedgerInvitation) *dto.LedgerInvitationResponse {
+	return &dto.LedgerInvitationResponse{
+		ID:          row.ID,
+		LedgerID:    row.LedgerID,
+		LedgerName:  row.Edges.Ledger.Name,
+		Invitee:     newLedgerUserResponse(row.Edges.Invitee),
+		InvitedBy:   newLedgerUserResponse(row.Edges.InvitedBy),
+		Role:        row.Role,
+		Status:      row.Status,
+		ExpiresAt:   utils.ToDateTimeString(row.ExpiresAt),
+		RespondedAt: utils.ToNillableDateTimeString(row.RespondedAt),
+		CreatedAt:   utils.ToDateTimeString(row.CreatedAt),
+	}
+}
+
+func newLedgerInvitationResponses(rows []*ent.LedgerInvitation) []dto.LedgerInvitationResponse {
+	response := make([]dto.LedgerInvitationResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newLedgerInvitationResponse(row))
+	}
+	return response
+}
@@ -0,0 +1,555 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	loanEntity            = "loans"
+	loanInstallmentEntity = "loan_installments"
+	loanPrepaymentEntity  = "loan_prepayments"
+)
+
+func (p *PostgreSQL) GetLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanResponse, error) {
+	row, err := ensureLoan(ctx, p.Client, ledgerID, id)
+	if err != nil {
+		return nil, err
+	}
+	return newLoanResponse(row), nil
+}
+
+// CreateLoan grava o empréstimo com o cronograma completo. Cada parcela vira uma despesa
+// pendente dividida entre amortização e juros.
+func (p *PostgreSQL) CreateLoan(ctx context.Context, ledgerID uuid.UUID, input domain.Loan) (*dto.LoanResponse, error) {
+	categoryIDs := []uuid.UUID{}
+	for _, id := range []*uuid.UUID{input.InterestCategoryID, input.PrincipalCategoryID} {
+		if id != nil {
+			categoryIDs = append(categoryIDs, *id)
+		}
+	}
+	if err := ensureLedgerCategories(ctx, p.Client, ledgerID, categoryIDs); err != nil {
+		return nil, err
+	}
+
+	currency, err := p.currencyOrBase(ctx, ledgerID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var row *ent.Loan
+	err = p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+			return err
+		}
+
+		var err error
+		row, err = tx.Loan.
+			Create().
+			SetLedgerID(ledgerID).
+			SetName(input.Name).
+			SetPrincipal(input.Principal).
+			SetInterestRate(input.InterestRate).
+			SetRatePeriod(string(input.RatePeriod)).
+			SetTermMonths(input.TermMonths).
+			SetSystem(string(input.System)).
+			SetFirstPaymentDate(input.FirstPaymentDate).
+			SetCurrency(currency).
+			SetNillableAccountID(input.AccountID).
+			SetNillableInterestCategoryID(input.InterestCategoryID).
+			SetNillablePrincipalCategoryID(input.PrincipalCategoryID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(loanEntity, err)
+		}
+
+		schedule := toDomainLoan(row).Schedule(row.Principal, 1, row.TermMonths)
+		return createLoanInstallments(ctx, tx.Client(), ledgerID, row, schedule)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newLoanResponse(row), nil
+}
+
+func (p *PostgreSQL) UpdateLoan(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Loan) (*dto.LoanResponse, error) {
+	var row *ent.Loan
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+			return err
+		}
+
+		update := tx.Loan.
+			UpdateOneID(id).
+			Where(loan.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			SetName(input.Name)
+
+		if input.AccountID != nil {
+			update = update.SetAccountID(*input.AccountID)
+		} else {
+			update = update.ClearAccountID()
+		}
+
+		var err error
+		row, err = update.Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToUpdate(loanEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newLoanResponse(row), nil
+}
+
+// DeleteLoanByID remove o empréstimo e as transações das parcelas ainda não pagas. Parcelas
+// pagas e amortizações extras continuam no histórico de transações.
+func (p *PostgreSQL) DeleteLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		if _, err := ensureLoan(ctx, tx.Client(), ledgerID, id); err != nil {
+			return err
+		}
+
+		pending, err := tx.LoanInstallment.Query().
+			Where(loaninstallment.LoanIDEQ(id)).
+			Where(loaninstallment.HasTransactionWith(transaction.StatusNEQ(string(domain.StatusPaid)))).
+			All(ctx)
+		if err != nil {
+			return appError.FailedToFind(loanInstallmentEntity, err)
+		}
+
+		transactionIDs := make([]uuid.UUID, 0, len(pending))
+		for _, installment := range pending {
+			transactionIDs = append(transactionIDs, *installment.TransactionID)
+		}
+
+		if err := tx.Loan.DeleteOneID(id).Exec(ctx); err != nil {
+			return appError.FailedToDelete(loanEntity, err)
+		}
+
+		if len(transactionIDs) > 0 {
+			_, err := tx.Transaction.Delete().
+				Where(transaction.IDIn(transactionIDs...)).
+				Exec(ctx)
+			if err != nil {
+				return appError.FailedToDelete(transactionEntity, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *PostgreSQL) ListLoans(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.LoanResponse, error) {
+	query := p.Client.Loan.Query().
+		Where(loan.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyLoanFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(loan.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(loan.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.LoanResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newLoanResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountLoans(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Loan.Query().
+		Where(loan.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyLoanFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// LoanSchedule retorna o cronograma atual com a situação de cada parcela, dada pela transação
+// vinculada.
+func (p *PostgreSQL) LoanSchedule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanScheduleResponse, error) {
+	row, err := ensureLoan(ctx, p.Client, ledgerID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	installments, err := p.Client.LoanInstallment.Query().
+		Where(loaninstallment.LoanIDEQ(id)).
+		WithTransaction().
+		Order(ent.Asc(loaninstallment.FieldNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(loanInstallmentEntity, err)
+	}
+
+	prepayments, err := p.Client.LoanPrepayment.Query().
+		Where(loanprepayment.LoanIDEQ(id)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(loanPrepaymentEntity, err)
+	}
+
+	response := &dto.LoanScheduleResponse{
+		Loan:         *newLoanResponse(row),
+		Balance:      row.Principal,
+		Installments: make([]dto.LoanInstallmentResponse, 0, len(installments)),
+	}
+
+	for _, prepayment := range prepayments {
+		response.Balance -= prepayment.Amount
+		response.InterestSaved += prepayment.InterestSaved
+	}
+
+	for _, installment := range installments {
+		item := dto.NewLoanInstallmentResponse(*toDomainLoanInstallment(installment))
+		item.TransactionID = installment.TransactionID
+
+		if txn := installment.Edges.Transaction; txn != nil {
+			item.Status = utils.StringPtr(txn.Status)
+		}
+
+		response.TotalInterest += installment.Interest
+		if item.Status != nil && *item.Status == string(domain.StatusPaid) {
+			response.PaidInstallments++
+			response.InterestPaid += installment.Interest
+			response.Balance -= installment.Principal
+		} else {
+			response.RemainingInstallments++
+		}
+
+		response.Installments = append(response.Installments, item)
+	}
+
+	return response, nil
+}
+
+// CreateLoanPrepayment registra uma amortização extra. As parcelas que vencem depois da data
+// são substituídas pelo cronograma recalculado a partir do novo saldo, e a diferença de juros
+// entre os dois cronogramas fica registrada como juros economizados.
+func (p *PostgreSQL) CreateLoanPrepayment(ctx context.Context, ledgerID uuid.UUID, input domain.LoanPrepayment) (*dto.LoanPrepaymentResponse, error) {
+	var row *ent.LoanPrepayment
+	var remaining int
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		loanRow, err := ensureLoan(ctx, client, ledgerID, input.LoanID)
+		if err != nil {
+			return err
+		}
+
+		later, err := client.LoanPrepayment.Query().
+			Where(loanprepayment.LoanIDEQ(loanRow.ID)).
+			Where(loanprepayment.PaymentDateGT(input.PaymentDate)).
+			Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind(loanPrepaymentEntity, err)
+		}
+		if later {
+			return appError.ErrPrepaymentConflict
+		}
+
+		installments, err := client.LoanInstallment.Query().
+			Where(loaninstallment.LoanIDEQ(loanRow.ID)).
+			WithTransaction().
+			Order(ent.Asc(loaninstallment.FieldNumber)).
+			All(ctx)
+		if err != nil {
+			return appError.FailedToFind(loanInstallmentEntity, err)
+		}
+
+		// O saldo na data é o da última parcela vencida, descontadas as amortizações extras
+		// feitas desde o seu vencimento
+		balance := loanRow.Principal
+		since := time.Time{}
+		replaced := []*ent.LoanInstallment{}
+		for _, installment := range installments {
+			if !installment.DueDate.After(input.PaymentDate) {
+				balance = installment.Balance
+				since = installment.DueDate
+				continue
+			}
+
+			if txn := installment.Edges.Transaction; txn != nil && txn.Status == string(domain.StatusPaid) {
+				return appError.ErrPrepaymentConflict
+			}
+			replaced = append(replaced, installment)
+		}
+
+		previous, err := client.LoanPrepayment.Query().
+			Where(loanprepayment.LoanIDEQ(loanRow.ID)).
+			Where(loanprepayment.PaymentDateGTE(since)).
+			All(ctx)
+		if err != nil {
+			return appError.FailedToFind(loanPrepaymentEntity, err)
+		}
+		for _, prepayment := range previous {
+			balance -= prepayment.Amount
+		}
+
+		if input.Amount > balance {
+			return appError.ErrPrepaymentExceedsBalance
+		}
+
+		current := make([]domain.LoanInstallment, 0, len(replaced))
+		transactionIDs := []uuid.UUID{}
+		installmentIDs := []uuid.UUID{}
+		for _, installment := range replaced {
+			current = append(current, *toDomainLoanInstallment(installment))
+			installmentIDs = append(installmentIDs, installment.ID)
+			if installment.TransactionID != nil {
+				transactionIDs = append(transactionIDs, *installment.TransactionID)
+			}
+		}
+
+		details := toDomainLoan(loanRow)
+		schedule := details.Reschedule(balance-input.Amount, current, input.Mode)
+		remaining = len(schedule)
+
+		if len(installmentIDs) > 0 {
+			if _, err := client.LoanInstallment.Delete().Where(loaninstallment.IDIn(installmentIDs...)).Exec(ctx); err != nil {
+				return appError.FailedToDelete(loanInstallmentEntity, err)
+			}
+		}
+		if len(transactionIDs) > 0 {
+			if _, err := client.Transaction.Delete().Where(transaction.IDIn(transactionIDs...)).Exec(ctx); err != nil {
+				return appError.FailedToDelete(transactionEntity, err)
+			}
+		}
+
+		if err := createLoanInstallments(ctx, client, ledgerID, loanRow, schedule); err != nil {
+			return err
+		}
+
+		payment, err := client.Transaction.
+			Create().
+			SetLedgerID(ledgerID).
+			SetTitle(fmt.Sprintf("%s - amortização extra", loanRow.Name)).
+			SetAmount(input.Amount).
+			SetCurrency(loanRow.Currency).
+			SetRecordType(string(domain.TypeExpense)).
+			SetStatus(string(domain.StatusPaid)).
+			SetRecordDate(input.PaymentDate).
+			SetNillableCategoryID(loanRow.PrincipalCategoryID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(transactionEntity, err)
+		}
+
+		row, err = client.LoanPrepayment.
+			Create().
+			SetLedgerID(ledgerID).
+			SetLoanID(loanRow.ID).
+			SetPaymentDate(input.PaymentDate).
+			SetAmount(input.Amount).
+			SetMode(string(input.Mode)).
+			SetInterestSaved(domain.TotalInterest(current) - domain.TotalInterest(schedule)).
+			SetTransactionID(payment.ID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(loanPrepaymentEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := newLoanPrepaymentResponse(row)
+	response.RemainingInstallments = &remaining
+	return response, nil
+}
+
+func (p *PostgreSQL) ListLoanPrepayments(ctx context.Context, ledgerID uuid.UUID, loanID uuid.UUID) ([]dto.LoanPrepaymentResponse, error) {
+	if _, err := ensureLoan(ctx, p.Client, ledgerID, loanID); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.LoanPrepayment.Query().
+		Where(loanprepayment.LoanIDEQ(loanID)).
+		Order(ent.Desc(loanprepayment.FieldPaymentDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(loanPrepaymentEntity, err)
+	}
+
+	response := make([]dto.LoanPrepaymentResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newLoanPrepaymentResponse(row))
+	}
+	return response, nil
+}
+
+// createLoanInstallments grava as parcelas e as despesas pendentes vinculadas. A despesa é
+// dividida entre amortização e juros nas categorias do empréstimo; sem juros, fica inteira na
+// categoria de amortização.
+func createLoanInstallments(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, row *ent.Loan, installments []domain.LoanInstallment) error {
+	for _, installment := range installments {
+		create := client.Transaction.
+			Create().
+			SetLedgerID(ledgerID).
+			SetTitle(fmt.Sprintf("%s - parcela %d", row.Name, installment.Number)).
+			SetAmount(installment.Payment).
+			SetCurrency(row.Currency).
+			SetRecordType(string(domain.TypeExpense)).
+			SetStatus(string(domain.StatusPending)).
+			SetRecordDate(installment.DueDate)
+
+		var splits []domain.TransactionSplit
+		switch {
+		case installment.Interest > 0 && installment.Principal > 0:
+			splits = []domain.TransactionSplit{
+				{CategoryID: row.PrincipalCategoryID, Amount: installment.Principal, Note: utils.StringPtr("Amortização")},
+				{CategoryID: row.InterestCategoryID, Amount: installment.Interest, Note: utils.StringPtr("Juros")},
+			}
+		case installment.Interest > 0:
+			create = create.SetNillableCategoryID(row.InterestCategoryID)
+		default:
+			create = create.SetNillableCategoryID(row.PrincipalCategoryID)
+		}
+
+		created, err := create.Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(transactionEntity, err)
+		}
+
+		if err := createTransactionSplits(ctx, client, created.ID, splits); err != nil {
+			return err
+		}
+
+		err = client.LoanInstallment.
+			Create().
+			SetLedgerID(ledgerID).
+			SetLoanID(row.ID).
+			SetNumber(installment.Number).
+			SetDueDate(installment.DueDate).
+			SetPayment(installment.Payment).
+			SetInterest(installment.Interest).
+			SetPrincipal(installment.Principal).
+			SetBalance(installment.Balance).
+			SetTransactionID(created.ID).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToSave(loanInstallmentEntity, err)
+		}
+	}
+	return nil
+}
+
+func ensureLoan(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, id uuid.UUID) (*ent.Loan, error) {
+	row, err := client.Loan.Query().
+		Where(loan.IDEQ(id)).
+		Where(loan.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(loanEntity, err)
+	}
+	return row, nil
+}
+
+func toDomainLoan(row *ent.Loan) *domain.Loan {
+	return &domain.Loan{
+		ID:                  row.ID,
+		Name:                row.Name,
+		Principal:           row.Principal,
+		InterestRate:        row.InterestRate,
+		RatePeriod:          domain.RatePeriod(row.RatePeriod),
+		TermMonths:          row.TermMonths,
+		System:              domain.LoanSystem(row.System),
+		FirstPaymentDate:    row.FirstPaymentDate,
+		Currency:            row.Currency,
+		AccountID:           row.AccountID,
+		InterestCategoryID:  row.InterestCategoryID,
+		PrincipalCategoryID: row.PrincipalCategoryID,
+		CreatedAt:           row.CreatedAt,
+		UpdatedAt:           row.UpdatedAt,
+	}
+}
+
+func toDomainLoanInstallment(row *ent.LoanInstallment) *domain.LoanInstallment {
+	return &domain.LoanInstallment{
+		Number:    row.Number,
+		DueDate:   row.DueDate,
+		Payment:   row.Payment,
+		Interest:  row.Interest,
+		Principal: row.Principal,
+		Balance:   row.Balance,
+	}
+}
+
+func newLoanResponse(row *ent.Loan) *dto.LoanResponse {
+	return &dto.LoanResponse{
+		ID:                  row.ID,
+		Name:                row.Name,
+		Principal:           row.Principal,
+		InterestRate:        row.InterestRate,
+		RatePeriod:          row.RatePeriod,
+		TermMonths:          row.TermMonths,
+		System:              row.System,
+		FirstPaymentDate:    row.FirstPaymentDate.Format(time.DateOnly),
+		Currency:            row.Currency,
+		AccountID:           row.AccountID,
+		InterestCategoryID:  row.InterestCategoryID,
+		PrincipalCategoryID: row.PrincipalCategoryID,
+		CreatedAt:           utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:           utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func newLoanPrepaymentResponse(row *ent.LoanPrepayment) *dto.LoanPrepaymentResponse {
+	return &dto.LoanPrepaymentResponse{
+		ID:            row.ID,
+		LoanID:        row.LoanID,
+		PaymentDate:   row.PaymentDate.Format(time.DateOnly),
+		Amount:        row.Amount,
+		Mode:          row.Mode,
+		InterestSaved: row.InterestSaved,
+		TransactionID: row.TransactionID,
+		CreatedAt:     utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:     utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func applyLoanFilters(query *ent.LoanQuery, pgn *pagination.Pagination) *ent.LoanQuery {
+	if pgn.Search != "" {
+		query = query.Where(loan.NameContainsFold(pgn.Search))
+	}
+	return query
+}
@@ -0,0 +1,704 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/notification"
+	"frog-go/internal/ent/notificationdelivery"
+	"frog-go/internal/ent/notificationpreference"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/user"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	importJobEntity              = "import_jobs"
+	notificationEntity           = "notifications"
+	notificationDeliveryEntity   = "notification_deliveries"
+	notificationPreferenceEntity = "notification_preferences"
+)
+
+// GetNotificationPreference retorna as preferências do usuário ou, se ele ainda não salvou
+// nenhuma, as padrão.
+func (p *PostgreSQL) GetNotificationPreference(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreference, error) {
+	row, err := p.Client.NotificationPreference.Query().
+		Where(notificationpreference.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			preference := domain.DefaultNotificationPreference()
+			preference.UserID = userID
+			return &preference, nil
+		}
+		return nil, appError.FailedToFind(notificationPreferenceEntity, err)
+	}
+
+	preference := toDomainNotificationPreference(row, userID)
+	return &preference, nil
+}
+
+func (p *PostgreSQL) UpsertNotificationPreference(ctx context.Context, userID uuid.UUID, input domain.NotificationPreference) (*domain.NotificationPreference, error) {
+	var row *ent.NotificationPreference
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := tx.NotificationPreference.Query().
+			Where(notificationpreference.HasUserWith(user.IDEQ(userID))).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return appError.FailedToFind(notificationPreferenceEntity, err)
+		}
+
+		if current != nil {
+			update := tx.NotificationPreference.
+				UpdateOne(current).
+				SetLeadDays(input.LeadDays).
+				SetTimezone(input.Timezone).
+				SetEmailEnabled(input.EmailEnabled).
+				SetInappEnabled(input.InAppEnabled)
+
+			if input.QuietStart != nil && input.QuietEnd != nil {
+				update.SetQuietStart(*input.QuietStart).SetQuietEnd(*input.QuietEnd)
+			} else {
+				update.ClearQuietStart().ClearQuietEnd()
+			}
+
+			if input.WebhookURL != nil {
+				update.SetWebhookURL(*input.WebhookURL)
+			} else {
+				update.ClearWebhookURL()
+			}
+
+			if input.WebhookSecret != nil {
+				update.SetWebhookSecret(*input.WebhookSecret)
+			} else {
+				update.ClearWebhookSecret()
+			}
+
+			row, err = update.Save(ctx)
+			if err != nil {
+				return appError.FailedToUpdate(notificationPreferenceEntity, err)
+			}
+			return nil
+		}
+
+		row, err = tx.NotificationPreference.
+			Create().
+			SetUserID(userID).
+			SetLeadDays(input.LeadDays).
+			SetNillableQuietStart(input.QuietStart).
+			SetNillableQuietEnd(input.QuietEnd).
+			SetTimezone(input.Timezone).
+			SetEmailEnabled(input.EmailEnabled).
+			SetNillableWebhookURL(input.WebhookURL).
+			SetNillableWebhookSecret(input.WebhookSecret).
+			SetInappEnabled(input.InAppEnabled).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(notificationPreferenceEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	preference := toDomainNotificationPreference(row, userID)
+	return &preference, nil
+}
+
+// ListDueReminders monta, para todos os livros, os lembretes de faturas em aberto e de
+// transações pendentes a pagar que vencem de hoje até a antecedência escolhida. Os lembretes
+// vão para o dono do livro, contando os dias no fuso dele. Compras no cartão ficam de fora, já
+// que vencem com a fatura.
+func (p *PostgreSQL) ListDueReminders(ctx context.Context, now time.Time) ([]domain.Reminder, error) {
+	preferences, err := p.notificationPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preferenceOf := func(userID uuid.UUID) domain.NotificationPreference {
+		if preference, ok := preferences[userID]; ok {
+			return preference
+		}
+		preference := domain.DefaultNotificationPreference()
+		preference.UserID = userID
+		return preference
+	}
+
+	// a janela cobre todos os fusos; o filtro fino por usuário é feito abaixo
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	to := from.AddDate(0, 0, domain.NotificationMaxLeadDays+2)
+
+	isDue := func(preference domain.NotificationPreference, date time.Time) (time.Time, bool) {
+		date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		today := preference.Today(now)
+		if date.Before(today) || date.After(today.AddDate(0, 0, preference.LeadDays)) {
+			return today, false
+		}
+		return today, true
+	}
+
+	invoices, err := p.Client.Invoice.Query().
+		Where(invoice.StatusIn(domain.UnpaidInvoiceStatus()...)).
+		Where(invoice.DueDateGTE(from)).
+		Where(invoice.DueDateLT(to)).
+		WithLedger(func(q *ent.LedgerQuery) {
+			q.WithOwner()
+		}).
+		WithPayments().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind("invoices", err)
+	}
+
+	reminders := []domain.Reminder{}
+	for _, row := range invoices {
+		owner := ledgerOwner(row.Edges.Ledger)
+		if owner == nil {
+			continue
+		}
+		userID := owner.ID
+
+		today, ok := isDue(preferenceOf(userID), row.DueDate)
+		if !ok {
+			continue
+		}
+
+		outstanding := domain.OutstandingAmount(row.Amount, toInvoicePayments(row.Edges.Payments))
+		if outstanding <= 0 {
+			continue
+		}
+
+		dueDate := time.Date(row.DueDate.Year(), row.DueDate.Month(), row.DueDate.Day(), 0, 0, 0, 0, time.UTC)
+		reminders = append(reminders, domain.NewInvoiceDueReminder(userID, row.ID, row.Title, dueDate, outstanding, owner.BaseCurrency, today))
+	}
+
+	transactions, err := p.Client.Transaction.Query().
+		Where(transaction.StatusEQ(string(domain.StatusPending))).
+		Where(transaction.RecordTypeNEQ(string(domain.TypeIncome))).
+		Where(transaction.Not(transaction.HasInvoice())).
+		Where(transaction.RecordDateGTE(from)).
+		Where(transaction.RecordDateLT(to)).
+		WithLedger(func(q *ent.LedgerQuery) {
+			q.WithOwner()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+
+	for _, row := range transactions {
+		owner := ledgerOwner(row.Edges.Ledger)
+		if owner == nil {
+			continue
+		}
+		userID := owner.ID
+
+		today, ok := isDue(preferenceOf(userID), row.RecordDate)
+		if !ok {
+			continue
+		}
+
+		recordDate := time.Date(row.RecordDate.Year(), row.RecordDate.Month(), row.RecordDate.Day(), 0, 0, 0, 0, time.UTC)
+		reminders = append(reminders, domain.NewTransactionDueReminder(userID, row.ID, row.Title, recordDate, row.Amount, row.Currency, today))
+	}
+
+	return reminders, nil
+}
+
+// ListSpendingAnomalies procura, em todos os livros, as despesas dos últimos AnomalyWindowDays
+// dias bem acima do padrão da categoria nos AnomalyLookbackDays dias anteriores. Os valores
+// ficam na moeda base e o aviso vai para o dono do livro. Parcelas de empréstimo e a categoria
+// padrão ficam de fora.
+func (p *PostgreSQL) ListSpendingAnomalies(ctx context.Context, now time.Time) ([]domain.Reminder, error) {
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -domain.AnomalyWindowDays)
+	since := from.AddDate(0, 0, -domain.AnomalyLookbackDays)
+
+	query := `
+		SELECT t.id, t.title, t.record_date, ROUND(t.amount * t.exchange_rate, 2) AS amount,
+			c.name, l.owner_id, u.base_currency,
+			COALESCE(h.average, 0), COALESCE(h.deviation, 0), h.samples
+		FROM transactions AS t
+			JOIN categories AS c ON c.id = t.category_id
+			JOIN ledgers AS l ON l.id = t.ledger_id
+			JOIN users AS u ON u.id = l.owner_id
+			CROSS JOIN LATERAL (
+				SELECT AVG(ROUND(o.amount * o.exchange_rate, 2)) AS average,
+					STDDEV_SAMP(ROUND(o.amount * o.exchange_rate, 2)) AS deviation,
+					COUNT(*) AS samples
+				FROM transactions AS o
+				WHERE o.ledger_id = t.ledger_id
+				AND o.category_id = t.category_id
+				AND o.record_type = $1
+				AND o.record_date >= $4
+				AND o.record_date < $2
+			) AS h
+		WHERE t.record_type = $1
+		AND t.record_date >= $2
+		AND t.record_date < $3
+		AND c.name <> $5
+		AND h.samples >= $6
+		AND NOT EXISTS (SELECT 1 FROM loan_installments AS li WHERE li.transaction_id = t.id)
+		ORDER BY t.record_date, t.id
+	`
+
+	rows, err := p.db.QueryContext(ctx, query, string(domain.TypeExpense), from, to, since, domain.DefaultCategoryName, domain.AnomalyMinSamples)
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+	defer rows.Close()
+
+	reminders := []domain.Reminder{}
+	for rows.Next() {
+		var (
+			id, ownerID               uuid.UUID
+			title, category, currency string
+			recordDate                time.Time
+			amount                    domain.Money
+			average, deviation        float64
+			samples                   int
+		)
+		if err := rows.Scan(&id, &title, &recordDate, &amount, &category, &ownerID, &currency, &average, &deviation, &samples); err != nil {
+			return nil, err
+		}
+
+		if !domain.IsSpendingAnomaly(amount, average, deviation, samples) {
+			continue
+		}
+
+		recordDate = time.Date(recordDate.Year(), recordDate.Month(), recordDate.Day(), 0, 0, 0, 0, time.UTC)
+		averageAmount := domain.Money(math.Round(average * 100))
+		reminders = append(reminders, domain.NewSpendingAnomalyReminder(ownerID, id, title, category, recordDate, amount, averageAmount, currency))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reminders, nil
+}
+
+// EnqueueReminder registra uma entrega pendente do lembrete em cada canal ativo do usuário.
+// Canais em que o lembrete já foi registrado são ignorados pelo índice único, então repetir a
+// varredura não duplica avisos. Retorna quantas entregas novas foram criadas.
+func (p *PostgreSQL) EnqueueReminder(ctx context.Context, reminder domain.Reminder) (int, error) {
+	preference, err := p.GetNotificationPreference(ctx, reminder.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, channel := range preference.Channels() {
+		exists, err := p.Client.NotificationDelivery.Query().
+			Where(notificationdelivery.HasUserWith(user.IDEQ(reminder.UserID))).
+			Where(notificationdelivery.KeyEQ(reminder.Key)).
+			Where(notificationdelivery.ChannelEQ(string(channel))).
+			Exist(ctx)
+		if err != nil {
+			return created, appError.FailedToFind(notificationDeliveryEntity, err)
+		}
+		if exists {
+			continue
+		}
+
+		err = p.Client.NotificationDelivery.
+			Create().
+			SetUserID(reminder.UserID).
+			SetKind(string(reminder.Kind)).
+			SetKey(reminder.Key).
+			SetChannel(string(channel)).
+			SetNillableReferenceID(reminder.ReferenceID).
+			SetTitle(reminder.Title).
+			SetBody(reminder.Body).
+			SetPayload(reminder.Payload).
+			Exec(ctx)
+		if err != nil {
+			// outra execução registrou a mesma entrega entre a consulta e a gravação
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return created, appError.FailedToSave(notificationDeliveryEntity, err)
+		}
+		created++
+	}
+
+	return created, nil
+}
+
+// ClaimNotificationDeliveries reserva as entregas pendentes mais antigas, já liberadas por
+// not_before, e retorna cada uma com o e-mail do usuário e as preferências atuais dele. A
+// reserva troca o status para sending em uma única instrução com SKIP LOCKED, então execuções
+// concorrentes nunca pegam a mesma entrega. Uma reserva mais antiga que
+// NotificationClaimTimeout é de uma execução que parou no meio e pode ser retomada.
+func (p *PostgreSQL) ClaimNotificationDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.NotificationDelivery, error) {
+	query := `
+		UPDATE notification_deliveries SET status = $1, updated_at = $3
+		WHERE id IN (
+			SELECT id FROM notification_deliveries
+			WHERE (status = $2 AND (not_before IS NULL OR not_before <= $3))
+			OR (status = $1 AND updated_at < $4)
+			ORDER BY created_at
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`
+
+	claimed, err := p.db.QueryContext(ctx, query,
+		string(domain.DeliverySending),
+		string(domain.DeliveryPending),
+		now,
+		now.Add(-domain.NotificationClaimTimeout),
+		limit,
+	)
+	if err != nil {
+		return nil, appError.FailedToUpdate(notificationDeliveryEntity, err)
+	}
+	defer claimed.Close()
+
+	ids := []uuid.UUID{}
+	for claimed.Next() {
+		var id uuid.UUID
+		if err := claimed.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := claimed.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []domain.NotificationDelivery{}, nil
+	}
+
+	rows, err := p.Client.NotificationDelivery.Query().
+		Where(notificationdelivery.IDIn(ids...)).
+		Order(ent.Asc(notificationdelivery.FieldCreatedAt)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(notificationDeliveryEntity, err)
+	}
+
+	preferences, err := p.notificationPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]domain.NotificationDelivery, 0, len(rows))
+	for _, row := range rows {
+		if row.Edges.User == nil {
+			continue
+		}
+		owner := row.Edges.User
+
+		preference, ok := preferences[owner.ID]
+		if !ok {
+			preference = domain.DefaultNotificationPreference()
+			preference.UserID = owner.ID
+		}
+
+		var email *string
+		if owner.Email != "" {
+			email = &owner.Email
+		}
+
+		deliveries = append(deliveries, domain.NotificationDelivery{
+			ID: row.ID,
+			Reminder: domain.Reminder{
+				UserID:      owner.ID,
+				Kind:        domain.NotificationKind(row.Kind),
+				Key:         row.Key,
+				ReferenceID: row.ReferenceID,
+				Title:       row.Title,
+				Body:        row.Body,
+				Payload:     row.Payload,
+			},
+			Channel:    domain.NotificationChannel(row.Channel),
+			Attempts:   row.Attempts,
+			Email:      email,
+			Preference: preference,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	return deliveries, nil
+}
+
+func (p *PostgreSQL) MarkNotificationDelivered(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	err := p.Client.NotificationDelivery.
+		UpdateOneID(id).
+		SetStatus(string(domain.DeliverySent)).
+		SetSentAt(sentAt).
+		AddAttempts(1).
+		ClearLastError().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToUpdate(notificationDeliveryEntity, err)
+	}
+	return nil
+}
+
+// MarkNotificationFailed registra a tentativa que falhou. Com giveUp a entrega deixa de ser
+// tentada; caso contrário volta a ficar pendente.
+func (p *PostgreSQL) MarkNotificationFailed(ctx context.Context, id uuid.UUID, cause string, giveUp bool) error {
+	status := domain.DeliveryPending
+	if giveUp {
+		status = domain.DeliveryFailed
+	}
+
+	update := p.Client.NotificationDelivery.
+		UpdateOneID(id).
+		SetStatus(string(status)).
+		AddAttempts(1).
+		SetLastError(cause)
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToUpdate(notificationDeliveryEntity, err)
+	}
+	return nil
+}
+
+// DeferNotificationDelivery devolve a entrega reservada para a fila, liberada só a partir de
+// notBefore, sem contar como tentativa.
+func (p *PostgreSQL) DeferNotificationDelivery(ctx context.Context, id uuid.UUID, notBefore time.Time) error {
+	err := p.Client.NotificationDelivery.
+		UpdateOneID(id).
+		SetStatus(string(domain.DeliveryPending)).
+		SetNotBefore(notBefore).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToUpdate(notificationDeliveryEntity, err)
+	}
+	return nil
+}
+
+// CreateNotification guarda um aviso na caixa de entrada do app. Um aviso com a mesma chave
+// já guardado para o usuário é mantido, então repetir a entrega não duplica o aviso.
+func (p *PostgreSQL) CreateNotification(ctx context.Context, input domain.Notification) error {
+	var payload []byte
+	if input.Payload != nil {
+		var err error
+		payload, err = json.Marshal(input.Payload)
+		if err != nil {
+			return appError.FailedToSave(notificationEntity, err)
+		}
+	}
+
+	query := `
+		INSERT INTO notifications (id, created_at, updated_at, key, type, title, body, payload, user_id)
+		VALUES ($1, $2, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (key, user_id) DO NOTHING
+	`
+
+	_, err := p.db.ExecContext(ctx, query,
+		uuid.New(),
+		time.Now().UTC(),
+		input.Key,
+		string(input.Type),
+		input.Title,
+		input.Body,
+		payload,
+		input.UserID,
+	)
+	if err != nil {
+		return appError.FailedToSave(notificationEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListNotifications(ctx context.Context, userID uuid.UUID, flt dto.NotificationFilters, pgn *pagination.Pagination) ([]dto.NotificationResponse, error) {
+	query := p.Client.Notification.Query().
+		Where(notification.HasUserWith(user.IDEQ(userID)))
+
+	query = applyNotificationFilters(query, flt)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(notification.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(notification.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(notificationEntity, err)
+	}
+
+	response := make([]dto.NotificationResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newNotificationResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountNotifications(ctx context.Context, userID uuid.UUID, flt dto.NotificationFilters) (int, error) {
+	query := p.Client.Notification.Query().
+		Where(notification.HasUserWith(user.IDEQ(userID)))
+
+	query = applyNotificationFilters(query, flt)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, appError.FailedToFind(notificationEntity, err)
+	}
+	return total, nil
+}
+
+// MarkNotificationRead marca um aviso do usuário como lido. Um aviso já lido mantém a data
+// da primeira leitura.
+func (p *PostgreSQL) MarkNotificationRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, now time.Time) (*dto.NotificationResponse, error) {
+	_, err := p.Client.Notification.Update().
+		Where(notification.IDEQ(id)).
+		Where(notification.HasUserWith(user.IDEQ(userID))).
+		Where(notification.ReadAtIsNil()).
+		SetReadAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, appError.FailedToUpdate(notificationEntity, err)
+	}
+
+	row, err := p.Client.Notification.Query().
+		Where(notification.IDEQ(id)).
+		Where(notification.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(notificationEntity, err)
+	}
+	return newNotificationResponse(row), nil
+}
+
+// MarkAllNotificationsRead marca como lidos todos os avisos não lidos do usuário e retorna
+// quantos foram marcados.
+func (p *PostgreSQL) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID, now time.Time) (int, error) {
+	total, err := p.Client.Notification.Update().
+		Where(notification.HasUserWith(user.IDEQ(userID))).
+		Where(notification.ReadAtIsNil()).
+		SetReadAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, appError.FailedToUpdate(notificationEntity, err)
+	}
+	return total, nil
+}
+
+// RecordImportProgress conta mais uma linha processada da importação, criando o registro na
+// primeira. O incremento é feito no banco porque as linhas chegam em paralelo; a importação é
+// concluída quando a contagem alcança o total. Uma linha de outro usuário com o mesmo job não
+// altera nada e retorna ErrNotFound.
+func (p *PostgreSQL) RecordImportProgress(ctx context.Context, input domain.ImportJob, failed bool, now time.Time) (*domain.ImportJob, error) {
+	failedRows := 0
+	if failed {
+		failedRows = 1
+	}
+
+	query := `
+		INSERT INTO import_jobs (id, created_at, updated_at, filename, total, processed, failed, completed_at, user_id)
+		VALUES ($1, $2, $2, $3, $4, 1, $5, CASE WHEN $4 <= 1 THEN $2::timestamptz END, $6)
+		ON CONFLICT (id) DO UPDATE SET
+			processed = import_jobs.processed + 1,
+			failed = import_jobs.failed + EXCLUDED.failed,
+			completed_at = CASE
+				WHEN import_jobs.processed + 1 >= import_jobs.total THEN COALESCE(import_jobs.completed_at, EXCLUDED.updated_at)
+				ELSE import_jobs.completed_at
+			END,
+			updated_at = EXCLUDED.updated_at
+		WHERE import_jobs.user_id = EXCLUDED.user_id
+		RETURNING id, user_id, filename, total, processed, failed, completed_at
+	`
+
+	job := domain.ImportJob{}
+	err := p.db.QueryRowContext(ctx, query, input.ID, now, input.Filename, input.Total, failedRows, input.UserID).
+		Scan(&job.ID, &job.UserID, &job.Filename, &job.Total, &job.Processed, &job.Failed, &job.CompletedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToSave(importJobEntity, err)
+	}
+	return &job, nil
+}
+
+func applyNotificationFilters(query *ent.NotificationQuery, flt dto.NotificationFilters) *ent.NotificationQuery {
+	if flt.Unread != nil {
+		if *flt.Unread {
+			query = query.Where(notification.ReadAtIsNil())
+		} else {
+			query = query.Where(notification.ReadAtNotNil())
+		}
+	}
+	if flt.Type != nil {
+		query = query.Where(notification.TypeEQ(*flt.Type))
+	}
+	return query
+}
+
+func newNotificationResponse(row *ent.Notification) *dto.NotificationResponse {
+	return &dto.NotificationResponse{
+		ID:        row.ID,
+		Type:      row.Type,
+		Title:     row.Title,
+		Body:      row.Body,
+		Payload:   row.Payload,
+		Read:      row.ReadAt != nil,
+		ReadAt:    utils.ToNillableDateTimeString(row.ReadAt),
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+	}
+}
+
+// notificationPreferences carrega as preferências salvas de todos os usuários.
+func (p *PostgreSQL) notificationPreferences(ctx context.Context) (map[uuid.UUID]domain.NotificationPreference, error) {
+	rows, err := p.Client.NotificationPreference.Query().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(notificationPreferenceEntity, err)
+	}
+
+	preferences := make(map[uuid.UUID]domain.NotificationPreference, len(rows))
+	for _, row := range rows {
+		if row.Edges.User == nil {
+			continue
+		}
+		preferences[row.Edges.User.ID] = toDomainNotificationPreference(row, row.Edges.User.ID)
+	}
+	return preferences, nil
+}
+
+func toDomainNotificationPreference(row *ent.NotificationPreference, userID uuid.UUID) domain.NotificationPreference {
+	return domain.NotificationPreference{
+		UserID:        userID,
+		LeadDays:      row.LeadDays,
+		QuietStart:    row.QuietStart,
+		QuietEnd:      row.QuietEnd,
+		Timezone:      row.Timezone,
+		EmailEnabled:  row.EmailEnabled,
+		WebhookURL:    row.WebhookURL,
+		WebhookSecret: row.WebhookSecret,
+		InAppEnabled:  row.InappEnabled,
+	}
+}
@@ -0,0 +1,461 @@
+package postgresql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+)
+
+const payeeEntity = "payees"
+
+// payeeLinkBatchSize limita quantas transações sem favorecido são processadas por vez.
+const payeeLinkBatchSize = 500
+
+// payeeOrderColumns traduz as colunas de ordenação aceitas na listagem para a consulta de totais.
+var payeeOrderColumns = map[string]string{
+	"id":               "p.id",
+	"name":             "p.name",
+	"created_at":       "p.created_at",
+	"updated_at":       "p.updated_at",
+	"transactions":     "transactions",
+	"income":           "income",
+	"expense":          "expense",
+	"last_record_date": "last_record_date",
+}
+
+// payeeTotalsQuery soma as transações de cada favorecido no período informado ($2 e $3
+// podem ser nulos). Os %s recebem os filtros extras e a ordenação/paginação.
+const payeeTotalsQuery = `
+	SELECT p.id,
+		COUNT(t.id) AS transactions,
+		COALESCE(SUM(CASE WHEN t.record_type = 'income' THEN ROUND(t.amount * t.exchange_rate, 2) ELSE 0 END), 0) AS income,
+		COALESCE(SUM(CASE WHEN t.record_type = 'expense' THEN ROUND(t.amount * t.exchange_rate, 2) ELSE 0 END), 0) AS expense,
+		MAX(t.record_date) AS last_record_date
+	FROM payees AS p
+		LEFT JOIN transactions AS t ON t.payee_id = p.id
+			AND ($2::timestamptz IS NULL OR t.record_date >= $2)
+			AND ($3::timestamptz IS NULL OR t.record_date <= $3)
+	WHERE p.ledger_id = $1
+	%s
+	GROUP BY p.id
+	%s
+`
+
+type payeeTotals struct {
+	transactions   int
+	income         domain.Money
+	expense        domain.Money
+	lastRecordDate *time.Time
+}
+
+func (p *PostgreSQL) GetPayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, flt dto.PayeeFilters) (*dto.PayeeResponse, error) {
+	row, err := p.Client.Payee.Query().
+		Where(payee.IDEQ(id)).
+		Where(payee.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(payeeEntity, err)
+	}
+
+	_, totals, err := p.queryPayeeTotals(ctx, ledgerID, flt, "AND p.id = $4", "", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPayeeResponse(row, totals[row.ID]), nil
+}
+
+func (p *PostgreSQL) CreatePayee(ctx context.Context, ledgerID uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error) {
+	var id uuid.UUID
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		aliases, merged, err := preparePayeeAliases(ctx, client, ledgerID, nil, input)
+		if err != nil {
+			return err
+		}
+
+		row, err := client.Payee.
+			Create().
+			SetLedgerID(ledgerID).
+			SetName(input.Name).
+			SetNormalizedName(input.NormalizedName).
+			SetAliases(aliases).
+			SetNillableDocument(input.Document).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(payeeEntity, err)
+		}
+		id = row.ID
+
+		return mergePayees(ctx, client, row.ID, merged)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetPayeeByID(ctx, ledgerID, id, dto.PayeeFilters{})
+}
+
+// UpdatePayee renomeia o favorecido e redefine seus apelidos. O nome normalizado anterior
+// vira apelido, para que novos títulos continuem caindo no mesmo favorecido.
+func (p *PostgreSQL) UpdatePayee(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		current, err := client.Payee.Query().
+			Where(payee.IDEQ(id)).
+			Where(payee.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToFind(payeeEntity, err)
+		}
+
+		if current.NormalizedName != input.NormalizedName && !slices.Contains(input.Aliases, current.NormalizedName) {
+			input.Aliases = append(input.Aliases, current.NormalizedName)
+		}
+
+		aliases, merged, err := preparePayeeAliases(ctx, client, ledgerID, &id, input)
+		if err != nil {
+			return err
+		}
+
+		update := client.Payee.
+			UpdateOneID(id).
+			SetName(input.Name).
+			SetNormalizedName(input.NormalizedName).
+			SetAliases(aliases)
+
+		if input.Document != nil {
+			update = update.SetDocument(*input.Document)
+		} else {
+			update = update.ClearDocument()
+		}
+
+		err = update.Exec(ctx)
+		if err != nil {
+			return appError.FailedToUpdate(payeeEntity, err)
+		}
+
+		return mergePayees(ctx, client, id, merged)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetPayeeByID(ctx, ledgerID, id, dto.PayeeFilters{})
+}
+
+// DeletePayeeByID remove o favorecido; suas transações ficam sem favorecido.
+func (p *PostgreSQL) DeletePayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Payee.DeleteOneID(id).
+		Where(payee.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(payeeEntity, err)
+	}
+	return nil
+}
+
+// ListPayees lista os favorecidos com os totais do período, permitindo ordenar pelos totais
+// para destacar os estabelecimentos com mais gastos.
+func (p *PostgreSQL) ListPayees(ctx context.Context, ledgerID uuid.UUID, flt dto.PayeeFilters, pgn *pagination.Pagination) ([]dto.PayeeResponse, error) {
+	column, ok := payeeOrderColumns[pgn.OrderBy]
+	if !ok {
+		return nil, appError.InvalidParam("order_by", fmt.Errorf("invalid column: %s", pgn.OrderBy))
+	}
+
+	direction := "DESC"
+	if pgn.OrderDirection == config.OrderAsc {
+		direction = "ASC"
+	}
+
+	where := ""
+	args := []any{}
+	if pgn.Search != "" {
+		where = "AND (p.name ILIKE $4 OR p.normalized_name ILIKE $4)"
+		args = append(args, "%"+pgn.Search+"%")
+	}
+
+	order := fmt.Sprintf(
+		"ORDER BY %s %s NULLS LAST, p.id ASC LIMIT %d OFFSET %d",
+		column, direction, pgn.PageSize, pgn.Offset(),
+	)
+
+	ids, totals, err := p.queryPayeeTotals(ctx, ledgerID, flt, where, order, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.Payee.Query().
+		Where(payee.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(payeeEntity, err)
+	}
+
+	byID := make(map[uuid.UUID]*ent.Payee, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	response := make([]dto.PayeeResponse, 0, len(ids))
+	for _, id := range ids {
+		if row, ok := byID[id]; ok {
+			response = append(response, *newPayeeResponse(row, totals[id]))
+		}
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountPayees(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Payee.Query().
+		Where(payee.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	if pgn.Search != "" {
+		query = query.Where(
+			payee.Or(
+				payee.NameContainsFold(pgn.Search),
+				payee.NormalizedNameContainsFold(pgn.Search),
+			),
+		)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// LinkMissingPayees vincula a um favorecido as transações que ainda não têm um, como as
+// lançadas antes da normalização, percorrendo todas as transações em lotes.
+func (p *PostgreSQL) LinkMissingPayees(ctx context.Context) (int, error) {
+	total := 0
+	var lastID *uuid.UUID
+
+	for {
+		query := p.Client.Transaction.Query().
+			Where(transaction.Not(transaction.HasPayee())).
+			Order(ent.Asc(transaction.FieldID)).
+			Limit(payeeLinkBatchSize).
+			WithLedger()
+		if lastID != nil {
+			query = query.Where(transaction.IDGT(*lastID))
+		}
+
+		rows, err := query.All(ctx)
+		if err != nil {
+			return total, appError.FailedToFind(transactionEntity, err)
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		byPayee := map[uuid.UUID][]uuid.UUID{}
+		for _, row := range rows {
+			lastID = &row.ID
+			if row.Edges.Ledger == nil {
+				continue
+			}
+
+			payeeID, err := hooks.ResolvePayee(ctx, p.Client, row.Edges.Ledger.ID, row.Title)
+			if err != nil {
+				return total, err
+			}
+			if payeeID != nil {
+				byPayee[*payeeID] = append(byPayee[*payeeID], row.ID)
+			}
+		}
+
+		for payeeID, ids := range byPayee {
+			linked, err := p.Client.Transaction.Update().
+				Where(transaction.IDIn(ids...)).
+				SetPayeeID(payeeID).
+				Save(ctx)
+			if err != nil {
+				return total, appError.FailedToUpdate(transactionEntity, err)
+			}
+			total += linked
+		}
+	}
+}
+
+// queryPayeeTotals executa payeeTotalsQuery e retorna os IDs na ordem da consulta junto
+// com os totais de cada favorecido. Os argumentos extras começam em $4.
+func (p *PostgreSQL) queryPayeeTotals(ctx context.Context, ledgerID uuid.UUID, flt dto.PayeeFilters, where string, order string, args ...any) ([]uuid.UUID, map[uuid.UUID]payeeTotals, error) {
+	query := fmt.Sprintf(payeeTotalsQuery, where, order)
+	params := append([]any{ledgerID, utils.ToDateTimeUnsafe(flt.StartDate), utils.ToDateTimeUnsafe(flt.EndDate)}, args...)
+
+	rows, err := p.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, nil, appError.FailedToFind(payeeEntity, err)
+	}
+	defer rows.Close()
+
+	ids := []uuid.UUID{}
+	totals := map[uuid.UUID]payeeTotals{}
+
+	for rows.Next() {
+		var id uuid.UUID
+		var entry payeeTotals
+		var lastRecordDate stdsql.NullTime
+
+		if err := rows.Scan(&id, &entry.transactions, &entry.income, &entry.expense, &lastRecordDate); err != nil {
+			return nil, nil, err
+		}
+		if lastRecordDate.Valid {
+			entry.lastRecordDate = &lastRecordDate.Time
+		}
+
+		ids = append(ids, id)
+		totals[id] = entry
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return ids, totals, nil
+}
+
+// preparePayeeAliases valida o nome e os apelidos contra os demais favorecidos do livro.
+// Favorecidos cujo nome normalizado foi informado como apelido são retornados para serem
+// incorporados, e seus apelidos passam para o favorecido que os recebe.
+func preparePayeeAliases(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, selfID *uuid.UUID, input domain.Payee) ([]string, []uuid.UUID, error) {
+	others := []predicate.Payee{payee.HasLedgerWith(ledger.IDEQ(ledgerID))}
+	if selfID != nil {
+		others = append(others, payee.IDNEQ(*selfID))
+	}
+
+	nameTaken, err := client.Payee.Query().
+		Where(others...).
+		Where(payee.NormalizedNameEQ(input.NormalizedName)).
+		Exist(ctx)
+	if err != nil {
+		return nil, nil, appError.FailedToFind(payeeEntity, err)
+	}
+	if nameTaken {
+		return nil, nil, appError.ErrPayeeConflict
+	}
+
+	aliases := slices.Clone(input.Aliases)
+	merged := []uuid.UUID{}
+
+	if len(input.Aliases) > 0 {
+		rows, err := client.Payee.Query().
+			Where(others...).
+			Where(payee.NormalizedNameIn(input.Aliases...)).
+			All(ctx)
+		if err != nil {
+			return nil, nil, appError.FailedToFind(payeeEntity, err)
+		}
+
+		for _, row := range rows {
+			merged = append(merged, row.ID)
+			for _, alias := range row.Aliases {
+				if alias != input.NormalizedName && !slices.Contains(aliases, alias) {
+					aliases = append(aliases, alias)
+				}
+			}
+		}
+	}
+
+	keys := append([]string{input.NormalizedName}, input.Aliases...)
+	contains := make([]predicate.Payee, 0, len(keys))
+	for _, key := range keys {
+		contains = append(contains, func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(payee.FieldAliases, key))
+		})
+	}
+
+	query := client.Payee.Query().
+		Where(others...).
+		Where(payee.Or(contains...))
+	if len(merged) > 0 {
+		query = query.Where(payee.IDNotIn(merged...))
+	}
+
+	aliasTaken, err := query.Exist(ctx)
+	if err != nil {
+		return nil, nil, appError.FailedToFind(payeeEntity, err)
+	}
+	if aliasTaken {
+		return nil, nil, appError.ErrPayeeConflict
+	}
+
+	return aliases, merged, nil
+}
+
+// mergePayees move as transações dos favorecidos incorporados para o destino e os remove.
+func mergePayees(ctx context.Context, client *ent.Client, targetID uuid.UUID, merged []uuid.UUID) error {
+	if len(merged) == 0 {
+		return nil
+	}
+
+	err := client.Transaction.Update().
+		Where(transaction.HasPayeeWith(payee.IDIn(merged...))).
+		SetPayeeID(targetID).
+		Exec(ctx)
+	if err != nil {
+		return appError.FailedToUpdate(transactionEntity, err)
+	}
+
+	if _, err := client.Payee.Delete().Where(payee.IDIn(merged...)).Exec(ctx); err != nil {
+		return appError.FailedToDelete(payeeEntity, err)
+	}
+	return nil
+}
+
+func newPayeeResponse(row *ent.Payee, totals payeeTotals) *dto.PayeeResponse {
+	response := &dto.PayeeResponse{
+		ID:             row.ID,
+		Name:           row.Name,
+		NormalizedName: row.NormalizedName,
+		Aliases:        row.Aliases,
+		Document:       row.Document,
+		Transactions:   totals.transactions,
+		Income:         totals.income,
+		Expense:        totals.expense,
+		CreatedAt:      utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:      utils.ToDateTimeString(row.UpdatedAt),
+	}
+
+	if response.Aliases == nil {
+		response.Aliases = []string{}
+	}
+
+	if totals.lastRecordDate != nil {
+		date := utils.ToDateTimeString(*totals.lastRecordDate)
+		response.LastRecordDate = &date
+	}
+
+	return response
+}
@@ -1,6 +1,7 @@
 package postgresql
 
 import (
+	"context"
 	stdsql "database/sql"
 	"fmt"
 	"frog-go/internal/adapters/repository/postgresql/hooks"
@@ -15,9 +16,11 @@ import (
 )
 
 type PostgreSQL struct {
-	log    *logger.Logger
-	Client *ent.Client
-	db     *stdsql.DB
+	log         *logger.Logger
+	Client      *ent.Client
+	db          *stdsql.DB
+	categorizer *hooks.Categorizer
+	learner     *hooks.Learner
 }
 
 func NewPostgreSQL(user, password, host, port, database, SeedPath string) (repository.Repository, error) {
@@ -48,14 +51,27 @@ func NewPostgreSQL(user, password, host, port, database, SeedPath string) (repos
 		return nil, err
 	}
 
+	learner := hooks.NewLearner()
+
 	client.Transaction.Use(
-		hooks.SetCategoryFromTitleHook(client, categorizer),
-		hooks.UpdateInvoiceAmountHook(client),
+		hooks.SetCategoryFromTitleHook(client, categorizer, learner),
+		hooks.LearnFromCorrectionsHook(learner),
+		hooks.ValidateCategoryOwnerHook(),
+		hooks.SetExchangeRateHook(),
+		hooks.ValidateInvoiceOpenHook(),
+		hooks.SetPayeeFromTitleHook(),
+		hooks.UpdateInvoiceAmountHook(),
 	)
 
 	log.Start("Host: %s:%s | User: %s | DB: %s", host, port, user, database)
 
-	return &PostgreSQL{Client: client, log: log, db: sqlDB}, nil
+	return &PostgreSQL{
+		Client:      client,
+		log:         log,
+		db:          sqlDB,
+		categorizer: categorizer,
+		learner:     learner,
+	}, nil
 }
 
 func (p *PostgreSQL) Close() {
@@ -65,3 +81,20 @@ func (p *PostgreSQL) Close() {
 		p.log.Info("Database connection closed.")
 	}
 }
+
+// withTx executa fn dentro de uma transação do banco, fazendo rollback em caso de erro.
+func (p *PostgreSQL) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := p.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,259 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+const recurringRuleEntity = "recurring_rules"
+
+func (p *PostgreSQL) GetRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RecurringRuleResponse, error) {
+	row, err := p.Client.RecurringRule.Query().
+		Where(recurringrule.IDEQ(id)).
+		Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(recurringRuleEntity, err)
+	}
+	return newRecurringRuleResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateRecurringRule(ctx context.Context, ledgerID uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error) {
+	currency, err := p.currencyOrBase(ctx, ledgerID, input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var id uuid.UUID
+	err = p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureRecurringRuleLinks(ctx, tx, ledgerID, input); err != nil {
+			return err
+		}
+
+		row, err := tx.RecurringRule.
+			Create().
+			SetLedgerID(ledgerID).
+			SetTitle(input.Title).
+			SetAmount(input.Amount).
+			SetCurrency(currency).
+			SetRecordType(string(input.RecordType)).
+			SetFrequency(string(input.Frequency)).
+			SetStartDate(input.StartDate).
+			SetNillableEndDate(input.EndDate).
+			SetNillableAccountID(input.AccountID).
+			SetNillableCategoryID(input.CategoryID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(recurringRuleEntity, err)
+		}
+		id = row.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetRecurringRuleByID(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) UpdateRecurringRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureRecurringRuleLinks(ctx, tx, ledgerID, input); err != nil {
+			return err
+		}
+
+		update := tx.RecurringRule.
+			UpdateOneID(id).
+			Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			SetTitle(input.Title).
+			SetAmount(input.Amount).
+			SetRecordType(string(input.RecordType)).
+			SetFrequency(string(input.Frequency)).
+			SetStartDate(input.StartDate)
+
+		if input.Currency != "" {
+			update = update.SetCurrency(input.Currency)
+		}
+
+		if input.EndDate != nil {
+			update = update.SetEndDate(*input.EndDate)
+		} else {
+			update = update.ClearEndDate()
+		}
+
+		if input.AccountID != nil {
+			update = update.SetAccountID(*input.AccountID)
+		} else {
+			update = update.ClearAccountID()
+		}
+
+		if input.CategoryID != nil {
+			update = update.SetCategoryID(*input.CategoryID)
+		} else {
+			update = update.ClearCategoryID()
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToUpdate(recurringRuleEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetRecurringRuleByID(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) DeleteRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.RecurringRule.DeleteOneID(id).
+		Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(recurringRuleEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListRecurringRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RecurringRuleResponse, error) {
+	query := p.Client.RecurringRule.Query().
+		Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithCategory()
+
+	query = applyRecurringRuleFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(recurringrule.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(recurringrule.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.RecurringRuleResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newRecurringRuleResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountRecurringRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.RecurringRule.Query().
+		Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyRecurringRuleFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// ensureLedgerRecurringRule garante que a regra recorrente informada pertence ao livro.
+func ensureLedgerRecurringRule(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, id *uuid.UUID) error {
+	if id == nil {
+		return nil
+	}
+
+	exists, err := client.RecurringRule.Query().
+		Where(recurringrule.IDEQ(*id)).
+		Where(recurringrule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exist(ctx)
+	if err != nil {
+		return appError.FailedToFind(recurringRuleEntity, err)
+	}
+	if !exists {
+		return appError.InvalidParam("recurring_rule_id", appError.ErrRecurringRuleNotFound)
+	}
+	return nil
+}
+
+// ensureRecurringRuleLinks garante que a conta e a categoria da regra pertencem ao livro.
+func ensureRecurringRuleLinks(ctx context.Context, tx *ent.Tx, ledgerID uuid.UUID, input domain.RecurringRule) error {
+	if err := ensureLedgerAccount(ctx, tx, ledgerID, input.AccountID); err != nil {
+		return err
+	}
+
+	if input.CategoryID != nil {
+		if err := ensureLedgerCategories(ctx, tx.Client(), ledgerID, []uuid.UUID{*input.CategoryID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyRecurringRuleFilters(query *ent.RecurringRuleQuery, pgn *pagination.Pagination) *ent.RecurringRuleQuery {
+	if pgn.Search != "" {
+		query = query.Where(recurringrule.TitleContainsFold(pgn.Search))
+	}
+	return query
+}
+
+func newRecurringRuleDomain(row *ent.RecurringRule) domain.RecurringRule {
+	return domain.RecurringRule{
+		ID:         row.ID,
+		Title:      row.Title,
+		Amount:     row.Amount,
+		Currency:   row.Currency,
+		RecordType: domain.RecordType(row.RecordType),
+		Frequency:  domain.RecurrenceFrequency(row.Frequency),
+		StartDate:  row.StartDate,
+		EndDate:    row.EndDate,
+		AccountID:  row.AccountID,
+		CategoryID: row.CategoryID,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
+
+func newRecurringRuleResponse(row *ent.RecurringRule) *dto.RecurringRuleResponse {
+	response := &dto.RecurringRuleResponse{
+		ID:         row.ID,
+		Title:      row.Title,
+		Amount:     row.Amount,
+		Currency:   row.Currency,
+		RecordType: row.RecordType,
+		Frequency:  row.Frequency,
+		StartDate:  utils.ToDateTimeString(row.StartDate),
+		EndDate:    utils.ToNillableDateTimeString(row.EndDate),
+		AccountID:  row.AccountID,
+		CreatedAt:  utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:  utils.ToDateTimeString(row.UpdatedAt),
+	}
+
+	if row.Edges.Category != nil {
+		response.Category = &dto.TransactionCategoryResponse{
+			ID:   row.Edges.Category.ID,
+			Name: row.Edges.Category.Name,
+		}
+	}
+	return response
+}
@@ -0,0 +1,254 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/rule"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+const ruleEntity = "rules"
+
+func (p *PostgreSQL) GetRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RuleResponse, error) {
+	row, err := p.Client.Rule.Query().
+		Where(rule.IDEQ(id)).
+		Where(rule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(ruleEntity, err)
+	}
+	return newRuleResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateRule(ctx context.Context, ledgerID uuid.UUID, input domain.Rule) (*dto.RuleResponse, error) {
+	if err := p.ensureRuleReferences(ctx, ledgerID, input); err != nil {
+		return nil, err
+	}
+
+	row, err := p.Client.Rule.
+		Create().
+		SetLedgerID(ledgerID).
+		SetName(input.Name).
+		SetPriority(input.Priority).
+		SetEnabled(input.Enabled).
+		SetNillableTitleContains(input.Conditions.TitleContains).
+		SetNillableTitleRegex(input.Conditions.TitleRegex).
+		SetNillableAmountMin(input.Conditions.AmountMin).
+		SetNillableAmountMax(input.Conditions.AmountMax).
+		SetNillableRecordType((*string)(input.Conditions.RecordType)).
+		SetNillableInvoiceID(input.Conditions.InvoiceID).
+		SetNillableCategoryID(input.Actions.CategoryID).
+		SetNillableActionRecordType((*string)(input.Actions.RecordType)).
+		SetNillableActionStatus((*string)(input.Actions.Status)).
+		SetSkip(input.Actions.Skip).
+		Save(ctx)
+
+	if err != nil {
+		return nil, appError.FailedToSave(ruleEntity, err)
+	}
+
+	return newRuleResponse(row), nil
+}
+
+func (p *PostgreSQL) UpdateRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Rule) (*dto.RuleResponse, error) {
+	if err := p.ensureRuleReferences(ctx, ledgerID, input); err != nil {
+		return nil, err
+	}
+
+	update := p.Client.Rule.
+		UpdateOneID(id).
+		Where(rule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		SetName(input.Name).
+		SetPriority(input.Priority).
+		SetEnabled(input.Enabled).
+		SetSkip(input.Actions.Skip)
+
+	// PUT substitui a regra inteira, então condições e ações ausentes são limpas
+	c, a := input.Conditions, input.Actions
+
+	if c.TitleContains != nil {
+		update = update.SetTitleContains(*c.TitleContains)
+	} else {
+		update = update.ClearTitleContains()
+	}
+	if c.TitleRegex != nil {
+		update = update.SetTitleRegex(*c.TitleRegex)
+	} else {
+		update = update.ClearTitleRegex()
+	}
+	if c.AmountMin != nil {
+		update = update.SetAmountMin(*c.AmountMin)
+	} else {
+		update = update.ClearAmountMin()
+	}
+	if c.AmountMax != nil {
+		update = update.SetAmountMax(*c.AmountMax)
+	} else {
+		update = update.ClearAmountMax()
+	}
+	if c.RecordType != nil {
+		update = update.SetRecordType(string(*c.RecordType))
+	} else {
+		update = update.ClearRecordType()
+	}
+	if c.InvoiceID != nil {
+		update = update.SetInvoiceID(*c.InvoiceID)
+	} else {
+		update = update.ClearInvoiceID()
+	}
+	if a.CategoryID != nil {
+		update = update.SetCategoryID(*a.CategoryID)
+	} else {
+		update = update.ClearCategoryID()
+	}
+	if a.RecordType != nil {
+		update = update.SetActionRecordType(string(*a.RecordType))
+	} else {
+		update = update.ClearActionRecordType()
+	}
+	if a.Status != nil {
+		update = update.SetActionStatus(string(*a.Status))
+	} else {
+		update = update.ClearActionStatus()
+	}
+
+	row, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToUpdate(ruleEntity, err)
+	}
+
+	return newRuleResponse(row), nil
+}
+
+func (p *PostgreSQL) DeleteRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Rule.DeleteOneID(id).
+		Where(rule.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(ruleEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RuleResponse, error) {
+	query := p.Client.Rule.Query().
+		Where(rule.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyRuleFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(rule.FieldCreatedAt))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(rule.FieldCreatedAt))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.RuleResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newRuleResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Rule.Query().
+		Where(rule.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyRuleFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// ensureRuleReferences garante que a fatura e a categoria citadas na regra são do livro.
+func (p *PostgreSQL) ensureRuleReferences(ctx context.Context, ledgerID uuid.UUID, input domain.Rule) error {
+	if id := input.Conditions.InvoiceID; id != nil {
+		exists, err := p.Client.Invoice.Query().
+			Where(invoice.IDEQ(*id)).
+			Where(invoice.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind("invoice", err)
+		}
+		if !exists {
+			return appError.InvalidParam("conditions.invoice_id", appError.ErrInvoiceNotFound)
+		}
+	}
+
+	if id := input.Actions.CategoryID; id != nil {
+		exists, err := p.Client.Category.Query().
+			Where(category.IDEQ(*id)).
+			Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind(categoryEntity, err)
+		}
+		if !exists {
+			return appError.InvalidParam("actions.category_id", appError.ErrCategoryNotFound)
+		}
+	}
+
+	return nil
+}
+
+func newRuleResponse(row *ent.Rule) *dto.RuleResponse {
+	return &dto.RuleResponse{
+		ID:       row.ID,
+		Name:     row.Name,
+		Priority: row.Priority,
+		Enabled:  row.Enabled,
+		Conditions: dto.RuleConditionsResponse{
+			TitleContains: row.TitleContains,
+			TitleRegex:    row.TitleRegex,
+			AmountMin:     row.AmountMin,
+			AmountMax:     row.AmountMax,
+			RecordType:    row.RecordType,
+			InvoiceID:     row.InvoiceID,
+		},
+		Actions: dto.RuleActionsResponse{
+			CategoryID: row.CategoryID,
+			RecordType: row.ActionRecordType,
+			Status:     row.ActionStatus,
+			Skip:       row.Skip,
+		},
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+func applyRuleFilters(query *ent.RuleQuery, pgn *pagination.Pagination) *ent.RuleQuery {
+	if pgn.Search != "" {
+		query = query.Where(rule.NameContainsFold(pgn.Search))
+	}
+	return query
+}
@@ -0,0 +1,261 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/settlement"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/user"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+const settlementEntity = "settlements"
+
+// CreateSettlements registra pagamentos entre membros do livro.
+func (p *PostgreSQL) CreateSettlements(ctx context.Context, ledgerID uuid.UUID, inputs []domain.Settlement) ([]dto.SettlementResponse, error) {
+	if len(inputs) == 0 {
+		return []dto.SettlementResponse{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(inputs))
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		userIDs := []uuid.UUID{}
+		for _, input := range inputs {
+			userIDs = append(userIDs, input.FromUserID, input.ToUserID)
+		}
+		if err := ensureLedgerUsers(ctx, client, ledgerID, userIDs); err != nil {
+			return err
+		}
+
+		builders := make([]*ent.SettlementCreate, 0, len(inputs))
+		for _, input := range inputs {
+			builders = append(builders, client.Settlement.
+				Create().
+				SetLedgerID(ledgerID).
+				SetFromUserID(input.FromUserID).
+				SetToUserID(input.ToUserID).
+				SetAmount(input.Amount).
+				SetSettledAt(input.SettledAt).
+				SetNillableNote(input.Note))
+		}
+
+		rows, err := client.Settlement.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(settlementEntity, err)
+		}
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.Settlement.Query().
+		Where(settlement.IDIn(ids...)).
+		WithFromUser().
+		WithToUser().
+		Order(ent.Asc(settlement.FieldCreatedAt), ent.Asc(settlement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(settlementEntity, err)
+	}
+	return newSettlementResponses(rows), nil
+}
+
+func (p *PostgreSQL) DeleteSettlementByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Settlement.DeleteOneID(id).
+		Where(settlement.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(settlementEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListSettlements(ctx context.Context, ledgerID uuid.UUID, flt dto.SettlementFilters, pgn *pagination.Pagination) ([]dto.SettlementResponse, error) {
+	query := p.Client.Settlement.Query().
+		Where(settlement.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithFromUser().
+		WithToUser()
+
+	query = applySettlementFilters(query, flt)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(settlement.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(settlement.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(settlementEntity, err)
+	}
+	return newSettlementResponses(rows), nil
+}
+
+func (p *PostgreSQL) CountSettlements(ctx context.Context, ledgerID uuid.UUID, flt dto.SettlementFilters) (int, error) {
+	query := p.Client.Settlement.Query().
+		Where(settlement.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applySettlementFilters(query, flt)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, appError.FailedToFind(settlementEntity, err)
+	}
+	return total, nil
+}
+
+// SharedBalances calcula, na moeda base, quanto cada membro pagou e consumiu nas despesas
+// divididas não canceladas, somando os acertos já registrados, e sugere o menor conjunto de
+// pagamentos que zera os saldos.
+func (p *PostgreSQL) SharedBalances(ctx context.Context, ledgerID uuid.UUID) (*dto.SharedBalancesResponse, error) {
+	currency, err := p.baseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := p.Client.Transaction.Query().
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(transaction.ShareModeNotNil()).
+		Where(transaction.PaidByIDNotNil()).
+		Where(transaction.RecordTypeEQ(string(domain.TypeExpense))).
+		Where(transaction.StatusNEQ(string(domain.StatusCanceled))).
+		WithParticipants().
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+
+	settlements, err := p.Client.Settlement.Query().
+		Where(settlement.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(settlementEntity, err)
+	}
+
+	memberIDs, err := ledgerUserIDs(ctx, p.Client, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := map[uuid.UUID]*dto.SharedBalanceResponse{}
+	balanceOf := func(id uuid.UUID) *dto.SharedBalanceResponse {
+		if balances[id] == nil {
+			balances[id] = &dto.SharedBalanceResponse{User: dto.LedgerUserResponse{ID: id}}
+		}
+		return balances[id]
+	}
+
+	for _, id := range memberIDs {
+		balanceOf(id)
+	}
+
+	for _, row := range transactions {
+		for _, participant := range row.Edges.Participants {
+			share := participant.Amount.Convert(row.ExchangeRate)
+			balanceOf(*row.PaidByID).Paid += share
+			balanceOf(participant.UserID).Share += share
+		}
+	}
+
+	for _, row := range settlements {
+		balanceOf(row.FromUserID).Sent += row.Amount
+		balanceOf(row.ToUserID).Received += row.Amount
+	}
+
+	ids := make([]uuid.UUID, 0, len(balances))
+	net := map[uuid.UUID]domain.Money{}
+	for id, balance := range balances {
+		balance.Net = balance.Paid - balance.Share + balance.Sent - balance.Received
+		net[id] = balance.Net
+		ids = append(ids, id)
+	}
+
+	users, err := p.Client.User.Query().
+		Where(user.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(userEntity, err)
+	}
+
+	usersByID := map[uuid.UUID]dto.LedgerUserResponse{}
+	for _, row := range users {
+		usersByID[row.ID] = newLedgerUserResponse(row)
+		balances[row.ID].User = usersByID[row.ID]
+	}
+
+	response := &dto.SharedBalancesResponse{
+		Currency: currency,
+		Balances: make([]dto.SharedBalanceResponse, 0, len(balances)),
+		Payments: []dto.SettlementPaymentResponse{},
+	}
+
+	for _, balance := range balances {
+		response.Balances = append(response.Balances, *balance)
+	}
+	sort.Slice(response.Balances, func(i, j int) bool {
+		if response.Balances[i].Net != response.Balances[j].Net {
+			return response.Balances[i].Net > response.Balances[j].Net
+		}
+		return response.Balances[i].User.Username < response.Balances[j].User.Username
+	})
+
+	for _, debt := range domain.SimplifyDebts(net) {
+		response.Payments = append(response.Payments, dto.SettlementPaymentResponse{
+			From:   usersByID[debt.FromUserID],
+			To:     usersByID[debt.ToUserID],
+			Amount: debt.Amount,
+		})
+	}
+
+	return response, nil
+}
+
+func applySettlementFilters(query *ent.SettlementQuery, flt dto.SettlementFilters) *ent.SettlementQuery {
+	if flt.UserID != nil && *flt.UserID != "" {
+		if id, err := uuid.Parse(*flt.UserID); err == nil {
+			query = query.Where(settlement.Or(
+				settlement.FromUserIDEQ(id),
+				settlement.ToUserIDEQ(id),
+			))
+		}
+	}
+
+	return query
+}
+
+func newSettlementResponses(rows []*ent.Settlement) []dto.SettlementResponse {
+	response := make([]dto.SettlementResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, dto.SettlementResponse{
+			ID:        row.ID,
+			From:      newLedgerUserResponse(row.Edges.FromUser),
+			To:        newLedgerUserResponse(row.Edges.ToUser),
+			Amount:    row.Amount,
+			SettledAt: utils.ToDateTimeString(row.SettledAt),
+			Note:      row.Note,
+			CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		})
+	}
+	return response
+}
@@ -0,0 +1,161 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/tag"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+const tagEntity = "tags"
+
+func (p *PostgreSQL) GetTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TagResponse, error) {
+	row, err := p.Client.Tag.Query().
+		Where(tag.IDEQ(id)).
+		Where(tag.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(tagEntity, err)
+	}
+	return newTagResponse(row), nil
+}
+
+func (p *PostgreSQL) CreateTag(ctx context.Context, ledgerID uuid.UUID, input domain.Tag) (*dto.TagResponse, error) {
+	row, err := p.Client.Tag.
+		Create().
+		SetLedgerID(ledgerID).
+		SetName(input.Name).
+		SetNillableColor(input.Color).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, appError.ErrTagConflict
+		}
+		return nil, appError.FailedToSave(tagEntity, err)
+	}
+
+	return newTagResponse(row), nil
+}
+
+func (p *PostgreSQL) UpdateTag(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Tag) (*dto.TagResponse, error) {
+	row, err := p.Client.Tag.
+		UpdateOneID(id).
+		Where(tag.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		SetName(input.Name).
+		SetNillableColor(input.Color).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, appError.ErrTagConflict
+		}
+		return nil, appError.FailedToUpdate(tagEntity, err)
+	}
+
+	return newTagResponse(row), nil
+}
+
+func (p *PostgreSQL) DeleteTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	err := p.Client.Tag.DeleteOneID(id).
+		Where(tag.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToDelete(tagEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) ListTags(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.TagResponse, error) {
+	query := p.Client.Tag.Query().
+		Where(tag.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyTagFilters(query, pgn)
+
+	if pgn.OrderDirection == config.OrderAsc {
+		query = query.Order(ent.Asc(pgn.OrderBy), ent.Asc(tag.FieldID))
+	} else {
+		query = query.Order(ent.Desc(pgn.OrderBy), ent.Asc(tag.FieldID))
+	}
+
+	query = query.Limit(pgn.PageSize).Offset(pgn.Offset())
+
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.TagResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newTagResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) CountTags(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error) {
+	query := p.Client.Tag.Query().
+		Where(tag.HasLedgerWith(ledger.IDEQ(ledgerID)))
+
+	query = applyTagFilters(query, pgn)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func newTagResponse(row *ent.Tag) *dto.TagResponse {
+	return &dto.TagResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		Color:     row.Color,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
+
+// ensureLedgerTags garante que todas as tags informadas pertencem ao livro.
+func ensureLedgerTags(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	total, err := client.Tag.Query().
+		Where(tag.IDIn(ids...)).
+		Where(tag.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Count(ctx)
+	if err != nil {
+		return appError.FailedToFind(tagEntity, err)
+	}
+	if total != len(ids) {
+		return appError.InvalidParam("tag_ids", appError.ErrTagNotFound)
+	}
+	return nil
+}
+
+func applyTagFilters(query *ent.TagQuery, pgn *pagination.Pagination) *ent.TagQuery {
+	if pgn.Search != "" {
+		query = query.Where(tag.NameContainsFold(pgn.Search))
+	}
+	return query
+}
@@ -0,0 +1,219 @@
+package postgresql
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaxReport monta o relatório do imposto de renda do ano com os lançamentos pagos, na moeda
+// base. O grupo fiscal de cada lançamento vem da categoria (ou da divisão), herdado do
+// ancestral mais próximo quando a categoria não tem um.
+func (p *PostgreSQL) TaxReport(ctx context.Context, ledgerID uuid.UUID, year int) (*dto.TaxReportResponse, error) {
+	base, err := p.baseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := p.Client.Category.Query().
+		Where(category.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	tree := make(domain.CategoryTree, len(categories))
+	groups := map[uuid.UUID]domain.TaxGroup{}
+	categoryNames := map[uuid.UUID]string{}
+	for _, row := range categories {
+		tree[row.ID] = row.ParentID
+		categoryNames[row.ID] = row.Name
+		if row.TaxGroup != nil {
+			groups[row.ID] = domain.TaxGroup(*row.TaxGroup)
+		}
+	}
+
+	payees, err := p.Client.Payee.Query().
+		Where(payee.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(payeeEntity, err)
+	}
+
+	type payeeInfo struct {
+		name     string
+		document *string
+	}
+	payeeInfos := map[uuid.UUID]payeeInfo{}
+	for _, row := range payees {
+		info := payeeInfo{name: row.Name}
+		if row.Document != nil {
+			document := domain.FormatTaxDocument(*row.Document)
+			info.document = &document
+		}
+		payeeInfos[row.ID] = info
+	}
+
+	query := fmt.Sprintf(`
+		SELECT t.record_type, t.category_id, t.payee_id, SUM(t.amount) AS total
+		FROM (%s) AS t
+		WHERE t.status = $2
+		AND t.record_date >= $3
+		AND t.record_date < $4
+		GROUP BY t.record_type, t.category_id, t.payee_id
+	`, transactionLinesSQL("$1"))
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	rows, err := p.db.QueryContext(ctx, query, ledgerID, string(domain.StatusPaid), start, start.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+	defer rows.Close()
+
+	response := &dto.TaxReportResponse{
+		Year:       year,
+		Currency:   base,
+		Deductions: []dto.TaxGroupTotalResponse{},
+		Sources:    []dto.TaxIncomeSourceResponse{},
+		Payments:   []dto.TaxPaymentResponse{},
+	}
+
+	type paymentKey struct {
+		group   domain.TaxGroup
+		payeeID uuid.UUID
+	}
+	sources := map[uuid.UUID]*dto.TaxIncomeSourceResponse{}
+	payments := map[paymentKey]*dto.TaxPaymentResponse{}
+	groupTotals := map[domain.TaxGroup]map[uuid.UUID]domain.Money{}
+
+	newPayee := func(payeeID uuid.NullUUID) (*uuid.UUID, *string, *string) {
+		if !payeeID.Valid {
+			return nil, nil, nil
+		}
+		id := payeeID.UUID
+		info, ok := payeeInfos[id]
+		if !ok {
+			return &id, nil, nil
+		}
+		return &id, &info.name, info.document
+	}
+
+	sourceOf := func(payeeID uuid.NullUUID) *dto.TaxIncomeSourceResponse {
+		source, ok := sources[payeeID.UUID]
+		if !ok {
+			source = &dto.TaxIncomeSourceResponse{}
+			source.PayeeID, source.Name, source.Document = newPayee(payeeID)
+			sources[payeeID.UUID] = source
+		}
+		return source
+	}
+
+	for rows.Next() {
+		var recordType string
+		var categoryID, payeeID uuid.NullUUID
+		var total domain.Money
+
+		if err := rows.Scan(&recordType, &categoryID, &payeeID, &total); err != nil {
+			return nil, err
+		}
+
+		var group domain.TaxGroup
+		if categoryID.Valid {
+			group, _ = tree.TaxGroupOf(categoryID.UUID, groups)
+		}
+
+		switch domain.RecordType(recordType) {
+		case domain.TypeTax:
+			sourceOf(payeeID).WithheldTax += total
+			response.WithheldTax += total
+
+		case domain.TypeIncome:
+			if group == domain.TaxExemptIncome {
+				sourceOf(payeeID).ExemptIncome += total
+				response.ExemptIncome += total
+			} else {
+				sourceOf(payeeID).TaxableIncome += total
+				response.TaxableIncome += total
+			}
+
+		case domain.TypeExpense:
+			if !group.IsDeductible() {
+				continue
+			}
+
+			if groupTotals[group] == nil {
+				groupTotals[group] = map[uuid.UUID]domain.Money{}
+			}
+			groupTotals[group][categoryID.UUID] += total
+			response.DeductibleTotal += total
+
+			key := paymentKey{group: group, payeeID: payeeID.UUID}
+			payment, ok := payments[key]
+			if !ok {
+				payment = &dto.TaxPaymentResponse{Group: string(group), Label: group.Label()}
+				payment.PayeeID, payment.Name, payment.Document = newPayee(payeeID)
+				payments[key] = payment
+			}
+			payment.Total += total
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, value := range domain.ValidTaxGroup() {
+		group := domain.TaxGroup(value)
+		totals, ok := groupTotals[group]
+		if !ok {
+			continue
+		}
+
+		item := dto.TaxGroupTotalResponse{
+			Group:      value,
+			Label:      group.Label(),
+			Categories: []dto.TaxCategoryTotalResponse{},
+		}
+		for id, total := range totals {
+			item.Total += total
+			item.Categories = append(item.Categories, dto.TaxCategoryTotalResponse{
+				CategoryID: id,
+				Name:       categoryNames[id],
+				Total:      total,
+			})
+		}
+		slices.SortFunc(item.Categories, func(a, b dto.TaxCategoryTotalResponse) int {
+			return cmp.Compare(b.Total, a.Total)
+		})
+
+		response.Deductions = append(response.Deductions, item)
+	}
+
+	for _, source := range sources {
+		response.Sources = append(response.Sources, *source)
+	}
+	slices.SortFunc(response.Sources, func(a, b dto.TaxIncomeSourceResponse) int {
+		return cmp.Compare(b.TaxableIncome+b.ExemptIncome, a.TaxableIncome+a.ExemptIncome)
+	})
+
+	for _, payment := range payments {
+		response.Payments = append(response.Payments, *payment)
+	}
+	slices.SortFunc(response.Payments, func(a, b dto.TaxPaymentResponse) int {
+		if a.Group != b.Group {
+			return slices.Index(domain.ValidTaxGroup(), a.Group) - slices.Index(domain.ValidTaxGroup(), b.Group)
+		}
+		return cmp.Compare(b.Total, a.Total)
+	})
+
+	return response, nil
+}
@@ -0,0 +1,254 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgermember"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const transactionParticipantEntity = "transaction participants"
+
+// SetTransactionParticipants divide a despesa entre membros do livro, substituindo a divisão
+// anterior. As partes ficam na moeda da transação.
+func (p *PostgreSQL) SetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.ExpenseSharing) (*dto.TransactionParticipantsResponse, error) {
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		row, err := findLedgerTransaction(ctx, client, ledgerID, id)
+		if err != nil {
+			return err
+		}
+		if row.RecordType != string(domain.TypeExpense) {
+			return appError.ErrShareNotExpense
+		}
+
+		userIDs := []uuid.UUID{input.PaidByID}
+		for _, participant := range input.Participants {
+			userIDs = append(userIDs, participant.UserID)
+		}
+		if err := ensureLedgerUsers(ctx, client, ledgerID, userIDs); err != nil {
+			return err
+		}
+
+		participants, err := input.Allocate(row.Amount)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteTransactionParticipants(ctx, client, id); err != nil {
+			return err
+		}
+
+		builders := make([]*ent.TransactionParticipantCreate, 0, len(participants))
+		for _, participant := range participants {
+			builders = append(builders, client.TransactionParticipant.
+				Create().
+				SetTransactionID(id).
+				SetUserID(participant.UserID).
+				SetAmount(participant.Amount).
+				SetNillablePercentage(participant.Percentage))
+		}
+		if err := client.TransactionParticipant.CreateBulk(builders...).Exec(ctx); err != nil {
+			return appError.FailedToSave(transactionParticipantEntity, err)
+		}
+
+		err = client.Transaction.
+			UpdateOneID(id).
+			SetPaidByID(input.PaidByID).
+			SetShareMode(string(input.Mode)).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToUpdate(transactionEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetTransactionParticipants(ctx, ledgerID, id)
+}
+
+func (p *PostgreSQL) GetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionParticipantsResponse, error) {
+	row, err := p.Client.Transaction.Query().
+		Where(transaction.IDEQ(id)).
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		WithPaidBy().
+		WithParticipants(func(q *ent.TransactionParticipantQuery) {
+			q.WithUser().Order(ent.Asc(transactionparticipant.FieldCreatedAt), ent.Asc(transactionparticipant.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+
+	return newTransactionParticipantsResponse(row), nil
+}
+
+// DeleteTransactionParticipants desfaz a divisão; a despesa deixa de ser dividida entre os
+// membros do livro.
+func (p *PostgreSQL) DeleteTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return p.withTx(ctx, func(tx *ent.Tx) error {
+		client := tx.Client()
+
+		if _, err := findLedgerTransaction(ctx, client, ledgerID, id); err != nil {
+			return err
+		}
+		return clearTransactionParticipants(ctx, client, id)
+	})
+}
+
+// reallocateTransactionParticipants refaz a divisão depois que a transação muda: partes
+// iguais e percentuais acompanham o novo valor, partes exatas precisam continuar somando o
+// total e transações que deixam de ser despesa perdem a divisão.
+func reallocateTransactionParticipants(ctx context.Context, client *ent.Client, row *ent.Transaction) error {
+	if row.ShareMode == nil {
+		return nil
+	}
+
+	current, err := client.TransactionParticipant.Query().
+		Where(transactionparticipant.TransactionIDEQ(row.ID)).
+		Order(ent.Asc(transactionparticipant.FieldCreatedAt), ent.Asc(transactionparticipant.FieldID)).
+		All(ctx)
+	if err != nil {
+		return appError.FailedToFind(transactionParticipantEntity, err)
+	}
+
+	if row.RecordType != string(domain.TypeExpense) || len(current) == 0 {
+		return clearTransactionParticipants(ctx, client, row.ID)
+	}
+
+	sharing := domain.ExpenseSharing{Mode: domain.ShareMode(*row.ShareMode)}
+	for _, participant := range current {
+		sharing.Participants = append(sharing.Participants, domain.ExpenseParticipant{
+			UserID:     participant.UserID,
+			Percentage: participant.Percentage,
+			Amount:     participant.Amount,
+		})
+	}
+
+	participants, err := sharing.Allocate(row.Amount)
+	if err != nil {
+		return err
+	}
+
+	for i, participant := range participants {
+		if participant.Amount == current[i].Amount {
+			continue
+		}
+		err := client.TransactionParticipant.
+			UpdateOne(current[i]).
+			SetAmount(participant.Amount).
+			Exec(ctx)
+		if err != nil {
+			return appError.FailedToUpdate(transactionParticipantEntity, err)
+		}
+	}
+	return nil
+}
+
+func clearTransactionParticipants(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if err := deleteTransactionParticipants(ctx, client, id); err != nil {
+		return err
+	}
+
+	err := client.Transaction.
+		UpdateOneID(id).
+		ClearPaidByID().
+		ClearShareMode().
+		Exec(ctx)
+	if err != nil {
+		return appError.FailedToUpdate(transactionEntity, err)
+	}
+	return nil
+}
+
+func deleteTransactionParticipants(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	_, err := client.TransactionParticipant.Delete().
+		Where(transactionparticipant.TransactionIDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		return appError.FailedToDelete(transactionParticipantEntity, err)
+	}
+	return nil
+}
+
+func findLedgerTransaction(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, id uuid.UUID) (*ent.Transaction, error) {
+	row, err := client.Transaction.Query().
+		Where(transaction.IDEQ(id)).
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(transactionEntity, err)
+	}
+	return row, nil
+}
+
+// ledgerUserIDs retorna os membros do livro, que são quem pode participar das despesas dele.
+func ledgerUserIDs(ctx context.Context, client *ent.Client, ledgerID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := client.LedgerMember.Query().
+		Where(ledgermember.LedgerIDEQ(ledgerID)).
+		Order(ent.Asc(ledgermember.FieldCreatedAt), ent.Asc(ledgermember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(ledgerMemberEntity, err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.UserID)
+	}
+	return ids, nil
+}
+
+func ensureLedgerUsers(ctx context.Context, client *ent.Client, ledgerID uuid.UUID, ids []uuid.UUID) error {
+	members, err := ledgerUserIDs(ctx, client, ledgerID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if !slices.Contains(members, id) {
+			return appError.ErrParticipantNotMember
+		}
+	}
+	return nil
+}
+
+func newTransactionParticipantsResponse(row *ent.Transaction) *dto.TransactionParticipantsResponse {
+	response := &dto.TransactionParticipantsResponse{
+		TransactionID: row.ID,
+		Currency:      row.Currency,
+		Mode:          row.ShareMode,
+		Participants:  make([]dto.TransactionParticipantResponse, 0, len(row.Edges.Participants)),
+	}
+
+	if row.Edges.PaidBy != nil {
+		paidBy := newLedgerUserResponse(row.Edges.PaidBy)
+		response.PaidBy = &paidBy
+	}
+
+	for _, participant := range row.Edges.Participants {
+		response.Participants = append(response.Participants, dto.TransactionParticipantResponse{
+			User:       newLedgerUserResponse(participant.Edges.User),
+			Amount:     participant.Amount,
+			Percentage: participant.Percentage,
+		})
+	}
+	return response
+}
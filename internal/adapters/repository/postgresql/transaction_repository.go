@@ -1,16 +1,22 @@
 package postgresql
 
 import (
+	stdsql "database/sql"
 	"fmt"
 	"frog-go/internal/config"
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/ent"
+	"frog-go/internal/ent/account"
 	entCategory "frog-go/internal/ent/category"
 	entInvoice "frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	entPayee "frog-go/internal/ent/payee"
+	"frog-go/internal/ent/predicate"
+	entTag "frog-go/internal/ent/tag"
 	"frog-go/internal/ent/transaction"
-	"frog-go/internal/ent/user"
+	"frog-go/internal/ent/transactionsplit"
 	"frog-go/internal/utils"
 	"sort"
 	"time"
@@ -24,11 +30,14 @@ import (
 
 const transactionEntity = "transactions"
 
-func (p *PostgreSQL) GetTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error) {
+func (p *PostgreSQL) GetTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error) {
 	row, err := p.Client.Transaction.Query().
 		Where(transaction.IDEQ(id)).
-		Where(transaction.HasUserWith(user.IDEQ(userID))).
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
 		WithCategory().
+		WithPayee().
+		WithTags().
+		WithSplits(withSplitCategory).
 		Only(ctx)
 
 	if err != nil {
@@ -40,9 +49,9 @@ func (p *PostgreSQL) GetTransactionByID(ctx context.Context, userID uuid.UUID, i
 	return newTransactionResponse(row)
 }
 
-func (p *PostgreSQL) DeleteTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+func (p *PostgreSQL) DeleteTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
 	err := p.Client.Transaction.DeleteOneID(id).
-		Where(transaction.HasUserWith(user.IDEQ(userID))).
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
 		Exec(ctx)
 
 	if err != nil {
@@ -54,59 +63,187 @@ func (p *PostgreSQL) DeleteTransactionByID(ctx context.Context, userID uuid.UUID
 	return nil
 }
 
-func (p *PostgreSQL) CreateTransaction(ctx context.Context, userID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
-	created, err := p.Client.Transaction.
-		Create().
-		SetUserID(userID).
-		SetTitle(input.Title).
-		SetAmount(input.Amount).
-		SetRecordType(string(input.RecordType)).
-		SetStatus(string(input.Status)).
-		SetRecordDate(input.RecordDate).
-		SetNillableCategoryID(input.CategoryID).
-		SetNillableInvoiceID(input.InvoiceID).
-		Save(ctx)
+func (p *PostgreSQL) CreateTransaction(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
+	if err := ensureLedgerTags(ctx, p.Client, ledgerID, input.TagIDs); err != nil {
+		return nil, err
+	}
+
+	if err := ensureLedgerCategories(ctx, p.Client, ledgerID, splitCategoryIDs(input.Splits)); err != nil {
+		return nil, appError.InvalidParam("splits.category_id", err)
+	}
+
+	if err := ensureLedgerInvoice(ctx, p.Client, ledgerID, input.InvoiceID); err != nil {
+		return nil, err
+	}
 
+	if err := ensureLedgerRecurringRule(ctx, p.Client, ledgerID, input.RecurringRuleID); err != nil {
+		return nil, err
+	}
+
+	currency, err := p.currencyOrBase(ctx, ledgerID, input.Currency)
 	if err != nil {
-		return nil, appError.FailedToSave(transactionEntity, err)
+		return nil, err
 	}
 
-	row, err := p.Client.Transaction.Query().
-		Where(transaction.ID(created.ID)).
-		WithCategory().
-		WithInvoice().
-		Only(ctx)
+	var id uuid.UUID
+
+	err = p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureLedgerTransferAccount(ctx, tx, ledgerID, input.TransferAccountID); err != nil {
+			return err
+		}
 
+		created, err := tx.Transaction.
+			Create().
+			SetLedgerID(ledgerID).
+			SetCreatedByID(createdByID).
+			SetTitle(input.Title).
+			SetAmount(input.Amount).
+			SetCurrency(currency).
+			SetRecordType(string(input.RecordType)).
+			SetStatus(string(input.Status)).
+			SetRecordDate(input.RecordDate).
+			SetNillableCategoryID(input.CategoryID).
+			SetNillableInvoiceID(input.InvoiceID).
+			SetNillableRecurringRuleID(input.RecurringRuleID).
+			SetNillableTransferAccountID(input.TransferAccountID).
+			SetNillableInstallmentNumber(input.InstallmentNumber).
+			SetNillableInstallmentCount(input.InstallmentCount).
+			AddTagIDs(input.TagIDs...).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(transactionEntity, err)
+		}
+		id = created.ID
+
+		return createTransactionSplits(ctx, tx.Client(), created.ID, input.Splits)
+	})
 	if err != nil {
-		return nil, appError.FailedToFind(transactionEntity, err)
+		return nil, err
 	}
 
-	return newTransactionResponse(row)
+	return p.loadTransaction(ctx, id)
 }
 
-func (p *PostgreSQL) UpdateTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
-	updated, err := p.Client.Transaction.
-		UpdateOneID(id).
-		Where(transaction.HasUserWith(user.IDEQ(userID))).
-		SetTitle(input.Title).
-		SetAmount(input.Amount).
-		SetRecordType(string(input.RecordType)).
-		SetStatus(string(input.Status)).
-		SetRecordDate(input.RecordDate).
-		SetNillableCategoryID(input.CategoryID).
-		SetNillableInvoiceID(input.InvoiceID).
-		Save(ctx)
+func (p *PostgreSQL) UpdateTransaction(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
+	if err := ensureLedgerTags(ctx, p.Client, ledgerID, input.TagIDs); err != nil {
+		return nil, err
+	}
 
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, appError.ErrNotFound
+	if err := ensureLedgerCategories(ctx, p.Client, ledgerID, splitCategoryIDs(input.Splits)); err != nil {
+		return nil, appError.InvalidParam("splits.category_id", err)
+	}
+
+	if err := ensureLedgerInvoice(ctx, p.Client, ledgerID, input.InvoiceID); err != nil {
+		return nil, err
+	}
+
+	if err := ensureLedgerRecurringRule(ctx, p.Client, ledgerID, input.RecurringRuleID); err != nil {
+		return nil, err
+	}
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		if err := ensureLedgerTransferAccount(ctx, tx, ledgerID, input.TransferAccountID); err != nil {
+			return err
+		}
+
+		client := tx.Client()
+
+		update := client.Transaction.
+			UpdateOneID(id).
+			Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			SetTitle(input.Title).
+			SetAmount(input.Amount).
+			SetRecordType(string(input.RecordType)).
+			SetStatus(string(input.Status)).
+			SetRecordDate(input.RecordDate).
+			SetNillableCategoryID(input.CategoryID).
+			SetNillableInvoiceID(input.InvoiceID).
+			SetNillableRecurringRuleID(input.RecurringRuleID).
+			SetNillableInstallmentNumber(input.InstallmentNumber).
+			SetNillableInstallmentCount(input.InstallmentCount)
+
+		if input.Currency != "" {
+			update = update.SetCurrency(input.Currency)
+		}
+
+		if input.TransferAccountID != nil {
+			update = update.SetTransferAccountID(*input.TransferAccountID)
+		} else {
+			update = update.ClearTransferAccountID()
+		}
+
+		if input.TagIDs != nil {
+			update = update.ClearTags().AddTagIDs(input.TagIDs...)
+		}
+
+		row, err := update.Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToSave(transactionEntity, err)
+		}
+
+		if err := reallocateTransactionParticipants(ctx, client, row); err != nil {
+			return err
+		}
+
+		if input.Splits != nil {
+			_, err := client.TransactionSplit.Delete().
+				Where(transactionsplit.HasTransactionWith(transaction.ID(id))).
+				Exec(ctx)
+			if err != nil {
+				return appError.FailedToDelete(transactionSplitEntity, err)
+			}
+			return createTransactionSplits(ctx, client, id, input.Splits)
+		}
+
+		// Sem novas linhas, as atuais precisam continuar somando o valor da transação
+		current, err := client.TransactionSplit.Query().
+			Where(transactionsplit.HasTransactionWith(transaction.ID(id))).
+			All(ctx)
+		if err != nil {
+			return appError.FailedToFind(transactionSplitEntity, err)
 		}
-		return nil, appError.FailedToSave(transactionEntity, err)
+		if len(current) > 0 && !domain.SplitsMatch(input.Amount, toTransactionSplits(current)) {
+			return appError.ErrSplitMismatch
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.loadTransaction(ctx, id)
+}
+
+// ensureLedgerTransferAccount garante que a conta de destino de uma transferência pertence ao livro.
+func ensureLedgerTransferAccount(ctx context.Context, tx *ent.Tx, ledgerID uuid.UUID, accountID *uuid.UUID) error {
+	if accountID == nil {
+		return nil
+	}
+
+	exists, err := tx.Account.Query().
+		Where(account.IDEQ(*accountID)).
+		Where(account.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Exist(ctx)
+	if err != nil {
+		return appError.FailedToFind(accountEntity, err)
+	}
+	if !exists {
+		return appError.InvalidParam("transfer_account_id", appError.ErrAccountNotFound)
 	}
+	return nil
+}
 
+// loadTransaction carrega a transação com as arestas exibidas na resposta.
+func (p *PostgreSQL) loadTransaction(ctx context.Context, id uuid.UUID) (*dto.TransactionResponse, error) {
 	row, err := p.Client.Transaction.Query().
-		Where(transaction.ID(updated.ID)).
+		Where(transaction.ID(id)).
 		WithCategory().
+		WithPayee().
+		WithTags().
+		WithSplits(withSplitCategory).
 		WithInvoice().
 		Only(ctx)
 
@@ -117,10 +254,13 @@ func (p *PostgreSQL) UpdateTransaction(ctx context.Context, userID uuid.UUID, id
 	return newTransactionResponse(row)
 }
 
-func (p *PostgreSQL) ListTransactions(ctx context.Context, userID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, error) {
+func (p *PostgreSQL) ListTransactions(ctx context.Context, ledgerID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, error) {
 	query := p.Client.Transaction.Query().
-		Where(transaction.HasUserWith(user.IDEQ(userID))).
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID))).
 		WithCategory().
+		WithPayee().
+		WithTags().
+		WithSplits(withSplitCategory).
 		WithInvoice()
 
 	query = applyTransactionFilters(query, flt, pgn)
@@ -135,9 +275,9 @@ func (p *PostgreSQL) ListTransactions(ctx context.Context, userID uuid.UUID, flt
 	return newTransactionResponseList(data)
 }
 
-func (p *PostgreSQL) CountTransactions(ctx context.Context, userID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) (int, error) {
+func (p *PostgreSQL) CountTransactions(ctx context.Context, ledgerID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) (int, error) {
 	query := p.Client.Transaction.Query().
-		Where(transaction.HasUserWith(user.IDEQ(userID)))
+		Where(transaction.HasLedgerWith(ledger.IDEQ(ledgerID)))
 
 	query = applyTransactionFilters(query, flt, pgn)
 
@@ -148,7 +288,7 @@ func (p *PostgreSQL) CountTransactions(ctx context.Context, userID uuid.UUID, fl
 	return total, nil
 }
 
-func (p *PostgreSQL) TransactionsGeneralStats(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error) {
+func (p *PostgreSQL) TransactionsGeneralStats(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error) {
 	startDate, err := utils.ToDateTime(flt.StartDate)
 	if err != nil {
 		return nil, err
@@ -158,66 +298,94 @@ func (p *PostgreSQL) TransactionsGeneralStats(ctx context.Context, userID uuid.U
 		return nil, err
 	}
 
-	var dateExpr string
-	switch flt.DateField {
-	case "due_date":
-		dateExpr = "COALESCE(i.due_date, t.record_date)"
-	case "record_date":
-		dateExpr = "t.record_date"
-	default:
-		return nil, fmt.Errorf("invalid dateField: %s", flt.DateField)
+	dateExpr, err := chartDateExpr(flt.DateField)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := p.loadSummaryCategories(ctx, ledgerID, flt.Depth)
+	if err != nil {
+		return nil, err
 	}
 
 	query := fmt.Sprintf(`
-		SELECT
+		SELECT t.category_id,
 			SUM(CASE WHEN t.record_type = 'income' THEN t.amount ELSE 0 END) AS income,
 			SUM(CASE WHEN t.record_type = 'expense' THEN t.amount ELSE 0 END) AS expense,
 			SUM(CASE WHEN t.record_type = 'tax' THEN t.amount ELSE 0 END) AS tax,
 			COUNT(CASE WHEN t.record_type = 'income' THEN 1 END) AS incomeTransactions,
-	        COUNT(CASE WHEN t.record_type = 'expense' THEN 1 END) AS expenseTransactions
-		FROM transactions AS t
+	        COUNT(CASE WHEN t.record_type = 'expense' THEN 1 END) AS expenseTransactions,
+			COUNT(CASE WHEN t.record_type = 'income' AND t.is_first THEN 1 END) AS incomeParents,
+			COUNT(CASE WHEN t.record_type = 'expense' AND t.is_first THEN 1 END) AS expenseParents
+		FROM (%s) AS t
 			LEFT JOIN invoices AS i ON t.invoice_id = i.id
-		WHERE t.user_id = $1
+		WHERE t.ledger_id = $1
 		AND %s BETWEEN $2 AND $3
-	`, dateExpr)
+		GROUP BY t.category_id
+	`, transactionLinesSQL("$1"), dateExpr)
 
-	var income float64
-	var expense float64
-	var tax float64
-	var incomeTransactions int
-	var expenseTransactions int
+	rows, err := p.db.QueryContext(ctx, query, ledgerID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	err = p.db.QueryRowContext(ctx, query, userID, startDate, endDate).
-		Scan(&income, &expense, &tax, &incomeTransactions, &expenseTransactions)
+	var total categoryTotals
+	byCategory := map[uuid.UUID]categoryTotals{}
 
-	if err != nil {
+	// Nas categorias cada linha conta como lançamento; no total, cada transação conta uma vez
+	var incomeTransactions, expenseTransactions int
+
+	for rows.Next() {
+		var categoryID uuid.NullUUID
+		var entry categoryTotals
+		var incomeParents, expenseParents int
+
+		if err := rows.Scan(&categoryID, &entry.income, &entry.expense, &entry.tax, &entry.incomeTransactions, &entry.expenseTransactions, &incomeParents, &expenseParents); err != nil {
+			return nil, err
+		}
+
+		total = total.add(entry)
+		incomeTransactions += incomeParents
+		expenseTransactions += expenseParents
+		if categoryID.Valid {
+			byCategory[categoryID.UUID] = entry
+		}
+	}
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
+	var tags []dto.TagSummary
+	if flt.GroupBy == dto.GroupByTag {
+		byTag, err := p.tagTotals(ctx, ledgerID, dateExpr, "", startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+
+		summary, err := p.loadSummaryTags(ctx, ledgerID)
+		if err != nil {
+			return nil, err
+		}
+		tags = summary.summarize(byTag[""])
+	}
+
 	return &dto.TransactionStatsSummary{
-		Income:              income - tax,
-		Expense:             expense,
-		Tax:                 tax,
-		Balance:             income - expense - tax,
+		Income:              total.income - total.tax,
+		Expense:             total.expense,
+		Tax:                 total.tax,
+		Balance:             total.income - total.expense - total.tax,
 		IncomeTransactions:  incomeTransactions,
 		ExpenseTransactions: expenseTransactions,
+		Categories:          categories.summarize(byCategory),
+		Tags:                tags,
 	}, nil
 }
 
-func (p *PostgreSQL) TransactionsSummary(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error) {
-	var periodTrunc string
-
-	switch flt.Period {
-	case "daily":
-		periodTrunc = "day"
-	case "weekly":
-		periodTrunc = "week"
-	case "monthly":
-		periodTrunc = "month"
-	case "year", "yearly":
-		periodTrunc = "year"
-	default:
-		return nil, fmt.Errorf("invalid period: %s", flt.Period)
+func (p *PostgreSQL) TransactionsSummary(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error) {
+	periodTrunc, err := dto.ChartPeriodTrunc(flt.Period)
+	if err != nil {
+		return nil, err
 	}
 
 	startDate, err := utils.ToDateTime(flt.StartDate)
@@ -229,133 +397,97 @@ func (p *PostgreSQL) TransactionsSummary(ctx context.Context, userID uuid.UUID,
 		return nil, err
 	}
 
-	// Buscar todas as categorias
-	allCategories := []string{}
-	catQuery := "SELECT name FROM categories"
-	catRows, err := p.db.QueryContext(ctx, catQuery)
+	dateExpr, err := chartDateExpr(flt.DateField)
 	if err != nil {
 		return nil, err
 	}
-	defer catRows.Close()
 
-	for catRows.Next() {
-		var name string
-		if err := catRows.Scan(&name); err != nil {
-			return nil, err
-		}
-		allCategories = append(allCategories, name)
-	}
-
-	var dateExpr string
-	switch flt.DateField {
-	case "due_date":
-		dateExpr = "COALESCE(i.due_date, t.record_date)"
-	case "record_date":
-		dateExpr = "t.record_date"
-	default:
-		return nil, fmt.Errorf("invalid dateField: %s", flt.DateField)
+	// Buscar todas as categorias do livro
+	categories, err := p.loadSummaryCategories(ctx, ledgerID, flt.Depth)
+	if err != nil {
+		return nil, err
 	}
 
 	query := fmt.Sprintf(`
 		SELECT DATE_TRUNC($1, %s) AS period,
-			c.name AS category,
+			t.category_id,
 			SUM(CASE WHEN t.record_type = 'income' THEN t.amount ELSE 0 END) AS income,
 			SUM(CASE WHEN t.record_type = 'expense' THEN t.amount ELSE 0 END) AS expense,
 			SUM(CASE WHEN t.record_type = 'tax' THEN t.amount ELSE 0 END) AS tax,
 			COUNT(CASE WHEN t.record_type = 'income' THEN 1 END) AS incomeTransactions,
 			COUNT(CASE WHEN t.record_type = 'expense' THEN 1 END) AS expenseTransactions
-		FROM transactions t
+		FROM (%s) AS t
 			LEFT JOIN invoices AS i ON t.invoice_id = i.id
-			LEFT JOIN categories AS c ON t.category_id = c.id
-		WHERE t.user_id = $2
+		WHERE t.ledger_id = $2
 		AND %s BETWEEN $3 AND $4
-		GROUP BY period, c.name
+		GROUP BY period, t.category_id
 		ORDER BY period
-	`, dateExpr, dateExpr)
+	`, dateExpr, transactionLinesSQL("$2"), dateExpr)
 
-	rows, err := p.db.QueryContext(ctx, query, periodTrunc, userID, startDate, endDate)
+	rows, err := p.db.QueryContext(ctx, query, periodTrunc, ledgerID, startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	type rawData struct {
-		period              string
-		category            string
-		income              float64
-		expense             float64
-		tax                 float64
-		incomeTransactions  int
-		expenseTransactions int
+	type periodData struct {
+		total      categoryTotals
+		byCategory map[uuid.UUID]categoryTotals
 	}
 
-	periodByDate := map[string][]rawData{}
+	periodByDate := map[string]*periodData{}
 
 	for rows.Next() {
 		var period time.Time
-		var category string
-		var income, expense, tax float64
-		var incomeTransactions, expenseTransactions int
+		var categoryID uuid.NullUUID
+		var entry categoryTotals
 
-		if err := rows.Scan(&period, &category, &income, &expense, &tax, &incomeTransactions, &expenseTransactions); err != nil {
+		if err := rows.Scan(&period, &categoryID, &entry.income, &entry.expense, &entry.tax, &entry.incomeTransactions, &entry.expenseTransactions); err != nil {
 			return nil, err
 		}
 
 		key := period.Format("2006-01-02")
-		periodByDate[key] = append(periodByDate[key], rawData{
-			period:              key,
-			category:            category,
-			income:              income,
-			expense:             expense,
-			tax:                 tax,
-			incomeTransactions:  incomeTransactions,
-			expenseTransactions: expenseTransactions,
-		})
+		data, ok := periodByDate[key]
+		if !ok {
+			data = &periodData{byCategory: map[uuid.UUID]categoryTotals{}}
+			periodByDate[key] = data
+		}
+
+		data.total = data.total.add(entry)
+		if categoryID.Valid {
+			data.byCategory[categoryID.UUID] = entry
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var byTag map[string]map[uuid.UUID]categoryTotals
+	var tags *summaryTags
+	if flt.GroupBy == dto.GroupByTag {
+		byTag, err = p.tagTotals(ctx, ledgerID, dateExpr, periodTrunc, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+
+		tags, err = p.loadSummaryTags(ctx, ledgerID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var result []dto.SummaryByDate
-	for date, entries := range periodByDate {
+	for date, data := range periodByDate {
 		summary := dto.SummaryByDate{
 			Date:       date,
-			Income:     0,
-			Expense:    0,
-			Categories: []dto.CategorySummary{},
-		}
-
-		catMap := map[string]rawData{}
-		for _, entry := range entries {
-			catMap[entry.category] = entry
-			summary.Income += entry.income
-			summary.Expense += entry.expense
-			summary.Tax += entry.tax
-		}
-
-		for _, category := range allCategories {
-			data, exists := catMap[category]
-			income := 0.0
-			expense := 0.0
-			tax := 0.0
-			incomeTransactions := 0
-			expenseTransactions := 0
-
-			if exists {
-				income = data.income
-				expense = data.expense
-				tax = data.tax
-				incomeTransactions = data.incomeTransactions
-				expenseTransactions = data.expenseTransactions
-			}
-
-			summary.Categories = append(summary.Categories, dto.CategorySummary{
-				Category:            category,
-				Income:              income,
-				Expense:             expense,
-				Tax:                 tax,
-				IncomeTransactions:  incomeTransactions,
-				ExpenseTransactions: expenseTransactions,
-			})
+			Income:     data.total.income,
+			Expense:    data.total.expense,
+			Tax:        data.total.tax,
+			Categories: categories.summarize(data.byCategory),
+		}
+		if tags != nil {
+			summary.Tags = tags.summarize(byTag[date])
 		}
-
 		result = append(result, summary)
 	}
 
@@ -366,16 +498,227 @@ func (p *PostgreSQL) TransactionsSummary(ctx context.Context, userID uuid.UUID,
 	return result, nil
 }
 
+// transactionLinesSQL expõe as transações do livro (parâmetro ledgerParam) como linhas:
+// transações divididas aparecem uma vez por linha, com o valor e a categoria da linha, e as
+// demais aparecem inteiras. Os valores já vêm convertidos para a moeda base pela cotação
+// gravada na transação. is_first marca uma única linha por transação, para as contagens.
+func transactionLinesSQL(ledgerParam string) string {
+	return fmt.Sprintf(`
+		SELECT t.id, t.ledger_id, t.record_type, t.status, t.record_date, t.invoice_id, t.payee_id, t.recurring_rule_id, t.transfer_account_id,
+			CASE WHEN s.id IS NULL THEN t.category_id ELSE s.category_id END AS category_id,
+			ROUND(COALESCE(s.amount, t.amount) * t.exchange_rate, 2) AS amount,
+			ROW_NUMBER() OVER (PARTITION BY t.id ORDER BY s.created_at, s.id) = 1 AS is_first
+		FROM transactions AS t
+			LEFT JOIN transaction_splits AS s ON s.transaction_id = t.id
+		WHERE t.ledger_id = %s
+	`, ledgerParam)
+}
+
+func chartDateExpr(dateField string) (string, error) {
+	switch dateField {
+	case "due_date":
+		return "COALESCE(i.due_date, t.record_date)", nil
+	case "record_date":
+		return "t.record_date", nil
+	default:
+		return "", fmt.Errorf("invalid dateField: %s", dateField)
+	}
+}
+
+type categoryTotals struct {
+	income              domain.Money
+	expense             domain.Money
+	tax                 domain.Money
+	incomeTransactions  int
+	expenseTransactions int
+}
+
+func (c categoryTotals) add(other categoryTotals) categoryTotals {
+	return categoryTotals{
+		income:              c.income + other.income,
+		expense:             c.expense + other.expense,
+		tax:                 c.tax + other.tax,
+		incomeTransactions:  c.incomeTransactions + other.incomeTransactions,
+		expenseTransactions: c.expenseTransactions + other.expenseTransactions,
+	}
+}
+
+// summaryCategories guarda as categorias exibidas nos gráficos e a profundidade
+// usada para somar os valores das subcategorias nos seus ancestrais.
+type summaryCategories struct {
+	rows  []*ent.Category
+	tree  domain.CategoryTree
+	depth *int
+}
+
+func (p *PostgreSQL) loadSummaryCategories(ctx context.Context, ledgerID uuid.UUID, depth *int) (*summaryCategories, error) {
+	if depth != nil && *depth < 0 {
+		return nil, appError.InvalidParam("depth", fmt.Errorf("must be greater than or equal to 0"))
+	}
+
+	rows, err := p.Client.Category.Query().
+		Where(entCategory.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Order(ent.Asc(entCategory.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(categoryEntity, err)
+	}
+
+	tree := make(domain.CategoryTree, len(rows))
+	for _, row := range rows {
+		tree[row.ID] = row.ParentID
+	}
+
+	return &summaryCategories{rows: rows, tree: tree, depth: depth}, nil
+}
+
+// summarize monta o resumo por categoria. Sem profundidade cada categoria traz apenas
+// os próprios valores; com profundidade, as subcategorias mais profundas são somadas
+// no ancestral daquele nível e deixam de ser listadas.
+func (s *summaryCategories) summarize(byCategory map[uuid.UUID]categoryTotals) []dto.CategorySummary {
+	totals := byCategory
+	if s.depth != nil {
+		totals = map[uuid.UUID]categoryTotals{}
+		for id, entry := range byCategory {
+			target := s.tree.Rollup(id, *s.depth)
+			totals[target] = totals[target].add(entry)
+		}
+	}
+
+	summaries := []dto.CategorySummary{}
+	for _, row := range s.rows {
+		depth := s.tree.Depth(row.ID)
+		if s.depth != nil && depth > *s.depth {
+			continue
+		}
+
+		entry := totals[row.ID]
+		summaries = append(summaries, dto.CategorySummary{
+			CategoryID:          row.ID,
+			Category:            row.Name,
+			ParentID:            row.ParentID,
+			Depth:               depth,
+			Income:              entry.income,
+			Expense:             entry.expense,
+			Tax:                 entry.tax,
+			IncomeTransactions:  entry.incomeTransactions,
+			ExpenseTransactions: entry.expenseTransactions,
+		})
+	}
+	return summaries
+}
+
+// tagTotals soma as transações por tag e, quando periodTrunc é informado, por período
+// (chave no formato 2006-01-02; vazia sem período). Uma transação com várias tags entra
+// no total de cada uma delas.
+func (p *PostgreSQL) tagTotals(ctx context.Context, ledgerID uuid.UUID, dateExpr string, periodTrunc string, startDate, endDate time.Time) (map[string]map[uuid.UUID]categoryTotals, error) {
+	period := "NULL::timestamptz"
+	args := []any{ledgerID, startDate, endDate}
+	if periodTrunc != "" {
+		period = fmt.Sprintf("DATE_TRUNC($4, %s)", dateExpr)
+		args = append(args, periodTrunc)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s AS period,
+			tt.tag_id,
+			SUM(CASE WHEN t.record_type = 'income' THEN ROUND(t.amount * t.exchange_rate, 2) ELSE 0 END) AS income,
+			SUM(CASE WHEN t.record_type = 'expense' THEN ROUND(t.amount * t.exchange_rate, 2) ELSE 0 END) AS expense,
+			SUM(CASE WHEN t.record_type = 'tax' THEN ROUND(t.amount * t.exchange_rate, 2) ELSE 0 END) AS tax,
+			COUNT(CASE WHEN t.record_type = 'income' THEN 1 END) AS incomeTransactions,
+			COUNT(CASE WHEN t.record_type = 'expense' THEN 1 END) AS expenseTransactions
+		FROM transactions AS t
+			JOIN transaction_tags AS tt ON tt.transaction_id = t.id
+			LEFT JOIN invoices AS i ON t.invoice_id = i.id
+		WHERE t.ledger_id = $1
+		AND %s BETWEEN $2 AND $3
+		GROUP BY period, tt.tag_id
+	`, period, dateExpr)
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := map[string]map[uuid.UUID]categoryTotals{}
+	for rows.Next() {
+		var period stdsql.NullTime
+		var tagID uuid.UUID
+		var entry categoryTotals
+
+		if err := rows.Scan(&period, &tagID, &entry.income, &entry.expense, &entry.tax, &entry.incomeTransactions, &entry.expenseTransactions); err != nil {
+			return nil, err
+		}
+
+		key := ""
+		if period.Valid {
+			key = period.Time.Format("2006-01-02")
+		}
+		if result[key] == nil {
+			result[key] = map[uuid.UUID]categoryTotals{}
+		}
+		result[key][tagID] = entry
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// summaryTags guarda as tags do livro exibidas nos gráficos agrupados por tag.
+type summaryTags struct {
+	rows []*ent.Tag
+}
+
+func (p *PostgreSQL) loadSummaryTags(ctx context.Context, ledgerID uuid.UUID) (*summaryTags, error) {
+	rows, err := p.Client.Tag.Query().
+		Where(entTag.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Order(ent.Asc(entTag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(tagEntity, err)
+	}
+	return &summaryTags{rows: rows}, nil
+}
+
+func (s *summaryTags) summarize(byTag map[uuid.UUID]categoryTotals) []dto.TagSummary {
+	summaries := make([]dto.TagSummary, 0, len(s.rows))
+	for _, row := range s.rows {
+		entry := byTag[row.ID]
+		summaries = append(summaries, dto.TagSummary{
+			TagID:               row.ID,
+			Tag:                 row.Name,
+			Color:               row.Color,
+			Income:              entry.income,
+			Expense:             entry.expense,
+			Tax:                 entry.tax,
+			IncomeTransactions:  entry.incomeTransactions,
+			ExpenseTransactions: entry.expenseTransactions,
+		})
+	}
+	return summaries
+}
+
 func mapTransactionToResponse(row *ent.Transaction) dto.TransactionResponse {
 	response := dto.TransactionResponse{
-		ID:         row.ID,
-		Title:      row.Title,
-		Amount:     row.Amount,
-		Status:     row.Status,
-		RecordType: row.RecordType,
-		RecordDate: utils.ToDateTimeString(row.RecordDate),
-		CreatedAt:  utils.ToDateTimeString(row.CreatedAt),
-		UpdatedAt:  utils.ToDateTimeString(row.UpdatedAt),
+		ID:                row.ID,
+		Title:             row.Title,
+		Amount:            row.Amount,
+		Currency:          row.Currency,
+		ExchangeRate:      row.ExchangeRate,
+		BaseAmount:        row.Amount.Convert(row.ExchangeRate),
+		Status:            row.Status,
+		RecordType:        row.RecordType,
+		RecurringRuleID:   row.RecurringRuleID,
+		TransferAccountID: row.TransferAccountID,
+		InstallmentNumber: row.InstallmentNumber,
+		InstallmentCount:  row.InstallmentCount,
+		CreatedByID:       row.CreatedByID,
+		RecordDate:        utils.ToDateTimeString(row.RecordDate),
+		CreatedAt:         utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:         utils.ToDateTimeString(row.UpdatedAt),
 	}
 
 	if row.Edges.Invoice != nil {
@@ -392,6 +735,38 @@ func mapTransactionToResponse(row *ent.Transaction) dto.TransactionResponse {
 		}
 	}
 
+	response.Tags = make([]dto.TransactionTagResponse, 0, len(row.Edges.Tags))
+	for _, t := range row.Edges.Tags {
+		response.Tags = append(response.Tags, dto.TransactionTagResponse{
+			ID:    t.ID,
+			Name:  t.Name,
+			Color: t.Color,
+		})
+	}
+
+	response.Splits = make([]dto.TransactionSplitResponse, 0, len(row.Edges.Splits))
+	for _, split := range row.Edges.Splits {
+		line := dto.TransactionSplitResponse{
+			ID:     split.ID,
+			Amount: split.Amount,
+			Note:   split.Note,
+		}
+		if split.Edges.Category != nil {
+			line.Category = &dto.TransactionCategoryResponse{
+				ID:   split.Edges.Category.ID,
+				Name: split.Edges.Category.Name,
+			}
+		}
+		response.Splits = append(response.Splits, line)
+	}
+
+	if row.Edges.Payee != nil {
+		response.Payee = &dto.TransactionPayeeResponse{
+			ID:   row.Edges.Payee.ID,
+			Name: row.Edges.Payee.Name,
+		}
+	}
+
 	return response
 }
 
@@ -460,6 +835,9 @@ func applyTransactionFilters(query *ent.TransactionQuery, flt dto.TransactionFil
 				transaction.HasCategoryWith(
 					entCategory.NameContainsFold(pgn.Search),
 				),
+				transaction.HasPayeeWith(
+					entPayee.NameContainsFold(pgn.Search),
+				),
 			),
 		)
 	}
@@ -494,6 +872,22 @@ func applyTransactionFilters(query *ent.TransactionQuery, flt dto.TransactionFil
 		}
 	}
 
+	if flt.PayeeIDs != nil {
+		payeeIDs := utils.ToUUIDSlice(*flt.PayeeIDs)
+		if len(payeeIDs) > 0 {
+			query = query.Where(
+				transaction.HasPayeeWith(entPayee.IDIn(payeeIDs...)),
+			)
+		}
+	}
+
+	if flt.TagIDs != nil {
+		tagIDs := utils.ToUUIDSlice(*flt.TagIDs)
+		if len(tagIDs) > 0 {
+			query = query.Where(tagFilter(tagIDs, flt.TagMatch))
+		}
+	}
+
 	if flt.MinAmount != nil {
 		query = query.Where(
 			transaction.AmountGTE(*flt.MinAmount),
@@ -516,3 +910,16 @@ func applyTransactionFilters(query *ent.TransactionQuery, flt dto.TransactionFil
 
 	return query
 }
+
+// tagFilter seleciona transações com qualquer uma das tags ("any", padrão) ou com todas elas ("all").
+func tagFilter(tagIDs []uuid.UUID, match *string) predicate.Transaction {
+	if match == nil || *match != dto.TagMatchAll {
+		return transaction.HasTagsWith(entTag.IDIn(tagIDs...))
+	}
+
+	predicates := make([]predicate.Transaction, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		predicates = append(predicates, transaction.HasTagsWith(entTag.IDEQ(id)))
+	}
+	return transaction.And(predicates...)
+}
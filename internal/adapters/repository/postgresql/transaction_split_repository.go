@@ -0,0 +1,61 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/transactionsplit"
+
+	"github.com/google/uuid"
+)
+
+const transactionSplitEntity = "transaction splits"
+
+func createTransactionSplits(ctx context.Context, client *ent.Client, transactionID uuid.UUID, splits []domain.TransactionSplit) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.TransactionSplitCreate, 0, len(splits))
+	for _, split := range splits {
+		builders = append(builders, client.TransactionSplit.
+			Create().
+			SetTransactionID(transactionID).
+			SetAmount(split.Amount).
+			SetNillableNote(split.Note).
+			SetNillableCategoryID(split.CategoryID))
+	}
+
+	if err := client.TransactionSplit.CreateBulk(builders...).Exec(ctx); err != nil {
+		return appError.FailedToSave(transactionSplitEntity, err)
+	}
+	return nil
+}
+
+func toTransactionSplits(rows []*ent.TransactionSplit) []domain.TransactionSplit {
+	splits := make([]domain.TransactionSplit, 0, len(rows))
+	for _, row := range rows {
+		splits = append(splits, domain.TransactionSplit{
+			ID:         row.ID,
+			CategoryID: row.CategoryID,
+			Amount:     row.Amount,
+			Note:       row.Note,
+		})
+	}
+	return splits
+}
+
+func splitCategoryIDs(splits []domain.TransactionSplit) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, split := range splits {
+		if split.CategoryID != nil {
+			ids = append(ids, *split.CategoryID)
+		}
+	}
+	return ids
+}
+
+func withSplitCategory(q *ent.TransactionSplitQuery) {
+	q.WithCategory().Order(ent.Asc(transactionsplit.FieldCreatedAt))
+}
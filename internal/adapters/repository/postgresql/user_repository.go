@@ -2,10 +2,15 @@ package postgresql
 
 import (
 	"context"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
 	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/ent"
 	"frog-go/internal/ent/user"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
 )
 
 const userEntity = "users"
@@ -26,6 +31,7 @@ func (p *PostgreSQL) GetUserByEmail(ctx context.Context, email string) (*domain.
 		Email:        row.Email,
 		PasswordHash: row.PasswordHash,
 		IsActive:     row.IsActive,
+		BaseCurrency: row.BaseCurrency,
 		CreatedAt:    row.CreatedAt,
 		UpdatedAt:    row.UpdatedAt,
 	}, nil
@@ -47,7 +53,199 @@ func (p *PostgreSQL) GetUserByUsername(ctx context.Context, username string) (*d
 		Email:        row.Email,
 		PasswordHash: row.PasswordHash,
 		IsActive:     row.IsActive,
+		BaseCurrency: row.BaseCurrency,
 		CreatedAt:    row.CreatedAt,
 		UpdatedAt:    row.UpdatedAt,
 	}, nil
 }
+
+func (p *PostgreSQL) CreateUser(ctx context.Context, input domain.User) (*dto.UserResponse, error) {
+	var created *ent.User
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.User.
+			Create().
+			SetName(input.Name).
+			SetUsername(input.Username).
+			SetEmail(input.Email).
+			SetPasswordHash(input.PasswordHash).
+			SetIsActive(input.IsActive).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(userEntity, err)
+		}
+
+		// Todo usuário começa com o livro pessoal, usado quando a requisição não informa outro
+		_, err = createLedger(ctx, tx.Client(), created.ID, domain.Ledger{Name: domain.PersonalLedgerName, Personal: true})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newUserResponse(created), nil
+}
+
+// EnsureDefaultCategories garante que o livro possua todas as categorias padrão.
+func (p *PostgreSQL) EnsureDefaultCategories(ctx context.Context, ledgerID uuid.UUID) error {
+	return createDefaultCategories(ctx, p.Client, ledgerID)
+}
+
+func (p *PostgreSQL) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error) {
+	row, err := p.Client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(userEntity, err)
+	}
+	return newUserResponse(row), nil
+}
+
+// GetUserCredentials retorna o usuário com o hash da senha, para conferir a senha atual.
+func (p *PostgreSQL) GetUserCredentials(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	row, err := p.Client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, appError.ErrNotFound
+		}
+		return nil, appError.FailedToFind(userEntity, err)
+	}
+
+	return &domain.User{
+		ID:           row.ID,
+		Name:         row.Name,
+		Username:     row.Username,
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+		IsActive:     row.IsActive,
+		BaseCurrency: row.BaseCurrency,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}, nil
+}
+
+// UpdateUserProfile troca o nome e o username; campos vazios mantêm o valor atual.
+func (p *PostgreSQL) UpdateUserProfile(ctx context.Context, userID uuid.UUID, name string, username string) (*dto.UserResponse, error) {
+	update := p.Client.User.UpdateOneID(userID)
+	if name != "" {
+		update = update.SetName(name)
+	}
+	if username != "" {
+		update = update.SetUsername(username)
+	}
+
+	row, err := update.Save(ctx)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return nil, appError.ErrNotFound
+		case ent.IsConstraintError(err):
+			return nil, appError.ErrUserConflict
+		}
+		return nil, appError.FailedToUpdate(userEntity, err)
+	}
+	return newUserResponse(row), nil
+}
+
+func (p *PostgreSQL) UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) error {
+	err := p.Client.User.UpdateOneID(userID).SetEmail(email).Exec(ctx)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return appError.ErrNotFound
+		case ent.IsConstraintError(err):
+			return appError.ErrUserConflict
+		}
+		return appError.FailedToUpdate(userEntity, err)
+	}
+	return nil
+}
+
+func (p *PostgreSQL) UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	err := p.Client.User.UpdateOneID(userID).SetPasswordHash(passwordHash).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return appError.ErrNotFound
+		}
+		return appError.FailedToUpdate(userEntity, err)
+	}
+	return nil
+}
+
+// UpdateUserBaseCurrency troca a moeda base do usuário, que é a moeda base dos livros dele.
+// Como as cotações gravadas nas transações e os valores das faturas estão na moeda base, a
+// troca só é permitida antes do primeiro lançamento em qualquer um desses livros.
+func (p *PostgreSQL) UpdateUserBaseCurrency(ctx context.Context, userID uuid.UUID, currency string) (*dto.UserResponse, error) {
+	var updated *ent.User
+
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		row, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return appError.ErrNotFound
+			}
+			return appError.FailedToFind(userEntity, err)
+		}
+
+		if row.BaseCurrency == currency {
+			updated = row
+			return nil
+		}
+
+		hasTransactions, err := row.QueryLedgers().QueryTransactions().Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind(transactionEntity, err)
+		}
+		hasInvoices, err := row.QueryLedgers().QueryInvoices().Exist(ctx)
+		if err != nil {
+			return appError.FailedToFind("invoices", err)
+		}
+		if hasTransactions || hasInvoices {
+			return appError.ErrBaseCurrencyLocked
+		}
+
+		updated, err = row.Update().SetBaseCurrency(currency).Save(ctx)
+		if err != nil {
+			return appError.FailedToUpdate(userEntity, err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return newUserResponse(updated), nil
+}
+
+// baseCurrency retorna a moeda para a qual os valores do livro são convertidos.
+func (p *PostgreSQL) baseCurrency(ctx context.Context, ledgerID uuid.UUID) (string, error) {
+	currency, err := hooks.LedgerBaseCurrency(ctx, p.Client, ledgerID)
+	if err != nil {
+		return "", appError.FailedToFind(userEntity, err)
+	}
+	return currency, nil
+}
+
+// currencyOrBase retorna a moeda informada ou, na falta dela, a moeda base do livro.
+func (p *PostgreSQL) currencyOrBase(ctx context.Context, ledgerID uuid.UUID, currency string) (string, error) {
+	if currency != "" {
+		return currency, nil
+	}
+	return p.baseCurrency(ctx, ledgerID)
+}
+
+func newUserResponse(row *ent.User) *dto.UserResponse {
+	return &dto.UserResponse{
+		ID:           row.ID,
+		Name:         row.Name,
+		Username:     row.Username,
+		Email:        row.Email,
+		IsActive:     row.IsActive,
+		BaseCurrency: row.BaseCurrency,
+		CreatedAt:    utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt:    utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
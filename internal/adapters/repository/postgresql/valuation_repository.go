@@ -0,0 +1,289 @@
+package postgresql
+
+import (
+	"context"
+	"frog-go/internal/adapters/repository/postgresql/hooks"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/ent"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/valuation"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const valuationEntity = "valuations"
+
+// UpsertValuation grava o valor do item na data, substituindo a avaliação já existente para
+// a mesma data.
+func (p *PostgreSQL) UpsertValuation(ctx context.Context, ledgerID uuid.UUID, input domain.Valuation) (*dto.ValuationResponse, error) {
+	if err := p.ensureValuationTarget(ctx, ledgerID, input.ValuationTarget); err != nil {
+		return nil, err
+	}
+
+	var row *ent.Valuation
+	err := p.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := tx.Valuation.Query().
+			Where(valuationTargetPredicate(input.ValuationTarget)).
+			Where(valuation.ValueDateEQ(input.ValueDate)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return appError.FailedToFind(valuationEntity, err)
+		}
+
+		if current != nil {
+			row, err = tx.Valuation.
+				UpdateOne(current).
+				SetAmount(input.Amount).
+				SetNillableNote(input.Note).
+				Save(ctx)
+			if err != nil {
+				return appError.FailedToUpdate(valuationEntity, err)
+			}
+			return nil
+		}
+
+		row, err = tx.Valuation.
+			Create().
+			SetLedgerID(ledgerID).
+			SetValueDate(input.ValueDate).
+			SetAmount(input.Amount).
+			SetNillableNote(input.Note).
+			SetNillableAssetID(input.AssetID).
+			SetNillableAccountID(input.AccountID).
+			Save(ctx)
+		if err != nil {
+			return appError.FailedToSave(valuationEntity, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newValuationResponse(row), nil
+}
+
+func (p *PostgreSQL) ListValuations(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget) ([]dto.ValuationResponse, error) {
+	if err := p.ensureValuationTarget(ctx, ledgerID, target); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.Client.Valuation.Query().
+		Where(valuation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(valuationTargetPredicate(target)).
+		Order(ent.Desc(valuation.FieldValueDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(valuationEntity, err)
+	}
+
+	response := make([]dto.ValuationResponse, 0, len(rows))
+	for _, row := range rows {
+		response = append(response, *newValuationResponse(row))
+	}
+	return response, nil
+}
+
+func (p *PostgreSQL) DeleteValuationByID(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget, id uuid.UUID) error {
+	deleted, err := p.Client.Valuation.Delete().
+		Where(valuation.IDEQ(id)).
+		Where(valuation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(valuationTargetPredicate(target)).
+		Exec(ctx)
+
+	if err != nil {
+		return appError.FailedToDelete(valuationEntity, err)
+	}
+	if deleted == 0 {
+		return appError.ErrNotFound
+	}
+	return nil
+}
+
+// NetWorth monta a série do patrimônio do início do período de start até end. Cada ponto
+// usa a avaliação mais recente de cada item até o fim do período, convertida para a moeda
+// base pela cotação dessa data.
+func (p *PostgreSQL) NetWorth(ctx context.Context, ledgerID uuid.UUID, periodTrunc string, start time.Time, end time.Time) ([]dto.NetWorthByDate, error) {
+	base, err := p.baseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	assets, err := p.Client.Asset.Query().
+		Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(assetEntity, err)
+	}
+
+	accounts, err := p.Client.Account.Query().
+		Where(account.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(accountEntity, err)
+	}
+
+	valuations, err := p.Client.Valuation.Query().
+		Where(valuation.HasLedgerWith(ledger.IDEQ(ledgerID))).
+		Where(valuation.ValueDateLTE(end)).
+		Order(ent.Asc(valuation.FieldValueDate)).
+		All(ctx)
+	if err != nil {
+		return nil, appError.FailedToFind(valuationEntity, err)
+	}
+
+	assetsByID := map[uuid.UUID]*ent.Asset{}
+	for _, row := range assets {
+		assetsByID[row.ID] = row
+	}
+	accountsByID := map[uuid.UUID]*ent.Account{}
+	for _, row := range accounts {
+		accountsByID[row.ID] = row
+	}
+
+	rates := map[string]float64{}
+	convert := func(amount domain.Money, currency string, date time.Time) (domain.Money, error) {
+		key := currency + date.Format("2006-01-02")
+		rate, ok := rates[key]
+		if !ok {
+			var err error
+			rate, err = hooks.ExchangeRateOn(ctx, p.Client, ledgerID, currency, base, date)
+			if err != nil {
+				return 0, err
+			}
+			rates[key] = rate
+		}
+		return amount.Convert(rate), nil
+	}
+
+	latest := map[uuid.UUID]*ent.Valuation{}
+	next := 0
+
+	result := []dto.NetWorthByDate{}
+	for period := chartPeriodStart(start, periodTrunc); !period.After(end); period = chartNextPeriod(period, periodTrunc) {
+		asOf := chartNextPeriod(period, periodTrunc).AddDate(0, 0, -1)
+		if asOf.After(end) {
+			asOf = end
+		}
+
+		for ; next < len(valuations) && !valuations[next].ValueDate.After(asOf); next++ {
+			row := valuations[next]
+			if row.AssetID != nil {
+				latest[*row.AssetID] = row
+			} else if row.AccountID != nil {
+				latest[*row.AccountID] = row
+			}
+		}
+
+		point := dto.NetWorthByDate{Date: period.Format("2006-01-02")}
+		for id, row := range latest {
+			if item, ok := assetsByID[id]; ok {
+				value, err := convert(row.Amount, item.Currency, asOf)
+				if err != nil {
+					return nil, err
+				}
+				if item.Class == string(domain.AssetClassLiability) {
+					point.Liabilities += value
+				} else {
+					point.Assets += value
+				}
+			} else if item, ok := accountsByID[id]; ok {
+				value, err := convert(row.Amount, item.Currency, asOf)
+				if err != nil {
+					return nil, err
+				}
+				point.Accounts += value
+			}
+		}
+		point.NetWorth = point.Accounts + point.Assets - point.Liabilities
+
+		result = append(result, point)
+	}
+
+	return result, nil
+}
+
+func (p *PostgreSQL) ensureValuationTarget(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget) error {
+	var exists bool
+	var err error
+
+	switch {
+	case target.AssetID != nil:
+		exists, err = p.Client.Asset.Query().
+			Where(asset.IDEQ(*target.AssetID)).
+			Where(asset.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exist(ctx)
+	case target.AccountID != nil:
+		exists, err = p.Client.Account.Query().
+			Where(account.IDEQ(*target.AccountID)).
+			Where(account.HasLedgerWith(ledger.IDEQ(ledgerID))).
+			Exist(ctx)
+	default:
+		return appError.ErrNotFound
+	}
+
+	if err != nil {
+		return appError.FailedToFind(valuationEntity, err)
+	}
+	if !exists {
+		return appError.ErrNotFound
+	}
+	return nil
+}
+
+func valuationTargetPredicate(target domain.ValuationTarget) predicate.Valuation {
+	if target.AssetID != nil {
+		return valuation.AssetIDEQ(*target.AssetID)
+	}
+	return valuation.AccountIDEQ(*target.AccountID)
+}
+
+// chartPeriodStart trunca a data para o início do período, como o DATE_TRUNC do Postgres:
+// semanas começam na segunda-feira.
+func chartPeriodStart(date time.Time, periodTrunc string) time.Time {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch periodTrunc {
+	case "week":
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case "month":
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case "year":
+		return time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return date
+	}
+}
+
+func chartNextPeriod(period time.Time, periodTrunc string) time.Time {
+	switch periodTrunc {
+	case "week":
+		return period.AddDate(0, 0, 7)
+	case "month":
+		return period.AddDate(0, 1, 0)
+	case "year":
+		return period.AddDate(1, 0, 0)
+	default:
+		return period.AddDate(0, 0, 1)
+	}
+}
+
+func newValuationResponse(row *ent.Valuation) *dto.ValuationResponse {
+	return &dto.ValuationResponse{
+		ID:        row.ID,
+		AssetID:   row.AssetID,
+		AccountID: row.AccountID,
+		ValueDate: row.ValueDate.Format(time.DateOnly),
+		Amount:    row.Amount,
+		Note:      row.Note,
+		CreatedAt: utils.ToDateTimeString(row.CreatedAt),
+		UpdatedAt: utils.ToDateTimeString(row.UpdatedAt),
+	}
+}
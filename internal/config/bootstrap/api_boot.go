@@ -2,16 +2,19 @@ package bootstrap
 
 import (
 	"fmt"
+	"frog-go/internal/adapters/blobstore/localfs"
 	"frog-go/internal/adapters/messagebus/rabbitmq"
 	"frog-go/internal/adapters/repository/postgresql"
 	"frog-go/internal/config"
+	"frog-go/internal/core/ports/outbound/blobstore"
 	"frog-go/internal/core/ports/outbound/messagebus"
 	"frog-go/internal/core/ports/outbound/repository"
 )
 
 type APIDeps struct {
-	Repo repository.Repository
-	Mbus messagebus.MessageBus
+	Repo  repository.Repository
+	Mbus  messagebus.MessageBus
+	Blobs blobstore.BlobStore
 }
 
 func InitApi(envPath string) (*APIDeps, error) {
@@ -44,9 +47,17 @@ func InitApi(envPath string) (*APIDeps, error) {
 		return nil, fmt.Errorf("failed to connect to RabbitMQ: %v", err)
 	}
 
+	blobs, err := localfs.NewLocalFS(cfg.BlobStoragePath)
+	if err != nil {
+		repo.Close()
+		mbus.Close()
+		return nil, fmt.Errorf("failed to open blob storage: %v", err)
+	}
+
 	return &APIDeps{
-		Repo: repo,
-		Mbus: mbus,
+		Repo:  repo,
+		Mbus:  mbus,
+		Blobs: blobs,
 	}, nil
 
 }
@@ -3,16 +3,23 @@ package bootstrap
 import (
 	"fmt"
 	"frog-go/internal/adapters/messagebus/rabbitmq"
+	"frog-go/internal/adapters/notifier/email"
+	"frog-go/internal/adapters/notifier/inapp"
+	"frog-go/internal/adapters/notifier/webhook"
 	"frog-go/internal/adapters/repository/postgresql"
 	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/core/ports/outbound/messagebus"
+	"frog-go/internal/core/ports/outbound/notifier"
 	"frog-go/internal/core/ports/outbound/repository"
+	"time"
 )
 
 type WorkerDeps struct {
-	Repo  repository.Repository
-	Mbus  messagebus.MessageBus
-	Cfg   *config.ConfigConsumer
+	Repo     repository.Repository
+	Mbus     messagebus.MessageBus
+	Cfg      *config.ConfigConsumer
+	Channels notifier.Channels
 }
 
 func InitWorker(envPath string) (*WorkerDeps, error) {
@@ -45,9 +52,24 @@ func InitWorker(envPath string) (*WorkerDeps, error) {
 		return nil, fmt.Errorf("failed to connect to RabbitMQ: %v", err)
 	}
 
+	channels := notifier.Channels{
+		domain.ChannelWebhook: webhook.NewWebhook(time.Duration(cfg.WebhookTimeoutSeconds) * time.Second),
+		domain.ChannelInApp:   inapp.NewInApp(repo),
+	}
+	if cfg.SMTPHost != "" {
+		smtp, err := email.NewSMTP(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPass, cfg.SMTPFrom)
+		if err != nil {
+			repo.Close()
+			mbus.Close()
+			return nil, fmt.Errorf("failed to configure SMTP: %v", err)
+		}
+		channels[domain.ChannelEmail] = smtp
+	}
+
 	return &WorkerDeps{
-		Repo:  repo,
-		Mbus:  mbus,
-		Cfg:   config.LoadConsumerConfig(envPath),
+		Repo:     repo,
+		Mbus:     mbus,
+		Cfg:      config.LoadConsumerConfig(envPath),
+		Channels: channels,
 	}, nil
 }
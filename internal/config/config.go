@@ -15,9 +15,18 @@ const (
 
 const (
 	ResourceTransactions = "transactions"
+	ResourceBudgetAlerts = "budget-alerts"
 	ActionCreate         = "create"
 	ModelNubank          = "nubank"
 )
+
+const (
+	JobInvoicesOverdue   = "invoices-overdue"
+	JobPayeesLink        = "payees-link"
+	JobBudgetsAlerts     = "budgets-alerts"
+	JobNotificationsSend = "notifications-send"
+	JobAnomaliesDetect   = "anomalies-detect"
+)
 const (
 	OrderAsc  = "asc"
 	OrderDesc = "desc"
@@ -38,6 +47,19 @@ type Config struct {
 	MessageBusPort string
 
 	SeedPath string
+
+	// BlobStoragePath é o diretório onde os anexos são gravados.
+	BlobStoragePath string
+
+	// SMTP usado nos avisos por e-mail; sem SMTPHost o canal de e-mail fica desativado.
+	SMTPHost string
+	SMTPPort string
+	SMTPUser string
+	SMTPPass string
+	SMTPFrom string
+
+	// WebhookTimeoutSeconds é o tempo máximo de espera pela resposta de um webhook de avisos.
+	WebhookTimeoutSeconds int
 }
 
 func LoadConfig(envPath string) (*Config, error) {
@@ -58,6 +80,16 @@ func LoadConfig(envPath string) (*Config, error) {
 		MessageBusPort: os.Getenv("MESSAGE_BUS_PORT"),
 
 		SeedPath: os.Getenv("SEED_PATH"),
+
+		BlobStoragePath: getEnv("BLOB_STORAGE_PATH", "./storage/blobs"),
+
+		SMTPHost: os.Getenv("SMTP_HOST"),
+		SMTPPort: getEnv("SMTP_PORT", "1025"),
+		SMTPUser: os.Getenv("SMTP_USER"),
+		SMTPPass: os.Getenv("SMTP_PASS"),
+		SMTPFrom: getEnv("SMTP_FROM", "Frog <noreply@frog.local>"),
+
+		WebhookTimeoutSeconds: getEnvAsInt("WEBHOOK_TIMEOUT_SECONDS", 10),
 	}
 
 	return cfg, nil
@@ -95,6 +127,13 @@ func LoadConsumerConfig(envPath string) *ConfigConsumer {
 	return cfg
 }
 
+func getEnv(key string, defaultVal string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return defaultVal
+}
+
 func getEnvAsInt(key string, defaultVal int) int {
 	valStr := os.Getenv(key)
 	if valStr == "" {
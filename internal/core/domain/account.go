@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccountType string
+
+const (
+	AccountChecking   AccountType = "checking"
+	AccountSavings    AccountType = "savings"
+	AccountCreditCard AccountType = "credit_card"
+	AccountCash       AccountType = "cash"
+	AccountInvestment AccountType = "investment"
+)
+
+func ValidAccountType() []string {
+	return []string{
+		string(AccountChecking),
+		string(AccountSavings),
+		string(AccountCreditCard),
+		string(AccountCash),
+		string(AccountInvestment),
+	}
+}
+
+func (a AccountType) IsValid() bool {
+	return slices.Contains(ValidAccountType(), string(a))
+}
+
+type Account struct {
+	ID          uuid.UUID   `json:"id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	Name        string      `json:"name"`
+	AccountType AccountType `json:"account_type"`
+	Currency    string      `json:"currency"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// NewAccount valida a conta. Sem moeda informada, a conta assume a moeda base do usuário
+// na criação e mantém a atual na edição.
+func NewAccount(name string, accountType *AccountType, currency string) (*Account, error) {
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	accountTypeValue := AccountChecking
+	if accountType != nil && *accountType != "" {
+		accountTypeValue = *accountType
+	}
+
+	if !accountTypeValue.IsValid() {
+		return nil, appError.InvalidParam("account_type", fmt.Errorf("invalid value"))
+	}
+
+	if currency != "" {
+		var err error
+		currency, err = NormalizeCurrency(currency)
+		if err != nil {
+			return nil, appError.InvalidParam("currency", err)
+		}
+	}
+
+	return &Account{
+		Name:        name,
+		AccountType: accountTypeValue,
+		Currency:    currency,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package domain
+
+const (
+	// AnomalyWindowDays é quantos dias para trás o detector confere a cada execução. Cada
+	// despesa é avisada uma única vez, então a janela só precisa cobrir execuções perdidas.
+	AnomalyWindowDays = 7
+	// AnomalyLookbackDays é o histórico, antes da janela, usado como padrão de cada categoria.
+	AnomalyLookbackDays = 90
+	// AnomalyMinSamples é o mínimo de despesas no histórico para a categoria ter um padrão.
+	AnomalyMinSamples = 5
+	// AnomalyDeviations é quantos desvios padrão acima da média a despesa precisa estar.
+	AnomalyDeviations = 3.0
+	// AnomalyMinRatio é quantas vezes a média a despesa precisa valer, para que categorias de
+	// valor quase constante não avisem por qualquer centavo a mais.
+	AnomalyMinRatio = 2.0
+)
+
+// IsSpendingAnomaly indica se uma despesa está fora do padrão da categoria, dado o histórico
+// de samples despesas com média average e desvio padrão deviation, todos na moeda base.
+func IsSpendingAnomaly(amount Money, average float64, deviation float64, samples int) bool {
+	if samples < AnomalyMinSamples || average <= 0 {
+		return false
+	}
+
+	value := amount.Float64()
+	return value >= average*AnomalyMinRatio && value > average+AnomalyDeviations*deviation
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAttachmentSize é o tamanho máximo, em bytes, de um arquivo anexado.
+const MaxAttachmentSize int64 = 10 << 20
+
+// AttachmentContentTypes são os tipos aceitos como anexo: comprovantes (PDF e fotos)
+// e arquivos de fatura/extrato (CSV, XLSX e OFX).
+var AttachmentContentTypes = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"text/csv",
+	"text/plain",
+	"application/x-ofx",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	BlobKey     string    `json:"blob_key"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	AttachmentTarget
+}
+
+// AttachmentTarget identifica a quem o anexo pertence: uma transação, uma fatura ou
+// o job de importação que recebeu o arquivo original. Apenas um deles é preenchido.
+type AttachmentTarget struct {
+	TransactionID *uuid.UUID `json:"transaction_id"`
+	InvoiceID     *uuid.UUID `json:"invoice_id"`
+	ImportJobID   *uuid.UUID `json:"import_job_id"`
+}
+
+// NewAttachment valida o nome, o tamanho e o tipo do arquivo enviado. O nome é reduzido
+// ao nome base, descartando qualquer caminho informado pelo cliente.
+func NewAttachment(target AttachmentTarget, filename, contentType string, size int64) (*Attachment, error) {
+	filename = strings.TrimSpace(filepath.Base(strings.ReplaceAll(filename, "\\", "/")))
+	if filename == "" || filename == "." || filename == "/" {
+		return nil, appError.InvalidParam("filename", appError.ErrEmptyField)
+	}
+
+	if size <= 0 {
+		return nil, appError.InvalidParam("file", appError.ErrEmptyField)
+	}
+	if size > MaxAttachmentSize {
+		return nil, appError.ErrAttachmentTooLarge
+	}
+
+	if !slices.Contains(AttachmentContentTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", appError.ErrAttachmentType, contentType)
+	}
+
+	return &Attachment{
+		Filename:         filename,
+		ContentType:      contentType,
+		Size:             size,
+		AttachmentTarget: target,
+	}, nil
+}
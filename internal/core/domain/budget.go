@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BudgetAlertThresholds são os percentuais de uso do orçamento que disparam um alerta.
+var BudgetAlertThresholds = []int{80, 100}
+
+// Budget limita os gastos de uma categoria em um mês. Sem Month o orçamento é recorrente e
+// vale para todos os meses que não têm orçamento próprio. O limite é um valor fixo (Amount)
+// ou um percentual da receita do mês (Percentage).
+type Budget struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	CategoryID uuid.UUID  `json:"category_id"`
+	Month      *time.Time `json:"month"`
+	Amount     *Money     `json:"amount"`
+	Percentage *int       `json:"percentage"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// NewBudget valida o orçamento. Sem valor nem percentual, o repositório usa o percentual
+// sugerido da categoria.
+func NewBudget(categoryID uuid.UUID, month *time.Time, amount *Money, percentage *int) (*Budget, error) {
+	if categoryID == uuid.Nil {
+		return nil, appError.EmptyField("category_id")
+	}
+
+	if amount != nil && percentage != nil {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("inform either amount or percentage"))
+	}
+
+	if amount != nil && *amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	if percentage != nil && (*percentage <= 0 || *percentage > 100) {
+		return nil, appError.InvalidParam("percentage", fmt.Errorf("must be between 1 and 100"))
+	}
+
+	var start *time.Time
+	if month != nil {
+		value := MonthStart(*month)
+		start = &value
+	}
+
+	return &Budget{
+		CategoryID: categoryID,
+		Month:      start,
+		Amount:     amount,
+		Percentage: percentage,
+	}, nil
+}
+
+// MonthStart retorna o primeiro dia do mês da data, em UTC.
+func MonthStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// BudgetLimit calcula o valor orçado no mês a partir da receita do mês.
+func (b Budget) BudgetLimit(income Money) Money {
+	if b.Amount != nil {
+		return *b.Amount
+	}
+	if b.Percentage != nil {
+		return income * Money(*b.Percentage) / 100
+	}
+	return 0
+}
+
+// ProjectSpending estima o gasto no fim do mês mantendo o ritmo diário observado até now.
+// Meses encerrados mantêm o gasto real e meses futuros ainda não têm projeção.
+func ProjectSpending(spent Money, month time.Time, now time.Time) Money {
+	start := MonthStart(month)
+	end := start.AddDate(0, 1, 0)
+	now = now.UTC()
+
+	switch {
+	case !now.Before(end):
+		return spent
+	case now.Before(start):
+		return 0
+	}
+
+	days := int64(end.Sub(start).Hours() / 24)
+	elapsed := int64(now.Day())
+	return Money(int64(spent) * days / elapsed)
+}
+
+// BudgetThreshold retorna o maior limite de alerta atingido pelo gasto (0 se nenhum).
+func BudgetThreshold(spent, budgeted Money) int {
+	reached := 0
+	if budgeted <= 0 {
+		return reached
+	}
+	for _, threshold := range BudgetAlertThresholds {
+		if int64(spent)*100 >= int64(budgeted)*int64(threshold) {
+			reached = threshold
+		}
+	}
+	return reached
+}
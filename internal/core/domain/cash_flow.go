@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CashFlowDefaultDays é o horizonte padrão da previsão, em dias.
+	CashFlowDefaultDays = 90
+	// CashFlowMaxDays é o maior horizonte aceito, em dias.
+	CashFlowMaxDays = 365
+	// CashFlowDefaultLookback é a janela padrão, em dias, da média de gastos variáveis.
+	CashFlowDefaultLookback = 90
+)
+
+type CashFlowKind string
+
+const (
+	CashFlowTransaction     CashFlowKind = "transaction"
+	CashFlowInvoice         CashFlowKind = "invoice"
+	CashFlowLoanInstallment CashFlowKind = "loan_installment"
+	CashFlowRecurring       CashFlowKind = "recurring"
+	CashFlowCardInstallment CashFlowKind = "card_installment"
+)
+
+// CashFlowItem é uma entrada (positiva) ou saída (negativa) prevista, na moeda base. Itens sem
+// conta entram só no saldo total.
+type CashFlowItem struct {
+	Date      time.Time    `json:"date"`
+	Kind      CashFlowKind `json:"kind"`
+	SourceID  uuid.UUID    `json:"source_id"`
+	Title     string       `json:"title"`
+	AccountID *uuid.UUID   `json:"account_id"`
+	Amount    Money        `json:"amount"`
+}
+
+// CashFlowEstimate é o gasto variável médio de uma categoria: Total é o gasto pago na janela
+// e DailyAmount a média diária projetada em todos os dias da previsão.
+type CashFlowEstimate struct {
+	CategoryID  *uuid.UUID `json:"category_id"`
+	Total       Money      `json:"total"`
+	DailyAmount float64    `json:"daily_amount"`
+}
+
+type CashFlowDay struct {
+	Date     time.Time `json:"date"`
+	Inflow   Money     `json:"inflow"`
+	Outflow  Money     `json:"outflow"`
+	Estimate Money     `json:"estimate"`
+	Balance  Money     `json:"balance"`
+}
+
+// CashFlowProjection é a evolução diária de um saldo. FirstNegativeDate é o primeiro dia em que
+// o saldo fica negativo, se houver.
+type CashFlowProjection struct {
+	OpeningBalance    Money         `json:"opening_balance"`
+	ClosingBalance    Money         `json:"closing_balance"`
+	LowestBalance     Money         `json:"lowest_balance"`
+	FirstNegativeDate *time.Time    `json:"first_negative_date"`
+	Days              []CashFlowDay `json:"days"`
+}
+
+// ProjectCashFlow projeta o saldo dia a dia de start a end, inclusive. Itens vencidos antes de
+// start entram no primeiro dia. dailyEstimate é descontado todo dia, acumulando os centavos
+// para que o total projetado não perca o arredondamento.
+func ProjectCashFlow(start, end time.Time, opening Money, items []CashFlowItem, dailyEstimate float64) CashFlowProjection {
+	byDate := map[time.Time][]CashFlowItem{}
+	for _, item := range items {
+		date := item.Date
+		if date.Before(start) {
+			date = start
+		}
+		byDate[date] = append(byDate[date], item)
+	}
+
+	projection := CashFlowProjection{
+		OpeningBalance: opening,
+		LowestBalance:  opening,
+		Days:           []CashFlowDay{},
+	}
+
+	balance := opening
+	var estimated Money
+	for date, day := start, 1; !date.After(end); date, day = date.AddDate(0, 0, 1), day+1 {
+		entry := CashFlowDay{Date: date}
+		for _, item := range byDate[date] {
+			if item.Amount >= 0 {
+				entry.Inflow += item.Amount
+			} else {
+				entry.Outflow -= item.Amount
+			}
+		}
+
+		total := Money(math.Round(dailyEstimate * float64(day)))
+		entry.Estimate = total - estimated
+		estimated = total
+
+		balance += entry.Inflow - entry.Outflow - entry.Estimate
+		entry.Balance = balance
+
+		if balance < projection.LowestBalance {
+			projection.LowestBalance = balance
+		}
+		if balance < 0 && projection.FirstNegativeDate == nil {
+			negative := date
+			projection.FirstNegativeDate = &negative
+		}
+
+		projection.Days = append(projection.Days, entry)
+	}
+	projection.ClosingBalance = balance
+
+	return projection
+}
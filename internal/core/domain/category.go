@@ -1,7 +1,9 @@
 package domain
 
 import (
+	"fmt"
 	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
 
 	"github.com/google/uuid"
 )
@@ -12,19 +14,118 @@ type Category struct {
 	Description         *string
 	Color               *string
 	SuggestedPercentage *int
+	ParentID            *uuid.UUID
+	TaxGroup            *TaxGroup
 	CreatedAt           string
 	UpdatedAt           string
 }
 
-func NewCategory(name string, description, color *string, suggestedPercentage *int) (*Category, error) {
+func NewCategory(name string, description, color *string, suggestedPercentage *int, parentID *uuid.UUID, taxGroup *TaxGroup) (*Category, error) {
 	if name == "" {
 		return nil, appError.EmptyField("name")
 	}
 
+	if taxGroup != nil && !taxGroup.IsValid() {
+		return nil, appError.InvalidParam("tax_group", fmt.Errorf("invalid value"))
+	}
+
 	return &Category{
 		Name:                name,
 		Description:         description,
 		Color:               color,
 		SuggestedPercentage: suggestedPercentage,
+		ParentID:            parentID,
+		TaxGroup:            taxGroup,
 	}, nil
 }
+
+// CategoryTree mapeia cada categoria do usuário para o seu pai (nil nas categorias raiz).
+type CategoryTree map[uuid.UUID]*uuid.UUID
+
+// Depth retorna quantos ancestrais a categoria possui; categorias raiz têm profundidade 0.
+func (t CategoryTree) Depth(id uuid.UUID) int {
+	depth := 0
+	visited := map[uuid.UUID]bool{id: true}
+	for parent := t[id]; parent != nil && !visited[*parent]; parent = t[*parent] {
+		visited[*parent] = true
+		depth++
+	}
+	return depth
+}
+
+// Rollup retorna o ancestral da categoria que está na profundidade informada.
+// Categorias mais rasas que a profundidade pedida são retornadas sem alteração.
+func (t CategoryTree) Rollup(id uuid.UUID, depth int) uuid.UUID {
+	for steps := t.Depth(id) - depth; steps > 0; steps-- {
+		id = *t[id]
+	}
+	return id
+}
+
+// CreatesCycle indica se tornar parentID o pai de id criaria um ciclo na hierarquia.
+func (t CategoryTree) CreatesCycle(id uuid.UUID, parentID uuid.UUID) bool {
+	visited := map[uuid.UUID]bool{}
+	for current := &parentID; current != nil && !visited[*current]; current = t[*current] {
+		if *current == id {
+			return true
+		}
+		visited[*current] = true
+	}
+	return false
+}
+
+// DefaultCategoryName é a categoria usada quando nenhuma outra se aplica à transação.
+const DefaultCategoryName = "Sem categoria"
+
+// DefaultCategories retorna o conjunto de categorias criado para todo novo usuário.
+func DefaultCategories() []Category {
+	return []Category{
+		{
+			Name:                "Assinaturas",
+			Description:         utils.StringPtr("Serviços recorrentes como streaming, apps e plataformas."),
+			Color:               utils.StringPtr("#FF6B6B"),
+			SuggestedPercentage: utils.IntPtr(5),
+		},
+		{
+			Name:                "Alimentação e delivery",
+			Description:         utils.StringPtr("Mercado, restaurantes, delivery, cafés, padarias"),
+			Color:               utils.StringPtr("#FFA94D"),
+			SuggestedPercentage: utils.IntPtr(20),
+		},
+		{
+			Name:                "Saúde e bem-estar",
+			Description:         utils.StringPtr("Farmácia, plano de saúde, terapias e autocuidado."),
+			Color:               utils.StringPtr("#20C997"),
+			SuggestedPercentage: utils.IntPtr(5),
+		},
+		{
+			Name:                "Compras pessoais",
+			Description:         utils.StringPtr("Produtos online, marketplaces, roupas, estética e cuidados pessoais."),
+			Color:               utils.StringPtr("#845EF7"),
+			SuggestedPercentage: utils.IntPtr(10),
+		},
+		{
+			Name:                "Transporte",
+			Description:         utils.StringPtr("Uber, 99, combustível e transporte em geral."),
+			Color:               utils.StringPtr("#339AF0"),
+			SuggestedPercentage: utils.IntPtr(5),
+		},
+		{
+			Name:                "Lazer",
+			Description:         utils.StringPtr("Bares, festas, eventos, shows, cinema e entretenimento."),
+			Color:               utils.StringPtr("#DA77F2"),
+			SuggestedPercentage: utils.IntPtr(5),
+		},
+		{
+			Name:                "Moradia",
+			Description:         utils.StringPtr("Aluguel, condomínio, luz, água, gás e contas da casa."),
+			Color:               utils.StringPtr("#FFB3C1"),
+			SuggestedPercentage: utils.IntPtr(30),
+		},
+		{
+			Name:        DefaultCategoryName,
+			Description: utils.StringPtr("Gastos não classificados ou indefinidos."),
+			Color:       utils.StringPtr("#CBD5E1"),
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+type CategorySource string
+
+const (
+	CategorySourceManual  CategorySource = "manual"
+	CategorySourceRule    CategorySource = "rule"
+	CategorySourceLearned CategorySource = "learned"
+	CategorySourceKeyword CategorySource = "keyword"
+)
+
+func ValidCategorySource() []string {
+	return []string{
+		string(CategorySourceManual),
+		string(CategorySourceRule),
+		string(CategorySourceLearned),
+		string(CategorySourceKeyword),
+	}
+}
+
+func (s CategorySource) IsValid() bool {
+	return slices.Contains(ValidCategorySource(), string(s))
+}
+
+// TrainingWeight define o peso de uma transação no treino do classificador. Correções
+// feitas pelo usuário valem mais; categorias atribuídas pelo próprio classificador ou pelo
+// palpite por palavra-chave são ignoradas, para que ele não reforce os próprios erros nem
+// aprenda chutes que o usuário nunca confirmou.
+func (s *CategorySource) TrainingWeight() float64 {
+	if s == nil {
+		return 1
+	}
+
+	switch *s {
+	case CategorySourceManual:
+		return 3
+	case CategorySourceLearned, CategorySourceKeyword:
+		return 0
+	default:
+		return 1
+	}
+}
+
+type CategoryPrediction struct {
+	CategoryID uuid.UUID
+	Confidence float64
+}
+
+// CategoryClassifier é um classificador naive Bayes multinomial sobre os tokens
+// normalizados do título e a faixa de valor da transação.
+type CategoryClassifier struct {
+	docs        float64
+	classDocs   map[uuid.UUID]float64
+	tokenCounts map[uuid.UUID]map[string]float64
+	tokenTotals map[uuid.UUID]float64
+	vocabulary  map[string]struct{}
+}
+
+func NewCategoryClassifier() *CategoryClassifier {
+	return &CategoryClassifier{
+		classDocs:   map[uuid.UUID]float64{},
+		tokenCounts: map[uuid.UUID]map[string]float64{},
+		tokenTotals: map[uuid.UUID]float64{},
+		vocabulary:  map[string]struct{}{},
+	}
+}
+
+// Samples retorna a soma dos pesos dos exemplos usados no treino.
+func (c *CategoryClassifier) Samples() float64 {
+	return c.docs
+}
+
+func (c *CategoryClassifier) Train(title string, amount Money, categoryID uuid.UUID, weight float64) {
+	if weight <= 0 {
+		return
+	}
+
+	features := ClassifierFeatures(title, amount)
+	if len(features) == 0 {
+		return
+	}
+
+	c.docs += weight
+	c.classDocs[categoryID] += weight
+
+	counts, ok := c.tokenCounts[categoryID]
+	if !ok {
+		counts = map[string]float64{}
+		c.tokenCounts[categoryID] = counts
+	}
+
+	for _, feature := range features {
+		counts[feature] += weight
+		c.tokenTotals[categoryID] += weight
+		c.vocabulary[feature] = struct{}{}
+	}
+}
+
+// Predict retorna as categorias ordenadas da mais para a menos provável.
+func (c *CategoryClassifier) Predict(title string, amount Money) []CategoryPrediction {
+	features := ClassifierFeatures(title, amount)
+	if c.docs == 0 || len(features) == 0 {
+		return nil
+	}
+
+	vocabulary := float64(len(c.vocabulary))
+	scores := make(map[uuid.UUID]float64, len(c.classDocs))
+	best := math.Inf(-1)
+
+	for categoryID, docs := range c.classDocs {
+		// Suavização de Laplace para tokens nunca vistos na categoria
+		score := math.Log(docs / c.docs)
+		for _, feature := range features {
+			score += math.Log((c.tokenCounts[categoryID][feature] + 1) / (c.tokenTotals[categoryID] + vocabulary))
+		}
+		scores[categoryID] = score
+		best = math.Max(best, score)
+	}
+
+	var total float64
+	for _, score := range scores {
+		total += math.Exp(score - best)
+	}
+
+	predictions := make([]CategoryPrediction, 0, len(scores))
+	for categoryID, score := range scores {
+		predictions = append(predictions, CategoryPrediction{
+			CategoryID: categoryID,
+			Confidence: math.Exp(score-best) / total,
+		})
+	}
+
+	slices.SortFunc(predictions, func(a, b CategoryPrediction) int {
+		if a.Confidence != b.Confidence {
+			if a.Confidence > b.Confidence {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.CategoryID.String(), b.CategoryID.String())
+	})
+
+	return predictions
+}
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// ClassifierFeatures normaliza o título em tokens (minúsculos, sem acentos, sem números)
+// e acrescenta a faixa de valor da transação.
+func ClassifierFeatures(title string, amount Money) []string {
+	normalized := accentReplacer.Replace(strings.ToLower(title))
+
+	tokens := strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	features := make([]string, 0, len(tokens)+1)
+	for _, token := range tokens {
+		if len(token) < 2 {
+			continue
+		}
+		features = append(features, token)
+	}
+
+	if len(features) == 0 {
+		return nil
+	}
+
+	return append(features, amountBucket(amount))
+}
+
+func amountBucket(amount Money) string {
+	value := amount.Abs().Float64()
+
+	for _, limit := range []float64{10, 50, 100, 250, 500, 1000, 5000} {
+		if value < limit {
+			return fmt.Sprintf("amount:<%g", limit)
+		}
+	}
+	return "amount:>=5000"
+}
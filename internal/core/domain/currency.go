@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultCurrency é a moeda base dos usuários e a moeda das transações sem moeda informada.
+const DefaultCurrency = "BRL"
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// NormalizeCurrency valida um código de moeda ISO 4217 (como "USD" ou "EUR"),
+// aceitando-o em minúsculas.
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !currencyCode.MatchString(code) {
+		return "", fmt.Errorf("invalid currency code: %q", code)
+	}
+	return code, nil
+}
+
+// ExchangeRate é a cotação de uma moeda em outra numa data: 1 FromCurrency vale Rate ToCurrency.
+type ExchangeRate struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"user_id"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         float64   `json:"rate"`
+	RateDate     time.Time `json:"rate_date"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func NewExchangeRate(fromCurrency, toCurrency string, rate float64, rateDate time.Time) (*ExchangeRate, error) {
+	from, err := NormalizeCurrency(fromCurrency)
+	if err != nil {
+		return nil, appError.InvalidParam("from_currency", err)
+	}
+
+	to, err := NormalizeCurrency(toCurrency)
+	if err != nil {
+		return nil, appError.InvalidParam("to_currency", err)
+	}
+
+	if from == to {
+		return nil, appError.InvalidParam("to_currency", fmt.Errorf("must differ from from_currency"))
+	}
+
+	if rate <= 0 {
+		return nil, appError.InvalidParam("rate", fmt.Errorf("must be greater than zero"))
+	}
+
+	if rateDate.IsZero() {
+		return nil, appError.EmptyField("rate_date")
+	}
+
+	return &ExchangeRate{
+		FromCurrency: from,
+		ToCurrency:   to,
+		Rate:         rate,
+		RateDate:     time.Date(rateDate.Year(), rateDate.Month(), rateDate.Day(), 0, 0, 0, 0, time.UTC),
+	}, nil
+}
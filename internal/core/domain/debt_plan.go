@@ -0,0 +1,308 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DebtCardMinimumPercentage é o pagamento mínimo padrão das faturas de cartão, em
+// percentual do saldo, usado quando o plano não informa outro.
+const DebtCardMinimumPercentage = 15.0
+
+type DebtKind string
+
+const (
+	DebtInvoice DebtKind = "invoice"
+	DebtLoan    DebtKind = "loan"
+)
+
+type DebtStrategy string
+
+const (
+	DebtSnowball  DebtStrategy = "snowball"
+	DebtAvalanche DebtStrategy = "avalanche"
+	DebtCustom    DebtStrategy = "custom"
+)
+
+func ValidDebtStrategy() []string {
+	return []string{
+		string(DebtSnowball),
+		string(DebtAvalanche),
+		string(DebtCustom),
+	}
+}
+
+func (s DebtStrategy) IsValid() bool {
+	return slices.Contains(ValidDebtStrategy(), string(s))
+}
+
+// OpenDebt é uma dívida em aberto na moeda base. InterestRate é o percentual ao mês e
+// MinimumPayment é o valor pago todo mês antes de distribuir o restante do orçamento.
+// Quando MinimumPercentage é informado, como nas faturas de cartão, o mínimo é esse
+// percentual do saldo de cada mês e MinimumPayment é o mínimo do saldo atual.
+type OpenDebt struct {
+	ID                uuid.UUID `json:"id"`
+	Kind              DebtKind  `json:"kind"`
+	Name              string    `json:"name"`
+	Balance           Money     `json:"balance"`
+	InterestRate      float64   `json:"interest_rate"`
+	MinimumPayment    Money     `json:"minimum_payment"`
+	MinimumPercentage *float64  `json:"minimum_percentage"`
+}
+
+// MinimumFor é o pagamento mínimo da dívida para o saldo informado.
+func (d OpenDebt) MinimumFor(balance Money) Money {
+	minimum := d.MinimumPayment
+	if d.MinimumPercentage != nil {
+		minimum = Money(math.Round(float64(balance) * *d.MinimumPercentage / 100))
+	}
+	return min(minimum, balance)
+}
+
+// DebtPlan são os parâmetros da simulação. As faturas não têm taxa cadastrada, então os
+// juros e o mínimo do cartão vêm do plano; CustomOrder define a prioridade da estratégia
+// custom e StartDate é a data do primeiro pagamento.
+type DebtPlan struct {
+	MonthlyBudget         Money       `json:"monthly_budget"`
+	CardInterestRate      float64     `json:"card_interest_rate"`
+	CardMinimumPercentage float64     `json:"card_minimum_percentage"`
+	CustomOrder           []uuid.UUID `json:"custom_order"`
+	StartDate             time.Time   `json:"start_date"`
+}
+
+// DebtPayoff é o resultado de uma dívida na simulação: o mês (a partir de 1) e a data em que
+// é quitada, e quanto foi pago de juros e no total.
+type DebtPayoff struct {
+	Debt         OpenDebt  `json:"debt"`
+	Month        int       `json:"month"`
+	PayoffDate   time.Time `json:"payoff_date"`
+	InterestPaid Money     `json:"interest_paid"`
+	TotalPaid    Money     `json:"total_paid"`
+}
+
+// DebtPlanResult é a simulação de uma estratégia. Payoffs segue a ordem de prioridade usada.
+type DebtPlanResult struct {
+	Strategy      DebtStrategy `json:"strategy"`
+	Months        int          `json:"months"`
+	PayoffDate    time.Time    `json:"payoff_date"`
+	TotalInterest Money        `json:"total_interest"`
+	TotalPaid     Money        `json:"total_paid"`
+	Payoffs       []DebtPayoff `json:"payoffs"`
+}
+
+func NewDebtPlan(monthlyBudget Money, cardInterestRate float64, cardMinimumPercentage *float64, customOrder []uuid.UUID, startDate time.Time) (*DebtPlan, error) {
+	if monthlyBudget <= 0 {
+		return nil, appError.InvalidParam("monthly_budget", fmt.Errorf("must be greater than zero"))
+	}
+
+	if cardInterestRate < 0 || cardInterestRate >= 100 || math.IsNaN(cardInterestRate) {
+		return nil, appError.InvalidParam("card_interest_rate", fmt.Errorf("must be between 0 and 100"))
+	}
+
+	minimum := DebtCardMinimumPercentage
+	if cardMinimumPercentage != nil {
+		minimum = *cardMinimumPercentage
+	}
+	if minimum < 0 || minimum > 100 || math.IsNaN(minimum) {
+		return nil, appError.InvalidParam("card_minimum_percentage", fmt.Errorf("must be between 0 and 100"))
+	}
+
+	for i, id := range customOrder {
+		if slices.Contains(customOrder[:i], id) {
+			return nil, appError.InvalidParam("custom_order", fmt.Errorf("duplicated debt %s", id))
+		}
+	}
+
+	if startDate.IsZero() {
+		now := time.Now().UTC()
+		startDate = time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return &DebtPlan{
+		MonthlyBudget:         monthlyBudget,
+		CardInterestRate:      cardInterestRate,
+		CardMinimumPercentage: minimum,
+		CustomOrder:           customOrder,
+		StartDate:             time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+// ApplyCardTerms completa as faturas com a taxa e o percentual mínimo do cartão definidos
+// no plano.
+func (p DebtPlan) ApplyCardTerms(debts []OpenDebt) []OpenDebt {
+	result := make([]OpenDebt, 0, len(debts))
+	for _, debt := range debts {
+		if debt.Kind == DebtInvoice {
+			percentage := p.CardMinimumPercentage
+			debt.InterestRate = p.CardInterestRate
+			debt.MinimumPercentage = &percentage
+			debt.MinimumPayment = debt.MinimumFor(debt.Balance)
+		}
+		result = append(result, debt)
+	}
+	return result
+}
+
+// Strategies são as estratégias simuladas pelo plano; custom só entra com uma ordem informada.
+func (p DebtPlan) Strategies() []DebtStrategy {
+	strategies := []DebtStrategy{DebtSnowball, DebtAvalanche}
+	if len(p.CustomOrder) > 0 {
+		strategies = append(strategies, DebtCustom)
+	}
+	return strategies
+}
+
+// PaymentDate é a data do pagamento do mês informado (a partir de 1): o dia de StartDate,
+// limitado ao último dia nos meses mais curtos.
+func (p DebtPlan) PaymentDate(month int) time.Time {
+	start := p.StartDate
+	first := time.Date(start.Year(), start.Month()+time.Month(month-1), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(start.Day(), lastDay), 0, 0, 0, 0, time.UTC)
+}
+
+// Order define a prioridade das dívidas na estratégia. Snowball quita primeiro o menor saldo
+// e avalanche a maior taxa; na custom as dívidas fora de CustomOrder vão para o fim, na ordem
+// do snowball.
+func (p DebtPlan) Order(debts []OpenDebt, strategy DebtStrategy) ([]OpenDebt, error) {
+	ordered := slices.Clone(debts)
+	slices.SortStableFunc(ordered, func(a, b OpenDebt) int {
+		if a.Balance != b.Balance {
+			return cmp.Compare(a.Balance, b.Balance)
+		}
+		return cmp.Compare(b.InterestRate, a.InterestRate)
+	})
+
+	switch strategy {
+	case DebtAvalanche:
+		slices.SortStableFunc(ordered, func(a, b OpenDebt) int {
+			return cmp.Compare(b.InterestRate, a.InterestRate)
+		})
+	case DebtCustom:
+		custom := make([]OpenDebt, 0, len(ordered))
+		for _, id := range p.CustomOrder {
+			index := slices.IndexFunc(ordered, func(debt OpenDebt) bool { return debt.ID == id })
+			if index < 0 {
+				return nil, appError.InvalidParam("custom_order", fmt.Errorf("debt %s not found", id))
+			}
+			custom = append(custom, ordered[index])
+			ordered = slices.Delete(ordered, index, index+1)
+		}
+		ordered = append(custom, ordered...)
+	}
+
+	return ordered, nil
+}
+
+// Simulate paga as dívidas mês a mês: os juros do mês incidem sobre o saldo, os mínimos são
+// pagos e o que sobra do orçamento vai para a dívida de maior prioridade ainda em aberto. Os
+// mínimos percentuais são recalculados sobre o saldo do mês e os das dívidas quitadas
+// passam a reforçar as seguintes.
+func (p DebtPlan) Simulate(debts []OpenDebt, strategy DebtStrategy) (*DebtPlanResult, error) {
+	ordered, err := p.Order(debts, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if MinimumPayments(ordered) > p.MonthlyBudget {
+		return nil, appError.ErrDebtBudgetTooLow
+	}
+
+	balances := make([]Money, len(ordered))
+	result := &DebtPlanResult{
+		Strategy: strategy,
+		Payoffs:  make([]DebtPayoff, len(ordered)),
+	}
+
+	open := 0
+	for i, debt := range ordered {
+		balances[i] = debt.Balance
+		result.Payoffs[i] = DebtPayoff{Debt: debt}
+		if debt.Balance > 0 {
+			open++
+		}
+	}
+
+	for month := 1; open > 0; month++ {
+		if month > LoanMaxTerm {
+			return nil, appError.ErrDebtPlanTooLong
+		}
+
+		available := p.MonthlyBudget
+		pay := func(i int, amount Money) {
+			balances[i] -= amount
+			available -= amount
+			result.Payoffs[i].TotalPaid += amount
+		}
+
+		for i, debt := range ordered {
+			if balances[i] <= 0 {
+				continue
+			}
+			interest := Money(math.Round(float64(balances[i]) * debt.InterestRate / 100))
+			balances[i] += interest
+			result.Payoffs[i].InterestPaid += interest
+		}
+
+		minimums := make([]Money, len(ordered))
+		var totalMinimum Money
+		for i, debt := range ordered {
+			if balances[i] > 0 {
+				minimums[i] = debt.MinimumFor(balances[i])
+				totalMinimum += minimums[i]
+			}
+		}
+		if totalMinimum > p.MonthlyBudget {
+			return nil, appError.ErrDebtBudgetTooLow
+		}
+
+		for i, minimum := range minimums {
+			if minimum > 0 {
+				pay(i, minimum)
+			}
+		}
+
+		for i := range ordered {
+			if available <= 0 {
+				break
+			}
+			if balances[i] > 0 {
+				pay(i, min(available, balances[i]))
+			}
+		}
+
+		for i := range ordered {
+			if balances[i] <= 0 && result.Payoffs[i].Month == 0 && ordered[i].Balance > 0 {
+				result.Payoffs[i].Month = month
+				result.Payoffs[i].PayoffDate = p.PaymentDate(month)
+				open--
+			}
+		}
+		result.Months = month
+	}
+
+	if result.Months > 0 {
+		result.PayoffDate = p.PaymentDate(result.Months)
+	}
+	for _, payoff := range result.Payoffs {
+		result.TotalInterest += payoff.InterestPaid
+		result.TotalPaid += payoff.TotalPaid
+	}
+
+	return result, nil
+}
+
+// MinimumPayments soma os pagamentos mínimos das dívidas sobre o saldo atual.
+func MinimumPayments(debts []OpenDebt) Money {
+	var total Money
+	for _, debt := range debts {
+		total += debt.MinimumFor(debt.Balance)
+	}
+	return total
+}
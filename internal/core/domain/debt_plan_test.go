@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	appError "frog-go/internal/core/errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	debtCard = uuid.MustParse("00000000-0000-0000-0000-0000000000c1")
+	debtLoan = uuid.MustParse("00000000-0000-0000-0000-0000000000c2")
+)
+
+func testDebtPlan(budget Money, customOrder ...uuid.UUID) DebtPlan {
+	return DebtPlan{
+		MonthlyBudget:         budget,
+		CardMinimumPercentage: DebtCardMinimumPercentage,
+		CustomOrder:           customOrder,
+		StartDate:             time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestDebtPlanSimulate(t *testing.T) {
+	small := OpenDebt{ID: debtCard, Name: "Cartão", Balance: 10000, MinimumPayment: 1000}
+	large := OpenDebt{ID: debtLoan, Name: "Empréstimo", Balance: 30000, MinimumPayment: 2000}
+
+	tests := []struct {
+		name          string
+		plan          DebtPlan
+		debts         []OpenDebt
+		strategy      DebtStrategy
+		wantOrder     []uuid.UUID
+		wantMonths    []int
+		wantTotalPaid Money
+		wantInterest  Money
+		wantPayoff    time.Time
+		wantErr       bool
+		wantErrIs     error
+	}{
+		{
+			name:          "snowball sem juros quita o menor saldo primeiro",
+			plan:          testDebtPlan(10000),
+			debts:         []OpenDebt{large, small},
+			strategy:      DebtSnowball,
+			wantOrder:     []uuid.UUID{debtCard, debtLoan},
+			wantMonths:    []int{2, 4},
+			wantTotalPaid: 40000,
+			wantPayoff:    time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "avalanche quita a maior taxa primeiro",
+			plan:      testDebtPlan(10000),
+			debts:     []OpenDebt{small, {ID: debtLoan, Name: "Empréstimo", Balance: 30000, InterestRate: 2, MinimumPayment: 2000}},
+			strategy:  DebtAvalanche,
+			wantOrder: []uuid.UUID{debtLoan, debtCard},
+		},
+		{
+			name:      "custom segue a ordem informada",
+			plan:      testDebtPlan(10000, debtLoan),
+			debts:     []OpenDebt{small, large},
+			strategy:  DebtCustom,
+			wantOrder: []uuid.UUID{debtLoan, debtCard},
+		},
+		{
+			name:     "custom com dívida desconhecida",
+			plan:     testDebtPlan(10000, uuid.MustParse("00000000-0000-0000-0000-0000000000ff")),
+			debts:    []OpenDebt{small, large},
+			strategy: DebtCustom,
+			wantErr:  true,
+		},
+		{
+			name:      "orçamento abaixo dos mínimos",
+			plan:      testDebtPlan(2500),
+			debts:     []OpenDebt{small, large},
+			strategy:  DebtSnowball,
+			wantErr:   true,
+			wantErrIs: appError.ErrDebtBudgetTooLow,
+		},
+		{
+			name:      "juros maiores que o orçamento",
+			plan:      testDebtPlan(1000),
+			debts:     []OpenDebt{{ID: debtCard, Balance: 100000, InterestRate: 5, MinimumPayment: 1000}},
+			strategy:  DebtSnowball,
+			wantErr:   true,
+			wantErrIs: appError.ErrDebtPlanTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.plan.Simulate(tt.debts, tt.strategy)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Simulate = %+v, want error", result)
+				}
+				if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+					t.Fatalf("Simulate error = %v, want %v", err, tt.wantErrIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Simulate error: %v", err)
+			}
+
+			for i, id := range tt.wantOrder {
+				if result.Payoffs[i].Debt.ID != id {
+					t.Errorf("payoff %d = %s, want %s", i, result.Payoffs[i].Debt.ID, id)
+				}
+			}
+			for i, month := range tt.wantMonths {
+				if result.Payoffs[i].Month != month {
+					t.Errorf("payoff %d month = %d, want %d", i, result.Payoffs[i].Month, month)
+				}
+			}
+			if tt.wantTotalPaid > 0 && result.TotalPaid != tt.wantTotalPaid {
+				t.Errorf("TotalPaid = %d, want %d", result.TotalPaid, tt.wantTotalPaid)
+			}
+			if tt.wantTotalPaid > 0 && result.TotalInterest != tt.wantInterest {
+				t.Errorf("TotalInterest = %d, want %d", result.TotalInterest, tt.wantInterest)
+			}
+			if !tt.wantPayoff.IsZero() && !result.PayoffDate.Equal(tt.wantPayoff) {
+				t.Errorf("PayoffDate = %v, want %v", result.PayoffDate, tt.wantPayoff)
+			}
+
+			var balance Money
+			for _, debt := range tt.debts {
+				balance += debt.Balance
+			}
+			if result.TotalPaid != balance+result.TotalInterest {
+				t.Errorf("TotalPaid = %d, want balance %d + interest %d", result.TotalPaid, balance, result.TotalInterest)
+			}
+		})
+	}
+}
+
+func TestDebtPlanAvalancheSavesInterest(t *testing.T) {
+	plan := testDebtPlan(80000)
+	plan.CardInterestRate = 12
+	debts := plan.ApplyCardTerms([]OpenDebt{
+		{ID: debtCard, Kind: DebtInvoice, Name: "Cartão", Balance: 300000},
+		{ID: debtLoan, Kind: DebtLoan, Name: "Empréstimo", Balance: 100000, InterestRate: 1, MinimumPayment: 5000},
+	})
+
+	snowball, err := plan.Simulate(debts, DebtSnowball)
+	if err != nil {
+		t.Fatalf("Simulate snowball error: %v", err)
+	}
+	avalanche, err := plan.Simulate(debts, DebtAvalanche)
+	if err != nil {
+		t.Fatalf("Simulate avalanche error: %v", err)
+	}
+
+	if avalanche.Payoffs[0].Debt.ID != debtCard {
+		t.Errorf("avalanche first payoff = %s, want the card", avalanche.Payoffs[0].Debt.ID)
+	}
+	if avalanche.TotalInterest >= snowball.TotalInterest {
+		t.Errorf("avalanche interest %d should be below snowball interest %d", avalanche.TotalInterest, snowball.TotalInterest)
+	}
+}
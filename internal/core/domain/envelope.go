@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AllocationKind string
+
+const (
+	AllocationAssign AllocationKind = "assign"
+	AllocationMove   AllocationKind = "move"
+)
+
+func ValidAllocationKind() []string {
+	return []string{
+		string(AllocationAssign),
+		string(AllocationMove),
+	}
+}
+
+func (a AllocationKind) IsValid() bool {
+	return slices.Contains(ValidAllocationKind(), string(a))
+}
+
+// EnvelopeAllocation atribui (valor positivo) ou retira (negativo) dinheiro do envelope de
+// uma categoria no mês. Movimentações entre envelopes geram um par de lançamentos com o
+// mesmo TransferID.
+type EnvelopeAllocation struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"user_id"`
+	CategoryID uuid.UUID      `json:"category_id"`
+	Month      time.Time      `json:"month"`
+	Amount     Money          `json:"amount"`
+	Kind       AllocationKind `json:"kind"`
+	TransferID *uuid.UUID     `json:"transfer_id"`
+	Note       *string        `json:"note"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+func NewEnvelopeAllocation(categoryID uuid.UUID, month time.Time, amount Money, note *string) (*EnvelopeAllocation, error) {
+	if categoryID == uuid.Nil {
+		return nil, appError.EmptyField("category_id")
+	}
+
+	if month.IsZero() {
+		return nil, appError.EmptyField("month")
+	}
+
+	if amount == 0 {
+		return nil, appError.EmptyField("amount")
+	}
+
+	return &EnvelopeAllocation{
+		CategoryID: categoryID,
+		Month:      MonthStart(month),
+		Amount:     amount,
+		Kind:       AllocationAssign,
+		Note:       note,
+	}, nil
+}
+
+// EnvelopeMove transfere dinheiro de um envelope para outro dentro do mês.
+type EnvelopeMove struct {
+	FromCategoryID uuid.UUID
+	ToCategoryID   uuid.UUID
+	Month          time.Time
+	Amount         Money
+	Note           *string
+}
+
+func NewEnvelopeMove(fromCategoryID, toCategoryID uuid.UUID, month time.Time, amount Money, note *string) (*EnvelopeMove, error) {
+	if fromCategoryID == uuid.Nil {
+		return nil, appError.EmptyField("from_category_id")
+	}
+
+	if toCategoryID == uuid.Nil {
+		return nil, appError.EmptyField("to_category_id")
+	}
+
+	if fromCategoryID == toCategoryID {
+		return nil, appError.InvalidParam("to_category_id", fmt.Errorf("must differ from from_category_id"))
+	}
+
+	if month.IsZero() {
+		return nil, appError.EmptyField("month")
+	}
+
+	if amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	return &EnvelopeMove{
+		FromCategoryID: fromCategoryID,
+		ToCategoryID:   toCategoryID,
+		Month:          MonthStart(month),
+		Amount:         amount,
+		Note:           note,
+	}, nil
+}
+
+// Allocations retorna o par de lançamentos da movimentação: a saída do envelope de origem
+// e a entrada no de destino.
+func (m EnvelopeMove) Allocations() []EnvelopeAllocation {
+	transferID := uuid.New()
+	return []EnvelopeAllocation{
+		{CategoryID: m.FromCategoryID, Month: m.Month, Amount: -m.Amount, Kind: AllocationMove, TransferID: &transferID, Note: m.Note},
+		{CategoryID: m.ToCategoryID, Month: m.Month, Amount: m.Amount, Kind: AllocationMove, TransferID: &transferID, Note: m.Note},
+	}
+}
+
+// Envelope é a situação do envelope de uma categoria no mês. O saldo (positivo ou
+// negativo) do mês anterior é levado para o seguinte.
+type Envelope struct {
+	CarriedOver Money
+	Assigned    Money
+	Spent       Money
+}
+
+func (e Envelope) Available() Money {
+	return e.CarriedOver + e.Assigned - e.Spent
+}
@@ -0,0 +1,315 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"math"
+	"math/bits"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShareMode string
+
+const (
+	ShareEqual      ShareMode = "equal"
+	SharePercentage ShareMode = "percentage"
+	ShareExact      ShareMode = "exact"
+)
+
+func ValidShareMode() []string {
+	return []string{
+		string(ShareEqual),
+		string(SharePercentage),
+		string(ShareExact),
+	}
+}
+
+func (m ShareMode) IsValid() bool {
+	return slices.Contains(ValidShareMode(), string(m))
+}
+
+// ExpenseParticipant é a parte de um membro em uma despesa dividida. Percentage só é usado
+// no modo percentage e Amount é informado no modo exact e calculado nos demais.
+type ExpenseParticipant struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Percentage *float64  `json:"percentage"`
+	Amount     Money     `json:"amount"`
+}
+
+// ExpenseSharing divide uma despesa paga por PaidByID entre os participantes.
+type ExpenseSharing struct {
+	PaidByID     uuid.UUID            `json:"paid_by_id"`
+	Mode         ShareMode            `json:"mode"`
+	Participants []ExpenseParticipant `json:"participants"`
+}
+
+// Settlement é um pagamento de From para To que abate as dívidas entre os dois.
+type Settlement struct {
+	ID         uuid.UUID `json:"id"`
+	FromUserID uuid.UUID `json:"from_user_id"`
+	ToUserID   uuid.UUID `json:"to_user_id"`
+	Amount     Money     `json:"amount"`
+	SettledAt  time.Time `json:"settled_at"`
+	Note       *string   `json:"note"`
+}
+
+// Debt é quanto From deve pagar a To para zerar os saldos.
+type Debt struct {
+	FromUserID uuid.UUID
+	ToUserID   uuid.UUID
+	Amount     Money
+}
+
+func NewExpenseSharing(paidByID uuid.UUID, mode ShareMode, participants []ExpenseParticipant) (*ExpenseSharing, error) {
+	if paidByID == uuid.Nil {
+		return nil, appError.EmptyField("paid_by_id")
+	}
+
+	if mode == "" {
+		mode = ShareEqual
+	}
+	if !mode.IsValid() {
+		return nil, appError.InvalidParam("mode", fmt.Errorf("invalid value"))
+	}
+
+	if len(participants) == 0 {
+		return nil, appError.EmptyField("participants")
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, participant := range participants {
+		if participant.UserID == uuid.Nil {
+			return nil, appError.EmptyField("participants.user_id")
+		}
+		if seen[participant.UserID] {
+			return nil, appError.InvalidParam("participants.user_id", fmt.Errorf("duplicated participant"))
+		}
+		seen[participant.UserID] = true
+
+		switch mode {
+		case SharePercentage:
+			if participant.Percentage == nil || *participant.Percentage <= 0 || *participant.Percentage > 100 {
+				return nil, appError.InvalidParam("participants.percentage", fmt.Errorf("must be between 0 and 100"))
+			}
+		case ShareExact:
+			if participant.Amount <= 0 {
+				return nil, appError.InvalidParam("participants.amount", fmt.Errorf("must be greater than zero"))
+			}
+		}
+	}
+
+	return &ExpenseSharing{
+		PaidByID:     paidByID,
+		Mode:         mode,
+		Participants: participants,
+	}, nil
+}
+
+// Allocate calcula a parte de cada participante em uma despesa de valor total. Os centavos
+// que sobram dos arredondamentos vão para os primeiros participantes, de modo que as partes
+// sempre somam o total; no modo exact as partes informadas precisam somar o total.
+func (s ExpenseSharing) Allocate(total Money) ([]ExpenseParticipant, error) {
+	participants := slices.Clone(s.Participants)
+	count := Money(len(participants))
+
+	switch s.Mode {
+	case ShareEqual:
+		for i := range participants {
+			participants[i].Amount = total / count
+			participants[i].Percentage = nil
+		}
+	case SharePercentage:
+		var percentage float64
+		for i, participant := range participants {
+			percentage += *participant.Percentage
+			participants[i].Amount = Money(math.Round(float64(total) * *participant.Percentage / 100))
+		}
+		if math.Abs(percentage-100) > 0.005 {
+			return nil, appError.ErrShareMismatch
+		}
+	case ShareExact:
+		var sum Money
+		for i, participant := range participants {
+			sum += participant.Amount
+			participants[i].Percentage = nil
+		}
+		if sum != total {
+			return nil, appError.ErrShareMismatch
+		}
+		return participants, nil
+	}
+
+	var sum Money
+	for _, participant := range participants {
+		sum += participant.Amount
+	}
+
+	step := Money(1)
+	if sum > total {
+		step = -1
+	}
+	for i := 0; sum != total; i = (i + 1) % len(participants) {
+		participants[i].Amount += step
+		sum += step
+	}
+
+	return participants, nil
+}
+
+// simplifyExactLimit é o maior número de membros com saldo para a busca exata, que é
+// exponencial no número de membros.
+const simplifyExactLimit = 16
+
+type netBalance struct {
+	userID uuid.UUID
+	amount Money
+}
+
+// SimplifyDebts transforma os saldos líquidos (positivo: tem a receber) no menor número
+// de pagamentos. Os membros são divididos no maior número possível de grupos que somam
+// zero e cada grupo de k membros é quitado com k-1 pagamentos. Acima de
+// simplifyExactLimit membros com saldo a busca exata é trocada pela quitação direta do
+// maior devedor com o maior credor, que tem no máximo n-1 pagamentos.
+func SimplifyDebts(balances map[uuid.UUID]Money) []Debt {
+	members := make([]netBalance, 0, len(balances))
+	for userID, amount := range balances {
+		if amount != 0 {
+			members = append(members, netBalance{userID, amount})
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].userID.String() < members[j].userID.String()
+	})
+
+	if len(members) > simplifyExactLimit {
+		return settleGroup(members)
+	}
+
+	debts := []Debt{}
+	for _, group := range zeroSumGroups(members) {
+		debts = append(debts, settleGroup(group)...)
+	}
+	return debts
+}
+
+// zeroSumGroups particiona os membros no maior número de grupos de soma zero.
+// groups[mask] guarda quantos grupos fecham ao remover os membros de mask um a um e
+// last[mask] o membro removido para chegar nesse máximo.
+func zeroSumGroups(members []netBalance) [][]netBalance {
+	full := 1<<len(members) - 1
+	sums := make([]Money, full+1)
+	groups := make([]int, full+1)
+	last := make([]int, full+1)
+
+	for mask := 1; mask <= full; mask++ {
+		lowest := bits.TrailingZeros(uint(mask))
+		sums[mask] = sums[mask&(mask-1)] + members[lowest].amount
+
+		groups[mask] = -1
+		for i := range members {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			if count := groups[mask^(1<<i)]; count > groups[mask] {
+				groups[mask] = count
+				last[mask] = i
+			}
+		}
+		if sums[mask] == 0 {
+			groups[mask]++
+		}
+	}
+
+	var partition [][]netBalance
+	var group []netBalance
+	for mask := full; mask != 0; {
+		i := last[mask]
+		group = append(group, members[i])
+		mask ^= 1 << i
+		if sums[mask] == 0 {
+			partition = append(partition, group)
+			group = nil
+		}
+	}
+	return partition
+}
+
+// settleGroup quita o grupo pagando sempre o maior devedor ao maior credor.
+func settleGroup(members []netBalance) []Debt {
+	var creditors, debtors []netBalance
+	for _, member := range members {
+		switch {
+		case member.amount > 0:
+			creditors = append(creditors, member)
+		case member.amount < 0:
+			debtors = append(debtors, netBalance{member.userID, -member.amount})
+		}
+	}
+
+	byAmount := func(items []netBalance) {
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].amount != items[j].amount {
+				return items[i].amount > items[j].amount
+			}
+			return items[i].userID.String() < items[j].userID.String()
+		})
+	}
+	byAmount(creditors)
+	byAmount(debtors)
+
+	debts := []Debt{}
+	for len(creditors) > 0 && len(debtors) > 0 {
+		amount := min(creditors[0].amount, debtors[0].amount)
+		debts = append(debts, Debt{
+			FromUserID: debtors[0].userID,
+			ToUserID:   creditors[0].userID,
+			Amount:     amount,
+		})
+
+		creditors[0].amount -= amount
+		debtors[0].amount -= amount
+		if creditors[0].amount == 0 {
+			creditors = creditors[1:]
+		}
+		if debtors[0].amount == 0 {
+			debtors = debtors[1:]
+		}
+		byAmount(creditors)
+		byAmount(debtors)
+	}
+	return debts
+}
+
+func NewSettlement(fromUserID uuid.UUID, toUserID uuid.UUID, amount Money, settledAt *time.Time, note *string) (*Settlement, error) {
+	if fromUserID == uuid.Nil {
+		return nil, appError.EmptyField("from_user_id")
+	}
+
+	if toUserID == uuid.Nil {
+		return nil, appError.EmptyField("to_user_id")
+	}
+
+	if fromUserID == toUserID {
+		return nil, appError.InvalidParam("to_user_id", fmt.Errorf("must differ from from_user_id"))
+	}
+
+	if amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	settlement := &Settlement{
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		Amount:     amount,
+		SettledAt:  time.Now().UTC(),
+		Note:       note,
+	}
+	if settledAt != nil {
+		settlement.SettledAt = *settledAt
+	}
+	return settlement, nil
+}
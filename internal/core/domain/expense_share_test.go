@@ -0,0 +1,252 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	appError "frog-go/internal/core/errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	memberA = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	memberB = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	memberC = uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+	memberD = uuid.MustParse("00000000-0000-0000-0000-00000000000d")
+	memberE = uuid.MustParse("00000000-0000-0000-0000-00000000000e")
+)
+
+func percentage(value float64) *float64 {
+	return &value
+}
+
+func TestExpenseSharingAllocate(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         ShareMode
+		participants []ExpenseParticipant
+		total        Money
+		want         []Money
+		wantErr      error
+	}{
+		{
+			name:         "igual com sobra de centavo",
+			mode:         ShareEqual,
+			participants: []ExpenseParticipant{{UserID: memberA}, {UserID: memberB}, {UserID: memberC}},
+			total:        100,
+			want:         []Money{34, 33, 33},
+		},
+		{
+			name:         "igual negativo",
+			mode:         ShareEqual,
+			participants: []ExpenseParticipant{{UserID: memberA}, {UserID: memberB}, {UserID: memberC}},
+			total:        -100,
+			want:         []Money{-34, -33, -33},
+		},
+		{
+			name: "percentual",
+			mode: SharePercentage,
+			participants: []ExpenseParticipant{
+				{UserID: memberA, Percentage: percentage(33.33)},
+				{UserID: memberB, Percentage: percentage(33.33)},
+				{UserID: memberC, Percentage: percentage(33.34)},
+			},
+			total: 1000,
+			want:  []Money{334, 333, 333},
+		},
+		{
+			name: "percentual arredondado acima do total",
+			mode: SharePercentage,
+			participants: []ExpenseParticipant{
+				{UserID: memberA, Percentage: percentage(50)},
+				{UserID: memberB, Percentage: percentage(50)},
+			},
+			total: 101,
+			want:  []Money{50, 51},
+		},
+		{
+			name: "percentual que não soma 100",
+			mode: SharePercentage,
+			participants: []ExpenseParticipant{
+				{UserID: memberA, Percentage: percentage(50)},
+				{UserID: memberB, Percentage: percentage(40)},
+			},
+			total:   1000,
+			wantErr: appError.ErrShareMismatch,
+		},
+		{
+			name: "exato",
+			mode: ShareExact,
+			participants: []ExpenseParticipant{
+				{UserID: memberA, Amount: 700},
+				{UserID: memberB, Amount: 300},
+			},
+			total: 1000,
+			want:  []Money{700, 300},
+		},
+		{
+			name: "exato que não soma o total",
+			mode: ShareExact,
+			participants: []ExpenseParticipant{
+				{UserID: memberA, Amount: 700},
+				{UserID: memberB, Amount: 200},
+			},
+			total:   1000,
+			wantErr: appError.ErrShareMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sharing, err := NewExpenseSharing(memberA, tt.mode, tt.participants)
+			if err != nil {
+				t.Fatalf("NewExpenseSharing error: %v", err)
+			}
+
+			got, err := sharing.Allocate(tt.total)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Allocate error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Allocate error: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("len(Allocate) = %d, want %d", len(got), len(tt.want))
+			}
+			for i, participant := range got {
+				if participant.Amount != tt.want[i] {
+					t.Errorf("participant %d amount = %d, want %d", i, participant.Amount, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewExpenseSharing(t *testing.T) {
+	tests := []struct {
+		name         string
+		paidByID     uuid.UUID
+		mode         ShareMode
+		participants []ExpenseParticipant
+		wantErr      bool
+	}{
+		{name: "modo padrão", paidByID: memberA, participants: []ExpenseParticipant{{UserID: memberA}}},
+		{name: "sem pagador", paidByID: uuid.Nil, participants: []ExpenseParticipant{{UserID: memberA}}, wantErr: true},
+		{name: "modo inválido", paidByID: memberA, mode: "half", participants: []ExpenseParticipant{{UserID: memberA}}, wantErr: true},
+		{name: "sem participantes", paidByID: memberA, wantErr: true},
+		{name: "participante repetido", paidByID: memberA, participants: []ExpenseParticipant{{UserID: memberA}, {UserID: memberA}}, wantErr: true},
+		{name: "percentual ausente", paidByID: memberA, mode: SharePercentage, participants: []ExpenseParticipant{{UserID: memberA}}, wantErr: true},
+		{name: "valor exato zerado", paidByID: memberA, mode: ShareExact, participants: []ExpenseParticipant{{UserID: memberA}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewExpenseSharing(tt.paidByID, tt.mode, tt.participants)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewExpenseSharing error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSimplifyDebts(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances map[uuid.UUID]Money
+		want     []Debt
+	}{
+		{
+			name:     "sem saldos",
+			balances: map[uuid.UUID]Money{memberA: 0, memberB: 0},
+			want:     []Debt{},
+		},
+		{
+			name:     "um credor",
+			balances: map[uuid.UUID]Money{memberA: 3000, memberB: -1000, memberC: -2000},
+			want: []Debt{
+				{FromUserID: memberC, ToUserID: memberA, Amount: 2000},
+				{FromUserID: memberB, ToUserID: memberA, Amount: 1000},
+			},
+		},
+		{
+			name:     "grupos independentes",
+			balances: map[uuid.UUID]Money{memberA: 1000, memberB: 500, memberC: -700, memberD: -300, memberE: -500},
+			want: []Debt{
+				{FromUserID: memberE, ToUserID: memberB, Amount: 500},
+				{FromUserID: memberC, ToUserID: memberA, Amount: 700},
+				{FromUserID: memberD, ToUserID: memberA, Amount: 300},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SimplifyDebts(tt.balances)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SimplifyDebts = %+v, want %+v", got, tt.want)
+			}
+
+			net := map[uuid.UUID]Money{}
+			for _, debt := range got {
+				if debt.Amount <= 0 {
+					t.Errorf("debt %+v must be positive", debt)
+				}
+				net[debt.FromUserID] -= debt.Amount
+				net[debt.ToUserID] += debt.Amount
+			}
+			for userID, balance := range tt.balances {
+				if net[userID] != balance {
+					t.Errorf("net balance of %s = %d, want %d", userID, net[userID], balance)
+				}
+			}
+
+			for _, want := range tt.want {
+				found := false
+				for _, debt := range got {
+					if debt == want {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("missing debt %+v in %+v", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSimplifyDebtsAboveExactLimit(t *testing.T) {
+	balances := map[uuid.UUID]Money{}
+	var total Money
+	for i := 0; i < simplifyExactLimit+3; i++ {
+		amount := Money((i + 1) * 100)
+		if i%2 == 1 {
+			amount = -amount
+		}
+		balances[uuid.New()] = amount
+		total += amount
+	}
+	balances[uuid.New()] = -total
+
+	got := SimplifyDebts(balances)
+	if len(got) > len(balances)-1 {
+		t.Errorf("len(SimplifyDebts) = %d, want at most %d", len(got), len(balances)-1)
+	}
+
+	net := map[uuid.UUID]Money{}
+	for _, debt := range got {
+		net[debt.FromUserID] -= debt.Amount
+		net[debt.ToUserID] += debt.Amount
+	}
+	for userID, balance := range balances {
+		if net[userID] != balance {
+			t.Errorf("net balance of %s = %d, want %d", userID, net[userID], balance)
+		}
+	}
+}
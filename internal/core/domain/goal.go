@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GoalPaceMonths é a janela, em meses, usada para medir o ritmo recente de aportes.
+const GoalPaceMonths = 3
+
+// Goal é uma meta de economia: juntar TargetAmount até TargetDate. Os aportes vêm das
+// transferências para a conta vinculada (AccountID), das transações da categoria vinculada
+// (e das suas subcategorias) ou das marcadas com a tag vinculada; basta um dos três.
+type Goal struct {
+	ID            uuid.UUID  `json:"id"`
+	Name          string     `json:"name"`
+	TargetAmount  Money      `json:"target_amount"`
+	InitialAmount Money      `json:"initial_amount"`
+	TargetDate    time.Time  `json:"target_date"`
+	AccountID     *uuid.UUID `json:"account_id"`
+	CategoryID    *uuid.UUID `json:"category_id"`
+	TagID         *uuid.UUID `json:"tag_id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// GoalProgress resume o andamento de uma meta em uma data.
+type GoalProgress struct {
+	Saved         Money
+	Remaining     Money
+	Percentage    float64
+	MonthsLeft    int
+	MonthlyNeeded Money
+	MonthlyPace   Money
+	ProjectedDate *time.Time
+	Achieved      bool
+	OnTrack       bool
+}
+
+func NewGoal(
+	name string,
+	targetAmount Money,
+	initialAmount Money,
+	targetDate time.Time,
+	accountID *uuid.UUID,
+	categoryID *uuid.UUID,
+	tagID *uuid.UUID,
+) (*Goal, error) {
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	if targetAmount <= 0 {
+		return nil, appError.InvalidParam("target_amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	if initialAmount < 0 {
+		return nil, appError.InvalidParam("initial_amount", fmt.Errorf("must not be negative"))
+	}
+
+	if targetDate.IsZero() {
+		return nil, appError.EmptyField("target_date")
+	}
+
+	if accountID == nil && categoryID == nil && tagID == nil {
+		return nil, appError.InvalidParam("account_id", fmt.Errorf("inform an account, a category or a tag to track contributions"))
+	}
+
+	return &Goal{
+		Name:          name,
+		TargetAmount:  targetAmount,
+		InitialAmount: initialAmount,
+		TargetDate:    time.Date(targetDate.Year(), targetDate.Month(), targetDate.Day(), 0, 0, 0, 0, time.UTC),
+		AccountID:     accountID,
+		CategoryID:    categoryID,
+		TagID:         tagID,
+	}, nil
+}
+
+// Progress calcula o andamento da meta em now. contributed é a soma de todos os aportes até
+// now e recent a dos últimos GoalPaceMonths meses, usada para projetar a data de conclusão.
+// MonthlyNeeded divide o que falta pelos meses restantes, contando o mês atual e o mês alvo.
+func (g Goal) Progress(contributed Money, recent Money, now time.Time) GoalProgress {
+	progress := GoalProgress{
+		Saved:       g.InitialAmount + contributed,
+		MonthlyPace: recent / GoalPaceMonths,
+	}
+
+	progress.Remaining = max(g.TargetAmount-progress.Saved, 0)
+	progress.Percentage = math.Round(float64(progress.Saved)*10000/float64(g.TargetAmount)) / 100
+	progress.Achieved = progress.Remaining == 0
+
+	now = now.UTC()
+	progress.MonthsLeft = max((g.TargetDate.Year()-now.Year())*12+int(g.TargetDate.Month()-now.Month())+1, 0)
+
+	if progress.MonthsLeft > 0 {
+		months := Money(progress.MonthsLeft)
+		progress.MonthlyNeeded = (progress.Remaining + months - 1) / months
+	} else {
+		progress.MonthlyNeeded = progress.Remaining
+	}
+
+	if progress.Achieved {
+		progress.OnTrack = true
+		return progress
+	}
+
+	if progress.MonthlyPace > 0 {
+		months := int((progress.Remaining + progress.MonthlyPace - 1) / progress.MonthlyPace)
+		projected := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, months, 0)
+		progress.ProjectedDate = &projected
+		progress.OnTrack = !projected.After(g.TargetDate)
+	}
+
+	return progress
+}
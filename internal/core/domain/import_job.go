@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportJob acompanha uma importação de arquivo. Cada linha vira uma mensagem na fila de
+// transações; a importação termina quando todas foram processadas, com ou sem erro.
+type ImportJob struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Filename    string     `json:"filename"`
+	Total       int        `json:"total"`
+	Processed   int        `json:"processed"`
+	Failed      int        `json:"failed"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+func (j ImportJob) Completed() bool {
+	return j.CompletedAt != nil
+}
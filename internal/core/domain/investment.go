@@ -0,0 +1,318 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// quantityEpsilon absorve os erros de arredondamento na soma das quantidades fracionárias.
+const quantityEpsilon = 1e-9
+
+type InvestmentClass string
+
+const (
+	InvestmentTreasury    InvestmentClass = "treasury"
+	InvestmentFixedIncome InvestmentClass = "fixed_income"
+	InvestmentStock       InvestmentClass = "stock"
+	InvestmentREIT        InvestmentClass = "reit"
+	InvestmentETF         InvestmentClass = "etf"
+	InvestmentCrypto      InvestmentClass = "crypto"
+	InvestmentOther       InvestmentClass = "other"
+)
+
+func ValidInvestmentClass() []string {
+	return []string{
+		string(InvestmentTreasury),
+		string(InvestmentFixedIncome),
+		string(InvestmentStock),
+		string(InvestmentREIT),
+		string(InvestmentETF),
+		string(InvestmentCrypto),
+		string(InvestmentOther),
+	}
+}
+
+func (c InvestmentClass) IsValid() bool {
+	return slices.Contains(ValidInvestmentClass(), string(c))
+}
+
+type TradeKind string
+
+const (
+	TradeBuy  TradeKind = "buy"
+	TradeSell TradeKind = "sell"
+)
+
+func ValidTradeKind() []string {
+	return []string{
+		string(TradeBuy),
+		string(TradeSell),
+	}
+}
+
+func (k TradeKind) IsValid() bool {
+	return slices.Contains(ValidTradeKind(), string(k))
+}
+
+type IncomeKind string
+
+const (
+	IncomeDividend IncomeKind = "dividend"
+	IncomeCoupon   IncomeKind = "coupon"
+	IncomeJCP      IncomeKind = "jcp"
+)
+
+func ValidIncomeKind() []string {
+	return []string{
+		string(IncomeDividend),
+		string(IncomeCoupon),
+		string(IncomeJCP),
+	}
+}
+
+func (k IncomeKind) IsValid() bool {
+	return slices.Contains(ValidIncomeKind(), string(k))
+}
+
+// Label é o nome do provento usado no título da transação de receita.
+func (k IncomeKind) Label() string {
+	switch k {
+	case IncomeDividend:
+		return "Dividendos"
+	case IncomeCoupon:
+		return "Cupom"
+	case IncomeJCP:
+		return "JCP"
+	default:
+		return string(k)
+	}
+}
+
+type Holding struct {
+	ID         uuid.UUID       `json:"id"`
+	Name       string          `json:"name"`
+	Ticker     string          `json:"ticker"`
+	AssetClass InvestmentClass `json:"asset_class"`
+	Currency   string          `json:"currency"`
+	AccountID  *uuid.UUID      `json:"account_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// InvestmentTrade é um lote de compra ou venda. Price é o preço unitário e Fees as taxas
+// da operação, ambos na moeda do investimento.
+type InvestmentTrade struct {
+	ID        uuid.UUID `json:"id"`
+	HoldingID uuid.UUID `json:"holding_id"`
+	Kind      TradeKind `json:"kind"`
+	TradeDate time.Time `json:"trade_date"`
+	Quantity  float64   `json:"quantity"`
+	Price     Money     `json:"price"`
+	Fees      Money     `json:"fees"`
+	Note      *string   `json:"note"`
+}
+
+// InvestmentQuote é o preço unitário em uma data. Na importação de CSV o investimento vem
+// pelo Ticker; nas demais rotas, por HoldingID.
+type InvestmentQuote struct {
+	ID        uuid.UUID  `json:"id"`
+	HoldingID *uuid.UUID `json:"holding_id"`
+	Ticker    string     `json:"ticker"`
+	QuoteDate time.Time  `json:"quote_date"`
+	Price     Money      `json:"price"`
+}
+
+// InvestmentIncome é um provento recebido. CategoryID é a categoria da transação de receita
+// gerada para o provento.
+type InvestmentIncome struct {
+	ID          uuid.UUID  `json:"id"`
+	HoldingID   uuid.UUID  `json:"holding_id"`
+	Kind        IncomeKind `json:"kind"`
+	PaymentDate time.Time  `json:"payment_date"`
+	Amount      Money      `json:"amount"`
+	Note        *string    `json:"note"`
+	CategoryID  *uuid.UUID `json:"category_id"`
+}
+
+// Position é a posição de um investimento pelo método do custo médio: as compras somam
+// quantidade e custo (preço mais taxas) e as vendas baixam o custo pelo preço médio,
+// realizando o ganho ou a perda da diferença.
+type Position struct {
+	Quantity     float64
+	CostBasis    Money
+	AverageCost  Money
+	RealizedGain Money
+}
+
+func NewHolding(name string, ticker string, assetClass InvestmentClass, currency string, accountID *uuid.UUID) (*Holding, error) {
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	ticker = strings.ToUpper(strings.TrimSpace(ticker))
+	if ticker == "" {
+		return nil, appError.EmptyField("ticker")
+	}
+
+	if assetClass == "" {
+		assetClass = InvestmentOther
+	}
+	if !assetClass.IsValid() {
+		return nil, appError.InvalidParam("asset_class", fmt.Errorf("invalid value"))
+	}
+
+	if currency != "" {
+		var err error
+		currency, err = NormalizeCurrency(currency)
+		if err != nil {
+			return nil, appError.InvalidParam("currency", err)
+		}
+	}
+
+	return &Holding{
+		Name:       name,
+		Ticker:     ticker,
+		AssetClass: assetClass,
+		Currency:   currency,
+		AccountID:  accountID,
+	}, nil
+}
+
+func NewInvestmentTrade(
+	holdingID uuid.UUID,
+	kind TradeKind,
+	tradeDate time.Time,
+	quantity float64,
+	price Money,
+	fees Money,
+	note *string,
+) (*InvestmentTrade, error) {
+	if !kind.IsValid() {
+		return nil, appError.InvalidParam("kind", fmt.Errorf("invalid value"))
+	}
+
+	if tradeDate.IsZero() {
+		return nil, appError.EmptyField("trade_date")
+	}
+
+	if quantity <= 0 || math.IsNaN(quantity) || math.IsInf(quantity, 0) {
+		return nil, appError.InvalidParam("quantity", fmt.Errorf("must be greater than zero"))
+	}
+
+	if price < 0 {
+		return nil, appError.InvalidParam("price", fmt.Errorf("must not be negative"))
+	}
+
+	if fees < 0 {
+		return nil, appError.InvalidParam("fees", fmt.Errorf("must not be negative"))
+	}
+
+	return &InvestmentTrade{
+		HoldingID: holdingID,
+		Kind:      kind,
+		TradeDate: tradeDate,
+		Quantity:  quantity,
+		Price:     price,
+		Fees:      fees,
+		Note:      note,
+	}, nil
+}
+
+func NewInvestmentQuote(holdingID *uuid.UUID, ticker string, quoteDate time.Time, price Money) (*InvestmentQuote, error) {
+	ticker = strings.ToUpper(strings.TrimSpace(ticker))
+	if holdingID == nil && ticker == "" {
+		return nil, appError.EmptyField("ticker")
+	}
+
+	if quoteDate.IsZero() {
+		return nil, appError.EmptyField("quote_date")
+	}
+
+	if price <= 0 {
+		return nil, appError.InvalidParam("price", fmt.Errorf("must be greater than zero"))
+	}
+
+	return &InvestmentQuote{
+		HoldingID: holdingID,
+		Ticker:    ticker,
+		QuoteDate: quoteDate,
+		Price:     price,
+	}, nil
+}
+
+func NewInvestmentIncome(
+	holdingID uuid.UUID,
+	kind IncomeKind,
+	paymentDate time.Time,
+	amount Money,
+	note *string,
+	categoryID *uuid.UUID,
+) (*InvestmentIncome, error) {
+	if !kind.IsValid() {
+		return nil, appError.InvalidParam("kind", fmt.Errorf("invalid value"))
+	}
+
+	if paymentDate.IsZero() {
+		return nil, appError.EmptyField("payment_date")
+	}
+
+	if amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	return &InvestmentIncome{
+		HoldingID:   holdingID,
+		Kind:        kind,
+		PaymentDate: paymentDate,
+		Amount:      amount,
+		Note:        note,
+		CategoryID:  categoryID,
+	}, nil
+}
+
+// NewPosition apura a posição a partir das operações em ordem cronológica. Uma venda maior
+// que a quantidade em carteira resulta em ErrInsufficientQuantity.
+func NewPosition(trades []InvestmentTrade) (*Position, error) {
+	position := &Position{}
+
+	for _, trade := range trades {
+		switch trade.Kind {
+		case TradeBuy:
+			position.Quantity += trade.Quantity
+			position.CostBasis += Money(math.Round(trade.Quantity*float64(trade.Price))) + trade.Fees
+		case TradeSell:
+			if trade.Quantity > position.Quantity+quantityEpsilon {
+				return nil, appError.ErrInsufficientQuantity
+			}
+
+			cost := position.CostBasis
+			if remaining := position.Quantity - trade.Quantity; remaining > quantityEpsilon {
+				cost = Money(math.Round(float64(position.CostBasis) * trade.Quantity / position.Quantity))
+				position.Quantity = remaining
+			} else {
+				position.Quantity = 0
+			}
+
+			proceeds := Money(math.Round(trade.Quantity*float64(trade.Price))) - trade.Fees
+			position.RealizedGain += proceeds - cost
+			position.CostBasis -= cost
+		}
+	}
+
+	if position.Quantity > quantityEpsilon {
+		position.AverageCost = Money(math.Round(float64(position.CostBasis) / position.Quantity))
+	}
+
+	return position, nil
+}
+
+// Value é o valor da posição ao preço unitário informado.
+func (p Position) Value(price Money) Money {
+	return Money(math.Round(p.Quantity * float64(price)))
+}
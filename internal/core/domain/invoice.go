@@ -3,43 +3,169 @@ package domain
 import (
 	"fmt"
 	appError "frog-go/internal/core/errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type InvoiceStatus string
+
+const (
+	InvoiceOpen     InvoiceStatus = "open"
+	InvoiceClosed   InvoiceStatus = "closed"
+	InvoicePaid     InvoiceStatus = "paid"
+	InvoiceOverdue  InvoiceStatus = "overdue"
+	InvoiceCanceled InvoiceStatus = "canceled"
+)
+
+func ValidInvoiceStatus() []string {
+	return []string{
+		string(InvoiceOpen),
+		string(InvoiceClosed),
+		string(InvoicePaid),
+		string(InvoiceOverdue),
+		string(InvoiceCanceled),
+	}
+}
+
+func (a InvoiceStatus) IsValid() bool {
+	return slices.Contains(ValidInvoiceStatus(), string(a))
+}
+
+// UnpaidInvoiceStatus retorna os status de faturas que ainda aguardam pagamento.
+func UnpaidInvoiceStatus() []string {
+	return []string{
+		string(InvoiceOpen),
+		string(InvoiceClosed),
+		string(InvoiceOverdue),
+	}
+}
+
+// OverdueCandidateStatus retorna os status de faturas que passam a vencidas quando o
+// vencimento passa sem pagamento.
+func OverdueCandidateStatus() []string {
+	return []string{
+		string(InvoiceOpen),
+		string(InvoiceClosed),
+	}
+}
+
+// CanReceivePayment indica se a fatura ainda aceita pagamentos parciais.
+func (a InvoiceStatus) CanReceivePayment() bool {
+	return a == InvoiceOpen || a == InvoiceClosed || a == InvoiceOverdue
+}
+
+// CanClose indica se a fatura ainda aceita ser fechada. Uma fatura aberta que venceu antes
+// de ser fechada também pode ser fechada, desde que ainda não tenha closed_at.
+func (a InvoiceStatus) CanClose() bool {
+	return a == InvoiceOpen || a == InvoiceOverdue
+}
+
+// CanPay indica se a fatura já foi fechada e ainda não foi paga.
+func (a InvoiceStatus) CanPay() bool {
+	return a == InvoiceClosed || a == InvoiceOverdue
+}
+
+// AcceptsTransactions indica se novas transações podem ser lançadas na fatura.
+func (a InvoiceStatus) AcceptsTransactions() bool {
+	return a == InvoiceOpen
+}
+
 type Invoice struct {
-	ID        uuid.UUID `json:"id"`
-	UserID    uuid.UUID `json:"user_id"`
-	Title     string    `json:"title"`
-	Amount    float64   `json:"amount"`
-	DueDate   time.Time `json:"due_date"`
-	Status    TxnStatus `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               uuid.UUID     `json:"id"`
+	UserID           uuid.UUID     `json:"user_id"`
+	Title            string        `json:"title"`
+	Amount           Money         `json:"amount"`
+	DueDate          time.Time     `json:"due_date"`
+	Status           InvoiceStatus `json:"status"`
+	ClosedAt         *time.Time    `json:"closed_at"`
+	PaidAt           *time.Time    `json:"paid_at"`
+	PaymentAccountID *uuid.UUID    `json:"payment_account_id"`
+	CreatedAt        time.Time     `json:"created_at"`
+	UpdatedAt        time.Time     `json:"updated_at"`
+}
+
+type InvoicePaymentKind string
+
+const (
+	PaymentKindPayment  InvoicePaymentKind = "payment"
+	PaymentKindRollover InvoicePaymentKind = "rollover"
+)
+
+func ValidInvoicePaymentKind() []string {
+	return []string{
+		string(PaymentKindPayment),
+		string(PaymentKindRollover),
+	}
+}
+
+func (a InvoicePaymentKind) IsValid() bool {
+	return slices.Contains(ValidInvoicePaymentKind(), string(a))
+}
+
+// InvoicePayment representa um pagamento (total ou parcial) de uma fatura.
+// Amount zero em um pagamento total significa quitar todo o saldo em aberto.
+type InvoicePayment struct {
+	ID        uuid.UUID          `json:"id"`
+	InvoiceID uuid.UUID          `json:"invoice_id"`
+	Amount    Money              `json:"amount"`
+	PaidAt    time.Time          `json:"paid_at"`
+	AccountID *uuid.UUID         `json:"account_id"`
+	Kind      InvoicePaymentKind `json:"kind"`
+}
+
+// InvoiceClosing define o que fazer com o saldo restante ao fechar uma fatura.
+type InvoiceClosing struct {
+	Rollover      bool
+	NextInvoiceID *uuid.UUID
 }
 
 func NewInvoice(
 	title string,
 	dueDate time.Time,
-	status *TxnStatus,
 ) (*Invoice, error) {
 	if title == "" {
 		return nil, appError.EmptyField("name")
 	}
 
-	statusValue := StatusPending
-	if status != nil {
-		statusValue = *status
-	}
-
-	if !statusValue.IsValid() {
-		return nil, appError.InvalidParam("status", fmt.Errorf("invalid value"))
-	}
-
 	return &Invoice{
 		Title:   title,
 		DueDate: dueDate,
-		Status:  statusValue,
+		Status:  InvoiceOpen,
 	}, nil
 }
+
+// NewInvoiceSettlement cria o pagamento que quita todo o saldo em aberto da fatura.
+func NewInvoiceSettlement(paidAt *time.Time, accountID *uuid.UUID) *InvoicePayment {
+	paidAtValue := time.Now()
+	if paidAt != nil {
+		paidAtValue = *paidAt
+	}
+
+	return &InvoicePayment{
+		PaidAt:    paidAtValue,
+		AccountID: accountID,
+		Kind:      PaymentKindPayment,
+	}
+}
+
+func NewInvoicePayment(amount Money, paidAt *time.Time, accountID *uuid.UUID) (*InvoicePayment, error) {
+	if amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	payment := NewInvoiceSettlement(paidAt, accountID)
+	payment.Amount = amount
+
+	return payment, nil
+}
+
+// OutstandingAmount retorna o saldo que ainda falta pagar da fatura.
+func OutstandingAmount(amount Money, payments []InvoicePayment) Money {
+	var paid Money
+	for _, p := range payments {
+		paid += p.Amount
+	}
+	return amount - paid
+}
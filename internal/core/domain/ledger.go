@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LedgerRole string
+
+const (
+	LedgerOwner  LedgerRole = "owner"
+	LedgerEditor LedgerRole = "editor"
+	LedgerViewer LedgerRole = "viewer"
+)
+
+func ValidLedgerRole() []string {
+	return []string{
+		string(LedgerOwner),
+		string(LedgerEditor),
+		string(LedgerViewer),
+	}
+}
+
+func (r LedgerRole) IsValid() bool {
+	return slices.Contains(ValidLedgerRole(), string(r))
+}
+
+// IsAssignable indica se o papel pode ser dado a um membro. O papel owner é só do dono do
+// livro e não é transferido por convite nem por troca de papel.
+func (r LedgerRole) IsAssignable() bool {
+	return r.IsValid() && r != LedgerOwner
+}
+
+// CanWrite indica se o papel permite criar, alterar e remover lançamentos do livro.
+func (r LedgerRole) CanWrite() bool {
+	return r == LedgerOwner || r == LedgerEditor
+}
+
+// CanManage indica se o papel permite gerenciar membros e convites do livro.
+func (r LedgerRole) CanManage() bool {
+	return r == LedgerOwner
+}
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "pending"
+	InvitationAccepted InvitationStatus = "accepted"
+	InvitationDeclined InvitationStatus = "declined"
+	InvitationRevoked  InvitationStatus = "revoked"
+)
+
+func ValidInvitationStatus() []string {
+	return []string{
+		string(InvitationPending),
+		string(InvitationAccepted),
+		string(InvitationDeclined),
+		string(InvitationRevoked),
+	}
+}
+
+func (s InvitationStatus) IsValid() bool {
+	return slices.Contains(ValidInvitationStatus(), string(s))
+}
+
+// LedgerInvitationTTL é o prazo para o convidado aceitar um convite.
+const LedgerInvitationTTL = 7 * 24 * time.Hour
+
+// PersonalLedgerName é o nome do livro pessoal criado no cadastro do usuário.
+const PersonalLedgerName = "Pessoal"
+
+// Ledger é o conjunto de lançamentos compartilhado entre os membros. O livro pessoal
+// (Personal) é criado no cadastro e usado quando a requisição não escolhe outro livro.
+type Ledger struct {
+	ID        uuid.UUID `json:"id"`
+	OwnerID   uuid.UUID `json:"owner_id"`
+	Name      string    `json:"name"`
+	Personal  bool      `json:"personal"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LedgerAccess é o livro ativo de uma requisição e o papel de quem o acessa.
+type LedgerAccess struct {
+	LedgerID uuid.UUID
+	Role     LedgerRole
+}
+
+type LedgerInvitation struct {
+	ID        uuid.UUID        `json:"id"`
+	LedgerID  uuid.UUID        `json:"ledger_id"`
+	Username  string           `json:"username"`
+	Role      LedgerRole       `json:"role"`
+	Status    InvitationStatus `json:"status"`
+	ExpiresAt time.Time        `json:"expires_at"`
+}
+
+func NewLedger(name string) (*Ledger, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	return &Ledger{Name: name}, nil
+}
+
+func NewLedgerRole(role string) (LedgerRole, error) {
+	value := LedgerRole(role)
+	if !value.IsValid() {
+		return "", appError.InvalidParam("role", fmt.Errorf("invalid value"))
+	}
+	if !value.IsAssignable() {
+		return "", appError.InvalidParam("role", appError.ErrLedgerOwnerRole)
+	}
+	return value, nil
+}
+
+// NewLedgerInvitation valida o convite; sem papel informado, o convidado entra como editor.
+func NewLedgerInvitation(username string, role *LedgerRole) (*LedgerInvitation, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, appError.EmptyField("username")
+	}
+
+	roleValue := LedgerEditor
+	if role != nil && *role != "" {
+		roleValue = *role
+	}
+
+	if !roleValue.IsValid() {
+		return nil, appError.InvalidParam("role", fmt.Errorf("invalid value"))
+	}
+	if !roleValue.IsAssignable() {
+		return nil, appError.InvalidParam("role", appError.ErrLedgerOwnerRole)
+	}
+
+	return &LedgerInvitation{
+		Username: username,
+		Role:     roleValue,
+		Status:   InvitationPending,
+	}, nil
+}
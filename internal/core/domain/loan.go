@@ -0,0 +1,295 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoanMaxTerm é o prazo máximo aceito, em meses.
+const LoanMaxTerm = 600
+
+type LoanSystem string
+
+const (
+	LoanSAC   LoanSystem = "sac"
+	LoanPrice LoanSystem = "price"
+)
+
+func ValidLoanSystem() []string {
+	return []string{
+		string(LoanSAC),
+		string(LoanPrice),
+	}
+}
+
+func (s LoanSystem) IsValid() bool {
+	return slices.Contains(ValidLoanSystem(), string(s))
+}
+
+type RatePeriod string
+
+const (
+	RateMonthly RatePeriod = "monthly"
+	RateYearly  RatePeriod = "yearly"
+)
+
+func ValidRatePeriod() []string {
+	return []string{
+		string(RateMonthly),
+		string(RateYearly),
+	}
+}
+
+func (p RatePeriod) IsValid() bool {
+	return slices.Contains(ValidRatePeriod(), string(p))
+}
+
+// PrepaymentMode define o efeito da amortização extra: reduzir o prazo, mantendo a parcela
+// (Price) ou a amortização (SAC), ou reduzir a parcela, mantendo o prazo.
+type PrepaymentMode string
+
+const (
+	PrepaymentReduceTerm    PrepaymentMode = "reduce_term"
+	PrepaymentReducePayment PrepaymentMode = "reduce_payment"
+)
+
+func ValidPrepaymentMode() []string {
+	return []string{
+		string(PrepaymentReduceTerm),
+		string(PrepaymentReducePayment),
+	}
+}
+
+func (m PrepaymentMode) IsValid() bool {
+	return slices.Contains(ValidPrepaymentMode(), string(m))
+}
+
+// Loan é um empréstimo ou financiamento. InterestRate é o percentual ao mês ou ao ano,
+// conforme RatePeriod; FirstPaymentDate é o vencimento da primeira parcela e as seguintes
+// vencem no mesmo dia dos meses seguintes.
+type Loan struct {
+	ID                  uuid.UUID  `json:"id"`
+	Name                string     `json:"name"`
+	Principal           Money      `json:"principal"`
+	InterestRate        float64    `json:"interest_rate"`
+	RatePeriod          RatePeriod `json:"rate_period"`
+	TermMonths          int        `json:"term_months"`
+	System              LoanSystem `json:"system"`
+	FirstPaymentDate    time.Time  `json:"first_payment_date"`
+	Currency            string     `json:"currency"`
+	AccountID           *uuid.UUID `json:"account_id"`
+	InterestCategoryID  *uuid.UUID `json:"interest_category_id"`
+	PrincipalCategoryID *uuid.UUID `json:"principal_category_id"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// LoanInstallment é uma parcela do cronograma. Balance é o saldo devedor após o pagamento.
+type LoanInstallment struct {
+	Number    int       `json:"number"`
+	DueDate   time.Time `json:"due_date"`
+	Payment   Money     `json:"payment"`
+	Interest  Money     `json:"interest"`
+	Principal Money     `json:"principal"`
+	Balance   Money     `json:"balance"`
+}
+
+type LoanPrepayment struct {
+	ID          uuid.UUID      `json:"id"`
+	LoanID      uuid.UUID      `json:"loan_id"`
+	PaymentDate time.Time      `json:"payment_date"`
+	Amount      Money          `json:"amount"`
+	Mode        PrepaymentMode `json:"mode"`
+}
+
+func NewLoan(
+	name string,
+	principal Money,
+	interestRate float64,
+	ratePeriod RatePeriod,
+	termMonths int,
+	system LoanSystem,
+	firstPaymentDate time.Time,
+	currency string,
+) (*Loan, error) {
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	if principal <= 0 {
+		return nil, appError.InvalidParam("principal", fmt.Errorf("must be greater than zero"))
+	}
+
+	if interestRate < 0 || interestRate >= 100 || math.IsNaN(interestRate) {
+		return nil, appError.InvalidParam("interest_rate", fmt.Errorf("must be between 0 and 100"))
+	}
+
+	if ratePeriod == "" {
+		ratePeriod = RateMonthly
+	}
+	if !ratePeriod.IsValid() {
+		return nil, appError.InvalidParam("rate_period", fmt.Errorf("invalid value"))
+	}
+
+	if termMonths <= 0 || termMonths > LoanMaxTerm {
+		return nil, appError.InvalidParam("term_months", fmt.Errorf("must be between 1 and %d", LoanMaxTerm))
+	}
+
+	if !system.IsValid() {
+		return nil, appError.InvalidParam("system", fmt.Errorf("invalid value"))
+	}
+
+	if firstPaymentDate.IsZero() {
+		return nil, appError.EmptyField("first_payment_date")
+	}
+
+	if currency != "" {
+		var err error
+		currency, err = NormalizeCurrency(currency)
+		if err != nil {
+			return nil, appError.InvalidParam("currency", err)
+		}
+	}
+
+	return &Loan{
+		Name:             name,
+		Principal:        principal,
+		InterestRate:     interestRate,
+		RatePeriod:       ratePeriod,
+		TermMonths:       termMonths,
+		System:           system,
+		FirstPaymentDate: time.Date(firstPaymentDate.Year(), firstPaymentDate.Month(), firstPaymentDate.Day(), 0, 0, 0, 0, time.UTC),
+		Currency:         currency,
+	}, nil
+}
+
+func NewLoanPrepayment(paymentDate time.Time, amount Money, mode PrepaymentMode) (*LoanPrepayment, error) {
+	if paymentDate.IsZero() {
+		return nil, appError.EmptyField("payment_date")
+	}
+
+	if amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	if mode == "" {
+		mode = PrepaymentReduceTerm
+	}
+	if !mode.IsValid() {
+		return nil, appError.InvalidParam("mode", fmt.Errorf("invalid value"))
+	}
+
+	return &LoanPrepayment{
+		PaymentDate: paymentDate,
+		Amount:      amount,
+		Mode:        mode,
+	}, nil
+}
+
+// MonthlyRate é a taxa mensal em fração. Taxas anuais são convertidas pela taxa equivalente
+// composta: (1 + a.a.)^(1/12) - 1.
+func (l Loan) MonthlyRate() float64 {
+	rate := l.InterestRate / 100
+	if l.RatePeriod == RateYearly {
+		return math.Pow(1+rate, 1.0/12) - 1
+	}
+	return rate
+}
+
+// DueDate é o vencimento da parcela: o dia da primeira parcela, limitado ao último dia nos
+// meses mais curtos.
+func (l Loan) DueDate(number int) time.Time {
+	first := l.FirstPaymentDate
+	month := time.Date(first.Year(), first.Month()+time.Month(number-1), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := month.AddDate(0, 1, -1).Day()
+	return time.Date(month.Year(), month.Month(), min(first.Day(), lastDay), 0, 0, 0, 0, time.UTC)
+}
+
+// Schedule gera terms parcelas a partir da parcela fromNumber para amortizar balance. No SAC a
+// amortização é constante e os juros decrescem; na Price a parcela é constante. A última
+// parcela absorve as diferenças de arredondamento e zera o saldo.
+func (l Loan) Schedule(balance Money, fromNumber int, terms int) []LoanInstallment {
+	rate := l.MonthlyRate()
+
+	var payment, amortization Money
+	switch l.System {
+	case LoanPrice:
+		if rate == 0 {
+			payment = Money(math.Round(float64(balance) / float64(terms)))
+		} else {
+			payment = Money(math.Round(float64(balance) * rate / (1 - math.Pow(1+rate, -float64(terms)))))
+		}
+	default:
+		amortization = Money(math.Round(float64(balance) / float64(terms)))
+	}
+
+	installments := make([]LoanInstallment, 0, terms)
+	for i := 0; i < terms && balance > 0; i++ {
+		interest := Money(math.Round(float64(balance) * rate))
+
+		principal := amortization
+		if l.System == LoanPrice {
+			principal = payment - interest
+		}
+		if i == terms-1 || principal > balance {
+			principal = balance
+		}
+		balance -= principal
+
+		installments = append(installments, LoanInstallment{
+			Number:    fromNumber + i,
+			DueDate:   l.DueDate(fromNumber + i),
+			Payment:   principal + interest,
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		})
+	}
+	return installments
+}
+
+// Reschedule recalcula as parcelas restantes depois de uma amortização extra que deixou o
+// saldo em balance. remaining são as parcelas substituídas, em ordem. Ao reduzir o prazo, o
+// novo prazo é o necessário para quitar o saldo mantendo a parcela (Price) ou a amortização
+// (SAC) da primeira parcela substituída.
+func (l Loan) Reschedule(balance Money, remaining []LoanInstallment, mode PrepaymentMode) []LoanInstallment {
+	if balance <= 0 || len(remaining) == 0 {
+		return []LoanInstallment{}
+	}
+
+	terms := len(remaining)
+	if mode == PrepaymentReduceTerm {
+		rate := l.MonthlyRate()
+		first := remaining[0]
+
+		var needed float64
+		switch {
+		case l.System == LoanSAC && first.Principal > 0:
+			needed = float64(balance) / float64(first.Principal)
+		case l.System == LoanPrice && rate == 0 && first.Payment > 0:
+			needed = float64(balance) / float64(first.Payment)
+		case l.System == LoanPrice && float64(first.Payment) > float64(balance)*rate:
+			needed = math.Log(float64(first.Payment)/(float64(first.Payment)-float64(balance)*rate)) / math.Log(1+rate)
+		}
+
+		if needed > 0 {
+			terms = min(terms, max(1, int(math.Ceil(needed-1e-9))))
+		}
+	}
+
+	return l.Schedule(balance, remaining[0].Number, terms)
+}
+
+// TotalInterest soma os juros das parcelas.
+func TotalInterest(installments []LoanInstallment) Money {
+	var total Money
+	for _, installment := range installments {
+		total += installment.Interest
+	}
+	return total
+}
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func testLoan(system LoanSystem, principal Money, rate float64, terms int) Loan {
+	return Loan{
+		Name:             "Financiamento",
+		Principal:        principal,
+		InterestRate:     rate,
+		RatePeriod:       RateMonthly,
+		TermMonths:       terms,
+		System:           system,
+		FirstPaymentDate: time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestLoanMonthlyRate(t *testing.T) {
+	tests := []struct {
+		name   string
+		rate   float64
+		period RatePeriod
+		want   float64
+	}{
+		{name: "mensal", rate: 1, period: RateMonthly, want: 0.01},
+		{name: "anual equivalente", rate: (math.Pow(1.01, 12) - 1) * 100, period: RateYearly, want: 0.01},
+		{name: "sem juros", rate: 0, period: RateYearly, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loan := Loan{InterestRate: tt.rate, RatePeriod: tt.period}
+			if got := loan.MonthlyRate(); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("MonthlyRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoanDueDate(t *testing.T) {
+	loan := testLoan(LoanSAC, 100000, 1, 12)
+
+	tests := []struct {
+		number int
+		want   time.Time
+	}{
+		{number: 1, want: time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		{number: 2, want: time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{number: 3, want: time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{number: 13, want: time.Date(2027, time.January, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := loan.DueDate(tt.number); !got.Equal(tt.want) {
+			t.Errorf("DueDate(%d) = %v, want %v", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestLoanSchedule(t *testing.T) {
+	tests := []struct {
+		name         string
+		loan         Loan
+		wantFirst    LoanInstallment
+		wantLast     LoanInstallment
+		wantInterest Money
+		wantPayments int
+	}{
+		{
+			name:         "SAC",
+			loan:         testLoan(LoanSAC, 120000, 1, 12),
+			wantFirst:    LoanInstallment{Number: 1, Payment: 11200, Interest: 1200, Principal: 10000, Balance: 110000},
+			wantLast:     LoanInstallment{Number: 12, Payment: 10100, Interest: 100, Principal: 10000, Balance: 0},
+			wantInterest: 7800,
+			wantPayments: 12,
+		},
+		{
+			name:         "Price",
+			loan:         testLoan(LoanPrice, 100000, 1, 12),
+			wantFirst:    LoanInstallment{Number: 1, Payment: 8885, Interest: 1000, Principal: 7885, Balance: 92115},
+			wantLast:     LoanInstallment{Number: 12, Payment: 8884, Interest: 88, Principal: 8796, Balance: 0},
+			wantInterest: 6619,
+			wantPayments: 12,
+		},
+		{
+			name:         "Price sem juros",
+			loan:         testLoan(LoanPrice, 100000, 0, 3),
+			wantFirst:    LoanInstallment{Number: 1, Payment: 33333, Interest: 0, Principal: 33333, Balance: 66667},
+			wantLast:     LoanInstallment{Number: 3, Payment: 33334, Interest: 0, Principal: 33334, Balance: 0},
+			wantInterest: 0,
+			wantPayments: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installments := tt.loan.Schedule(tt.loan.Principal, 1, tt.loan.TermMonths)
+			if len(installments) != tt.wantPayments {
+				t.Fatalf("len(Schedule) = %d, want %d", len(installments), tt.wantPayments)
+			}
+
+			assertInstallment(t, installments[0], tt.wantFirst)
+			assertInstallment(t, installments[len(installments)-1], tt.wantLast)
+
+			var principal Money
+			for _, installment := range installments {
+				principal += installment.Principal
+				if installment.Payment != installment.Principal+installment.Interest {
+					t.Errorf("installment %d: payment %d != principal %d + interest %d", installment.Number, installment.Payment, installment.Principal, installment.Interest)
+				}
+			}
+			if principal != tt.loan.Principal {
+				t.Errorf("sum of principal = %d, want %d", principal, tt.loan.Principal)
+			}
+			if got := TotalInterest(installments); got != tt.wantInterest {
+				t.Errorf("TotalInterest = %d, want %d", got, tt.wantInterest)
+			}
+		})
+	}
+}
+
+func TestLoanReschedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		loan       Loan
+		paid       int
+		prepayment Money
+		mode       PrepaymentMode
+		wantTerms  int
+		wantFirst  LoanInstallment
+		maxPayment Money
+	}{
+		{
+			name:       "SAC reduzindo o prazo mantém a amortização",
+			loan:       testLoan(LoanSAC, 120000, 1, 12),
+			paid:       2,
+			prepayment: 40000,
+			mode:       PrepaymentReduceTerm,
+			wantTerms:  6,
+			wantFirst:  LoanInstallment{Number: 3, Payment: 10600, Interest: 600, Principal: 10000, Balance: 50000},
+		},
+		{
+			name:       "SAC reduzindo a parcela mantém o prazo",
+			loan:       testLoan(LoanSAC, 120000, 1, 12),
+			paid:       2,
+			prepayment: 40000,
+			mode:       PrepaymentReducePayment,
+			wantTerms:  10,
+			wantFirst:  LoanInstallment{Number: 3, Payment: 6600, Interest: 600, Principal: 6000, Balance: 54000},
+		},
+		{
+			name:       "Price reduzindo o prazo",
+			loan:       testLoan(LoanPrice, 100000, 1, 12),
+			paid:       4,
+			prepayment: 20000,
+			mode:       PrepaymentReduceTerm,
+			wantTerms:  6,
+			wantFirst:  LoanInstallment{Number: 5, Payment: 8280, Interest: 480, Principal: 7800, Balance: 40184},
+			maxPayment: 8885,
+		},
+		{
+			name:       "quitação total",
+			loan:       testLoan(LoanPrice, 100000, 1, 12),
+			paid:       4,
+			prepayment: 67984,
+			mode:       PrepaymentReduceTerm,
+			wantTerms:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := tt.loan.Schedule(tt.loan.Principal, 1, tt.loan.TermMonths)
+			balance := schedule[tt.paid-1].Balance - tt.prepayment
+
+			installments := tt.loan.Reschedule(balance, schedule[tt.paid:], tt.mode)
+			if len(installments) != tt.wantTerms {
+				t.Fatalf("len(Reschedule) = %d, want %d", len(installments), tt.wantTerms)
+			}
+			if tt.wantTerms == 0 {
+				return
+			}
+
+			assertInstallment(t, installments[0], tt.wantFirst)
+			if last := installments[len(installments)-1]; last.Balance != 0 {
+				t.Errorf("last balance = %d, want 0", last.Balance)
+			}
+			for _, installment := range installments {
+				if tt.maxPayment > 0 && installment.Payment > tt.maxPayment {
+					t.Errorf("installment %d: payment %d above %d", installment.Number, installment.Payment, tt.maxPayment)
+				}
+			}
+		})
+	}
+}
+
+func assertInstallment(t *testing.T, got LoanInstallment, want LoanInstallment) {
+	t.Helper()
+	if got.Number != want.Number || got.Payment != want.Payment || got.Interest != want.Interest || got.Principal != want.Principal || got.Balance != want.Balance {
+		t.Errorf("installment = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Money é um valor monetário exato, guardado em centavos. Em JSON é um número com duas casas
+// decimais (12.34) e no Postgres é gravado como numeric, sem passar por ponto flutuante.
+type Money int64
+
+// MaxMoney é o maior valor aceito pelas colunas numeric(18,2).
+const MaxMoney = 9999999999999999_99
+
+var hundred = big.NewRat(100, 1)
+
+// moneyPattern aceita só decimais simples, com ponto ou vírgula; big.Rat sozinho também
+// aceitaria notação científica ("1e3") e frações ("1/3").
+var moneyPattern = regexp.MustCompile(`^-?\d+([.,]\d+)?$`)
+
+// ParseMoney lê um valor decimal ("1234.56", "-0,5") sem perda de precisão. Casas além dos
+// centavos são arredondadas para longe do zero.
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if !moneyPattern.MatchString(value) {
+		return 0, fmt.Errorf("invalid amount: %q", value)
+	}
+
+	r, ok := new(big.Rat).SetString(strings.Replace(value, ",", ".", 1))
+	if !ok {
+		return 0, fmt.Errorf("invalid amount: %q", value)
+	}
+	r.Mul(r, hundred)
+
+	// arredonda |r| somando meio centavo antes de truncar
+	num := new(big.Int).Abs(r.Num())
+	num.Mul(num, big.NewInt(2))
+	num.Add(num, r.Denom())
+	cents := num.Quo(num, new(big.Int).Mul(r.Denom(), big.NewInt(2)))
+
+	if !cents.IsInt64() || Money(cents.Int64()) > MaxMoney {
+		return 0, fmt.Errorf("amount out of range: %q", value)
+	}
+	if r.Sign() < 0 {
+		return -Money(cents.Int64()), nil
+	}
+	return Money(cents.Int64()), nil
+}
+
+func (m Money) String() string {
+	cents := int64(m)
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// Float64 devolve o valor aproximado, para cálculos que não voltam a ser gravados.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// Convert aplica uma cotação ao valor, arredondando para centavos.
+func (m Money) Convert(rate float64) Money {
+	return Money(math.Round(float64(m) * rate))
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON aceita o valor como número ou como texto.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	value := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := ParseMoney(value)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// UnmarshalParam permite usar Money em filtros de query string.
+func (m *Money) UnmarshalParam(param string) error {
+	parsed, err := ParseMoney(param)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+	case []byte:
+		return m.UnmarshalParam(string(v))
+	case string:
+		return m.UnmarshalParam(v)
+	case int64:
+		*m = Money(v * 100)
+	case float64:
+		parsed, err := ParseMoney(strconv.FormatFloat(v, 'f', -1, 64))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	default:
+		return fmt.Errorf("unsupported money value: %T", src)
+	}
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
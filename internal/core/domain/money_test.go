@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Money
+		wantErr bool
+	}{
+		{name: "ponto decimal", value: "1234.56", want: 123456},
+		{name: "vírgula decimal", value: "-0,5", want: -50},
+		{name: "inteiro", value: "10", want: 1000},
+		{name: "espaços", value: " 7.1 ", want: 710},
+		{name: "meio centavo arredonda para cima", value: "0.005", want: 1},
+		{name: "meio centavo negativo arredonda para baixo", value: "-0.005", want: -1},
+		{name: "menos de meio centavo", value: "0.004", want: 0},
+		{name: "muitas casas", value: "2.3456789", want: 235},
+		{name: "maior valor", value: "9999999999999999.99", want: MaxMoney},
+		{name: "acima do maior valor", value: "10000000000000000.00", wantErr: true},
+		{name: "notação científica", value: "1e3", wantErr: true},
+		{name: "fração", value: "1/3", wantErr: true},
+		{name: "vazio", value: "", wantErr: true},
+		{name: "texto", value: "abc", wantErr: true},
+		{name: "dois separadores", value: "1.2.3", wantErr: true},
+		{name: "milhar e decimal", value: "1,234.56", wantErr: true},
+		{name: "sem parte inteira", value: ".5", wantErr: true},
+		{name: "sinal positivo", value: "+1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseMoney(%q) = %v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMoney(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		value Money
+		want  string
+	}{
+		{value: 0, want: "0.00"},
+		{value: 5, want: "0.05"},
+		{value: -5, want: "-0.05"},
+		{value: 123456, want: "1234.56"},
+		{value: -100, want: "-1.00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.want {
+			t.Errorf("Money(%d).String() = %q, want %q", int64(tt.value), got, tt.want)
+		}
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	tests := []struct {
+		name  string
+		value Money
+		rate  float64
+		want  Money
+	}{
+		{name: "mesma moeda", value: 1234, rate: 1, want: 1234},
+		{name: "arredonda para baixo", value: 1000, rate: 5.4321, want: 5432},
+		{name: "arredonda para cima", value: 1000, rate: 0.18765, want: 188},
+		{name: "negativo", value: -1000, rate: 0.18765, want: -188},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.Convert(tt.rate); got != tt.want {
+				t.Errorf("Money(%d).Convert(%v) = %d, want %d", int64(tt.value), tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Money
+		wantErr bool
+	}{
+		{name: "número", data: `12.34`, want: 1234},
+		{name: "texto", data: `"12,34"`, want: 1234},
+		{name: "nulo mantém o valor", data: `null`, want: 99},
+		{name: "notação científica", data: `1e3`, wantErr: true},
+		{name: "texto inválido", data: `"doze"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Money(99)
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Unmarshal(%s) = %d, want error", tt.data, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%s) error: %v", tt.data, err)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal(%s) = %d, want %d", tt.data, got, tt.want)
+			}
+		})
+	}
+
+	data, err := json.Marshal(Money(-1234))
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(data) != "-12.34" {
+		t.Errorf("Marshal = %s, want -12.34", data)
+	}
+}
+
+func TestMoneyScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    Money
+		wantErr bool
+	}{
+		{name: "nulo", src: nil, want: 0},
+		{name: "numeric do banco", src: []byte("1234.56"), want: 123456},
+		{name: "texto", src: "0.10", want: 10},
+		{name: "inteiro", src: int64(5), want: 500},
+		{name: "float", src: 0.1 + 0.2, want: 30},
+		{name: "tipo não suportado", src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Money(99)
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Scan(%v) = %d, want error", tt.src, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) error: %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%v) = %d, want %d", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssetClass string
+
+const (
+	AssetClassAsset     AssetClass = "asset"
+	AssetClassLiability AssetClass = "liability"
+)
+
+func ValidAssetClass() []string {
+	return []string{
+		string(AssetClassAsset),
+		string(AssetClassLiability),
+	}
+}
+
+func (c AssetClass) IsValid() bool {
+	return slices.Contains(ValidAssetClass(), string(c))
+}
+
+type AssetKind string
+
+const (
+	AssetProperty   AssetKind = "property"
+	AssetVehicle    AssetKind = "vehicle"
+	AssetInvestment AssetKind = "investment"
+	AssetLoan       AssetKind = "loan"
+	AssetOther      AssetKind = "other"
+)
+
+func ValidAssetKind() []string {
+	return []string{
+		string(AssetProperty),
+		string(AssetVehicle),
+		string(AssetInvestment),
+		string(AssetLoan),
+		string(AssetOther),
+	}
+}
+
+func (k AssetKind) IsValid() bool {
+	return slices.Contains(ValidAssetKind(), string(k))
+}
+
+type Asset struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Class     AssetClass `json:"class"`
+	Kind      AssetKind  `json:"kind"`
+	Currency  string     `json:"currency"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ValuationTarget identifica o item avaliado: um bem ou dívida, ou uma conta. Apenas um
+// deles é preenchido.
+type ValuationTarget struct {
+	AssetID   *uuid.UUID `json:"asset_id"`
+	AccountID *uuid.UUID `json:"account_id"`
+}
+
+// Valuation é o valor do item na data, na moeda do item. Bens e dívidas são avaliados
+// sempre com valores positivos; o saldo de uma conta pode ser negativo.
+type Valuation struct {
+	ID        uuid.UUID `json:"id"`
+	ValueDate time.Time `json:"value_date"`
+	Amount    Money     `json:"amount"`
+	Note      *string   `json:"note"`
+	ValuationTarget
+}
+
+// NewAsset valida o bem ou a dívida. Sem classe o item é um bem e sem moeda assume a moeda
+// base do usuário na criação, como as contas.
+func NewAsset(name string, class AssetClass, kind AssetKind, currency string) (*Asset, error) {
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	if class == "" {
+		class = AssetClassAsset
+	}
+	if !class.IsValid() {
+		return nil, appError.InvalidParam("class", fmt.Errorf("invalid value"))
+	}
+
+	if kind == "" {
+		kind = AssetOther
+	}
+	if !kind.IsValid() {
+		return nil, appError.InvalidParam("kind", fmt.Errorf("invalid value"))
+	}
+
+	if currency != "" {
+		var err error
+		currency, err = NormalizeCurrency(currency)
+		if err != nil {
+			return nil, appError.InvalidParam("currency", err)
+		}
+	}
+
+	return &Asset{
+		Name:     name,
+		Class:    class,
+		Kind:     kind,
+		Currency: currency,
+	}, nil
+}
+
+func NewValuation(target ValuationTarget, valueDate time.Time, amount Money, note *string) (*Valuation, error) {
+	if valueDate.IsZero() {
+		return nil, appError.EmptyField("value_date")
+	}
+
+	if target.AssetID != nil && amount < 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must not be negative"))
+	}
+
+	return &Valuation{
+		ValueDate:       valueDate,
+		Amount:          amount,
+		Note:            note,
+		ValuationTarget: target,
+	}, nil
+}
@@ -0,0 +1,430 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"net/url"
+	"slices"
+	"time"
+	// embute a base de fusos horários, usada nas preferências mesmo em imagens sem zoneinfo
+	_ "time/tzdata"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// NotificationDefaultLeadDays é a antecedência padrão, em dias, dos lembretes de vencimento.
+	NotificationDefaultLeadDays = 3
+	// NotificationMaxLeadDays é a maior antecedência aceita nas preferências.
+	NotificationMaxLeadDays = 30
+	// NotificationMaxAttempts é quantas vezes uma entrega é tentada antes de ser dada como falha.
+	NotificationMaxAttempts = 5
+	// NotificationClaimTimeout é por quanto tempo uma entrega fica reservada para quem a
+	// pegou; depois disso, se não foi concluída, outra execução pode retomá-la.
+	NotificationClaimTimeout = 10 * time.Minute
+	// NotificationDefaultTimezone é o fuso usado no horário de silêncio e na data dos lembretes.
+	NotificationDefaultTimezone = "America/Sao_Paulo"
+)
+
+const quietHoursLayout = "15:04"
+
+// NotificationChannel é um meio de entrega dos avisos.
+type NotificationChannel string
+
+const (
+	ChannelEmail   NotificationChannel = "email"
+	ChannelWebhook NotificationChannel = "webhook"
+	ChannelInApp   NotificationChannel = "inapp"
+)
+
+func ValidNotificationChannel() []string {
+	return []string{
+		string(ChannelEmail),
+		string(ChannelWebhook),
+		string(ChannelInApp),
+	}
+}
+
+func (c NotificationChannel) IsValid() bool {
+	return slices.Contains(ValidNotificationChannel(), string(c))
+}
+
+// NotificationKind é o assunto do aviso.
+type NotificationKind string
+
+const (
+	NotificationInvoiceDue      NotificationKind = "invoice_due"
+	NotificationInvoiceOverdue  NotificationKind = "invoice_overdue"
+	NotificationTransactionDue  NotificationKind = "transaction_due"
+	NotificationBudgetAlert     NotificationKind = "budget_alert"
+	NotificationImportCompleted NotificationKind = "import_completed"
+	NotificationAnomaly         NotificationKind = "anomaly"
+)
+
+func ValidNotificationKind() []string {
+	return []string{
+		string(NotificationInvoiceDue),
+		string(NotificationInvoiceOverdue),
+		string(NotificationTransactionDue),
+		string(NotificationBudgetAlert),
+		string(NotificationImportCompleted),
+		string(NotificationAnomaly),
+	}
+}
+
+func (k NotificationKind) IsValid() bool {
+	return slices.Contains(ValidNotificationKind(), string(k))
+}
+
+// DeliveryStatus é a situação da entrega de um aviso em um canal.
+type DeliveryStatus string
+
+const (
+	DeliveryPending DeliveryStatus = "pending"
+	DeliverySending DeliveryStatus = "sending"
+	DeliverySent    DeliveryStatus = "sent"
+	DeliveryFailed  DeliveryStatus = "failed"
+)
+
+func ValidDeliveryStatus() []string {
+	return []string{
+		string(DeliveryPending),
+		string(DeliverySending),
+		string(DeliverySent),
+		string(DeliveryFailed),
+	}
+}
+
+func (s DeliveryStatus) IsValid() bool {
+	return slices.Contains(ValidDeliveryStatus(), string(s))
+}
+
+// NotificationPreference define como um usuário recebe os avisos: com quantos dias de
+// antecedência, por quais canais e em que faixa do dia nada deve ser enviado. O horário de
+// silêncio é no fuso Timezone e pode atravessar a meia-noite (22:00 a 07:00).
+type NotificationPreference struct {
+	UserID        uuid.UUID `json:"user_id"`
+	LeadDays      int       `json:"lead_days"`
+	QuietStart    *string   `json:"quiet_start"`
+	QuietEnd      *string   `json:"quiet_end"`
+	Timezone      string    `json:"timezone"`
+	EmailEnabled  bool      `json:"email_enabled"`
+	WebhookURL    *string   `json:"webhook_url"`
+	WebhookSecret *string   `json:"webhook_secret"`
+	InAppEnabled  bool      `json:"inapp_enabled"`
+}
+
+// DefaultNotificationPreference vale para quem ainda não salvou preferências: só a caixa de
+// entrada do app, sem horário de silêncio.
+func DefaultNotificationPreference() NotificationPreference {
+	return NotificationPreference{
+		LeadDays:     NotificationDefaultLeadDays,
+		Timezone:     NotificationDefaultTimezone,
+		InAppEnabled: true,
+	}
+}
+
+func NewNotificationPreference(
+	leadDays int,
+	quietStart *string,
+	quietEnd *string,
+	timezone string,
+	emailEnabled bool,
+	webhookURL *string,
+	webhookSecret *string,
+	inAppEnabled bool,
+) (*NotificationPreference, error) {
+	if leadDays < 0 || leadDays > NotificationMaxLeadDays {
+		return nil, appError.InvalidParam("lead_days", fmt.Errorf("must be between 0 and %d", NotificationMaxLeadDays))
+	}
+
+	if (quietStart == nil) != (quietEnd == nil) {
+		return nil, appError.InvalidParam("quiet_start", fmt.Errorf("inform both quiet_start and quiet_end"))
+	}
+	if quietStart != nil {
+		start, err := time.Parse(quietHoursLayout, *quietStart)
+		if err != nil {
+			return nil, appError.InvalidParam("quiet_start", fmt.Errorf("must be in HH:MM format"))
+		}
+		end, err := time.Parse(quietHoursLayout, *quietEnd)
+		if err != nil {
+			return nil, appError.InvalidParam("quiet_end", fmt.Errorf("must be in HH:MM format"))
+		}
+		if start.Equal(end) {
+			return nil, appError.InvalidParam("quiet_end", fmt.Errorf("must differ from quiet_start"))
+		}
+	}
+
+	if timezone == "" {
+		timezone = NotificationDefaultTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, appError.InvalidParam("timezone", err)
+	}
+
+	if webhookURL != nil {
+		parsed, err := url.Parse(*webhookURL)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return nil, appError.InvalidParam("webhook_url", fmt.Errorf("must be an https URL"))
+		}
+	}
+	if webhookSecret != nil && webhookURL == nil {
+		return nil, appError.InvalidParam("webhook_secret", fmt.Errorf("requires webhook_url"))
+	}
+
+	return &NotificationPreference{
+		LeadDays:      leadDays,
+		QuietStart:    quietStart,
+		QuietEnd:      quietEnd,
+		Timezone:      timezone,
+		EmailEnabled:  emailEnabled,
+		WebhookURL:    webhookURL,
+		WebhookSecret: webhookSecret,
+		InAppEnabled:  inAppEnabled,
+	}, nil
+}
+
+// Channels lista os canais ativos; o webhook fica ativo quando há URL cadastrada.
+func (p NotificationPreference) Channels() []NotificationChannel {
+	channels := []NotificationChannel{}
+	if p.EmailEnabled {
+		channels = append(channels, ChannelEmail)
+	}
+	if p.WebhookURL != nil {
+		channels = append(channels, ChannelWebhook)
+	}
+	if p.InAppEnabled {
+		channels = append(channels, ChannelInApp)
+	}
+	return channels
+}
+
+// Location é o fuso das preferências, ou o padrão se o salvo não existir mais.
+func (p NotificationPreference) Location() *time.Location {
+	if location, err := time.LoadLocation(p.Timezone); err == nil {
+		return location
+	}
+	location, err := time.LoadLocation(NotificationDefaultTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Today é a data de now no fuso do usuário, à meia-noite em UTC como as datas dos lançamentos.
+func (p NotificationPreference) Today(now time.Time) time.Time {
+	local := now.In(p.Location())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// InQuietHours indica se now cai no horário de silêncio. O início é incluído e o fim não.
+func (p NotificationPreference) InQuietHours(now time.Time) bool {
+	if p.QuietStart == nil || p.QuietEnd == nil {
+		return false
+	}
+
+	start, err := time.Parse(quietHoursLayout, *p.QuietStart)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(quietHoursLayout, *p.QuietEnd)
+	if err != nil {
+		return false
+	}
+
+	local := now.In(p.Location())
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from < to {
+		return minute >= from && minute < to
+	}
+	return minute >= from || minute < to
+}
+
+// QuietHoursEnd é o próximo fim do horário de silêncio a partir de now, em UTC. Sem horário
+// de silêncio válido, retorna now.
+func (p NotificationPreference) QuietHoursEnd(now time.Time) time.Time {
+	if !p.InQuietHours(now) {
+		return now
+	}
+
+	end, err := time.Parse(quietHoursLayout, *p.QuietEnd)
+	if err != nil {
+		return now
+	}
+
+	local := now.In(p.Location())
+	next := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, local.Location())
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next.UTC()
+}
+
+// Reminder é um aviso a ser entregue. Key identifica o aviso, de forma que cada um seja
+// enviado uma única vez por canal mesmo que a varredura o encontre de novo.
+type Reminder struct {
+	UserID      uuid.UUID        `json:"user_id"`
+	Kind        NotificationKind `json:"kind"`
+	Key         string           `json:"key"`
+	ReferenceID *uuid.UUID       `json:"reference_id"`
+	Title       string           `json:"title"`
+	Body        string           `json:"body"`
+	Payload     map[string]any   `json:"payload"`
+}
+
+// NotificationDelivery é a entrega pendente de um aviso em um canal, com o destino já
+// resolvido a partir do usuário e das suas preferências.
+type NotificationDelivery struct {
+	ID         uuid.UUID
+	Reminder   Reminder
+	Channel    NotificationChannel
+	Attempts   int
+	Email      *string
+	Preference NotificationPreference
+	CreatedAt  time.Time
+}
+
+// Notification é um aviso guardado na caixa de entrada do app. Key é a chave do lembrete que
+// o originou e impede que o mesmo aviso entre duas vezes na caixa de entrada.
+type Notification struct {
+	ID        uuid.UUID        `json:"id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	Key       string           `json:"key"`
+	Type      NotificationKind `json:"type"`
+	Title     string           `json:"title"`
+	Body      string           `json:"body"`
+	Payload   map[string]any   `json:"payload"`
+	ReadAt    *time.Time       `json:"read_at"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// NewInvoiceDueReminder avisa do vencimento de uma fatura em aberto, com o saldo na moeda
+// base. A chave inclui o vencimento, então uma fatura com a data alterada gera um novo lembrete.
+func NewInvoiceDueReminder(userID uuid.UUID, id uuid.UUID, title string, dueDate time.Time, outstanding Money, currency string, today time.Time) Reminder {
+	return Reminder{
+		UserID:      userID,
+		Kind:        NotificationInvoiceDue,
+		Key:         fmt.Sprintf("%s:%s:%s", NotificationInvoiceDue, id, dueDate.Format(time.DateOnly)),
+		ReferenceID: &id,
+		Title:       fmt.Sprintf("Fatura %s %s", title, dueIn(dueDate, today)),
+		Body:        fmt.Sprintf("A fatura %s vence em %s com %s %s em aberto.", title, dueDate.Format("02/01/2006"), currency, outstanding),
+		Payload: map[string]any{
+			"invoice_id":  id,
+			"due_date":    dueDate.Format(time.DateOnly),
+			"outstanding": outstanding.Float64(),
+			"currency":    currency,
+		},
+	}
+}
+
+// NewInvoiceOverdueReminder avisa que uma fatura passou do vencimento sem ser paga.
+func NewInvoiceOverdueReminder(userID uuid.UUID, id uuid.UUID, title string, dueDate time.Time, outstanding Money, currency string) Reminder {
+	return Reminder{
+		UserID:      userID,
+		Kind:        NotificationInvoiceOverdue,
+		Key:         fmt.Sprintf("%s:%s:%s", NotificationInvoiceOverdue, id, dueDate.Format(time.DateOnly)),
+		ReferenceID: &id,
+		Title:       fmt.Sprintf("Fatura %s vencida", title),
+		Body:        fmt.Sprintf("A fatura %s venceu em %s e ainda tem %s %s em aberto.", title, dueDate.Format("02/01/2006"), currency, outstanding),
+		Payload: map[string]any{
+			"invoice_id":  id,
+			"due_date":    dueDate.Format(time.DateOnly),
+			"outstanding": outstanding.Float64(),
+			"currency":    currency,
+		},
+	}
+}
+
+// NewTransactionDueReminder avisa de uma transação pendente que vence em breve.
+func NewTransactionDueReminder(userID uuid.UUID, id uuid.UUID, title string, recordDate time.Time, amount Money, currency string, today time.Time) Reminder {
+	return Reminder{
+		UserID:      userID,
+		Kind:        NotificationTransactionDue,
+		Key:         fmt.Sprintf("%s:%s:%s", NotificationTransactionDue, id, recordDate.Format(time.DateOnly)),
+		ReferenceID: &id,
+		Title:       fmt.Sprintf("%s %s", title, dueIn(recordDate, today)),
+		Body:        fmt.Sprintf("O lançamento %s de %s %s vence em %s e ainda está pendente.", title, currency, amount, recordDate.Format("02/01/2006")),
+		Payload: map[string]any{
+			"transaction_id": id,
+			"record_date":    recordDate.Format(time.DateOnly),
+			"amount":         amount.Float64(),
+			"currency":       currency,
+		},
+	}
+}
+
+// NewBudgetAlertReminder avisa que o gasto de um orçamento atingiu o limite no mês.
+func NewBudgetAlertReminder(userID uuid.UUID, budgetID uuid.UUID, category string, month string, threshold int, budgeted Money, spent Money) Reminder {
+	return Reminder{
+		UserID:      userID,
+		Kind:        NotificationBudgetAlert,
+		Key:         fmt.Sprintf("%s:%s:%s:%d", NotificationBudgetAlert, budgetID, month, threshold),
+		ReferenceID: &budgetID,
+		Title:       fmt.Sprintf("Orçamento de %s em %d%%", category, threshold),
+		Body:        fmt.Sprintf("Você gastou %s dos %s previstos para %s em %s.", spent, budgeted, category, month),
+		Payload: map[string]any{
+			"budget_id": budgetID,
+			"month":     month,
+			"threshold": threshold,
+			"budgeted":  budgeted.Float64(),
+			"spent":     spent.Float64(),
+		},
+	}
+}
+
+// NewSpendingAnomalyReminder avisa de uma despesa bem acima do padrão da sua categoria.
+func NewSpendingAnomalyReminder(userID uuid.UUID, id uuid.UUID, title string, category string, recordDate time.Time, amount Money, average Money, currency string) Reminder {
+	return Reminder{
+		UserID:      userID,
+		Kind:        NotificationAnomaly,
+		Key:         fmt.Sprintf("%s:%s", NotificationAnomaly, id),
+		ReferenceID: &id,
+		Title:       fmt.Sprintf("Gasto fora do padrão em %s", category),
+		Body:        fmt.Sprintf("O lançamento %s de %s %s em %s está bem acima da média de %s %s da categoria %s.", title, currency, amount, recordDate.Format("02/01/2006"), currency, average, category),
+		Payload: map[string]any{
+			"transaction_id": id,
+			"category":       category,
+			"record_date":    recordDate.Format(time.DateOnly),
+			"amount":         amount.Float64(),
+			"average":        average.Float64(),
+			"currency":       currency,
+		},
+	}
+}
+
+// NewImportCompletedReminder avisa que todas as linhas de uma importação foram processadas.
+func NewImportCompletedReminder(job ImportJob) Reminder {
+	body := fmt.Sprintf("Todas as %d linhas de %s foram processadas.", job.Total, job.Filename)
+	if job.Failed > 0 {
+		body = fmt.Sprintf("%d de %d linhas de %s foram importadas; %d tiveram erro.", job.Total-job.Failed, job.Total, job.Filename, job.Failed)
+	}
+
+	return Reminder{
+		UserID:      job.UserID,
+		Kind:        NotificationImportCompleted,
+		Key:         fmt.Sprintf("%s:%s", NotificationImportCompleted, job.ID),
+		ReferenceID: &job.ID,
+		Title:       fmt.Sprintf("Importação de %s concluída", job.Filename),
+		Body:        body,
+		Payload: map[string]any{
+			"job_id":   job.ID,
+			"filename": job.Filename,
+			"total":    job.Total,
+			"failed":   job.Failed,
+		},
+	}
+}
+
+func dueIn(date time.Time, today time.Time) string {
+	days := int(date.Sub(today).Hours() / 24)
+	switch {
+	case days <= 0:
+		return "vence hoje"
+	case days == 1:
+		return "vence amanhã"
+	}
+	return fmt.Sprintf("vence em %d dias", days)
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+type Payee struct {
+	ID             uuid.UUID `json:"id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Name           string    `json:"name"`
+	NormalizedName string    `json:"normalized_name"`
+	Aliases        []string  `json:"aliases"`
+	Document       *string   `json:"document"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NewPayee valida o nome do favorecido e normaliza os apelidos informados pelo usuário,
+// descartando repetidos e os que coincidem com o próprio nome.
+func NewPayee(name string, aliases []string) (*Payee, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	normalized := NormalizePayee(name)
+	if normalized == "" {
+		return nil, appError.InvalidParam("name", fmt.Errorf("must contain letters or digits"))
+	}
+
+	normalizedAliases := []string{}
+	for _, alias := range aliases {
+		value := NormalizePayee(alias)
+		if value == "" {
+			return nil, appError.InvalidParam("aliases", fmt.Errorf("%q must contain letters or digits", alias))
+		}
+		if value == normalized || slices.Contains(normalizedAliases, value) {
+			continue
+		}
+		normalizedAliases = append(normalizedAliases, value)
+	}
+
+	return &Payee{
+		Name:           strings.TrimSpace(name),
+		NormalizedName: normalized,
+		Aliases:        normalizedAliases,
+	}, nil
+}
+
+// payeeProcessors são os prefixos de adquirentes e intermediadores que aparecem antes
+// do "*" no título da fatura, como em "IFD*IFOOD.COM" ou "PG *LOJA".
+var payeeProcessors = []string{
+	"IFD", "PG", "PAG", "PAGSEGURO", "PAGSEG", "MP", "MERCADOPAGO", "MERPAGO", "EC", "SUMUP",
+	"PP", "PAYPAL", "STONE", "CIELO", "GETNET", "ZP", "ZOOP", "EBANX", "DL", "PICPAY", "HTM",
+	"EBW", "SHPP", "GOOGLE",
+}
+
+// payeeCities são localidades que as adquirentes acrescentam ao final do título.
+var payeeCities = []string{
+	"SAO PAULO", "RIO DE JANEIRO", "BELO HORIZONTE", "PORTO ALEGRE", "BRASILIA", "CURITIBA",
+	"SALVADOR", "RECIFE", "FORTALEZA", "GOIANIA", "FLORIANOPOLIS", "CAMPINAS", "OSASCO",
+	"BARUERI", "GUARULHOS", "SANTOS", "NITEROI", "MANAUS", "BELEM", "VITORIA", "SAO BERNARDO",
+	"SANTO ANDRE", "SAO JOSE", "JOINVILLE", "LONDRINA", "RIBEIRAO PRETO", "SOROCABA", "UBERLANDIA",
+	"CONTAGEM", "NATAL", "JOAO PESSOA", "MACEIO", "TERESINA", "CUIABA", "CAMPO GRANDE", "ARACAJU",
+	"SAO LUIS",
+}
+
+// payeeRegions são as siglas de estado e país que costumam encerrar o título.
+var payeeRegions = []string{
+	"BR", "BRA", "BRASIL", "AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS",
+	"MG", "PA", "PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO",
+}
+
+var (
+	payeeInstallment = regexp.MustCompile(`(?i)\s*(\(?\s*(PARC(ELA)?\.?\s*)?\d{1,2}\s*/\s*\d{1,2}\s*\)?)\s*$`)
+	payeeDomain      = regexp.MustCompile(`(?i)^([^ ]+?)\.(COM|NET|ORG)(\.BR)?\b.*$`)
+)
+
+// NormalizePayee reduz o título de uma transação ao nome do estabelecimento: remove o
+// prefixo da adquirente, o que vem depois do "*", parcelas, domínios, cidade e estado.
+// O resultado é maiúsculo, sem acentos e serve como chave de agrupamento dos favorecidos.
+func NormalizePayee(title string) string {
+	value := strings.ToUpper(accentReplacer.Replace(strings.ToLower(strings.TrimSpace(title))))
+
+	value = payeeInstallment.ReplaceAllString(value, "")
+
+	if before, after, found := strings.Cut(value, "*"); found {
+		prefix := strings.TrimSpace(before)
+		if slices.Contains(payeeProcessors, prefix) || prefix == "" {
+			value = after
+		} else {
+			value = before
+		}
+		// Títulos com vários "*" mantêm apenas o primeiro trecho depois do corte
+		value, _, _ = strings.Cut(value, "*")
+	}
+
+	value = strings.TrimPrefix(strings.TrimSpace(value), "WWW.")
+	value = payeeDomain.ReplaceAllString(value, "$1")
+
+	tokens := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens = trimPayeeSuffixes(tokens)
+
+	return strings.Join(tokens, " ")
+}
+
+// PayeeDisplayName formata o nome normalizado para exibição ("IFOOD" vira "Ifood").
+func PayeeDisplayName(normalized string) string {
+	words := strings.Fields(strings.ToLower(normalized))
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
+
+// trimPayeeSuffixes remove do final do título as siglas de estado/país e o nome da cidade,
+// mantendo sempre ao menos uma palavra.
+func trimPayeeSuffixes(tokens []string) []string {
+	for len(tokens) > 1 && slices.Contains(payeeRegions, tokens[len(tokens)-1]) {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	for _, city := range payeeCities {
+		words := strings.Fields(city)
+		if len(tokens) <= len(words) {
+			continue
+		}
+		if slices.Equal(tokens[len(tokens)-len(words):], words) {
+			tokens = tokens[:len(tokens)-len(words)]
+			break
+		}
+	}
+
+	for len(tokens) > 1 && slices.Contains(payeeRegions, tokens[len(tokens)-1]) {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	return tokens
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizePayee(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "IFD*IFOOD.COM", want: "IFOOD"},
+		{title: "PG *LOJA X SAO PAULO BR", want: "LOJA X"},
+		{title: "UBER *TRIP HELP.UBER.COM", want: "UBER"},
+		{title: "*PADARIA", want: "PADARIA"},
+		{title: "Padaria São João 02/10", want: "PADARIA SAO JOAO"},
+		{title: "MAGAZINE LUIZA PARC 03/12", want: "MAGAZINE LUIZA"},
+		{title: "NETFLIX (1/12)", want: "NETFLIX"},
+		{title: "www.amazon.com.br", want: "AMAZON"},
+		{title: "Mercado Central Rio de Janeiro RJ", want: "MERCADO CENTRAL"},
+		{title: "  posto   shell  ", want: "POSTO SHELL"},
+		{title: "SP", want: "SP"},
+		{title: "***", want: ""},
+		{title: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := NormalizePayee(tt.title); got != tt.want {
+				t.Errorf("NormalizePayee(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayeeDisplayName(t *testing.T) {
+	tests := []struct {
+		normalized string
+		want       string
+	}{
+		{normalized: "IFOOD", want: "Ifood"},
+		{normalized: "PADARIA SAO JOAO", want: "Padaria Sao Joao"},
+		{normalized: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := PayeeDisplayName(tt.normalized); got != tt.want {
+			t.Errorf("PayeeDisplayName(%q) = %q, want %q", tt.normalized, got, tt.want)
+		}
+	}
+}
+
+func TestNewPayee(t *testing.T) {
+	tests := []struct {
+		name        string
+		payeeName   string
+		aliases     []string
+		wantAliases []string
+		wantErr     bool
+	}{
+		{name: "apelidos normalizados e sem repetição", payeeName: "iFood", aliases: []string{"IFD*IFOOD.COM", "ifood", "Ifood Club", "IFOOD CLUB"}, wantAliases: []string{"IFOOD CLUB"}},
+		{name: "nome vazio", payeeName: "  ", wantErr: true},
+		{name: "nome sem letras", payeeName: "***", wantErr: true},
+		{name: "apelido sem letras", payeeName: "iFood", aliases: []string{"--"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payee, err := NewPayee(tt.payeeName, tt.aliases)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewPayee(%q) = %+v, want error", tt.payeeName, payee)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPayee(%q) error: %v", tt.payeeName, err)
+			}
+			if !slices.Equal(payee.Aliases, tt.wantAliases) {
+				t.Errorf("aliases = %v, want %v", payee.Aliases, tt.wantAliases)
+			}
+		})
+	}
+}
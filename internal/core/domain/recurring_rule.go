@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurrenceFrequency string
+
+const (
+	FrequencyWeekly  RecurrenceFrequency = "weekly"
+	FrequencyMonthly RecurrenceFrequency = "monthly"
+	FrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
+func ValidRecurrenceFrequency() []string {
+	return []string{
+		string(FrequencyWeekly),
+		string(FrequencyMonthly),
+		string(FrequencyYearly),
+	}
+}
+
+func (f RecurrenceFrequency) IsValid() bool {
+	return slices.Contains(ValidRecurrenceFrequency(), string(f))
+}
+
+// RecurringRule é um lançamento que se repete (aluguel, salário, assinaturas) a partir de
+// StartDate até EndDate, se houver. As transações de cada ocorrência podem ser vinculadas à
+// regra; a previsão de fluxo de caixa projeta só as ocorrências que ainda não foram lançadas.
+type RecurringRule struct {
+	ID         uuid.UUID           `json:"id"`
+	Title      string              `json:"title"`
+	Amount     Money               `json:"amount"`
+	Currency   string              `json:"currency"`
+	RecordType RecordType          `json:"record_type"`
+	Frequency  RecurrenceFrequency `json:"frequency"`
+	StartDate  time.Time           `json:"start_date"`
+	EndDate    *time.Time          `json:"end_date"`
+	AccountID  *uuid.UUID          `json:"account_id"`
+	CategoryID *uuid.UUID          `json:"category_id"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+func NewRecurringRule(
+	title string,
+	amount Money,
+	recordType RecordType,
+	frequency RecurrenceFrequency,
+	startDate time.Time,
+	endDate *time.Time,
+	accountID *uuid.UUID,
+	categoryID *uuid.UUID,
+) (*RecurringRule, error) {
+	if title == "" {
+		return nil, appError.EmptyField("title")
+	}
+
+	if amount <= 0 {
+		return nil, appError.InvalidParam("amount", fmt.Errorf("must be greater than zero"))
+	}
+
+	if recordType == "" {
+		recordType = TypeExpense
+	}
+	if !recordType.IsValid() {
+		return nil, appError.InvalidParam("record_type", fmt.Errorf("invalid value"))
+	}
+
+	if !frequency.IsValid() {
+		return nil, appError.InvalidParam("frequency", fmt.Errorf("must be one of %v", ValidRecurrenceFrequency()))
+	}
+
+	if startDate.IsZero() {
+		return nil, appError.EmptyField("start_date")
+	}
+	startDate = time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	if endDate != nil {
+		date := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, time.UTC)
+		if date.Before(startDate) {
+			return nil, appError.InvalidParam("end_date", fmt.Errorf("must not be before start_date"))
+		}
+		endDate = &date
+	}
+
+	return &RecurringRule{
+		Title:      title,
+		Amount:     amount,
+		RecordType: recordType,
+		Frequency:  frequency,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		AccountID:  accountID,
+		CategoryID: categoryID,
+	}, nil
+}
+
+// Occurrence é a data da n-ésima repetição da regra, contando StartDate como a de número zero.
+// Nas regras mensais e anuais o dia que não existe no mês cai no último dia do mês.
+func (r RecurringRule) Occurrence(n int) time.Time {
+	switch r.Frequency {
+	case FrequencyWeekly:
+		return r.StartDate.AddDate(0, 0, 7*n)
+	case FrequencyYearly:
+		return AddMonths(r.StartDate, 12*n)
+	default:
+		return AddMonths(r.StartDate, n)
+	}
+}
+
+// Occurrences lista as repetições da regra entre from e to, inclusive, respeitando EndDate.
+// Ficam de fora as já lançadas: uma transação vinculada cobre a ocorrência do mesmo mês (regras
+// mensais), do mesmo ano (anuais) ou a até 3 dias de distância (semanais), então lançar o
+// aluguel alguns dias antes do vencimento não faz a previsão contar o mês duas vezes.
+func (r RecurringRule) Occurrences(from time.Time, to time.Time, recorded []time.Time) []time.Time {
+	dates := []time.Time{}
+	for n := 0; ; n++ {
+		date := r.Occurrence(n)
+		if date.After(to) || (r.EndDate != nil && date.After(*r.EndDate)) {
+			return dates
+		}
+		if date.Before(from) || slices.ContainsFunc(recorded, func(value time.Time) bool { return r.covers(date, value) }) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+}
+
+// covers indica se a transação lançada em recorded corresponde à ocorrência de date.
+func (r RecurringRule) covers(date time.Time, recorded time.Time) bool {
+	recorded = time.Date(recorded.Year(), recorded.Month(), recorded.Day(), 0, 0, 0, 0, time.UTC)
+	switch r.Frequency {
+	case FrequencyWeekly:
+		diff := date.Sub(recorded)
+		return diff <= 3*24*time.Hour && diff >= -3*24*time.Hour
+	case FrequencyYearly:
+		return date.Year() == recorded.Year()
+	default:
+		return date.Year() == recorded.Year() && date.Month() == recorded.Month()
+	}
+}
+
+// AddMonths soma months meses a date mantendo o dia, ou o último dia do mês quando ele não
+// existe (31/01 mais um mês é 28/02 ou 29/02).
+func AddMonths(date time.Time, months int) time.Time {
+	first := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location()).AddDate(0, months, 0)
+	last := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(date.Day(), last), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+	appError "frog-go/internal/core/errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RuleConditions reúne os critérios de uma regra; todos os informados precisam ser atendidos.
+type RuleConditions struct {
+	TitleContains *string     `json:"title_contains"`
+	TitleRegex    *string     `json:"title_regex"`
+	AmountMin     *Money      `json:"amount_min"`
+	AmountMax     *Money      `json:"amount_max"`
+	RecordType    *RecordType `json:"record_type"`
+	InvoiceID     *uuid.UUID  `json:"invoice_id"`
+}
+
+// RuleActions descreve o que acontece com a transação quando a regra é atendida.
+type RuleActions struct {
+	CategoryID *uuid.UUID  `json:"category_id"`
+	RecordType *RecordType `json:"record_type"`
+	Status     *TxnStatus  `json:"status"`
+	Skip       bool        `json:"skip"`
+}
+
+type Rule struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"user_id"`
+	Name       string         `json:"name"`
+	Priority   int            `json:"priority"`
+	Enabled    bool           `json:"enabled"`
+	Conditions RuleConditions `json:"conditions"`
+	Actions    RuleActions    `json:"actions"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+func NewRule(name string, priority int, enabled bool, conditions RuleConditions, actions RuleActions) (*Rule, error) {
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	if conditions.isEmpty() {
+		return nil, appError.EmptyField("conditions")
+	}
+
+	if conditions.TitleRegex != nil {
+		if _, err := regexp.Compile(*conditions.TitleRegex); err != nil {
+			return nil, appError.InvalidParam("conditions.title_regex", err)
+		}
+	}
+
+	if conditions.AmountMin != nil && conditions.AmountMax != nil && *conditions.AmountMin > *conditions.AmountMax {
+		return nil, appError.InvalidParam("conditions.amount_min", fmt.Errorf("must be less than or equal to amount_max"))
+	}
+
+	if conditions.RecordType != nil && !conditions.RecordType.IsValid() {
+		return nil, appError.InvalidParam("conditions.record_type", fmt.Errorf("invalid value"))
+	}
+
+	if actions.isEmpty() {
+		return nil, appError.EmptyField("actions")
+	}
+
+	if actions.RecordType != nil && !actions.RecordType.IsValid() {
+		return nil, appError.InvalidParam("actions.record_type", fmt.Errorf("invalid value"))
+	}
+
+	if actions.Status != nil && !actions.Status.IsValid() {
+		return nil, appError.InvalidParam("actions.status", fmt.Errorf("invalid value"))
+	}
+
+	return &Rule{
+		Name:       name,
+		Priority:   priority,
+		Enabled:    enabled,
+		Conditions: conditions,
+		Actions:    actions,
+	}, nil
+}
+
+// Matches indica se a transação atende a todas as condições da regra.
+func (r Rule) Matches(t Transaction) bool {
+	c := r.Conditions
+
+	if c.TitleContains != nil && !strings.Contains(strings.ToLower(t.Title), strings.ToLower(*c.TitleContains)) {
+		return false
+	}
+
+	if c.TitleRegex != nil {
+		re, err := regexp.Compile(*c.TitleRegex)
+		if err != nil || !re.MatchString(t.Title) {
+			return false
+		}
+	}
+
+	if c.AmountMin != nil && t.Amount < *c.AmountMin {
+		return false
+	}
+
+	if c.AmountMax != nil && t.Amount > *c.AmountMax {
+		return false
+	}
+
+	if c.RecordType != nil && t.RecordType != *c.RecordType {
+		return false
+	}
+
+	if c.InvoiceID != nil && (t.InvoiceID == nil || *t.InvoiceID != *c.InvoiceID) {
+		return false
+	}
+
+	return true
+}
+
+func (c RuleConditions) isEmpty() bool {
+	return c.TitleContains == nil &&
+		c.TitleRegex == nil &&
+		c.AmountMin == nil &&
+		c.AmountMax == nil &&
+		c.RecordType == nil &&
+		c.InvoiceID == nil
+}
+
+func (a RuleActions) isEmpty() bool {
+	return a.CategoryID == nil && a.RecordType == nil && a.Status == nil && !a.Skip
+}
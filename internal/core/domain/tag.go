@@ -0,0 +1,30 @@
+package domain
+
+import (
+	appError "frog-go/internal/core/errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name"`
+	Color     *string   `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTag(name string, color *string) (*Tag, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	return &Tag{
+		Name:  name,
+		Color: color,
+	}, nil
+}
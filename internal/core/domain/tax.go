@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// TaxGroup classifica uma categoria na declaração do imposto de renda. Os grupos de despesa
+// são dedutíveis; os de receita separam os rendimentos tributáveis dos isentos. Receitas em
+// categorias sem grupo contam como tributáveis.
+type TaxGroup string
+
+const (
+	TaxHealth        TaxGroup = "health"
+	TaxEducation     TaxGroup = "education"
+	TaxPension       TaxGroup = "pension"
+	TaxTaxableIncome TaxGroup = "taxable_income"
+	TaxExemptIncome  TaxGroup = "exempt_income"
+)
+
+func ValidTaxGroup() []string {
+	return []string{
+		string(TaxHealth),
+		string(TaxEducation),
+		string(TaxPension),
+		string(TaxTaxableIncome),
+		string(TaxExemptIncome),
+	}
+}
+
+func (g TaxGroup) IsValid() bool {
+	return slices.Contains(ValidTaxGroup(), string(g))
+}
+
+// IsDeductible indica se o grupo reúne despesas dedutíveis.
+func (g TaxGroup) IsDeductible() bool {
+	return g == TaxHealth || g == TaxEducation || g == TaxPension
+}
+
+func (g TaxGroup) Label() string {
+	switch g {
+	case TaxHealth:
+		return "Despesas médicas"
+	case TaxEducation:
+		return "Instrução"
+	case TaxPension:
+		return "Previdência complementar"
+	case TaxTaxableIncome:
+		return "Rendimentos tributáveis"
+	case TaxExemptIncome:
+		return "Rendimentos isentos"
+	}
+	return string(g)
+}
+
+// TaxGroupOf é o grupo fiscal da categoria: o dela ou, sem grupo próprio, o do ancestral mais
+// próximo que tenha um.
+func (t CategoryTree) TaxGroupOf(id uuid.UUID, groups map[uuid.UUID]TaxGroup) (TaxGroup, bool) {
+	visited := map[uuid.UUID]bool{}
+	for current := &id; current != nil && !visited[*current]; current = t[*current] {
+		if group, ok := groups[*current]; ok {
+			return group, true
+		}
+		visited[*current] = true
+	}
+	return "", false
+}
+
+// NormalizeTaxDocument valida um CPF (11 dígitos) ou CNPJ (14 dígitos) pelos dígitos
+// verificadores e o devolve só com os números.
+func NormalizeTaxDocument(value string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		if r == '.' || r == '-' || r == '/' || unicode.IsSpace(r) {
+			return -1
+		}
+		return 'x'
+	}, value)
+
+	if strings.Contains(digits, "x") {
+		return "", fmt.Errorf("must contain only digits")
+	}
+
+	switch len(digits) {
+	case 11:
+		if !validCheckDigits(digits, []int{10, 9, 8, 7, 6, 5, 4, 3, 2}, []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) {
+			return "", fmt.Errorf("invalid CPF")
+		}
+	case 14:
+		if !validCheckDigits(digits, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) {
+			return "", fmt.Errorf("invalid CNPJ")
+		}
+	default:
+		return "", fmt.Errorf("must be a CPF (11 digits) or a CNPJ (14 digits)")
+	}
+
+	return digits, nil
+}
+
+// FormatTaxDocument aplica a máscara do CPF (000.000.000-00) ou do CNPJ (00.000.000/0000-00).
+func FormatTaxDocument(digits string) string {
+	switch len(digits) {
+	case 11:
+		return digits[:3] + "." + digits[3:6] + "." + digits[6:9] + "-" + digits[9:]
+	case 14:
+		return digits[:2] + "." + digits[2:5] + "." + digits[5:8] + "/" + digits[8:12] + "-" + digits[12:]
+	}
+	return digits
+}
+
+// validCheckDigits confere os dois dígitos verificadores pelo módulo 11 com os pesos de cada
+// dígito. Sequências de um mesmo número passam no cálculo, mas não são documentos válidos.
+func validCheckDigits(digits string, firstWeights, secondWeights []int) bool {
+	if strings.Count(digits, digits[:1]) == len(digits) {
+		return false
+	}
+
+	check := func(weights []int) byte {
+		sum := 0
+		for i, weight := range weights {
+			sum += int(digits[i]-'0') * weight
+		}
+		rest := sum % 11
+		if rest < 2 {
+			return '0'
+		}
+		return byte('0' + 11 - rest)
+	}
+
+	n := len(digits)
+	return digits[n-2] == check(firstWeights) && digits[n-1] == check(secondWeights)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "testing"
+
+func TestNormalizeTaxDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "CPF com máscara", value: "529.982.247-25", want: "52998224725"},
+		{name: "CPF só com dígitos", value: "52998224725", want: "52998224725"},
+		{name: "CNPJ com máscara", value: "11.222.333/0001-81", want: "11222333000181"},
+		{name: "CNPJ com espaços", value: " 11 222 333 0001 81 ", want: "11222333000181"},
+		{name: "CPF com dígito verificador errado", value: "529.982.247-24", wantErr: true},
+		{name: "CNPJ com dígito verificador errado", value: "11.222.333/0001-80", wantErr: true},
+		{name: "sequência repetida", value: "111.111.111-11", wantErr: true},
+		{name: "letras", value: "529.982.247-2X", wantErr: true},
+		{name: "tamanho inválido", value: "1234567890", wantErr: true},
+		{name: "vazio", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTaxDocument(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NormalizeTaxDocument(%q) = %q, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeTaxDocument(%q) error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeTaxDocument(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTaxDocument(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   string
+	}{
+		{digits: "52998224725", want: "529.982.247-25"},
+		{digits: "11222333000181", want: "11.222.333/0001-81"},
+		{digits: "123", want: "123"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatTaxDocument(tt.digits); got != tt.want {
+			t.Errorf("FormatTaxDocument(%q) = %q, want %q", tt.digits, got, tt.want)
+		}
+	}
+}
@@ -50,22 +50,33 @@ func (a RecordType) IsValid() bool {
 }
 
 type Transaction struct {
-	ID         uuid.UUID  `json:"id"`
-	UserID     uuid.UUID  `json:"user_id"`
-	Title      string     `json:"title"`
-	Amount     float64    `json:"amount"`
-	RecordDate time.Time  `json:"record_date"`
-	CategoryID *uuid.UUID `json:"category_id"`
-	InvoiceID  *uuid.UUID `json:"invoice_id"`
-	Status     TxnStatus  `json:"status"`
-	RecordType RecordType `json:"record_type"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
+	ID         uuid.UUID          `json:"id"`
+	UserID     uuid.UUID          `json:"user_id"`
+	Title      string             `json:"title"`
+	Amount     Money              `json:"amount"`
+	Currency   string             `json:"currency"`
+	RecordDate time.Time          `json:"record_date"`
+	CategoryID *uuid.UUID         `json:"category_id"`
+	InvoiceID  *uuid.UUID         `json:"invoice_id"`
+	TagIDs     []uuid.UUID        `json:"tag_ids"`
+	Splits     []TransactionSplit `json:"splits"`
+	// TransferAccountID é a conta do livro que recebe o dinheiro quando o lançamento é uma
+	// transferência (ex.: o aporte mensal na poupança)
+	TransferAccountID *uuid.UUID `json:"transfer_account_id"`
+	// RecurringRuleID vincula a transação a uma ocorrência de regra recorrente
+	RecurringRuleID *uuid.UUID `json:"recurring_rule_id"`
+	// InstallmentNumber e InstallmentCount marcam a parcela de uma compra parcelada no cartão
+	InstallmentNumber *int       `json:"installment_number"`
+	InstallmentCount  *int       `json:"installment_count"`
+	Status            TxnStatus  `json:"status"`
+	RecordType        RecordType `json:"record_type"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
 }
 
 func NewTransaction(
 	title string,
-	amount float64,
+	amount Money,
 	RecordDate time.Time,
 	invoiceID *uuid.UUID,
 	categoryID *uuid.UUID,
@@ -104,3 +115,69 @@ func NewTransaction(
 		CategoryID: categoryID,
 	}, nil
 }
+
+// SetInstallment marca a transação como a parcela number de count de uma compra parcelada no
+// cartão. Só vale para transações em fatura; sem os dois valores a compra é à vista.
+func (t *Transaction) SetInstallment(number *int, count *int) error {
+	if number == nil && count == nil {
+		t.InstallmentNumber, t.InstallmentCount = nil, nil
+		return nil
+	}
+
+	if number == nil {
+		return appError.EmptyField("installment_number")
+	}
+	if count == nil {
+		return appError.EmptyField("installment_count")
+	}
+	if *count < 2 {
+		return appError.InvalidParam("installment_count", fmt.Errorf("must be at least 2"))
+	}
+	if *number < 1 || *number > *count {
+		return appError.InvalidParam("installment_number", fmt.Errorf("must be between 1 and installment_count"))
+	}
+	if t.InvoiceID == nil {
+		return appError.InvalidParam("installment_number", fmt.Errorf("only card purchases with an invoice_id have installments"))
+	}
+
+	t.InstallmentNumber, t.InstallmentCount = number, count
+	return nil
+}
+
+// TransactionSplit é uma das linhas de uma transação dividida entre categorias.
+type TransactionSplit struct {
+	ID         uuid.UUID  `json:"id"`
+	CategoryID *uuid.UUID `json:"category_id"`
+	Amount     Money      `json:"amount"`
+	Note       *string    `json:"note"`
+}
+
+// SetSplits divide a transação nas linhas informadas, que precisam somar o valor da
+// transação. Uma lista vazia desfaz a divisão.
+func (t *Transaction) SetSplits(splits []TransactionSplit) error {
+	if len(splits) == 1 {
+		return appError.InvalidParam("splits", fmt.Errorf("must have at least two lines"))
+	}
+
+	for _, split := range splits {
+		if split.Amount == 0 {
+			return appError.EmptyField("splits.amount")
+		}
+	}
+
+	if len(splits) > 0 && !SplitsMatch(t.Amount, splits) {
+		return appError.InvalidParam("splits", appError.ErrSplitMismatch)
+	}
+
+	t.Splits = splits
+	return nil
+}
+
+// SplitsMatch compara a soma das linhas com o valor da transação.
+func SplitsMatch(amount Money, splits []TransactionSplit) bool {
+	var total Money
+	for _, split := range splits {
+		total += split.Amount
+	}
+	return total == amount
+}
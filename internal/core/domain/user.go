@@ -20,6 +20,7 @@ type User struct {
 	Email        string    `json:"email"`
 	PasswordHash string    `json:"password_hash"`
 	IsActive     bool      `json:"is_active"`
+	BaseCurrency string    `json:"base_currency"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
+type AccountRequest struct {
+	Name        string `json:"name"`
+	AccountType string `json:"account_type"`
+	Currency    string `json:"currency"`
+}
+
+type AccountResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	AccountType string     `json:"account_type"`
+	Currency    string     `json:"currency"`
+	CreatedByID *uuid.UUID `json:"created_by_id"`
+	CreatedAt   string     `json:"created_at"`
+	UpdatedAt   string     `json:"updated_at"`
+}
+
+func (r *AccountRequest) ToDomain() (*domain.Account, error) {
+	accountType := domain.AccountType(r.AccountType)
+
+	return domain.NewAccount(r.Name, &accountType, r.Currency)
+}
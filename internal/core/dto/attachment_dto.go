@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/google/uuid"
+
+type AttachmentResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	Filename      string     `json:"filename"`
+	ContentType   string     `json:"content_type"`
+	Size          int64      `json:"size"`
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	InvoiceID     *uuid.UUID `json:"invoice_id,omitempty"`
+	ImportJobID   *uuid.UUID `json:"import_job_id,omitempty"`
+	CreatedAt     string     `json:"created_at"`
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BudgetMonthFormat é o formato dos meses de orçamento (ex: 2026-10).
+const BudgetMonthFormat = "2006-01"
+
+type BudgetRequest struct {
+	CategoryID string        `json:"category_id"`
+	Month      *string       `json:"month"`
+	Amount     *domain.Money `json:"amount" swaggertype:"number"`
+	Percentage *int          `json:"percentage"`
+}
+
+type BudgetFilters struct {
+	CategoryIDs *[]string `form:"category_ids"`
+	Month       *string   `form:"month"`
+}
+
+type BudgetResponse struct {
+	ID         uuid.UUID                   `json:"id"`
+	Category   TransactionCategoryResponse `json:"category"`
+	Month      *string                     `json:"month"`
+	Amount     *domain.Money               `json:"amount" swaggertype:"number"`
+	Percentage *int                        `json:"percentage"`
+	CreatedAt  string                      `json:"created_at"`
+	UpdatedAt  string                      `json:"updated_at"`
+}
+
+type BudgetStatusFilters struct {
+	Month string `form:"month"`
+}
+
+type BudgetStatusResponse struct {
+	BudgetID       uuid.UUID                   `json:"budget_id"`
+	Category       TransactionCategoryResponse `json:"category"`
+	Month          string                      `json:"month"`
+	Recurring      bool                        `json:"recurring"`
+	Budgeted       domain.Money                `json:"budgeted" swaggertype:"number"`
+	Spent          domain.Money                `json:"spent" swaggertype:"number"`
+	Remaining      domain.Money                `json:"remaining" swaggertype:"number"`
+	Projected      domain.Money                `json:"projected" swaggertype:"number"`
+	UsedPercentage float64                     `json:"used_percentage"`
+	Threshold      int                         `json:"threshold"`
+}
+
+// BudgetAlertEvent é publicado quando o gasto de um orçamento atinge 80% ou 100% no mês.
+type BudgetAlertEvent struct {
+	UserID    uuid.UUID                   `json:"user_id"`
+	BudgetID  uuid.UUID                   `json:"budget_id"`
+	Category  TransactionCategoryResponse `json:"category"`
+	Month     string                      `json:"month"`
+	Threshold int                         `json:"threshold"`
+	Budgeted  domain.Money                `json:"budgeted" swaggertype:"number"`
+	Spent     domain.Money                `json:"spent" swaggertype:"number"`
+}
+
+func (r *BudgetRequest) ToDomain() (*domain.Budget, error) {
+	categoryID, err := utils.ToUUID(r.CategoryID)
+	if err != nil {
+		return nil, appError.InvalidParam("category_id", err)
+	}
+
+	var month *time.Time
+	if r.Month != nil && *r.Month != "" {
+		value, err := ParseBudgetMonth(*r.Month)
+		if err != nil {
+			return nil, appError.InvalidParam("month", err)
+		}
+		month = &value
+	}
+
+	return domain.NewBudget(categoryID, month, r.Amount, r.Percentage)
+}
+
+// ParseBudgetMonth lê um mês no formato 2026-10.
+func ParseBudgetMonth(value string) (time.Time, error) {
+	return time.Parse(BudgetMonthFormat, value)
+}
@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CashFlowFilters struct {
+	Days         int `form:"days"`
+	LookbackDays int `form:"lookback_days"`
+}
+
+type CashFlowItemResponse struct {
+	Date      string       `json:"date"`
+	Kind      string       `json:"kind"`
+	SourceID  uuid.UUID    `json:"source_id"`
+	Title     string       `json:"title"`
+	AccountID *uuid.UUID   `json:"account_id"`
+	Amount    domain.Money `json:"amount" swaggertype:"number"`
+}
+
+// CashFlowEstimateResponse é o gasto variável médio da categoria: Total é o gasto pago na
+// janela de lookback_days e DailyAmount a média diária projetada.
+type CashFlowEstimateResponse struct {
+	CategoryID   *uuid.UUID   `json:"category_id"`
+	CategoryName *string      `json:"category_name"`
+	Total        domain.Money `json:"total" swaggertype:"number"`
+	DailyAmount  domain.Money `json:"daily_amount" swaggertype:"number"`
+}
+
+type CashFlowDayResponse struct {
+	Date     string       `json:"date"`
+	Inflow   domain.Money `json:"inflow" swaggertype:"number"`
+	Outflow  domain.Money `json:"outflow" swaggertype:"number"`
+	Estimate domain.Money `json:"estimate" swaggertype:"number"`
+	Balance  domain.Money `json:"balance" swaggertype:"number"`
+}
+
+type CashFlowProjectionResponse struct {
+	OpeningBalance    domain.Money          `json:"opening_balance" swaggertype:"number"`
+	ClosingBalance    domain.Money          `json:"closing_balance" swaggertype:"number"`
+	LowestBalance     domain.Money          `json:"lowest_balance" swaggertype:"number"`
+	FirstNegativeDate *string               `json:"first_negative_date"`
+	Days              []CashFlowDayResponse `json:"days"`
+}
+
+type CashFlowAccountResponse struct {
+	AccountID  uuid.UUID                  `json:"account_id"`
+	Name       string                     `json:"name"`
+	Projection CashFlowProjectionResponse `json:"projection"`
+}
+
+// CashFlowForecastResponse é a previsão na moeda base. Total inclui os itens sem conta e os
+// gastos variáveis estimados; FirstNegativeDate é o primeiro dia em que algum saldo, de uma
+// conta ou o total, fica negativo.
+type CashFlowForecastResponse struct {
+	Currency          string                     `json:"currency"`
+	StartDate         string                     `json:"start_date"`
+	EndDate           string                     `json:"end_date"`
+	LookbackDays      int                        `json:"lookback_days"`
+	FirstNegativeDate *string                    `json:"first_negative_date"`
+	Total             CashFlowProjectionResponse `json:"total"`
+	Accounts          []CashFlowAccountResponse  `json:"accounts"`
+	Items             []CashFlowItemResponse     `json:"items"`
+	Estimates         []CashFlowEstimateResponse `json:"estimates"`
+}
+
+func NewCashFlowItemResponse(item domain.CashFlowItem) CashFlowItemResponse {
+	return CashFlowItemResponse{
+		Date:      item.Date.Format(time.DateOnly),
+		Kind:      string(item.Kind),
+		SourceID:  item.SourceID,
+		Title:     item.Title,
+		AccountID: item.AccountID,
+		Amount:    item.Amount,
+	}
+}
+
+func NewCashFlowEstimateResponse(estimate domain.CashFlowEstimate, categoryName *string) CashFlowEstimateResponse {
+	return CashFlowEstimateResponse{
+		CategoryID:   estimate.CategoryID,
+		CategoryName: categoryName,
+		Total:        estimate.Total,
+		DailyAmount:  domain.Money(math.Round(estimate.DailyAmount)),
+	}
+}
+
+func NewCashFlowProjectionResponse(projection domain.CashFlowProjection) CashFlowProjectionResponse {
+	response := CashFlowProjectionResponse{
+		OpeningBalance: projection.OpeningBalance,
+		ClosingBalance: projection.ClosingBalance,
+		LowestBalance:  projection.LowestBalance,
+		Days:           make([]CashFlowDayResponse, 0, len(projection.Days)),
+	}
+
+	if projection.FirstNegativeDate != nil {
+		response.FirstNegativeDate = utils.StringPtr(projection.FirstNegativeDate.Format(time.DateOnly))
+	}
+
+	for _, day := range projection.Days {
+		response.Days = append(response.Days, CashFlowDayResponse{
+			Date:     day.Date.Format(time.DateOnly),
+			Inflow:   day.Inflow,
+			Outflow:  day.Outflow,
+			Estimate: day.Estimate,
+			Balance:  day.Balance,
+		})
+	}
+	return response
+}
@@ -2,6 +2,8 @@ package dto
 
 import (
 	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
 
 	"github.com/google/uuid"
 )
@@ -11,27 +13,69 @@ type CategoryRequest struct {
 	Description         *string `json:"description"`
 	Color               *string `json:"color"`
 	SuggestedPercentage *int    `json:"suggested_percentage"`
+	ParentID            *string `json:"parent_id"`
+	TaxGroup            *string `json:"tax_group"`
 }
 
 type CategoryResponse struct {
-	ID                  uuid.UUID `json:"id"`
-	Name                string    `json:"name"`
-	Description         *string   `json:"description"`
-	Color               *string   `json:"color"`
-	SuggestedPercentage *int      `json:"suggested_percentage"`
+	ID                  uuid.UUID           `json:"id"`
+	Name                string              `json:"name"`
+	Description         *string             `json:"description"`
+	Color               *string             `json:"color"`
+	SuggestedPercentage *int                `json:"suggested_percentage"`
+	ParentID            *uuid.UUID          `json:"parent_id"`
+	TaxGroup            *string             `json:"tax_group"`
+	CreatedByID         *uuid.UUID          `json:"created_by_id"`
+	Children            []*CategoryResponse `json:"children,omitempty"`
 }
 
-func NewCategoryResponse(id uuid.UUID, name string, description, color *string, suggestedPercentage *int) *CategoryResponse {
+func NewCategoryResponse(id uuid.UUID, name string, description, color *string, suggestedPercentage *int, parentID *uuid.UUID, taxGroup *string, createdByID *uuid.UUID) *CategoryResponse {
 	return &CategoryResponse{
 		ID:                  id,
 		Name:                name,
 		Description:         description,
 		Color:               color,
 		SuggestedPercentage: suggestedPercentage,
+		ParentID:            parentID,
+		TaxGroup:            taxGroup,
+		CreatedByID:         createdByID,
 	}
 }
 
 func (r *CategoryRequest) ToDomain() (*domain.Category, error) {
-	return domain.NewCategory(r.Name, r.Description, r.Color, r.SuggestedPercentage)
+	var parentID *uuid.UUID
+	if r.ParentID != nil {
+		id, err := utils.ToNillableUUID(*r.ParentID)
+		if err != nil {
+			return nil, appError.InvalidParam("parent_id", err)
+		}
+		parentID = id
+	}
+
+	var taxGroup *domain.TaxGroup
+	if r.TaxGroup != nil && *r.TaxGroup != "" {
+		value := domain.TaxGroup(*r.TaxGroup)
+		taxGroup = &value
+	}
+
+	return domain.NewCategory(r.Name, r.Description, r.Color, r.SuggestedPercentage, parentID, taxGroup)
+}
+
+type CategorySuggestFilters struct {
+	Title  string       `form:"title" binding:"required"`
+	Amount domain.Money `form:"amount"`
+}
+
+type CategoryCandidateResponse struct {
+	CategoryID uuid.UUID `json:"category_id"`
+	Category   string    `json:"category"`
+	Confidence float64   `json:"confidence"`
+}
 
+type CategorySuggestionResponse struct {
+	CategoryID *uuid.UUID                  `json:"category_id"`
+	Category   *string                     `json:"category"`
+	Source     *string                     `json:"source"`
+	Confidence float64                     `json:"confidence"`
+	Candidates []CategoryCandidateResponse `json:"candidates"`
 }
@@ -1,34 +1,82 @@
 package dto
 
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
 type ChartFilters struct {
 	Period    string `form:"period"`
 	StartDate string `form:"start_date"`
 	EndDate   string `form:"end_date"`
 	DateField string `form:"date_field"`
+	Depth     *int   `form:"depth"`
+	GroupBy   string `form:"group_by" binding:"omitempty,oneof=category tag"`
+}
+
+const (
+	GroupByCategory = "category"
+	GroupByTag      = "tag"
+)
+
+// ChartPeriodTrunc converte o período dos gráficos (daily, weekly, monthly, yearly) na
+// unidade usada pelo DATE_TRUNC do Postgres.
+func ChartPeriodTrunc(period string) (string, error) {
+	switch period {
+	case "daily":
+		return "day", nil
+	case "weekly":
+		return "week", nil
+	case "monthly":
+		return "month", nil
+	case "year", "yearly":
+		return "year", nil
+	default:
+		return "", fmt.Errorf("invalid period: %s", period)
+	}
 }
 
 type CategorySummary struct {
-	Category            string  `json:"category"`
-	Income              float64 `json:"income"`
-	Expense             float64 `json:"expense"`
-	Tax                 float64 `json:"tax"`
-	IncomeTransactions  int     `json:"income_transactions"`
-	ExpenseTransactions int     `json:"expense_transactions"`
+	CategoryID          uuid.UUID    `json:"category_id"`
+	Category            string       `json:"category"`
+	ParentID            *uuid.UUID   `json:"parent_id"`
+	Depth               int          `json:"depth"`
+	Income              domain.Money `json:"income" swaggertype:"number"`
+	Expense             domain.Money `json:"expense" swaggertype:"number"`
+	Tax                 domain.Money `json:"tax" swaggertype:"number"`
+	IncomeTransactions  int          `json:"income_transactions"`
+	ExpenseTransactions int          `json:"expense_transactions"`
+}
+
+type TagSummary struct {
+	TagID               uuid.UUID    `json:"tag_id"`
+	Tag                 string       `json:"tag"`
+	Color               *string      `json:"color"`
+	Income              domain.Money `json:"income" swaggertype:"number"`
+	Expense             domain.Money `json:"expense" swaggertype:"number"`
+	Tax                 domain.Money `json:"tax" swaggertype:"number"`
+	IncomeTransactions  int          `json:"income_transactions"`
+	ExpenseTransactions int          `json:"expense_transactions"`
 }
 
 type SummaryByDate struct {
 	Date       string            `json:"date"`
-	Income     float64           `json:"income"`
-	Tax        float64           `json:"tax"`
-	Expense    float64           `json:"expense"`
+	Income     domain.Money      `json:"income" swaggertype:"number"`
+	Tax        domain.Money      `json:"tax" swaggertype:"number"`
+	Expense    domain.Money      `json:"expense" swaggertype:"number"`
 	Categories []CategorySummary `json:"categories"`
+	Tags       []TagSummary      `json:"tags,omitempty"`
 }
 
 type TransactionStatsSummary struct {
-	Income              float64 `json:"income"`
-	Expense             float64 `json:"expense"`
-	Tax                 float64 `json:"tax"`
-	Balance             float64 `json:"balance"`
-	IncomeTransactions  int     `json:"income_transactions"`
-	ExpenseTransactions int     `json:"expense_transactions"`
+	Income              domain.Money      `json:"income" swaggertype:"number"`
+	Expense             domain.Money      `json:"expense" swaggertype:"number"`
+	Tax                 domain.Money      `json:"tax" swaggertype:"number"`
+	Balance             domain.Money      `json:"balance" swaggertype:"number"`
+	IncomeTransactions  int               `json:"income_transactions"`
+	ExpenseTransactions int               `json:"expense_transactions"`
+	Categories          []CategorySummary `json:"categories"`
+	Tags                []TagSummary      `json:"tags,omitempty"`
 }
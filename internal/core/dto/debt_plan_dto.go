@@ -0,0 +1,165 @@
+package dto
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DebtPlanRequest são os parâmetros do planejamento. CardInterestRate é o percentual ao mês
+// cobrado sobre o saldo das faturas e CardMinimumPercentage o mínimo pago delas (15% quando
+// omitido); CustomOrder lista os IDs das dívidas na ordem da estratégia custom.
+type DebtPlanRequest struct {
+	MonthlyBudget         domain.Money `json:"monthly_budget" swaggertype:"number"`
+	CardInterestRate      float64      `json:"card_interest_rate"`
+	CardMinimumPercentage *float64     `json:"card_minimum_percentage"`
+	CustomOrder           []string     `json:"custom_order"`
+	StartDate             string       `json:"start_date"`
+}
+
+type DebtResponse struct {
+	ID                uuid.UUID    `json:"id"`
+	Kind              string       `json:"kind"`
+	Name              string       `json:"name"`
+	Balance           domain.Money `json:"balance" swaggertype:"number"`
+	InterestRate      float64      `json:"interest_rate"`
+	MinimumPayment    domain.Money `json:"minimum_payment" swaggertype:"number"`
+	MinimumPercentage *float64     `json:"minimum_percentage"`
+}
+
+type DebtPayoffResponse struct {
+	Debt         DebtResponse `json:"debt"`
+	Month        int          `json:"month"`
+	PayoffDate   *string      `json:"payoff_date"`
+	InterestPaid domain.Money `json:"interest_paid" swaggertype:"number"`
+	TotalPaid    domain.Money `json:"total_paid" swaggertype:"number"`
+}
+
+// DebtStrategyResponse é a simulação de uma estratégia. ExtraInterest é quanto ela paga de
+// juros a mais que a melhor estratégia.
+type DebtStrategyResponse struct {
+	Strategy      string               `json:"strategy"`
+	Months        int                  `json:"months"`
+	PayoffDate    *string              `json:"payoff_date"`
+	TotalInterest domain.Money         `json:"total_interest" swaggertype:"number"`
+	TotalPaid     domain.Money         `json:"total_paid" swaggertype:"number"`
+	ExtraInterest domain.Money         `json:"extra_interest" swaggertype:"number"`
+	Payoffs       []DebtPayoffResponse `json:"payoffs"`
+}
+
+// DebtPlanResponse compara as estratégias. Best é a de menor juros (no empate, a mais curta)
+// e InterestSaved é a diferença de juros entre ela e a pior.
+type DebtPlanResponse struct {
+	Currency       string                 `json:"currency"`
+	MonthlyBudget  domain.Money           `json:"monthly_budget" swaggertype:"number"`
+	MinimumPayment domain.Money           `json:"minimum_payment" swaggertype:"number"`
+	StartDate      string                 `json:"start_date"`
+	Debts          []DebtResponse         `json:"debts"`
+	Strategies     []DebtStrategyResponse `json:"strategies"`
+	Best           *string                `json:"best"`
+	InterestSaved  domain.Money           `json:"interest_saved" swaggertype:"number"`
+}
+
+func (r *DebtPlanRequest) ToDomain() (*domain.DebtPlan, error) {
+	customOrder := make([]uuid.UUID, 0, len(r.CustomOrder))
+	for _, value := range r.CustomOrder {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, appError.InvalidParam("custom_order", fmt.Errorf("invalid debt id %q", value))
+		}
+		customOrder = append(customOrder, id)
+	}
+
+	var startDate time.Time
+	if r.StartDate != "" {
+		var err error
+		startDate, err = utils.ToDateTime(r.StartDate)
+		if err != nil {
+			return nil, appError.InvalidParam("start_date", err)
+		}
+	}
+
+	return domain.NewDebtPlan(r.MonthlyBudget, r.CardInterestRate, r.CardMinimumPercentage, customOrder, startDate)
+}
+
+func NewDebtResponse(debt domain.OpenDebt) DebtResponse {
+	return DebtResponse{
+		ID:                debt.ID,
+		Kind:              string(debt.Kind),
+		Name:              debt.Name,
+		Balance:           debt.Balance,
+		InterestRate:      debt.InterestRate,
+		MinimumPayment:    debt.MinimumPayment,
+		MinimumPercentage: debt.MinimumPercentage,
+	}
+}
+
+// NewDebtPlanResponse monta a comparação a partir das simulações de cada estratégia.
+func NewDebtPlanResponse(currency string, plan domain.DebtPlan, debts []domain.OpenDebt, results []domain.DebtPlanResult) *DebtPlanResponse {
+	response := &DebtPlanResponse{
+		Currency:       currency,
+		MonthlyBudget:  plan.MonthlyBudget,
+		MinimumPayment: domain.MinimumPayments(debts),
+		StartDate:      plan.StartDate.Format(time.DateOnly),
+		Debts:          make([]DebtResponse, 0, len(debts)),
+		Strategies:     make([]DebtStrategyResponse, 0, len(results)),
+	}
+
+	for _, debt := range debts {
+		response.Debts = append(response.Debts, NewDebtResponse(debt))
+	}
+
+	var best, worst *domain.DebtPlanResult
+	for i := range results {
+		result := &results[i]
+		if best == nil || result.TotalInterest < best.TotalInterest ||
+			(result.TotalInterest == best.TotalInterest && result.Months < best.Months) {
+			best = result
+		}
+		if worst == nil || result.TotalInterest > worst.TotalInterest {
+			worst = result
+		}
+	}
+
+	if best != nil {
+		response.Best = utils.StringPtr(string(best.Strategy))
+		response.InterestSaved = worst.TotalInterest - best.TotalInterest
+	}
+
+	for _, result := range results {
+		item := DebtStrategyResponse{
+			Strategy:      string(result.Strategy),
+			Months:        result.Months,
+			PayoffDate:    formatPayoffDate(result.PayoffDate),
+			TotalInterest: result.TotalInterest,
+			TotalPaid:     result.TotalPaid,
+			ExtraInterest: result.TotalInterest - best.TotalInterest,
+			Payoffs:       make([]DebtPayoffResponse, 0, len(result.Payoffs)),
+		}
+
+		for _, payoff := range result.Payoffs {
+			item.Payoffs = append(item.Payoffs, DebtPayoffResponse{
+				Debt:         NewDebtResponse(payoff.Debt),
+				Month:        payoff.Month,
+				PayoffDate:   formatPayoffDate(payoff.PayoffDate),
+				InterestPaid: payoff.InterestPaid,
+				TotalPaid:    payoff.TotalPaid,
+			})
+		}
+
+		response.Strategies = append(response.Strategies, item)
+	}
+
+	return response
+}
+
+func formatPayoffDate(date time.Time) *string {
+	if date.IsZero() {
+		return nil
+	}
+	return utils.StringPtr(date.Format(time.DateOnly))
+}
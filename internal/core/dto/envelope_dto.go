@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type EnvelopeAllocationRequest struct {
+	CategoryID string       `json:"category_id"`
+	Month      string       `json:"month"`
+	Amount     domain.Money `json:"amount" swaggertype:"number"`
+	Note       *string      `json:"note"`
+}
+
+type EnvelopeMoveRequest struct {
+	FromCategoryID string       `json:"from_category_id"`
+	ToCategoryID   string       `json:"to_category_id"`
+	Month          string       `json:"month"`
+	Amount         domain.Money `json:"amount" swaggertype:"number"`
+	Note           *string      `json:"note"`
+}
+
+type EnvelopeFilters struct {
+	Month string `form:"month"`
+}
+
+type EnvelopeAllocationFilters struct {
+	CategoryIDs *[]string `form:"category_ids"`
+	Kinds       *[]string `form:"kinds"`
+	Month       *string   `form:"month"`
+}
+
+type EnvelopeAllocationResponse struct {
+	ID         uuid.UUID                   `json:"id"`
+	Category   TransactionCategoryResponse `json:"category"`
+	Month      string                      `json:"month"`
+	Amount     domain.Money                `json:"amount" swaggertype:"number"`
+	Kind       string                      `json:"kind"`
+	TransferID *uuid.UUID                  `json:"transfer_id"`
+	Note       *string                     `json:"note"`
+	CreatedAt  string                      `json:"created_at"`
+	UpdatedAt  string                      `json:"updated_at"`
+}
+
+type EnvelopeResponse struct {
+	Category    TransactionCategoryResponse `json:"category"`
+	CarriedOver domain.Money                `json:"carried_over" swaggertype:"number"`
+	Assigned    domain.Money                `json:"assigned" swaggertype:"number"`
+	Spent       domain.Money                `json:"spent" swaggertype:"number"`
+	Available   domain.Money                `json:"available" swaggertype:"number"`
+}
+
+type EnvelopeMonthResponse struct {
+	Month        string             `json:"month"`
+	Income       domain.Money       `json:"income" swaggertype:"number"`
+	Assigned     domain.Money       `json:"assigned" swaggertype:"number"`
+	ToBeAssigned domain.Money       `json:"to_be_assigned" swaggertype:"number"`
+	Envelopes    []EnvelopeResponse `json:"envelopes"`
+}
+
+func (r *EnvelopeAllocationRequest) ToDomain() (*domain.EnvelopeAllocation, error) {
+	categoryID, err := utils.ToUUID(r.CategoryID)
+	if err != nil {
+		return nil, appError.InvalidParam("category_id", err)
+	}
+
+	month, err := ParseBudgetMonth(r.Month)
+	if err != nil {
+		return nil, appError.InvalidParam("month", err)
+	}
+
+	return domain.NewEnvelopeAllocation(categoryID, month, r.Amount, r.Note)
+}
+
+func (r *EnvelopeMoveRequest) ToDomain() (*domain.EnvelopeMove, error) {
+	fromCategoryID, err := utils.ToUUID(r.FromCategoryID)
+	if err != nil {
+		return nil, appError.InvalidParam("from_category_id", err)
+	}
+
+	toCategoryID, err := utils.ToUUID(r.ToCategoryID)
+	if err != nil {
+		return nil, appError.InvalidParam("to_category_id", err)
+	}
+
+	month, err := ParseBudgetMonth(r.Month)
+	if err != nil {
+		return nil, appError.InvalidParam("month", err)
+	}
+
+	return domain.NewEnvelopeMove(fromCategoryID, toCategoryID, month, r.Amount, r.Note)
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRateRequest struct {
+	FromCurrency string  `json:"from_currency"`
+	ToCurrency   string  `json:"to_currency"`
+	Rate         float64 `json:"rate"`
+	RateDate     string  `json:"rate_date"`
+}
+
+type ExchangeRateFilters struct {
+	FromCurrency *string `form:"from_currency"`
+	ToCurrency   *string `form:"to_currency"`
+	StartDate    *string `form:"start_date"`
+	EndDate      *string `form:"end_date"`
+}
+
+type ExchangeRateResponse struct {
+	ID           uuid.UUID `json:"id"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         float64   `json:"rate"`
+	RateDate     string    `json:"rate_date"`
+	CreatedAt    string    `json:"created_at"`
+	UpdatedAt    string    `json:"updated_at"`
+}
+
+type ExchangeRateImportResponse struct {
+	Imported int `json:"imported"`
+}
+
+func (r *ExchangeRateRequest) ToDomain() (*domain.ExchangeRate, error) {
+	rateDate, err := utils.ToDateTime(r.RateDate)
+	if err != nil {
+		return nil, appError.InvalidParam("rate_date", err)
+	}
+
+	return domain.NewExchangeRate(r.FromCurrency, r.ToCurrency, r.Rate, rateDate)
+}
@@ -0,0 +1,138 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransactionParticipantRequest struct {
+	UserID     string        `json:"user_id"`
+	Percentage *float64      `json:"percentage"`
+	Amount     *domain.Money `json:"amount" swaggertype:"number"`
+}
+
+type TransactionParticipantsRequest struct {
+	PaidByID     *string                         `json:"paid_by_id"`
+	Mode         string                          `json:"mode"`
+	Participants []TransactionParticipantRequest `json:"participants"`
+}
+
+type TransactionParticipantResponse struct {
+	User       LedgerUserResponse `json:"user"`
+	Amount     domain.Money       `json:"amount" swaggertype:"number"`
+	Percentage *float64           `json:"percentage"`
+}
+
+type TransactionParticipantsResponse struct {
+	TransactionID uuid.UUID                        `json:"transaction_id"`
+	Currency      string                           `json:"currency"`
+	PaidBy        *LedgerUserResponse              `json:"paid_by"`
+	Mode          *string                          `json:"mode"`
+	Participants  []TransactionParticipantResponse `json:"participants"`
+}
+
+type SettlementRequest struct {
+	FromUserID string       `json:"from_user_id"`
+	ToUserID   string       `json:"to_user_id"`
+	Amount     domain.Money `json:"amount" swaggertype:"number"`
+	SettledAt  *string      `json:"settled_at"`
+	Note       *string      `json:"note"`
+}
+
+type SettleUpRequest struct {
+	Note *string `json:"note"`
+}
+
+type SettlementResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	From      LedgerUserResponse `json:"from"`
+	To        LedgerUserResponse `json:"to"`
+	Amount    domain.Money       `json:"amount" swaggertype:"number"`
+	SettledAt string             `json:"settled_at"`
+	Note      *string            `json:"note"`
+	CreatedAt string             `json:"created_at"`
+}
+
+type SettlementFilters struct {
+	UserID *string `form:"user_id"`
+}
+
+// SharedBalanceResponse resume a posição de um membro nas despesas divididas, na moeda
+// base: Net positivo é o que ele tem a receber e negativo o que ele deve.
+type SharedBalanceResponse struct {
+	User     LedgerUserResponse `json:"user"`
+	Paid     domain.Money       `json:"paid" swaggertype:"number"`
+	Share    domain.Money       `json:"share" swaggertype:"number"`
+	Sent     domain.Money       `json:"sent" swaggertype:"number"`
+	Received domain.Money       `json:"received" swaggertype:"number"`
+	Net      domain.Money       `json:"net" swaggertype:"number"`
+}
+
+type SettlementPaymentResponse struct {
+	From   LedgerUserResponse `json:"from"`
+	To     LedgerUserResponse `json:"to"`
+	Amount domain.Money       `json:"amount" swaggertype:"number"`
+}
+
+type SharedBalancesResponse struct {
+	Currency string                      `json:"currency"`
+	Balances []SharedBalanceResponse     `json:"balances"`
+	Payments []SettlementPaymentResponse `json:"payments"`
+}
+
+// ToDomain monta a divisão da despesa; sem paid_by_id, quem pagou é paidByID.
+func (r *TransactionParticipantsRequest) ToDomain(paidByID uuid.UUID) (*domain.ExpenseSharing, error) {
+	if r.PaidByID != nil {
+		id, err := utils.ToUUID(*r.PaidByID)
+		if err != nil {
+			return nil, appError.InvalidParam("paid_by_id", err)
+		}
+		paidByID = id
+	}
+
+	participants := make([]domain.ExpenseParticipant, 0, len(r.Participants))
+	for _, item := range r.Participants {
+		userID, err := utils.ToUUID(item.UserID)
+		if err != nil {
+			return nil, appError.InvalidParam("participants.user_id", err)
+		}
+
+		participant := domain.ExpenseParticipant{
+			UserID:     userID,
+			Percentage: item.Percentage,
+		}
+		if item.Amount != nil {
+			participant.Amount = *item.Amount
+		}
+		participants = append(participants, participant)
+	}
+
+	return domain.NewExpenseSharing(paidByID, domain.ShareMode(r.Mode), participants)
+}
+
+func (r *SettlementRequest) ToDomain() (*domain.Settlement, error) {
+	fromUserID, err := utils.ToUUID(r.FromUserID)
+	if err != nil {
+		return nil, appError.InvalidParam("from_user_id", err)
+	}
+
+	toUserID, err := utils.ToUUID(r.ToUserID)
+	if err != nil {
+		return nil, appError.InvalidParam("to_user_id", err)
+	}
+
+	var settledAt *time.Time
+	if r.SettledAt != nil {
+		date, err := utils.ToDateTime(*r.SettledAt)
+		if err != nil {
+			return nil, appError.InvalidParam("settled_at", err)
+		}
+		settledAt = &date
+	}
+
+	return domain.NewSettlement(fromUserID, toUserID, r.Amount, settledAt, r.Note)
+}
@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type GoalRequest struct {
+	Name          string        `json:"name"`
+	TargetAmount  domain.Money  `json:"target_amount" swaggertype:"number"`
+	InitialAmount *domain.Money `json:"initial_amount" swaggertype:"number"`
+	TargetDate    string        `json:"target_date"`
+	AccountID     *string       `json:"account_id"`
+	CategoryID    *string       `json:"category_id"`
+	TagID         *string       `json:"tag_id"`
+}
+
+type GoalResponse struct {
+	ID            uuid.UUID                    `json:"id"`
+	Name          string                       `json:"name"`
+	TargetAmount  domain.Money                 `json:"target_amount" swaggertype:"number"`
+	InitialAmount domain.Money                 `json:"initial_amount" swaggertype:"number"`
+	TargetDate    string                       `json:"target_date"`
+	AccountID     *uuid.UUID                   `json:"account_id"`
+	Category      *TransactionCategoryResponse `json:"category"`
+	Tag           *TransactionTagResponse      `json:"tag"`
+	CreatedAt     string                       `json:"created_at"`
+	UpdatedAt     string                       `json:"updated_at"`
+}
+
+type GoalProgressResponse struct {
+	Goal          GoalResponse `json:"goal"`
+	Saved         domain.Money `json:"saved" swaggertype:"number"`
+	Remaining     domain.Money `json:"remaining" swaggertype:"number"`
+	Percentage    float64      `json:"percentage"`
+	MonthsLeft    int          `json:"months_left"`
+	MonthlyNeeded domain.Money `json:"monthly_needed" swaggertype:"number"`
+	MonthlyPace   domain.Money `json:"monthly_pace" swaggertype:"number"`
+	ProjectedDate *string      `json:"projected_date"`
+	Achieved      bool         `json:"achieved"`
+	OnTrack       bool         `json:"on_track"`
+}
+
+func (r *GoalRequest) ToDomain() (*domain.Goal, error) {
+	targetDate, err := utils.ToDateTime(r.TargetDate)
+	if err != nil {
+		return nil, appError.InvalidParam("target_date", err)
+	}
+
+	var initialAmount domain.Money
+	if r.InitialAmount != nil {
+		initialAmount = *r.InitialAmount
+	}
+
+	var accountID *uuid.UUID
+	if r.AccountID != nil {
+		accountID, err = utils.ToNillableUUID(*r.AccountID)
+		if err != nil {
+			return nil, appError.InvalidParam("account_id", err)
+		}
+	}
+
+	var categoryID *uuid.UUID
+	if r.CategoryID != nil {
+		categoryID, err = utils.ToNillableUUID(*r.CategoryID)
+		if err != nil {
+			return nil, appError.InvalidParam("category_id", err)
+		}
+	}
+
+	var tagID *uuid.UUID
+	if r.TagID != nil {
+		tagID, err = utils.ToNillableUUID(*r.TagID)
+		if err != nil {
+			return nil, appError.InvalidParam("tag_id", err)
+		}
+	}
+
+	return domain.NewGoal(r.Name, r.TargetAmount, initialAmount, targetDate, accountID, categoryID, tagID)
+}
@@ -0,0 +1,176 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type HoldingRequest struct {
+	Name       string  `json:"name"`
+	Ticker     string  `json:"ticker"`
+	AssetClass string  `json:"asset_class"`
+	Currency   string  `json:"currency"`
+	AccountID  *string `json:"account_id"`
+}
+
+type HoldingResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Ticker     string     `json:"ticker"`
+	AssetClass string     `json:"asset_class"`
+	Currency   string     `json:"currency"`
+	AccountID  *uuid.UUID `json:"account_id"`
+	CreatedAt  string     `json:"created_at"`
+	UpdatedAt  string     `json:"updated_at"`
+}
+
+type InvestmentTradeRequest struct {
+	Kind      string        `json:"kind"`
+	TradeDate string        `json:"trade_date"`
+	Quantity  float64       `json:"quantity"`
+	Price     domain.Money  `json:"price" swaggertype:"number"`
+	Fees      *domain.Money `json:"fees" swaggertype:"number"`
+	Note      *string       `json:"note"`
+}
+
+type InvestmentTradeResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	HoldingID uuid.UUID    `json:"holding_id"`
+	Kind      string       `json:"kind"`
+	TradeDate string       `json:"trade_date"`
+	Quantity  float64      `json:"quantity"`
+	Price     domain.Money `json:"price" swaggertype:"number"`
+	Fees      domain.Money `json:"fees" swaggertype:"number"`
+	Note      *string      `json:"note"`
+	CreatedAt string       `json:"created_at"`
+	UpdatedAt string       `json:"updated_at"`
+}
+
+type InvestmentQuoteRequest struct {
+	QuoteDate string       `json:"quote_date"`
+	Price     domain.Money `json:"price" swaggertype:"number"`
+}
+
+type InvestmentQuoteResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	HoldingID uuid.UUID    `json:"holding_id"`
+	QuoteDate string       `json:"quote_date"`
+	Price     domain.Money `json:"price" swaggertype:"number"`
+	CreatedAt string       `json:"created_at"`
+	UpdatedAt string       `json:"updated_at"`
+}
+
+type InvestmentQuoteImportResponse struct {
+	Imported int `json:"imported"`
+}
+
+type InvestmentIncomeRequest struct {
+	Kind        string       `json:"kind"`
+	PaymentDate string       `json:"payment_date"`
+	Amount      domain.Money `json:"amount" swaggertype:"number"`
+	Note        *string      `json:"note"`
+	CategoryID  *string      `json:"category_id"`
+}
+
+type InvestmentIncomeResponse struct {
+	ID            uuid.UUID    `json:"id"`
+	HoldingID     uuid.UUID    `json:"holding_id"`
+	Kind          string       `json:"kind"`
+	PaymentDate   string       `json:"payment_date"`
+	Amount        domain.Money `json:"amount" swaggertype:"number"`
+	Note          *string      `json:"note"`
+	TransactionID *uuid.UUID   `json:"transaction_id"`
+	CreatedAt     string       `json:"created_at"`
+	UpdatedAt     string       `json:"updated_at"`
+}
+
+// PortfolioHoldingResponse é a posição de um investimento na moeda do investimento. Price é
+// a cotação mais recente ou, sem cotações, o preço da última operação; ValueBase é o valor
+// convertido para a moeda base.
+type PortfolioHoldingResponse struct {
+	Holding        HoldingResponse `json:"holding"`
+	Quantity       float64         `json:"quantity"`
+	AverageCost    domain.Money    `json:"average_cost" swaggertype:"number"`
+	CostBasis      domain.Money    `json:"cost_basis" swaggertype:"number"`
+	Price          domain.Money    `json:"price" swaggertype:"number"`
+	PriceDate      *string         `json:"price_date"`
+	Value          domain.Money    `json:"value" swaggertype:"number"`
+	RealizedGain   domain.Money    `json:"realized_gain" swaggertype:"number"`
+	UnrealizedGain domain.Money    `json:"unrealized_gain" swaggertype:"number"`
+	Income         domain.Money    `json:"income" swaggertype:"number"`
+	ValueBase      domain.Money    `json:"value_base" swaggertype:"number"`
+}
+
+type PortfolioAllocationResponse struct {
+	AssetClass string       `json:"asset_class"`
+	Value      domain.Money `json:"value" swaggertype:"number"`
+	Percentage float64      `json:"percentage"`
+}
+
+// PortfolioResponse resume a carteira na moeda base, convertida pela cotação do dia.
+type PortfolioResponse struct {
+	Currency       string                        `json:"currency"`
+	Value          domain.Money                  `json:"value" swaggertype:"number"`
+	CostBasis      domain.Money                  `json:"cost_basis" swaggertype:"number"`
+	RealizedGain   domain.Money                  `json:"realized_gain" swaggertype:"number"`
+	UnrealizedGain domain.Money                  `json:"unrealized_gain" swaggertype:"number"`
+	Income         domain.Money                  `json:"income" swaggertype:"number"`
+	Holdings       []PortfolioHoldingResponse    `json:"holdings"`
+	Allocation     []PortfolioAllocationResponse `json:"allocation"`
+}
+
+func (r *HoldingRequest) ToDomain() (*domain.Holding, error) {
+	var accountID *uuid.UUID
+	if r.AccountID != nil {
+		var err error
+		accountID, err = utils.ToNillableUUID(*r.AccountID)
+		if err != nil {
+			return nil, appError.InvalidParam("account_id", err)
+		}
+	}
+
+	return domain.NewHolding(r.Name, r.Ticker, domain.InvestmentClass(r.AssetClass), r.Currency, accountID)
+}
+
+func (r *InvestmentTradeRequest) ToDomain(holdingID uuid.UUID) (*domain.InvestmentTrade, error) {
+	tradeDate, err := utils.ToDateTime(r.TradeDate)
+	if err != nil {
+		return nil, appError.InvalidParam("trade_date", err)
+	}
+
+	var fees domain.Money
+	if r.Fees != nil {
+		fees = *r.Fees
+	}
+
+	return domain.NewInvestmentTrade(holdingID, domain.TradeKind(r.Kind), tradeDate, r.Quantity, r.Price, fees, r.Note)
+}
+
+func (r *InvestmentQuoteRequest) ToDomain(holdingID uuid.UUID) (*domain.InvestmentQuote, error) {
+	quoteDate, err := utils.ToDateTime(r.QuoteDate)
+	if err != nil {
+		return nil, appError.InvalidParam("quote_date", err)
+	}
+
+	return domain.NewInvestmentQuote(&holdingID, "", quoteDate, r.Price)
+}
+
+func (r *InvestmentIncomeRequest) ToDomain(holdingID uuid.UUID) (*domain.InvestmentIncome, error) {
+	paymentDate, err := utils.ToDateTime(r.PaymentDate)
+	if err != nil {
+		return nil, appError.InvalidParam("payment_date", err)
+	}
+
+	var categoryID *uuid.UUID
+	if r.CategoryID != nil {
+		categoryID, err = utils.ToNillableUUID(*r.CategoryID)
+		if err != nil {
+			return nil, appError.InvalidParam("category_id", err)
+		}
+	}
+
+	return domain.NewInvestmentIncome(holdingID, domain.IncomeKind(r.Kind), paymentDate, r.Amount, r.Note, categoryID)
+}
@@ -4,6 +4,7 @@ import (
 	"frog-go/internal/core/domain"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/utils"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,25 +12,55 @@ import (
 type InvoiceRequest struct {
 	Title   string `json:"title"`
 	DueDate string `json:"due_date"`
-	Status  string `json:"status"`
+}
+
+type InvoicePayRequest struct {
+	PaidAt    string  `json:"paid_at"`
+	AccountID *string `json:"account_id"`
+}
+
+type InvoiceCloseRequest struct {
+	Rollover      bool    `json:"rollover"`
+	NextInvoiceID *string `json:"next_invoice_id"`
+}
+
+type InvoicePaymentRequest struct {
+	Amount    domain.Money `json:"amount" swaggertype:"number"`
+	PaidAt    string       `json:"paid_at"`
+	AccountID *string      `json:"account_id"`
 }
 
 type InvoiceFilters struct {
-	MinAmount *float64  `form:"min_amount"`
-	MaxAmount *float64  `form:"max_amount"`
-	StartDate *string   `form:"start_date"`
-	EndDate   *string   `form:"end_date"`
-	Statuses  *[]string `form:"statuses"`
+	MinAmount *domain.Money `form:"min_amount"`
+	MaxAmount *domain.Money `form:"max_amount"`
+	StartDate *string       `form:"start_date"`
+	EndDate   *string       `form:"end_date"`
+	Statuses  *[]string     `form:"statuses"`
 }
 
 type InvoiceResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Title     string    `json:"title"`
-	Amount    float64   `json:"amount"`
-	DueDate   string    `json:"due_date"`
-	Status    string    `json:"status"`
-	CreatedAt string    `json:"created_at"`
-	UpdatedAt string    `json:"updated_at"`
+	ID                uuid.UUID    `json:"id"`
+	Title             string       `json:"title"`
+	Amount            domain.Money `json:"amount" swaggertype:"number"`
+	PaidAmount        domain.Money `json:"paid_amount" swaggertype:"number"`
+	OutstandingAmount domain.Money `json:"outstanding_amount" swaggertype:"number"`
+	DueDate           string       `json:"due_date"`
+	Status            string       `json:"status"`
+	ClosedAt          *string      `json:"closed_at"`
+	PaidAt            *string      `json:"paid_at"`
+	PaymentAccountID  *uuid.UUID   `json:"payment_account_id"`
+	CreatedByID       *uuid.UUID   `json:"created_by_id"`
+	CreatedAt         string       `json:"created_at"`
+	UpdatedAt         string       `json:"updated_at"`
+}
+
+type InvoicePaymentResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	Amount    domain.Money `json:"amount" swaggertype:"number"`
+	PaidAt    string       `json:"paid_at"`
+	Kind      string       `json:"kind"`
+	AccountID *uuid.UUID   `json:"account_id"`
+	CreatedAt string       `json:"created_at"`
 }
 
 func (r *InvoiceRequest) ToDomain() (*domain.Invoice, error) {
@@ -39,11 +70,59 @@ func (r *InvoiceRequest) ToDomain() (*domain.Invoice, error) {
 		return nil, appError.InvalidParam("due_date", err)
 	}
 
-	status := domain.TxnStatus(r.Status)
-
 	return domain.NewInvoice(
 		r.Title,
 		dueDate,
-		&status,
 	)
 }
+
+func (r *InvoicePayRequest) ToDomain() (*domain.InvoicePayment, error) {
+	paidAt, accountID, err := parsePaymentSource(r.PaidAt, r.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewInvoiceSettlement(paidAt, accountID), nil
+}
+
+func (r *InvoicePaymentRequest) ToDomain() (*domain.InvoicePayment, error) {
+	paidAt, accountID, err := parsePaymentSource(r.PaidAt, r.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewInvoicePayment(r.Amount, paidAt, accountID)
+}
+
+func (r *InvoiceCloseRequest) ToDomain() (*domain.InvoiceClosing, error) {
+	var nextInvoiceID *uuid.UUID
+	if r.NextInvoiceID != nil {
+		id, err := utils.ToNillableUUID(*r.NextInvoiceID)
+		if err != nil {
+			return nil, appError.InvalidParam("next_invoice_id", err)
+		}
+		nextInvoiceID = id
+	}
+
+	return &domain.InvoiceClosing{
+		Rollover:      r.Rollover,
+		NextInvoiceID: nextInvoiceID,
+	}, nil
+}
+
+func parsePaymentSource(paidAtStr string, accountIDStr *string) (*time.Time, *uuid.UUID, error) {
+	paidAt, err := utils.ToNillableDateTime(paidAtStr)
+	if err != nil {
+		return nil, nil, appError.InvalidParam("paid_at", err)
+	}
+
+	var accountID *uuid.UUID
+	if accountIDStr != nil {
+		accountID, err = utils.ToNillableUUID(*accountIDStr)
+		if err != nil {
+			return nil, nil, appError.InvalidParam("account_id", err)
+		}
+	}
+
+	return paidAt, accountID, nil
+}
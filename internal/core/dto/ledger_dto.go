@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
+type LedgerRequest struct {
+	Name string `json:"name"`
+}
+
+type LedgerMemberRequest struct {
+	Role string `json:"role"`
+}
+
+type LedgerInvitationRequest struct {
+	Username string  `json:"username"`
+	Role     *string `json:"role"`
+}
+
+type LedgerUserResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Name     string    `json:"name"`
+}
+
+type LedgerResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	Name      string             `json:"name"`
+	Owner     LedgerUserResponse `json:"owner"`
+	Role      string             `json:"role"`
+	Personal  bool               `json:"personal"`
+	CreatedAt string             `json:"created_at"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+type LedgerMemberResponse struct {
+	User      LedgerUserResponse `json:"user"`
+	Role      string             `json:"role"`
+	CreatedAt string             `json:"created_at"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+type LedgerInvitationResponse struct {
+	ID          uuid.UUID          `json:"id"`
+	LedgerID    uuid.UUID          `json:"ledger_id"`
+	LedgerName  string             `json:"ledger_name"`
+	Invitee     LedgerUserResponse `json:"invitee"`
+	InvitedBy   LedgerUserResponse `json:"invited_by"`
+	Role        string             `json:"role"`
+	Status      string             `json:"status"`
+	ExpiresAt   string             `json:"expires_at"`
+	RespondedAt *string            `json:"responded_at"`
+	CreatedAt   string             `json:"created_at"`
+}
+
+func (r *LedgerRequest) ToDomain() (*domain.Ledger, error) {
+	return domain.NewLedger(r.Name)
+}
+
+func (r *LedgerMemberRequest) ToDomain() (domain.LedgerRole, error) {
+	return domain.NewLedgerRole(r.Role)
+}
+
+func (r *LedgerInvitationRequest) ToDomain() (*domain.LedgerInvitation, error) {
+	var role *domain.LedgerRole
+	if r.Role != nil {
+		value := domain.LedgerRole(*r.Role)
+		role = &value
+	}
+
+	return domain.NewLedgerInvitation(r.Username, role)
+}
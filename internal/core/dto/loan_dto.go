@@ -0,0 +1,199 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoanRequest struct {
+	Name                string       `json:"name"`
+	Principal           domain.Money `json:"principal" swaggertype:"number"`
+	InterestRate        float64      `json:"interest_rate"`
+	RatePeriod          string       `json:"rate_period"`
+	TermMonths          int          `json:"term_months"`
+	System              string       `json:"system"`
+	FirstPaymentDate    string       `json:"first_payment_date"`
+	Currency            string       `json:"currency"`
+	AccountID           *string      `json:"account_id"`
+	InterestCategoryID  *string      `json:"interest_category_id"`
+	PrincipalCategoryID *string      `json:"principal_category_id"`
+}
+
+// LoanUpdateRequest altera apenas os dados descritivos do empréstimo; as condições
+// financeiras definem o cronograma e não mudam depois da criação.
+type LoanUpdateRequest struct {
+	Name      string  `json:"name"`
+	AccountID *string `json:"account_id"`
+}
+
+type LoanResponse struct {
+	ID                  uuid.UUID    `json:"id"`
+	Name                string       `json:"name"`
+	Principal           domain.Money `json:"principal" swaggertype:"number"`
+	InterestRate        float64      `json:"interest_rate"`
+	RatePeriod          string       `json:"rate_period"`
+	TermMonths          int          `json:"term_months"`
+	System              string       `json:"system"`
+	FirstPaymentDate    string       `json:"first_payment_date"`
+	Currency            string       `json:"currency"`
+	AccountID           *uuid.UUID   `json:"account_id"`
+	InterestCategoryID  *uuid.UUID   `json:"interest_category_id"`
+	PrincipalCategoryID *uuid.UUID   `json:"principal_category_id"`
+	CreatedAt           string       `json:"created_at"`
+	UpdatedAt           string       `json:"updated_at"`
+}
+
+type LoanInstallmentResponse struct {
+	Number        int          `json:"number"`
+	DueDate       string       `json:"due_date"`
+	Payment       domain.Money `json:"payment" swaggertype:"number"`
+	Interest      domain.Money `json:"interest" swaggertype:"number"`
+	Principal     domain.Money `json:"principal" swaggertype:"number"`
+	Balance       domain.Money `json:"balance" swaggertype:"number"`
+	TransactionID *uuid.UUID   `json:"transaction_id,omitempty"`
+	Status        *string      `json:"status,omitempty"`
+}
+
+// LoanScheduleResponse é o cronograma atual do empréstimo. Parcelas pagas são as que têm a
+// transação vinculada marcada como paga; Balance é o saldo devedor depois delas e das
+// amortizações extras.
+type LoanScheduleResponse struct {
+	Loan                  LoanResponse              `json:"loan"`
+	Balance               domain.Money              `json:"balance" swaggertype:"number"`
+	TotalInterest         domain.Money              `json:"total_interest" swaggertype:"number"`
+	InterestPaid          domain.Money              `json:"interest_paid" swaggertype:"number"`
+	InterestSaved         domain.Money              `json:"interest_saved" swaggertype:"number"`
+	PaidInstallments      int                       `json:"paid_installments"`
+	RemainingInstallments int                       `json:"remaining_installments"`
+	Installments          []LoanInstallmentResponse `json:"installments"`
+}
+
+// LoanSimulationResponse é o cronograma calculado sem gravar o empréstimo.
+type LoanSimulationResponse struct {
+	TotalPayment  domain.Money              `json:"total_payment" swaggertype:"number"`
+	TotalInterest domain.Money              `json:"total_interest" swaggertype:"number"`
+	Installments  []LoanInstallmentResponse `json:"installments"`
+}
+
+type LoanPrepaymentRequest struct {
+	PaymentDate string       `json:"payment_date"`
+	Amount      domain.Money `json:"amount" swaggertype:"number"`
+	Mode        string       `json:"mode"`
+}
+
+type LoanPrepaymentResponse struct {
+	ID                    uuid.UUID    `json:"id"`
+	LoanID                uuid.UUID    `json:"loan_id"`
+	PaymentDate           string       `json:"payment_date"`
+	Amount                domain.Money `json:"amount" swaggertype:"number"`
+	Mode                  string       `json:"mode"`
+	InterestSaved         domain.Money `json:"interest_saved" swaggertype:"number"`
+	TransactionID         *uuid.UUID   `json:"transaction_id"`
+	RemainingInstallments *int         `json:"remaining_installments,omitempty"`
+	CreatedAt             string       `json:"created_at"`
+	UpdatedAt             string       `json:"updated_at"`
+}
+
+func (r *LoanRequest) ToDomain() (*domain.Loan, error) {
+	firstPaymentDate, err := utils.ToDateTime(r.FirstPaymentDate)
+	if err != nil {
+		return nil, appError.InvalidParam("first_payment_date", err)
+	}
+
+	loan, err := domain.NewLoan(
+		r.Name,
+		r.Principal,
+		r.InterestRate,
+		domain.RatePeriod(r.RatePeriod),
+		r.TermMonths,
+		domain.LoanSystem(r.System),
+		firstPaymentDate,
+		r.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.AccountID != nil {
+		loan.AccountID, err = utils.ToNillableUUID(*r.AccountID)
+		if err != nil {
+			return nil, appError.InvalidParam("account_id", err)
+		}
+	}
+
+	if r.InterestCategoryID != nil {
+		loan.InterestCategoryID, err = utils.ToNillableUUID(*r.InterestCategoryID)
+		if err != nil {
+			return nil, appError.InvalidParam("interest_category_id", err)
+		}
+	}
+
+	if r.PrincipalCategoryID != nil {
+		loan.PrincipalCategoryID, err = utils.ToNillableUUID(*r.PrincipalCategoryID)
+		if err != nil {
+			return nil, appError.InvalidParam("principal_category_id", err)
+		}
+	}
+
+	return loan, nil
+}
+
+func (r *LoanUpdateRequest) ToDomain() (*domain.Loan, error) {
+	if r.Name == "" {
+		return nil, appError.EmptyField("name")
+	}
+
+	var accountID *uuid.UUID
+	if r.AccountID != nil {
+		var err error
+		accountID, err = utils.ToNillableUUID(*r.AccountID)
+		if err != nil {
+			return nil, appError.InvalidParam("account_id", err)
+		}
+	}
+
+	return &domain.Loan{Name: r.Name, AccountID: accountID}, nil
+}
+
+func (r *LoanPrepaymentRequest) ToDomain(loanID uuid.UUID) (*domain.LoanPrepayment, error) {
+	paymentDate, err := utils.ToDateTime(r.PaymentDate)
+	if err != nil {
+		return nil, appError.InvalidParam("payment_date", err)
+	}
+
+	prepayment, err := domain.NewLoanPrepayment(paymentDate, r.Amount, domain.PrepaymentMode(r.Mode))
+	if err != nil {
+		return nil, err
+	}
+	prepayment.LoanID = loanID
+
+	return prepayment, nil
+}
+
+func NewLoanSimulationResponse(installments []domain.LoanInstallment) *LoanSimulationResponse {
+	response := &LoanSimulationResponse{
+		Installments: make([]LoanInstallmentResponse, 0, len(installments)),
+	}
+
+	for _, installment := range installments {
+		response.TotalPayment += installment.Payment
+		response.TotalInterest += installment.Interest
+		response.Installments = append(response.Installments, NewLoanInstallmentResponse(installment))
+	}
+	return response
+}
+
+func NewLoanInstallmentResponse(installment domain.LoanInstallment) LoanInstallmentResponse {
+	return LoanInstallmentResponse{
+		Number:    installment.Number,
+		DueDate:   installment.DueDate.Format(time.DateOnly),
+		Payment:   installment.Payment,
+		Interest:  installment.Interest,
+		Principal: installment.Principal,
+		Balance:   installment.Balance,
+	}
+}
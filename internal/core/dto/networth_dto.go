@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type AssetRequest struct {
+	Name     string `json:"name"`
+	Class    string `json:"class"`
+	Kind     string `json:"kind"`
+	Currency string `json:"currency"`
+}
+
+type AssetResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Class     string    `json:"class"`
+	Kind      string    `json:"kind"`
+	Currency  string    `json:"currency"`
+	CreatedAt string    `json:"created_at"`
+	UpdatedAt string    `json:"updated_at"`
+}
+
+type ValuationRequest struct {
+	ValueDate string       `json:"value_date"`
+	Amount    domain.Money `json:"amount" swaggertype:"number"`
+	Note      *string      `json:"note"`
+}
+
+type ValuationResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	AssetID   *uuid.UUID   `json:"asset_id"`
+	AccountID *uuid.UUID   `json:"account_id"`
+	ValueDate string       `json:"value_date"`
+	Amount    domain.Money `json:"amount" swaggertype:"number"`
+	Note      *string      `json:"note"`
+	CreatedAt string       `json:"created_at"`
+	UpdatedAt string       `json:"updated_at"`
+}
+
+type NetWorthFilters struct {
+	Period    string `form:"period"`
+	StartDate string `form:"start_date"`
+	EndDate   string `form:"end_date"`
+}
+
+// NetWorthByDate é o patrimônio no fim do período, na moeda base. Liabilities vem positivo
+// e é descontado de NetWorth.
+type NetWorthByDate struct {
+	Date        string       `json:"date"`
+	Accounts    domain.Money `json:"accounts" swaggertype:"number"`
+	Assets      domain.Money `json:"assets" swaggertype:"number"`
+	Liabilities domain.Money `json:"liabilities" swaggertype:"number"`
+	NetWorth    domain.Money `json:"net_worth" swaggertype:"number"`
+}
+
+func (r *AssetRequest) ToDomain() (*domain.Asset, error) {
+	return domain.NewAsset(r.Name, domain.AssetClass(r.Class), domain.AssetKind(r.Kind), r.Currency)
+}
+
+func (r *ValuationRequest) ToDomain(target domain.ValuationTarget) (*domain.Valuation, error) {
+	valueDate, err := utils.ToDateTime(r.ValueDate)
+	if err != nil {
+		return nil, appError.InvalidParam("value_date", err)
+	}
+
+	return domain.NewValuation(target, valueDate, r.Amount, r.Note)
+}
@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceRequest substitui as preferências de avisos. Sem lead_days vale a
+// antecedência padrão e sem inapp_enabled a caixa de entrada do app continua ativa.
+type NotificationPreferenceRequest struct {
+	LeadDays      *int    `json:"lead_days"`
+	QuietStart    *string `json:"quiet_start" example:"22:00"`
+	QuietEnd      *string `json:"quiet_end" example:"07:00"`
+	Timezone      string  `json:"timezone" example:"America/Sao_Paulo"`
+	EmailEnabled  bool    `json:"email_enabled"`
+	WebhookURL    *string `json:"webhook_url"`
+	WebhookSecret *string `json:"webhook_secret"`
+	InAppEnabled  *bool   `json:"inapp_enabled"`
+}
+
+// NotificationPreferenceResponse não devolve o segredo do webhook, só indica se há um.
+type NotificationPreferenceResponse struct {
+	LeadDays         int      `json:"lead_days"`
+	QuietStart       *string  `json:"quiet_start"`
+	QuietEnd         *string  `json:"quiet_end"`
+	Timezone         string   `json:"timezone"`
+	EmailEnabled     bool     `json:"email_enabled"`
+	WebhookURL       *string  `json:"webhook_url"`
+	HasWebhookSecret bool     `json:"has_webhook_secret"`
+	InAppEnabled     bool     `json:"inapp_enabled"`
+	Channels         []string `json:"channels"`
+}
+
+type NotificationFilters struct {
+	Unread *bool   `form:"unread"`
+	Type   *string `form:"type"`
+}
+
+type NotificationResponse struct {
+	ID        uuid.UUID      `json:"id"`
+	Type      string         `json:"type"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Payload   map[string]any `json:"payload"`
+	Read      bool           `json:"read"`
+	ReadAt    *string        `json:"read_at"`
+	CreatedAt string         `json:"created_at"`
+}
+
+type NotificationUnreadCountResponse struct {
+	Unread int `json:"unread"`
+}
+
+type NotificationReadAllResponse struct {
+	Updated int `json:"updated"`
+}
+
+func (r *NotificationPreferenceRequest) ToDomain() (*domain.NotificationPreference, error) {
+	leadDays := domain.NotificationDefaultLeadDays
+	if r.LeadDays != nil {
+		leadDays = *r.LeadDays
+	}
+
+	inAppEnabled := true
+	if r.InAppEnabled != nil {
+		inAppEnabled = *r.InAppEnabled
+	}
+
+	return domain.NewNotificationPreference(
+		leadDays,
+		nilIfBlank(r.QuietStart),
+		nilIfBlank(r.QuietEnd),
+		strings.TrimSpace(r.Timezone),
+		r.EmailEnabled,
+		nilIfBlank(r.WebhookURL),
+		nilIfBlank(r.WebhookSecret),
+		inAppEnabled,
+	)
+}
+
+func NewNotificationPreferenceResponse(preference domain.NotificationPreference) *NotificationPreferenceResponse {
+	channels := []string{}
+	for _, channel := range preference.Channels() {
+		channels = append(channels, string(channel))
+	}
+
+	return &NotificationPreferenceResponse{
+		LeadDays:         preference.LeadDays,
+		QuietStart:       preference.QuietStart,
+		QuietEnd:         preference.QuietEnd,
+		Timezone:         preference.Timezone,
+		EmailEnabled:     preference.EmailEnabled,
+		WebhookURL:       preference.WebhookURL,
+		HasWebhookSecret: preference.WebhookSecret != nil,
+		InAppEnabled:     preference.InAppEnabled,
+		Channels:         channels,
+	}
+}
+
+// nilIfBlank trata texto vazio como ausente.
+func nilIfBlank(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
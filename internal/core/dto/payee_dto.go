@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+
+	"github.com/google/uuid"
+)
+
+// PayeeRequest traz o CPF ou CNPJ do favorecido em document, com ou sem máscara.
+type PayeeRequest struct {
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+	Document *string  `json:"document"`
+}
+
+// PayeeFilters limita o período considerado nos totais de cada favorecido.
+type PayeeFilters struct {
+	StartDate *string `form:"start_date"`
+	EndDate   *string `form:"end_date"`
+}
+
+type PayeeResponse struct {
+	ID             uuid.UUID    `json:"id"`
+	Name           string       `json:"name"`
+	NormalizedName string       `json:"normalized_name"`
+	Aliases        []string     `json:"aliases"`
+	Document       *string      `json:"document"`
+	Transactions   int          `json:"transactions"`
+	Income         domain.Money `json:"income" swaggertype:"number"`
+	Expense        domain.Money `json:"expense" swaggertype:"number"`
+	LastRecordDate *string      `json:"last_record_date"`
+	CreatedAt      string       `json:"created_at"`
+	UpdatedAt      string       `json:"updated_at"`
+}
+
+func (r *PayeeRequest) ToDomain() (*domain.Payee, error) {
+	payee, err := domain.NewPayee(r.Name, r.Aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Document != nil && *r.Document != "" {
+		document, err := domain.NormalizeTaxDocument(*r.Document)
+		if err != nil {
+			return nil, appError.InvalidParam("document", err)
+		}
+		payee.Document = &document
+	}
+
+	return payee, nil
+}
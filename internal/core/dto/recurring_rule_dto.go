@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurringRuleRequest struct {
+	Title      string       `json:"title"`
+	Amount     domain.Money `json:"amount" swaggertype:"number"`
+	Currency   string       `json:"currency"`
+	RecordType string       `json:"record_type"`
+	Frequency  string       `json:"frequency"`
+	StartDate  string       `json:"start_date"`
+	EndDate    *string      `json:"end_date"`
+	AccountID  *string      `json:"account_id"`
+	CategoryID *string      `json:"category_id"`
+}
+
+type RecurringRuleResponse struct {
+	ID         uuid.UUID                    `json:"id"`
+	Title      string                       `json:"title"`
+	Amount     domain.Money                 `json:"amount" swaggertype:"number"`
+	Currency   string                       `json:"currency"`
+	RecordType string                       `json:"record_type"`
+	Frequency  string                       `json:"frequency"`
+	StartDate  string                       `json:"start_date"`
+	EndDate    *string                      `json:"end_date"`
+	AccountID  *uuid.UUID                   `json:"account_id"`
+	Category   *TransactionCategoryResponse `json:"category"`
+	CreatedAt  string                       `json:"created_at"`
+	UpdatedAt  string                       `json:"updated_at"`
+}
+
+func (r *RecurringRuleRequest) ToDomain() (*domain.RecurringRule, error) {
+	startDate, err := utils.ToDateTime(r.StartDate)
+	if err != nil {
+		return nil, appError.InvalidParam("start_date", err)
+	}
+
+	var endDate *time.Time
+	if r.EndDate != nil {
+		endDate, err = utils.ToNillableDateTime(*r.EndDate)
+		if err != nil {
+			return nil, appError.InvalidParam("end_date", err)
+		}
+	}
+
+	var accountID *uuid.UUID
+	if r.AccountID != nil {
+		accountID, err = utils.ToNillableUUID(*r.AccountID)
+		if err != nil {
+			return nil, appError.InvalidParam("account_id", err)
+		}
+	}
+
+	var categoryID *uuid.UUID
+	if r.CategoryID != nil {
+		categoryID, err = utils.ToNillableUUID(*r.CategoryID)
+		if err != nil {
+			return nil, appError.InvalidParam("category_id", err)
+		}
+	}
+
+	rule, err := domain.NewRecurringRule(
+		r.Title,
+		r.Amount,
+		domain.RecordType(r.RecordType),
+		domain.RecurrenceFrequency(r.Frequency),
+		startDate,
+		endDate,
+		accountID,
+		categoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// currency ausente assume a moeda base do livro
+	if r.Currency != "" {
+		rule.Currency, err = domain.NormalizeCurrency(r.Currency)
+		if err != nil {
+			return nil, appError.InvalidParam("currency", err)
+		}
+	}
+
+	return rule, nil
+}
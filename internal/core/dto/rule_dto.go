@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type RuleConditionsRequest struct {
+	TitleContains *string       `json:"title_contains"`
+	TitleRegex    *string       `json:"title_regex"`
+	AmountMin     *domain.Money `json:"amount_min" swaggertype:"number"`
+	AmountMax     *domain.Money `json:"amount_max" swaggertype:"number"`
+	RecordType    *string       `json:"record_type"`
+	InvoiceID     *string       `json:"invoice_id"`
+}
+
+type RuleActionsRequest struct {
+	CategoryID *string `json:"category_id"`
+	RecordType *string `json:"record_type"`
+	Status     *string `json:"status"`
+	Skip       bool    `json:"skip"`
+}
+
+type RuleRequest struct {
+	Name       string                `json:"name"`
+	Priority   int                   `json:"priority"`
+	Enabled    *bool                 `json:"enabled"`
+	Conditions RuleConditionsRequest `json:"conditions"`
+	Actions    RuleActionsRequest    `json:"actions"`
+}
+
+type RuleConditionsResponse struct {
+	TitleContains *string       `json:"title_contains"`
+	TitleRegex    *string       `json:"title_regex"`
+	AmountMin     *domain.Money `json:"amount_min" swaggertype:"number"`
+	AmountMax     *domain.Money `json:"amount_max" swaggertype:"number"`
+	RecordType    *string       `json:"record_type"`
+	InvoiceID     *uuid.UUID    `json:"invoice_id"`
+}
+
+type RuleActionsResponse struct {
+	CategoryID *uuid.UUID `json:"category_id"`
+	RecordType *string    `json:"record_type"`
+	Status     *string    `json:"status"`
+	Skip       bool       `json:"skip"`
+}
+
+type RuleResponse struct {
+	ID         uuid.UUID              `json:"id"`
+	Name       string                 `json:"name"`
+	Priority   int                    `json:"priority"`
+	Enabled    bool                   `json:"enabled"`
+	Conditions RuleConditionsResponse `json:"conditions"`
+	Actions    RuleActionsResponse    `json:"actions"`
+	CreatedAt  string                 `json:"created_at"`
+	UpdatedAt  string                 `json:"updated_at"`
+}
+
+func (r *RuleRequest) ToDomain() (*domain.Rule, error) {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+
+	invoiceID, err := toNillableUUIDParam("conditions.invoice_id", r.Conditions.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, err := toNillableUUIDParam("actions.category_id", r.Actions.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := domain.RuleConditions{
+		TitleContains: emptyToNil(r.Conditions.TitleContains),
+		TitleRegex:    emptyToNil(r.Conditions.TitleRegex),
+		AmountMin:     r.Conditions.AmountMin,
+		AmountMax:     r.Conditions.AmountMax,
+		InvoiceID:     invoiceID,
+	}
+	if value := emptyToNil(r.Conditions.RecordType); value != nil {
+		recordType := domain.RecordType(*value)
+		conditions.RecordType = &recordType
+	}
+
+	actions := domain.RuleActions{
+		CategoryID: categoryID,
+		Skip:       r.Actions.Skip,
+	}
+	if value := emptyToNil(r.Actions.RecordType); value != nil {
+		recordType := domain.RecordType(*value)
+		actions.RecordType = &recordType
+	}
+	if value := emptyToNil(r.Actions.Status); value != nil {
+		status := domain.TxnStatus(*value)
+		actions.Status = &status
+	}
+
+	return domain.NewRule(r.Name, r.Priority, enabled, conditions, actions)
+}
+
+func toNillableUUIDParam(param string, value *string) (*uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	id, err := utils.ToNillableUUID(*value)
+	if err != nil {
+		return nil, appError.InvalidParam(param, err)
+	}
+	return id, nil
+}
+
+func emptyToNil(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
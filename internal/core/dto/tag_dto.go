@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
+type TagRequest struct {
+	Name  string  `json:"name"`
+	Color *string `json:"color"`
+}
+
+type TagResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Color     *string   `json:"color"`
+	CreatedAt string    `json:"created_at"`
+	UpdatedAt string    `json:"updated_at"`
+}
+
+func (r *TagRequest) ToDomain() (*domain.Tag, error) {
+	return domain.NewTag(r.Name, r.Color)
+}
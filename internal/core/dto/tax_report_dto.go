@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"frog-go/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
+type TaxReportFilters struct {
+	Year   int    `form:"year"`
+	Format string `form:"format"`
+}
+
+type TaxCategoryTotalResponse struct {
+	CategoryID uuid.UUID    `json:"category_id"`
+	Name       string       `json:"name"`
+	Total      domain.Money `json:"total" swaggertype:"number"`
+}
+
+// TaxGroupTotalResponse soma as despesas dedutíveis de um grupo e mostra as categorias que
+// entraram nele.
+type TaxGroupTotalResponse struct {
+	Group      string                     `json:"group"`
+	Label      string                     `json:"label"`
+	Total      domain.Money               `json:"total" swaggertype:"number"`
+	Categories []TaxCategoryTotalResponse `json:"categories"`
+}
+
+// TaxIncomeSourceResponse são os rendimentos recebidos de uma fonte pagadora e o imposto
+// retido por ela. Document é o CPF ou CNPJ formatado, quando cadastrado no favorecido.
+type TaxIncomeSourceResponse struct {
+	PayeeID       *uuid.UUID   `json:"payee_id"`
+	Name          *string      `json:"name"`
+	Document      *string      `json:"document"`
+	TaxableIncome domain.Money `json:"taxable_income" swaggertype:"number"`
+	ExemptIncome  domain.Money `json:"exempt_income" swaggertype:"number"`
+	WithheldTax   domain.Money `json:"withheld_tax" swaggertype:"number"`
+}
+
+// TaxPaymentResponse é o total pago a um favorecido em um grupo dedutível, como na ficha de
+// pagamentos efetuados.
+type TaxPaymentResponse struct {
+	Group    string       `json:"group"`
+	Label    string       `json:"label"`
+	PayeeID  *uuid.UUID   `json:"payee_id"`
+	Name     *string      `json:"name"`
+	Document *string      `json:"document"`
+	Total    domain.Money `json:"total" swaggertype:"number"`
+}
+
+// TaxReportResponse resume o ano na moeda base, considerando os lançamentos pagos. Receitas
+// em categorias sem grupo fiscal contam como tributáveis e o imposto retido vem dos lançamentos
+// do tipo tax.
+type TaxReportResponse struct {
+	Year            int                       `json:"year"`
+	Currency        string                    `json:"currency"`
+	TaxableIncome   domain.Money              `json:"taxable_income" swaggertype:"number"`
+	ExemptIncome    domain.Money              `json:"exempt_income" swaggertype:"number"`
+	WithheldTax     domain.Money              `json:"withheld_tax" swaggertype:"number"`
+	DeductibleTotal domain.Money              `json:"deductible_total" swaggertype:"number"`
+	Deductions      []TaxGroupTotalResponse   `json:"deductions"`
+	Sources         []TaxIncomeSourceResponse `json:"sources"`
+	Payments        []TaxPaymentResponse      `json:"payments"`
+}
+
+// ExportFile é um arquivo gerado para download.
+type ExportFile struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
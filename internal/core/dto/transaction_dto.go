@@ -4,42 +4,75 @@ import (
 	"frog-go/internal/core/domain"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/utils"
+	"slices"
 
 	"github.com/google/uuid"
 )
 
 type TransactionRequest struct {
-	Title      string  `json:"title"`
-	Amount     float64 `json:"amount"`
-	RecordDate string  `json:"record_date"`
-	CategoryID *string `json:"category_id"`
-	InvoiceID  *string `json:"invoice_id"`
-	Status     string  `json:"status" validate:"required,oneof=pending paid canceled"`
-	RecordType string  `json:"record_type" validate:"required,oneof=income expense"`
+	Title             string                     `json:"title"`
+	Amount            domain.Money               `json:"amount" swaggertype:"number"`
+	Currency          string                     `json:"currency"`
+	RecordDate        string                     `json:"record_date"`
+	CategoryID        *string                    `json:"category_id"`
+	InvoiceID         *string                    `json:"invoice_id"`
+	TagIDs            *[]string                  `json:"tag_ids"`
+	Splits            *[]TransactionSplitRequest `json:"splits"`
+	Status            string                     `json:"status" validate:"required,oneof=pending paid canceled"`
+	RecordType        string                     `json:"record_type" validate:"required,oneof=income expense"`
+	RecurringRuleID   *string                    `json:"recurring_rule_id"`
+	TransferAccountID *string                    `json:"transfer_account_id"`
+	InstallmentNumber *int                       `json:"installment_number"`
+	InstallmentCount  *int                       `json:"installment_count"`
 }
 
+type TransactionSplitRequest struct {
+	Amount     domain.Money `json:"amount" swaggertype:"number"`
+	CategoryID *string      `json:"category_id"`
+	Note       *string      `json:"note"`
+}
+
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
 // TODO: fazer um bind que funcione com uuid.UUID o ShouldBindQuery n esta reconhecendo o *[]uuid.UUID
 type TransactionFilters struct {
-	InvoiceIDs  *[]string `form:"invoice_ids"`
-	CategoryIDs *[]string `form:"category_ids"`
-	Statuses    *[]string `form:"statuses"`
-	RecordTypes *[]string `form:"record_types"`
-	MinAmount   *float64  `form:"min_amount"`
-	MaxAmount   *float64  `form:"max_amount"`
-	StartDate   *string   `form:"start_date"`
-	EndDate     *string   `form:"end_date"`
+	InvoiceIDs  *[]string     `form:"invoice_ids"`
+	CategoryIDs *[]string     `form:"category_ids"`
+	PayeeIDs    *[]string     `form:"payee_ids"`
+	TagIDs      *[]string     `form:"tag_ids"`
+	TagMatch    *string       `form:"tag_match" binding:"omitempty,oneof=any all"`
+	Statuses    *[]string     `form:"statuses"`
+	RecordTypes *[]string     `form:"record_types"`
+	MinAmount   *domain.Money `form:"min_amount"`
+	MaxAmount   *domain.Money `form:"max_amount"`
+	StartDate   *string       `form:"start_date"`
+	EndDate     *string       `form:"end_date"`
 }
 type TransactionResponse struct {
-	ID         uuid.UUID                    `json:"id"`
-	Title      string                       `json:"title"`
-	Amount     float64                      `json:"amount"`
-	RecordDate string                       `json:"record_date"`
-	Category   *TransactionCategoryResponse `json:"category"`
-	Invoice    *TransactionInvoiceResponse  `json:"invoice"`
-	RecordType string                       `json:"record_type"`
-	Status     string                       `json:"status"`
-	CreatedAt  string                       `json:"created_at"`
-	UpdatedAt  string                       `json:"updated_at"`
+	ID                uuid.UUID                    `json:"id"`
+	Title             string                       `json:"title"`
+	Amount            domain.Money                 `json:"amount" swaggertype:"number"`
+	Currency          string                       `json:"currency"`
+	ExchangeRate      float64                      `json:"exchange_rate"`
+	BaseAmount        domain.Money                 `json:"base_amount" swaggertype:"number"`
+	RecordDate        string                       `json:"record_date"`
+	Category          *TransactionCategoryResponse `json:"category"`
+	Payee             *TransactionPayeeResponse    `json:"payee"`
+	Tags              []TransactionTagResponse     `json:"tags"`
+	Splits            []TransactionSplitResponse   `json:"splits"`
+	Invoice           *TransactionInvoiceResponse  `json:"invoice"`
+	RecordType        string                       `json:"record_type"`
+	Status            string                       `json:"status"`
+	RecurringRuleID   *uuid.UUID                   `json:"recurring_rule_id"`
+	TransferAccountID *uuid.UUID                   `json:"transfer_account_id"`
+	InstallmentNumber *int                         `json:"installment_number"`
+	InstallmentCount  *int                         `json:"installment_count"`
+	CreatedByID       *uuid.UUID                   `json:"created_by_id"`
+	CreatedAt         string                       `json:"created_at"`
+	UpdatedAt         string                       `json:"updated_at"`
 }
 
 type TransactionInvoiceResponse struct {
@@ -52,6 +85,24 @@ type TransactionCategoryResponse struct {
 	Name string    `json:"name"`
 }
 
+type TransactionPayeeResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type TransactionTagResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Color *string   `json:"color"`
+}
+
+type TransactionSplitResponse struct {
+	ID       uuid.UUID                    `json:"id"`
+	Amount   domain.Money                 `json:"amount" swaggertype:"number"`
+	Note     *string                      `json:"note"`
+	Category *TransactionCategoryResponse `json:"category"`
+}
+
 func (r *TransactionRequest) ToDomain() (*domain.Transaction, error) {
 	RecordDate, err := utils.ToDateTime(r.RecordDate)
 	if err != nil {
@@ -77,7 +128,7 @@ func (r *TransactionRequest) ToDomain() (*domain.Transaction, error) {
 	status := domain.TxnStatus(r.Status)
 	recordType := domain.RecordType(r.RecordType)
 
-	return domain.NewTransaction(
+	txn, err := domain.NewTransaction(
 		r.Title,
 		r.Amount,
 		RecordDate,
@@ -86,4 +137,71 @@ func (r *TransactionRequest) ToDomain() (*domain.Transaction, error) {
 		&status,
 		&recordType,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.RecurringRuleID != nil {
+		txn.RecurringRuleID, err = utils.ToNillableUUID(*r.RecurringRuleID)
+		if err != nil {
+			return nil, appError.InvalidParam("recurring_rule_id", err)
+		}
+	}
+
+	if r.TransferAccountID != nil {
+		txn.TransferAccountID, err = utils.ToNillableUUID(*r.TransferAccountID)
+		if err != nil {
+			return nil, appError.InvalidParam("transfer_account_id", err)
+		}
+	}
+
+	if err := txn.SetInstallment(r.InstallmentNumber, r.InstallmentCount); err != nil {
+		return nil, err
+	}
+
+	// currency ausente assume a moeda base do usuário na criação e mantém a atual na edição
+	if r.Currency != "" {
+		txn.Currency, err = domain.NormalizeCurrency(r.Currency)
+		if err != nil {
+			return nil, appError.InvalidParam("currency", err)
+		}
+	}
+
+	// tag_ids ausente mantém as tags atuais; uma lista vazia remove todas
+	if r.TagIDs != nil {
+		txn.TagIDs = []uuid.UUID{}
+		for _, value := range *r.TagIDs {
+			id, err := utils.ToUUID(value)
+			if err != nil {
+				return nil, appError.InvalidParam("tag_ids", err)
+			}
+			if !slices.Contains(txn.TagIDs, id) {
+				txn.TagIDs = append(txn.TagIDs, id)
+			}
+		}
+	}
+
+	// splits ausente mantém a divisão atual; uma lista vazia desfaz a divisão
+	if r.Splits != nil {
+		splits := make([]domain.TransactionSplit, 0, len(*r.Splits))
+		for _, line := range *r.Splits {
+			var splitCategoryID *uuid.UUID
+			if line.CategoryID != nil {
+				splitCategoryID, err = utils.ToNillableUUID(*line.CategoryID)
+				if err != nil {
+					return nil, appError.InvalidParam("splits.category_id", err)
+				}
+			}
+			splits = append(splits, domain.TransactionSplit{
+				CategoryID: splitCategoryID,
+				Amount:     line.Amount,
+				Note:       line.Note,
+			})
+		}
+		if err := txn.SetSplits(splits); err != nil {
+			return nil, err
+		}
+	}
+
+	return txn, nil
 }
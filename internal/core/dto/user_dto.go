@@ -2,6 +2,7 @@ package dto
 
 import (
 	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
 	"frog-go/internal/utils"
 
 	"github.com/google/uuid"
@@ -16,12 +17,40 @@ type UserRequest struct {
 }
 
 type UserResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	IsActive  bool      `json:"is_active"`
-	CreatedAt string    `json:"created_at"`
-	UpdatedAt string    `json:"updated_at"`
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Username     string    `json:"username"`
+	Email        string    `json:"email"`
+	IsActive     bool      `json:"is_active"`
+	BaseCurrency string    `json:"base_currency"`
+	CreatedAt    string    `json:"created_at"`
+	UpdatedAt    string    `json:"updated_at"`
+}
+
+type UserProfileRequest struct {
+	Name     string `json:"name" binding:"omitempty,max=255"`
+	Username string `json:"username" binding:"omitempty,max=255"`
+}
+
+type UserPasswordRequest struct {
+	OldPassword string `json:"old_password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=6"`
+}
+
+type UserEmailRequest struct {
+	NewEmail string `json:"new_email" binding:"required,email,max=255"`
+}
+
+type BaseCurrencyRequest struct {
+	BaseCurrency string `json:"base_currency" binding:"required"`
+}
+
+func (r *BaseCurrencyRequest) ToDomain() (string, error) {
+	currency, err := domain.NormalizeCurrency(r.BaseCurrency)
+	if err != nil {
+		return "", appError.InvalidParam("base_currency", err)
+	}
+	return currency, nil
 }
 
 func (r *UserRequest) ToDomain() (*domain.User, error) {
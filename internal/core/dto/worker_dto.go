@@ -1,10 +1,15 @@
 package dto
 
+// ImportTxnMessage é uma linha de um arquivo importado. Total é o número de linhas do
+// arquivo, usado para saber quando a importação terminou; LedgerID é o livro que recebe as
+// transações e UserID quem importou.
 type ImportTxnMessage struct {
 	JobID    string `json:"job_id"`
+	LedgerID string `json:"ledger_id"`
 	UserID   string `json:"user_id"`
 	Filename string `json:"filename"`
 	Action   string `json:"action"`
+	Total    int    `json:"total"`
 	Data     struct {
 		Transaction TransactionRequest `json:"transaction"`
 	} `json:"data"`
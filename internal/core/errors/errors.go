@@ -14,22 +14,28 @@ const (
 	NotFound            = "Resource not found"
 	Conflict            = "Data conflict"
 	UnprocessableEntity = "Unprocessable entity"
+	PayloadTooLarge     = "Payload too large"
+	UnsupportedMedia    = "Unsupported media type"
 	TooManyRequests     = "Too many requests, please try again later"
 	InternalServerError = "Internal server error"
+	NotImplemented      = "Not implemented"
 	ServiceUnavailable  = "Service temporarily unavailable"
 )
 
 var ErrorMessages = map[int]string{
 
-	http.StatusBadRequest:          BadRequest,
-	http.StatusUnauthorized:        Unauthorized,
-	http.StatusForbidden:           Forbidden,
-	http.StatusNotFound:            NotFound,
-	http.StatusConflict:            Conflict,
-	http.StatusUnprocessableEntity: UnprocessableEntity,
-	http.StatusTooManyRequests:     TooManyRequests,
-	http.StatusInternalServerError: InternalServerError,
-	http.StatusServiceUnavailable:  ServiceUnavailable,
+	http.StatusBadRequest:            BadRequest,
+	http.StatusUnauthorized:          Unauthorized,
+	http.StatusForbidden:             Forbidden,
+	http.StatusNotFound:              NotFound,
+	http.StatusConflict:              Conflict,
+	http.StatusUnprocessableEntity:   UnprocessableEntity,
+	http.StatusRequestEntityTooLarge: PayloadTooLarge,
+	http.StatusUnsupportedMediaType:  UnsupportedMedia,
+	http.StatusTooManyRequests:       TooManyRequests,
+	http.StatusInternalServerError:   InternalServerError,
+	http.StatusNotImplemented:        NotImplemented,
+	http.StatusServiceUnavailable:    ServiceUnavailable,
 }
 
 var (
@@ -41,6 +47,7 @@ var (
 	ErrUnprocessable      = errors.New(strings.ToLower(UnprocessableEntity))
 	ErrTooManyRequests    = errors.New(strings.ToLower(TooManyRequests))
 	ErrInternalServer     = errors.New(strings.ToLower(InternalServerError))
+	ErrNotImplemented     = errors.New(strings.ToLower(NotImplemented))
 	ErrServiceUnavailable = errors.New(strings.ToLower(ServiceUnavailable))
 	ErrEmptyField         = errors.New("empty field")
 
@@ -48,7 +55,46 @@ var (
 	ErrTokenExpired            = errors.New("token expired")
 	ErrInvalidToken            = errors.New("invalid token")
 	ErrInvalidPassword         = errors.New("invalid password")
+	ErrUserConflict            = errors.New("username or email already in use")
 	ErrUserNotFoundInCtx       = errors.New("user not found in context")
+	ErrLedgerNotFoundInCtx     = errors.New("ledger not found in context")
+
+	ErrInvalidStatusTransition  = errors.New("invalid status transition")
+	ErrInvoiceNotOpen           = errors.New("invoice is not open")
+	ErrNoRolloverTarget         = errors.New("no open invoice to receive the rollover")
+	ErrPaymentExceedsBalance    = errors.New("payment exceeds the invoice outstanding amount")
+	ErrCategoryNotFound         = errors.New("category not found")
+	ErrCategoryCycle            = errors.New("category cannot be its own ancestor")
+	ErrInvoiceNotFound          = errors.New("invoice not found")
+	ErrRecurringRuleNotFound    = errors.New("recurring rule not found")
+	ErrAccountNotFound          = errors.New("account not found")
+	ErrTransactionSkipped       = errors.New("transaction skipped by rule")
+	ErrPayeeConflict            = errors.New("payee name or alias already in use")
+	ErrTagNotFound              = errors.New("tag not found")
+	ErrTagConflict              = errors.New("tag name already in use")
+	ErrSplitMismatch            = errors.New("split lines must sum to the transaction amount")
+	ErrAttachmentTooLarge       = errors.New("attachment exceeds the maximum size")
+	ErrAttachmentType           = errors.New("attachment type is not allowed")
+	ErrBlobNotFound             = errors.New("blob not found")
+	ErrExchangeRateNotFound     = errors.New("no exchange rate to the base currency on or before the record date")
+	ErrBaseCurrencyLocked       = errors.New("base currency cannot change once transactions or invoices exist")
+	ErrBudgetConflict           = errors.New("category already has a budget for this month")
+	ErrBudgetAmountRequired     = errors.New("budget needs an amount, a percentage or a category suggested percentage")
+	ErrLedgerMemberConflict     = errors.New("user is already a member or has a pending invitation")
+	ErrLedgerOwner              = errors.New("the ledger owner cannot be removed or lose the owner role")
+	ErrLedgerOwnerRole          = errors.New("the owner role cannot be assigned to members")
+	ErrInviteeNotFound          = errors.New("invited user not found")
+	ErrInvitationNotPending     = errors.New("invitation is no longer pending")
+	ErrShareMismatch            = errors.New("participant shares must sum to the transaction amount")
+	ErrShareNotExpense          = errors.New("only expenses can be shared")
+	ErrParticipantNotMember     = errors.New("participants must be members of the ledger")
+	ErrInsufficientQuantity     = errors.New("sell quantity exceeds the quantity held")
+	ErrHoldingConflict          = errors.New("ticker already in use")
+	ErrHoldingNotFound          = errors.New("holding not found")
+	ErrPrepaymentExceedsBalance = errors.New("prepayment exceeds the outstanding balance")
+	ErrPrepaymentConflict       = errors.New("prepayment cannot precede an earlier prepayment or installments already paid")
+	ErrDebtBudgetTooLow         = errors.New("monthly budget does not cover the minimum payments")
+	ErrDebtPlanTooLong          = errors.New("monthly budget does not pay off the debts within the maximum term")
 )
 
 type ErrorResponse struct {
@@ -5,6 +5,7 @@ import (
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
 	"frog-go/internal/utils/pagination"
+	"io"
 	"mime/multipart"
 	"time"
 
@@ -12,33 +13,55 @@ import (
 )
 
 type CategoryService interface {
-	GetCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error)
-	CreateCategory(ctx context.Context, input domain.Category) (*dto.CategoryResponse, error)
-	UpdateCategory(ctx context.Context, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error)
-	DeleteCategoryByID(ctx context.Context, id uuid.UUID) error
-	ListCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, int, error)
+	GetCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.CategoryResponse, error)
+	CreateCategory(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Category) (*dto.CategoryResponse, error)
+	UpdateCategory(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error)
+	DeleteCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListCategories(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.CategoryResponse, int, error)
+	ListCategoryTree(ctx context.Context, ledgerID uuid.UUID) ([]*dto.CategoryResponse, error)
+	SuggestCategory(ctx context.Context, ledgerID uuid.UUID, flt dto.CategorySuggestFilters) (*dto.CategorySuggestionResponse, error)
 }
 
 type TransactionService interface {
-	GetTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error)
-	CreateTransaction(ctx context.Context, userID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
-	UpdateTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
-	DeleteTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
-	ListTransactions(ctx context.Context, userID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error)
-	TransactionsSummary(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error)
-	TransactionsGeneralStats(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error)
+	GetTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error)
+	CreateTransaction(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
+	UpdateTransaction(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
+	DeleteTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListTransactions(ctx context.Context, ledgerID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error)
+	TransactionsSummary(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error)
+	TransactionsGeneralStats(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error)
+	RecordImportProgress(ctx context.Context, input domain.ImportJob, failed bool) (*domain.ImportJob, error)
 }
 
 type InvoiceService interface {
-	GetInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error)
-	CreateInvoice(ctx context.Context, userID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
-	UpdateInvoice(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
-	DeleteInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
-	ListInvoices(ctx context.Context, userID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error)
-	ListInvoiceDebts(ctx context.Context, userID uuid.UUID, id uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error)
+	GetInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error)
+	CreateInvoice(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
+	UpdateInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
+	DeleteInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListInvoices(ctx context.Context, ledgerID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error)
+	ListInvoiceDebts(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error)
+	CloseInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoiceClosing) (*dto.InvoiceResponse, error)
+	PayInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoicePayment) (*dto.InvoiceResponse, error)
+	MarkOverdueInvoices(ctx context.Context) ([]domain.Reminder, error)
+	ListInvoicePayments(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID) ([]dto.InvoicePaymentResponse, error)
+	CreateInvoicePayment(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, input domain.InvoicePayment) (*dto.InvoicePaymentResponse, error)
+	DeleteInvoicePaymentByID(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, id uuid.UUID) error
 }
+
+type AccountService interface {
+	CreateAccount(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Account) (*dto.AccountResponse, error)
+	ListAccounts(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AccountResponse, int, error)
+}
+
 type UploadService interface {
-	ImportFile(userID uuid.UUID, model, action string, invoiceID *uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) error
+	ImportFile(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID, model, action string, invoiceID *uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (uuid.UUID, error)
+}
+
+type AttachmentService interface {
+	CreateAttachment(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, file multipart.File, fileHeader *multipart.FileHeader) (*dto.AttachmentResponse, error)
+	ListAttachments(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget) ([]dto.AttachmentResponse, error)
+	OpenAttachment(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) (*domain.Attachment, io.ReadCloser, error)
+	DeleteAttachmentByID(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) error
 }
 
 type AuthService interface {
@@ -53,4 +76,173 @@ type UserService interface {
 	CreateUser(ctx context.Context, input domain.User) (*dto.UserResponse, error)
 	GetUser(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error)
 	UpdateUserPassword(ctx context.Context, userID uuid.UUID, oldPassword string, newPassword string) error
+	UpdateUserProfile(ctx context.Context, userID uuid.UUID, name string, username string) (*dto.UserResponse, error)
+	UpdateUserEmail(ctx context.Context, userID uuid.UUID, newEmail string) error
+	DeactivateUserAccount(ctx context.Context, userID uuid.UUID) error
+	LogoutUser(ctx context.Context, userID uuid.UUID) error
+	UpdateUserBaseCurrency(ctx context.Context, userID uuid.UUID, currency string) (*dto.UserResponse, error)
+}
+
+type ExchangeRateService interface {
+	UpsertExchangeRate(ctx context.Context, ledgerID uuid.UUID, input domain.ExchangeRate) (*dto.ExchangeRateResponse, error)
+	ImportExchangeRates(ctx context.Context, ledgerID uuid.UUID, file io.Reader) (int, error)
+	DeleteExchangeRateByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListExchangeRates(ctx context.Context, ledgerID uuid.UUID, flt dto.ExchangeRateFilters, pgn *pagination.Pagination) ([]dto.ExchangeRateResponse, int, error)
+}
+
+type RuleService interface {
+	GetRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RuleResponse, error)
+	CreateRule(ctx context.Context, ledgerID uuid.UUID, input domain.Rule) (*dto.RuleResponse, error)
+	UpdateRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Rule) (*dto.RuleResponse, error)
+	DeleteRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RuleResponse, int, error)
+}
+
+type PayeeService interface {
+	GetPayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, flt dto.PayeeFilters) (*dto.PayeeResponse, error)
+	CreatePayee(ctx context.Context, ledgerID uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error)
+	UpdatePayee(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error)
+	DeletePayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListPayees(ctx context.Context, ledgerID uuid.UUID, flt dto.PayeeFilters, pgn *pagination.Pagination) ([]dto.PayeeResponse, int, error)
+	LinkMissingPayees(ctx context.Context) (int, error)
+}
+
+type TagService interface {
+	GetTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TagResponse, error)
+	CreateTag(ctx context.Context, ledgerID uuid.UUID, input domain.Tag) (*dto.TagResponse, error)
+	UpdateTag(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Tag) (*dto.TagResponse, error)
+	DeleteTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListTags(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.TagResponse, int, error)
+}
+
+type BudgetService interface {
+	GetBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.BudgetResponse, error)
+	CreateBudget(ctx context.Context, ledgerID uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error)
+	UpdateBudget(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error)
+	DeleteBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListBudgets(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetFilters, pgn *pagination.Pagination) ([]dto.BudgetResponse, int, error)
+	BudgetsStatus(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetStatusFilters) ([]dto.BudgetStatusResponse, error)
+	PublishBudgetAlerts(ctx context.Context) (int, error)
+}
+
+type EnvelopeService interface {
+	EnvelopesMonth(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeFilters) (*dto.EnvelopeMonthResponse, error)
+	CreateEnvelopeAllocation(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeAllocation) (*dto.EnvelopeAllocationResponse, error)
+	MoveEnvelopeMoney(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeMove) ([]dto.EnvelopeAllocationResponse, error)
+	DeleteEnvelopeAllocationByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeAllocationFilters, pgn *pagination.Pagination) ([]dto.EnvelopeAllocationResponse, int, error)
+}
+
+type GoalService interface {
+	GetGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.GoalResponse, error)
+	CreateGoal(ctx context.Context, ledgerID uuid.UUID, input domain.Goal) (*dto.GoalResponse, error)
+	UpdateGoal(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Goal) (*dto.GoalResponse, error)
+	DeleteGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListGoals(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.GoalResponse, int, error)
+	GoalProgress(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.GoalProgressResponse, error)
+	GoalsProgress(ctx context.Context, ledgerID uuid.UUID) ([]dto.GoalProgressResponse, error)
+}
+
+type LedgerService interface {
+	ResolveLedger(ctx context.Context, userID uuid.UUID, ledgerID *uuid.UUID) (*domain.LedgerAccess, error)
+	CreateLedger(ctx context.Context, userID uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error)
+	UpdateLedger(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error)
+	ListLedgers(ctx context.Context, userID uuid.UUID) ([]dto.LedgerResponse, error)
+	ListLedgerMembers(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) ([]dto.LedgerMemberResponse, error)
+	UpdateLedgerMember(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, memberID uuid.UUID, role domain.LedgerRole) (*dto.LedgerMemberResponse, error)
+	DeleteLedgerMember(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, memberID uuid.UUID) error
+	CreateLedgerInvitation(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, input domain.LedgerInvitation) (*dto.LedgerInvitationResponse, error)
+	ListLedgerInvitations(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) ([]dto.LedgerInvitationResponse, error)
+	RevokeLedgerInvitation(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, id uuid.UUID) error
+	ListReceivedLedgerInvitations(ctx context.Context, userID uuid.UUID) ([]dto.LedgerInvitationResponse, error)
+	RespondLedgerInvitation(ctx context.Context, userID uuid.UUID, id uuid.UUID, accept bool) (*dto.LedgerInvitationResponse, error)
+}
+
+type SharingService interface {
+	SetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.ExpenseSharing) (*dto.TransactionParticipantsResponse, error)
+	GetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionParticipantsResponse, error)
+	DeleteTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	CreateSettlement(ctx context.Context, ledgerID uuid.UUID, input domain.Settlement) (*dto.SettlementResponse, error)
+	DeleteSettlementByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListSettlements(ctx context.Context, ledgerID uuid.UUID, flt dto.SettlementFilters, pgn *pagination.Pagination) ([]dto.SettlementResponse, int, error)
+	SharedBalances(ctx context.Context, ledgerID uuid.UUID) (*dto.SharedBalancesResponse, error)
+	SettleUp(ctx context.Context, ledgerID uuid.UUID, note *string) ([]dto.SettlementResponse, error)
+}
+
+type NetWorthService interface {
+	GetAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.AssetResponse, error)
+	CreateAsset(ctx context.Context, ledgerID uuid.UUID, input domain.Asset) (*dto.AssetResponse, error)
+	UpdateAsset(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Asset) (*dto.AssetResponse, error)
+	DeleteAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListAssets(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AssetResponse, int, error)
+	UpsertValuation(ctx context.Context, ledgerID uuid.UUID, input domain.Valuation) (*dto.ValuationResponse, error)
+	ListValuations(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget) ([]dto.ValuationResponse, error)
+	DeleteValuationByID(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget, id uuid.UUID) error
+	NetWorth(ctx context.Context, ledgerID uuid.UUID, flt dto.NetWorthFilters) ([]dto.NetWorthByDate, error)
+}
+
+type InvestmentService interface {
+	GetHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.HoldingResponse, error)
+	CreateHolding(ctx context.Context, ledgerID uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error)
+	UpdateHolding(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error)
+	DeleteHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListHoldings(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.HoldingResponse, int, error)
+	CreateInvestmentTrade(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentTrade) (*dto.InvestmentTradeResponse, error)
+	ListInvestmentTrades(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentTradeResponse, error)
+	DeleteInvestmentTradeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error
+	UpsertInvestmentQuote(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentQuote) (*dto.InvestmentQuoteResponse, error)
+	ImportInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, file io.Reader) (int, error)
+	ListInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentQuoteResponse, error)
+	DeleteInvestmentQuoteByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error
+	CreateInvestmentIncome(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentIncome) (*dto.InvestmentIncomeResponse, error)
+	ListInvestmentIncomes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentIncomeResponse, error)
+	DeleteInvestmentIncomeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error
+	Portfolio(ctx context.Context, ledgerID uuid.UUID) (*dto.PortfolioResponse, error)
+}
+
+type LoanService interface {
+	GetLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanResponse, error)
+	CreateLoan(ctx context.Context, ledgerID uuid.UUID, input domain.Loan) (*dto.LoanResponse, error)
+	UpdateLoan(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Loan) (*dto.LoanResponse, error)
+	DeleteLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListLoans(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.LoanResponse, int, error)
+	SimulateLoan(ctx context.Context, input domain.Loan) (*dto.LoanSimulationResponse, error)
+	LoanSchedule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanScheduleResponse, error)
+	CreateLoanPrepayment(ctx context.Context, ledgerID uuid.UUID, input domain.LoanPrepayment) (*dto.LoanPrepaymentResponse, error)
+	ListLoanPrepayments(ctx context.Context, ledgerID uuid.UUID, loanID uuid.UUID) ([]dto.LoanPrepaymentResponse, error)
+}
+
+type DebtPlanService interface {
+	PlanDebts(ctx context.Context, ledgerID uuid.UUID, input domain.DebtPlan) (*dto.DebtPlanResponse, error)
+}
+
+type CashFlowService interface {
+	CashFlowForecast(ctx context.Context, ledgerID uuid.UUID, flt dto.CashFlowFilters) (*dto.CashFlowForecastResponse, error)
+}
+
+type RecurringRuleService interface {
+	GetRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RecurringRuleResponse, error)
+	CreateRecurringRule(ctx context.Context, ledgerID uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error)
+	UpdateRecurringRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error)
+	DeleteRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListRecurringRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RecurringRuleResponse, int, error)
+}
+
+type TaxReportService interface {
+	TaxReport(ctx context.Context, ledgerID uuid.UUID, year int) (*dto.TaxReportResponse, error)
+	ExportTaxReport(ctx context.Context, ledgerID uuid.UUID, year int, format string) (*dto.ExportFile, error)
+}
+
+type NotificationService interface {
+	GetNotificationPreference(ctx context.Context, userID uuid.UUID) (*dto.NotificationPreferenceResponse, error)
+	UpdateNotificationPreference(ctx context.Context, userID uuid.UUID, input domain.NotificationPreference) (*dto.NotificationPreferenceResponse, error)
+	ScheduleReminders(ctx context.Context) (int, error)
+	NotifySpendingAnomalies(ctx context.Context) (int, error)
+	NotifyBudgetAlert(ctx context.Context, event dto.BudgetAlertEvent) (int, error)
+	DeliverNotifications(ctx context.Context) (int, int, error)
+	Notify(ctx context.Context, reminders []domain.Reminder) (int, error)
+	ListNotifications(ctx context.Context, userID uuid.UUID, flt dto.NotificationFilters, pgn *pagination.Pagination) ([]dto.NotificationResponse, int, error)
+	CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (*dto.NotificationUnreadCountResponse, error)
+	MarkNotificationRead(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.NotificationResponse, error)
+	MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) (*dto.NotificationReadAllResponse, error)
 }
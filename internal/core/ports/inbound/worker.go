@@ -1,5 +1,11 @@
 package inbound
 
+import "context"
+
 type Consumer interface {
 	ProcessMessage(timeoutSeconds int, messageBody []byte) error
 }
+
+type Job interface {
+	Run(ctx context.Context) error
+}
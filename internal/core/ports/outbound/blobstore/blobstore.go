@@ -0,0 +1,14 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore guarda o conteúdo dos arquivos endereçado pelo próprio conteúdo: a chave é
+// derivada dos bytes gravados, então arquivos iguais ocupam um único blob.
+type BlobStore interface {
+	Put(ctx context.Context, r io.Reader) (key string, size int64, err error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,15 @@
+package notifier
+
+import (
+	"context"
+	"frog-go/internal/core/domain"
+)
+
+// Channel entrega um aviso por um meio (e-mail, webhook, caixa de entrada do app). Um erro
+// mantém a entrega pendente para uma nova tentativa.
+type Channel interface {
+	Send(ctx context.Context, delivery domain.NotificationDelivery) error
+}
+
+// Channels são os canais configurados, por nome.
+type Channels map[domain.NotificationChannel]Channel
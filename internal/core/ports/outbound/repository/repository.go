@@ -5,6 +5,7 @@ import (
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
 	"frog-go/internal/utils/pagination"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -12,30 +13,199 @@ import (
 type Repository interface {
 	Close()
 
-	GetCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error)
-	GetCategoryIDByName(ctx context.Context, name *string) (*uuid.UUID, error)
-	CreateCategory(ctx context.Context, input domain.Category) (*dto.CategoryResponse, error)
-	UpdateCategory(ctx context.Context, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error)
-	DeleteCategoryByID(ctx context.Context, id uuid.UUID) error
-	ListCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, error)
-	CountCategories(ctx context.Context, pgn *pagination.Pagination) (int, error)
-
-	GetTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error)
-	CreateTransaction(ctx context.Context, userID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
-	UpdateTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
-	DeleteTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
-	ListTransactions(ctx context.Context, userID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, error)
-	CountTransactions(ctx context.Context, userID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) (int, error)
-	TransactionsSummary(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error)
-	TransactionsGeneralStats(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error)
-
-	GetInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error)
-	CreateInvoice(ctx context.Context, userID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
-	UpdateInvoice(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
-	DeleteInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
-	ListInvoices(ctx context.Context, userID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error)
-	CountInvoices(ctx context.Context, userID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) (int, error)
+	GetCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.CategoryResponse, error)
+	GetCategoryIDByName(ctx context.Context, ledgerID uuid.UUID, name *string) (*uuid.UUID, error)
+	CreateCategory(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Category) (*dto.CategoryResponse, error)
+	UpdateCategory(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error)
+	DeleteCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListCategories(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.CategoryResponse, error)
+	ListCategoryTree(ctx context.Context, ledgerID uuid.UUID) ([]*dto.CategoryResponse, error)
+	SuggestCategory(ctx context.Context, ledgerID uuid.UUID, flt dto.CategorySuggestFilters) (*dto.CategorySuggestionResponse, error)
+	CountCategories(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+
+	GetTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error)
+	CreateTransaction(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
+	UpdateTransaction(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error)
+	DeleteTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListTransactions(ctx context.Context, ledgerID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, error)
+	CountTransactions(ctx context.Context, ledgerID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) (int, error)
+	TransactionsSummary(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error)
+	TransactionsGeneralStats(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error)
+
+	GetInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error)
+	CreateInvoice(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
+	UpdateInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error)
+	DeleteInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListInvoices(ctx context.Context, ledgerID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, error)
+	CountInvoices(ctx context.Context, ledgerID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) (int, error)
+	CloseInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoiceClosing) (*dto.InvoiceResponse, error)
+	PayInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoicePayment) (*dto.InvoiceResponse, error)
+	MarkOverdueInvoices(ctx context.Context, now time.Time) ([]domain.Reminder, error)
+
+	ListInvoicePayments(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID) ([]dto.InvoicePaymentResponse, error)
+	CreateInvoicePayment(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, input domain.InvoicePayment) (*dto.InvoicePaymentResponse, error)
+	DeleteInvoicePaymentByID(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, id uuid.UUID) error
+
+	CreateAccount(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Account) (*dto.AccountResponse, error)
+	ListAccounts(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AccountResponse, error)
+	CountAccounts(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
 
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*domain.User, error)
+	CreateUser(ctx context.Context, input domain.User) (*dto.UserResponse, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error)
+	GetUserCredentials(ctx context.Context, userID uuid.UUID) (*domain.User, error)
+	UpdateUserProfile(ctx context.Context, userID uuid.UUID, name string, username string) (*dto.UserResponse, error)
+	UpdateUserEmail(ctx context.Context, userID uuid.UUID, email string) error
+	UpdateUserPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	UpdateUserBaseCurrency(ctx context.Context, userID uuid.UUID, currency string) (*dto.UserResponse, error)
+
+	GetRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RuleResponse, error)
+	CreateRule(ctx context.Context, ledgerID uuid.UUID, input domain.Rule) (*dto.RuleResponse, error)
+	UpdateRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Rule) (*dto.RuleResponse, error)
+	DeleteRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RuleResponse, error)
+	CountRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+
+	GetPayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, flt dto.PayeeFilters) (*dto.PayeeResponse, error)
+	CreatePayee(ctx context.Context, ledgerID uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error)
+	UpdatePayee(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error)
+	DeletePayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListPayees(ctx context.Context, ledgerID uuid.UUID, flt dto.PayeeFilters, pgn *pagination.Pagination) ([]dto.PayeeResponse, error)
+	CountPayees(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+	LinkMissingPayees(ctx context.Context) (int, error)
+
+	GetTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TagResponse, error)
+	CreateTag(ctx context.Context, ledgerID uuid.UUID, input domain.Tag) (*dto.TagResponse, error)
+	UpdateTag(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Tag) (*dto.TagResponse, error)
+	DeleteTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListTags(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.TagResponse, error)
+	CountTags(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+
+	GetAttachment(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) (*domain.Attachment, error)
+	CreateAttachment(ctx context.Context, ledgerID uuid.UUID, input domain.Attachment) (*dto.AttachmentResponse, error)
+	DeleteAttachmentByID(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) error
+	ListAttachments(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget) ([]dto.AttachmentResponse, error)
+	CountAttachmentsByBlobKey(ctx context.Context, key string) (int, error)
+	WithBlobLock(ctx context.Context, key string, fn func() error) error
+
+	UpsertExchangeRates(ctx context.Context, ledgerID uuid.UUID, input []domain.ExchangeRate) ([]dto.ExchangeRateResponse, error)
+	DeleteExchangeRateByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListExchangeRates(ctx context.Context, ledgerID uuid.UUID, flt dto.ExchangeRateFilters, pgn *pagination.Pagination) ([]dto.ExchangeRateResponse, error)
+	CountExchangeRates(ctx context.Context, ledgerID uuid.UUID, flt dto.ExchangeRateFilters) (int, error)
+
+	GetBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.BudgetResponse, error)
+	CreateBudget(ctx context.Context, ledgerID uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error)
+	UpdateBudget(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error)
+	DeleteBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListBudgets(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetFilters, pgn *pagination.Pagination) ([]dto.BudgetResponse, error)
+	CountBudgets(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetFilters) (int, error)
+	BudgetsStatus(ctx context.Context, ledgerID uuid.UUID, month time.Time, now time.Time) ([]dto.BudgetStatusResponse, error)
+	ListBudgetAlerts(ctx context.Context, now time.Time) ([]dto.BudgetAlertEvent, error)
+	MarkBudgetAlerted(ctx context.Context, id uuid.UUID, month time.Time, threshold int) error
+
+	CreateEnvelopeAllocation(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeAllocation) (*dto.EnvelopeAllocationResponse, error)
+	MoveEnvelopeMoney(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeMove) ([]dto.EnvelopeAllocationResponse, error)
+	DeleteEnvelopeAllocationByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeAllocationFilters, pgn *pagination.Pagination) ([]dto.EnvelopeAllocationResponse, error)
+	CountEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeAllocationFilters) (int, error)
+	EnvelopesMonth(ctx context.Context, ledgerID uuid.UUID, month time.Time) (*dto.EnvelopeMonthResponse, error)
+
+	GetGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.GoalResponse, error)
+	CreateGoal(ctx context.Context, ledgerID uuid.UUID, input domain.Goal) (*dto.GoalResponse, error)
+	UpdateGoal(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Goal) (*dto.GoalResponse, error)
+	DeleteGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListGoals(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.GoalResponse, error)
+	CountGoals(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+	GoalProgress(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, now time.Time) (*dto.GoalProgressResponse, error)
+	GoalsProgress(ctx context.Context, ledgerID uuid.UUID, now time.Time) ([]dto.GoalProgressResponse, error)
+
+	GetLedgerAccess(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) (*domain.LedgerAccess, error)
+	GetPersonalLedgerAccess(ctx context.Context, userID uuid.UUID) (*domain.LedgerAccess, error)
+	GetLedgerBaseCurrency(ctx context.Context, ledgerID uuid.UUID) (string, error)
+	CreateLedger(ctx context.Context, userID uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error)
+	UpdateLedger(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error)
+	ListLedgers(ctx context.Context, userID uuid.UUID) ([]dto.LedgerResponse, error)
+	ListLedgerMembers(ctx context.Context, ledgerID uuid.UUID) ([]dto.LedgerMemberResponse, error)
+	UpdateLedgerMemberRole(ctx context.Context, ledgerID uuid.UUID, memberID uuid.UUID, role domain.LedgerRole) (*dto.LedgerMemberResponse, error)
+	DeleteLedgerMember(ctx context.Context, ledgerID uuid.UUID, memberID uuid.UUID) error
+	CreateLedgerInvitation(ctx context.Context, ledgerID uuid.UUID, invitedByID uuid.UUID, input domain.LedgerInvitation, now time.Time) (*dto.LedgerInvitationResponse, error)
+	ListLedgerInvitations(ctx context.Context, ledgerID uuid.UUID) ([]dto.LedgerInvitationResponse, error)
+	ListReceivedLedgerInvitations(ctx context.Context, userID uuid.UUID, now time.Time) ([]dto.LedgerInvitationResponse, error)
+	RevokeLedgerInvitation(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, now time.Time) error
+	RespondLedgerInvitation(ctx context.Context, userID uuid.UUID, id uuid.UUID, accept bool, now time.Time) (*dto.LedgerInvitationResponse, error)
+
+	SetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.ExpenseSharing) (*dto.TransactionParticipantsResponse, error)
+	GetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionParticipantsResponse, error)
+	DeleteTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	CreateSettlements(ctx context.Context, ledgerID uuid.UUID, inputs []domain.Settlement) ([]dto.SettlementResponse, error)
+	DeleteSettlementByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListSettlements(ctx context.Context, ledgerID uuid.UUID, flt dto.SettlementFilters, pgn *pagination.Pagination) ([]dto.SettlementResponse, error)
+	CountSettlements(ctx context.Context, ledgerID uuid.UUID, flt dto.SettlementFilters) (int, error)
+	SharedBalances(ctx context.Context, ledgerID uuid.UUID) (*dto.SharedBalancesResponse, error)
+
+	GetAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.AssetResponse, error)
+	CreateAsset(ctx context.Context, ledgerID uuid.UUID, input domain.Asset) (*dto.AssetResponse, error)
+	UpdateAsset(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Asset) (*dto.AssetResponse, error)
+	DeleteAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListAssets(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AssetResponse, error)
+	CountAssets(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+	UpsertValuation(ctx context.Context, ledgerID uuid.UUID, input domain.Valuation) (*dto.ValuationResponse, error)
+	ListValuations(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget) ([]dto.ValuationResponse, error)
+	DeleteValuationByID(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget, id uuid.UUID) error
+	NetWorth(ctx context.Context, ledgerID uuid.UUID, periodTrunc string, start time.Time, end time.Time) ([]dto.NetWorthByDate, error)
+
+	GetHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.HoldingResponse, error)
+	CreateHolding(ctx context.Context, ledgerID uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error)
+	UpdateHolding(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error)
+	DeleteHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListHoldings(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.HoldingResponse, error)
+	CountHoldings(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+	CreateInvestmentTrade(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentTrade) (*dto.InvestmentTradeResponse, error)
+	ListInvestmentTrades(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentTradeResponse, error)
+	DeleteInvestmentTradeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error
+	UpsertInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, input []domain.InvestmentQuote) ([]dto.InvestmentQuoteResponse, error)
+	ListInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentQuoteResponse, error)
+	DeleteInvestmentQuoteByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error
+	CreateInvestmentIncome(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentIncome) (*dto.InvestmentIncomeResponse, error)
+	ListInvestmentIncomes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentIncomeResponse, error)
+	DeleteInvestmentIncomeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error
+	Portfolio(ctx context.Context, ledgerID uuid.UUID) (*dto.PortfolioResponse, error)
+
+	GetLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanResponse, error)
+	CreateLoan(ctx context.Context, ledgerID uuid.UUID, input domain.Loan) (*dto.LoanResponse, error)
+	UpdateLoan(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Loan) (*dto.LoanResponse, error)
+	DeleteLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListLoans(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.LoanResponse, error)
+	CountLoans(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+	LoanSchedule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanScheduleResponse, error)
+	CreateLoanPrepayment(ctx context.Context, ledgerID uuid.UUID, input domain.LoanPrepayment) (*dto.LoanPrepaymentResponse, error)
+	ListLoanPrepayments(ctx context.Context, ledgerID uuid.UUID, loanID uuid.UUID) ([]dto.LoanPrepaymentResponse, error)
+
+	ListDebts(ctx context.Context, ledgerID uuid.UUID, date time.Time) ([]domain.OpenDebt, error)
+	CashFlowForecast(ctx context.Context, ledgerID uuid.UUID, start time.Time, end time.Time, lookback int) (*dto.CashFlowForecastResponse, error)
+
+	GetRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RecurringRuleResponse, error)
+	CreateRecurringRule(ctx context.Context, ledgerID uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error)
+	UpdateRecurringRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error)
+	DeleteRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error
+	ListRecurringRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RecurringRuleResponse, error)
+	CountRecurringRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) (int, error)
+	TaxReport(ctx context.Context, ledgerID uuid.UUID, year int) (*dto.TaxReportResponse, error)
+
+	GetNotificationPreference(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreference, error)
+	UpsertNotificationPreference(ctx context.Context, userID uuid.UUID, input domain.NotificationPreference) (*domain.NotificationPreference, error)
+	ListDueReminders(ctx context.Context, now time.Time) ([]domain.Reminder, error)
+	ListSpendingAnomalies(ctx context.Context, now time.Time) ([]domain.Reminder, error)
+	EnqueueReminder(ctx context.Context, reminder domain.Reminder) (int, error)
+	ClaimNotificationDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.NotificationDelivery, error)
+	MarkNotificationDelivered(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+	MarkNotificationFailed(ctx context.Context, id uuid.UUID, cause string, giveUp bool) error
+	DeferNotificationDelivery(ctx context.Context, id uuid.UUID, notBefore time.Time) error
+	CreateNotification(ctx context.Context, input domain.Notification) error
+	ListNotifications(ctx context.Context, userID uuid.UUID, flt dto.NotificationFilters, pgn *pagination.Pagination) ([]dto.NotificationResponse, error)
+	CountNotifications(ctx context.Context, userID uuid.UUID, flt dto.NotificationFilters) (int, error)
+	MarkNotificationRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, now time.Time) (*dto.NotificationResponse, error)
+	MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID, now time.Time) (int, error)
+	RecordImportProgress(ctx context.Context, input domain.ImportJob, failed bool, now time.Time) (*domain.ImportJob, error)
 }
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type accountService struct {
+	repo repository.Repository
+}
+
+func NewAccountService(repo repository.Repository) inbound.AccountService {
+	return &accountService{repo: repo}
+}
+
+func (s *accountService) CreateAccount(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Account) (*dto.AccountResponse, error) {
+	return s.repo.CreateAccount(ctx, ledgerID, createdByID, input)
+}
+
+func (s *accountService) ListAccounts(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AccountResponse, int, error) {
+	data, err := s.repo.ListAccounts(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountAccounts(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/blobstore"
+	"frog-go/internal/core/ports/outbound/repository"
+
+	"github.com/google/uuid"
+)
+
+// sniffLength é a quantidade de bytes que http.DetectContentType considera.
+const sniffLength = 512
+
+type attachmentService struct {
+	repo  repository.Repository
+	blobs blobstore.BlobStore
+}
+
+func NewAttachmentService(repo repository.Repository, blobs blobstore.BlobStore) inbound.AttachmentService {
+	return &attachmentService{repo: repo, blobs: blobs}
+}
+
+// CreateAttachment identifica o tipo pelo conteúdo do arquivo, valida os limites, grava o
+// blob e registra o anexo. Se o registro falhar, o blob sem referências é descartado.
+func (s *attachmentService) CreateAttachment(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, file multipart.File, fileHeader *multipart.FileHeader) (*dto.AttachmentResponse, error) {
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	contentType := detectAttachmentType(head[:n], fileHeader.Filename)
+	input, err := domain.NewAttachment(target, fileHeader.Filename, contentType, fileHeader.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	// O tamanho informado pelo cliente não é confiável: o limite é aplicado também na leitura
+	key, size, err := s.blobs.Put(ctx, io.LimitReader(file, domain.MaxAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if size > domain.MaxAttachmentSize {
+		_ = s.releaseBlob(ctx, key)
+		return nil, appError.ErrAttachmentTooLarge
+	}
+
+	input.BlobKey = key
+	input.Size = size
+
+	var response *dto.AttachmentResponse
+	err = s.repo.WithBlobLock(ctx, key, func() error {
+		// Uma exclusão concorrente do mesmo conteúdo pode ter removido o blob entre o Put e o lock
+		if err := s.restoreBlob(ctx, key, file); err != nil {
+			return err
+		}
+
+		response, err = s.repo.CreateAttachment(ctx, ledgerID, *input)
+		return err
+	})
+	if err != nil {
+		_ = s.releaseBlob(ctx, key)
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *attachmentService) ListAttachments(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget) ([]dto.AttachmentResponse, error) {
+	return s.repo.ListAttachments(ctx, ledgerID, target)
+}
+
+// OpenAttachment retorna os dados do anexo e o conteúdo do arquivo, que deve ser fechado
+// por quem chamou.
+func (s *attachmentService) OpenAttachment(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) (*domain.Attachment, io.ReadCloser, error) {
+	data, err := s.repo.GetAttachment(ctx, ledgerID, target, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Open(ctx, data.BlobKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, content, nil
+}
+
+func (s *attachmentService) DeleteAttachmentByID(ctx context.Context, ledgerID uuid.UUID, target domain.AttachmentTarget, id uuid.UUID) error {
+	data, err := s.repo.GetAttachment(ctx, ledgerID, target, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteAttachmentByID(ctx, ledgerID, target, id); err != nil {
+		return err
+	}
+	return s.releaseBlob(ctx, data.BlobKey)
+}
+
+// releaseBlob remove o blob quando nenhum anexo aponta mais para ele. A contagem e a remoção
+// acontecem com a chave travada, para não apagar o conteúdo de um anexo sendo registrado.
+func (s *attachmentService) releaseBlob(ctx context.Context, key string) error {
+	return s.repo.WithBlobLock(ctx, key, func() error {
+		refs, err := s.repo.CountAttachmentsByBlobKey(ctx, key)
+		if err != nil {
+			return err
+		}
+		if refs > 0 {
+			return nil
+		}
+		return s.blobs.Delete(ctx, key)
+	})
+}
+
+// restoreBlob grava o arquivo de novo quando o blob não existe mais.
+func (s *attachmentService) restoreBlob(ctx context.Context, key string, file multipart.File) error {
+	content, err := s.blobs.Open(ctx, key)
+	if err == nil {
+		return content.Close()
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, _, err = s.blobs.Put(ctx, io.LimitReader(file, domain.MaxAttachmentSize+1))
+	return err
+}
+
+// detectAttachmentType identifica o tipo pelo conteúdo. Planilhas e arquivos de texto
+// não têm assinatura própria e são diferenciados pela extensão do arquivo.
+func detectAttachmentType(head []byte, filename string) string {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "application/octet-stream"
+	}
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	switch {
+	case mediaType == "application/zip" && ext == ".xlsx":
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case mediaType == "text/plain" && ext == ".csv":
+		return "text/csv"
+	case (mediaType == "text/plain" || mediaType == "text/xml") && ext == ".ofx":
+		return "application/x-ofx"
+	}
+	return mediaType
+}
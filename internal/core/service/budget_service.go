@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/messagebus"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type budgetService struct {
+	repo repository.Repository
+	mb   messagebus.MessageBus
+}
+
+func NewBudgetService(repo repository.Repository, mb messagebus.MessageBus) inbound.BudgetService {
+	return &budgetService{repo: repo, mb: mb}
+}
+
+func (s *budgetService) GetBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.BudgetResponse, error) {
+	return s.repo.GetBudgetByID(ctx, ledgerID, id)
+}
+
+func (s *budgetService) CreateBudget(ctx context.Context, ledgerID uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error) {
+	return s.repo.CreateBudget(ctx, ledgerID, input)
+}
+
+func (s *budgetService) UpdateBudget(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Budget) (*dto.BudgetResponse, error) {
+	return s.repo.UpdateBudget(ctx, ledgerID, id, input)
+}
+
+func (s *budgetService) DeleteBudgetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteBudgetByID(ctx, ledgerID, id)
+}
+
+func (s *budgetService) ListBudgets(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetFilters, pgn *pagination.Pagination) ([]dto.BudgetResponse, int, error) {
+	data, err := s.repo.ListBudgets(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountBudgets(ctx, ledgerID, flt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+// BudgetsStatus mostra o uso dos orçamentos no mês informado ou, sem mês, no mês atual.
+func (s *budgetService) BudgetsStatus(ctx context.Context, ledgerID uuid.UUID, flt dto.BudgetStatusFilters) ([]dto.BudgetStatusResponse, error) {
+	now := time.Now().UTC()
+
+	month := now
+	if flt.Month != "" {
+		value, err := dto.ParseBudgetMonth(flt.Month)
+		if err != nil {
+			return nil, fmt.Errorf("%w: month: %v", appError.ErrBadRequest, err)
+		}
+		month = value
+	}
+
+	return s.repo.BudgetsStatus(ctx, ledgerID, month, now)
+}
+
+// PublishBudgetAlerts publica na fila de alertas os orçamentos do mês atual que passaram
+// de 80% ou 100% desde o último aviso e registra o limite avisado. Retorna quantos alertas
+// foram publicados.
+func (s *budgetService) PublishBudgetAlerts(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+
+	alerts, err := s.repo.ListBudgetAlerts(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, alert := range alerts {
+		body, err := json.Marshal(alert)
+		if err != nil {
+			return i, fmt.Errorf("failed to serialize budget alert: %w", err)
+		}
+
+		if err := s.mb.SendMessage(config.ResourceBudgetAlerts, body); err != nil {
+			return i, err
+		}
+
+		if err := s.repo.MarkBudgetAlerted(ctx, alert.BudgetID, now, alert.Threshold); err != nil {
+			return i, err
+		}
+	}
+
+	return len(alerts), nil
+}
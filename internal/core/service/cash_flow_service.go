@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+
+	"github.com/google/uuid"
+)
+
+type cashFlowService struct {
+	repo repository.Repository
+}
+
+func NewCashFlowService(repo repository.Repository) inbound.CashFlowService {
+	return &cashFlowService{repo: repo}
+}
+
+// CashFlowForecast projeta os saldos a partir de hoje. Sem filtros, prevê 90 dias com a média
+// de gastos dos 90 dias anteriores.
+func (s *cashFlowService) CashFlowForecast(ctx context.Context, ledgerID uuid.UUID, flt dto.CashFlowFilters) (*dto.CashFlowForecastResponse, error) {
+	days := flt.Days
+	if days == 0 {
+		days = domain.CashFlowDefaultDays
+	}
+	if days < 1 || days > domain.CashFlowMaxDays {
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", appError.ErrBadRequest, domain.CashFlowMaxDays)
+	}
+
+	lookback := flt.LookbackDays
+	if lookback == 0 {
+		lookback = domain.CashFlowDefaultLookback
+	}
+	if lookback < 1 || lookback > domain.CashFlowMaxDays {
+		return nil, fmt.Errorf("%w: lookback_days must be between 1 and %d", appError.ErrBadRequest, domain.CashFlowMaxDays)
+	}
+
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	return s.repo.CashFlowForecast(ctx, ledgerID, start, start.AddDate(0, 0, days-1), lookback)
+}
@@ -19,32 +19,40 @@ type categoryService struct {
 func NewCategoryService(repo repository.Repository) inbound.CategoryService {
 	return &categoryService{repo: repo}
 }
-func (s *categoryService) GetCategoryByID(ctx context.Context, id uuid.UUID) (*dto.CategoryResponse, error) {
-	return s.repo.GetCategoryByID(ctx, id)
+func (s *categoryService) GetCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.CategoryResponse, error) {
+	return s.repo.GetCategoryByID(ctx, ledgerID, id)
 }
 
-func (s *categoryService) CreateCategory(ctx context.Context, input domain.Category) (*dto.CategoryResponse, error) {
-	return s.repo.CreateCategory(ctx, input)
+func (s *categoryService) CreateCategory(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Category) (*dto.CategoryResponse, error) {
+	return s.repo.CreateCategory(ctx, ledgerID, createdByID, input)
 }
 
-func (s *categoryService) UpdateCategory(ctx context.Context, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error) {
-	return s.repo.UpdateCategory(ctx, id, input)
+func (s *categoryService) UpdateCategory(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Category) (*dto.CategoryResponse, error) {
+	return s.repo.UpdateCategory(ctx, ledgerID, id, input)
 }
 
-func (s *categoryService) DeleteCategoryByID(ctx context.Context, id uuid.UUID) error {
-	return s.repo.DeleteCategoryByID(ctx, id)
+func (s *categoryService) DeleteCategoryByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteCategoryByID(ctx, ledgerID, id)
 }
 
-func (s *categoryService) ListCategories(ctx context.Context, pgn *pagination.Pagination) ([]dto.CategoryResponse, int, error) {
-	data, err := s.repo.ListCategories(ctx, pgn)
+func (s *categoryService) ListCategories(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.CategoryResponse, int, error) {
+	data, err := s.repo.ListCategories(ctx, ledgerID, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.repo.CountCategories(ctx, pgn)
+	total, err := s.repo.CountCategories(ctx, ledgerID, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	return data, total, nil
 }
+
+func (s *categoryService) ListCategoryTree(ctx context.Context, ledgerID uuid.UUID) ([]*dto.CategoryResponse, error) {
+	return s.repo.ListCategoryTree(ctx, ledgerID)
+}
+
+func (s *categoryService) SuggestCategory(ctx context.Context, ledgerID uuid.UUID, flt dto.CategorySuggestFilters) (*dto.CategorySuggestionResponse, error) {
+	return s.repo.SuggestCategory(ctx, ledgerID, flt)
+}
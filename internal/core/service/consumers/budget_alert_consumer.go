@@ -0,0 +1,54 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+	"time"
+)
+
+// BudgetAlertConsumer transforma os alertas da fila de orçamentos em avisos e já tenta
+// entregá-los, respeitando o horário de silêncio de cada usuário.
+type BudgetAlertConsumer struct {
+	service inbound.NotificationService
+	log     *logger.Logger
+}
+
+func NewBudgetAlertConsumer(service inbound.NotificationService) *BudgetAlertConsumer {
+	return &BudgetAlertConsumer{
+		service: service,
+		log:     logger.NewLogger("BudgetAlertConsumer"),
+	}
+}
+
+func (c *BudgetAlertConsumer) ProcessMessage(
+	timeoutSeconds int,
+	messageBody []byte,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	var event dto.BudgetAlertEvent
+	if err := json.Unmarshal(messageBody, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal BudgetAlertEvent: %w", err)
+	}
+
+	created, err := c.service.NotifyBudgetAlert(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue budget alert: %w", err)
+	}
+	if created == 0 {
+		c.log.Info("Budget alert %s %d%%: nothing new to notify", event.BudgetID, event.Threshold)
+		return nil
+	}
+
+	sent, failed, err := c.service.DeliverNotifications(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to deliver notifications: %w", err)
+	}
+	c.log.Info("Budget alert %s %d%%: %d notification(s) sent, %d failed", event.BudgetID, event.Threshold, sent, failed)
+	return nil
+}
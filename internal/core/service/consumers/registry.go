@@ -12,7 +12,12 @@ type ConsumerFactory func(*bootstrap.WorkerDeps) inbound.Consumer
 var Registry = map[string]ConsumerFactory{
 	config.ResourceTransactions: func(b *bootstrap.WorkerDeps) inbound.Consumer {
 		txnService := service.NewTransactionService(b.Repo)
-		consumer := NewTransactionConsumer(txnService, b.Cfg)
+		notificationService := service.NewNotificationService(b.Repo, b.Channels)
+		consumer := NewTransactionConsumer(txnService, notificationService, b.Cfg)
 		return consumer
 	},
+	config.ResourceBudgetAlerts: func(b *bootstrap.WorkerDeps) inbound.Consumer {
+		notificationService := service.NewNotificationService(b.Repo, b.Channels)
+		return NewBudgetAlertConsumer(notificationService)
+	},
 }
@@ -3,14 +3,19 @@ package consumers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
 	"frog-go/internal/core/ports/inbound"
 	"frog-go/internal/utils"
 	"frog-go/internal/utils/logger"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type Message struct {
@@ -19,19 +24,22 @@ type Message struct {
 }
 
 type TransactionConsumer struct {
-	service inbound.TransactionService
-	cfg     *config.ConfigConsumer
-	log     *logger.Logger
+	service       inbound.TransactionService
+	notifications inbound.NotificationService
+	cfg           *config.ConfigConsumer
+	log           *logger.Logger
 }
 
 func NewTransactionConsumer(
 	service inbound.TransactionService,
+	notifications inbound.NotificationService,
 	cfg *config.ConfigConsumer,
 ) *TransactionConsumer {
 	return &TransactionConsumer{
-		service: service,
-		cfg:     cfg,
-		log:     logger.NewLogger("TransactionConsumer"),
+		service:       service,
+		notifications: notifications,
+		cfg:           cfg,
+		log:           logger.NewLogger("TransactionConsumer"),
 	}
 }
 
@@ -47,12 +55,26 @@ func (c *TransactionConsumer) ProcessMessage(
 		return fmt.Errorf("failed to unmarshal ImportTxnMessage: %w", err)
 	}
 
+	ledgerID, err := utils.ToUUID(msg.LedgerID)
+	if err != nil {
+		return fmt.Errorf("invalid ledger ID: %w", err)
+	}
+
 	userID, err := utils.ToUUID(msg.UserID)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %w", err)
 	}
 
 	c.log.Info("Processing message: %+v", msg)
+	err = c.process(ctx, ledgerID, userID, msg)
+
+	if msg.Total > 0 {
+		c.recordProgress(timeoutSeconds, userID, msg, err != nil)
+	}
+	return err
+}
+
+func (c *TransactionConsumer) process(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID, msg dto.ImportTxnMessage) error {
 	switch msg.Action {
 	case config.ActionCreate:
 		input, err := msg.Data.Transaction.ToDomain()
@@ -65,7 +87,11 @@ func (c *TransactionConsumer) ProcessMessage(
 			return nil
 		}
 
-		if _, err := c.service.CreateTransaction(ctx, userID, *input); err != nil {
+		if _, err := c.service.CreateTransaction(ctx, ledgerID, userID, *input); err != nil {
+			if errors.Is(err, appError.ErrTransactionSkipped) {
+				c.log.Info("Skipping title by rule: %s", input.Title)
+				return nil
+			}
 			return fmt.Errorf("failed to create transaction: %w", err)
 		}
 
@@ -76,6 +102,40 @@ func (c *TransactionConsumer) ProcessMessage(
 	return nil
 }
 
+// recordProgress conta a linha na importação e, quando ela conclui a importação, avisa o
+// usuário; o aviso tem a chave do job, então não se repete. Usa um contexto próprio para que
+// uma linha que estourou o tempo ainda seja contada. Falhas aqui só vão para o log: a linha
+// em si já foi processada.
+func (c *TransactionConsumer) recordProgress(timeoutSeconds int, userID uuid.UUID, msg dto.ImportTxnMessage, failed bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	jobID, err := utils.ToUUID(msg.JobID)
+	if err != nil {
+		c.log.Error("invalid job ID %q: %v", msg.JobID, err)
+		return
+	}
+
+	job, err := c.service.RecordImportProgress(ctx, domain.ImportJob{
+		ID:       jobID,
+		UserID:   userID,
+		Filename: msg.Filename,
+		Total:    msg.Total,
+	}, failed)
+	if err != nil {
+		c.log.Error("failed to record progress of import %s: %v", jobID, err)
+		return
+	}
+
+	if !job.Completed() {
+		return
+	}
+
+	if _, err := c.notifications.Notify(ctx, []domain.Reminder{domain.NewImportCompletedReminder(*job)}); err != nil {
+		c.log.Error("failed to notify completion of import %s: %v", jobID, err)
+	}
+}
+
 func (c *TransactionConsumer) shouldSkipTitle(title string) bool {
 	for _, skip := range c.cfg.SkipTitles {
 		if strings.EqualFold(skip, title) {
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+
+	"github.com/google/uuid"
+)
+
+type debtPlanService struct {
+	repo repository.Repository
+}
+
+func NewDebtPlanService(repo repository.Repository) inbound.DebtPlanService {
+	return &debtPlanService{repo: repo}
+}
+
+// PlanDebts simula a quitação das dívidas em aberto em cada estratégia do plano.
+func (s *debtPlanService) PlanDebts(ctx context.Context, ledgerID uuid.UUID, input domain.DebtPlan) (*dto.DebtPlanResponse, error) {
+	currency, err := s.repo.GetLedgerBaseCurrency(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	debts, err := s.repo.ListDebts(ctx, ledgerID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	debts = input.ApplyCardTerms(debts)
+
+	if _, err := input.Order(debts, domain.DebtCustom); err != nil {
+		return nil, fmt.Errorf("%w: %v", appError.ErrBadRequest, err)
+	}
+
+	results := []domain.DebtPlanResult{}
+	for _, strategy := range input.Strategies() {
+		result, err := input.Simulate(debts, strategy)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *result)
+	}
+
+	return dto.NewDebtPlanResponse(currency, input, debts, results), nil
+}
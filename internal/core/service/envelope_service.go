@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type envelopeService struct {
+	repo repository.Repository
+}
+
+func NewEnvelopeService(repo repository.Repository) inbound.EnvelopeService {
+	return &envelopeService{repo: repo}
+}
+
+// EnvelopesMonth mostra os envelopes do mês informado ou, sem mês, do mês atual.
+func (s *envelopeService) EnvelopesMonth(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeFilters) (*dto.EnvelopeMonthResponse, error) {
+	month := time.Now().UTC()
+	if flt.Month != "" {
+		value, err := dto.ParseBudgetMonth(flt.Month)
+		if err != nil {
+			return nil, fmt.Errorf("%w: month: %v", appError.ErrBadRequest, err)
+		}
+		month = value
+	}
+
+	return s.repo.EnvelopesMonth(ctx, ledgerID, month)
+}
+
+func (s *envelopeService) CreateEnvelopeAllocation(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeAllocation) (*dto.EnvelopeAllocationResponse, error) {
+	return s.repo.CreateEnvelopeAllocation(ctx, ledgerID, input)
+}
+
+func (s *envelopeService) MoveEnvelopeMoney(ctx context.Context, ledgerID uuid.UUID, input domain.EnvelopeMove) ([]dto.EnvelopeAllocationResponse, error) {
+	return s.repo.MoveEnvelopeMoney(ctx, ledgerID, input)
+}
+
+func (s *envelopeService) DeleteEnvelopeAllocationByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteEnvelopeAllocationByID(ctx, ledgerID, id)
+}
+
+func (s *envelopeService) ListEnvelopeAllocations(ctx context.Context, ledgerID uuid.UUID, flt dto.EnvelopeAllocationFilters, pgn *pagination.Pagination) ([]dto.EnvelopeAllocationResponse, int, error) {
+	data, err := s.repo.ListEnvelopeAllocations(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountEnvelopeAllocations(ctx, ledgerID, flt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
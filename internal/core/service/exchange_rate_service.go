@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+// exchangeRateColumns são as colunas obrigatórias do CSV de cotações, em qualquer ordem.
+var exchangeRateColumns = []string{"rate_date", "from_currency", "to_currency", "rate"}
+
+type exchangeRateService struct {
+	repo repository.Repository
+}
+
+func NewExchangeRateService(repo repository.Repository) inbound.ExchangeRateService {
+	return &exchangeRateService{repo: repo}
+}
+
+func (s *exchangeRateService) UpsertExchangeRate(ctx context.Context, ledgerID uuid.UUID, input domain.ExchangeRate) (*dto.ExchangeRateResponse, error) {
+	data, err := s.repo.UpsertExchangeRates(ctx, ledgerID, []domain.ExchangeRate{input})
+	if err != nil {
+		return nil, err
+	}
+	return &data[0], nil
+}
+
+// ImportExchangeRates lê um CSV com cabeçalho rate_date, from_currency, to_currency e rate.
+// Nenhuma cotação é gravada se alguma linha for inválida.
+func (s *exchangeRateService) ImportExchangeRates(ctx context.Context, ledgerID uuid.UUID, file io.Reader) (int, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to read header: %v", appError.ErrBadRequest, err)
+	}
+
+	index := map[string]int{}
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range exchangeRateColumns {
+		if _, ok := index[column]; !ok {
+			return 0, fmt.Errorf("%w: missing column %q", appError.ErrBadRequest, column)
+		}
+	}
+
+	rates := []domain.ExchangeRate{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%w: line %d: %v", appError.ErrBadRequest, line, err)
+		}
+
+		rate, err := parseExchangeRate(record, index)
+		if err != nil {
+			return 0, fmt.Errorf("%w: line %d: %v", appError.ErrBadRequest, line, err)
+		}
+		rates = append(rates, *rate)
+	}
+
+	if len(rates) == 0 {
+		return 0, fmt.Errorf("%w: no rates found", appError.ErrBadRequest)
+	}
+
+	data, err := s.repo.UpsertExchangeRates(ctx, ledgerID, rates)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (s *exchangeRateService) DeleteExchangeRateByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteExchangeRateByID(ctx, ledgerID, id)
+}
+
+func (s *exchangeRateService) ListExchangeRates(ctx context.Context, ledgerID uuid.UUID, flt dto.ExchangeRateFilters, pgn *pagination.Pagination) ([]dto.ExchangeRateResponse, int, error) {
+	data, err := s.repo.ListExchangeRates(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountExchangeRates(ctx, ledgerID, flt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+func parseExchangeRate(record []string, index map[string]int) (*domain.ExchangeRate, error) {
+	value := func(column string) string {
+		if i := index[column]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	rateDate, err := utils.ToDateTime(value("rate_date"))
+	if err != nil {
+		return nil, appError.InvalidParam("rate_date", err)
+	}
+
+	// Aceita a vírgula como separador decimal, comum nas planilhas em português
+	rate, err := strconv.ParseFloat(strings.ReplaceAll(value("rate"), ",", "."), 64)
+	if err != nil {
+		return nil, appError.InvalidParam("rate", err)
+	}
+
+	return domain.NewExchangeRate(value("from_currency"), value("to_currency"), rate, rateDate)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type goalService struct {
+	repo repository.Repository
+}
+
+func NewGoalService(repo repository.Repository) inbound.GoalService {
+	return &goalService{repo: repo}
+}
+
+func (s *goalService) GetGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.GoalResponse, error) {
+	return s.repo.GetGoalByID(ctx, ledgerID, id)
+}
+
+func (s *goalService) CreateGoal(ctx context.Context, ledgerID uuid.UUID, input domain.Goal) (*dto.GoalResponse, error) {
+	return s.repo.CreateGoal(ctx, ledgerID, input)
+}
+
+func (s *goalService) UpdateGoal(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Goal) (*dto.GoalResponse, error) {
+	return s.repo.UpdateGoal(ctx, ledgerID, id, input)
+}
+
+func (s *goalService) DeleteGoalByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteGoalByID(ctx, ledgerID, id)
+}
+
+func (s *goalService) ListGoals(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.GoalResponse, int, error) {
+	data, err := s.repo.ListGoals(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountGoals(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+func (s *goalService) GoalProgress(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.GoalProgressResponse, error) {
+	return s.repo.GoalProgress(ctx, ledgerID, id, time.Now().UTC())
+}
+
+func (s *goalService) GoalsProgress(ctx context.Context, ledgerID uuid.UUID) ([]dto.GoalProgressResponse, error) {
+	return s.repo.GoalsProgress(ctx, ledgerID, time.Now().UTC())
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+// investmentQuoteColumns são as colunas obrigatórias do CSV de cotações de investimentos, em
+// qualquer ordem.
+var investmentQuoteColumns = []string{"ticker", "quote_date", "price"}
+
+type investmentService struct {
+	repo repository.Repository
+}
+
+func NewInvestmentService(repo repository.Repository) inbound.InvestmentService {
+	return &investmentService{repo: repo}
+}
+
+func (s *investmentService) GetHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.HoldingResponse, error) {
+	return s.repo.GetHoldingByID(ctx, ledgerID, id)
+}
+
+func (s *investmentService) CreateHolding(ctx context.Context, ledgerID uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error) {
+	return s.repo.CreateHolding(ctx, ledgerID, input)
+}
+
+func (s *investmentService) UpdateHolding(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Holding) (*dto.HoldingResponse, error) {
+	return s.repo.UpdateHolding(ctx, ledgerID, id, input)
+}
+
+func (s *investmentService) DeleteHoldingByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteHoldingByID(ctx, ledgerID, id)
+}
+
+func (s *investmentService) ListHoldings(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.HoldingResponse, int, error) {
+	data, err := s.repo.ListHoldings(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountHoldings(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+func (s *investmentService) CreateInvestmentTrade(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentTrade) (*dto.InvestmentTradeResponse, error) {
+	return s.repo.CreateInvestmentTrade(ctx, ledgerID, input)
+}
+
+func (s *investmentService) ListInvestmentTrades(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentTradeResponse, error) {
+	return s.repo.ListInvestmentTrades(ctx, ledgerID, holdingID)
+}
+
+func (s *investmentService) DeleteInvestmentTradeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteInvestmentTradeByID(ctx, ledgerID, holdingID, id)
+}
+
+func (s *investmentService) UpsertInvestmentQuote(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentQuote) (*dto.InvestmentQuoteResponse, error) {
+	data, err := s.repo.UpsertInvestmentQuotes(ctx, ledgerID, []domain.InvestmentQuote{input})
+	if err != nil {
+		return nil, err
+	}
+	return &data[0], nil
+}
+
+// ImportInvestmentQuotes lê um CSV com cabeçalho ticker, quote_date e price. Nenhuma cotação
+// é gravada se alguma linha for inválida ou citar um ticker que não está na carteira.
+func (s *investmentService) ImportInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, file io.Reader) (int, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to read header: %v", appError.ErrBadRequest, err)
+	}
+
+	index := map[string]int{}
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range investmentQuoteColumns {
+		if _, ok := index[column]; !ok {
+			return 0, fmt.Errorf("%w: missing column %q", appError.ErrBadRequest, column)
+		}
+	}
+
+	quotes := []domain.InvestmentQuote{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%w: line %d: %v", appError.ErrBadRequest, line, err)
+		}
+
+		quote, err := parseInvestmentQuote(record, index)
+		if err != nil {
+			return 0, fmt.Errorf("%w: line %d: %v", appError.ErrBadRequest, line, err)
+		}
+		quotes = append(quotes, *quote)
+	}
+
+	if len(quotes) == 0 {
+		return 0, fmt.Errorf("%w: no quotes found", appError.ErrBadRequest)
+	}
+
+	data, err := s.repo.UpsertInvestmentQuotes(ctx, ledgerID, quotes)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (s *investmentService) ListInvestmentQuotes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentQuoteResponse, error) {
+	return s.repo.ListInvestmentQuotes(ctx, ledgerID, holdingID)
+}
+
+func (s *investmentService) DeleteInvestmentQuoteByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteInvestmentQuoteByID(ctx, ledgerID, holdingID, id)
+}
+
+func (s *investmentService) CreateInvestmentIncome(ctx context.Context, ledgerID uuid.UUID, input domain.InvestmentIncome) (*dto.InvestmentIncomeResponse, error) {
+	return s.repo.CreateInvestmentIncome(ctx, ledgerID, input)
+}
+
+func (s *investmentService) ListInvestmentIncomes(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID) ([]dto.InvestmentIncomeResponse, error) {
+	return s.repo.ListInvestmentIncomes(ctx, ledgerID, holdingID)
+}
+
+func (s *investmentService) DeleteInvestmentIncomeByID(ctx context.Context, ledgerID uuid.UUID, holdingID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteInvestmentIncomeByID(ctx, ledgerID, holdingID, id)
+}
+
+func (s *investmentService) Portfolio(ctx context.Context, ledgerID uuid.UUID) (*dto.PortfolioResponse, error) {
+	return s.repo.Portfolio(ctx, ledgerID)
+}
+
+func parseInvestmentQuote(record []string, index map[string]int) (*domain.InvestmentQuote, error) {
+	value := func(column string) string {
+		if i := index[column]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	quoteDate, err := utils.ToDateTime(value("quote_date"))
+	if err != nil {
+		return nil, appError.InvalidParam("quote_date", err)
+	}
+
+	price, err := domain.ParseMoney(value("price"))
+	if err != nil {
+		return nil, appError.InvalidParam("price", err)
+	}
+
+	return domain.NewInvestmentQuote(nil, value("ticker"), quoteDate, price)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
@@ -20,29 +21,29 @@ func NewInvoiceService(repo repository.Repository) inbound.InvoiceService {
 	return &invoiceService{repo: repo}
 }
 
-func (s *invoiceService) GetInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error) {
-	return s.repo.GetInvoiceByID(ctx, userID, id)
+func (s *invoiceService) GetInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.InvoiceResponse, error) {
+	return s.repo.GetInvoiceByID(ctx, ledgerID, id)
 }
 
-func (s *invoiceService) CreateInvoice(ctx context.Context, userID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
-	return s.repo.CreateInvoice(ctx, userID, input)
+func (s *invoiceService) CreateInvoice(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
+	return s.repo.CreateInvoice(ctx, ledgerID, createdByID, input)
 }
 
-func (s *invoiceService) UpdateInvoice(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
-	return s.repo.UpdateInvoice(ctx, userID, id, input)
+func (s *invoiceService) UpdateInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Invoice) (*dto.InvoiceResponse, error) {
+	return s.repo.UpdateInvoice(ctx, ledgerID, id, input)
 }
 
-func (s *invoiceService) DeleteInvoiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
-	return s.repo.DeleteInvoiceByID(ctx, userID, id)
+func (s *invoiceService) DeleteInvoiceByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteInvoiceByID(ctx, ledgerID, id)
 }
 
-func (s *invoiceService) ListInvoices(ctx context.Context, userID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error) {
-	data, err := s.repo.ListInvoices(ctx, userID, flt, pgn)
+func (s *invoiceService) ListInvoices(ctx context.Context, ledgerID uuid.UUID, flt dto.InvoiceFilters, pgn *pagination.Pagination) ([]dto.InvoiceResponse, int, error) {
+	data, err := s.repo.ListInvoices(ctx, ledgerID, flt, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.repo.CountInvoices(ctx, userID, flt, pgn)
+	total, err := s.repo.CountInvoices(ctx, ledgerID, flt, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -50,18 +51,42 @@ func (s *invoiceService) ListInvoices(ctx context.Context, userID uuid.UUID, flt
 	return data, total, nil
 }
 
-func (s *invoiceService) ListInvoiceDebts(ctx context.Context, userID uuid.UUID, id uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error) {
+func (s *invoiceService) ListInvoiceDebts(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error) {
 	flt.InvoiceIDs = &[]string{id.String()}
 
-	data, err := s.repo.ListTransactions(ctx, userID, flt, pgn)
+	data, err := s.repo.ListTransactions(ctx, ledgerID, flt, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.repo.CountTransactions(ctx, userID, flt, pgn)
+	total, err := s.repo.CountTransactions(ctx, ledgerID, flt, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	return data, total, nil
 }
+
+func (s *invoiceService) CloseInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoiceClosing) (*dto.InvoiceResponse, error) {
+	return s.repo.CloseInvoice(ctx, ledgerID, id, input)
+}
+
+func (s *invoiceService) PayInvoice(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.InvoicePayment) (*dto.InvoiceResponse, error) {
+	return s.repo.PayInvoice(ctx, ledgerID, id, input)
+}
+
+func (s *invoiceService) MarkOverdueInvoices(ctx context.Context) ([]domain.Reminder, error) {
+	return s.repo.MarkOverdueInvoices(ctx, time.Now())
+}
+
+func (s *invoiceService) ListInvoicePayments(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID) ([]dto.InvoicePaymentResponse, error) {
+	return s.repo.ListInvoicePayments(ctx, ledgerID, invoiceID)
+}
+
+func (s *invoiceService) CreateInvoicePayment(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, input domain.InvoicePayment) (*dto.InvoicePaymentResponse, error) {
+	return s.repo.CreateInvoicePayment(ctx, ledgerID, invoiceID, input)
+}
+
+func (s *invoiceService) DeleteInvoicePaymentByID(ctx context.Context, ledgerID uuid.UUID, invoiceID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteInvoicePaymentByID(ctx, ledgerID, invoiceID, id)
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+)
+
+// AnomalyJob avisa os donos dos livros das despesas recentes fora do padrão da categoria.
+type AnomalyJob struct {
+	notifications inbound.NotificationService
+	log           *logger.Logger
+}
+
+func NewAnomalyJob(notifications inbound.NotificationService) *AnomalyJob {
+	return &AnomalyJob{
+		notifications: notifications,
+		log:           logger.NewLogger("AnomalyJob"),
+	}
+}
+
+func (j *AnomalyJob) Run(ctx context.Context) error {
+	total, err := j.notifications.NotifySpendingAnomalies(ctx)
+	if total > 0 {
+		j.log.Info("%d spending anomaly notification(s) queued", total)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to notify spending anomalies: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+)
+
+// BudgetAlertJob avisa quando o gasto de um orçamento no mês atinge 80% ou 100%.
+type BudgetAlertJob struct {
+	service inbound.BudgetService
+	log     *logger.Logger
+}
+
+func NewBudgetAlertJob(service inbound.BudgetService) *BudgetAlertJob {
+	return &BudgetAlertJob{
+		service: service,
+		log:     logger.NewLogger("BudgetAlertJob"),
+	}
+}
+
+func (j *BudgetAlertJob) Run(ctx context.Context) error {
+	total, err := j.service.PublishBudgetAlerts(ctx)
+	if total > 0 {
+		j.log.Info("%d budget alert(s) published", total)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to publish budget alerts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+)
+
+// InvoiceOverdueJob marca como vencidas as faturas não pagas cujo vencimento já passou e
+// avisa os donos de cada uma.
+type InvoiceOverdueJob struct {
+	service       inbound.InvoiceService
+	notifications inbound.NotificationService
+	log           *logger.Logger
+}
+
+func NewInvoiceOverdueJob(service inbound.InvoiceService, notifications inbound.NotificationService) *InvoiceOverdueJob {
+	return &InvoiceOverdueJob{
+		service:       service,
+		notifications: notifications,
+		log:           logger.NewLogger("InvoiceOverdueJob"),
+	}
+}
+
+func (j *InvoiceOverdueJob) Run(ctx context.Context) error {
+	reminders, err := j.service.MarkOverdueInvoices(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark overdue invoices: %w", err)
+	}
+
+	if len(reminders) == 0 {
+		return nil
+	}
+	j.log.Info("%d invoice(s) marked as overdue", len(reminders))
+
+	if _, err := j.notifications.Notify(ctx, reminders); err != nil {
+		return fmt.Errorf("failed to notify overdue invoices: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+)
+
+// NotificationJob registra os lembretes de faturas e transações que vencem dentro da
+// antecedência de cada usuário e envia as entregas pendentes.
+type NotificationJob struct {
+	service inbound.NotificationService
+	log     *logger.Logger
+}
+
+func NewNotificationJob(service inbound.NotificationService) *NotificationJob {
+	return &NotificationJob{
+		service: service,
+		log:     logger.NewLogger("NotificationJob"),
+	}
+}
+
+func (j *NotificationJob) Run(ctx context.Context) error {
+	scheduled, err := j.service.ScheduleReminders(ctx)
+	if scheduled > 0 {
+		j.log.Info("%d reminder delivery(ies) scheduled", scheduled)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to schedule reminders: %w", err)
+	}
+
+	sent, failed, err := j.service.DeliverNotifications(ctx)
+	if sent > 0 || failed > 0 {
+		j.log.Info("%d notification(s) sent, %d failed", sent, failed)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to deliver notifications: %w", err)
+	}
+	return nil
+}
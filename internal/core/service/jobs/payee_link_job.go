@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+)
+
+// PayeeLinkJob vincula a um favorecido as transações que ainda não possuem um.
+type PayeeLinkJob struct {
+	service inbound.PayeeService
+	log     *logger.Logger
+}
+
+func NewPayeeLinkJob(service inbound.PayeeService) *PayeeLinkJob {
+	return &PayeeLinkJob{
+		service: service,
+		log:     logger.NewLogger("PayeeLinkJob"),
+	}
+}
+
+func (j *PayeeLinkJob) Run(ctx context.Context) error {
+	total, err := j.service.LinkMissingPayees(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to link payees: %w", err)
+	}
+
+	if total > 0 {
+		j.log.Info("%d transaction(s) linked to payees", total)
+	}
+	return nil
+}
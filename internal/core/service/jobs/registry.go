@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"frog-go/internal/config"
+	"frog-go/internal/config/bootstrap"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/service"
+)
+
+type JobFactory func(*bootstrap.WorkerDeps) inbound.Job
+
+var Registry = map[string]JobFactory{
+	config.JobInvoicesOverdue: func(b *bootstrap.WorkerDeps) inbound.Job {
+		invoiceService := service.NewInvoiceService(b.Repo)
+		notificationService := service.NewNotificationService(b.Repo, b.Channels)
+		return NewInvoiceOverdueJob(invoiceService, notificationService)
+	},
+	config.JobPayeesLink: func(b *bootstrap.WorkerDeps) inbound.Job {
+		payeeService := service.NewPayeeService(b.Repo)
+		return NewPayeeLinkJob(payeeService)
+	},
+	config.JobBudgetsAlerts: func(b *bootstrap.WorkerDeps) inbound.Job {
+		budgetService := service.NewBudgetService(b.Repo, b.Mbus)
+		return NewBudgetAlertJob(budgetService)
+	},
+	config.JobNotificationsSend: func(b *bootstrap.WorkerDeps) inbound.Job {
+		notificationService := service.NewNotificationService(b.Repo, b.Channels)
+		return NewNotificationJob(notificationService)
+	},
+	config.JobAnomaliesDetect: func(b *bootstrap.WorkerDeps) inbound.Job {
+		notificationService := service.NewNotificationService(b.Repo, b.Channels)
+		return NewAnomalyJob(notificationService)
+	},
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+
+	"github.com/google/uuid"
+)
+
+type ledgerService struct {
+	repo repository.Repository
+}
+
+func NewLedgerService(repo repository.Repository) inbound.LedgerService {
+	return &ledgerService{repo: repo}
+}
+
+// ResolveLedger confere se o usuário é membro do livro e retorna o papel dele; sem livro
+// informado, retorna o livro pessoal. Não membros recebem ErrNotFound, para não revelar
+// livros alheios.
+func (s *ledgerService) ResolveLedger(ctx context.Context, userID uuid.UUID, ledgerID *uuid.UUID) (*domain.LedgerAccess, error) {
+	if ledgerID == nil {
+		return s.repo.GetPersonalLedgerAccess(ctx, userID)
+	}
+	return s.repo.GetLedgerAccess(ctx, userID, *ledgerID)
+}
+
+func (s *ledgerService) CreateLedger(ctx context.Context, userID uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error) {
+	return s.repo.CreateLedger(ctx, userID, input)
+}
+
+func (s *ledgerService) UpdateLedger(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Ledger) (*dto.LedgerResponse, error) {
+	if err := s.requireManager(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateLedger(ctx, userID, id, input)
+}
+
+func (s *ledgerService) ListLedgers(ctx context.Context, userID uuid.UUID) ([]dto.LedgerResponse, error) {
+	return s.repo.ListLedgers(ctx, userID)
+}
+
+func (s *ledgerService) ListLedgerMembers(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) ([]dto.LedgerMemberResponse, error) {
+	if _, err := s.repo.GetLedgerAccess(ctx, userID, ledgerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListLedgerMembers(ctx, ledgerID)
+}
+
+// UpdateLedgerMember troca o papel de um membro. O papel owner não pode ser dado: o livro tem
+// um único dono.
+func (s *ledgerService) UpdateLedgerMember(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, memberID uuid.UUID, role domain.LedgerRole) (*dto.LedgerMemberResponse, error) {
+	if !role.IsAssignable() {
+		return nil, appError.InvalidParam("role", appError.ErrLedgerOwnerRole)
+	}
+	if err := s.requireManager(ctx, userID, ledgerID); err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateLedgerMemberRole(ctx, ledgerID, memberID, role)
+}
+
+// DeleteLedgerMember remove um membro do livro. Donos removem qualquer membro e os demais
+// só podem sair do livro.
+func (s *ledgerService) DeleteLedgerMember(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, memberID uuid.UUID) error {
+	if userID != memberID {
+		if err := s.requireManager(ctx, userID, ledgerID); err != nil {
+			return err
+		}
+	}
+	return s.repo.DeleteLedgerMember(ctx, ledgerID, memberID)
+}
+
+func (s *ledgerService) CreateLedgerInvitation(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, input domain.LedgerInvitation) (*dto.LedgerInvitationResponse, error) {
+	if !input.Role.IsAssignable() {
+		return nil, appError.InvalidParam("role", appError.ErrLedgerOwnerRole)
+	}
+	if err := s.requireManager(ctx, userID, ledgerID); err != nil {
+		return nil, err
+	}
+	return s.repo.CreateLedgerInvitation(ctx, ledgerID, userID, input, time.Now().UTC())
+}
+
+func (s *ledgerService) ListLedgerInvitations(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) ([]dto.LedgerInvitationResponse, error) {
+	if err := s.requireManager(ctx, userID, ledgerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListLedgerInvitations(ctx, ledgerID)
+}
+
+func (s *ledgerService) RevokeLedgerInvitation(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID, id uuid.UUID) error {
+	if err := s.requireManager(ctx, userID, ledgerID); err != nil {
+		return err
+	}
+	return s.repo.RevokeLedgerInvitation(ctx, ledgerID, id, time.Now().UTC())
+}
+
+func (s *ledgerService) ListReceivedLedgerInvitations(ctx context.Context, userID uuid.UUID) ([]dto.LedgerInvitationResponse, error) {
+	return s.repo.ListReceivedLedgerInvitations(ctx, userID, time.Now().UTC())
+}
+
+func (s *ledgerService) RespondLedgerInvitation(ctx context.Context, userID uuid.UUID, id uuid.UUID, accept bool) (*dto.LedgerInvitationResponse, error) {
+	return s.repo.RespondLedgerInvitation(ctx, userID, id, accept, time.Now().UTC())
+}
+
+func (s *ledgerService) requireManager(ctx context.Context, userID uuid.UUID, ledgerID uuid.UUID) error {
+	access, err := s.repo.GetLedgerAccess(ctx, userID, ledgerID)
+	if err != nil {
+		return err
+	}
+	if !access.Role.CanManage() {
+		return appError.ErrForbidden
+	}
+	return nil
+}
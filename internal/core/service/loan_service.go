@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type loanService struct {
+	repo repository.Repository
+}
+
+func NewLoanService(repo repository.Repository) inbound.LoanService {
+	return &loanService{repo: repo}
+}
+
+func (s *loanService) GetLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanResponse, error) {
+	return s.repo.GetLoanByID(ctx, ledgerID, id)
+}
+
+func (s *loanService) CreateLoan(ctx context.Context, ledgerID uuid.UUID, input domain.Loan) (*dto.LoanResponse, error) {
+	return s.repo.CreateLoan(ctx, ledgerID, input)
+}
+
+func (s *loanService) UpdateLoan(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Loan) (*dto.LoanResponse, error) {
+	return s.repo.UpdateLoan(ctx, ledgerID, id, input)
+}
+
+func (s *loanService) DeleteLoanByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteLoanByID(ctx, ledgerID, id)
+}
+
+func (s *loanService) ListLoans(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.LoanResponse, int, error) {
+	data, err := s.repo.ListLoans(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountLoans(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+// SimulateLoan calcula o cronograma completo sem gravar nada.
+func (s *loanService) SimulateLoan(ctx context.Context, input domain.Loan) (*dto.LoanSimulationResponse, error) {
+	return dto.NewLoanSimulationResponse(input.Schedule(input.Principal, 1, input.TermMonths)), nil
+}
+
+func (s *loanService) LoanSchedule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.LoanScheduleResponse, error) {
+	return s.repo.LoanSchedule(ctx, ledgerID, id)
+}
+
+func (s *loanService) CreateLoanPrepayment(ctx context.Context, ledgerID uuid.UUID, input domain.LoanPrepayment) (*dto.LoanPrepaymentResponse, error) {
+	return s.repo.CreateLoanPrepayment(ctx, ledgerID, input)
+}
+
+func (s *loanService) ListLoanPrepayments(ctx context.Context, ledgerID uuid.UUID, loanID uuid.UUID) ([]dto.LoanPrepaymentResponse, error) {
+	return s.repo.ListLoanPrepayments(ctx, ledgerID, loanID)
+}
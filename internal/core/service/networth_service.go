@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type netWorthService struct {
+	repo repository.Repository
+}
+
+func NewNetWorthService(repo repository.Repository) inbound.NetWorthService {
+	return &netWorthService{repo: repo}
+}
+
+func (s *netWorthService) GetAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.AssetResponse, error) {
+	return s.repo.GetAssetByID(ctx, ledgerID, id)
+}
+
+func (s *netWorthService) CreateAsset(ctx context.Context, ledgerID uuid.UUID, input domain.Asset) (*dto.AssetResponse, error) {
+	return s.repo.CreateAsset(ctx, ledgerID, input)
+}
+
+func (s *netWorthService) UpdateAsset(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Asset) (*dto.AssetResponse, error) {
+	return s.repo.UpdateAsset(ctx, ledgerID, id, input)
+}
+
+func (s *netWorthService) DeleteAssetByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteAssetByID(ctx, ledgerID, id)
+}
+
+func (s *netWorthService) ListAssets(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.AssetResponse, int, error) {
+	data, err := s.repo.ListAssets(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountAssets(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+func (s *netWorthService) UpsertValuation(ctx context.Context, ledgerID uuid.UUID, input domain.Valuation) (*dto.ValuationResponse, error) {
+	return s.repo.UpsertValuation(ctx, ledgerID, input)
+}
+
+func (s *netWorthService) ListValuations(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget) ([]dto.ValuationResponse, error) {
+	return s.repo.ListValuations(ctx, ledgerID, target)
+}
+
+func (s *netWorthService) DeleteValuationByID(ctx context.Context, ledgerID uuid.UUID, target domain.ValuationTarget, id uuid.UUID) error {
+	return s.repo.DeleteValuationByID(ctx, ledgerID, target, id)
+}
+
+// NetWorth monta a série do patrimônio. Sem filtros, mostra os últimos 12 meses mês a mês.
+func (s *netWorthService) NetWorth(ctx context.Context, ledgerID uuid.UUID, flt dto.NetWorthFilters) ([]dto.NetWorthByDate, error) {
+	period := flt.Period
+	if period == "" {
+		period = "monthly"
+	}
+
+	periodTrunc, err := dto.ChartPeriodTrunc(period)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", appError.ErrBadRequest, err)
+	}
+
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if flt.EndDate != "" {
+		end, err = utils.ToDateTime(flt.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w: end_date: %v", appError.ErrBadRequest, err)
+		}
+	}
+
+	start := end.AddDate(-1, 0, 0)
+	if flt.StartDate != "" {
+		start, err = utils.ToDateTime(flt.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w: start_date: %v", appError.ErrBadRequest, err)
+		}
+	}
+
+	if start.After(end) {
+		return nil, fmt.Errorf("%w: start_date must not be after end_date", appError.ErrBadRequest)
+	}
+
+	return s.repo.NetWorth(ctx, ledgerID, periodTrunc, start, end)
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/notifier"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+// notificationBatchSize limita quantas entregas pendentes são tentadas por execução.
+const notificationBatchSize = 500
+
+type notificationService struct {
+	repo     repository.Repository
+	channels notifier.Channels
+}
+
+// NewNotificationService recebe os canais de entrega configurados; na API, que só mexe nas
+// preferências, channels pode ser nil.
+func NewNotificationService(repo repository.Repository, channels notifier.Channels) inbound.NotificationService {
+	return &notificationService{repo: repo, channels: channels}
+}
+
+func (s *notificationService) GetNotificationPreference(ctx context.Context, userID uuid.UUID) (*dto.NotificationPreferenceResponse, error) {
+	preference, err := s.repo.GetNotificationPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewNotificationPreferenceResponse(*preference), nil
+}
+
+// UpdateNotificationPreference salva as preferências. Sem webhook_secret, o segredo atual é
+// mantido enquanto a URL do webhook não muda.
+func (s *notificationService) UpdateNotificationPreference(ctx context.Context, userID uuid.UUID, input domain.NotificationPreference) (*dto.NotificationPreferenceResponse, error) {
+	if input.WebhookSecret == nil && input.WebhookURL != nil {
+		current, err := s.repo.GetNotificationPreference(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if current.WebhookURL != nil && *current.WebhookURL == *input.WebhookURL {
+			input.WebhookSecret = current.WebhookSecret
+		}
+	}
+
+	preference, err := s.repo.UpsertNotificationPreference(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewNotificationPreferenceResponse(*preference), nil
+}
+
+// ScheduleReminders registra as entregas dos lembretes de vencimento. Lembretes já
+// registrados não são repetidos. Retorna quantas entregas novas foram criadas.
+func (s *notificationService) ScheduleReminders(ctx context.Context) (int, error) {
+	reminders, err := s.repo.ListDueReminders(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, reminder := range reminders {
+		created, err := s.repo.EnqueueReminder(ctx, reminder)
+		total += created
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// NotifySpendingAnomalies avisa os donos dos livros das despesas recentes fora do padrão da
+// categoria. Cada despesa é avisada uma única vez. Retorna quantas entregas novas foram criadas.
+func (s *notificationService) NotifySpendingAnomalies(ctx context.Context) (int, error) {
+	reminders, err := s.repo.ListSpendingAnomalies(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	return s.Notify(ctx, reminders)
+}
+
+// NotifyBudgetAlert registra as entregas de um alerta publicado na fila de orçamentos.
+func (s *notificationService) NotifyBudgetAlert(ctx context.Context, event dto.BudgetAlertEvent) (int, error) {
+	reminder := domain.NewBudgetAlertReminder(
+		event.UserID,
+		event.BudgetID,
+		event.Category.Name,
+		event.Month,
+		event.Threshold,
+		event.Budgeted,
+		event.Spent,
+	)
+	return s.repo.EnqueueReminder(ctx, reminder)
+}
+
+// DeliverNotifications reserva e tenta as entregas pendentes; execuções concorrentes (o job,
+// os workers via Notify) não pegam a mesma entrega. E-mail e webhook são adiados para o fim
+// do horário de silêncio do usuário; a caixa de entrada do app recebe na hora. Uma entrega
+// que falha volta a ficar pendente até NotificationMaxAttempts tentativas, e a de um canal
+// desativado depois do registro é descartada. Retorna quantas foram enviadas e quantas falharam.
+func (s *notificationService) DeliverNotifications(ctx context.Context) (int, int, error) {
+	now := time.Now().UTC()
+	deliveries, err := s.repo.ClaimNotificationDeliveries(ctx, now, notificationBatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sent, failed := 0, 0
+	for _, delivery := range deliveries {
+		if !slices.Contains(delivery.Preference.Channels(), delivery.Channel) {
+			if err := s.repo.MarkNotificationFailed(ctx, delivery.ID, "channel disabled by the user", true); err != nil {
+				return sent, failed, err
+			}
+			failed++
+			continue
+		}
+
+		if delivery.Channel != domain.ChannelInApp && delivery.Preference.InQuietHours(now) {
+			if err := s.repo.DeferNotificationDelivery(ctx, delivery.ID, delivery.Preference.QuietHoursEnd(now)); err != nil {
+				return sent, failed, err
+			}
+			continue
+		}
+
+		if err := s.send(ctx, delivery); err != nil {
+			giveUp := delivery.Attempts+1 >= domain.NotificationMaxAttempts
+			if err := s.repo.MarkNotificationFailed(ctx, delivery.ID, err.Error(), giveUp); err != nil {
+				return sent, failed, err
+			}
+			failed++
+			continue
+		}
+
+		if err := s.repo.MarkNotificationDelivered(ctx, delivery.ID, time.Now().UTC()); err != nil {
+			return sent, failed, err
+		}
+		sent++
+	}
+
+	return sent, failed, nil
+}
+
+// Notify registra as entregas dos avisos gerados pelos workers e já tenta entregá-las, para
+// que a caixa de entrada do app receba na hora. Avisos já registrados não são repetidos.
+// Retorna quantas entregas novas foram criadas.
+func (s *notificationService) Notify(ctx context.Context, reminders []domain.Reminder) (int, error) {
+	total := 0
+	for _, reminder := range reminders {
+		created, err := s.repo.EnqueueReminder(ctx, reminder)
+		total += created
+		if err != nil {
+			return total, err
+		}
+	}
+
+	if total > 0 {
+		if _, _, err := s.DeliverNotifications(ctx); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (s *notificationService) ListNotifications(ctx context.Context, userID uuid.UUID, flt dto.NotificationFilters, pgn *pagination.Pagination) ([]dto.NotificationResponse, int, error) {
+	if flt.Type != nil && !domain.NotificationKind(*flt.Type).IsValid() {
+		return nil, 0, fmt.Errorf("%w: invalid notification type: %s", appError.ErrBadRequest, *flt.Type)
+	}
+
+	data, err := s.repo.ListNotifications(ctx, userID, flt, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountNotifications(ctx, userID, flt)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, total, nil
+}
+
+func (s *notificationService) CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (*dto.NotificationUnreadCountResponse, error) {
+	unread := true
+	total, err := s.repo.CountNotifications(ctx, userID, dto.NotificationFilters{Unread: &unread})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.NotificationUnreadCountResponse{Unread: total}, nil
+}
+
+func (s *notificationService) MarkNotificationRead(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.NotificationResponse, error) {
+	return s.repo.MarkNotificationRead(ctx, userID, id, time.Now().UTC())
+}
+
+func (s *notificationService) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) (*dto.NotificationReadAllResponse, error) {
+	total, err := s.repo.MarkAllNotificationsRead(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return &dto.NotificationReadAllResponse{Updated: total}, nil
+}
+
+func (s *notificationService) send(ctx context.Context, delivery domain.NotificationDelivery) error {
+	channel, ok := s.channels[delivery.Channel]
+	if !ok {
+		return fmt.Errorf("channel %s is not configured", delivery.Channel)
+	}
+	return channel.Send(ctx, delivery)
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type payeeService struct {
+	repo repository.Repository
+}
+
+func NewPayeeService(repo repository.Repository) inbound.PayeeService {
+	return &payeeService{repo: repo}
+}
+
+func (s *payeeService) GetPayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, flt dto.PayeeFilters) (*dto.PayeeResponse, error) {
+	return s.repo.GetPayeeByID(ctx, ledgerID, id, flt)
+}
+
+func (s *payeeService) CreatePayee(ctx context.Context, ledgerID uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error) {
+	return s.repo.CreatePayee(ctx, ledgerID, input)
+}
+
+func (s *payeeService) UpdatePayee(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Payee) (*dto.PayeeResponse, error) {
+	return s.repo.UpdatePayee(ctx, ledgerID, id, input)
+}
+
+func (s *payeeService) DeletePayeeByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeletePayeeByID(ctx, ledgerID, id)
+}
+
+func (s *payeeService) ListPayees(ctx context.Context, ledgerID uuid.UUID, flt dto.PayeeFilters, pgn *pagination.Pagination) ([]dto.PayeeResponse, int, error) {
+	data, err := s.repo.ListPayees(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountPayees(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+func (s *payeeService) LinkMissingPayees(ctx context.Context) (int, error) {
+	return s.repo.LinkMissingPayees(ctx)
+}
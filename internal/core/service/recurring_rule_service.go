@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type recurringRuleService struct {
+	repo repository.Repository
+}
+
+func NewRecurringRuleService(repo repository.Repository) inbound.RecurringRuleService {
+	return &recurringRuleService{repo: repo}
+}
+
+func (s *recurringRuleService) GetRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RecurringRuleResponse, error) {
+	return s.repo.GetRecurringRuleByID(ctx, ledgerID, id)
+}
+
+func (s *recurringRuleService) CreateRecurringRule(ctx context.Context, ledgerID uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error) {
+	return s.repo.CreateRecurringRule(ctx, ledgerID, input)
+}
+
+func (s *recurringRuleService) UpdateRecurringRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.RecurringRule) (*dto.RecurringRuleResponse, error) {
+	return s.repo.UpdateRecurringRule(ctx, ledgerID, id, input)
+}
+
+func (s *recurringRuleService) DeleteRecurringRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteRecurringRuleByID(ctx, ledgerID, id)
+}
+
+func (s *recurringRuleService) ListRecurringRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RecurringRuleResponse, int, error) {
+	data, err := s.repo.ListRecurringRules(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountRecurringRules(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type ruleService struct {
+	repo repository.Repository
+}
+
+func NewRuleService(repo repository.Repository) inbound.RuleService {
+	return &ruleService{repo: repo}
+}
+
+func (s *ruleService) GetRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.RuleResponse, error) {
+	return s.repo.GetRuleByID(ctx, ledgerID, id)
+}
+
+func (s *ruleService) CreateRule(ctx context.Context, ledgerID uuid.UUID, input domain.Rule) (*dto.RuleResponse, error) {
+	return s.repo.CreateRule(ctx, ledgerID, input)
+}
+
+func (s *ruleService) UpdateRule(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Rule) (*dto.RuleResponse, error) {
+	return s.repo.UpdateRule(ctx, ledgerID, id, input)
+}
+
+func (s *ruleService) DeleteRuleByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteRuleByID(ctx, ledgerID, id)
+}
+
+func (s *ruleService) ListRules(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.RuleResponse, int, error) {
+	data, err := s.repo.ListRules(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountRules(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
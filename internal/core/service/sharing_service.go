@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type sharingService struct {
+	repo repository.Repository
+}
+
+func NewSharingService(repo repository.Repository) inbound.SharingService {
+	return &sharingService{repo: repo}
+}
+
+func (s *sharingService) SetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.ExpenseSharing) (*dto.TransactionParticipantsResponse, error) {
+	return s.repo.SetTransactionParticipants(ctx, ledgerID, id, input)
+}
+
+func (s *sharingService) GetTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionParticipantsResponse, error) {
+	return s.repo.GetTransactionParticipants(ctx, ledgerID, id)
+}
+
+func (s *sharingService) DeleteTransactionParticipants(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteTransactionParticipants(ctx, ledgerID, id)
+}
+
+func (s *sharingService) CreateSettlement(ctx context.Context, ledgerID uuid.UUID, input domain.Settlement) (*dto.SettlementResponse, error) {
+	data, err := s.repo.CreateSettlements(ctx, ledgerID, []domain.Settlement{input})
+	if err != nil {
+		return nil, err
+	}
+	return &data[0], nil
+}
+
+func (s *sharingService) DeleteSettlementByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteSettlementByID(ctx, ledgerID, id)
+}
+
+func (s *sharingService) ListSettlements(ctx context.Context, ledgerID uuid.UUID, flt dto.SettlementFilters, pgn *pagination.Pagination) ([]dto.SettlementResponse, int, error) {
+	data, err := s.repo.ListSettlements(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountSettlements(ctx, ledgerID, flt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
+
+func (s *sharingService) SharedBalances(ctx context.Context, ledgerID uuid.UUID) (*dto.SharedBalancesResponse, error) {
+	return s.repo.SharedBalances(ctx, ledgerID)
+}
+
+// SettleUp registra os pagamentos sugeridos pelos saldos, zerando as dívidas entre os
+// membros do livro.
+func (s *sharingService) SettleUp(ctx context.Context, ledgerID uuid.UUID, note *string) ([]dto.SettlementResponse, error) {
+	balances, err := s.repo.SharedBalances(ctx, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]domain.Settlement, 0, len(balances.Payments))
+	for _, payment := range balances.Payments {
+		input, err := domain.NewSettlement(payment.From.ID, payment.To.ID, payment.Amount, nil, note)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, *input)
+	}
+
+	return s.repo.CreateSettlements(ctx, ledgerID, inputs)
+}
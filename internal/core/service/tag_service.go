@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils/pagination"
+
+	"github.com/google/uuid"
+)
+
+type tagService struct {
+	repo repository.Repository
+}
+
+func NewTagService(repo repository.Repository) inbound.TagService {
+	return &tagService{repo: repo}
+}
+
+func (s *tagService) GetTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TagResponse, error) {
+	return s.repo.GetTagByID(ctx, ledgerID, id)
+}
+
+func (s *tagService) CreateTag(ctx context.Context, ledgerID uuid.UUID, input domain.Tag) (*dto.TagResponse, error) {
+	return s.repo.CreateTag(ctx, ledgerID, input)
+}
+
+func (s *tagService) UpdateTag(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Tag) (*dto.TagResponse, error) {
+	return s.repo.UpdateTag(ctx, ledgerID, id, input)
+}
+
+func (s *tagService) DeleteTagByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteTagByID(ctx, ledgerID, id)
+}
+
+func (s *tagService) ListTags(ctx context.Context, ledgerID uuid.UUID, pgn *pagination.Pagination) ([]dto.TagResponse, int, error) {
+	data, err := s.repo.ListTags(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountTags(ctx, ledgerID, pgn)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, total, nil
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/core/ports/outbound/repository"
+
+	"github.com/google/uuid"
+	"github.com/xuri/excelize/v2"
+)
+
+type taxReportService struct {
+	repo repository.Repository
+}
+
+func NewTaxReportService(repo repository.Repository) inbound.TaxReportService {
+	return &taxReportService{repo: repo}
+}
+
+// TaxReport monta o relatório do ano. Sem ano, usa o ano-calendário anterior, que é o
+// declarado no ano corrente.
+func (s *taxReportService) TaxReport(ctx context.Context, ledgerID uuid.UUID, year int) (*dto.TaxReportResponse, error) {
+	if year == 0 {
+		year = time.Now().UTC().Year() - 1
+	}
+	if year < 1900 || year > 9999 {
+		return nil, fmt.Errorf("%w: invalid year", appError.ErrBadRequest)
+	}
+
+	return s.repo.TaxReport(ctx, ledgerID, year)
+}
+
+// ExportTaxReport gera o relatório em csv ou xlsx, com uma linha por valor a digitar em cada
+// ficha da declaração.
+func (s *taxReportService) ExportTaxReport(ctx context.Context, ledgerID uuid.UUID, year int, format string) (*dto.ExportFile, error) {
+	if format == "" {
+		format = "xlsx"
+	}
+	if format != "csv" && format != "xlsx" {
+		return nil, fmt.Errorf("%w: format must be csv or xlsx", appError.ErrBadRequest)
+	}
+
+	report, err := s.TaxReport(ctx, ledgerID, year)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := fmt.Sprintf("irpf-%d.%s", report.Year, format)
+	if format == "csv" {
+		content, err := writeTaxReportCSV(report)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.ExportFile{Filename: filename, ContentType: "text/csv; charset=utf-8", Content: content}, nil
+	}
+
+	content, err := writeTaxReportXLSX(report)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ExportFile{
+		Filename:    filename,
+		ContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		Content:     content,
+	}, nil
+}
+
+// taxReportRow é uma linha da exportação: a ficha da declaração, o grupo dentro dela, o
+// favorecido e o valor.
+type taxReportRow struct {
+	sheet    string
+	group    string
+	name     string
+	document string
+	amount   domain.Money
+}
+
+var taxReportHeader = []string{"Ficha", "Grupo", "Nome", "CPF/CNPJ", "Valor"}
+
+func taxReportRows(report *dto.TaxReportResponse) []taxReportRow {
+	text := func(value *string) string {
+		if value == nil {
+			return ""
+		}
+		return *value
+	}
+
+	rows := []taxReportRow{}
+	for _, source := range report.Sources {
+		name, document := text(source.Name), text(source.Document)
+		if source.TaxableIncome != 0 {
+			rows = append(rows, taxReportRow{"Rendimentos tributáveis", "Rendimento", name, document, source.TaxableIncome})
+		}
+		if source.WithheldTax != 0 {
+			rows = append(rows, taxReportRow{"Rendimentos tributáveis", "Imposto retido na fonte", name, document, source.WithheldTax})
+		}
+		if source.ExemptIncome != 0 {
+			rows = append(rows, taxReportRow{"Rendimentos isentos", "Rendimento", name, document, source.ExemptIncome})
+		}
+	}
+
+	for _, payment := range report.Payments {
+		rows = append(rows, taxReportRow{"Pagamentos efetuados", payment.Label, text(payment.Name), text(payment.Document), payment.Total})
+	}
+	return rows
+}
+
+// writeTaxReportCSV usa ponto e vírgula e vírgula decimal, como o Excel em português espera.
+func writeTaxReportCSV(report *dto.TaxReportResponse) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	writer.Comma = ';'
+
+	if err := writer.Write(taxReportHeader); err != nil {
+		return nil, err
+	}
+	for _, row := range taxReportRows(report) {
+		amount := strings.Replace(row.amount.String(), ".", ",", 1)
+		if err := writer.Write([]string{row.sheet, row.group, row.name, row.document, amount}); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// writeTaxReportXLSX gera uma aba de resumo com os totais do ano e uma aba com as linhas de
+// cada ficha.
+func writeTaxReportXLSX(report *dto.TaxReportResponse) ([]byte, error) {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	moneyFormat := "#,##0.00"
+	moneyStyle, err := file.NewStyle(&excelize.Style{CustomNumFmt: &moneyFormat})
+	if err != nil {
+		return nil, err
+	}
+	headerStyle, err := file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := "Resumo"
+	if err := file.SetSheetName(file.GetSheetName(0), summary); err != nil {
+		return nil, err
+	}
+
+	summaryRows := [][]any{
+		{"Ano-calendário", report.Year},
+		{"Moeda", report.Currency},
+		{domain.TaxTaxableIncome.Label(), report.TaxableIncome.Float64()},
+		{domain.TaxExemptIncome.Label(), report.ExemptIncome.Float64()},
+		{"Imposto retido na fonte", report.WithheldTax.Float64()},
+		{"Despesas dedutíveis", report.DeductibleTotal.Float64()},
+	}
+	for _, deduction := range report.Deductions {
+		summaryRows = append(summaryRows, []any{deduction.Label, deduction.Total.Float64()})
+	}
+
+	for i, row := range summaryRows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+		if err := file.SetSheetRow(summary, cell, &row); err != nil {
+			return nil, err
+		}
+	}
+	if err := file.SetCellStyle(summary, "A1", fmt.Sprintf("A%d", len(summaryRows)), headerStyle); err != nil {
+		return nil, err
+	}
+	if err := file.SetCellStyle(summary, "B3", fmt.Sprintf("B%d", len(summaryRows)), moneyStyle); err != nil {
+		return nil, err
+	}
+	if err := file.SetColWidth(summary, "A", "A", 30); err != nil {
+		return nil, err
+	}
+	if err := file.SetColWidth(summary, "B", "B", 18); err != nil {
+		return nil, err
+	}
+
+	details := "Declaração"
+	if _, err := file.NewSheet(details); err != nil {
+		return nil, err
+	}
+	if err := file.SetSheetRow(details, "A1", &taxReportHeader); err != nil {
+		return nil, err
+	}
+	if err := file.SetCellStyle(details, "A1", "E1", headerStyle); err != nil {
+		return nil, err
+	}
+
+	rows := taxReportRows(report)
+	for i, row := range rows {
+		values := []any{row.sheet, row.group, row.name, row.document, row.amount.Float64()}
+		if err := file.SetSheetRow(details, fmt.Sprintf("A%d", i+2), &values); err != nil {
+			return nil, err
+		}
+	}
+	if len(rows) > 0 {
+		if err := file.SetCellStyle(details, "E2", fmt.Sprintf("E%d", len(rows)+1), moneyStyle); err != nil {
+			return nil, err
+		}
+	}
+	if err := file.SetColWidth(details, "A", "C", 30); err != nil {
+		return nil, err
+	}
+	if err := file.SetColWidth(details, "D", "E", 20); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := file.Write(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
@@ -19,29 +20,29 @@ type transactionService struct {
 func NewTransactionService(repo repository.Repository) inbound.TransactionService {
 	return &transactionService{repo: repo}
 }
-func (s *transactionService) GetTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error) {
-	return s.repo.GetTransactionByID(ctx, userID, id)
+func (s *transactionService) GetTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) (*dto.TransactionResponse, error) {
+	return s.repo.GetTransactionByID(ctx, ledgerID, id)
 }
 
-func (s *transactionService) CreateTransaction(ctx context.Context, userID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
-	return s.repo.CreateTransaction(ctx, userID, input)
+func (s *transactionService) CreateTransaction(ctx context.Context, ledgerID uuid.UUID, createdByID uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
+	return s.repo.CreateTransaction(ctx, ledgerID, createdByID, input)
 }
 
-func (s *transactionService) UpdateTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
-	return s.repo.UpdateTransaction(ctx, userID, id, input)
+func (s *transactionService) UpdateTransaction(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID, input domain.Transaction) (*dto.TransactionResponse, error) {
+	return s.repo.UpdateTransaction(ctx, ledgerID, id, input)
 }
 
-func (s *transactionService) DeleteTransactionByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
-	return s.repo.DeleteTransactionByID(ctx, userID, id)
+func (s *transactionService) DeleteTransactionByID(ctx context.Context, ledgerID uuid.UUID, id uuid.UUID) error {
+	return s.repo.DeleteTransactionByID(ctx, ledgerID, id)
 }
 
-func (s *transactionService) ListTransactions(ctx context.Context, userID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error) {
-	data, err := s.repo.ListTransactions(ctx, userID, flt, pgn)
+func (s *transactionService) ListTransactions(ctx context.Context, ledgerID uuid.UUID, flt dto.TransactionFilters, pgn *pagination.Pagination) ([]dto.TransactionResponse, int, error) {
+	data, err := s.repo.ListTransactions(ctx, ledgerID, flt, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.repo.CountTransactions(ctx, userID, flt, pgn)
+	total, err := s.repo.CountTransactions(ctx, ledgerID, flt, pgn)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -49,10 +50,15 @@ func (s *transactionService) ListTransactions(ctx context.Context, userID uuid.U
 	return data, total, nil
 }
 
-func (s *transactionService) TransactionsSummary(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error) {
-	return s.repo.TransactionsSummary(ctx, userID, flt)
+func (s *transactionService) TransactionsSummary(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) ([]dto.SummaryByDate, error) {
+	return s.repo.TransactionsSummary(ctx, ledgerID, flt)
 }
 
-func (s *transactionService) TransactionsGeneralStats(ctx context.Context, userID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error) {
-	return s.repo.TransactionsGeneralStats(ctx, userID, flt)
+func (s *transactionService) TransactionsGeneralStats(ctx context.Context, ledgerID uuid.UUID, flt dto.ChartFilters) (*dto.TransactionStatsSummary, error) {
+	return s.repo.TransactionsGeneralStats(ctx, ledgerID, flt)
+}
+
+// RecordImportProgress conta uma linha processada de uma importação, com ou sem erro.
+func (s *transactionService) RecordImportProgress(ctx context.Context, input domain.ImportJob, failed bool) (*domain.ImportJob, error) {
+	return s.repo.RecordImportProgress(ctx, input, failed, time.Now().UTC())
 }
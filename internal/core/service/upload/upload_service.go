@@ -1,8 +1,10 @@
 package upload
 
 import (
+	"context"
 	"encoding/csv"
 	"fmt"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/core/ports/inbound"
 	"frog-go/internal/core/ports/outbound/messagebus"
 	"io"
@@ -14,17 +16,30 @@ import (
 )
 
 type uploadService struct {
-	mb messagebus.MessageBus
+	mb          messagebus.MessageBus
+	attachments inbound.AttachmentService
 }
 
-func NewUploadService(mb messagebus.MessageBus) inbound.UploadService {
-	return &uploadService{mb: mb}
+func NewUploadService(mb messagebus.MessageBus, attachments inbound.AttachmentService) inbound.UploadService {
+	return &uploadService{mb: mb, attachments: attachments}
 }
 
-func (c *uploadService) ImportFile(userID uuid.UUID, model, action string, invoiceID *uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) error {
+// ImportFile arquiva o arquivo original como anexo do job de importação e enfileira as
+// transações lidas dele. Retorna o ID do job.
+func (c *uploadService) ImportFile(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID, model, action string, invoiceID *uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (uuid.UUID, error) {
+	jobID := uuid.New()
+
+	target := domain.AttachmentTarget{ImportJobID: &jobID}
+	if _, err := c.attachments.CreateAttachment(ctx, ledgerID, target, file, fileHeader); err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return uuid.Nil, err
+	}
+
 	fileType, err := detectFileType(file)
 	if err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
 	filename := fileHeader.Filename
@@ -37,14 +52,14 @@ func (c *uploadService) ImportFile(userID uuid.UUID, model, action string, invoi
 	case "xlsx":
 		rows, err = c.readXLSX(file)
 	default:
-		return fmt.Errorf("unsupported file type")
+		return uuid.Nil, fmt.Errorf("unsupported file type")
 	}
 
 	if err != nil {
-		return err
+		return uuid.Nil, err
 	}
 
-	return c.readRows(userID, model, action, filename, invoiceID, rows)
+	return jobID, c.readRows(jobID, ledgerID, userID, model, action, filename, invoiceID, rows)
 }
 
 func (c *uploadService) readCSV(file multipart.File) ([][]string, error) {
@@ -62,14 +77,14 @@ func (c *uploadService) readXLSX(file multipart.File) ([][]string, error) {
 	return f.GetRows(sheetName)
 }
 
-func (c *uploadService) readRows(userID uuid.UUID, model, action, filename string, invoiceID *uuid.UUID, rows [][]string) error {
+func (c *uploadService) readRows(jobID uuid.UUID, ledgerID uuid.UUID, userID uuid.UUID, model, action, filename string, invoiceID *uuid.UUID, rows [][]string) error {
 	if len(rows) < 2 {
 		return fmt.Errorf("invalid file: no data found")
 	}
 
 	columnIndex := indexColumns(rows[0])
 
-	c.processTransactions(userID, model, action, filename, invoiceID, rows, columnIndex)
+	c.processTransactions(jobID, ledgerID, userID, model, action, filename, invoiceID, rows, columnIndex)
 
 	return nil
 
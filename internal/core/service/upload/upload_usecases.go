@@ -4,24 +4,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
 	appError "frog-go/internal/core/errors"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 )
 
 func (s *uploadService) processTransactions(
+	jobID uuid.UUID,
+	ledgerID uuid.UUID,
 	userID uuid.UUID,
 	model, action, filename string,
 	invoiceID *uuid.UUID,
 	rows [][]string,
 	idx map[string]int,
 ) error {
-	jobID := uuid.New().String()
-
 	baseName := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
 	parts := strings.Split(baseName, "_")
 	if len(parts) < 2 {
@@ -36,10 +36,12 @@ func (s *uploadService) processTransactions(
 		}
 
 		msg := dto.ImportTxnMessage{
-			JobID:    jobID,
+			JobID:    jobID.String(),
+			LedgerID: ledgerID.String(),
 			UserID:   userID.String(),
 			Filename: filename,
 			Action:   action,
+			Total:    len(rows) - 1,
 			Data: struct {
 				Transaction dto.TransactionRequest `json:"transaction"`
 			}{
@@ -70,7 +72,7 @@ func buildTransactionRequest(model string, invoiceID *uuid.UUID, row []string, i
 }
 
 func nubankToRequest(invoiceID *uuid.UUID, row []string, idx map[string]int) (*dto.TransactionRequest, error) {
-	amount, err := strconv.ParseFloat(getValue(row, idx, "amount"), 64)
+	amount, err := domain.ParseMoney(getValue(row, idx, "amount"))
 	if err != nil {
 		return nil, appError.InvalidParam("amount", err)
 	}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"frog-go/internal/core/domain"
 	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
 	"frog-go/internal/core/ports/inbound"
 	"frog-go/internal/core/ports/outbound/repository"
+	"frog-go/internal/utils"
 
 	"github.com/google/uuid"
 )
@@ -28,13 +31,49 @@ func (s *userService) GetUserByUsername(ctx context.Context, username string) (*
 }
 
 func (s *userService) CreateUser(ctx context.Context, input domain.User) (*dto.UserResponse, error) {
-	return nil, nil
+	return s.repo.CreateUser(ctx, input)
 }
 
 func (s *userService) GetUser(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error) {
-	return nil, nil
+	return s.repo.GetUserByID(ctx, userID)
 }
 
+// UpdateUserPassword troca a senha depois de conferir a senha atual.
 func (s *userService) UpdateUserPassword(ctx context.Context, userID uuid.UUID, oldPassword string, newPassword string) error {
-	return nil
+	current, err := s.repo.GetUserCredentials(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !utils.CheckPassword(current.PasswordHash, oldPassword) {
+		return appError.ErrInvalidPassword
+	}
+
+	passwordHash, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	return s.repo.UpdateUserPassword(ctx, userID, string(passwordHash))
+}
+
+func (s *userService) UpdateUserProfile(ctx context.Context, userID uuid.UUID, name string, username string) (*dto.UserResponse, error) {
+	return s.repo.UpdateUserProfile(ctx, userID, strings.TrimSpace(name), strings.TrimSpace(username))
+}
+
+func (s *userService) UpdateUserEmail(ctx context.Context, userID uuid.UUID, newEmail string) error {
+	return s.repo.UpdateUserEmail(ctx, userID, strings.TrimSpace(newEmail))
+}
+
+// DeactivateUserAccount e LogoutUser dependem de revogar os tokens já emitidos, que hoje
+// valem até expirar.
+func (s *userService) DeactivateUserAccount(ctx context.Context, userID uuid.UUID) error {
+	return appError.ErrNotImplemented
+}
+
+func (s *userService) LogoutUser(ctx context.Context, userID uuid.UUID) error {
+	return appError.ErrNotImplemented
+}
+
+func (s *userService) UpdateUserBaseCurrency(ctx context.Context, userID uuid.UUID, currency string) (*dto.UserResponse, error) {
+	return s.repo.UpdateUserBaseCurrency(ctx, userID, currency)
 }
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Asset is the model entity for the Asset schema.
+type Asset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Class holds the value of the "class" field.
+	Class string `json:"class,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AssetQuery when eager-loading is set.
+	Edges        AssetEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// AssetEdges holds the relations/edges for other nodes in the graph.
+type AssetEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Valuations holds the value of the valuations edge.
+	Valuations []*Valuation `json:"valuations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AssetEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// ValuationsOrErr returns the Valuations value or an error if the edge
+// was not loaded in eager-loading.
+func (e AssetEdges) ValuationsOrErr() ([]*Valuation, error) {
+	if e.loadedTypes[1] {
+		return e.Valuations, nil
+	}
+	return nil, &NotLoadedError{edge: "valuations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Asset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case asset.FieldName, asset.FieldClass, asset.FieldKind, asset.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case asset.FieldCreatedAt, asset.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case asset.FieldID:
+			values[i] = new(uuid.UUID)
+		case asset.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Asset fields.
+func (_m *Asset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case asset.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case asset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case asset.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case asset.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case asset.FieldClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field class", values[i])
+			} else if value.Valid {
+				_m.Class = value.String
+			}
+		case asset.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case asset.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case asset.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Asset.
+// This includes values selected through modifiers, order, etc.
+func (_m *Asset) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Asset entity.
+func (_m *Asset) QueryLedger() *LedgerQuery {
+	return NewAssetClient(_m.config).QueryLedger(_m)
+}
+
+// QueryValuations queries the "valuations" edge of the Asset entity.
+func (_m *Asset) QueryValuations() *ValuationQuery {
+	return NewAssetClient(_m.config).QueryValuations(_m)
+}
+
+// Update returns a builder for updating this Asset.
+// Note that you need to call Asset.Unwrap() before calling this method if this Asset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Asset) Update() *AssetUpdateOne {
+	return NewAssetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Asset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Asset) Unwrap() *Asset {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Asset is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Asset) String() string {
+	var builder strings.Builder
+	builder.WriteString("Asset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("class=")
+	builder.WriteString(_m.Class)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Assets is a parsable slice of Asset.
+type Assets []*Asset
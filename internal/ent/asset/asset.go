@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package asset
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the asset type in the database.
+	Label = "asset"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldClass holds the string denoting the class field in the database.
+	FieldClass = "class"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeValuations holds the string denoting the valuations edge name in mutations.
+	EdgeValuations = "valuations"
+	// Table holds the table name of the asset in the database.
+	Table = "assets"
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "assets"
+	// LedgerInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgerInverseTable = "ledgers"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "ledger_id"
+	// ValuationsTable is the table that holds the valuations relation/edge.
+	ValuationsTable = "valuations"
+	// ValuationsInverseTable is the table name for the Valuation entity.
+	// It exists in this package in order to avoid circular dependency with the "valuation" package.
+	ValuationsInverseTable = "valuations"
+	// ValuationsColumn is the table column denoting the valuations relation/edge.
+	ValuationsColumn = "asset_id"
+)
+
+// Columns holds all SQL columns for asset fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldClass,
+	FieldKind,
+	FieldCurrency,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "assets"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ledger_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultClass holds the default value on creation for the "class" field.
+	DefaultClass string
+	// ClassValidator is a validator for the "class" field. It is called by the builders before save.
+	ClassValidator func(string) error
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Asset queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByClass orders the results by the class field.
+func ByClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClass, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByLedgerField orders the results by ledger field.
+func ByLedgerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByValuationsCount orders the results by valuations count.
+func ByValuationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newValuationsStep(), opts...)
+	}
+}
+
+// ByValuations orders the results by valuations terms.
+func ByValuations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newValuationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+	)
+}
+func newValuationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ValuationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ValuationsTable, ValuationsColumn),
+	)
+}
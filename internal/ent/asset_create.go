@@ -0,0 +1,884 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/valuation"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AssetCreate is the builder for creating a Asset entity.
+type AssetCreate struct {
+	config
+	mutation *AssetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AssetCreate) SetCreatedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableCreatedAt(v *time.Time) *AssetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AssetCreate) SetUpdatedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableUpdatedAt(v *time.Time) *AssetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AssetCreate) SetName(v string) *AssetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetClass sets the "class" field.
+func (_c *AssetCreate) SetClass(v string) *AssetCreate {
+	_c.mutation.SetClass(v)
+	return _c
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableClass(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetClass(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *AssetCreate) SetKind(v string) *AssetCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableKind(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *AssetCreate) SetCurrency(v string) *AssetCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableCurrency(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AssetCreate) SetID(v uuid.UUID) *AssetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableID(v *uuid.UUID) *AssetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *AssetCreate) SetLedgerID(id uuid.UUID) *AssetCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *AssetCreate) SetLedger(v *Ledger) *AssetCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// AddValuationIDs adds the "valuations" edge to the Valuation entity by IDs.
+func (_c *AssetCreate) AddValuationIDs(ids ...uuid.UUID) *AssetCreate {
+	_c.mutation.AddValuationIDs(ids...)
+	return _c
+}
+
+// AddValuations adds the "valuations" edges to the Valuation entity.
+func (_c *AssetCreate) AddValuations(v ...*Valuation) *AssetCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddValuationIDs(ids...)
+}
+
+// Mutation returns the AssetMutation object of the builder.
+func (_c *AssetCreate) Mutation() *AssetMutation {
+	return _c.mutation
+}
+
+// Save creates the Asset in the database.
+func (_c *AssetCreate) Save(ctx context.Context) (*Asset, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AssetCreate) SaveX(ctx context.Context) *Asset {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AssetCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := asset.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := asset.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Class(); !ok {
+		v := asset.DefaultClass
+		_c.mutation.SetClass(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := asset.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := asset.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := asset.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AssetCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Asset.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Asset.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Asset.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := asset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Asset.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Class(); !ok {
+		return &ValidationError{Name: "class", err: errors.New(`ent: missing required field "Asset.class"`)}
+	}
+	if v, ok := _c.mutation.Class(); ok {
+		if err := asset.ClassValidator(v); err != nil {
+			return &ValidationError{Name: "class", err: fmt.Errorf(`ent: validator failed for field "Asset.class": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Asset.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := asset.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Asset.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Asset.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := asset.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Asset.currency": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Asset.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *AssetCreate) sqlSave(ctx context.Context) (*Asset, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Asset{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(asset.Table, sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(asset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(asset.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Class(); ok {
+		_spec.SetField(asset.FieldClass, field.TypeString, value)
+		_node.Class = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(asset.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(asset.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   asset.LedgerTable,
+			Columns: []string{asset.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ValuationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Asset.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AssetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AssetCreate) OnConflict(opts ...sql.ConflictOption) *AssetUpsertOne {
+	_c.conflict = opts
+	return &AssetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Asset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AssetCreate) OnConflictColumns(columns ...string) *AssetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AssetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AssetUpsertOne is the builder for "upsert"-ing
+	//  one Asset node.
+	AssetUpsertOne struct {
+		create *AssetCreate
+	}
+
+	// AssetUpsert is the "OnConflict" setter.
+	AssetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssetUpsert) SetUpdatedAt(v time.Time) *AssetUpsert {
+	u.Set(asset.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateUpdatedAt() *AssetUpsert {
+	u.SetExcluded(asset.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AssetUpsert) SetName(v string) *AssetUpsert {
+	u.Set(asset.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateName() *AssetUpsert {
+	u.SetExcluded(asset.FieldName)
+	return u
+}
+
+// SetClass sets the "class" field.
+func (u *AssetUpsert) SetClass(v string) *AssetUpsert {
+	u.Set(asset.FieldClass, v)
+	return u
+}
+
+// UpdateClass sets the "class" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateClass() *AssetUpsert {
+	u.SetExcluded(asset.FieldClass)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *AssetUpsert) SetKind(v string) *AssetUpsert {
+	u.Set(asset.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateKind() *AssetUpsert {
+	u.SetExcluded(asset.FieldKind)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *AssetUpsert) SetCurrency(v string) *AssetUpsert {
+	u.Set(asset.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *AssetUpsert) UpdateCurrency() *AssetUpsert {
+	u.SetExcluded(asset.FieldCurrency)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Asset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(asset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AssetUpsertOne) UpdateNewValues() *AssetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(asset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(asset.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Asset.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AssetUpsertOne) Ignore() *AssetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AssetUpsertOne) DoNothing() *AssetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AssetCreate.OnConflict
+// documentation for more info.
+func (u *AssetUpsertOne) Update(set func(*AssetUpsert)) *AssetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AssetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssetUpsertOne) SetUpdatedAt(v time.Time) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateUpdatedAt() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AssetUpsertOne) SetName(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateName() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetClass sets the "class" field.
+func (u *AssetUpsertOne) SetClass(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetClass(v)
+	})
+}
+
+// UpdateClass sets the "class" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateClass() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateClass()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AssetUpsertOne) SetKind(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateKind() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *AssetUpsertOne) SetCurrency(v string) *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *AssetUpsertOne) UpdateCurrency() *AssetUpsertOne {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// Exec executes the query.
+func (u *AssetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AssetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AssetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AssetUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AssetUpsertOne.ID is not supported by MySQL driver. Use AssetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AssetUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AssetCreateBulk is the builder for creating many Asset entities in bulk.
+type AssetCreateBulk struct {
+	config
+	err      error
+	builders []*AssetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Asset entities in the database.
+func (_c *AssetCreateBulk) Save(ctx context.Context) ([]*Asset, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Asset, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AssetCreateBulk) SaveX(ctx context.Context) []*Asset {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Asset.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AssetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AssetCreateBulk) OnConflict(opts ...sql.ConflictOption) *AssetUpsertBulk {
+	_c.conflict = opts
+	return &AssetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Asset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AssetCreateBulk) OnConflictColumns(columns ...string) *AssetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AssetUpsertBulk{
+		create: _c,
+	}
+}
+
+// AssetUpsertBulk is the builder for "upsert"-ing
+// a bulk of Asset nodes.
+type AssetUpsertBulk struct {
+	create *AssetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Asset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(asset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AssetUpsertBulk) UpdateNewValues() *AssetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(asset.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(asset.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Asset.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AssetUpsertBulk) Ignore() *AssetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AssetUpsertBulk) DoNothing() *AssetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AssetCreateBulk.OnConflict
+// documentation for more info.
+func (u *AssetUpsertBulk) Update(set func(*AssetUpsert)) *AssetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AssetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssetUpsertBulk) SetUpdatedAt(v time.Time) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateUpdatedAt() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AssetUpsertBulk) SetName(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateName() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetClass sets the "class" field.
+func (u *AssetUpsertBulk) SetClass(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetClass(v)
+	})
+}
+
+// UpdateClass sets the "class" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateClass() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateClass()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AssetUpsertBulk) SetKind(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateKind() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *AssetUpsertBulk) SetCurrency(v string) *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *AssetUpsertBulk) UpdateCurrency() *AssetUpsertBulk {
+	return u.Update(func(s *AssetUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// Exec executes the query.
+func (u *AssetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AssetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AssetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AssetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,667 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/valuation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AssetUpdate is the builder for updating Asset entities.
+type AssetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AssetMutation
+}
+
+// Where appends a list predicates to the AssetUpdate builder.
+func (_u *AssetUpdate) Where(ps ...predicate.Asset) *AssetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AssetUpdate) SetUpdatedAt(v time.Time) *AssetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AssetUpdate) SetName(v string) *AssetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableName(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetClass sets the "class" field.
+func (_u *AssetUpdate) SetClass(v string) *AssetUpdate {
+	_u.mutation.SetClass(v)
+	return _u
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableClass(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetClass(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AssetUpdate) SetKind(v string) *AssetUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableKind(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *AssetUpdate) SetCurrency(v string) *AssetUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableCurrency(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *AssetUpdate) SetLedgerID(id uuid.UUID) *AssetUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *AssetUpdate) SetLedger(v *Ledger) *AssetUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// AddValuationIDs adds the "valuations" edge to the Valuation entity by IDs.
+func (_u *AssetUpdate) AddValuationIDs(ids ...uuid.UUID) *AssetUpdate {
+	_u.mutation.AddValuationIDs(ids...)
+	return _u
+}
+
+// AddValuations adds the "valuations" edges to the Valuation entity.
+func (_u *AssetUpdate) AddValuations(v ...*Valuation) *AssetUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddValuationIDs(ids...)
+}
+
+// Mutation returns the AssetMutation object of the builder.
+func (_u *AssetUpdate) Mutation() *AssetMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *AssetUpdate) ClearLedger() *AssetUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearValuations clears all "valuations" edges to the Valuation entity.
+func (_u *AssetUpdate) ClearValuations() *AssetUpdate {
+	_u.mutation.ClearValuations()
+	return _u
+}
+
+// RemoveValuationIDs removes the "valuations" edge to Valuation entities by IDs.
+func (_u *AssetUpdate) RemoveValuationIDs(ids ...uuid.UUID) *AssetUpdate {
+	_u.mutation.RemoveValuationIDs(ids...)
+	return _u
+}
+
+// RemoveValuations removes "valuations" edges to Valuation entities.
+func (_u *AssetUpdate) RemoveValuations(v ...*Valuation) *AssetUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveValuationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AssetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AssetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AssetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := asset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AssetUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := asset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Asset.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Class(); ok {
+		if err := asset.ClassValidator(v); err != nil {
+			return &ValidationError{Name: "class", err: fmt.Errorf(`ent: validator failed for field "Asset.class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := asset.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Asset.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := asset.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Asset.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Asset.ledger"`)
+	}
+	return nil
+}
+
+func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(asset.Table, asset.Columns, sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(asset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Class(); ok {
+		_spec.SetField(asset.FieldClass, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(asset.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(asset.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   asset.LedgerTable,
+			Columns: []string{asset.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   asset.LedgerTable,
+			Columns: []string{asset.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ValuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedValuationsIDs(); len(nodes) > 0 && !_u.mutation.ValuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ValuationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{asset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AssetUpdateOne is the builder for updating a single Asset entity.
+type AssetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AssetMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AssetUpdateOne) SetUpdatedAt(v time.Time) *AssetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AssetUpdateOne) SetName(v string) *AssetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableName(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetClass sets the "class" field.
+func (_u *AssetUpdateOne) SetClass(v string) *AssetUpdateOne {
+	_u.mutation.SetClass(v)
+	return _u
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableClass(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetClass(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *AssetUpdateOne) SetKind(v string) *AssetUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableKind(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *AssetUpdateOne) SetCurrency(v string) *AssetUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableCurrency(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *AssetUpdateOne) SetLedgerID(id uuid.UUID) *AssetUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *AssetUpdateOne) SetLedger(v *Ledger) *AssetUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// AddValuationIDs adds the "valuations" edge to the Valuation entity by IDs.
+func (_u *AssetUpdateOne) AddValuationIDs(ids ...uuid.UUID) *AssetUpdateOne {
+	_u.mutation.AddValuationIDs(ids...)
+	return _u
+}
+
+// AddValuations adds the "valuations" edges to the Valuation entity.
+func (_u *AssetUpdateOne) AddValuations(v ...*Valuation) *AssetUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddValuationIDs(ids...)
+}
+
+// Mutation returns the AssetMutation object of the builder.
+func (_u *AssetUpdateOne) Mutation() *AssetMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *AssetUpdateOne) ClearLedger() *AssetUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearValuations clears all "valuations" edges to the Valuation entity.
+func (_u *AssetUpdateOne) ClearValuations() *AssetUpdateOne {
+	_u.mutation.ClearValuations()
+	return _u
+}
+
+// RemoveValuationIDs removes the "valuations" edge to Valuation entities by IDs.
+func (_u *AssetUpdateOne) RemoveValuationIDs(ids ...uuid.UUID) *AssetUpdateOne {
+	_u.mutation.RemoveValuationIDs(ids...)
+	return _u
+}
+
+// RemoveValuations removes "valuations" edges to Valuation entities.
+func (_u *AssetUpdateOne) RemoveValuations(v ...*Valuation) *AssetUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveValuationIDs(ids...)
+}
+
+// Where appends a list predicates to the AssetUpdate builder.
+func (_u *AssetUpdateOne) Where(ps ...predicate.Asset) *AssetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AssetUpdateOne) Select(field string, fields ...string) *AssetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Asset entity.
+func (_u *AssetUpdateOne) Save(ctx context.Context) (*Asset, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssetUpdateOne) SaveX(ctx context.Context) *Asset {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AssetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AssetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := asset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AssetUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := asset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Asset.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Class(); ok {
+		if err := asset.ClassValidator(v); err != nil {
+			return &ValidationError{Name: "class", err: fmt.Errorf(`ent: validator failed for field "Asset.class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := asset.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Asset.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := asset.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Asset.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Asset.ledger"`)
+	}
+	return nil
+}
+
+func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(asset.Table, asset.Columns, sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Asset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, asset.FieldID)
+		for _, f := range fields {
+			if !asset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != asset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(asset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Class(); ok {
+		_spec.SetField(asset.FieldClass, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(asset.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(asset.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   asset.LedgerTable,
+			Columns: []string{asset.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   asset.LedgerTable,
+			Columns: []string{asset.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ValuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedValuationsIDs(); len(nodes) > 0 && !_u.mutation.ValuationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ValuationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   asset.ValuationsTable,
+			Columns: []string{asset.ValuationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Asset{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{asset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
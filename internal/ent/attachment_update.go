@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/attachment"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (_u *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AttachmentUpdate) SetUpdatedAt(v time.Time) *AttachmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *AttachmentUpdate) SetFilename(v string) *AttachmentUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableFilename(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *AttachmentUpdate) SetContentType(v string) *AttachmentUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableContentType(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AttachmentUpdate) SetSize(v int64) *AttachmentUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableSize(v *int64) *AttachmentUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AttachmentUpdate) AddSize(v int64) *AttachmentUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (_u *AttachmentUpdate) SetBlobKey(v string) *AttachmentUpdate {
+	_u.mutation.SetBlobKey(v)
+	return _u
+}
+
+// SetNillableBlobKey sets the "blob_key" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableBlobKey(v *string) *AttachmentUpdate {
+	if v != nil {
+		_u.SetBlobKey(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *AttachmentUpdate) SetTransactionID(v uuid.UUID) *AttachmentUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableTransactionID(v *uuid.UUID) *AttachmentUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *AttachmentUpdate) ClearTransactionID() *AttachmentUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *AttachmentUpdate) SetInvoiceID(v uuid.UUID) *AttachmentUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableInvoiceID(v *uuid.UUID) *AttachmentUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *AttachmentUpdate) ClearInvoiceID() *AttachmentUpdate {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetImportJobID sets the "import_job_id" field.
+func (_u *AttachmentUpdate) SetImportJobID(v uuid.UUID) *AttachmentUpdate {
+	_u.mutation.SetImportJobID(v)
+	return _u
+}
+
+// SetNillableImportJobID sets the "import_job_id" field if the given value is not nil.
+func (_u *AttachmentUpdate) SetNillableImportJobID(v *uuid.UUID) *AttachmentUpdate {
+	if v != nil {
+		_u.SetImportJobID(*v)
+	}
+	return _u
+}
+
+// ClearImportJobID clears the value of the "import_job_id" field.
+func (_u *AttachmentUpdate) ClearImportJobID() *AttachmentUpdate {
+	_u.mutation.ClearImportJobID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *AttachmentUpdate) SetLedgerID(id uuid.UUID) *AttachmentUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *AttachmentUpdate) SetLedger(v *Ledger) *AttachmentUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *AttachmentUpdate) SetTransaction(v *Transaction) *AttachmentUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *AttachmentUpdate) SetInvoice(v *Invoice) *AttachmentUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (_u *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *AttachmentUpdate) ClearLedger() *AttachmentUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *AttachmentUpdate) ClearTransaction() *AttachmentUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *AttachmentUpdate) ClearInvoice() *AttachmentUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AttachmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttachmentUpdate) check() error {
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BlobKey(); ok {
+		if err := attachment.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.blob_key": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.ledger"`)
+	}
+	return nil
+}
+
+func (_u *AttachmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BlobKey(); ok {
+		_spec.SetField(attachment.FieldBlobKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ImportJobID(); ok {
+		_spec.SetField(attachment.FieldImportJobID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImportJobIDCleared() {
+		_spec.ClearField(attachment.FieldImportJobID, field.TypeUUID)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.LedgerTable,
+			Columns: []string{attachment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.LedgerTable,
+			Columns: []string{attachment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.TransactionTable,
+			Columns: []string{attachment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.TransactionTable,
+			Columns: []string{attachment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AttachmentUpdateOne) SetUpdatedAt(v time.Time) *AttachmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *AttachmentUpdateOne) SetFilename(v string) *AttachmentUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableFilename(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *AttachmentUpdateOne) SetContentType(v string) *AttachmentUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableContentType(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AttachmentUpdateOne) SetSize(v int64) *AttachmentUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableSize(v *int64) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AttachmentUpdateOne) AddSize(v int64) *AttachmentUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (_u *AttachmentUpdateOne) SetBlobKey(v string) *AttachmentUpdateOne {
+	_u.mutation.SetBlobKey(v)
+	return _u
+}
+
+// SetNillableBlobKey sets the "blob_key" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableBlobKey(v *string) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetBlobKey(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *AttachmentUpdateOne) SetTransactionID(v uuid.UUID) *AttachmentUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableTransactionID(v *uuid.UUID) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *AttachmentUpdateOne) ClearTransactionID() *AttachmentUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *AttachmentUpdateOne) SetInvoiceID(v uuid.UUID) *AttachmentUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *AttachmentUpdateOne) ClearInvoiceID() *AttachmentUpdateOne {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetImportJobID sets the "import_job_id" field.
+func (_u *AttachmentUpdateOne) SetImportJobID(v uuid.UUID) *AttachmentUpdateOne {
+	_u.mutation.SetImportJobID(v)
+	return _u
+}
+
+// SetNillableImportJobID sets the "import_job_id" field if the given value is not nil.
+func (_u *AttachmentUpdateOne) SetNillableImportJobID(v *uuid.UUID) *AttachmentUpdateOne {
+	if v != nil {
+		_u.SetImportJobID(*v)
+	}
+	return _u
+}
+
+// ClearImportJobID clears the value of the "import_job_id" field.
+func (_u *AttachmentUpdateOne) ClearImportJobID() *AttachmentUpdateOne {
+	_u.mutation.ClearImportJobID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *AttachmentUpdateOne) SetLedgerID(id uuid.UUID) *AttachmentUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *AttachmentUpdateOne) SetLedger(v *Ledger) *AttachmentUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *AttachmentUpdateOne) SetTransaction(v *Transaction) *AttachmentUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *AttachmentUpdateOne) SetInvoice(v *Invoice) *AttachmentUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (_u *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *AttachmentUpdateOne) ClearLedger() *AttachmentUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *AttachmentUpdateOne) ClearTransaction() *AttachmentUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *AttachmentUpdateOne) ClearInvoice() *AttachmentUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (_u *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (_u *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AttachmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AttachmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BlobKey(); ok {
+		if err := attachment.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.blob_key": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.ledger"`)
+	}
+	return nil
+}
+
+func (_u *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.BlobKey(); ok {
+		_spec.SetField(attachment.FieldBlobKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ImportJobID(); ok {
+		_spec.SetField(attachment.FieldImportJobID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImportJobIDCleared() {
+		_spec.ClearField(attachment.FieldImportJobID, field.TypeUUID)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.LedgerTable,
+			Columns: []string{attachment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.LedgerTable,
+			Columns: []string{attachment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.TransactionTable,
+			Columns: []string{attachment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.TransactionTable,
+			Columns: []string{attachment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.InvoiceTable,
+			Columns: []string{attachment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Attachment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
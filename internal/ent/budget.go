@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/budget"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Budget is the model entity for the Budget schema.
+type Budget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID uuid.UUID `json:"category_id,omitempty"`
+	// Month holds the value of the "month" field.
+	Month *time.Time `json:"month,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount *domain.Money `json:"amount,omitempty"`
+	// Percentage holds the value of the "percentage" field.
+	Percentage *int `json:"percentage,omitempty"`
+	// AlertedThreshold holds the value of the "alerted_threshold" field.
+	AlertedThreshold int `json:"alerted_threshold,omitempty"`
+	// AlertedMonth holds the value of the "alerted_month" field.
+	AlertedMonth *time.Time `json:"alerted_month,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BudgetQuery when eager-loading is set.
+	Edges        BudgetEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// BudgetEdges holds the relations/edges for other nodes in the graph.
+type BudgetEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Budget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budget.FieldAmount:
+			values[i] = &sql.NullScanner{S: new(domain.Money)}
+		case budget.FieldPercentage, budget.FieldAlertedThreshold:
+			values[i] = new(sql.NullInt64)
+		case budget.FieldCreatedAt, budget.FieldUpdatedAt, budget.FieldMonth, budget.FieldAlertedMonth:
+			values[i] = new(sql.NullTime)
+		case budget.FieldID, budget.FieldCategoryID:
+			values[i] = new(uuid.UUID)
+		case budget.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Budget fields.
+func (_m *Budget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budget.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case budget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case budget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case budget.FieldCategoryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value != nil {
+				_m.CategoryID = *value
+			}
+		case budget.FieldMonth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field month", values[i])
+			} else if value.Valid {
+				_m.Month = new(time.Time)
+				*_m.Month = value.Time
+			}
+		case budget.FieldAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = new(domain.Money)
+				*_m.Amount = *value.S.(*domain.Money)
+			}
+		case budget.FieldPercentage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value.Valid {
+				_m.Percentage = new(int)
+				*_m.Percentage = int(value.Int64)
+			}
+		case budget.FieldAlertedThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field alerted_threshold", values[i])
+			} else if value.Valid {
+				_m.AlertedThreshold = int(value.Int64)
+			}
+		case budget.FieldAlertedMonth:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field alerted_month", values[i])
+			} else if value.Valid {
+				_m.AlertedMonth = new(time.Time)
+				*_m.AlertedMonth = value.Time
+			}
+		case budget.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Budget.
+// This includes values selected through modifiers, order, etc.
+func (_m *Budget) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Budget entity.
+func (_m *Budget) QueryLedger() *LedgerQuery {
+	return NewBudgetClient(_m.config).QueryLedger(_m)
+}
+
+// QueryCategory queries the "category" edge of the Budget entity.
+func (_m *Budget) QueryCategory() *CategoryQuery {
+	return NewBudgetClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this Budget.
+// Note that you need to call Budget.Unwrap() before calling this method if this Budget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Budget) Update() *BudgetUpdateOne {
+	return NewBudgetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Budget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Budget) Unwrap() *Budget {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Budget is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Budget) String() string {
+	var builder strings.Builder
+	builder.WriteString("Budget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("category_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryID))
+	builder.WriteString(", ")
+	if v := _m.Month; v != nil {
+		builder.WriteString("month=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Amount; v != nil {
+		builder.WriteString("amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Percentage; v != nil {
+		builder.WriteString("percentage=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("alerted_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertedThreshold))
+	builder.WriteString(", ")
+	if v := _m.AlertedMonth; v != nil {
+		builder.WriteString("alerted_month=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Budgets is a parsable slice of Budget.
+type Budgets []*Budget
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budget
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldMonth, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAmount, v))
+}
+
+// Percentage applies equality check predicate on the "percentage" field. It's identical to PercentageEQ.
+func Percentage(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldPercentage, v))
+}
+
+// AlertedThreshold applies equality check predicate on the "alerted_threshold" field. It's identical to AlertedThresholdEQ.
+func AlertedThreshold(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedThreshold, v))
+}
+
+// AlertedMonth applies equality check predicate on the "alerted_month" field. It's identical to AlertedMonthEQ.
+func AlertedMonth(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedMonth, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uuid.UUID) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldMonth, v))
+}
+
+// MonthIsNil applies the IsNil predicate on the "month" field.
+func MonthIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldMonth))
+}
+
+// MonthNotNil applies the NotNil predicate on the "month" field.
+func MonthNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldMonth))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v domain.Money) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountIsNil applies the IsNil predicate on the "amount" field.
+func AmountIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldAmount))
+}
+
+// AmountNotNil applies the NotNil predicate on the "amount" field.
+func AmountNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldAmount))
+}
+
+// PercentageEQ applies the EQ predicate on the "percentage" field.
+func PercentageEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldPercentage, v))
+}
+
+// PercentageNEQ applies the NEQ predicate on the "percentage" field.
+func PercentageNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldPercentage, v))
+}
+
+// PercentageIn applies the In predicate on the "percentage" field.
+func PercentageIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldPercentage, vs...))
+}
+
+// PercentageNotIn applies the NotIn predicate on the "percentage" field.
+func PercentageNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldPercentage, vs...))
+}
+
+// PercentageGT applies the GT predicate on the "percentage" field.
+func PercentageGT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldPercentage, v))
+}
+
+// PercentageGTE applies the GTE predicate on the "percentage" field.
+func PercentageGTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldPercentage, v))
+}
+
+// PercentageLT applies the LT predicate on the "percentage" field.
+func PercentageLT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldPercentage, v))
+}
+
+// PercentageLTE applies the LTE predicate on the "percentage" field.
+func PercentageLTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldPercentage, v))
+}
+
+// PercentageIsNil applies the IsNil predicate on the "percentage" field.
+func PercentageIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldPercentage))
+}
+
+// PercentageNotNil applies the NotNil predicate on the "percentage" field.
+func PercentageNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldPercentage))
+}
+
+// AlertedThresholdEQ applies the EQ predicate on the "alerted_threshold" field.
+func AlertedThresholdEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdNEQ applies the NEQ predicate on the "alerted_threshold" field.
+func AlertedThresholdNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdIn applies the In predicate on the "alerted_threshold" field.
+func AlertedThresholdIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAlertedThreshold, vs...))
+}
+
+// AlertedThresholdNotIn applies the NotIn predicate on the "alerted_threshold" field.
+func AlertedThresholdNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAlertedThreshold, vs...))
+}
+
+// AlertedThresholdGT applies the GT predicate on the "alerted_threshold" field.
+func AlertedThresholdGT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdGTE applies the GTE predicate on the "alerted_threshold" field.
+func AlertedThresholdGTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdLT applies the LT predicate on the "alerted_threshold" field.
+func AlertedThresholdLT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdLTE applies the LTE predicate on the "alerted_threshold" field.
+func AlertedThresholdLTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAlertedThreshold, v))
+}
+
+// AlertedMonthEQ applies the EQ predicate on the "alerted_month" field.
+func AlertedMonthEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedMonth, v))
+}
+
+// AlertedMonthNEQ applies the NEQ predicate on the "alerted_month" field.
+func AlertedMonthNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAlertedMonth, v))
+}
+
+// AlertedMonthIn applies the In predicate on the "alerted_month" field.
+func AlertedMonthIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAlertedMonth, vs...))
+}
+
+// AlertedMonthNotIn applies the NotIn predicate on the "alerted_month" field.
+func AlertedMonthNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAlertedMonth, vs...))
+}
+
+// AlertedMonthGT applies the GT predicate on the "alerted_month" field.
+func AlertedMonthGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAlertedMonth, v))
+}
+
+// AlertedMonthGTE applies the GTE predicate on the "alerted_month" field.
+func AlertedMonthGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAlertedMonth, v))
+}
+
+// AlertedMonthLT applies the LT predicate on the "alerted_month" field.
+func AlertedMonthLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAlertedMonth, v))
+}
+
+// AlertedMonthLTE applies the LTE predicate on the "alerted_month" field.
+func AlertedMonthLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAlertedMonth, v))
+}
+
+// AlertedMonthIsNil applies the IsNil predicate on the "alerted_month" field.
+func AlertedMonthIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldAlertedMonth))
+}
+
+// AlertedMonthNotNil applies the NotNil predicate on the "alerted_month" field.
+func AlertedMonthNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldAlertedMonth))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Budget {
+	return predicate.Budget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Budget {
+	return predicate.Budget(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Budget {
+	return predicate.Budget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.Budget {
+	return predicate.Budget(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Budget) predicate.Budget {
+	return predicate.Budget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Budget) predicate.Budget {
+	return predicate.Budget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Budget) predicate.Budget {
+	return predicate.Budget(sql.NotPredicates(p))
+}
@@ -0,0 +1,1097 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/budget"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BudgetCreate is the builder for creating a Budget entity.
+type BudgetCreate struct {
+	config
+	mutation *BudgetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BudgetCreate) SetCreatedAt(v time.Time) *BudgetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableCreatedAt(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BudgetCreate) SetUpdatedAt(v time.Time) *BudgetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableUpdatedAt(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *BudgetCreate) SetCategoryID(v uuid.UUID) *BudgetCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetMonth sets the "month" field.
+func (_c *BudgetCreate) SetMonth(v time.Time) *BudgetCreate {
+	_c.mutation.SetMonth(v)
+	return _c
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableMonth(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetMonth(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BudgetCreate) SetAmount(v domain.Money) *BudgetCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableAmount(v *domain.Money) *BudgetCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetPercentage sets the "percentage" field.
+func (_c *BudgetCreate) SetPercentage(v int) *BudgetCreate {
+	_c.mutation.SetPercentage(v)
+	return _c
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillablePercentage(v *int) *BudgetCreate {
+	if v != nil {
+		_c.SetPercentage(*v)
+	}
+	return _c
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (_c *BudgetCreate) SetAlertedThreshold(v int) *BudgetCreate {
+	_c.mutation.SetAlertedThreshold(v)
+	return _c
+}
+
+// SetNillableAlertedThreshold sets the "alerted_threshold" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableAlertedThreshold(v *int) *BudgetCreate {
+	if v != nil {
+		_c.SetAlertedThreshold(*v)
+	}
+	return _c
+}
+
+// SetAlertedMonth sets the "alerted_month" field.
+func (_c *BudgetCreate) SetAlertedMonth(v time.Time) *BudgetCreate {
+	_c.mutation.SetAlertedMonth(v)
+	return _c
+}
+
+// SetNillableAlertedMonth sets the "alerted_month" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableAlertedMonth(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetAlertedMonth(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BudgetCreate) SetID(v uuid.UUID) *BudgetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableID(v *uuid.UUID) *BudgetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *BudgetCreate) SetLedgerID(id uuid.UUID) *BudgetCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *BudgetCreate) SetLedger(v *Ledger) *BudgetCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *BudgetCreate) SetCategory(v *Category) *BudgetCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_c *BudgetCreate) Mutation() *BudgetMutation {
+	return _c.mutation
+}
+
+// Save creates the Budget in the database.
+func (_c *BudgetCreate) Save(ctx context.Context) (*Budget, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BudgetCreate) SaveX(ctx context.Context) *Budget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BudgetCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := budget.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := budget.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.AlertedThreshold(); !ok {
+		v := budget.DefaultAlertedThreshold
+		_c.mutation.SetAlertedThreshold(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := budget.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BudgetCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Budget.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Budget.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "Budget.category_id"`)}
+	}
+	if _, ok := _c.mutation.AlertedThreshold(); !ok {
+		return &ValidationError{Name: "alerted_threshold", err: errors.New(`ent: missing required field "Budget.alerted_threshold"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Budget.ledger"`)}
+	}
+	if len(_c.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "Budget.category"`)}
+	}
+	return nil
+}
+
+func (_c *BudgetCreate) sqlSave(ctx context.Context) (*Budget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BudgetCreate) createSpec() (*Budget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Budget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(budget.Table, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(budget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Month(); ok {
+		_spec.SetField(budget.FieldMonth, field.TypeTime, value)
+		_node.Month = &value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeInt64, value)
+		_node.Amount = &value
+	}
+	if value, ok := _c.mutation.Percentage(); ok {
+		_spec.SetField(budget.FieldPercentage, field.TypeInt, value)
+		_node.Percentage = &value
+	}
+	if value, ok := _c.mutation.AlertedThreshold(); ok {
+		_spec.SetField(budget.FieldAlertedThreshold, field.TypeInt, value)
+		_node.AlertedThreshold = value
+	}
+	if value, ok := _c.mutation.AlertedMonth(); ok {
+		_spec.SetField(budget.FieldAlertedMonth, field.TypeTime, value)
+		_node.AlertedMonth = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.LedgerTable,
+			Columns: []string{budget.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.CategoryTable,
+			Columns: []string{budget.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetCreate) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertOne {
+	_c.conflict = opts
+	return &BudgetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetCreate) OnConflictColumns(columns ...string) *BudgetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BudgetUpsertOne is the builder for "upsert"-ing
+	//  one Budget node.
+	BudgetUpsertOne struct {
+		create *BudgetCreate
+	}
+
+	// BudgetUpsert is the "OnConflict" setter.
+	BudgetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsert) SetUpdatedAt(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateUpdatedAt() *BudgetUpsert {
+	u.SetExcluded(budget.FieldUpdatedAt)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *BudgetUpsert) SetCategoryID(v uuid.UUID) *BudgetUpsert {
+	u.Set(budget.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateCategoryID() *BudgetUpsert {
+	u.SetExcluded(budget.FieldCategoryID)
+	return u
+}
+
+// SetMonth sets the "month" field.
+func (u *BudgetUpsert) SetMonth(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldMonth, v)
+	return u
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateMonth() *BudgetUpsert {
+	u.SetExcluded(budget.FieldMonth)
+	return u
+}
+
+// ClearMonth clears the value of the "month" field.
+func (u *BudgetUpsert) ClearMonth() *BudgetUpsert {
+	u.SetNull(budget.FieldMonth)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsert) SetAmount(v domain.Money) *BudgetUpsert {
+	u.Set(budget.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAmount() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsert) AddAmount(v domain.Money) *BudgetUpsert {
+	u.Add(budget.FieldAmount, v)
+	return u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *BudgetUpsert) ClearAmount() *BudgetUpsert {
+	u.SetNull(budget.FieldAmount)
+	return u
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *BudgetUpsert) SetPercentage(v int) *BudgetUpsert {
+	u.Set(budget.FieldPercentage, v)
+	return u
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdatePercentage() *BudgetUpsert {
+	u.SetExcluded(budget.FieldPercentage)
+	return u
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *BudgetUpsert) AddPercentage(v int) *BudgetUpsert {
+	u.Add(budget.FieldPercentage, v)
+	return u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *BudgetUpsert) ClearPercentage() *BudgetUpsert {
+	u.SetNull(budget.FieldPercentage)
+	return u
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (u *BudgetUpsert) SetAlertedThreshold(v int) *BudgetUpsert {
+	u.Set(budget.FieldAlertedThreshold, v)
+	return u
+}
+
+// UpdateAlertedThreshold sets the "alerted_threshold" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAlertedThreshold() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAlertedThreshold)
+	return u
+}
+
+// AddAlertedThreshold adds v to the "alerted_threshold" field.
+func (u *BudgetUpsert) AddAlertedThreshold(v int) *BudgetUpsert {
+	u.Add(budget.FieldAlertedThreshold, v)
+	return u
+}
+
+// SetAlertedMonth sets the "alerted_month" field.
+func (u *BudgetUpsert) SetAlertedMonth(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldAlertedMonth, v)
+	return u
+}
+
+// UpdateAlertedMonth sets the "alerted_month" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAlertedMonth() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAlertedMonth)
+	return u
+}
+
+// ClearAlertedMonth clears the value of the "alerted_month" field.
+func (u *BudgetUpsert) ClearAlertedMonth() *BudgetUpsert {
+	u.SetNull(budget.FieldAlertedMonth)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertOne) UpdateNewValues() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budget.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(budget.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetUpsertOne) Ignore() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertOne) DoNothing() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreate.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertOne) Update(set func(*BudgetUpsert)) *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsertOne) SetUpdatedAt(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateUpdatedAt() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *BudgetUpsertOne) SetCategoryID(v uuid.UUID) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateCategoryID() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *BudgetUpsertOne) SetMonth(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateMonth() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// ClearMonth clears the value of the "month" field.
+func (u *BudgetUpsertOne) ClearMonth() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearMonth()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertOne) SetAmount(v domain.Money) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsertOne) AddAmount(v domain.Money) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAmount() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *BudgetUpsertOne) ClearAmount() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *BudgetUpsertOne) SetPercentage(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *BudgetUpsertOne) AddPercentage(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdatePercentage() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *BudgetUpsertOne) ClearPercentage() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearPercentage()
+	})
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (u *BudgetUpsertOne) SetAlertedThreshold(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedThreshold(v)
+	})
+}
+
+// AddAlertedThreshold adds v to the "alerted_threshold" field.
+func (u *BudgetUpsertOne) AddAlertedThreshold(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAlertedThreshold(v)
+	})
+}
+
+// UpdateAlertedThreshold sets the "alerted_threshold" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAlertedThreshold() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedThreshold()
+	})
+}
+
+// SetAlertedMonth sets the "alerted_month" field.
+func (u *BudgetUpsertOne) SetAlertedMonth(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedMonth(v)
+	})
+}
+
+// UpdateAlertedMonth sets the "alerted_month" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAlertedMonth() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedMonth()
+	})
+}
+
+// ClearAlertedMonth clears the value of the "alerted_month" field.
+func (u *BudgetUpsertOne) ClearAlertedMonth() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAlertedMonth()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BudgetUpsertOne.ID is not supported by MySQL driver. Use BudgetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetCreateBulk is the builder for creating many Budget entities in bulk.
+type BudgetCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Budget entities in the database.
+func (_c *BudgetCreateBulk) Save(ctx context.Context) ([]*Budget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Budget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BudgetCreateBulk) SaveX(ctx context.Context) []*Budget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertBulk {
+	_c.conflict = opts
+	return &BudgetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetCreateBulk) OnConflictColumns(columns ...string) *BudgetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertBulk{
+		create: _c,
+	}
+}
+
+// BudgetUpsertBulk is the builder for "upsert"-ing
+// a bulk of Budget nodes.
+type BudgetUpsertBulk struct {
+	create *BudgetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) UpdateNewValues() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budget.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(budget.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) Ignore() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertBulk) DoNothing() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertBulk) Update(set func(*BudgetUpsert)) *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsertBulk) SetUpdatedAt(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateUpdatedAt() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *BudgetUpsertBulk) SetCategoryID(v uuid.UUID) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateCategoryID() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *BudgetUpsertBulk) SetMonth(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateMonth() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// ClearMonth clears the value of the "month" field.
+func (u *BudgetUpsertBulk) ClearMonth() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearMonth()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertBulk) SetAmount(v domain.Money) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsertBulk) AddAmount(v domain.Money) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAmount() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *BudgetUpsertBulk) ClearAmount() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *BudgetUpsertBulk) SetPercentage(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *BudgetUpsertBulk) AddPercentage(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdatePercentage() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *BudgetUpsertBulk) ClearPercentage() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearPercentage()
+	})
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (u *BudgetUpsertBulk) SetAlertedThreshold(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedThreshold(v)
+	})
+}
+
+// AddAlertedThreshold adds v to the "alerted_threshold" field.
+func (u *BudgetUpsertBulk) AddAlertedThreshold(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAlertedThreshold(v)
+	})
+}
+
+// UpdateAlertedThreshold sets the "alerted_threshold" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAlertedThreshold() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedThreshold()
+	})
+}
+
+// SetAlertedMonth sets the "alerted_month" field.
+func (u *BudgetUpsertBulk) SetAlertedMonth(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedMonth(v)
+	})
+}
+
+// UpdateAlertedMonth sets the "alerted_month" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAlertedMonth() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedMonth()
+	})
+}
+
+// ClearAlertedMonth clears the value of the "alerted_month" field.
+func (u *BudgetUpsertBulk) ClearAlertedMonth() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAlertedMonth()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BudgetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/budget"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BudgetUpdate is the builder for updating Budget entities.
+type BudgetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BudgetMutation
+}
+
+// Where appends a list predicates to the BudgetUpdate builder.
+func (_u *BudgetUpdate) Where(ps ...predicate.Budget) *BudgetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BudgetUpdate) SetUpdatedAt(v time.Time) *BudgetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *BudgetUpdate) SetCategoryID(v uuid.UUID) *BudgetUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableCategoryID(v *uuid.UUID) *BudgetUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *BudgetUpdate) SetMonth(v time.Time) *BudgetUpdate {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableMonth(v *time.Time) *BudgetUpdate {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// ClearMonth clears the value of the "month" field.
+func (_u *BudgetUpdate) ClearMonth() *BudgetUpdate {
+	_u.mutation.ClearMonth()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BudgetUpdate) SetAmount(v domain.Money) *BudgetUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAmount(v *domain.Money) *BudgetUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BudgetUpdate) AddAmount(v domain.Money) *BudgetUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (_u *BudgetUpdate) ClearAmount() *BudgetUpdate {
+	_u.mutation.ClearAmount()
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *BudgetUpdate) SetPercentage(v int) *BudgetUpdate {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillablePercentage(v *int) *BudgetUpdate {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *BudgetUpdate) AddPercentage(v int) *BudgetUpdate {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *BudgetUpdate) ClearPercentage() *BudgetUpdate {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (_u *BudgetUpdate) SetAlertedThreshold(v int) *BudgetUpdate {
+	_u.mutation.ResetAlertedThreshold()
+	_u.mutation.SetAlertedThreshold(v)
+	return _u
+}
+
+// SetNillableAlertedThreshold sets the "alerted_threshold" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAlertedThreshold(v *int) *BudgetUpdate {
+	if v != nil {
+		_u.SetAlertedThreshold(*v)
+	}
+	return _u
+}
+
+// AddAlertedThreshold adds value to the "alerted_threshold" field.
+func (_u *BudgetUpdate) AddAlertedThreshold(v int) *BudgetUpdate {
+	_u.mutation.AddAlertedThreshold(v)
+	return _u
+}
+
+// SetAlertedMonth sets the "alerted_month" field.
+func (_u *BudgetUpdate) SetAlertedMonth(v time.Time) *BudgetUpdate {
+	_u.mutation.SetAlertedMonth(v)
+	return _u
+}
+
+// SetNillableAlertedMonth sets the "alerted_month" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAlertedMonth(v *time.Time) *BudgetUpdate {
+	if v != nil {
+		_u.SetAlertedMonth(*v)
+	}
+	return _u
+}
+
+// ClearAlertedMonth clears the value of the "alerted_month" field.
+func (_u *BudgetUpdate) ClearAlertedMonth() *BudgetUpdate {
+	_u.mutation.ClearAlertedMonth()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *BudgetUpdate) SetLedgerID(id uuid.UUID) *BudgetUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *BudgetUpdate) SetLedger(v *Ledger) *BudgetUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *BudgetUpdate) SetCategory(v *Category) *BudgetUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_u *BudgetUpdate) Mutation() *BudgetMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *BudgetUpdate) ClearLedger() *BudgetUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *BudgetUpdate) ClearCategory() *BudgetUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BudgetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BudgetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BudgetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := budget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetUpdate) check() error {
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Budget.ledger"`)
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Budget.category"`)
+	}
+	return nil
+}
+
+func (_u *BudgetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(budget.FieldMonth, field.TypeTime, value)
+	}
+	if _u.mutation.MonthCleared() {
+		_spec.ClearField(budget.FieldMonth, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(budget.FieldAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.AmountCleared() {
+		_spec.ClearField(budget.FieldAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(budget.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(budget.FieldPercentage, field.TypeInt, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(budget.FieldPercentage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AlertedThreshold(); ok {
+		_spec.SetField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertedThreshold(); ok {
+		_spec.AddField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AlertedMonth(); ok {
+		_spec.SetField(budget.FieldAlertedMonth, field.TypeTime, value)
+	}
+	if _u.mutation.AlertedMonthCleared() {
+		_spec.ClearField(budget.FieldAlertedMonth, field.TypeTime)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.LedgerTable,
+			Columns: []string{budget.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.LedgerTable,
+			Columns: []string{budget.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.CategoryTable,
+			Columns: []string{budget.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.CategoryTable,
+			Columns: []string{budget.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BudgetUpdateOne is the builder for updating a single Budget entity.
+type BudgetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BudgetMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BudgetUpdateOne) SetUpdatedAt(v time.Time) *BudgetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *BudgetUpdateOne) SetCategoryID(v uuid.UUID) *BudgetUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableCategoryID(v *uuid.UUID) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *BudgetUpdateOne) SetMonth(v time.Time) *BudgetUpdateOne {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableMonth(v *time.Time) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// ClearMonth clears the value of the "month" field.
+func (_u *BudgetUpdateOne) ClearMonth() *BudgetUpdateOne {
+	_u.mutation.ClearMonth()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BudgetUpdateOne) SetAmount(v domain.Money) *BudgetUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAmount(v *domain.Money) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BudgetUpdateOne) AddAmount(v domain.Money) *BudgetUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (_u *BudgetUpdateOne) ClearAmount() *BudgetUpdateOne {
+	_u.mutation.ClearAmount()
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *BudgetUpdateOne) SetPercentage(v int) *BudgetUpdateOne {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillablePercentage(v *int) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *BudgetUpdateOne) AddPercentage(v int) *BudgetUpdateOne {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *BudgetUpdateOne) ClearPercentage() *BudgetUpdateOne {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (_u *BudgetUpdateOne) SetAlertedThreshold(v int) *BudgetUpdateOne {
+	_u.mutation.ResetAlertedThreshold()
+	_u.mutation.SetAlertedThreshold(v)
+	return _u
+}
+
+// SetNillableAlertedThreshold sets the "alerted_threshold" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAlertedThreshold(v *int) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAlertedThreshold(*v)
+	}
+	return _u
+}
+
+// AddAlertedThreshold adds value to the "alerted_threshold" field.
+func (_u *BudgetUpdateOne) AddAlertedThreshold(v int) *BudgetUpdateOne {
+	_u.mutation.AddAlertedThreshold(v)
+	return _u
+}
+
+// SetAlertedMonth sets the "alerted_month" field.
+func (_u *BudgetUpdateOne) SetAlertedMonth(v time.Time) *BudgetUpdateOne {
+	_u.mutation.SetAlertedMonth(v)
+	return _u
+}
+
+// SetNillableAlertedMonth sets the "alerted_month" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAlertedMonth(v *time.Time) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAlertedMonth(*v)
+	}
+	return _u
+}
+
+// ClearAlertedMonth clears the value of the "alerted_month" field.
+func (_u *BudgetUpdateOne) ClearAlertedMonth() *BudgetUpdateOne {
+	_u.mutation.ClearAlertedMonth()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *BudgetUpdateOne) SetLedgerID(id uuid.UUID) *BudgetUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *BudgetUpdateOne) SetLedger(v *Ledger) *BudgetUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *BudgetUpdateOne) SetCategory(v *Category) *BudgetUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_u *BudgetUpdateOne) Mutation() *BudgetMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *BudgetUpdateOne) ClearLedger() *BudgetUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *BudgetUpdateOne) ClearCategory() *BudgetUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the BudgetUpdate builder.
+func (_u *BudgetUpdateOne) Where(ps ...predicate.Budget) *BudgetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BudgetUpdateOne) Select(field string, fields ...string) *BudgetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Budget entity.
+func (_u *BudgetUpdateOne) Save(ctx context.Context) (*Budget, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetUpdateOne) SaveX(ctx context.Context) *Budget {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BudgetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BudgetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := budget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetUpdateOne) check() error {
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Budget.ledger"`)
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Budget.category"`)
+	}
+	return nil
+}
+
+func (_u *BudgetUpdateOne) sqlSave(ctx context.Context) (_node *Budget, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Budget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budget.FieldID)
+		for _, f := range fields {
+			if !budget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != budget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(budget.FieldMonth, field.TypeTime, value)
+	}
+	if _u.mutation.MonthCleared() {
+		_spec.ClearField(budget.FieldMonth, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(budget.FieldAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.AmountCleared() {
+		_spec.ClearField(budget.FieldAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(budget.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(budget.FieldPercentage, field.TypeInt, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(budget.FieldPercentage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AlertedThreshold(); ok {
+		_spec.SetField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertedThreshold(); ok {
+		_spec.AddField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AlertedMonth(); ok {
+		_spec.SetField(budget.FieldAlertedMonth, field.TypeTime, value)
+	}
+	if _u.mutation.AlertedMonthCleared() {
+		_spec.ClearField(budget.FieldAlertedMonth, field.TypeTime)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.LedgerTable,
+			Columns: []string{budget.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.LedgerTable,
+			Columns: []string{budget.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.CategoryTable,
+			Columns: []string{budget.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   budget.CategoryTable,
+			Columns: []string{budget.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Budget{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
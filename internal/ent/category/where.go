@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -85,6 +86,21 @@ func SuggestedPercentage(v int) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldSuggestedPercentage, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldParentID, v))
+}
+
+// TaxGroup applies equality check predicate on the "tax_group" field. It's identical to TaxGroupEQ.
+func TaxGroup(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldTaxGroup, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldCreatedByID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldCreatedAt, v))
@@ -430,6 +446,233 @@ func SuggestedPercentageNotNil() predicate.Category {
 	return predicate.Category(sql.FieldNotNull(FieldSuggestedPercentage))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldParentID))
+}
+
+// TaxGroupEQ applies the EQ predicate on the "tax_group" field.
+func TaxGroupEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldTaxGroup, v))
+}
+
+// TaxGroupNEQ applies the NEQ predicate on the "tax_group" field.
+func TaxGroupNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldTaxGroup, v))
+}
+
+// TaxGroupIn applies the In predicate on the "tax_group" field.
+func TaxGroupIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldTaxGroup, vs...))
+}
+
+// TaxGroupNotIn applies the NotIn predicate on the "tax_group" field.
+func TaxGroupNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldTaxGroup, vs...))
+}
+
+// TaxGroupGT applies the GT predicate on the "tax_group" field.
+func TaxGroupGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldTaxGroup, v))
+}
+
+// TaxGroupGTE applies the GTE predicate on the "tax_group" field.
+func TaxGroupGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldTaxGroup, v))
+}
+
+// TaxGroupLT applies the LT predicate on the "tax_group" field.
+func TaxGroupLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldTaxGroup, v))
+}
+
+// TaxGroupLTE applies the LTE predicate on the "tax_group" field.
+func TaxGroupLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldTaxGroup, v))
+}
+
+// TaxGroupContains applies the Contains predicate on the "tax_group" field.
+func TaxGroupContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldTaxGroup, v))
+}
+
+// TaxGroupHasPrefix applies the HasPrefix predicate on the "tax_group" field.
+func TaxGroupHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldTaxGroup, v))
+}
+
+// TaxGroupHasSuffix applies the HasSuffix predicate on the "tax_group" field.
+func TaxGroupHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldTaxGroup, v))
+}
+
+// TaxGroupIsNil applies the IsNil predicate on the "tax_group" field.
+func TaxGroupIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldTaxGroup))
+}
+
+// TaxGroupNotNil applies the NotNil predicate on the "tax_group" field.
+func TaxGroupNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldTaxGroup))
+}
+
+// TaxGroupEqualFold applies the EqualFold predicate on the "tax_group" field.
+func TaxGroupEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldTaxGroup, v))
+}
+
+// TaxGroupContainsFold applies the ContainsFold predicate on the "tax_group" field.
+func TaxGroupContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldTaxGroup, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...uuid.UUID) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDIsNil applies the IsNil predicate on the "created_by_id" field.
+func CreatedByIDIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldCreatedByID))
+}
+
+// CreatedByIDNotNil applies the NotNil predicate on the "created_by_id" field.
+func CreatedByIDNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldCreatedByID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Category) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Category) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Category) predicate.Category {
 	return predicate.Category(sql.AndPredicates(predicates...))
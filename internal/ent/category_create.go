@@ -7,8 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/user"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +23,7 @@ type CategoryCreate struct {
 	config
 	mutation *CategoryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -97,6 +102,48 @@ func (_c *CategoryCreate) SetNillableSuggestedPercentage(v *int) *CategoryCreate
 	return _c
 }
 
+// SetParentID sets the "parent_id" field.
+func (_c *CategoryCreate) SetParentID(v uuid.UUID) *CategoryCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableParentID(v *uuid.UUID) *CategoryCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetTaxGroup sets the "tax_group" field.
+func (_c *CategoryCreate) SetTaxGroup(v string) *CategoryCreate {
+	_c.mutation.SetTaxGroup(v)
+	return _c
+}
+
+// SetNillableTaxGroup sets the "tax_group" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableTaxGroup(v *string) *CategoryCreate {
+	if v != nil {
+		_c.SetTaxGroup(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *CategoryCreate) SetCreatedByID(v uuid.UUID) *CategoryCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableCreatedByID(v *uuid.UUID) *CategoryCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CategoryCreate) SetID(v uuid.UUID) *CategoryCreate {
 	_c.mutation.SetID(v)
@@ -111,6 +158,42 @@ func (_c *CategoryCreate) SetNillableID(v *uuid.UUID) *CategoryCreate {
 	return _c
 }
 
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *CategoryCreate) SetLedgerID(id uuid.UUID) *CategoryCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *CategoryCreate) SetLedger(v *Ledger) *CategoryCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *CategoryCreate) SetCreatedBy(v *User) *CategoryCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Category entity.
+func (_c *CategoryCreate) SetParent(v *Category) *CategoryCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by IDs.
+func (_c *CategoryCreate) AddChildIDs(ids ...uuid.UUID) *CategoryCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Category entity.
+func (_c *CategoryCreate) AddChildren(v ...*Category) *CategoryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_c *CategoryCreate) Mutation() *CategoryMutation {
 	return _c.mutation
@@ -181,6 +264,14 @@ func (_c *CategoryCreate) check() error {
 			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Category.color": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.TaxGroup(); ok {
+		if err := category.TaxGroupValidator(v); err != nil {
+			return &ValidationError{Name: "tax_group", err: fmt.Errorf(`ent: validator failed for field "Category.tax_group": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Category.ledger"`)}
+	}
 	return nil
 }
 
@@ -212,6 +303,7 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_node = &Category{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(category.Table, sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -240,14 +332,487 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldSuggestedPercentage, field.TypeInt, value)
 		_node.SuggestedPercentage = &value
 	}
+	if value, ok := _c.mutation.TaxGroup(); ok {
+		_spec.SetField(category.FieldTaxGroup, field.TypeString, value)
+		_node.TaxGroup = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.LedgerTable,
+			Columns: []string{category.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.CreatedByTable,
+			Columns: []string{category.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Category.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryCreate) OnConflict(opts ...sql.ConflictOption) *CategoryUpsertOne {
+	_c.conflict = opts
+	return &CategoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryCreate) OnConflictColumns(columns ...string) *CategoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryUpsertOne is the builder for "upsert"-ing
+	//  one Category node.
+	CategoryUpsertOne struct {
+		create *CategoryCreate
+	}
+
+	// CategoryUpsert is the "OnConflict" setter.
+	CategoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryUpsert) SetUpdatedAt(v time.Time) *CategoryUpsert {
+	u.Set(category.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateUpdatedAt() *CategoryUpsert {
+	u.SetExcluded(category.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CategoryUpsert) SetName(v string) *CategoryUpsert {
+	u.Set(category.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateName() *CategoryUpsert {
+	u.SetExcluded(category.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryUpsert) SetDescription(v string) *CategoryUpsert {
+	u.Set(category.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateDescription() *CategoryUpsert {
+	u.SetExcluded(category.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryUpsert) ClearDescription() *CategoryUpsert {
+	u.SetNull(category.FieldDescription)
+	return u
+}
+
+// SetColor sets the "color" field.
+func (u *CategoryUpsert) SetColor(v string) *CategoryUpsert {
+	u.Set(category.FieldColor, v)
+	return u
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateColor() *CategoryUpsert {
+	u.SetExcluded(category.FieldColor)
+	return u
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *CategoryUpsert) ClearColor() *CategoryUpsert {
+	u.SetNull(category.FieldColor)
+	return u
+}
+
+// SetSuggestedPercentage sets the "suggested_percentage" field.
+func (u *CategoryUpsert) SetSuggestedPercentage(v int) *CategoryUpsert {
+	u.Set(category.FieldSuggestedPercentage, v)
+	return u
+}
+
+// UpdateSuggestedPercentage sets the "suggested_percentage" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateSuggestedPercentage() *CategoryUpsert {
+	u.SetExcluded(category.FieldSuggestedPercentage)
+	return u
+}
+
+// AddSuggestedPercentage adds v to the "suggested_percentage" field.
+func (u *CategoryUpsert) AddSuggestedPercentage(v int) *CategoryUpsert {
+	u.Add(category.FieldSuggestedPercentage, v)
+	return u
+}
+
+// ClearSuggestedPercentage clears the value of the "suggested_percentage" field.
+func (u *CategoryUpsert) ClearSuggestedPercentage() *CategoryUpsert {
+	u.SetNull(category.FieldSuggestedPercentage)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CategoryUpsert) SetParentID(v uuid.UUID) *CategoryUpsert {
+	u.Set(category.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateParentID() *CategoryUpsert {
+	u.SetExcluded(category.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CategoryUpsert) ClearParentID() *CategoryUpsert {
+	u.SetNull(category.FieldParentID)
+	return u
+}
+
+// SetTaxGroup sets the "tax_group" field.
+func (u *CategoryUpsert) SetTaxGroup(v string) *CategoryUpsert {
+	u.Set(category.FieldTaxGroup, v)
+	return u
+}
+
+// UpdateTaxGroup sets the "tax_group" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateTaxGroup() *CategoryUpsert {
+	u.SetExcluded(category.FieldTaxGroup)
+	return u
+}
+
+// ClearTaxGroup clears the value of the "tax_group" field.
+func (u *CategoryUpsert) ClearTaxGroup() *CategoryUpsert {
+	u.SetNull(category.FieldTaxGroup)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(category.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryUpsertOne) UpdateNewValues() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(category.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(category.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedByID(); exists {
+			s.SetIgnore(category.FieldCreatedByID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryUpsertOne) Ignore() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryUpsertOne) DoNothing() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCreate.OnConflict
+// documentation for more info.
+func (u *CategoryUpsertOne) Update(set func(*CategoryUpsert)) *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryUpsertOne) SetUpdatedAt(v time.Time) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateUpdatedAt() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CategoryUpsertOne) SetName(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateName() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryUpsertOne) SetDescription(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDescription() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryUpsertOne) ClearDescription() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *CategoryUpsertOne) SetColor(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateColor() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *CategoryUpsertOne) ClearColor() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearColor()
+	})
+}
+
+// SetSuggestedPercentage sets the "suggested_percentage" field.
+func (u *CategoryUpsertOne) SetSuggestedPercentage(v int) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSuggestedPercentage(v)
+	})
+}
+
+// AddSuggestedPercentage adds v to the "suggested_percentage" field.
+func (u *CategoryUpsertOne) AddSuggestedPercentage(v int) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddSuggestedPercentage(v)
+	})
+}
+
+// UpdateSuggestedPercentage sets the "suggested_percentage" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateSuggestedPercentage() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSuggestedPercentage()
+	})
+}
+
+// ClearSuggestedPercentage clears the value of the "suggested_percentage" field.
+func (u *CategoryUpsertOne) ClearSuggestedPercentage() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearSuggestedPercentage()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CategoryUpsertOne) SetParentID(v uuid.UUID) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateParentID() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CategoryUpsertOne) ClearParentID() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetTaxGroup sets the "tax_group" field.
+func (u *CategoryUpsertOne) SetTaxGroup(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetTaxGroup(v)
+	})
+}
+
+// UpdateTaxGroup sets the "tax_group" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateTaxGroup() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateTaxGroup()
+	})
+}
+
+// ClearTaxGroup clears the value of the "tax_group" field.
+func (u *CategoryUpsertOne) ClearTaxGroup() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearTaxGroup()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryUpsertOne.ID is not supported by MySQL driver. Use CategoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // CategoryCreateBulk is the builder for creating many Category entities in bulk.
 type CategoryCreateBulk struct {
 	config
 	err      error
 	builders []*CategoryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Category entities in the database.
@@ -277,6 +842,7 @@ func (_c *CategoryCreateBulk) Save(ctx context.Context) ([]*Category, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -326,3 +892,263 @@ func (_c *CategoryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Category.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryUpsertBulk {
+	_c.conflict = opts
+	return &CategoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryCreateBulk) OnConflictColumns(columns ...string) *CategoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Category nodes.
+type CategoryUpsertBulk struct {
+	create *CategoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(category.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryUpsertBulk) UpdateNewValues() *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(category.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(category.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedByID(); exists {
+				s.SetIgnore(category.FieldCreatedByID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryUpsertBulk) Ignore() *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryUpsertBulk) DoNothing() *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryUpsertBulk) Update(set func(*CategoryUpsert)) *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryUpsertBulk) SetUpdatedAt(v time.Time) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateUpdatedAt() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CategoryUpsertBulk) SetName(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateName() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryUpsertBulk) SetDescription(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateDescription() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryUpsertBulk) ClearDescription() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *CategoryUpsertBulk) SetColor(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateColor() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// ClearColor clears the value of the "color" field.
+func (u *CategoryUpsertBulk) ClearColor() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearColor()
+	})
+}
+
+// SetSuggestedPercentage sets the "suggested_percentage" field.
+func (u *CategoryUpsertBulk) SetSuggestedPercentage(v int) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSuggestedPercentage(v)
+	})
+}
+
+// AddSuggestedPercentage adds v to the "suggested_percentage" field.
+func (u *CategoryUpsertBulk) AddSuggestedPercentage(v int) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddSuggestedPercentage(v)
+	})
+}
+
+// UpdateSuggestedPercentage sets the "suggested_percentage" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateSuggestedPercentage() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSuggestedPercentage()
+	})
+}
+
+// ClearSuggestedPercentage clears the value of the "suggested_percentage" field.
+func (u *CategoryUpsertBulk) ClearSuggestedPercentage() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearSuggestedPercentage()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CategoryUpsertBulk) SetParentID(v uuid.UUID) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateParentID() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CategoryUpsertBulk) ClearParentID() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetTaxGroup sets the "tax_group" field.
+func (u *CategoryUpsertBulk) SetTaxGroup(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetTaxGroup(v)
+	})
+}
+
+// UpdateTaxGroup sets the "tax_group" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateTaxGroup() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateTaxGroup()
+	})
+}
+
+// ClearTaxGroup clears the value of the "tax_group" field.
+func (u *CategoryUpsertBulk) ClearTaxGroup() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearTaxGroup()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/predicate"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // CategoryUpdate is the builder for updating Category entities.
@@ -115,11 +117,115 @@ func (_u *CategoryUpdate) ClearSuggestedPercentage() *CategoryUpdate {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *CategoryUpdate) SetParentID(v uuid.UUID) *CategoryUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableParentID(v *uuid.UUID) *CategoryUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *CategoryUpdate) ClearParentID() *CategoryUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetTaxGroup sets the "tax_group" field.
+func (_u *CategoryUpdate) SetTaxGroup(v string) *CategoryUpdate {
+	_u.mutation.SetTaxGroup(v)
+	return _u
+}
+
+// SetNillableTaxGroup sets the "tax_group" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableTaxGroup(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetTaxGroup(*v)
+	}
+	return _u
+}
+
+// ClearTaxGroup clears the value of the "tax_group" field.
+func (_u *CategoryUpdate) ClearTaxGroup() *CategoryUpdate {
+	_u.mutation.ClearTaxGroup()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *CategoryUpdate) SetLedgerID(id uuid.UUID) *CategoryUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *CategoryUpdate) SetLedger(v *Ledger) *CategoryUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Category entity.
+func (_u *CategoryUpdate) SetParent(v *Category) *CategoryUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by IDs.
+func (_u *CategoryUpdate) AddChildIDs(ids ...uuid.UUID) *CategoryUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Category entity.
+func (_u *CategoryUpdate) AddChildren(v ...*Category) *CategoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdate) Mutation() *CategoryMutation {
 	return _u.mutation
 }
 
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *CategoryUpdate) ClearLedger() *CategoryUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Category entity.
+func (_u *CategoryUpdate) ClearParent() *CategoryUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Category entity.
+func (_u *CategoryUpdate) ClearChildren() *CategoryUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Category entities by IDs.
+func (_u *CategoryUpdate) RemoveChildIDs(ids ...uuid.UUID) *CategoryUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Category entities.
+func (_u *CategoryUpdate) RemoveChildren(v ...*Category) *CategoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CategoryUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -168,6 +274,14 @@ func (_u *CategoryUpdate) check() error {
 			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Category.color": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaxGroup(); ok {
+		if err := category.TaxGroupValidator(v); err != nil {
+			return &ValidationError{Name: "tax_group", err: fmt.Errorf(`ent: validator failed for field "Category.tax_group": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Category.ledger"`)
+	}
 	return nil
 }
 
@@ -210,6 +324,115 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SuggestedPercentageCleared() {
 		_spec.ClearField(category.FieldSuggestedPercentage, field.TypeInt)
 	}
+	if value, ok := _u.mutation.TaxGroup(); ok {
+		_spec.SetField(category.FieldTaxGroup, field.TypeString, value)
+	}
+	if _u.mutation.TaxGroupCleared() {
+		_spec.ClearField(category.FieldTaxGroup, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.LedgerTable,
+			Columns: []string{category.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.LedgerTable,
+			Columns: []string{category.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{category.Label}
@@ -317,11 +540,115 @@ func (_u *CategoryUpdateOne) ClearSuggestedPercentage() *CategoryUpdateOne {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *CategoryUpdateOne) SetParentID(v uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableParentID(v *uuid.UUID) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *CategoryUpdateOne) ClearParentID() *CategoryUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetTaxGroup sets the "tax_group" field.
+func (_u *CategoryUpdateOne) SetTaxGroup(v string) *CategoryUpdateOne {
+	_u.mutation.SetTaxGroup(v)
+	return _u
+}
+
+// SetNillableTaxGroup sets the "tax_group" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableTaxGroup(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetTaxGroup(*v)
+	}
+	return _u
+}
+
+// ClearTaxGroup clears the value of the "tax_group" field.
+func (_u *CategoryUpdateOne) ClearTaxGroup() *CategoryUpdateOne {
+	_u.mutation.ClearTaxGroup()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *CategoryUpdateOne) SetLedgerID(id uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *CategoryUpdateOne) SetLedger(v *Ledger) *CategoryUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Category entity.
+func (_u *CategoryUpdateOne) SetParent(v *Category) *CategoryUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by IDs.
+func (_u *CategoryUpdateOne) AddChildIDs(ids ...uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Category entity.
+func (_u *CategoryUpdateOne) AddChildren(v ...*Category) *CategoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the CategoryMutation object of the builder.
 func (_u *CategoryUpdateOne) Mutation() *CategoryMutation {
 	return _u.mutation
 }
 
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *CategoryUpdateOne) ClearLedger() *CategoryUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Category entity.
+func (_u *CategoryUpdateOne) ClearParent() *CategoryUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Category entity.
+func (_u *CategoryUpdateOne) ClearChildren() *CategoryUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Category entities by IDs.
+func (_u *CategoryUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *CategoryUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Category entities.
+func (_u *CategoryUpdateOne) RemoveChildren(v ...*Category) *CategoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the CategoryUpdate builder.
 func (_u *CategoryUpdateOne) Where(ps ...predicate.Category) *CategoryUpdateOne {
 	_u.mutation.Where(ps...)
@@ -383,6 +710,14 @@ func (_u *CategoryUpdateOne) check() error {
 			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Category.color": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaxGroup(); ok {
+		if err := category.TaxGroupValidator(v); err != nil {
+			return &ValidationError{Name: "tax_group", err: fmt.Errorf(`ent: validator failed for field "Category.tax_group": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Category.ledger"`)
+	}
 	return nil
 }
 
@@ -442,6 +777,115 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if _u.mutation.SuggestedPercentageCleared() {
 		_spec.ClearField(category.FieldSuggestedPercentage, field.TypeInt)
 	}
+	if value, ok := _u.mutation.TaxGroup(); ok {
+		_spec.SetField(category.FieldTaxGroup, field.TypeString, value)
+	}
+	if _u.mutation.TaxGroupCleared() {
+		_spec.ClearField(category.FieldTaxGroup, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.LedgerTable,
+			Columns: []string{category.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   category.LedgerTable,
+			Columns: []string{category.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Category{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,10 +6,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/attachment"
+	"frog-go/internal/ent/budget"
 	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/envelopeallocation"
+	"frog-go/internal/ent/exchangerate"
+	"frog-go/internal/ent/goal"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/importjob"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/investmenttrade"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/ledgermember"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/notification"
+	"frog-go/internal/ent/notificationdelivery"
+	"frog-go/internal/ent/notificationpreference"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/rule"
+	"frog-go/internal/ent/settlement"
+	"frog-go/internal/ent/tag"
 	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/transactionsplit"
 	"frog-go/internal/ent/user"
+	"frog-go/internal/ent/valuation"
 	"reflect"
 	"sync"
 
@@ -76,10 +106,40 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			category.Table:    category.ValidColumn,
-			invoice.Table:     invoice.ValidColumn,
-			transaction.Table: transaction.ValidColumn,
-			user.Table:        user.ValidColumn,
+			account.Table:                account.ValidColumn,
+			asset.Table:                  asset.ValidColumn,
+			attachment.Table:             attachment.ValidColumn,
+			budget.Table:                 budget.ValidColumn,
+			category.Table:               category.ValidColumn,
+			envelopeallocation.Table:     envelopeallocation.ValidColumn,
+			exchangerate.Table:           exchangerate.ValidColumn,
+			goal.Table:                   goal.ValidColumn,
+			holding.Table:                holding.ValidColumn,
+			importjob.Table:              importjob.ValidColumn,
+			investmentincome.Table:       investmentincome.ValidColumn,
+			investmentquote.Table:        investmentquote.ValidColumn,
+			investmenttrade.Table:        investmenttrade.ValidColumn,
+			invoice.Table:                invoice.ValidColumn,
+			invoicepayment.Table:         invoicepayment.ValidColumn,
+			ledger.Table:                 ledger.ValidColumn,
+			ledgerinvitation.Table:       ledgerinvitation.ValidColumn,
+			ledgermember.Table:           ledgermember.ValidColumn,
+			loan.Table:                   loan.ValidColumn,
+			loaninstallment.Table:        loaninstallment.ValidColumn,
+			loanprepayment.Table:         loanprepayment.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationdelivery.Table:   notificationdelivery.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			payee.Table:                  payee.ValidColumn,
+			recurringrule.Table:          recurringrule.ValidColumn,
+			rule.Table:                   rule.ValidColumn,
+			settlement.Table:             settlement.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			transaction.Table:            transaction.ValidColumn,
+			transactionparticipant.Table: transactionparticipant.ValidColumn,
+			transactionsplit.Table:       transactionsplit.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			valuation.Table:              valuation.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
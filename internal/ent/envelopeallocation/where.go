@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package envelopeallocation
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldAmount, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldMonth, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldKind, v))
+}
+
+// TransferID applies equality check predicate on the "transfer_id" field. It's identical to TransferIDEQ.
+func TransferID(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldTransferID, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v domain.Money) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldAmount, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v time.Time) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldMonth, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldContainsFold(FieldKind, v))
+}
+
+// TransferIDEQ applies the EQ predicate on the "transfer_id" field.
+func TransferIDEQ(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldTransferID, v))
+}
+
+// TransferIDNEQ applies the NEQ predicate on the "transfer_id" field.
+func TransferIDNEQ(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldTransferID, v))
+}
+
+// TransferIDIn applies the In predicate on the "transfer_id" field.
+func TransferIDIn(vs ...uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldTransferID, vs...))
+}
+
+// TransferIDNotIn applies the NotIn predicate on the "transfer_id" field.
+func TransferIDNotIn(vs ...uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldTransferID, vs...))
+}
+
+// TransferIDGT applies the GT predicate on the "transfer_id" field.
+func TransferIDGT(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldTransferID, v))
+}
+
+// TransferIDGTE applies the GTE predicate on the "transfer_id" field.
+func TransferIDGTE(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldTransferID, v))
+}
+
+// TransferIDLT applies the LT predicate on the "transfer_id" field.
+func TransferIDLT(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldTransferID, v))
+}
+
+// TransferIDLTE applies the LTE predicate on the "transfer_id" field.
+func TransferIDLTE(v uuid.UUID) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldTransferID, v))
+}
+
+// TransferIDIsNil applies the IsNil predicate on the "transfer_id" field.
+func TransferIDIsNil() predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIsNull(FieldTransferID))
+}
+
+// TransferIDNotNil applies the NotNil predicate on the "transfer_id" field.
+func TransferIDNotNil() predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotNull(FieldTransferID))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.FieldContainsFold(FieldNote, v))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnvelopeAllocation) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnvelopeAllocation) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnvelopeAllocation) predicate.EnvelopeAllocation {
+	return predicate.EnvelopeAllocation(sql.NotPredicates(p))
+}
@@ -0,0 +1,1005 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/envelopeallocation"
+	"frog-go/internal/ent/ledger"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EnvelopeAllocationCreate is the builder for creating a EnvelopeAllocation entity.
+type EnvelopeAllocationCreate struct {
+	config
+	mutation *EnvelopeAllocationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EnvelopeAllocationCreate) SetCreatedAt(v time.Time) *EnvelopeAllocationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EnvelopeAllocationCreate) SetNillableCreatedAt(v *time.Time) *EnvelopeAllocationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EnvelopeAllocationCreate) SetUpdatedAt(v time.Time) *EnvelopeAllocationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EnvelopeAllocationCreate) SetNillableUpdatedAt(v *time.Time) *EnvelopeAllocationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *EnvelopeAllocationCreate) SetAmount(v domain.Money) *EnvelopeAllocationCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *EnvelopeAllocationCreate) SetCategoryID(v uuid.UUID) *EnvelopeAllocationCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetMonth sets the "month" field.
+func (_c *EnvelopeAllocationCreate) SetMonth(v time.Time) *EnvelopeAllocationCreate {
+	_c.mutation.SetMonth(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *EnvelopeAllocationCreate) SetKind(v string) *EnvelopeAllocationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (_c *EnvelopeAllocationCreate) SetTransferID(v uuid.UUID) *EnvelopeAllocationCreate {
+	_c.mutation.SetTransferID(v)
+	return _c
+}
+
+// SetNillableTransferID sets the "transfer_id" field if the given value is not nil.
+func (_c *EnvelopeAllocationCreate) SetNillableTransferID(v *uuid.UUID) *EnvelopeAllocationCreate {
+	if v != nil {
+		_c.SetTransferID(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *EnvelopeAllocationCreate) SetNote(v string) *EnvelopeAllocationCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *EnvelopeAllocationCreate) SetNillableNote(v *string) *EnvelopeAllocationCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EnvelopeAllocationCreate) SetID(v uuid.UUID) *EnvelopeAllocationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EnvelopeAllocationCreate) SetNillableID(v *uuid.UUID) *EnvelopeAllocationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *EnvelopeAllocationCreate) SetLedgerID(id uuid.UUID) *EnvelopeAllocationCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *EnvelopeAllocationCreate) SetLedger(v *Ledger) *EnvelopeAllocationCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *EnvelopeAllocationCreate) SetCategory(v *Category) *EnvelopeAllocationCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the EnvelopeAllocationMutation object of the builder.
+func (_c *EnvelopeAllocationCreate) Mutation() *EnvelopeAllocationMutation {
+	return _c.mutation
+}
+
+// Save creates the EnvelopeAllocation in the database.
+func (_c *EnvelopeAllocationCreate) Save(ctx context.Context) (*EnvelopeAllocation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnvelopeAllocationCreate) SaveX(ctx context.Context) *EnvelopeAllocation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvelopeAllocationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvelopeAllocationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnvelopeAllocationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := envelopeallocation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := envelopeallocation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := envelopeallocation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnvelopeAllocationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EnvelopeAllocation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EnvelopeAllocation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "EnvelopeAllocation.amount"`)}
+	}
+	if _, ok := _c.mutation.CategoryID(); !ok {
+		return &ValidationError{Name: "category_id", err: errors.New(`ent: missing required field "EnvelopeAllocation.category_id"`)}
+	}
+	if _, ok := _c.mutation.Month(); !ok {
+		return &ValidationError{Name: "month", err: errors.New(`ent: missing required field "EnvelopeAllocation.month"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "EnvelopeAllocation.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := envelopeallocation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EnvelopeAllocation.kind": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := envelopeallocation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "EnvelopeAllocation.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "EnvelopeAllocation.ledger"`)}
+	}
+	if len(_c.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "EnvelopeAllocation.category"`)}
+	}
+	return nil
+}
+
+func (_c *EnvelopeAllocationCreate) sqlSave(ctx context.Context) (*EnvelopeAllocation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnvelopeAllocationCreate) createSpec() (*EnvelopeAllocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvelopeAllocation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(envelopeallocation.Table, sqlgraph.NewFieldSpec(envelopeallocation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(envelopeallocation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(envelopeallocation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(envelopeallocation.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Month(); ok {
+		_spec.SetField(envelopeallocation.FieldMonth, field.TypeTime, value)
+		_node.Month = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(envelopeallocation.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.TransferID(); ok {
+		_spec.SetField(envelopeallocation.FieldTransferID, field.TypeUUID, value)
+		_node.TransferID = &value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(envelopeallocation.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.LedgerTable,
+			Columns: []string{envelopeallocation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.CategoryTable,
+			Columns: []string{envelopeallocation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvelopeAllocation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvelopeAllocationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EnvelopeAllocationCreate) OnConflict(opts ...sql.ConflictOption) *EnvelopeAllocationUpsertOne {
+	_c.conflict = opts
+	return &EnvelopeAllocationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvelopeAllocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EnvelopeAllocationCreate) OnConflictColumns(columns ...string) *EnvelopeAllocationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EnvelopeAllocationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EnvelopeAllocationUpsertOne is the builder for "upsert"-ing
+	//  one EnvelopeAllocation node.
+	EnvelopeAllocationUpsertOne struct {
+		create *EnvelopeAllocationCreate
+	}
+
+	// EnvelopeAllocationUpsert is the "OnConflict" setter.
+	EnvelopeAllocationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvelopeAllocationUpsert) SetUpdatedAt(v time.Time) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateUpdatedAt() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *EnvelopeAllocationUpsert) SetAmount(v domain.Money) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateAmount() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *EnvelopeAllocationUpsert) AddAmount(v domain.Money) *EnvelopeAllocationUpsert {
+	u.Add(envelopeallocation.FieldAmount, v)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *EnvelopeAllocationUpsert) SetCategoryID(v uuid.UUID) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateCategoryID() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldCategoryID)
+	return u
+}
+
+// SetMonth sets the "month" field.
+func (u *EnvelopeAllocationUpsert) SetMonth(v time.Time) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldMonth, v)
+	return u
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateMonth() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldMonth)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *EnvelopeAllocationUpsert) SetKind(v string) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateKind() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldKind)
+	return u
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (u *EnvelopeAllocationUpsert) SetTransferID(v uuid.UUID) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldTransferID, v)
+	return u
+}
+
+// UpdateTransferID sets the "transfer_id" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateTransferID() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldTransferID)
+	return u
+}
+
+// ClearTransferID clears the value of the "transfer_id" field.
+func (u *EnvelopeAllocationUpsert) ClearTransferID() *EnvelopeAllocationUpsert {
+	u.SetNull(envelopeallocation.FieldTransferID)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *EnvelopeAllocationUpsert) SetNote(v string) *EnvelopeAllocationUpsert {
+	u.Set(envelopeallocation.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsert) UpdateNote() *EnvelopeAllocationUpsert {
+	u.SetExcluded(envelopeallocation.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EnvelopeAllocationUpsert) ClearNote() *EnvelopeAllocationUpsert {
+	u.SetNull(envelopeallocation.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EnvelopeAllocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(envelopeallocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EnvelopeAllocationUpsertOne) UpdateNewValues() *EnvelopeAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(envelopeallocation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(envelopeallocation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvelopeAllocation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EnvelopeAllocationUpsertOne) Ignore() *EnvelopeAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvelopeAllocationUpsertOne) DoNothing() *EnvelopeAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvelopeAllocationCreate.OnConflict
+// documentation for more info.
+func (u *EnvelopeAllocationUpsertOne) Update(set func(*EnvelopeAllocationUpsert)) *EnvelopeAllocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvelopeAllocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvelopeAllocationUpsertOne) SetUpdatedAt(v time.Time) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateUpdatedAt() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *EnvelopeAllocationUpsertOne) SetAmount(v domain.Money) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *EnvelopeAllocationUpsertOne) AddAmount(v domain.Money) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateAmount() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *EnvelopeAllocationUpsertOne) SetCategoryID(v uuid.UUID) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateCategoryID() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *EnvelopeAllocationUpsertOne) SetMonth(v time.Time) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateMonth() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *EnvelopeAllocationUpsertOne) SetKind(v string) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateKind() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (u *EnvelopeAllocationUpsertOne) SetTransferID(v uuid.UUID) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetTransferID(v)
+	})
+}
+
+// UpdateTransferID sets the "transfer_id" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateTransferID() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateTransferID()
+	})
+}
+
+// ClearTransferID clears the value of the "transfer_id" field.
+func (u *EnvelopeAllocationUpsertOne) ClearTransferID() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.ClearTransferID()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *EnvelopeAllocationUpsertOne) SetNote(v string) *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertOne) UpdateNote() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EnvelopeAllocationUpsertOne) ClearNote() *EnvelopeAllocationUpsertOne {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvelopeAllocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EnvelopeAllocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvelopeAllocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EnvelopeAllocationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EnvelopeAllocationUpsertOne.ID is not supported by MySQL driver. Use EnvelopeAllocationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EnvelopeAllocationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EnvelopeAllocationCreateBulk is the builder for creating many EnvelopeAllocation entities in bulk.
+type EnvelopeAllocationCreateBulk struct {
+	config
+	err      error
+	builders []*EnvelopeAllocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EnvelopeAllocation entities in the database.
+func (_c *EnvelopeAllocationCreateBulk) Save(ctx context.Context) ([]*EnvelopeAllocation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnvelopeAllocation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvelopeAllocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnvelopeAllocationCreateBulk) SaveX(ctx context.Context) []*EnvelopeAllocation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnvelopeAllocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnvelopeAllocationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvelopeAllocation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvelopeAllocationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EnvelopeAllocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *EnvelopeAllocationUpsertBulk {
+	_c.conflict = opts
+	return &EnvelopeAllocationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvelopeAllocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EnvelopeAllocationCreateBulk) OnConflictColumns(columns ...string) *EnvelopeAllocationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EnvelopeAllocationUpsertBulk{
+		create: _c,
+	}
+}
+
+// EnvelopeAllocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of EnvelopeAllocation nodes.
+type EnvelopeAllocationUpsertBulk struct {
+	create *EnvelopeAllocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EnvelopeAllocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(envelopeallocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EnvelopeAllocationUpsertBulk) UpdateNewValues() *EnvelopeAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(envelopeallocation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(envelopeallocation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvelopeAllocation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EnvelopeAllocationUpsertBulk) Ignore() *EnvelopeAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvelopeAllocationUpsertBulk) DoNothing() *EnvelopeAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvelopeAllocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *EnvelopeAllocationUpsertBulk) Update(set func(*EnvelopeAllocationUpsert)) *EnvelopeAllocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvelopeAllocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvelopeAllocationUpsertBulk) SetUpdatedAt(v time.Time) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateUpdatedAt() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *EnvelopeAllocationUpsertBulk) SetAmount(v domain.Money) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *EnvelopeAllocationUpsertBulk) AddAmount(v domain.Money) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateAmount() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *EnvelopeAllocationUpsertBulk) SetCategoryID(v uuid.UUID) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateCategoryID() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *EnvelopeAllocationUpsertBulk) SetMonth(v time.Time) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateMonth() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *EnvelopeAllocationUpsertBulk) SetKind(v string) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateKind() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (u *EnvelopeAllocationUpsertBulk) SetTransferID(v uuid.UUID) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetTransferID(v)
+	})
+}
+
+// UpdateTransferID sets the "transfer_id" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateTransferID() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateTransferID()
+	})
+}
+
+// ClearTransferID clears the value of the "transfer_id" field.
+func (u *EnvelopeAllocationUpsertBulk) ClearTransferID() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.ClearTransferID()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *EnvelopeAllocationUpsertBulk) SetNote(v string) *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *EnvelopeAllocationUpsertBulk) UpdateNote() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *EnvelopeAllocationUpsertBulk) ClearNote() *EnvelopeAllocationUpsertBulk {
+	return u.Update(func(s *EnvelopeAllocationUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvelopeAllocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EnvelopeAllocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EnvelopeAllocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvelopeAllocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
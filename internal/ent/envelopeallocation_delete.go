@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"frog-go/internal/ent/envelopeallocation"
+	"frog-go/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EnvelopeAllocationDelete is the builder for deleting a EnvelopeAllocation entity.
+type EnvelopeAllocationDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvelopeAllocationMutation
+}
+
+// Where appends a list predicates to the EnvelopeAllocationDelete builder.
+func (_d *EnvelopeAllocationDelete) Where(ps ...predicate.EnvelopeAllocation) *EnvelopeAllocationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EnvelopeAllocationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EnvelopeAllocationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EnvelopeAllocationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(envelopeallocation.Table, sqlgraph.NewFieldSpec(envelopeallocation.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EnvelopeAllocationDeleteOne is the builder for deleting a single EnvelopeAllocation entity.
+type EnvelopeAllocationDeleteOne struct {
+	_d *EnvelopeAllocationDelete
+}
+
+// Where appends a list predicates to the EnvelopeAllocationDelete builder.
+func (_d *EnvelopeAllocationDeleteOne) Where(ps ...predicate.EnvelopeAllocation) *EnvelopeAllocationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EnvelopeAllocationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{envelopeallocation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EnvelopeAllocationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/envelopeallocation"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EnvelopeAllocationUpdate is the builder for updating EnvelopeAllocation entities.
+type EnvelopeAllocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnvelopeAllocationMutation
+}
+
+// Where appends a list predicates to the EnvelopeAllocationUpdate builder.
+func (_u *EnvelopeAllocationUpdate) Where(ps ...predicate.EnvelopeAllocation) *EnvelopeAllocationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnvelopeAllocationUpdate) SetUpdatedAt(v time.Time) *EnvelopeAllocationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *EnvelopeAllocationUpdate) SetAmount(v domain.Money) *EnvelopeAllocationUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdate) SetNillableAmount(v *domain.Money) *EnvelopeAllocationUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *EnvelopeAllocationUpdate) AddAmount(v domain.Money) *EnvelopeAllocationUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *EnvelopeAllocationUpdate) SetCategoryID(v uuid.UUID) *EnvelopeAllocationUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdate) SetNillableCategoryID(v *uuid.UUID) *EnvelopeAllocationUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *EnvelopeAllocationUpdate) SetMonth(v time.Time) *EnvelopeAllocationUpdate {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdate) SetNillableMonth(v *time.Time) *EnvelopeAllocationUpdate {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EnvelopeAllocationUpdate) SetKind(v string) *EnvelopeAllocationUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdate) SetNillableKind(v *string) *EnvelopeAllocationUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (_u *EnvelopeAllocationUpdate) SetTransferID(v uuid.UUID) *EnvelopeAllocationUpdate {
+	_u.mutation.SetTransferID(v)
+	return _u
+}
+
+// SetNillableTransferID sets the "transfer_id" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdate) SetNillableTransferID(v *uuid.UUID) *EnvelopeAllocationUpdate {
+	if v != nil {
+		_u.SetTransferID(*v)
+	}
+	return _u
+}
+
+// ClearTransferID clears the value of the "transfer_id" field.
+func (_u *EnvelopeAllocationUpdate) ClearTransferID() *EnvelopeAllocationUpdate {
+	_u.mutation.ClearTransferID()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *EnvelopeAllocationUpdate) SetNote(v string) *EnvelopeAllocationUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdate) SetNillableNote(v *string) *EnvelopeAllocationUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *EnvelopeAllocationUpdate) ClearNote() *EnvelopeAllocationUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *EnvelopeAllocationUpdate) SetLedgerID(id uuid.UUID) *EnvelopeAllocationUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *EnvelopeAllocationUpdate) SetLedger(v *Ledger) *EnvelopeAllocationUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *EnvelopeAllocationUpdate) SetCategory(v *Category) *EnvelopeAllocationUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the EnvelopeAllocationMutation object of the builder.
+func (_u *EnvelopeAllocationUpdate) Mutation() *EnvelopeAllocationMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *EnvelopeAllocationUpdate) ClearLedger() *EnvelopeAllocationUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *EnvelopeAllocationUpdate) ClearCategory() *EnvelopeAllocationUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnvelopeAllocationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvelopeAllocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnvelopeAllocationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvelopeAllocationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnvelopeAllocationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := envelopeallocation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvelopeAllocationUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := envelopeallocation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EnvelopeAllocation.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := envelopeallocation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "EnvelopeAllocation.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvelopeAllocation.ledger"`)
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvelopeAllocation.category"`)
+	}
+	return nil
+}
+
+func (_u *EnvelopeAllocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envelopeallocation.Table, envelopeallocation.Columns, sqlgraph.NewFieldSpec(envelopeallocation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(envelopeallocation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(envelopeallocation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(envelopeallocation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(envelopeallocation.FieldMonth, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(envelopeallocation.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TransferID(); ok {
+		_spec.SetField(envelopeallocation.FieldTransferID, field.TypeUUID, value)
+	}
+	if _u.mutation.TransferIDCleared() {
+		_spec.ClearField(envelopeallocation.FieldTransferID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(envelopeallocation.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(envelopeallocation.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.LedgerTable,
+			Columns: []string{envelopeallocation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.LedgerTable,
+			Columns: []string{envelopeallocation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.CategoryTable,
+			Columns: []string{envelopeallocation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.CategoryTable,
+			Columns: []string{envelopeallocation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envelopeallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnvelopeAllocationUpdateOne is the builder for updating a single EnvelopeAllocation entity.
+type EnvelopeAllocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnvelopeAllocationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EnvelopeAllocationUpdateOne) SetUpdatedAt(v time.Time) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *EnvelopeAllocationUpdateOne) SetAmount(v domain.Money) *EnvelopeAllocationUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdateOne) SetNillableAmount(v *domain.Money) *EnvelopeAllocationUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *EnvelopeAllocationUpdateOne) AddAmount(v domain.Money) *EnvelopeAllocationUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *EnvelopeAllocationUpdateOne) SetCategoryID(v uuid.UUID) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdateOne) SetNillableCategoryID(v *uuid.UUID) *EnvelopeAllocationUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *EnvelopeAllocationUpdateOne) SetMonth(v time.Time) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdateOne) SetNillableMonth(v *time.Time) *EnvelopeAllocationUpdateOne {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *EnvelopeAllocationUpdateOne) SetKind(v string) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdateOne) SetNillableKind(v *string) *EnvelopeAllocationUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (_u *EnvelopeAllocationUpdateOne) SetTransferID(v uuid.UUID) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetTransferID(v)
+	return _u
+}
+
+// SetNillableTransferID sets the "transfer_id" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdateOne) SetNillableTransferID(v *uuid.UUID) *EnvelopeAllocationUpdateOne {
+	if v != nil {
+		_u.SetTransferID(*v)
+	}
+	return _u
+}
+
+// ClearTransferID clears the value of the "transfer_id" field.
+func (_u *EnvelopeAllocationUpdateOne) ClearTransferID() *EnvelopeAllocationUpdateOne {
+	_u.mutation.ClearTransferID()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *EnvelopeAllocationUpdateOne) SetNote(v string) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *EnvelopeAllocationUpdateOne) SetNillableNote(v *string) *EnvelopeAllocationUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *EnvelopeAllocationUpdateOne) ClearNote() *EnvelopeAllocationUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *EnvelopeAllocationUpdateOne) SetLedgerID(id uuid.UUID) *EnvelopeAllocationUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *EnvelopeAllocationUpdateOne) SetLedger(v *Ledger) *EnvelopeAllocationUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *EnvelopeAllocationUpdateOne) SetCategory(v *Category) *EnvelopeAllocationUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the EnvelopeAllocationMutation object of the builder.
+func (_u *EnvelopeAllocationUpdateOne) Mutation() *EnvelopeAllocationMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *EnvelopeAllocationUpdateOne) ClearLedger() *EnvelopeAllocationUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *EnvelopeAllocationUpdateOne) ClearCategory() *EnvelopeAllocationUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the EnvelopeAllocationUpdate builder.
+func (_u *EnvelopeAllocationUpdateOne) Where(ps ...predicate.EnvelopeAllocation) *EnvelopeAllocationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnvelopeAllocationUpdateOne) Select(field string, fields ...string) *EnvelopeAllocationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnvelopeAllocation entity.
+func (_u *EnvelopeAllocationUpdateOne) Save(ctx context.Context) (*EnvelopeAllocation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnvelopeAllocationUpdateOne) SaveX(ctx context.Context) *EnvelopeAllocation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnvelopeAllocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnvelopeAllocationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnvelopeAllocationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := envelopeallocation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnvelopeAllocationUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := envelopeallocation.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "EnvelopeAllocation.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := envelopeallocation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "EnvelopeAllocation.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvelopeAllocation.ledger"`)
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EnvelopeAllocation.category"`)
+	}
+	return nil
+}
+
+func (_u *EnvelopeAllocationUpdateOne) sqlSave(ctx context.Context) (_node *EnvelopeAllocation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envelopeallocation.Table, envelopeallocation.Columns, sqlgraph.NewFieldSpec(envelopeallocation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnvelopeAllocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envelopeallocation.FieldID)
+		for _, f := range fields {
+			if !envelopeallocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != envelopeallocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(envelopeallocation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(envelopeallocation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(envelopeallocation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(envelopeallocation.FieldMonth, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(envelopeallocation.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TransferID(); ok {
+		_spec.SetField(envelopeallocation.FieldTransferID, field.TypeUUID, value)
+	}
+	if _u.mutation.TransferIDCleared() {
+		_spec.ClearField(envelopeallocation.FieldTransferID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(envelopeallocation.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(envelopeallocation.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.LedgerTable,
+			Columns: []string{envelopeallocation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.LedgerTable,
+			Columns: []string{envelopeallocation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.CategoryTable,
+			Columns: []string{envelopeallocation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   envelopeallocation.CategoryTable,
+			Columns: []string{envelopeallocation.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EnvelopeAllocation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envelopeallocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
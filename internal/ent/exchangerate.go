@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/ent/exchangerate"
+	"frog-go/internal/ent/ledger"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FromCurrency holds the value of the "from_currency" field.
+	FromCurrency string `json:"from_currency,omitempty"`
+	// ToCurrency holds the value of the "to_currency" field.
+	ToCurrency string `json:"to_currency,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate float64 `json:"rate,omitempty"`
+	// RateDate holds the value of the "rate_date" field.
+	RateDate time.Time `json:"rate_date,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExchangeRateQuery when eager-loading is set.
+	Edges        ExchangeRateEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// ExchangeRateEdges holds the relations/edges for other nodes in the graph.
+type ExchangeRateEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExchangeRateEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldRate:
+			values[i] = new(sql.NullFloat64)
+		case exchangerate.FieldFromCurrency, exchangerate.FieldToCurrency:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldCreatedAt, exchangerate.FieldUpdatedAt, exchangerate.FieldRateDate:
+			values[i] = new(sql.NullTime)
+		case exchangerate.FieldID:
+			values[i] = new(uuid.UUID)
+		case exchangerate.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (_m *ExchangeRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case exchangerate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case exchangerate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case exchangerate.FieldFromCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_currency", values[i])
+			} else if value.Valid {
+				_m.FromCurrency = value.String
+			}
+		case exchangerate.FieldToCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_currency", values[i])
+			} else if value.Valid {
+				_m.ToCurrency = value.String
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				_m.Rate = value.Float64
+			}
+		case exchangerate.FieldRateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_date", values[i])
+			} else if value.Valid {
+				_m.RateDate = value.Time
+			}
+		case exchangerate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExchangeRate.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExchangeRate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the ExchangeRate entity.
+func (_m *ExchangeRate) QueryLedger() *LedgerQuery {
+	return NewExchangeRateClient(_m.config).QueryLedger(_m)
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return NewExchangeRateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("from_currency=")
+	builder.WriteString(_m.FromCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("to_currency=")
+	builder.WriteString(_m.ToCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("rate_date=")
+	builder.WriteString(_m.RateDate.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FromCurrency applies equality check predicate on the "from_currency" field. It's identical to FromCurrencyEQ.
+func FromCurrency(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldFromCurrency, v))
+}
+
+// ToCurrency applies equality check predicate on the "to_currency" field. It's identical to ToCurrencyEQ.
+func ToCurrency(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldToCurrency, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateDate applies equality check predicate on the "rate_date" field. It's identical to RateDateEQ.
+func RateDate(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRateDate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// FromCurrencyEQ applies the EQ predicate on the "from_currency" field.
+func FromCurrencyEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldFromCurrency, v))
+}
+
+// FromCurrencyNEQ applies the NEQ predicate on the "from_currency" field.
+func FromCurrencyNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldFromCurrency, v))
+}
+
+// FromCurrencyIn applies the In predicate on the "from_currency" field.
+func FromCurrencyIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldFromCurrency, vs...))
+}
+
+// FromCurrencyNotIn applies the NotIn predicate on the "from_currency" field.
+func FromCurrencyNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldFromCurrency, vs...))
+}
+
+// FromCurrencyGT applies the GT predicate on the "from_currency" field.
+func FromCurrencyGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldFromCurrency, v))
+}
+
+// FromCurrencyGTE applies the GTE predicate on the "from_currency" field.
+func FromCurrencyGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldFromCurrency, v))
+}
+
+// FromCurrencyLT applies the LT predicate on the "from_currency" field.
+func FromCurrencyLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldFromCurrency, v))
+}
+
+// FromCurrencyLTE applies the LTE predicate on the "from_currency" field.
+func FromCurrencyLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldFromCurrency, v))
+}
+
+// FromCurrencyContains applies the Contains predicate on the "from_currency" field.
+func FromCurrencyContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldFromCurrency, v))
+}
+
+// FromCurrencyHasPrefix applies the HasPrefix predicate on the "from_currency" field.
+func FromCurrencyHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldFromCurrency, v))
+}
+
+// FromCurrencyHasSuffix applies the HasSuffix predicate on the "from_currency" field.
+func FromCurrencyHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldFromCurrency, v))
+}
+
+// FromCurrencyEqualFold applies the EqualFold predicate on the "from_currency" field.
+func FromCurrencyEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldFromCurrency, v))
+}
+
+// FromCurrencyContainsFold applies the ContainsFold predicate on the "from_currency" field.
+func FromCurrencyContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldFromCurrency, v))
+}
+
+// ToCurrencyEQ applies the EQ predicate on the "to_currency" field.
+func ToCurrencyEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldToCurrency, v))
+}
+
+// ToCurrencyNEQ applies the NEQ predicate on the "to_currency" field.
+func ToCurrencyNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldToCurrency, v))
+}
+
+// ToCurrencyIn applies the In predicate on the "to_currency" field.
+func ToCurrencyIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldToCurrency, vs...))
+}
+
+// ToCurrencyNotIn applies the NotIn predicate on the "to_currency" field.
+func ToCurrencyNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldToCurrency, vs...))
+}
+
+// ToCurrencyGT applies the GT predicate on the "to_currency" field.
+func ToCurrencyGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldToCurrency, v))
+}
+
+// ToCurrencyGTE applies the GTE predicate on the "to_currency" field.
+func ToCurrencyGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldToCurrency, v))
+}
+
+// ToCurrencyLT applies the LT predicate on the "to_currency" field.
+func ToCurrencyLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldToCurrency, v))
+}
+
+// ToCurrencyLTE applies the LTE predicate on the "to_currency" field.
+func ToCurrencyLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldToCurrency, v))
+}
+
+// ToCurrencyContains applies the Contains predicate on the "to_currency" field.
+func ToCurrencyContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldToCurrency, v))
+}
+
+// ToCurrencyHasPrefix applies the HasPrefix predicate on the "to_currency" field.
+func ToCurrencyHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldToCurrency, v))
+}
+
+// ToCurrencyHasSuffix applies the HasSuffix predicate on the "to_currency" field.
+func ToCurrencyHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldToCurrency, v))
+}
+
+// ToCurrencyEqualFold applies the EqualFold predicate on the "to_currency" field.
+func ToCurrencyEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldToCurrency, v))
+}
+
+// ToCurrencyContainsFold applies the ContainsFold predicate on the "to_currency" field.
+func ToCurrencyContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldToCurrency, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldRate, v))
+}
+
+// RateDateEQ applies the EQ predicate on the "rate_date" field.
+func RateDateEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRateDate, v))
+}
+
+// RateDateNEQ applies the NEQ predicate on the "rate_date" field.
+func RateDateNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldRateDate, v))
+}
+
+// RateDateIn applies the In predicate on the "rate_date" field.
+func RateDateIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldRateDate, vs...))
+}
+
+// RateDateNotIn applies the NotIn predicate on the "rate_date" field.
+func RateDateNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldRateDate, vs...))
+}
+
+// RateDateGT applies the GT predicate on the "rate_date" field.
+func RateDateGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldRateDate, v))
+}
+
+// RateDateGTE applies the GTE predicate on the "rate_date" field.
+func RateDateGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldRateDate, v))
+}
+
+// RateDateLT applies the LT predicate on the "rate_date" field.
+func RateDateLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldRateDate, v))
+}
+
+// RateDateLTE applies the LTE predicate on the "rate_date" field.
+func RateDateLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldRateDate, v))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.ExchangeRate {
+	return predicate.ExchangeRate(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.NotPredicates(p))
+}
@@ -0,0 +1,831 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/exchangerate"
+	"frog-go/internal/ent/ledger"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ExchangeRateCreate is the builder for creating a ExchangeRate entity.
+type ExchangeRateCreate struct {
+	config
+	mutation *ExchangeRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExchangeRateCreate) SetCreatedAt(v time.Time) *ExchangeRateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExchangeRateCreate) SetNillableCreatedAt(v *time.Time) *ExchangeRateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExchangeRateCreate) SetUpdatedAt(v time.Time) *ExchangeRateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExchangeRateCreate) SetNillableUpdatedAt(v *time.Time) *ExchangeRateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (_c *ExchangeRateCreate) SetFromCurrency(v string) *ExchangeRateCreate {
+	_c.mutation.SetFromCurrency(v)
+	return _c
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (_c *ExchangeRateCreate) SetToCurrency(v string) *ExchangeRateCreate {
+	_c.mutation.SetToCurrency(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *ExchangeRateCreate) SetRate(v float64) *ExchangeRateCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetRateDate sets the "rate_date" field.
+func (_c *ExchangeRateCreate) SetRateDate(v time.Time) *ExchangeRateCreate {
+	_c.mutation.SetRateDate(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExchangeRateCreate) SetID(v uuid.UUID) *ExchangeRateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExchangeRateCreate) SetNillableID(v *uuid.UUID) *ExchangeRateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *ExchangeRateCreate) SetLedgerID(id uuid.UUID) *ExchangeRateCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *ExchangeRateCreate) SetLedger(v *Ledger) *ExchangeRateCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (_c *ExchangeRateCreate) Mutation() *ExchangeRateMutation {
+	return _c.mutation
+}
+
+// Save creates the ExchangeRate in the database.
+func (_c *ExchangeRateCreate) Save(ctx context.Context) (*ExchangeRate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExchangeRateCreate) SaveX(ctx context.Context) *ExchangeRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExchangeRateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExchangeRateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExchangeRateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exchangerate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := exchangerate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := exchangerate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExchangeRateCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExchangeRate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExchangeRate.updated_at"`)}
+	}
+	if _, ok := _c.mutation.FromCurrency(); !ok {
+		return &ValidationError{Name: "from_currency", err: errors.New(`ent: missing required field "ExchangeRate.from_currency"`)}
+	}
+	if v, ok := _c.mutation.FromCurrency(); ok {
+		if err := exchangerate.FromCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "from_currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.from_currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToCurrency(); !ok {
+		return &ValidationError{Name: "to_currency", err: errors.New(`ent: missing required field "ExchangeRate.to_currency"`)}
+	}
+	if v, ok := _c.mutation.ToCurrency(); ok {
+		if err := exchangerate.ToCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "to_currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.to_currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ExchangeRate.rate"`)}
+	}
+	if v, ok := _c.mutation.Rate(); ok {
+		if err := exchangerate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.rate": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RateDate(); !ok {
+		return &ValidationError{Name: "rate_date", err: errors.New(`ent: missing required field "ExchangeRate.rate_date"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "ExchangeRate.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *ExchangeRateCreate) sqlSave(ctx context.Context) (*ExchangeRate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExchangeRateCreate) createSpec() (*ExchangeRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExchangeRate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exchangerate.Table, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exchangerate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(exchangerate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.FromCurrency(); ok {
+		_spec.SetField(exchangerate.FieldFromCurrency, field.TypeString, value)
+		_node.FromCurrency = value
+	}
+	if value, ok := _c.mutation.ToCurrency(); ok {
+		_spec.SetField(exchangerate.FieldToCurrency, field.TypeString, value)
+		_node.ToCurrency = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.RateDate(); ok {
+		_spec.SetField(exchangerate.FieldRateDate, field.TypeTime, value)
+		_node.RateDate = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exchangerate.LedgerTable,
+			Columns: []string{exchangerate.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeRate.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeRateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExchangeRateCreate) OnConflict(opts ...sql.ConflictOption) *ExchangeRateUpsertOne {
+	_c.conflict = opts
+	return &ExchangeRateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExchangeRateCreate) OnConflictColumns(columns ...string) *ExchangeRateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeRateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExchangeRateUpsertOne is the builder for "upsert"-ing
+	//  one ExchangeRate node.
+	ExchangeRateUpsertOne struct {
+		create *ExchangeRateCreate
+	}
+
+	// ExchangeRateUpsert is the "OnConflict" setter.
+	ExchangeRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExchangeRateUpsert) SetUpdatedAt(v time.Time) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateUpdatedAt() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldUpdatedAt)
+	return u
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (u *ExchangeRateUpsert) SetFromCurrency(v string) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldFromCurrency, v)
+	return u
+}
+
+// UpdateFromCurrency sets the "from_currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateFromCurrency() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldFromCurrency)
+	return u
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (u *ExchangeRateUpsert) SetToCurrency(v string) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldToCurrency, v)
+	return u
+}
+
+// UpdateToCurrency sets the "to_currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateToCurrency() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldToCurrency)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *ExchangeRateUpsert) SetRate(v float64) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateRate() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldRate)
+	return u
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ExchangeRateUpsert) AddRate(v float64) *ExchangeRateUpsert {
+	u.Add(exchangerate.FieldRate, v)
+	return u
+}
+
+// SetRateDate sets the "rate_date" field.
+func (u *ExchangeRateUpsert) SetRateDate(v time.Time) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldRateDate, v)
+	return u
+}
+
+// UpdateRateDate sets the "rate_date" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateRateDate() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldRateDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exchangerate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertOne) UpdateNewValues() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exchangerate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(exchangerate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExchangeRateUpsertOne) Ignore() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeRateUpsertOne) DoNothing() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeRateCreate.OnConflict
+// documentation for more info.
+func (u *ExchangeRateUpsertOne) Update(set func(*ExchangeRateUpsert)) *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExchangeRateUpsertOne) SetUpdatedAt(v time.Time) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateUpdatedAt() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (u *ExchangeRateUpsertOne) SetFromCurrency(v string) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetFromCurrency(v)
+	})
+}
+
+// UpdateFromCurrency sets the "from_currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateFromCurrency() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateFromCurrency()
+	})
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (u *ExchangeRateUpsertOne) SetToCurrency(v string) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetToCurrency(v)
+	})
+}
+
+// UpdateToCurrency sets the "to_currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateToCurrency() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateToCurrency()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ExchangeRateUpsertOne) SetRate(v float64) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ExchangeRateUpsertOne) AddRate(v float64) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateRate() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetRateDate sets the "rate_date" field.
+func (u *ExchangeRateUpsertOne) SetRateDate(v time.Time) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRateDate(v)
+	})
+}
+
+// UpdateRateDate sets the "rate_date" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateRateDate() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExchangeRateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExchangeRateUpsertOne.ID is not supported by MySQL driver. Use ExchangeRateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExchangeRateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExchangeRateCreateBulk is the builder for creating many ExchangeRate entities in bulk.
+type ExchangeRateCreateBulk struct {
+	config
+	err      error
+	builders []*ExchangeRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExchangeRate entities in the database.
+func (_c *ExchangeRateCreateBulk) Save(ctx context.Context) ([]*ExchangeRate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExchangeRate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExchangeRateCreateBulk) SaveX(ctx context.Context) []*ExchangeRate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExchangeRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExchangeRateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeRateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExchangeRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExchangeRateUpsertBulk {
+	_c.conflict = opts
+	return &ExchangeRateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExchangeRateCreateBulk) OnConflictColumns(columns ...string) *ExchangeRateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeRateUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExchangeRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExchangeRate nodes.
+type ExchangeRateUpsertBulk struct {
+	create *ExchangeRateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exchangerate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertBulk) UpdateNewValues() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exchangerate.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(exchangerate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertBulk) Ignore() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeRateUpsertBulk) DoNothing() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExchangeRateUpsertBulk) Update(set func(*ExchangeRateUpsert)) *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExchangeRateUpsertBulk) SetUpdatedAt(v time.Time) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateUpdatedAt() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFromCurrency sets the "from_currency" field.
+func (u *ExchangeRateUpsertBulk) SetFromCurrency(v string) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetFromCurrency(v)
+	})
+}
+
+// UpdateFromCurrency sets the "from_currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateFromCurrency() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateFromCurrency()
+	})
+}
+
+// SetToCurrency sets the "to_currency" field.
+func (u *ExchangeRateUpsertBulk) SetToCurrency(v string) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetToCurrency(v)
+	})
+}
+
+// UpdateToCurrency sets the "to_currency" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateToCurrency() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateToCurrency()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *ExchangeRateUpsertBulk) SetRate(v float64) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// AddRate adds v to the "rate" field.
+func (u *ExchangeRateUpsertBulk) AddRate(v float64) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.AddRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateRate() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetRateDate sets the "rate_date" field.
+func (u *ExchangeRateUpsertBulk) SetRateDate(v time.Time) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetRateDate(v)
+	})
+}
+
+// UpdateRateDate sets the "rate_date" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateRateDate() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateRateDate()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeRateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExchangeRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/goal"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/tag"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Goal is the model entity for the Goal schema.
+type Goal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TargetAmount holds the value of the "target_amount" field.
+	TargetAmount domain.Money `json:"target_amount,omitempty"`
+	// InitialAmount holds the value of the "initial_amount" field.
+	InitialAmount domain.Money `json:"initial_amount,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// TargetDate holds the value of the "target_date" field.
+	TargetDate time.Time `json:"target_date,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID *uuid.UUID `json:"account_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID *uuid.UUID `json:"tag_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoalQuery when eager-loading is set.
+	Edges        GoalEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// GoalEdges holds the relations/edges for other nodes in the graph.
+type GoalEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Goal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldAccountID, goal.FieldCategoryID, goal.FieldTagID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case goal.FieldTargetAmount, goal.FieldInitialAmount:
+			values[i] = new(domain.Money)
+		case goal.FieldName:
+			values[i] = new(sql.NullString)
+		case goal.FieldCreatedAt, goal.FieldUpdatedAt, goal.FieldTargetDate:
+			values[i] = new(sql.NullTime)
+		case goal.FieldID:
+			values[i] = new(uuid.UUID)
+		case goal.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Goal fields.
+func (_m *Goal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case goal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case goal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case goal.FieldTargetAmount:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field target_amount", values[i])
+			} else if value != nil {
+				_m.TargetAmount = *value
+			}
+		case goal.FieldInitialAmount:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field initial_amount", values[i])
+			} else if value != nil {
+				_m.InitialAmount = *value
+			}
+		case goal.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case goal.FieldTargetDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field target_date", values[i])
+			} else if value.Valid {
+				_m.TargetDate = value.Time
+			}
+		case goal.FieldAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = new(uuid.UUID)
+				*_m.AccountID = *value.S.(*uuid.UUID)
+			}
+		case goal.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(uuid.UUID)
+				*_m.CategoryID = *value.S.(*uuid.UUID)
+			}
+		case goal.FieldTagID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				_m.TagID = new(uuid.UUID)
+				*_m.TagID = *value.S.(*uuid.UUID)
+			}
+		case goal.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Goal.
+// This includes values selected through modifiers, order, etc.
+func (_m *Goal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Goal entity.
+func (_m *Goal) QueryLedger() *LedgerQuery {
+	return NewGoalClient(_m.config).QueryLedger(_m)
+}
+
+// QueryAccount queries the "account" edge of the Goal entity.
+func (_m *Goal) QueryAccount() *AccountQuery {
+	return NewGoalClient(_m.config).QueryAccount(_m)
+}
+
+// QueryCategory queries the "category" edge of the Goal entity.
+func (_m *Goal) QueryCategory() *CategoryQuery {
+	return NewGoalClient(_m.config).QueryCategory(_m)
+}
+
+// QueryTag queries the "tag" edge of the Goal entity.
+func (_m *Goal) QueryTag() *TagQuery {
+	return NewGoalClient(_m.config).QueryTag(_m)
+}
+
+// Update returns a builder for updating this Goal.
+// Note that you need to call Goal.Unwrap() before calling this method if this Goal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Goal) Update() *GoalUpdateOne {
+	return NewGoalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Goal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Goal) Unwrap() *Goal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Goal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Goal) String() string {
+	var builder strings.Builder
+	builder.WriteString("Goal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("target_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("initial_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InitialAmount))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("target_date=")
+	builder.WriteString(_m.TargetDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TagID; v != nil {
+		builder.WriteString("tag_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Goals is a parsable slice of Goal.
+type Goals []*Goal
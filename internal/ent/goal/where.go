@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goal
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TargetAmount applies equality check predicate on the "target_amount" field. It's identical to TargetAmountEQ.
+func TargetAmount(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTargetAmount, v))
+}
+
+// InitialAmount applies equality check predicate on the "initial_amount" field. It's identical to InitialAmountEQ.
+func InitialAmount(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldInitialAmount, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldName, v))
+}
+
+// TargetDate applies equality check predicate on the "target_date" field. It's identical to TargetDateEQ.
+func TargetDate(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTargetDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTagID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TargetAmountEQ applies the EQ predicate on the "target_amount" field.
+func TargetAmountEQ(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTargetAmount, v))
+}
+
+// TargetAmountNEQ applies the NEQ predicate on the "target_amount" field.
+func TargetAmountNEQ(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldTargetAmount, v))
+}
+
+// TargetAmountIn applies the In predicate on the "target_amount" field.
+func TargetAmountIn(vs ...domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldTargetAmount, vs...))
+}
+
+// TargetAmountNotIn applies the NotIn predicate on the "target_amount" field.
+func TargetAmountNotIn(vs ...domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldTargetAmount, vs...))
+}
+
+// TargetAmountGT applies the GT predicate on the "target_amount" field.
+func TargetAmountGT(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldTargetAmount, v))
+}
+
+// TargetAmountGTE applies the GTE predicate on the "target_amount" field.
+func TargetAmountGTE(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldTargetAmount, v))
+}
+
+// TargetAmountLT applies the LT predicate on the "target_amount" field.
+func TargetAmountLT(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldTargetAmount, v))
+}
+
+// TargetAmountLTE applies the LTE predicate on the "target_amount" field.
+func TargetAmountLTE(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldTargetAmount, v))
+}
+
+// InitialAmountEQ applies the EQ predicate on the "initial_amount" field.
+func InitialAmountEQ(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldInitialAmount, v))
+}
+
+// InitialAmountNEQ applies the NEQ predicate on the "initial_amount" field.
+func InitialAmountNEQ(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldInitialAmount, v))
+}
+
+// InitialAmountIn applies the In predicate on the "initial_amount" field.
+func InitialAmountIn(vs ...domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldInitialAmount, vs...))
+}
+
+// InitialAmountNotIn applies the NotIn predicate on the "initial_amount" field.
+func InitialAmountNotIn(vs ...domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldInitialAmount, vs...))
+}
+
+// InitialAmountGT applies the GT predicate on the "initial_amount" field.
+func InitialAmountGT(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldInitialAmount, v))
+}
+
+// InitialAmountGTE applies the GTE predicate on the "initial_amount" field.
+func InitialAmountGTE(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldInitialAmount, v))
+}
+
+// InitialAmountLT applies the LT predicate on the "initial_amount" field.
+func InitialAmountLT(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldInitialAmount, v))
+}
+
+// InitialAmountLTE applies the LTE predicate on the "initial_amount" field.
+func InitialAmountLTE(v domain.Money) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldInitialAmount, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldContainsFold(FieldName, v))
+}
+
+// TargetDateEQ applies the EQ predicate on the "target_date" field.
+func TargetDateEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTargetDate, v))
+}
+
+// TargetDateNEQ applies the NEQ predicate on the "target_date" field.
+func TargetDateNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldTargetDate, v))
+}
+
+// TargetDateIn applies the In predicate on the "target_date" field.
+func TargetDateIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldTargetDate, vs...))
+}
+
+// TargetDateNotIn applies the NotIn predicate on the "target_date" field.
+func TargetDateNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldTargetDate, vs...))
+}
+
+// TargetDateGT applies the GT predicate on the "target_date" field.
+func TargetDateGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldTargetDate, v))
+}
+
+// TargetDateGTE applies the GTE predicate on the "target_date" field.
+func TargetDateGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldTargetDate, v))
+}
+
+// TargetDateLT applies the LT predicate on the "target_date" field.
+func TargetDateLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldTargetDate, v))
+}
+
+// TargetDateLTE applies the LTE predicate on the "target_date" field.
+func TargetDateLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldTargetDate, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldAccountID))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldCategoryID))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// TagIDIsNil applies the IsNil predicate on the "tag_id" field.
+func TagIDIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldTagID))
+}
+
+// TagIDNotNil applies the NotNil predicate on the "tag_id" field.
+func TagIDNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldTagID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Goal) predicate.Goal {
+	return predicate.Goal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Goal) predicate.Goal {
+	return predicate.Goal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Goal) predicate.Goal {
+	return predicate.Goal(sql.NotPredicates(p))
+}
@@ -0,0 +1,1145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/goal"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/tag"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalCreate is the builder for creating a Goal entity.
+type GoalCreate struct {
+	config
+	mutation *GoalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoalCreate) SetCreatedAt(v time.Time) *GoalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableCreatedAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GoalCreate) SetUpdatedAt(v time.Time) *GoalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableUpdatedAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_c *GoalCreate) SetTargetAmount(v domain.Money) *GoalCreate {
+	_c.mutation.SetTargetAmount(v)
+	return _c
+}
+
+// SetInitialAmount sets the "initial_amount" field.
+func (_c *GoalCreate) SetInitialAmount(v domain.Money) *GoalCreate {
+	_c.mutation.SetInitialAmount(v)
+	return _c
+}
+
+// SetNillableInitialAmount sets the "initial_amount" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableInitialAmount(v *domain.Money) *GoalCreate {
+	if v != nil {
+		_c.SetInitialAmount(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *GoalCreate) SetName(v string) *GoalCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTargetDate sets the "target_date" field.
+func (_c *GoalCreate) SetTargetDate(v time.Time) *GoalCreate {
+	_c.mutation.SetTargetDate(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *GoalCreate) SetAccountID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableAccountID(v *uuid.UUID) *GoalCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *GoalCreate) SetCategoryID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableCategoryID(v *uuid.UUID) *GoalCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *GoalCreate) SetTagID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableTagID(v *uuid.UUID) *GoalCreate {
+	if v != nil {
+		_c.SetTagID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoalCreate) SetID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableID(v *uuid.UUID) *GoalCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *GoalCreate) SetLedgerID(id uuid.UUID) *GoalCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *GoalCreate) SetLedger(v *Ledger) *GoalCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *GoalCreate) SetAccount(v *Account) *GoalCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *GoalCreate) SetCategory(v *Category) *GoalCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *GoalCreate) SetTag(v *Tag) *GoalCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_c *GoalCreate) Mutation() *GoalMutation {
+	return _c.mutation
+}
+
+// Save creates the Goal in the database.
+func (_c *GoalCreate) Save(ctx context.Context) (*Goal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoalCreate) SaveX(ctx context.Context) *Goal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoalCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := goal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.InitialAmount(); !ok {
+		v := goal.DefaultInitialAmount
+		_c.mutation.SetInitialAmount(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := goal.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoalCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Goal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Goal.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TargetAmount(); !ok {
+		return &ValidationError{Name: "target_amount", err: errors.New(`ent: missing required field "Goal.target_amount"`)}
+	}
+	if _, ok := _c.mutation.InitialAmount(); !ok {
+		return &ValidationError{Name: "initial_amount", err: errors.New(`ent: missing required field "Goal.initial_amount"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Goal.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetDate(); !ok {
+		return &ValidationError{Name: "target_date", err: errors.New(`ent: missing required field "Goal.target_date"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Goal.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *GoalCreate) sqlSave(ctx context.Context) (*Goal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Goal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goal.Table, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TargetAmount(); ok {
+		_spec.SetField(goal.FieldTargetAmount, field.TypeInt64, value)
+		_node.TargetAmount = value
+	}
+	if value, ok := _c.mutation.InitialAmount(); ok {
+		_spec.SetField(goal.FieldInitialAmount, field.TypeInt64, value)
+		_node.InitialAmount = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(goal.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.TargetDate(); ok {
+		_spec.SetField(goal.FieldTargetDate, field.TypeTime, value)
+		_node.TargetDate = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.LedgerTable,
+			Columns: []string{goal.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.AccountTable,
+			Columns: []string{goal.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.CategoryTable,
+			Columns: []string{goal.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.TagTable,
+			Columns: []string{goal.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflict(opts ...sql.ConflictOption) *GoalUpsertOne {
+	_c.conflict = opts
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflictColumns(columns ...string) *GoalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoalUpsertOne is the builder for "upsert"-ing
+	//  one Goal node.
+	GoalUpsertOne struct {
+		create *GoalCreate
+	}
+
+	// GoalUpsert is the "OnConflict" setter.
+	GoalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsert) SetUpdatedAt(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateUpdatedAt() *GoalUpsert {
+	u.SetExcluded(goal.FieldUpdatedAt)
+	return u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (u *GoalUpsert) SetTargetAmount(v domain.Money) *GoalUpsert {
+	u.Set(goal.FieldTargetAmount, v)
+	return u
+}
+
+// UpdateTargetAmount sets the "target_amount" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTargetAmount() *GoalUpsert {
+	u.SetExcluded(goal.FieldTargetAmount)
+	return u
+}
+
+// AddTargetAmount adds v to the "target_amount" field.
+func (u *GoalUpsert) AddTargetAmount(v domain.Money) *GoalUpsert {
+	u.Add(goal.FieldTargetAmount, v)
+	return u
+}
+
+// SetInitialAmount sets the "initial_amount" field.
+func (u *GoalUpsert) SetInitialAmount(v domain.Money) *GoalUpsert {
+	u.Set(goal.FieldInitialAmount, v)
+	return u
+}
+
+// UpdateInitialAmount sets the "initial_amount" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateInitialAmount() *GoalUpsert {
+	u.SetExcluded(goal.FieldInitialAmount)
+	return u
+}
+
+// AddInitialAmount adds v to the "initial_amount" field.
+func (u *GoalUpsert) AddInitialAmount(v domain.Money) *GoalUpsert {
+	u.Add(goal.FieldInitialAmount, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GoalUpsert) SetName(v string) *GoalUpsert {
+	u.Set(goal.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateName() *GoalUpsert {
+	u.SetExcluded(goal.FieldName)
+	return u
+}
+
+// SetTargetDate sets the "target_date" field.
+func (u *GoalUpsert) SetTargetDate(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldTargetDate, v)
+	return u
+}
+
+// UpdateTargetDate sets the "target_date" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTargetDate() *GoalUpsert {
+	u.SetExcluded(goal.FieldTargetDate)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *GoalUpsert) SetAccountID(v uuid.UUID) *GoalUpsert {
+	u.Set(goal.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateAccountID() *GoalUpsert {
+	u.SetExcluded(goal.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *GoalUpsert) ClearAccountID() *GoalUpsert {
+	u.SetNull(goal.FieldAccountID)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *GoalUpsert) SetCategoryID(v uuid.UUID) *GoalUpsert {
+	u.Set(goal.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateCategoryID() *GoalUpsert {
+	u.SetExcluded(goal.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *GoalUpsert) ClearCategoryID() *GoalUpsert {
+	u.SetNull(goal.FieldCategoryID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *GoalUpsert) SetTagID(v uuid.UUID) *GoalUpsert {
+	u.Set(goal.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTagID() *GoalUpsert {
+	u.SetExcluded(goal.FieldTagID)
+	return u
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (u *GoalUpsert) ClearTagID() *GoalUpsert {
+	u.SetNull(goal.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertOne) UpdateNewValues() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goal.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoalUpsertOne) Ignore() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertOne) DoNothing() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreate.OnConflict
+// documentation for more info.
+func (u *GoalUpsertOne) Update(set func(*GoalUpsert)) *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertOne) SetUpdatedAt(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateUpdatedAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (u *GoalUpsertOne) SetTargetAmount(v domain.Money) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetAmount(v)
+	})
+}
+
+// AddTargetAmount adds v to the "target_amount" field.
+func (u *GoalUpsertOne) AddTargetAmount(v domain.Money) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddTargetAmount(v)
+	})
+}
+
+// UpdateTargetAmount sets the "target_amount" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTargetAmount() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetAmount()
+	})
+}
+
+// SetInitialAmount sets the "initial_amount" field.
+func (u *GoalUpsertOne) SetInitialAmount(v domain.Money) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetInitialAmount(v)
+	})
+}
+
+// AddInitialAmount adds v to the "initial_amount" field.
+func (u *GoalUpsertOne) AddInitialAmount(v domain.Money) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddInitialAmount(v)
+	})
+}
+
+// UpdateInitialAmount sets the "initial_amount" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateInitialAmount() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateInitialAmount()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GoalUpsertOne) SetName(v string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateName() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTargetDate sets the "target_date" field.
+func (u *GoalUpsertOne) SetTargetDate(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetDate(v)
+	})
+}
+
+// UpdateTargetDate sets the "target_date" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTargetDate() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetDate()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *GoalUpsertOne) SetAccountID(v uuid.UUID) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateAccountID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *GoalUpsertOne) ClearAccountID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *GoalUpsertOne) SetCategoryID(v uuid.UUID) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateCategoryID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *GoalUpsertOne) ClearCategoryID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *GoalUpsertOne) SetTagID(v uuid.UUID) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTagID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (u *GoalUpsertOne) ClearTagID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoalUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoalUpsertOne.ID is not supported by MySQL driver. Use GoalUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoalUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoalCreateBulk is the builder for creating many Goal entities in bulk.
+type GoalCreateBulk struct {
+	config
+	err      error
+	builders []*GoalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Goal entities in the database.
+func (_c *GoalCreateBulk) Save(ctx context.Context) ([]*Goal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Goal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoalCreateBulk) SaveX(ctx context.Context) []*Goal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoalUpsertBulk {
+	_c.conflict = opts
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflictColumns(columns ...string) *GoalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoalUpsertBulk is the builder for "upsert"-ing
+// a bulk of Goal nodes.
+type GoalUpsertBulk struct {
+	create *GoalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) UpdateNewValues() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goal.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) Ignore() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertBulk) DoNothing() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoalUpsertBulk) Update(set func(*GoalUpsert)) *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertBulk) SetUpdatedAt(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateUpdatedAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (u *GoalUpsertBulk) SetTargetAmount(v domain.Money) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetAmount(v)
+	})
+}
+
+// AddTargetAmount adds v to the "target_amount" field.
+func (u *GoalUpsertBulk) AddTargetAmount(v domain.Money) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddTargetAmount(v)
+	})
+}
+
+// UpdateTargetAmount sets the "target_amount" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTargetAmount() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetAmount()
+	})
+}
+
+// SetInitialAmount sets the "initial_amount" field.
+func (u *GoalUpsertBulk) SetInitialAmount(v domain.Money) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetInitialAmount(v)
+	})
+}
+
+// AddInitialAmount adds v to the "initial_amount" field.
+func (u *GoalUpsertBulk) AddInitialAmount(v domain.Money) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddInitialAmount(v)
+	})
+}
+
+// UpdateInitialAmount sets the "initial_amount" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateInitialAmount() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateInitialAmount()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GoalUpsertBulk) SetName(v string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateName() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTargetDate sets the "target_date" field.
+func (u *GoalUpsertBulk) SetTargetDate(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetDate(v)
+	})
+}
+
+// UpdateTargetDate sets the "target_date" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTargetDate() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetDate()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *GoalUpsertBulk) SetAccountID(v uuid.UUID) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateAccountID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *GoalUpsertBulk) ClearAccountID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *GoalUpsertBulk) SetCategoryID(v uuid.UUID) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateCategoryID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *GoalUpsertBulk) ClearCategoryID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *GoalUpsertBulk) SetTagID(v uuid.UUID) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTagID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (u *GoalUpsertBulk) ClearTagID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
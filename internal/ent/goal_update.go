@@ -0,0 +1,878 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/goal"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/tag"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalUpdate is the builder for updating Goal entities.
+type GoalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (_u *GoalUpdate) Where(ps ...predicate.Goal) *GoalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalUpdate) SetUpdatedAt(v time.Time) *GoalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_u *GoalUpdate) SetTargetAmount(v domain.Money) *GoalUpdate {
+	_u.mutation.ResetTargetAmount()
+	_u.mutation.SetTargetAmount(v)
+	return _u
+}
+
+// SetNillableTargetAmount sets the "target_amount" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableTargetAmount(v *domain.Money) *GoalUpdate {
+	if v != nil {
+		_u.SetTargetAmount(*v)
+	}
+	return _u
+}
+
+// AddTargetAmount adds value to the "target_amount" field.
+func (_u *GoalUpdate) AddTargetAmount(v domain.Money) *GoalUpdate {
+	_u.mutation.AddTargetAmount(v)
+	return _u
+}
+
+// SetInitialAmount sets the "initial_amount" field.
+func (_u *GoalUpdate) SetInitialAmount(v domain.Money) *GoalUpdate {
+	_u.mutation.ResetInitialAmount()
+	_u.mutation.SetInitialAmount(v)
+	return _u
+}
+
+// SetNillableInitialAmount sets the "initial_amount" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableInitialAmount(v *domain.Money) *GoalUpdate {
+	if v != nil {
+		_u.SetInitialAmount(*v)
+	}
+	return _u
+}
+
+// AddInitialAmount adds value to the "initial_amount" field.
+func (_u *GoalUpdate) AddInitialAmount(v domain.Money) *GoalUpdate {
+	_u.mutation.AddInitialAmount(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GoalUpdate) SetName(v string) *GoalUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableName(v *string) *GoalUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTargetDate sets the "target_date" field.
+func (_u *GoalUpdate) SetTargetDate(v time.Time) *GoalUpdate {
+	_u.mutation.SetTargetDate(v)
+	return _u
+}
+
+// SetNillableTargetDate sets the "target_date" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableTargetDate(v *time.Time) *GoalUpdate {
+	if v != nil {
+		_u.SetTargetDate(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *GoalUpdate) SetAccountID(v uuid.UUID) *GoalUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableAccountID(v *uuid.UUID) *GoalUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *GoalUpdate) ClearAccountID() *GoalUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *GoalUpdate) SetCategoryID(v uuid.UUID) *GoalUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableCategoryID(v *uuid.UUID) *GoalUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *GoalUpdate) ClearCategoryID() *GoalUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *GoalUpdate) SetTagID(v uuid.UUID) *GoalUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableTagID(v *uuid.UUID) *GoalUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (_u *GoalUpdate) ClearTagID() *GoalUpdate {
+	_u.mutation.ClearTagID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *GoalUpdate) SetLedgerID(id uuid.UUID) *GoalUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *GoalUpdate) SetLedger(v *Ledger) *GoalUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *GoalUpdate) SetAccount(v *Account) *GoalUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *GoalUpdate) SetCategory(v *Category) *GoalUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *GoalUpdate) SetTag(v *Tag) *GoalUpdate {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_u *GoalUpdate) Mutation() *GoalMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *GoalUpdate) ClearLedger() *GoalUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *GoalUpdate) ClearAccount() *GoalUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *GoalUpdate) ClearCategory() *GoalUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *GoalUpdate) ClearTag() *GoalUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Goal.ledger"`)
+	}
+	return nil
+}
+
+func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goal.Table, goal.Columns, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TargetAmount(); ok {
+		_spec.SetField(goal.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetAmount(); ok {
+		_spec.AddField(goal.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.InitialAmount(); ok {
+		_spec.SetField(goal.FieldInitialAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInitialAmount(); ok {
+		_spec.AddField(goal.FieldInitialAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(goal.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetDate(); ok {
+		_spec.SetField(goal.FieldTargetDate, field.TypeTime, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.LedgerTable,
+			Columns: []string{goal.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.LedgerTable,
+			Columns: []string{goal.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.AccountTable,
+			Columns: []string{goal.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.AccountTable,
+			Columns: []string{goal.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.CategoryTable,
+			Columns: []string{goal.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.CategoryTable,
+			Columns: []string{goal.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.TagTable,
+			Columns: []string{goal.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.TagTable,
+			Columns: []string{goal.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoalUpdateOne is the builder for updating a single Goal entity.
+type GoalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalUpdateOne) SetUpdatedAt(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_u *GoalUpdateOne) SetTargetAmount(v domain.Money) *GoalUpdateOne {
+	_u.mutation.ResetTargetAmount()
+	_u.mutation.SetTargetAmount(v)
+	return _u
+}
+
+// SetNillableTargetAmount sets the "target_amount" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTargetAmount(v *domain.Money) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTargetAmount(*v)
+	}
+	return _u
+}
+
+// AddTargetAmount adds value to the "target_amount" field.
+func (_u *GoalUpdateOne) AddTargetAmount(v domain.Money) *GoalUpdateOne {
+	_u.mutation.AddTargetAmount(v)
+	return _u
+}
+
+// SetInitialAmount sets the "initial_amount" field.
+func (_u *GoalUpdateOne) SetInitialAmount(v domain.Money) *GoalUpdateOne {
+	_u.mutation.ResetInitialAmount()
+	_u.mutation.SetInitialAmount(v)
+	return _u
+}
+
+// SetNillableInitialAmount sets the "initial_amount" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableInitialAmount(v *domain.Money) *GoalUpdateOne {
+	if v != nil {
+		_u.SetInitialAmount(*v)
+	}
+	return _u
+}
+
+// AddInitialAmount adds value to the "initial_amount" field.
+func (_u *GoalUpdateOne) AddInitialAmount(v domain.Money) *GoalUpdateOne {
+	_u.mutation.AddInitialAmount(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GoalUpdateOne) SetName(v string) *GoalUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableName(v *string) *GoalUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTargetDate sets the "target_date" field.
+func (_u *GoalUpdateOne) SetTargetDate(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetTargetDate(v)
+	return _u
+}
+
+// SetNillableTargetDate sets the "target_date" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTargetDate(v *time.Time) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTargetDate(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *GoalUpdateOne) SetAccountID(v uuid.UUID) *GoalUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableAccountID(v *uuid.UUID) *GoalUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *GoalUpdateOne) ClearAccountID() *GoalUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *GoalUpdateOne) SetCategoryID(v uuid.UUID) *GoalUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableCategoryID(v *uuid.UUID) *GoalUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *GoalUpdateOne) ClearCategoryID() *GoalUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *GoalUpdateOne) SetTagID(v uuid.UUID) *GoalUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTagID(v *uuid.UUID) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (_u *GoalUpdateOne) ClearTagID() *GoalUpdateOne {
+	_u.mutation.ClearTagID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *GoalUpdateOne) SetLedgerID(id uuid.UUID) *GoalUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *GoalUpdateOne) SetLedger(v *Ledger) *GoalUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *GoalUpdateOne) SetAccount(v *Account) *GoalUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *GoalUpdateOne) SetCategory(v *Category) *GoalUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *GoalUpdateOne) SetTag(v *Tag) *GoalUpdateOne {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_u *GoalUpdateOne) Mutation() *GoalMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *GoalUpdateOne) ClearLedger() *GoalUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *GoalUpdateOne) ClearAccount() *GoalUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *GoalUpdateOne) ClearCategory() *GoalUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *GoalUpdateOne) ClearTag() *GoalUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (_u *GoalUpdateOne) Where(ps ...predicate.Goal) *GoalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoalUpdateOne) Select(field string, fields ...string) *GoalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Goal entity.
+func (_u *GoalUpdateOne) Save(ctx context.Context) (*Goal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalUpdateOne) SaveX(ctx context.Context) *Goal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := goal.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Goal.name": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Goal.ledger"`)
+	}
+	return nil
+}
+
+func (_u *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goal.Table, goal.Columns, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Goal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goal.FieldID)
+		for _, f := range fields {
+			if !goal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TargetAmount(); ok {
+		_spec.SetField(goal.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetAmount(); ok {
+		_spec.AddField(goal.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.InitialAmount(); ok {
+		_spec.SetField(goal.FieldInitialAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInitialAmount(); ok {
+		_spec.AddField(goal.FieldInitialAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(goal.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetDate(); ok {
+		_spec.SetField(goal.FieldTargetDate, field.TypeTime, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.LedgerTable,
+			Columns: []string{goal.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.LedgerTable,
+			Columns: []string{goal.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.AccountTable,
+			Columns: []string{goal.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.AccountTable,
+			Columns: []string{goal.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.CategoryTable,
+			Columns: []string{goal.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.CategoryTable,
+			Columns: []string{goal.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.TagTable,
+			Columns: []string{goal.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   goal.TagTable,
+			Columns: []string{goal.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Goal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/ledger"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Holding is the model entity for the Holding schema.
+type Holding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Ticker holds the value of the "ticker" field.
+	Ticker string `json:"ticker,omitempty"`
+	// AssetClass holds the value of the "asset_class" field.
+	AssetClass string `json:"asset_class,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID *uuid.UUID `json:"account_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HoldingQuery when eager-loading is set.
+	Edges        HoldingEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// HoldingEdges holds the relations/edges for other nodes in the graph.
+type HoldingEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Trades holds the value of the trades edge.
+	Trades []*InvestmentTrade `json:"trades,omitempty"`
+	// Quotes holds the value of the quotes edge.
+	Quotes []*InvestmentQuote `json:"quotes,omitempty"`
+	// Incomes holds the value of the incomes edge.
+	Incomes []*InvestmentIncome `json:"incomes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldingEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldingEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// TradesOrErr returns the Trades value or an error if the edge
+// was not loaded in eager-loading.
+func (e HoldingEdges) TradesOrErr() ([]*InvestmentTrade, error) {
+	if e.loadedTypes[2] {
+		return e.Trades, nil
+	}
+	return nil, &NotLoadedError{edge: "trades"}
+}
+
+// QuotesOrErr returns the Quotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e HoldingEdges) QuotesOrErr() ([]*InvestmentQuote, error) {
+	if e.loadedTypes[3] {
+		return e.Quotes, nil
+	}
+	return nil, &NotLoadedError{edge: "quotes"}
+}
+
+// IncomesOrErr returns the Incomes value or an error if the edge
+// was not loaded in eager-loading.
+func (e HoldingEdges) IncomesOrErr() ([]*InvestmentIncome, error) {
+	if e.loadedTypes[4] {
+		return e.Incomes, nil
+	}
+	return nil, &NotLoadedError{edge: "incomes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holding.FieldAccountID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case holding.FieldName, holding.FieldTicker, holding.FieldAssetClass, holding.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case holding.FieldCreatedAt, holding.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case holding.FieldID:
+			values[i] = new(uuid.UUID)
+		case holding.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holding fields.
+func (_m *Holding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holding.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case holding.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case holding.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case holding.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case holding.FieldTicker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ticker", values[i])
+			} else if value.Valid {
+				_m.Ticker = value.String
+			}
+		case holding.FieldAssetClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_class", values[i])
+			} else if value.Valid {
+				_m.AssetClass = value.String
+			}
+		case holding.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case holding.FieldAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = new(uuid.UUID)
+				*_m.AccountID = *value.S.(*uuid.UUID)
+			}
+		case holding.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Holding.
+// This includes values selected through modifiers, order, etc.
+func (_m *Holding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Holding entity.
+func (_m *Holding) QueryLedger() *LedgerQuery {
+	return NewHoldingClient(_m.config).QueryLedger(_m)
+}
+
+// QueryAccount queries the "account" edge of the Holding entity.
+func (_m *Holding) QueryAccount() *AccountQuery {
+	return NewHoldingClient(_m.config).QueryAccount(_m)
+}
+
+// QueryTrades queries the "trades" edge of the Holding entity.
+func (_m *Holding) QueryTrades() *InvestmentTradeQuery {
+	return NewHoldingClient(_m.config).QueryTrades(_m)
+}
+
+// QueryQuotes queries the "quotes" edge of the Holding entity.
+func (_m *Holding) QueryQuotes() *InvestmentQuoteQuery {
+	return NewHoldingClient(_m.config).QueryQuotes(_m)
+}
+
+// QueryIncomes queries the "incomes" edge of the Holding entity.
+func (_m *Holding) QueryIncomes() *InvestmentIncomeQuery {
+	return NewHoldingClient(_m.config).QueryIncomes(_m)
+}
+
+// Update returns a builder for updating this Holding.
+// Note that you need to call Holding.Unwrap() before calling this method if this Holding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Holding) Update() *HoldingUpdateOne {
+	return NewHoldingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Holding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Holding) Unwrap() *Holding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Holding) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("ticker=")
+	builder.WriteString(_m.Ticker)
+	builder.WriteString(", ")
+	builder.WriteString("asset_class=")
+	builder.WriteString(_m.AssetClass)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	if v := _m.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holdings is a parsable slice of Holding.
+type Holdings []*Holding
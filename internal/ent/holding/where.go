@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package holding
+
+import (
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldName, v))
+}
+
+// Ticker applies equality check predicate on the "ticker" field. It's identical to TickerEQ.
+func Ticker(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldTicker, v))
+}
+
+// AssetClass applies equality check predicate on the "asset_class" field. It's identical to AssetClassEQ.
+func AssetClass(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAssetClass, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldCurrency, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldName, v))
+}
+
+// TickerEQ applies the EQ predicate on the "ticker" field.
+func TickerEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldTicker, v))
+}
+
+// TickerNEQ applies the NEQ predicate on the "ticker" field.
+func TickerNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldTicker, v))
+}
+
+// TickerIn applies the In predicate on the "ticker" field.
+func TickerIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldTicker, vs...))
+}
+
+// TickerNotIn applies the NotIn predicate on the "ticker" field.
+func TickerNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldTicker, vs...))
+}
+
+// TickerGT applies the GT predicate on the "ticker" field.
+func TickerGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldTicker, v))
+}
+
+// TickerGTE applies the GTE predicate on the "ticker" field.
+func TickerGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldTicker, v))
+}
+
+// TickerLT applies the LT predicate on the "ticker" field.
+func TickerLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldTicker, v))
+}
+
+// TickerLTE applies the LTE predicate on the "ticker" field.
+func TickerLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldTicker, v))
+}
+
+// TickerContains applies the Contains predicate on the "ticker" field.
+func TickerContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldTicker, v))
+}
+
+// TickerHasPrefix applies the HasPrefix predicate on the "ticker" field.
+func TickerHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldTicker, v))
+}
+
+// TickerHasSuffix applies the HasSuffix predicate on the "ticker" field.
+func TickerHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldTicker, v))
+}
+
+// TickerEqualFold applies the EqualFold predicate on the "ticker" field.
+func TickerEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldTicker, v))
+}
+
+// TickerContainsFold applies the ContainsFold predicate on the "ticker" field.
+func TickerContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldTicker, v))
+}
+
+// AssetClassEQ applies the EQ predicate on the "asset_class" field.
+func AssetClassEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAssetClass, v))
+}
+
+// AssetClassNEQ applies the NEQ predicate on the "asset_class" field.
+func AssetClassNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldAssetClass, v))
+}
+
+// AssetClassIn applies the In predicate on the "asset_class" field.
+func AssetClassIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldAssetClass, vs...))
+}
+
+// AssetClassNotIn applies the NotIn predicate on the "asset_class" field.
+func AssetClassNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldAssetClass, vs...))
+}
+
+// AssetClassGT applies the GT predicate on the "asset_class" field.
+func AssetClassGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldAssetClass, v))
+}
+
+// AssetClassGTE applies the GTE predicate on the "asset_class" field.
+func AssetClassGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldAssetClass, v))
+}
+
+// AssetClassLT applies the LT predicate on the "asset_class" field.
+func AssetClassLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldAssetClass, v))
+}
+
+// AssetClassLTE applies the LTE predicate on the "asset_class" field.
+func AssetClassLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldAssetClass, v))
+}
+
+// AssetClassContains applies the Contains predicate on the "asset_class" field.
+func AssetClassContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldAssetClass, v))
+}
+
+// AssetClassHasPrefix applies the HasPrefix predicate on the "asset_class" field.
+func AssetClassHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldAssetClass, v))
+}
+
+// AssetClassHasSuffix applies the HasSuffix predicate on the "asset_class" field.
+func AssetClassHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldAssetClass, v))
+}
+
+// AssetClassEqualFold applies the EqualFold predicate on the "asset_class" field.
+func AssetClassEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldAssetClass, v))
+}
+
+// AssetClassContainsFold applies the ContainsFold predicate on the "asset_class" field.
+func AssetClassContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldAssetClass, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Holding {
+	return predicate.Holding(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.Holding {
+	return predicate.Holding(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.Holding {
+	return predicate.Holding(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.Holding {
+	return predicate.Holding(sql.FieldNotNull(FieldAccountID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrades applies the HasEdge predicate on the "trades" edge.
+func HasTrades() predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TradesTable, TradesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTradesWith applies the HasEdge predicate on the "trades" edge with a given conditions (other predicates).
+func HasTradesWith(preds ...predicate.InvestmentTrade) predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := newTradesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuotes applies the HasEdge predicate on the "quotes" edge.
+func HasQuotes() predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, QuotesTable, QuotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuotesWith applies the HasEdge predicate on the "quotes" edge with a given conditions (other predicates).
+func HasQuotesWith(preds ...predicate.InvestmentQuote) predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := newQuotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncomes applies the HasEdge predicate on the "incomes" edge.
+func HasIncomes() predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, IncomesTable, IncomesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncomesWith applies the HasEdge predicate on the "incomes" edge with a given conditions (other predicates).
+func HasIncomesWith(preds ...predicate.InvestmentIncome) predicate.Holding {
+	return predicate.Holding(func(s *sql.Selector) {
+		step := newIncomesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Holding) predicate.Holding {
+	return predicate.Holding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Holding) predicate.Holding {
+	return predicate.Holding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Holding) predicate.Holding {
+	return predicate.Holding(sql.NotPredicates(p))
+}
@@ -0,0 +1,1021 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// HoldingCreate is the builder for creating a Holding entity.
+type HoldingCreate struct {
+	config
+	mutation *HoldingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HoldingCreate) SetCreatedAt(v time.Time) *HoldingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableCreatedAt(v *time.Time) *HoldingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HoldingCreate) SetUpdatedAt(v time.Time) *HoldingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableUpdatedAt(v *time.Time) *HoldingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *HoldingCreate) SetName(v string) *HoldingCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTicker sets the "ticker" field.
+func (_c *HoldingCreate) SetTicker(v string) *HoldingCreate {
+	_c.mutation.SetTicker(v)
+	return _c
+}
+
+// SetAssetClass sets the "asset_class" field.
+func (_c *HoldingCreate) SetAssetClass(v string) *HoldingCreate {
+	_c.mutation.SetAssetClass(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *HoldingCreate) SetCurrency(v string) *HoldingCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableCurrency(v *string) *HoldingCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *HoldingCreate) SetAccountID(v uuid.UUID) *HoldingCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableAccountID(v *uuid.UUID) *HoldingCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HoldingCreate) SetID(v uuid.UUID) *HoldingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *HoldingCreate) SetNillableID(v *uuid.UUID) *HoldingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *HoldingCreate) SetLedgerID(id uuid.UUID) *HoldingCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *HoldingCreate) SetLedger(v *Ledger) *HoldingCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *HoldingCreate) SetAccount(v *Account) *HoldingCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// AddTradeIDs adds the "trades" edge to the InvestmentTrade entity by IDs.
+func (_c *HoldingCreate) AddTradeIDs(ids ...uuid.UUID) *HoldingCreate {
+	_c.mutation.AddTradeIDs(ids...)
+	return _c
+}
+
+// AddTrades adds the "trades" edges to the InvestmentTrade entity.
+func (_c *HoldingCreate) AddTrades(v ...*InvestmentTrade) *HoldingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTradeIDs(ids...)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the InvestmentQuote entity by IDs.
+func (_c *HoldingCreate) AddQuoteIDs(ids ...uuid.UUID) *HoldingCreate {
+	_c.mutation.AddQuoteIDs(ids...)
+	return _c
+}
+
+// AddQuotes adds the "quotes" edges to the InvestmentQuote entity.
+func (_c *HoldingCreate) AddQuotes(v ...*InvestmentQuote) *HoldingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuoteIDs(ids...)
+}
+
+// AddIncomeIDs adds the "incomes" edge to the InvestmentIncome entity by IDs.
+func (_c *HoldingCreate) AddIncomeIDs(ids ...uuid.UUID) *HoldingCreate {
+	_c.mutation.AddIncomeIDs(ids...)
+	return _c
+}
+
+// AddIncomes adds the "incomes" edges to the InvestmentIncome entity.
+func (_c *HoldingCreate) AddIncomes(v ...*InvestmentIncome) *HoldingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIncomeIDs(ids...)
+}
+
+// Mutation returns the HoldingMutation object of the builder.
+func (_c *HoldingCreate) Mutation() *HoldingMutation {
+	return _c.mutation
+}
+
+// Save creates the Holding in the database.
+func (_c *HoldingCreate) Save(ctx context.Context) (*Holding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HoldingCreate) SaveX(ctx context.Context) *Holding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HoldingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HoldingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HoldingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := holding.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := holding.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := holding.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := holding.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HoldingCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Holding.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Holding.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Holding.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := holding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holding.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Ticker(); !ok {
+		return &ValidationError{Name: "ticker", err: errors.New(`ent: missing required field "Holding.ticker"`)}
+	}
+	if v, ok := _c.mutation.Ticker(); ok {
+		if err := holding.TickerValidator(v); err != nil {
+			return &ValidationError{Name: "ticker", err: fmt.Errorf(`ent: validator failed for field "Holding.ticker": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AssetClass(); !ok {
+		return &ValidationError{Name: "asset_class", err: errors.New(`ent: missing required field "Holding.asset_class"`)}
+	}
+	if v, ok := _c.mutation.AssetClass(); ok {
+		if err := holding.AssetClassValidator(v); err != nil {
+			return &ValidationError{Name: "asset_class", err: fmt.Errorf(`ent: validator failed for field "Holding.asset_class": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Holding.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := holding.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Holding.currency": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Holding.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *HoldingCreate) sqlSave(ctx context.Context) (*Holding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HoldingCreate) createSpec() (*Holding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Holding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(holding.Table, sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(holding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(holding.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(holding.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Ticker(); ok {
+		_spec.SetField(holding.FieldTicker, field.TypeString, value)
+		_node.Ticker = value
+	}
+	if value, ok := _c.mutation.AssetClass(); ok {
+		_spec.SetField(holding.FieldAssetClass, field.TypeString, value)
+		_node.AssetClass = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(holding.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.LedgerTable,
+			Columns: []string{holding.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IncomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holding.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldingUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HoldingCreate) OnConflict(opts ...sql.ConflictOption) *HoldingUpsertOne {
+	_c.conflict = opts
+	return &HoldingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HoldingCreate) OnConflictColumns(columns ...string) *HoldingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HoldingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HoldingUpsertOne is the builder for "upsert"-ing
+	//  one Holding node.
+	HoldingUpsertOne struct {
+		create *HoldingCreate
+	}
+
+	// HoldingUpsert is the "OnConflict" setter.
+	HoldingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HoldingUpsert) SetUpdatedAt(v time.Time) *HoldingUpsert {
+	u.Set(holding.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateUpdatedAt() *HoldingUpsert {
+	u.SetExcluded(holding.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *HoldingUpsert) SetName(v string) *HoldingUpsert {
+	u.Set(holding.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateName() *HoldingUpsert {
+	u.SetExcluded(holding.FieldName)
+	return u
+}
+
+// SetTicker sets the "ticker" field.
+func (u *HoldingUpsert) SetTicker(v string) *HoldingUpsert {
+	u.Set(holding.FieldTicker, v)
+	return u
+}
+
+// UpdateTicker sets the "ticker" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateTicker() *HoldingUpsert {
+	u.SetExcluded(holding.FieldTicker)
+	return u
+}
+
+// SetAssetClass sets the "asset_class" field.
+func (u *HoldingUpsert) SetAssetClass(v string) *HoldingUpsert {
+	u.Set(holding.FieldAssetClass, v)
+	return u
+}
+
+// UpdateAssetClass sets the "asset_class" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateAssetClass() *HoldingUpsert {
+	u.SetExcluded(holding.FieldAssetClass)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *HoldingUpsert) SetCurrency(v string) *HoldingUpsert {
+	u.Set(holding.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateCurrency() *HoldingUpsert {
+	u.SetExcluded(holding.FieldCurrency)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *HoldingUpsert) SetAccountID(v uuid.UUID) *HoldingUpsert {
+	u.Set(holding.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *HoldingUpsert) UpdateAccountID() *HoldingUpsert {
+	u.SetExcluded(holding.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *HoldingUpsert) ClearAccountID() *HoldingUpsert {
+	u.SetNull(holding.FieldAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(holding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HoldingUpsertOne) UpdateNewValues() *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(holding.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(holding.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HoldingUpsertOne) Ignore() *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldingUpsertOne) DoNothing() *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldingCreate.OnConflict
+// documentation for more info.
+func (u *HoldingUpsertOne) Update(set func(*HoldingUpsert)) *HoldingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HoldingUpsertOne) SetUpdatedAt(v time.Time) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateUpdatedAt() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HoldingUpsertOne) SetName(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateName() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTicker sets the "ticker" field.
+func (u *HoldingUpsertOne) SetTicker(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetTicker(v)
+	})
+}
+
+// UpdateTicker sets the "ticker" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateTicker() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateTicker()
+	})
+}
+
+// SetAssetClass sets the "asset_class" field.
+func (u *HoldingUpsertOne) SetAssetClass(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAssetClass(v)
+	})
+}
+
+// UpdateAssetClass sets the "asset_class" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateAssetClass() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAssetClass()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *HoldingUpsertOne) SetCurrency(v string) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateCurrency() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *HoldingUpsertOne) SetAccountID(v uuid.UUID) *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *HoldingUpsertOne) UpdateAccountID() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *HoldingUpsertOne) ClearAccountID() *HoldingUpsertOne {
+	return u.Update(func(s *HoldingUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HoldingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HoldingUpsertOne.ID is not supported by MySQL driver. Use HoldingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HoldingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HoldingCreateBulk is the builder for creating many Holding entities in bulk.
+type HoldingCreateBulk struct {
+	config
+	err      error
+	builders []*HoldingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Holding entities in the database.
+func (_c *HoldingCreateBulk) Save(ctx context.Context) ([]*Holding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Holding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HoldingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HoldingCreateBulk) SaveX(ctx context.Context) []*Holding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HoldingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HoldingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldingUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HoldingCreateBulk) OnConflict(opts ...sql.ConflictOption) *HoldingUpsertBulk {
+	_c.conflict = opts
+	return &HoldingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HoldingCreateBulk) OnConflictColumns(columns ...string) *HoldingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HoldingUpsertBulk{
+		create: _c,
+	}
+}
+
+// HoldingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Holding nodes.
+type HoldingUpsertBulk struct {
+	create *HoldingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(holding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HoldingUpsertBulk) UpdateNewValues() *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(holding.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(holding.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HoldingUpsertBulk) Ignore() *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldingUpsertBulk) DoNothing() *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldingCreateBulk.OnConflict
+// documentation for more info.
+func (u *HoldingUpsertBulk) Update(set func(*HoldingUpsert)) *HoldingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HoldingUpsertBulk) SetUpdatedAt(v time.Time) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateUpdatedAt() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HoldingUpsertBulk) SetName(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateName() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTicker sets the "ticker" field.
+func (u *HoldingUpsertBulk) SetTicker(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetTicker(v)
+	})
+}
+
+// UpdateTicker sets the "ticker" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateTicker() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateTicker()
+	})
+}
+
+// SetAssetClass sets the "asset_class" field.
+func (u *HoldingUpsertBulk) SetAssetClass(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAssetClass(v)
+	})
+}
+
+// UpdateAssetClass sets the "asset_class" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateAssetClass() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAssetClass()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *HoldingUpsertBulk) SetCurrency(v string) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateCurrency() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *HoldingUpsertBulk) SetAccountID(v uuid.UUID) *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *HoldingUpsertBulk) UpdateAccountID() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *HoldingUpsertBulk) ClearAccountID() *HoldingUpsertBulk {
+	return u.Update(func(s *HoldingUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HoldingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// HoldingUpdate is the builder for updating Holding entities.
+type HoldingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HoldingMutation
+}
+
+// Where appends a list predicates to the HoldingUpdate builder.
+func (_u *HoldingUpdate) Where(ps ...predicate.Holding) *HoldingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HoldingUpdate) SetUpdatedAt(v time.Time) *HoldingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HoldingUpdate) SetName(v string) *HoldingUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableName(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTicker sets the "ticker" field.
+func (_u *HoldingUpdate) SetTicker(v string) *HoldingUpdate {
+	_u.mutation.SetTicker(v)
+	return _u
+}
+
+// SetNillableTicker sets the "ticker" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableTicker(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetTicker(*v)
+	}
+	return _u
+}
+
+// SetAssetClass sets the "asset_class" field.
+func (_u *HoldingUpdate) SetAssetClass(v string) *HoldingUpdate {
+	_u.mutation.SetAssetClass(v)
+	return _u
+}
+
+// SetNillableAssetClass sets the "asset_class" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableAssetClass(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetAssetClass(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *HoldingUpdate) SetCurrency(v string) *HoldingUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableCurrency(v *string) *HoldingUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *HoldingUpdate) SetAccountID(v uuid.UUID) *HoldingUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *HoldingUpdate) SetNillableAccountID(v *uuid.UUID) *HoldingUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *HoldingUpdate) ClearAccountID() *HoldingUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *HoldingUpdate) SetLedgerID(id uuid.UUID) *HoldingUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *HoldingUpdate) SetLedger(v *Ledger) *HoldingUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *HoldingUpdate) SetAccount(v *Account) *HoldingUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// AddTradeIDs adds the "trades" edge to the InvestmentTrade entity by IDs.
+func (_u *HoldingUpdate) AddTradeIDs(ids ...uuid.UUID) *HoldingUpdate {
+	_u.mutation.AddTradeIDs(ids...)
+	return _u
+}
+
+// AddTrades adds the "trades" edges to the InvestmentTrade entity.
+func (_u *HoldingUpdate) AddTrades(v ...*InvestmentTrade) *HoldingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTradeIDs(ids...)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the InvestmentQuote entity by IDs.
+func (_u *HoldingUpdate) AddQuoteIDs(ids ...uuid.UUID) *HoldingUpdate {
+	_u.mutation.AddQuoteIDs(ids...)
+	return _u
+}
+
+// AddQuotes adds the "quotes" edges to the InvestmentQuote entity.
+func (_u *HoldingUpdate) AddQuotes(v ...*InvestmentQuote) *HoldingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuoteIDs(ids...)
+}
+
+// AddIncomeIDs adds the "incomes" edge to the InvestmentIncome entity by IDs.
+func (_u *HoldingUpdate) AddIncomeIDs(ids ...uuid.UUID) *HoldingUpdate {
+	_u.mutation.AddIncomeIDs(ids...)
+	return _u
+}
+
+// AddIncomes adds the "incomes" edges to the InvestmentIncome entity.
+func (_u *HoldingUpdate) AddIncomes(v ...*InvestmentIncome) *HoldingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIncomeIDs(ids...)
+}
+
+// Mutation returns the HoldingMutation object of the builder.
+func (_u *HoldingUpdate) Mutation() *HoldingMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *HoldingUpdate) ClearLedger() *HoldingUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *HoldingUpdate) ClearAccount() *HoldingUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearTrades clears all "trades" edges to the InvestmentTrade entity.
+func (_u *HoldingUpdate) ClearTrades() *HoldingUpdate {
+	_u.mutation.ClearTrades()
+	return _u
+}
+
+// RemoveTradeIDs removes the "trades" edge to InvestmentTrade entities by IDs.
+func (_u *HoldingUpdate) RemoveTradeIDs(ids ...uuid.UUID) *HoldingUpdate {
+	_u.mutation.RemoveTradeIDs(ids...)
+	return _u
+}
+
+// RemoveTrades removes "trades" edges to InvestmentTrade entities.
+func (_u *HoldingUpdate) RemoveTrades(v ...*InvestmentTrade) *HoldingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTradeIDs(ids...)
+}
+
+// ClearQuotes clears all "quotes" edges to the InvestmentQuote entity.
+func (_u *HoldingUpdate) ClearQuotes() *HoldingUpdate {
+	_u.mutation.ClearQuotes()
+	return _u
+}
+
+// RemoveQuoteIDs removes the "quotes" edge to InvestmentQuote entities by IDs.
+func (_u *HoldingUpdate) RemoveQuoteIDs(ids ...uuid.UUID) *HoldingUpdate {
+	_u.mutation.RemoveQuoteIDs(ids...)
+	return _u
+}
+
+// RemoveQuotes removes "quotes" edges to InvestmentQuote entities.
+func (_u *HoldingUpdate) RemoveQuotes(v ...*InvestmentQuote) *HoldingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuoteIDs(ids...)
+}
+
+// ClearIncomes clears all "incomes" edges to the InvestmentIncome entity.
+func (_u *HoldingUpdate) ClearIncomes() *HoldingUpdate {
+	_u.mutation.ClearIncomes()
+	return _u
+}
+
+// RemoveIncomeIDs removes the "incomes" edge to InvestmentIncome entities by IDs.
+func (_u *HoldingUpdate) RemoveIncomeIDs(ids ...uuid.UUID) *HoldingUpdate {
+	_u.mutation.RemoveIncomeIDs(ids...)
+	return _u
+}
+
+// RemoveIncomes removes "incomes" edges to InvestmentIncome entities.
+func (_u *HoldingUpdate) RemoveIncomes(v ...*InvestmentIncome) *HoldingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIncomeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HoldingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HoldingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HoldingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HoldingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HoldingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := holding.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HoldingUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holding.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Ticker(); ok {
+		if err := holding.TickerValidator(v); err != nil {
+			return &ValidationError{Name: "ticker", err: fmt.Errorf(`ent: validator failed for field "Holding.ticker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetClass(); ok {
+		if err := holding.AssetClassValidator(v); err != nil {
+			return &ValidationError{Name: "asset_class", err: fmt.Errorf(`ent: validator failed for field "Holding.asset_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := holding.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Holding.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holding.ledger"`)
+	}
+	return nil
+}
+
+func (_u *HoldingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holding.Table, holding.Columns, sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(holding.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holding.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ticker(); ok {
+		_spec.SetField(holding.FieldTicker, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetClass(); ok {
+		_spec.SetField(holding.FieldAssetClass, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(holding.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.LedgerTable,
+			Columns: []string{holding.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.LedgerTable,
+			Columns: []string{holding.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTradesIDs(); len(nodes) > 0 && !_u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuotesIDs(); len(nodes) > 0 && !_u.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IncomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIncomesIDs(); len(nodes) > 0 && !_u.mutation.IncomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IncomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HoldingUpdateOne is the builder for updating a single Holding entity.
+type HoldingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HoldingMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HoldingUpdateOne) SetUpdatedAt(v time.Time) *HoldingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HoldingUpdateOne) SetName(v string) *HoldingUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableName(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTicker sets the "ticker" field.
+func (_u *HoldingUpdateOne) SetTicker(v string) *HoldingUpdateOne {
+	_u.mutation.SetTicker(v)
+	return _u
+}
+
+// SetNillableTicker sets the "ticker" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableTicker(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetTicker(*v)
+	}
+	return _u
+}
+
+// SetAssetClass sets the "asset_class" field.
+func (_u *HoldingUpdateOne) SetAssetClass(v string) *HoldingUpdateOne {
+	_u.mutation.SetAssetClass(v)
+	return _u
+}
+
+// SetNillableAssetClass sets the "asset_class" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableAssetClass(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetAssetClass(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *HoldingUpdateOne) SetCurrency(v string) *HoldingUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableCurrency(v *string) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *HoldingUpdateOne) SetAccountID(v uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *HoldingUpdateOne) SetNillableAccountID(v *uuid.UUID) *HoldingUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *HoldingUpdateOne) ClearAccountID() *HoldingUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *HoldingUpdateOne) SetLedgerID(id uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *HoldingUpdateOne) SetLedger(v *Ledger) *HoldingUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *HoldingUpdateOne) SetAccount(v *Account) *HoldingUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// AddTradeIDs adds the "trades" edge to the InvestmentTrade entity by IDs.
+func (_u *HoldingUpdateOne) AddTradeIDs(ids ...uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.AddTradeIDs(ids...)
+	return _u
+}
+
+// AddTrades adds the "trades" edges to the InvestmentTrade entity.
+func (_u *HoldingUpdateOne) AddTrades(v ...*InvestmentTrade) *HoldingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTradeIDs(ids...)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the InvestmentQuote entity by IDs.
+func (_u *HoldingUpdateOne) AddQuoteIDs(ids ...uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.AddQuoteIDs(ids...)
+	return _u
+}
+
+// AddQuotes adds the "quotes" edges to the InvestmentQuote entity.
+func (_u *HoldingUpdateOne) AddQuotes(v ...*InvestmentQuote) *HoldingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuoteIDs(ids...)
+}
+
+// AddIncomeIDs adds the "incomes" edge to the InvestmentIncome entity by IDs.
+func (_u *HoldingUpdateOne) AddIncomeIDs(ids ...uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.AddIncomeIDs(ids...)
+	return _u
+}
+
+// AddIncomes adds the "incomes" edges to the InvestmentIncome entity.
+func (_u *HoldingUpdateOne) AddIncomes(v ...*InvestmentIncome) *HoldingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIncomeIDs(ids...)
+}
+
+// Mutation returns the HoldingMutation object of the builder.
+func (_u *HoldingUpdateOne) Mutation() *HoldingMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *HoldingUpdateOne) ClearLedger() *HoldingUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *HoldingUpdateOne) ClearAccount() *HoldingUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearTrades clears all "trades" edges to the InvestmentTrade entity.
+func (_u *HoldingUpdateOne) ClearTrades() *HoldingUpdateOne {
+	_u.mutation.ClearTrades()
+	return _u
+}
+
+// RemoveTradeIDs removes the "trades" edge to InvestmentTrade entities by IDs.
+func (_u *HoldingUpdateOne) RemoveTradeIDs(ids ...uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.RemoveTradeIDs(ids...)
+	return _u
+}
+
+// RemoveTrades removes "trades" edges to InvestmentTrade entities.
+func (_u *HoldingUpdateOne) RemoveTrades(v ...*InvestmentTrade) *HoldingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTradeIDs(ids...)
+}
+
+// ClearQuotes clears all "quotes" edges to the InvestmentQuote entity.
+func (_u *HoldingUpdateOne) ClearQuotes() *HoldingUpdateOne {
+	_u.mutation.ClearQuotes()
+	return _u
+}
+
+// RemoveQuoteIDs removes the "quotes" edge to InvestmentQuote entities by IDs.
+func (_u *HoldingUpdateOne) RemoveQuoteIDs(ids ...uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.RemoveQuoteIDs(ids...)
+	return _u
+}
+
+// RemoveQuotes removes "quotes" edges to InvestmentQuote entities.
+func (_u *HoldingUpdateOne) RemoveQuotes(v ...*InvestmentQuote) *HoldingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuoteIDs(ids...)
+}
+
+// ClearIncomes clears all "incomes" edges to the InvestmentIncome entity.
+func (_u *HoldingUpdateOne) ClearIncomes() *HoldingUpdateOne {
+	_u.mutation.ClearIncomes()
+	return _u
+}
+
+// RemoveIncomeIDs removes the "incomes" edge to InvestmentIncome entities by IDs.
+func (_u *HoldingUpdateOne) RemoveIncomeIDs(ids ...uuid.UUID) *HoldingUpdateOne {
+	_u.mutation.RemoveIncomeIDs(ids...)
+	return _u
+}
+
+// RemoveIncomes removes "incomes" edges to InvestmentIncome entities.
+func (_u *HoldingUpdateOne) RemoveIncomes(v ...*InvestmentIncome) *HoldingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIncomeIDs(ids...)
+}
+
+// Where appends a list predicates to the HoldingUpdate builder.
+func (_u *HoldingUpdateOne) Where(ps ...predicate.Holding) *HoldingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HoldingUpdateOne) Select(field string, fields ...string) *HoldingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Holding entity.
+func (_u *HoldingUpdateOne) Save(ctx context.Context) (*Holding, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HoldingUpdateOne) SaveX(ctx context.Context) *Holding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HoldingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HoldingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HoldingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := holding.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HoldingUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holding.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holding.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Ticker(); ok {
+		if err := holding.TickerValidator(v); err != nil {
+			return &ValidationError{Name: "ticker", err: fmt.Errorf(`ent: validator failed for field "Holding.ticker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AssetClass(); ok {
+		if err := holding.AssetClassValidator(v); err != nil {
+			return &ValidationError{Name: "asset_class", err: fmt.Errorf(`ent: validator failed for field "Holding.asset_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := holding.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Holding.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holding.ledger"`)
+	}
+	return nil
+}
+
+func (_u *HoldingUpdateOne) sqlSave(ctx context.Context) (_node *Holding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holding.Table, holding.Columns, sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Holding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holding.FieldID)
+		for _, f := range fields {
+			if !holding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(holding.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holding.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ticker(); ok {
+		_spec.SetField(holding.FieldTicker, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AssetClass(); ok {
+		_spec.SetField(holding.FieldAssetClass, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(holding.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.LedgerTable,
+			Columns: []string{holding.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.LedgerTable,
+			Columns: []string{holding.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   holding.AccountTable,
+			Columns: []string{holding.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTradesIDs(); len(nodes) > 0 && !_u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.TradesTable,
+			Columns: []string{holding.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuotesIDs(); len(nodes) > 0 && !_u.mutation.QuotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.QuotesTable,
+			Columns: []string{holding.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IncomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIncomesIDs(); len(nodes) > 0 && !_u.mutation.IncomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IncomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   holding.IncomesTable,
+			Columns: []string{holding.IncomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Holding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/importjob"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ImportJobCreate is the builder for creating a ImportJob entity.
+type ImportJobCreate struct {
+	config
+	mutation *ImportJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportJobCreate) SetCreatedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableCreatedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImportJobCreate) SetUpdatedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableUpdatedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *ImportJobCreate) SetFilename(v string) *ImportJobCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *ImportJobCreate) SetTotal(v int) *ImportJobCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetProcessed sets the "processed" field.
+func (_c *ImportJobCreate) SetProcessed(v int) *ImportJobCreate {
+	_c.mutation.SetProcessed(v)
+	return _c
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableProcessed(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetProcessed(*v)
+	}
+	return _c
+}
+
+// SetFailed sets the "failed" field.
+func (_c *ImportJobCreate) SetFailed(v int) *ImportJobCreate {
+	_c.mutation.SetFailed(v)
+	return _c
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableFailed(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetFailed(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ImportJobCreate) SetCompletedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableCompletedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImportJobCreate) SetID(v uuid.UUID) *ImportJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableID(v *uuid.UUID) *ImportJobCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *ImportJobCreate) SetUserID(id uuid.UUID) *ImportJobCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ImportJobCreate) SetUser(v *User) *ImportJobCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_c *ImportJobCreate) Mutation() *ImportJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportJob in the database.
+func (_c *ImportJobCreate) Save(ctx context.Context) (*ImportJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportJobCreate) SaveX(ctx context.Context) *ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportJobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := importjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := importjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		v := importjob.DefaultProcessed
+		_c.mutation.SetProcessed(v)
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		v := importjob.DefaultFailed
+		_c.mutation.SetFailed(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := importjob.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportJobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImportJob.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "ImportJob.filename"`)}
+	}
+	if v, ok := _c.mutation.Filename(); ok {
+		if err := importjob.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ImportJob.filename": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "ImportJob.total"`)}
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		return &ValidationError{Name: "processed", err: errors.New(`ent: missing required field "ImportJob.processed"`)}
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "ImportJob.failed"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ImportJob.user"`)}
+	}
+	return nil
+}
+
+func (_c *ImportJobCreate) sqlSave(ctx context.Context) (*ImportJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportJobCreate) createSpec() (*ImportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importjob.Table, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(importjob.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(importjob.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.Processed(); ok {
+		_spec.SetField(importjob.FieldProcessed, field.TypeInt, value)
+		_node.Processed = value
+	}
+	if value, ok := _c.mutation.Failed(); ok {
+		_spec.SetField(importjob.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(importjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportJob.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportJobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportJobCreate) OnConflict(opts ...sql.ConflictOption) *ImportJobUpsertOne {
+	_c.conflict = opts
+	return &ImportJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportJobCreate) OnConflictColumns(columns ...string) *ImportJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportJobUpsertOne is the builder for "upsert"-ing
+	//  one ImportJob node.
+	ImportJobUpsertOne struct {
+		create *ImportJobCreate
+	}
+
+	// ImportJobUpsert is the "OnConflict" setter.
+	ImportJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportJobUpsert) SetUpdatedAt(v time.Time) *ImportJobUpsert {
+	u.Set(importjob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateUpdatedAt() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldUpdatedAt)
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *ImportJobUpsert) SetFilename(v string) *ImportJobUpsert {
+	u.Set(importjob.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateFilename() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldFilename)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *ImportJobUpsert) SetTotal(v int) *ImportJobUpsert {
+	u.Set(importjob.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateTotal() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldTotal)
+	return u
+}
+
+// AddTotal adds v to the "total" field.
+func (u *ImportJobUpsert) AddTotal(v int) *ImportJobUpsert {
+	u.Add(importjob.FieldTotal, v)
+	return u
+}
+
+// SetProcessed sets the "processed" field.
+func (u *ImportJobUpsert) SetProcessed(v int) *ImportJobUpsert {
+	u.Set(importjob.FieldProcessed, v)
+	return u
+}
+
+// UpdateProcessed sets the "processed" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateProcessed() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldProcessed)
+	return u
+}
+
+// AddProcessed adds v to the "processed" field.
+func (u *ImportJobUpsert) AddProcessed(v int) *ImportJobUpsert {
+	u.Add(importjob.FieldProcessed, v)
+	return u
+}
+
+// SetFailed sets the "failed" field.
+func (u *ImportJobUpsert) SetFailed(v int) *ImportJobUpsert {
+	u.Set(importjob.FieldFailed, v)
+	return u
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateFailed() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldFailed)
+	return u
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *ImportJobUpsert) AddFailed(v int) *ImportJobUpsert {
+	u.Add(importjob.FieldFailed, v)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ImportJobUpsert) SetCompletedAt(v time.Time) *ImportJobUpsert {
+	u.Set(importjob.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateCompletedAt() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ImportJobUpsert) ClearCompletedAt() *ImportJobUpsert {
+	u.SetNull(importjob.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportJobUpsertOne) UpdateNewValues() *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(importjob.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(importjob.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportJobUpsertOne) Ignore() *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportJobUpsertOne) DoNothing() *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportJobCreate.OnConflict
+// documentation for more info.
+func (u *ImportJobUpsertOne) Update(set func(*ImportJobUpsert)) *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportJobUpsertOne) SetUpdatedAt(v time.Time) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateUpdatedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *ImportJobUpsertOne) SetFilename(v string) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateFilename() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *ImportJobUpsertOne) SetTotal(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *ImportJobUpsertOne) AddTotal(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateTotal() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetProcessed sets the "processed" field.
+func (u *ImportJobUpsertOne) SetProcessed(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetProcessed(v)
+	})
+}
+
+// AddProcessed adds v to the "processed" field.
+func (u *ImportJobUpsertOne) AddProcessed(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddProcessed(v)
+	})
+}
+
+// UpdateProcessed sets the "processed" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateProcessed() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateProcessed()
+	})
+}
+
+// SetFailed sets the "failed" field.
+func (u *ImportJobUpsertOne) SetFailed(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFailed(v)
+	})
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *ImportJobUpsertOne) AddFailed(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddFailed(v)
+	})
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateFailed() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFailed()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ImportJobUpsertOne) SetCompletedAt(v time.Time) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateCompletedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ImportJobUpsertOne) ClearCompletedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportJobUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ImportJobUpsertOne.ID is not supported by MySQL driver. Use ImportJobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportJobUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportJobCreateBulk is the builder for creating many ImportJob entities in bulk.
+type ImportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ImportJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportJob entities in the database.
+func (_c *ImportJobCreateBulk) Save(ctx context.Context) ([]*ImportJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) SaveX(ctx context.Context) []*ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportJobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportJobUpsertBulk {
+	_c.conflict = opts
+	return &ImportJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportJobCreateBulk) OnConflictColumns(columns ...string) *ImportJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportJob nodes.
+type ImportJobUpsertBulk struct {
+	create *ImportJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(importjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImportJobUpsertBulk) UpdateNewValues() *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(importjob.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(importjob.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportJobUpsertBulk) Ignore() *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportJobUpsertBulk) DoNothing() *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportJobUpsertBulk) Update(set func(*ImportJobUpsert)) *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ImportJobUpsertBulk) SetUpdatedAt(v time.Time) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateUpdatedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *ImportJobUpsertBulk) SetFilename(v string) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateFilename() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *ImportJobUpsertBulk) SetTotal(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *ImportJobUpsertBulk) AddTotal(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateTotal() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetProcessed sets the "processed" field.
+func (u *ImportJobUpsertBulk) SetProcessed(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetProcessed(v)
+	})
+}
+
+// AddProcessed adds v to the "processed" field.
+func (u *ImportJobUpsertBulk) AddProcessed(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddProcessed(v)
+	})
+}
+
+// UpdateProcessed sets the "processed" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateProcessed() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateProcessed()
+	})
+}
+
+// SetFailed sets the "failed" field.
+func (u *ImportJobUpsertBulk) SetFailed(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFailed(v)
+	})
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *ImportJobUpsertBulk) AddFailed(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddFailed(v)
+	})
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateFailed() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFailed()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ImportJobUpsertBulk) SetCompletedAt(v time.Time) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateCompletedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ImportJobUpsertBulk) ClearCompletedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
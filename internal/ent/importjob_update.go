@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/importjob"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ImportJobUpdate is the builder for updating ImportJob entities.
+type ImportJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImportJobMutation
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdate) Where(ps ...predicate.ImportJob) *ImportJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportJobUpdate) SetUpdatedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *ImportJobUpdate) SetFilename(v string) *ImportJobUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFilename(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *ImportJobUpdate) SetTotal(v int) *ImportJobUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableTotal(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *ImportJobUpdate) AddTotal(v int) *ImportJobUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *ImportJobUpdate) SetProcessed(v int) *ImportJobUpdate {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableProcessed(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *ImportJobUpdate) AddProcessed(v int) *ImportJobUpdate {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *ImportJobUpdate) SetFailed(v int) *ImportJobUpdate {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFailed(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *ImportJobUpdate) AddFailed(v int) *ImportJobUpdate {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ImportJobUpdate) SetCompletedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableCompletedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ImportJobUpdate) ClearCompletedAt() *ImportJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *ImportJobUpdate) SetUserID(id uuid.UUID) *ImportJobUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ImportJobUpdate) SetUser(v *User) *ImportJobUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdate) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ImportJobUpdate) ClearUser() *ImportJobUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportJobUpdate) check() error {
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := importjob.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ImportJob.filename": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportJob.user"`)
+	}
+	return nil
+}
+
+func (_u *ImportJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(importjob.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(importjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(importjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(importjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(importjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(importjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(importjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(importjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(importjob.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportJobUpdateOne is the builder for updating a single ImportJob entity.
+type ImportJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImportJobMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportJobUpdateOne) SetUpdatedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *ImportJobUpdateOne) SetFilename(v string) *ImportJobUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFilename(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *ImportJobUpdateOne) SetTotal(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableTotal(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *ImportJobUpdateOne) AddTotal(v int) *ImportJobUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *ImportJobUpdateOne) SetProcessed(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableProcessed(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *ImportJobUpdateOne) AddProcessed(v int) *ImportJobUpdateOne {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *ImportJobUpdateOne) SetFailed(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFailed(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *ImportJobUpdateOne) AddFailed(v int) *ImportJobUpdateOne {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ImportJobUpdateOne) SetCompletedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableCompletedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ImportJobUpdateOne) ClearCompletedAt() *ImportJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *ImportJobUpdateOne) SetUserID(id uuid.UUID) *ImportJobUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ImportJobUpdateOne) SetUser(v *User) *ImportJobUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdateOne) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ImportJobUpdateOne) ClearUser() *ImportJobUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdateOne) Where(ps ...predicate.ImportJob) *ImportJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportJobUpdateOne) Select(field string, fields ...string) *ImportJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportJob entity.
+func (_u *ImportJobUpdateOne) Save(ctx context.Context) (*ImportJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) SaveX(ctx context.Context) *ImportJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := importjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := importjob.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ImportJob.filename": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportJob.user"`)
+	}
+	return nil
+}
+
+func (_u *ImportJobUpdateOne) sqlSave(ctx context.Context) (_node *ImportJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importjob.FieldID)
+		for _, f := range fields {
+			if !importjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(importjob.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(importjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(importjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(importjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(importjob.FieldProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(importjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(importjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(importjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(importjob.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.UserTable,
+			Columns: []string{importjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ImportJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
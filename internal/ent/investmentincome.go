@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// InvestmentIncome is the model entity for the InvestmentIncome schema.
+type InvestmentIncome struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount domain.Money `json:"amount,omitempty"`
+	// HoldingID holds the value of the "holding_id" field.
+	HoldingID uuid.UUID `json:"holding_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// PaymentDate holds the value of the "payment_date" field.
+	PaymentDate time.Time `json:"payment_date,omitempty"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvestmentIncomeQuery when eager-loading is set.
+	Edges        InvestmentIncomeEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// InvestmentIncomeEdges holds the relations/edges for other nodes in the graph.
+type InvestmentIncomeEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Holding holds the value of the holding edge.
+	Holding *Holding `json:"holding,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentIncomeEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// HoldingOrErr returns the Holding value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentIncomeEdges) HoldingOrErr() (*Holding, error) {
+	if e.Holding != nil {
+		return e.Holding, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: holding.Label}
+	}
+	return nil, &NotLoadedError{edge: "holding"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentIncomeEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvestmentIncome) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case investmentincome.FieldTransactionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case investmentincome.FieldAmount:
+			values[i] = new(domain.Money)
+		case investmentincome.FieldKind, investmentincome.FieldNote:
+			values[i] = new(sql.NullString)
+		case investmentincome.FieldCreatedAt, investmentincome.FieldUpdatedAt, investmentincome.FieldPaymentDate:
+			values[i] = new(sql.NullTime)
+		case investmentincome.FieldID, investmentincome.FieldHoldingID:
+			values[i] = new(uuid.UUID)
+		case investmentincome.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvestmentIncome fields.
+func (_m *InvestmentIncome) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case investmentincome.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case investmentincome.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case investmentincome.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case investmentincome.FieldAmount:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case investmentincome.FieldHoldingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field holding_id", values[i])
+			} else if value != nil {
+				_m.HoldingID = *value
+			}
+		case investmentincome.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case investmentincome.FieldPaymentDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_date", values[i])
+			} else if value.Valid {
+				_m.PaymentDate = value.Time
+			}
+		case investmentincome.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = new(string)
+				*_m.Note = value.String
+			}
+		case investmentincome.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = new(uuid.UUID)
+				*_m.TransactionID = *value.S.(*uuid.UUID)
+			}
+		case investmentincome.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvestmentIncome.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvestmentIncome) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the InvestmentIncome entity.
+func (_m *InvestmentIncome) QueryLedger() *LedgerQuery {
+	return NewInvestmentIncomeClient(_m.config).QueryLedger(_m)
+}
+
+// QueryHolding queries the "holding" edge of the InvestmentIncome entity.
+func (_m *InvestmentIncome) QueryHolding() *HoldingQuery {
+	return NewInvestmentIncomeClient(_m.config).QueryHolding(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the InvestmentIncome entity.
+func (_m *InvestmentIncome) QueryTransaction() *TransactionQuery {
+	return NewInvestmentIncomeClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this InvestmentIncome.
+// Note that you need to call InvestmentIncome.Unwrap() before calling this method if this InvestmentIncome
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvestmentIncome) Update() *InvestmentIncomeUpdateOne {
+	return NewInvestmentIncomeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvestmentIncome entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvestmentIncome) Unwrap() *InvestmentIncome {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvestmentIncome is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvestmentIncome) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvestmentIncome(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("holding_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HoldingID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("payment_date=")
+	builder.WriteString(_m.PaymentDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvestmentIncomes is a parsable slice of InvestmentIncome.
+type InvestmentIncomes []*InvestmentIncome
@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package investmentincome
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldAmount, v))
+}
+
+// HoldingID applies equality check predicate on the "holding_id" field. It's identical to HoldingIDEQ.
+func HoldingID(v uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldHoldingID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldKind, v))
+}
+
+// PaymentDate applies equality check predicate on the "payment_date" field. It's identical to PaymentDateEQ.
+func PaymentDate(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldNote, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v domain.Money) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldAmount, v))
+}
+
+// HoldingIDEQ applies the EQ predicate on the "holding_id" field.
+func HoldingIDEQ(v uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldHoldingID, v))
+}
+
+// HoldingIDNEQ applies the NEQ predicate on the "holding_id" field.
+func HoldingIDNEQ(v uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldHoldingID, v))
+}
+
+// HoldingIDIn applies the In predicate on the "holding_id" field.
+func HoldingIDIn(vs ...uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldHoldingID, vs...))
+}
+
+// HoldingIDNotIn applies the NotIn predicate on the "holding_id" field.
+func HoldingIDNotIn(vs ...uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldHoldingID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldContainsFold(FieldKind, v))
+}
+
+// PaymentDateEQ applies the EQ predicate on the "payment_date" field.
+func PaymentDateEQ(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateNEQ applies the NEQ predicate on the "payment_date" field.
+func PaymentDateNEQ(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateIn applies the In predicate on the "payment_date" field.
+func PaymentDateIn(vs ...time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateNotIn applies the NotIn predicate on the "payment_date" field.
+func PaymentDateNotIn(vs ...time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateGT applies the GT predicate on the "payment_date" field.
+func PaymentDateGT(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldPaymentDate, v))
+}
+
+// PaymentDateGTE applies the GTE predicate on the "payment_date" field.
+func PaymentDateGTE(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldPaymentDate, v))
+}
+
+// PaymentDateLT applies the LT predicate on the "payment_date" field.
+func PaymentDateLT(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldPaymentDate, v))
+}
+
+// PaymentDateLTE applies the LTE predicate on the "payment_date" field.
+func PaymentDateLTE(v time.Time) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldPaymentDate, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldContainsFold(FieldNote, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...uuid.UUID) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.FieldNotNull(FieldTransactionID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHolding applies the HasEdge predicate on the "holding" edge.
+func HasHolding() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HoldingTable, HoldingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHoldingWith applies the HasEdge predicate on the "holding" edge with a given conditions (other predicates).
+func HasHoldingWith(preds ...predicate.Holding) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(func(s *sql.Selector) {
+		step := newHoldingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvestmentIncome) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvestmentIncome) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvestmentIncome) predicate.InvestmentIncome {
+	return predicate.InvestmentIncome(sql.NotPredicates(p))
+}
@@ -0,0 +1,1024 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvestmentIncomeCreate is the builder for creating a InvestmentIncome entity.
+type InvestmentIncomeCreate struct {
+	config
+	mutation *InvestmentIncomeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvestmentIncomeCreate) SetCreatedAt(v time.Time) *InvestmentIncomeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvestmentIncomeCreate) SetNillableCreatedAt(v *time.Time) *InvestmentIncomeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvestmentIncomeCreate) SetUpdatedAt(v time.Time) *InvestmentIncomeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvestmentIncomeCreate) SetNillableUpdatedAt(v *time.Time) *InvestmentIncomeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *InvestmentIncomeCreate) SetAmount(v domain.Money) *InvestmentIncomeCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_c *InvestmentIncomeCreate) SetHoldingID(v uuid.UUID) *InvestmentIncomeCreate {
+	_c.mutation.SetHoldingID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *InvestmentIncomeCreate) SetKind(v string) *InvestmentIncomeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_c *InvestmentIncomeCreate) SetPaymentDate(v time.Time) *InvestmentIncomeCreate {
+	_c.mutation.SetPaymentDate(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *InvestmentIncomeCreate) SetNote(v string) *InvestmentIncomeCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *InvestmentIncomeCreate) SetNillableNote(v *string) *InvestmentIncomeCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *InvestmentIncomeCreate) SetTransactionID(v uuid.UUID) *InvestmentIncomeCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *InvestmentIncomeCreate) SetNillableTransactionID(v *uuid.UUID) *InvestmentIncomeCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvestmentIncomeCreate) SetID(v uuid.UUID) *InvestmentIncomeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvestmentIncomeCreate) SetNillableID(v *uuid.UUID) *InvestmentIncomeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *InvestmentIncomeCreate) SetLedgerID(id uuid.UUID) *InvestmentIncomeCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *InvestmentIncomeCreate) SetLedger(v *Ledger) *InvestmentIncomeCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_c *InvestmentIncomeCreate) SetHolding(v *Holding) *InvestmentIncomeCreate {
+	return _c.SetHoldingID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *InvestmentIncomeCreate) SetTransaction(v *Transaction) *InvestmentIncomeCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the InvestmentIncomeMutation object of the builder.
+func (_c *InvestmentIncomeCreate) Mutation() *InvestmentIncomeMutation {
+	return _c.mutation
+}
+
+// Save creates the InvestmentIncome in the database.
+func (_c *InvestmentIncomeCreate) Save(ctx context.Context) (*InvestmentIncome, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvestmentIncomeCreate) SaveX(ctx context.Context) *InvestmentIncome {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvestmentIncomeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvestmentIncomeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvestmentIncomeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := investmentincome.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := investmentincome.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := investmentincome.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvestmentIncomeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvestmentIncome.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvestmentIncome.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "InvestmentIncome.amount"`)}
+	}
+	if _, ok := _c.mutation.HoldingID(); !ok {
+		return &ValidationError{Name: "holding_id", err: errors.New(`ent: missing required field "InvestmentIncome.holding_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "InvestmentIncome.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := investmentincome.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvestmentIncome.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentDate(); !ok {
+		return &ValidationError{Name: "payment_date", err: errors.New(`ent: missing required field "InvestmentIncome.payment_date"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := investmentincome.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvestmentIncome.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "InvestmentIncome.ledger"`)}
+	}
+	if len(_c.mutation.HoldingIDs()) == 0 {
+		return &ValidationError{Name: "holding", err: errors.New(`ent: missing required edge "InvestmentIncome.holding"`)}
+	}
+	return nil
+}
+
+func (_c *InvestmentIncomeCreate) sqlSave(ctx context.Context) (*InvestmentIncome, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvestmentIncomeCreate) createSpec() (*InvestmentIncome, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvestmentIncome{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(investmentincome.Table, sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(investmentincome.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmentincome.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(investmentincome.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(investmentincome.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.PaymentDate(); ok {
+		_spec.SetField(investmentincome.FieldPaymentDate, field.TypeTime, value)
+		_node.PaymentDate = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(investmentincome.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.LedgerTable,
+			Columns: []string{investmentincome.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.HoldingTable,
+			Columns: []string{investmentincome.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.HoldingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.TransactionTable,
+			Columns: []string{investmentincome.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvestmentIncome.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvestmentIncomeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvestmentIncomeCreate) OnConflict(opts ...sql.ConflictOption) *InvestmentIncomeUpsertOne {
+	_c.conflict = opts
+	return &InvestmentIncomeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvestmentIncome.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvestmentIncomeCreate) OnConflictColumns(columns ...string) *InvestmentIncomeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvestmentIncomeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvestmentIncomeUpsertOne is the builder for "upsert"-ing
+	//  one InvestmentIncome node.
+	InvestmentIncomeUpsertOne struct {
+		create *InvestmentIncomeCreate
+	}
+
+	// InvestmentIncomeUpsert is the "OnConflict" setter.
+	InvestmentIncomeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentIncomeUpsert) SetUpdatedAt(v time.Time) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdateUpdatedAt() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvestmentIncomeUpsert) SetAmount(v domain.Money) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdateAmount() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvestmentIncomeUpsert) AddAmount(v domain.Money) *InvestmentIncomeUpsert {
+	u.Add(investmentincome.FieldAmount, v)
+	return u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentIncomeUpsert) SetHoldingID(v uuid.UUID) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldHoldingID, v)
+	return u
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdateHoldingID() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldHoldingID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *InvestmentIncomeUpsert) SetKind(v string) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdateKind() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldKind)
+	return u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *InvestmentIncomeUpsert) SetPaymentDate(v time.Time) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldPaymentDate, v)
+	return u
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdatePaymentDate() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldPaymentDate)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *InvestmentIncomeUpsert) SetNote(v string) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdateNote() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvestmentIncomeUpsert) ClearNote() *InvestmentIncomeUpsert {
+	u.SetNull(investmentincome.FieldNote)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *InvestmentIncomeUpsert) SetTransactionID(v uuid.UUID) *InvestmentIncomeUpsert {
+	u.Set(investmentincome.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsert) UpdateTransactionID() *InvestmentIncomeUpsert {
+	u.SetExcluded(investmentincome.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *InvestmentIncomeUpsert) ClearTransactionID() *InvestmentIncomeUpsert {
+	u.SetNull(investmentincome.FieldTransactionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentIncome.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(investmentincome.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvestmentIncomeUpsertOne) UpdateNewValues() *InvestmentIncomeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(investmentincome.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(investmentincome.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentIncome.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvestmentIncomeUpsertOne) Ignore() *InvestmentIncomeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvestmentIncomeUpsertOne) DoNothing() *InvestmentIncomeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvestmentIncomeCreate.OnConflict
+// documentation for more info.
+func (u *InvestmentIncomeUpsertOne) Update(set func(*InvestmentIncomeUpsert)) *InvestmentIncomeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvestmentIncomeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentIncomeUpsertOne) SetUpdatedAt(v time.Time) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdateUpdatedAt() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvestmentIncomeUpsertOne) SetAmount(v domain.Money) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvestmentIncomeUpsertOne) AddAmount(v domain.Money) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdateAmount() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentIncomeUpsertOne) SetHoldingID(v uuid.UUID) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetHoldingID(v)
+	})
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdateHoldingID() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateHoldingID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvestmentIncomeUpsertOne) SetKind(v string) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdateKind() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *InvestmentIncomeUpsertOne) SetPaymentDate(v time.Time) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdatePaymentDate() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvestmentIncomeUpsertOne) SetNote(v string) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdateNote() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvestmentIncomeUpsertOne) ClearNote() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *InvestmentIncomeUpsertOne) SetTransactionID(v uuid.UUID) *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertOne) UpdateTransactionID() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *InvestmentIncomeUpsertOne) ClearTransactionID() *InvestmentIncomeUpsertOne {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvestmentIncomeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvestmentIncomeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvestmentIncomeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvestmentIncomeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvestmentIncomeUpsertOne.ID is not supported by MySQL driver. Use InvestmentIncomeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvestmentIncomeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvestmentIncomeCreateBulk is the builder for creating many InvestmentIncome entities in bulk.
+type InvestmentIncomeCreateBulk struct {
+	config
+	err      error
+	builders []*InvestmentIncomeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvestmentIncome entities in the database.
+func (_c *InvestmentIncomeCreateBulk) Save(ctx context.Context) ([]*InvestmentIncome, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvestmentIncome, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvestmentIncomeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvestmentIncomeCreateBulk) SaveX(ctx context.Context) []*InvestmentIncome {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvestmentIncomeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvestmentIncomeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvestmentIncome.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvestmentIncomeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvestmentIncomeCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvestmentIncomeUpsertBulk {
+	_c.conflict = opts
+	return &InvestmentIncomeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvestmentIncome.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvestmentIncomeCreateBulk) OnConflictColumns(columns ...string) *InvestmentIncomeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvestmentIncomeUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvestmentIncomeUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvestmentIncome nodes.
+type InvestmentIncomeUpsertBulk struct {
+	create *InvestmentIncomeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvestmentIncome.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(investmentincome.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvestmentIncomeUpsertBulk) UpdateNewValues() *InvestmentIncomeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(investmentincome.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(investmentincome.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentIncome.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvestmentIncomeUpsertBulk) Ignore() *InvestmentIncomeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvestmentIncomeUpsertBulk) DoNothing() *InvestmentIncomeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvestmentIncomeCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvestmentIncomeUpsertBulk) Update(set func(*InvestmentIncomeUpsert)) *InvestmentIncomeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvestmentIncomeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentIncomeUpsertBulk) SetUpdatedAt(v time.Time) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdateUpdatedAt() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvestmentIncomeUpsertBulk) SetAmount(v domain.Money) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvestmentIncomeUpsertBulk) AddAmount(v domain.Money) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdateAmount() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentIncomeUpsertBulk) SetHoldingID(v uuid.UUID) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetHoldingID(v)
+	})
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdateHoldingID() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateHoldingID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvestmentIncomeUpsertBulk) SetKind(v string) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdateKind() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *InvestmentIncomeUpsertBulk) SetPaymentDate(v time.Time) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdatePaymentDate() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvestmentIncomeUpsertBulk) SetNote(v string) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdateNote() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvestmentIncomeUpsertBulk) ClearNote() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *InvestmentIncomeUpsertBulk) SetTransactionID(v uuid.UUID) *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *InvestmentIncomeUpsertBulk) UpdateTransactionID() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *InvestmentIncomeUpsertBulk) ClearTransactionID() *InvestmentIncomeUpsertBulk {
+	return u.Update(func(s *InvestmentIncomeUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvestmentIncomeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvestmentIncomeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvestmentIncomeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvestmentIncomeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvestmentIncomeUpdate is the builder for updating InvestmentIncome entities.
+type InvestmentIncomeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvestmentIncomeMutation
+}
+
+// Where appends a list predicates to the InvestmentIncomeUpdate builder.
+func (_u *InvestmentIncomeUpdate) Where(ps ...predicate.InvestmentIncome) *InvestmentIncomeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestmentIncomeUpdate) SetUpdatedAt(v time.Time) *InvestmentIncomeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *InvestmentIncomeUpdate) SetAmount(v domain.Money) *InvestmentIncomeUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdate) SetNillableAmount(v *domain.Money) *InvestmentIncomeUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *InvestmentIncomeUpdate) AddAmount(v domain.Money) *InvestmentIncomeUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_u *InvestmentIncomeUpdate) SetHoldingID(v uuid.UUID) *InvestmentIncomeUpdate {
+	_u.mutation.SetHoldingID(v)
+	return _u
+}
+
+// SetNillableHoldingID sets the "holding_id" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdate) SetNillableHoldingID(v *uuid.UUID) *InvestmentIncomeUpdate {
+	if v != nil {
+		_u.SetHoldingID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvestmentIncomeUpdate) SetKind(v string) *InvestmentIncomeUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdate) SetNillableKind(v *string) *InvestmentIncomeUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *InvestmentIncomeUpdate) SetPaymentDate(v time.Time) *InvestmentIncomeUpdate {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdate) SetNillablePaymentDate(v *time.Time) *InvestmentIncomeUpdate {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *InvestmentIncomeUpdate) SetNote(v string) *InvestmentIncomeUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdate) SetNillableNote(v *string) *InvestmentIncomeUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *InvestmentIncomeUpdate) ClearNote() *InvestmentIncomeUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *InvestmentIncomeUpdate) SetTransactionID(v uuid.UUID) *InvestmentIncomeUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdate) SetNillableTransactionID(v *uuid.UUID) *InvestmentIncomeUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *InvestmentIncomeUpdate) ClearTransactionID() *InvestmentIncomeUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvestmentIncomeUpdate) SetLedgerID(id uuid.UUID) *InvestmentIncomeUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvestmentIncomeUpdate) SetLedger(v *Ledger) *InvestmentIncomeUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_u *InvestmentIncomeUpdate) SetHolding(v *Holding) *InvestmentIncomeUpdate {
+	return _u.SetHoldingID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *InvestmentIncomeUpdate) SetTransaction(v *Transaction) *InvestmentIncomeUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the InvestmentIncomeMutation object of the builder.
+func (_u *InvestmentIncomeUpdate) Mutation() *InvestmentIncomeMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvestmentIncomeUpdate) ClearLedger() *InvestmentIncomeUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearHolding clears the "holding" edge to the Holding entity.
+func (_u *InvestmentIncomeUpdate) ClearHolding() *InvestmentIncomeUpdate {
+	_u.mutation.ClearHolding()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *InvestmentIncomeUpdate) ClearTransaction() *InvestmentIncomeUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvestmentIncomeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvestmentIncomeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvestmentIncomeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvestmentIncomeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvestmentIncomeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := investmentincome.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvestmentIncomeUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := investmentincome.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvestmentIncome.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := investmentincome.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvestmentIncome.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentIncome.ledger"`)
+	}
+	if _u.mutation.HoldingCleared() && len(_u.mutation.HoldingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentIncome.holding"`)
+	}
+	return nil
+}
+
+func (_u *InvestmentIncomeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(investmentincome.Table, investmentincome.Columns, sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmentincome.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(investmentincome.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(investmentincome.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(investmentincome.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(investmentincome.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(investmentincome.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(investmentincome.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.LedgerTable,
+			Columns: []string{investmentincome.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.LedgerTable,
+			Columns: []string{investmentincome.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HoldingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.HoldingTable,
+			Columns: []string{investmentincome.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.HoldingTable,
+			Columns: []string{investmentincome.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.TransactionTable,
+			Columns: []string{investmentincome.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.TransactionTable,
+			Columns: []string{investmentincome.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{investmentincome.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvestmentIncomeUpdateOne is the builder for updating a single InvestmentIncome entity.
+type InvestmentIncomeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvestmentIncomeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestmentIncomeUpdateOne) SetUpdatedAt(v time.Time) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *InvestmentIncomeUpdateOne) SetAmount(v domain.Money) *InvestmentIncomeUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdateOne) SetNillableAmount(v *domain.Money) *InvestmentIncomeUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *InvestmentIncomeUpdateOne) AddAmount(v domain.Money) *InvestmentIncomeUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_u *InvestmentIncomeUpdateOne) SetHoldingID(v uuid.UUID) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetHoldingID(v)
+	return _u
+}
+
+// SetNillableHoldingID sets the "holding_id" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdateOne) SetNillableHoldingID(v *uuid.UUID) *InvestmentIncomeUpdateOne {
+	if v != nil {
+		_u.SetHoldingID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvestmentIncomeUpdateOne) SetKind(v string) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdateOne) SetNillableKind(v *string) *InvestmentIncomeUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *InvestmentIncomeUpdateOne) SetPaymentDate(v time.Time) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdateOne) SetNillablePaymentDate(v *time.Time) *InvestmentIncomeUpdateOne {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *InvestmentIncomeUpdateOne) SetNote(v string) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdateOne) SetNillableNote(v *string) *InvestmentIncomeUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *InvestmentIncomeUpdateOne) ClearNote() *InvestmentIncomeUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *InvestmentIncomeUpdateOne) SetTransactionID(v uuid.UUID) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *InvestmentIncomeUpdateOne) SetNillableTransactionID(v *uuid.UUID) *InvestmentIncomeUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *InvestmentIncomeUpdateOne) ClearTransactionID() *InvestmentIncomeUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvestmentIncomeUpdateOne) SetLedgerID(id uuid.UUID) *InvestmentIncomeUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvestmentIncomeUpdateOne) SetLedger(v *Ledger) *InvestmentIncomeUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_u *InvestmentIncomeUpdateOne) SetHolding(v *Holding) *InvestmentIncomeUpdateOne {
+	return _u.SetHoldingID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *InvestmentIncomeUpdateOne) SetTransaction(v *Transaction) *InvestmentIncomeUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the InvestmentIncomeMutation object of the builder.
+func (_u *InvestmentIncomeUpdateOne) Mutation() *InvestmentIncomeMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvestmentIncomeUpdateOne) ClearLedger() *InvestmentIncomeUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearHolding clears the "holding" edge to the Holding entity.
+func (_u *InvestmentIncomeUpdateOne) ClearHolding() *InvestmentIncomeUpdateOne {
+	_u.mutation.ClearHolding()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *InvestmentIncomeUpdateOne) ClearTransaction() *InvestmentIncomeUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the InvestmentIncomeUpdate builder.
+func (_u *InvestmentIncomeUpdateOne) Where(ps ...predicate.InvestmentIncome) *InvestmentIncomeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvestmentIncomeUpdateOne) Select(field string, fields ...string) *InvestmentIncomeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvestmentIncome entity.
+func (_u *InvestmentIncomeUpdateOne) Save(ctx context.Context) (*InvestmentIncome, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvestmentIncomeUpdateOne) SaveX(ctx context.Context) *InvestmentIncome {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvestmentIncomeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvestmentIncomeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvestmentIncomeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := investmentincome.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvestmentIncomeUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := investmentincome.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvestmentIncome.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := investmentincome.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvestmentIncome.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentIncome.ledger"`)
+	}
+	if _u.mutation.HoldingCleared() && len(_u.mutation.HoldingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentIncome.holding"`)
+	}
+	return nil
+}
+
+func (_u *InvestmentIncomeUpdateOne) sqlSave(ctx context.Context) (_node *InvestmentIncome, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(investmentincome.Table, investmentincome.Columns, sqlgraph.NewFieldSpec(investmentincome.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvestmentIncome.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, investmentincome.FieldID)
+		for _, f := range fields {
+			if !investmentincome.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != investmentincome.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmentincome.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(investmentincome.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(investmentincome.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(investmentincome.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(investmentincome.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(investmentincome.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(investmentincome.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.LedgerTable,
+			Columns: []string{investmentincome.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.LedgerTable,
+			Columns: []string{investmentincome.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HoldingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.HoldingTable,
+			Columns: []string{investmentincome.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.HoldingTable,
+			Columns: []string{investmentincome.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.TransactionTable,
+			Columns: []string{investmentincome.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentincome.TransactionTable,
+			Columns: []string{investmentincome.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InvestmentIncome{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{investmentincome.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
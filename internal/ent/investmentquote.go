@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/ledger"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// InvestmentQuote is the model entity for the InvestmentQuote schema.
+type InvestmentQuote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Price holds the value of the "price" field.
+	Price domain.Money `json:"price,omitempty"`
+	// HoldingID holds the value of the "holding_id" field.
+	HoldingID uuid.UUID `json:"holding_id,omitempty"`
+	// QuoteDate holds the value of the "quote_date" field.
+	QuoteDate time.Time `json:"quote_date,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvestmentQuoteQuery when eager-loading is set.
+	Edges        InvestmentQuoteEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// InvestmentQuoteEdges holds the relations/edges for other nodes in the graph.
+type InvestmentQuoteEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Holding holds the value of the holding edge.
+	Holding *Holding `json:"holding,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentQuoteEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// HoldingOrErr returns the Holding value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentQuoteEdges) HoldingOrErr() (*Holding, error) {
+	if e.Holding != nil {
+		return e.Holding, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: holding.Label}
+	}
+	return nil, &NotLoadedError{edge: "holding"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvestmentQuote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case investmentquote.FieldPrice:
+			values[i] = new(domain.Money)
+		case investmentquote.FieldCreatedAt, investmentquote.FieldUpdatedAt, investmentquote.FieldQuoteDate:
+			values[i] = new(sql.NullTime)
+		case investmentquote.FieldID, investmentquote.FieldHoldingID:
+			values[i] = new(uuid.UUID)
+		case investmentquote.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvestmentQuote fields.
+func (_m *InvestmentQuote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case investmentquote.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case investmentquote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case investmentquote.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case investmentquote.FieldPrice:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case investmentquote.FieldHoldingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field holding_id", values[i])
+			} else if value != nil {
+				_m.HoldingID = *value
+			}
+		case investmentquote.FieldQuoteDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_date", values[i])
+			} else if value.Valid {
+				_m.QuoteDate = value.Time
+			}
+		case investmentquote.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvestmentQuote.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvestmentQuote) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the InvestmentQuote entity.
+func (_m *InvestmentQuote) QueryLedger() *LedgerQuery {
+	return NewInvestmentQuoteClient(_m.config).QueryLedger(_m)
+}
+
+// QueryHolding queries the "holding" edge of the InvestmentQuote entity.
+func (_m *InvestmentQuote) QueryHolding() *HoldingQuery {
+	return NewInvestmentQuoteClient(_m.config).QueryHolding(_m)
+}
+
+// Update returns a builder for updating this InvestmentQuote.
+// Note that you need to call InvestmentQuote.Unwrap() before calling this method if this InvestmentQuote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvestmentQuote) Update() *InvestmentQuoteUpdateOne {
+	return NewInvestmentQuoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvestmentQuote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvestmentQuote) Unwrap() *InvestmentQuote {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvestmentQuote is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvestmentQuote) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvestmentQuote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("holding_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HoldingID))
+	builder.WriteString(", ")
+	builder.WriteString("quote_date=")
+	builder.WriteString(_m.QuoteDate.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvestmentQuotes is a parsable slice of InvestmentQuote.
+type InvestmentQuotes []*InvestmentQuote
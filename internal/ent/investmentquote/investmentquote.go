@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package investmentquote
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the investmentquote type in the database.
+	Label = "investment_quote"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldHoldingID holds the string denoting the holding_id field in the database.
+	FieldHoldingID = "holding_id"
+	// FieldQuoteDate holds the string denoting the quote_date field in the database.
+	FieldQuoteDate = "quote_date"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeHolding holds the string denoting the holding edge name in mutations.
+	EdgeHolding = "holding"
+	// Table holds the table name of the investmentquote in the database.
+	Table = "investment_quotes"
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "investment_quotes"
+	// LedgerInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgerInverseTable = "ledgers"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "ledger_id"
+	// HoldingTable is the table that holds the holding relation/edge.
+	HoldingTable = "investment_quotes"
+	// HoldingInverseTable is the table name for the Holding entity.
+	// It exists in this package in order to avoid circular dependency with the "holding" package.
+	HoldingInverseTable = "holdings"
+	// HoldingColumn is the table column denoting the holding relation/edge.
+	HoldingColumn = "holding_id"
+)
+
+// Columns holds all SQL columns for investmentquote fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPrice,
+	FieldHoldingID,
+	FieldQuoteDate,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "investment_quotes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ledger_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the InvestmentQuote queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByHoldingID orders the results by the holding_id field.
+func ByHoldingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldingID, opts...).ToFunc()
+}
+
+// ByQuoteDate orders the results by the quote_date field.
+func ByQuoteDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuoteDate, opts...).ToFunc()
+}
+
+// ByLedgerField orders the results by ledger field.
+func ByLedgerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHoldingField orders the results by holding field.
+func ByHoldingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHoldingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+	)
+}
+func newHoldingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HoldingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HoldingTable, HoldingColumn),
+	)
+}
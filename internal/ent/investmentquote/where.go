@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package investmentquote
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldPrice, v))
+}
+
+// HoldingID applies equality check predicate on the "holding_id" field. It's identical to HoldingIDEQ.
+func HoldingID(v uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldHoldingID, v))
+}
+
+// QuoteDate applies equality check predicate on the "quote_date" field. It's identical to QuoteDateEQ.
+func QuoteDate(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldQuoteDate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v domain.Money) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLTE(FieldPrice, v))
+}
+
+// HoldingIDEQ applies the EQ predicate on the "holding_id" field.
+func HoldingIDEQ(v uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldHoldingID, v))
+}
+
+// HoldingIDNEQ applies the NEQ predicate on the "holding_id" field.
+func HoldingIDNEQ(v uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNEQ(FieldHoldingID, v))
+}
+
+// HoldingIDIn applies the In predicate on the "holding_id" field.
+func HoldingIDIn(vs ...uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldIn(FieldHoldingID, vs...))
+}
+
+// HoldingIDNotIn applies the NotIn predicate on the "holding_id" field.
+func HoldingIDNotIn(vs ...uuid.UUID) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNotIn(FieldHoldingID, vs...))
+}
+
+// QuoteDateEQ applies the EQ predicate on the "quote_date" field.
+func QuoteDateEQ(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldEQ(FieldQuoteDate, v))
+}
+
+// QuoteDateNEQ applies the NEQ predicate on the "quote_date" field.
+func QuoteDateNEQ(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNEQ(FieldQuoteDate, v))
+}
+
+// QuoteDateIn applies the In predicate on the "quote_date" field.
+func QuoteDateIn(vs ...time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldIn(FieldQuoteDate, vs...))
+}
+
+// QuoteDateNotIn applies the NotIn predicate on the "quote_date" field.
+func QuoteDateNotIn(vs ...time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldNotIn(FieldQuoteDate, vs...))
+}
+
+// QuoteDateGT applies the GT predicate on the "quote_date" field.
+func QuoteDateGT(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGT(FieldQuoteDate, v))
+}
+
+// QuoteDateGTE applies the GTE predicate on the "quote_date" field.
+func QuoteDateGTE(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldGTE(FieldQuoteDate, v))
+}
+
+// QuoteDateLT applies the LT predicate on the "quote_date" field.
+func QuoteDateLT(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLT(FieldQuoteDate, v))
+}
+
+// QuoteDateLTE applies the LTE predicate on the "quote_date" field.
+func QuoteDateLTE(v time.Time) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.FieldLTE(FieldQuoteDate, v))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHolding applies the HasEdge predicate on the "holding" edge.
+func HasHolding() predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HoldingTable, HoldingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHoldingWith applies the HasEdge predicate on the "holding" edge with a given conditions (other predicates).
+func HasHoldingWith(preds ...predicate.Holding) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(func(s *sql.Selector) {
+		step := newHoldingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvestmentQuote) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvestmentQuote) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvestmentQuote) predicate.InvestmentQuote {
+	return predicate.InvestmentQuote(sql.NotPredicates(p))
+}
@@ -0,0 +1,786 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/ledger"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvestmentQuoteCreate is the builder for creating a InvestmentQuote entity.
+type InvestmentQuoteCreate struct {
+	config
+	mutation *InvestmentQuoteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvestmentQuoteCreate) SetCreatedAt(v time.Time) *InvestmentQuoteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvestmentQuoteCreate) SetNillableCreatedAt(v *time.Time) *InvestmentQuoteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvestmentQuoteCreate) SetUpdatedAt(v time.Time) *InvestmentQuoteCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvestmentQuoteCreate) SetNillableUpdatedAt(v *time.Time) *InvestmentQuoteCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *InvestmentQuoteCreate) SetPrice(v domain.Money) *InvestmentQuoteCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_c *InvestmentQuoteCreate) SetHoldingID(v uuid.UUID) *InvestmentQuoteCreate {
+	_c.mutation.SetHoldingID(v)
+	return _c
+}
+
+// SetQuoteDate sets the "quote_date" field.
+func (_c *InvestmentQuoteCreate) SetQuoteDate(v time.Time) *InvestmentQuoteCreate {
+	_c.mutation.SetQuoteDate(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvestmentQuoteCreate) SetID(v uuid.UUID) *InvestmentQuoteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvestmentQuoteCreate) SetNillableID(v *uuid.UUID) *InvestmentQuoteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *InvestmentQuoteCreate) SetLedgerID(id uuid.UUID) *InvestmentQuoteCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *InvestmentQuoteCreate) SetLedger(v *Ledger) *InvestmentQuoteCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_c *InvestmentQuoteCreate) SetHolding(v *Holding) *InvestmentQuoteCreate {
+	return _c.SetHoldingID(v.ID)
+}
+
+// Mutation returns the InvestmentQuoteMutation object of the builder.
+func (_c *InvestmentQuoteCreate) Mutation() *InvestmentQuoteMutation {
+	return _c.mutation
+}
+
+// Save creates the InvestmentQuote in the database.
+func (_c *InvestmentQuoteCreate) Save(ctx context.Context) (*InvestmentQuote, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvestmentQuoteCreate) SaveX(ctx context.Context) *InvestmentQuote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvestmentQuoteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvestmentQuoteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvestmentQuoteCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := investmentquote.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := investmentquote.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := investmentquote.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvestmentQuoteCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvestmentQuote.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvestmentQuote.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "InvestmentQuote.price"`)}
+	}
+	if _, ok := _c.mutation.HoldingID(); !ok {
+		return &ValidationError{Name: "holding_id", err: errors.New(`ent: missing required field "InvestmentQuote.holding_id"`)}
+	}
+	if _, ok := _c.mutation.QuoteDate(); !ok {
+		return &ValidationError{Name: "quote_date", err: errors.New(`ent: missing required field "InvestmentQuote.quote_date"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "InvestmentQuote.ledger"`)}
+	}
+	if len(_c.mutation.HoldingIDs()) == 0 {
+		return &ValidationError{Name: "holding", err: errors.New(`ent: missing required edge "InvestmentQuote.holding"`)}
+	}
+	return nil
+}
+
+func (_c *InvestmentQuoteCreate) sqlSave(ctx context.Context) (*InvestmentQuote, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvestmentQuoteCreate) createSpec() (*InvestmentQuote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvestmentQuote{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(investmentquote.Table, sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(investmentquote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmentquote.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(investmentquote.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.QuoteDate(); ok {
+		_spec.SetField(investmentquote.FieldQuoteDate, field.TypeTime, value)
+		_node.QuoteDate = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.LedgerTable,
+			Columns: []string{investmentquote.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.HoldingTable,
+			Columns: []string{investmentquote.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.HoldingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvestmentQuote.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvestmentQuoteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvestmentQuoteCreate) OnConflict(opts ...sql.ConflictOption) *InvestmentQuoteUpsertOne {
+	_c.conflict = opts
+	return &InvestmentQuoteUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvestmentQuote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvestmentQuoteCreate) OnConflictColumns(columns ...string) *InvestmentQuoteUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvestmentQuoteUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvestmentQuoteUpsertOne is the builder for "upsert"-ing
+	//  one InvestmentQuote node.
+	InvestmentQuoteUpsertOne struct {
+		create *InvestmentQuoteCreate
+	}
+
+	// InvestmentQuoteUpsert is the "OnConflict" setter.
+	InvestmentQuoteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentQuoteUpsert) SetUpdatedAt(v time.Time) *InvestmentQuoteUpsert {
+	u.Set(investmentquote.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsert) UpdateUpdatedAt() *InvestmentQuoteUpsert {
+	u.SetExcluded(investmentquote.FieldUpdatedAt)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *InvestmentQuoteUpsert) SetPrice(v domain.Money) *InvestmentQuoteUpsert {
+	u.Set(investmentquote.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsert) UpdatePrice() *InvestmentQuoteUpsert {
+	u.SetExcluded(investmentquote.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *InvestmentQuoteUpsert) AddPrice(v domain.Money) *InvestmentQuoteUpsert {
+	u.Add(investmentquote.FieldPrice, v)
+	return u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentQuoteUpsert) SetHoldingID(v uuid.UUID) *InvestmentQuoteUpsert {
+	u.Set(investmentquote.FieldHoldingID, v)
+	return u
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsert) UpdateHoldingID() *InvestmentQuoteUpsert {
+	u.SetExcluded(investmentquote.FieldHoldingID)
+	return u
+}
+
+// SetQuoteDate sets the "quote_date" field.
+func (u *InvestmentQuoteUpsert) SetQuoteDate(v time.Time) *InvestmentQuoteUpsert {
+	u.Set(investmentquote.FieldQuoteDate, v)
+	return u
+}
+
+// UpdateQuoteDate sets the "quote_date" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsert) UpdateQuoteDate() *InvestmentQuoteUpsert {
+	u.SetExcluded(investmentquote.FieldQuoteDate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentQuote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(investmentquote.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvestmentQuoteUpsertOne) UpdateNewValues() *InvestmentQuoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(investmentquote.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(investmentquote.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentQuote.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvestmentQuoteUpsertOne) Ignore() *InvestmentQuoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvestmentQuoteUpsertOne) DoNothing() *InvestmentQuoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvestmentQuoteCreate.OnConflict
+// documentation for more info.
+func (u *InvestmentQuoteUpsertOne) Update(set func(*InvestmentQuoteUpsert)) *InvestmentQuoteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvestmentQuoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentQuoteUpsertOne) SetUpdatedAt(v time.Time) *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertOne) UpdateUpdatedAt() *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *InvestmentQuoteUpsertOne) SetPrice(v domain.Money) *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *InvestmentQuoteUpsertOne) AddPrice(v domain.Money) *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertOne) UpdatePrice() *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentQuoteUpsertOne) SetHoldingID(v uuid.UUID) *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetHoldingID(v)
+	})
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertOne) UpdateHoldingID() *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdateHoldingID()
+	})
+}
+
+// SetQuoteDate sets the "quote_date" field.
+func (u *InvestmentQuoteUpsertOne) SetQuoteDate(v time.Time) *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetQuoteDate(v)
+	})
+}
+
+// UpdateQuoteDate sets the "quote_date" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertOne) UpdateQuoteDate() *InvestmentQuoteUpsertOne {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdateQuoteDate()
+	})
+}
+
+// Exec executes the query.
+func (u *InvestmentQuoteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvestmentQuoteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvestmentQuoteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvestmentQuoteUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvestmentQuoteUpsertOne.ID is not supported by MySQL driver. Use InvestmentQuoteUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvestmentQuoteUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvestmentQuoteCreateBulk is the builder for creating many InvestmentQuote entities in bulk.
+type InvestmentQuoteCreateBulk struct {
+	config
+	err      error
+	builders []*InvestmentQuoteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvestmentQuote entities in the database.
+func (_c *InvestmentQuoteCreateBulk) Save(ctx context.Context) ([]*InvestmentQuote, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvestmentQuote, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvestmentQuoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvestmentQuoteCreateBulk) SaveX(ctx context.Context) []*InvestmentQuote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvestmentQuoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvestmentQuoteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvestmentQuote.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvestmentQuoteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvestmentQuoteCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvestmentQuoteUpsertBulk {
+	_c.conflict = opts
+	return &InvestmentQuoteUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvestmentQuote.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvestmentQuoteCreateBulk) OnConflictColumns(columns ...string) *InvestmentQuoteUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvestmentQuoteUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvestmentQuoteUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvestmentQuote nodes.
+type InvestmentQuoteUpsertBulk struct {
+	create *InvestmentQuoteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvestmentQuote.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(investmentquote.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvestmentQuoteUpsertBulk) UpdateNewValues() *InvestmentQuoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(investmentquote.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(investmentquote.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentQuote.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvestmentQuoteUpsertBulk) Ignore() *InvestmentQuoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvestmentQuoteUpsertBulk) DoNothing() *InvestmentQuoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvestmentQuoteCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvestmentQuoteUpsertBulk) Update(set func(*InvestmentQuoteUpsert)) *InvestmentQuoteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvestmentQuoteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentQuoteUpsertBulk) SetUpdatedAt(v time.Time) *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertBulk) UpdateUpdatedAt() *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *InvestmentQuoteUpsertBulk) SetPrice(v domain.Money) *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *InvestmentQuoteUpsertBulk) AddPrice(v domain.Money) *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertBulk) UpdatePrice() *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentQuoteUpsertBulk) SetHoldingID(v uuid.UUID) *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetHoldingID(v)
+	})
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertBulk) UpdateHoldingID() *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdateHoldingID()
+	})
+}
+
+// SetQuoteDate sets the "quote_date" field.
+func (u *InvestmentQuoteUpsertBulk) SetQuoteDate(v time.Time) *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.SetQuoteDate(v)
+	})
+}
+
+// UpdateQuoteDate sets the "quote_date" field to the value that was provided on create.
+func (u *InvestmentQuoteUpsertBulk) UpdateQuoteDate() *InvestmentQuoteUpsertBulk {
+	return u.Update(func(s *InvestmentQuoteUpsert) {
+		s.UpdateQuoteDate()
+	})
+}
+
+// Exec executes the query.
+func (u *InvestmentQuoteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvestmentQuoteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvestmentQuoteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvestmentQuoteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
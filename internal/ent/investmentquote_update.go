@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvestmentQuoteUpdate is the builder for updating InvestmentQuote entities.
+type InvestmentQuoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvestmentQuoteMutation
+}
+
+// Where appends a list predicates to the InvestmentQuoteUpdate builder.
+func (_u *InvestmentQuoteUpdate) Where(ps ...predicate.InvestmentQuote) *InvestmentQuoteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestmentQuoteUpdate) SetUpdatedAt(v time.Time) *InvestmentQuoteUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *InvestmentQuoteUpdate) SetPrice(v domain.Money) *InvestmentQuoteUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *InvestmentQuoteUpdate) SetNillablePrice(v *domain.Money) *InvestmentQuoteUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *InvestmentQuoteUpdate) AddPrice(v domain.Money) *InvestmentQuoteUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_u *InvestmentQuoteUpdate) SetHoldingID(v uuid.UUID) *InvestmentQuoteUpdate {
+	_u.mutation.SetHoldingID(v)
+	return _u
+}
+
+// SetNillableHoldingID sets the "holding_id" field if the given value is not nil.
+func (_u *InvestmentQuoteUpdate) SetNillableHoldingID(v *uuid.UUID) *InvestmentQuoteUpdate {
+	if v != nil {
+		_u.SetHoldingID(*v)
+	}
+	return _u
+}
+
+// SetQuoteDate sets the "quote_date" field.
+func (_u *InvestmentQuoteUpdate) SetQuoteDate(v time.Time) *InvestmentQuoteUpdate {
+	_u.mutation.SetQuoteDate(v)
+	return _u
+}
+
+// SetNillableQuoteDate sets the "quote_date" field if the given value is not nil.
+func (_u *InvestmentQuoteUpdate) SetNillableQuoteDate(v *time.Time) *InvestmentQuoteUpdate {
+	if v != nil {
+		_u.SetQuoteDate(*v)
+	}
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvestmentQuoteUpdate) SetLedgerID(id uuid.UUID) *InvestmentQuoteUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvestmentQuoteUpdate) SetLedger(v *Ledger) *InvestmentQuoteUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_u *InvestmentQuoteUpdate) SetHolding(v *Holding) *InvestmentQuoteUpdate {
+	return _u.SetHoldingID(v.ID)
+}
+
+// Mutation returns the InvestmentQuoteMutation object of the builder.
+func (_u *InvestmentQuoteUpdate) Mutation() *InvestmentQuoteMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvestmentQuoteUpdate) ClearLedger() *InvestmentQuoteUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearHolding clears the "holding" edge to the Holding entity.
+func (_u *InvestmentQuoteUpdate) ClearHolding() *InvestmentQuoteUpdate {
+	_u.mutation.ClearHolding()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvestmentQuoteUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvestmentQuoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvestmentQuoteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvestmentQuoteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvestmentQuoteUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := investmentquote.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvestmentQuoteUpdate) check() error {
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentQuote.ledger"`)
+	}
+	if _u.mutation.HoldingCleared() && len(_u.mutation.HoldingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentQuote.holding"`)
+	}
+	return nil
+}
+
+func (_u *InvestmentQuoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(investmentquote.Table, investmentquote.Columns, sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmentquote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(investmentquote.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(investmentquote.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.QuoteDate(); ok {
+		_spec.SetField(investmentquote.FieldQuoteDate, field.TypeTime, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.LedgerTable,
+			Columns: []string{investmentquote.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.LedgerTable,
+			Columns: []string{investmentquote.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HoldingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.HoldingTable,
+			Columns: []string{investmentquote.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.HoldingTable,
+			Columns: []string{investmentquote.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{investmentquote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvestmentQuoteUpdateOne is the builder for updating a single InvestmentQuote entity.
+type InvestmentQuoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvestmentQuoteMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestmentQuoteUpdateOne) SetUpdatedAt(v time.Time) *InvestmentQuoteUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *InvestmentQuoteUpdateOne) SetPrice(v domain.Money) *InvestmentQuoteUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *InvestmentQuoteUpdateOne) SetNillablePrice(v *domain.Money) *InvestmentQuoteUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *InvestmentQuoteUpdateOne) AddPrice(v domain.Money) *InvestmentQuoteUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_u *InvestmentQuoteUpdateOne) SetHoldingID(v uuid.UUID) *InvestmentQuoteUpdateOne {
+	_u.mutation.SetHoldingID(v)
+	return _u
+}
+
+// SetNillableHoldingID sets the "holding_id" field if the given value is not nil.
+func (_u *InvestmentQuoteUpdateOne) SetNillableHoldingID(v *uuid.UUID) *InvestmentQuoteUpdateOne {
+	if v != nil {
+		_u.SetHoldingID(*v)
+	}
+	return _u
+}
+
+// SetQuoteDate sets the "quote_date" field.
+func (_u *InvestmentQuoteUpdateOne) SetQuoteDate(v time.Time) *InvestmentQuoteUpdateOne {
+	_u.mutation.SetQuoteDate(v)
+	return _u
+}
+
+// SetNillableQuoteDate sets the "quote_date" field if the given value is not nil.
+func (_u *InvestmentQuoteUpdateOne) SetNillableQuoteDate(v *time.Time) *InvestmentQuoteUpdateOne {
+	if v != nil {
+		_u.SetQuoteDate(*v)
+	}
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvestmentQuoteUpdateOne) SetLedgerID(id uuid.UUID) *InvestmentQuoteUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvestmentQuoteUpdateOne) SetLedger(v *Ledger) *InvestmentQuoteUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_u *InvestmentQuoteUpdateOne) SetHolding(v *Holding) *InvestmentQuoteUpdateOne {
+	return _u.SetHoldingID(v.ID)
+}
+
+// Mutation returns the InvestmentQuoteMutation object of the builder.
+func (_u *InvestmentQuoteUpdateOne) Mutation() *InvestmentQuoteMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvestmentQuoteUpdateOne) ClearLedger() *InvestmentQuoteUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearHolding clears the "holding" edge to the Holding entity.
+func (_u *InvestmentQuoteUpdateOne) ClearHolding() *InvestmentQuoteUpdateOne {
+	_u.mutation.ClearHolding()
+	return _u
+}
+
+// Where appends a list predicates to the InvestmentQuoteUpdate builder.
+func (_u *InvestmentQuoteUpdateOne) Where(ps ...predicate.InvestmentQuote) *InvestmentQuoteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvestmentQuoteUpdateOne) Select(field string, fields ...string) *InvestmentQuoteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvestmentQuote entity.
+func (_u *InvestmentQuoteUpdateOne) Save(ctx context.Context) (*InvestmentQuote, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvestmentQuoteUpdateOne) SaveX(ctx context.Context) *InvestmentQuote {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvestmentQuoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvestmentQuoteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvestmentQuoteUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := investmentquote.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvestmentQuoteUpdateOne) check() error {
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentQuote.ledger"`)
+	}
+	if _u.mutation.HoldingCleared() && len(_u.mutation.HoldingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentQuote.holding"`)
+	}
+	return nil
+}
+
+func (_u *InvestmentQuoteUpdateOne) sqlSave(ctx context.Context) (_node *InvestmentQuote, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(investmentquote.Table, investmentquote.Columns, sqlgraph.NewFieldSpec(investmentquote.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvestmentQuote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, investmentquote.FieldID)
+		for _, f := range fields {
+			if !investmentquote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != investmentquote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmentquote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(investmentquote.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(investmentquote.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.QuoteDate(); ok {
+		_spec.SetField(investmentquote.FieldQuoteDate, field.TypeTime, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.LedgerTable,
+			Columns: []string{investmentquote.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.LedgerTable,
+			Columns: []string{investmentquote.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HoldingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.HoldingTable,
+			Columns: []string{investmentquote.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmentquote.HoldingTable,
+			Columns: []string{investmentquote.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InvestmentQuote{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{investmentquote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// InvestmentTrade is the model entity for the InvestmentTrade schema.
+type InvestmentTrade struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Price holds the value of the "price" field.
+	Price domain.Money `json:"price,omitempty"`
+	// Fees holds the value of the "fees" field.
+	Fees domain.Money `json:"fees,omitempty"`
+	// HoldingID holds the value of the "holding_id" field.
+	HoldingID uuid.UUID `json:"holding_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// TradeDate holds the value of the "trade_date" field.
+	TradeDate time.Time `json:"trade_date,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity float64 `json:"quantity,omitempty"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvestmentTradeQuery when eager-loading is set.
+	Edges        InvestmentTradeEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// InvestmentTradeEdges holds the relations/edges for other nodes in the graph.
+type InvestmentTradeEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Holding holds the value of the holding edge.
+	Holding *Holding `json:"holding,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentTradeEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// HoldingOrErr returns the Holding value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvestmentTradeEdges) HoldingOrErr() (*Holding, error) {
+	if e.Holding != nil {
+		return e.Holding, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: holding.Label}
+	}
+	return nil, &NotLoadedError{edge: "holding"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvestmentTrade) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case investmenttrade.FieldPrice, investmenttrade.FieldFees:
+			values[i] = new(domain.Money)
+		case investmenttrade.FieldQuantity:
+			values[i] = new(sql.NullFloat64)
+		case investmenttrade.FieldKind, investmenttrade.FieldNote:
+			values[i] = new(sql.NullString)
+		case investmenttrade.FieldCreatedAt, investmenttrade.FieldUpdatedAt, investmenttrade.FieldTradeDate:
+			values[i] = new(sql.NullTime)
+		case investmenttrade.FieldID, investmenttrade.FieldHoldingID:
+			values[i] = new(uuid.UUID)
+		case investmenttrade.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvestmentTrade fields.
+func (_m *InvestmentTrade) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case investmenttrade.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case investmenttrade.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case investmenttrade.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case investmenttrade.FieldPrice:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case investmenttrade.FieldFees:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field fees", values[i])
+			} else if value != nil {
+				_m.Fees = *value
+			}
+		case investmenttrade.FieldHoldingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field holding_id", values[i])
+			} else if value != nil {
+				_m.HoldingID = *value
+			}
+		case investmenttrade.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case investmenttrade.FieldTradeDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trade_date", values[i])
+			} else if value.Valid {
+				_m.TradeDate = value.Time
+			}
+		case investmenttrade.FieldQuantity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = value.Float64
+			}
+		case investmenttrade.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = new(string)
+				*_m.Note = value.String
+			}
+		case investmenttrade.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvestmentTrade.
+// This includes values selected through modifiers, order, etc.
+func (_m *InvestmentTrade) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the InvestmentTrade entity.
+func (_m *InvestmentTrade) QueryLedger() *LedgerQuery {
+	return NewInvestmentTradeClient(_m.config).QueryLedger(_m)
+}
+
+// QueryHolding queries the "holding" edge of the InvestmentTrade entity.
+func (_m *InvestmentTrade) QueryHolding() *HoldingQuery {
+	return NewInvestmentTradeClient(_m.config).QueryHolding(_m)
+}
+
+// Update returns a builder for updating this InvestmentTrade.
+// Note that you need to call InvestmentTrade.Unwrap() before calling this method if this InvestmentTrade
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InvestmentTrade) Update() *InvestmentTradeUpdateOne {
+	return NewInvestmentTradeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InvestmentTrade entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InvestmentTrade) Unwrap() *InvestmentTrade {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvestmentTrade is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InvestmentTrade) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvestmentTrade(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("fees=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fees))
+	builder.WriteString(", ")
+	builder.WriteString("holding_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HoldingID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("trade_date=")
+	builder.WriteString(_m.TradeDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	if v := _m.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvestmentTrades is a parsable slice of InvestmentTrade.
+type InvestmentTrades []*InvestmentTrade
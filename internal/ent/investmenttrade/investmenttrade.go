@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package investmenttrade
+
+import (
+	"frog-go/internal/core/domain"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the investmenttrade type in the database.
+	Label = "investment_trade"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldFees holds the string denoting the fees field in the database.
+	FieldFees = "fees"
+	// FieldHoldingID holds the string denoting the holding_id field in the database.
+	FieldHoldingID = "holding_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldTradeDate holds the string denoting the trade_date field in the database.
+	FieldTradeDate = "trade_date"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeHolding holds the string denoting the holding edge name in mutations.
+	EdgeHolding = "holding"
+	// Table holds the table name of the investmenttrade in the database.
+	Table = "investment_trades"
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "investment_trades"
+	// LedgerInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgerInverseTable = "ledgers"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "ledger_id"
+	// HoldingTable is the table that holds the holding relation/edge.
+	HoldingTable = "investment_trades"
+	// HoldingInverseTable is the table name for the Holding entity.
+	// It exists in this package in order to avoid circular dependency with the "holding" package.
+	HoldingInverseTable = "holdings"
+	// HoldingColumn is the table column denoting the holding relation/edge.
+	HoldingColumn = "holding_id"
+)
+
+// Columns holds all SQL columns for investmenttrade fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPrice,
+	FieldFees,
+	FieldHoldingID,
+	FieldKind,
+	FieldTradeDate,
+	FieldQuantity,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "investment_trades"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ledger_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultFees holds the default value on creation for the "fees" field.
+	DefaultFees domain.Money
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(float64) error
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the InvestmentTrade queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByFees orders the results by the fees field.
+func ByFees(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFees, opts...).ToFunc()
+}
+
+// ByHoldingID orders the results by the holding_id field.
+func ByHoldingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldingID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByTradeDate orders the results by the trade_date field.
+func ByTradeDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradeDate, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByLedgerField orders the results by ledger field.
+func ByLedgerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHoldingField orders the results by holding field.
+func ByHoldingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHoldingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+	)
+}
+func newHoldingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HoldingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, HoldingTable, HoldingColumn),
+	)
+}
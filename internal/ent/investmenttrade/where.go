@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package investmenttrade
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldPrice, v))
+}
+
+// Fees applies equality check predicate on the "fees" field. It's identical to FeesEQ.
+func Fees(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldFees, v))
+}
+
+// HoldingID applies equality check predicate on the "holding_id" field. It's identical to HoldingIDEQ.
+func HoldingID(v uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldHoldingID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldKind, v))
+}
+
+// TradeDate applies equality check predicate on the "trade_date" field. It's identical to TradeDateEQ.
+func TradeDate(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldTradeDate, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldPrice, v))
+}
+
+// FeesEQ applies the EQ predicate on the "fees" field.
+func FeesEQ(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldFees, v))
+}
+
+// FeesNEQ applies the NEQ predicate on the "fees" field.
+func FeesNEQ(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldFees, v))
+}
+
+// FeesIn applies the In predicate on the "fees" field.
+func FeesIn(vs ...domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldFees, vs...))
+}
+
+// FeesNotIn applies the NotIn predicate on the "fees" field.
+func FeesNotIn(vs ...domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldFees, vs...))
+}
+
+// FeesGT applies the GT predicate on the "fees" field.
+func FeesGT(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldFees, v))
+}
+
+// FeesGTE applies the GTE predicate on the "fees" field.
+func FeesGTE(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldFees, v))
+}
+
+// FeesLT applies the LT predicate on the "fees" field.
+func FeesLT(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldFees, v))
+}
+
+// FeesLTE applies the LTE predicate on the "fees" field.
+func FeesLTE(v domain.Money) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldFees, v))
+}
+
+// HoldingIDEQ applies the EQ predicate on the "holding_id" field.
+func HoldingIDEQ(v uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldHoldingID, v))
+}
+
+// HoldingIDNEQ applies the NEQ predicate on the "holding_id" field.
+func HoldingIDNEQ(v uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldHoldingID, v))
+}
+
+// HoldingIDIn applies the In predicate on the "holding_id" field.
+func HoldingIDIn(vs ...uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldHoldingID, vs...))
+}
+
+// HoldingIDNotIn applies the NotIn predicate on the "holding_id" field.
+func HoldingIDNotIn(vs ...uuid.UUID) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldHoldingID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldContainsFold(FieldKind, v))
+}
+
+// TradeDateEQ applies the EQ predicate on the "trade_date" field.
+func TradeDateEQ(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldTradeDate, v))
+}
+
+// TradeDateNEQ applies the NEQ predicate on the "trade_date" field.
+func TradeDateNEQ(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldTradeDate, v))
+}
+
+// TradeDateIn applies the In predicate on the "trade_date" field.
+func TradeDateIn(vs ...time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldTradeDate, vs...))
+}
+
+// TradeDateNotIn applies the NotIn predicate on the "trade_date" field.
+func TradeDateNotIn(vs ...time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldTradeDate, vs...))
+}
+
+// TradeDateGT applies the GT predicate on the "trade_date" field.
+func TradeDateGT(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldTradeDate, v))
+}
+
+// TradeDateGTE applies the GTE predicate on the "trade_date" field.
+func TradeDateGTE(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldTradeDate, v))
+}
+
+// TradeDateLT applies the LT predicate on the "trade_date" field.
+func TradeDateLT(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldTradeDate, v))
+}
+
+// TradeDateLTE applies the LTE predicate on the "trade_date" field.
+func TradeDateLTE(v time.Time) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldTradeDate, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v float64) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldQuantity, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.FieldContainsFold(FieldNote, v))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHolding applies the HasEdge predicate on the "holding" edge.
+func HasHolding() predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, HoldingTable, HoldingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHoldingWith applies the HasEdge predicate on the "holding" edge with a given conditions (other predicates).
+func HasHoldingWith(preds ...predicate.Holding) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(func(s *sql.Selector) {
+		step := newHoldingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvestmentTrade) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvestmentTrade) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvestmentTrade) predicate.InvestmentTrade {
+	return predicate.InvestmentTrade(sql.NotPredicates(p))
+}
@@ -0,0 +1,1090 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvestmentTradeCreate is the builder for creating a InvestmentTrade entity.
+type InvestmentTradeCreate struct {
+	config
+	mutation *InvestmentTradeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvestmentTradeCreate) SetCreatedAt(v time.Time) *InvestmentTradeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvestmentTradeCreate) SetNillableCreatedAt(v *time.Time) *InvestmentTradeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvestmentTradeCreate) SetUpdatedAt(v time.Time) *InvestmentTradeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvestmentTradeCreate) SetNillableUpdatedAt(v *time.Time) *InvestmentTradeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *InvestmentTradeCreate) SetPrice(v domain.Money) *InvestmentTradeCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetFees sets the "fees" field.
+func (_c *InvestmentTradeCreate) SetFees(v domain.Money) *InvestmentTradeCreate {
+	_c.mutation.SetFees(v)
+	return _c
+}
+
+// SetNillableFees sets the "fees" field if the given value is not nil.
+func (_c *InvestmentTradeCreate) SetNillableFees(v *domain.Money) *InvestmentTradeCreate {
+	if v != nil {
+		_c.SetFees(*v)
+	}
+	return _c
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_c *InvestmentTradeCreate) SetHoldingID(v uuid.UUID) *InvestmentTradeCreate {
+	_c.mutation.SetHoldingID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *InvestmentTradeCreate) SetKind(v string) *InvestmentTradeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTradeDate sets the "trade_date" field.
+func (_c *InvestmentTradeCreate) SetTradeDate(v time.Time) *InvestmentTradeCreate {
+	_c.mutation.SetTradeDate(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *InvestmentTradeCreate) SetQuantity(v float64) *InvestmentTradeCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *InvestmentTradeCreate) SetNote(v string) *InvestmentTradeCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *InvestmentTradeCreate) SetNillableNote(v *string) *InvestmentTradeCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvestmentTradeCreate) SetID(v uuid.UUID) *InvestmentTradeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvestmentTradeCreate) SetNillableID(v *uuid.UUID) *InvestmentTradeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *InvestmentTradeCreate) SetLedgerID(id uuid.UUID) *InvestmentTradeCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *InvestmentTradeCreate) SetLedger(v *Ledger) *InvestmentTradeCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_c *InvestmentTradeCreate) SetHolding(v *Holding) *InvestmentTradeCreate {
+	return _c.SetHoldingID(v.ID)
+}
+
+// Mutation returns the InvestmentTradeMutation object of the builder.
+func (_c *InvestmentTradeCreate) Mutation() *InvestmentTradeMutation {
+	return _c.mutation
+}
+
+// Save creates the InvestmentTrade in the database.
+func (_c *InvestmentTradeCreate) Save(ctx context.Context) (*InvestmentTrade, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvestmentTradeCreate) SaveX(ctx context.Context) *InvestmentTrade {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvestmentTradeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvestmentTradeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvestmentTradeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := investmenttrade.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := investmenttrade.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Fees(); !ok {
+		v := investmenttrade.DefaultFees
+		_c.mutation.SetFees(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := investmenttrade.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvestmentTradeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvestmentTrade.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvestmentTrade.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "InvestmentTrade.price"`)}
+	}
+	if _, ok := _c.mutation.Fees(); !ok {
+		return &ValidationError{Name: "fees", err: errors.New(`ent: missing required field "InvestmentTrade.fees"`)}
+	}
+	if _, ok := _c.mutation.HoldingID(); !ok {
+		return &ValidationError{Name: "holding_id", err: errors.New(`ent: missing required field "InvestmentTrade.holding_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "InvestmentTrade.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := investmenttrade.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TradeDate(); !ok {
+		return &ValidationError{Name: "trade_date", err: errors.New(`ent: missing required field "InvestmentTrade.trade_date"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "InvestmentTrade.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := investmenttrade.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := investmenttrade.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "InvestmentTrade.ledger"`)}
+	}
+	if len(_c.mutation.HoldingIDs()) == 0 {
+		return &ValidationError{Name: "holding", err: errors.New(`ent: missing required edge "InvestmentTrade.holding"`)}
+	}
+	return nil
+}
+
+func (_c *InvestmentTradeCreate) sqlSave(ctx context.Context) (*InvestmentTrade, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvestmentTradeCreate) createSpec() (*InvestmentTrade, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvestmentTrade{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(investmenttrade.Table, sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(investmenttrade.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmenttrade.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(investmenttrade.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Fees(); ok {
+		_spec.SetField(investmenttrade.FieldFees, field.TypeInt64, value)
+		_node.Fees = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(investmenttrade.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.TradeDate(); ok {
+		_spec.SetField(investmenttrade.FieldTradeDate, field.TypeTime, value)
+		_node.TradeDate = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(investmenttrade.FieldQuantity, field.TypeFloat64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(investmenttrade.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.LedgerTable,
+			Columns: []string{investmenttrade.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.HoldingTable,
+			Columns: []string{investmenttrade.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.HoldingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvestmentTrade.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvestmentTradeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvestmentTradeCreate) OnConflict(opts ...sql.ConflictOption) *InvestmentTradeUpsertOne {
+	_c.conflict = opts
+	return &InvestmentTradeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvestmentTrade.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvestmentTradeCreate) OnConflictColumns(columns ...string) *InvestmentTradeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvestmentTradeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvestmentTradeUpsertOne is the builder for "upsert"-ing
+	//  one InvestmentTrade node.
+	InvestmentTradeUpsertOne struct {
+		create *InvestmentTradeCreate
+	}
+
+	// InvestmentTradeUpsert is the "OnConflict" setter.
+	InvestmentTradeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentTradeUpsert) SetUpdatedAt(v time.Time) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateUpdatedAt() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldUpdatedAt)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *InvestmentTradeUpsert) SetPrice(v domain.Money) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdatePrice() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *InvestmentTradeUpsert) AddPrice(v domain.Money) *InvestmentTradeUpsert {
+	u.Add(investmenttrade.FieldPrice, v)
+	return u
+}
+
+// SetFees sets the "fees" field.
+func (u *InvestmentTradeUpsert) SetFees(v domain.Money) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldFees, v)
+	return u
+}
+
+// UpdateFees sets the "fees" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateFees() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldFees)
+	return u
+}
+
+// AddFees adds v to the "fees" field.
+func (u *InvestmentTradeUpsert) AddFees(v domain.Money) *InvestmentTradeUpsert {
+	u.Add(investmenttrade.FieldFees, v)
+	return u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentTradeUpsert) SetHoldingID(v uuid.UUID) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldHoldingID, v)
+	return u
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateHoldingID() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldHoldingID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *InvestmentTradeUpsert) SetKind(v string) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateKind() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldKind)
+	return u
+}
+
+// SetTradeDate sets the "trade_date" field.
+func (u *InvestmentTradeUpsert) SetTradeDate(v time.Time) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldTradeDate, v)
+	return u
+}
+
+// UpdateTradeDate sets the "trade_date" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateTradeDate() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldTradeDate)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InvestmentTradeUpsert) SetQuantity(v float64) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateQuantity() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InvestmentTradeUpsert) AddQuantity(v float64) *InvestmentTradeUpsert {
+	u.Add(investmenttrade.FieldQuantity, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *InvestmentTradeUpsert) SetNote(v string) *InvestmentTradeUpsert {
+	u.Set(investmenttrade.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvestmentTradeUpsert) UpdateNote() *InvestmentTradeUpsert {
+	u.SetExcluded(investmenttrade.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvestmentTradeUpsert) ClearNote() *InvestmentTradeUpsert {
+	u.SetNull(investmenttrade.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentTrade.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(investmenttrade.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvestmentTradeUpsertOne) UpdateNewValues() *InvestmentTradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(investmenttrade.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(investmenttrade.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentTrade.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvestmentTradeUpsertOne) Ignore() *InvestmentTradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvestmentTradeUpsertOne) DoNothing() *InvestmentTradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvestmentTradeCreate.OnConflict
+// documentation for more info.
+func (u *InvestmentTradeUpsertOne) Update(set func(*InvestmentTradeUpsert)) *InvestmentTradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvestmentTradeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentTradeUpsertOne) SetUpdatedAt(v time.Time) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateUpdatedAt() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *InvestmentTradeUpsertOne) SetPrice(v domain.Money) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *InvestmentTradeUpsertOne) AddPrice(v domain.Money) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdatePrice() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetFees sets the "fees" field.
+func (u *InvestmentTradeUpsertOne) SetFees(v domain.Money) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetFees(v)
+	})
+}
+
+// AddFees adds v to the "fees" field.
+func (u *InvestmentTradeUpsertOne) AddFees(v domain.Money) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.AddFees(v)
+	})
+}
+
+// UpdateFees sets the "fees" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateFees() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateFees()
+	})
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentTradeUpsertOne) SetHoldingID(v uuid.UUID) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetHoldingID(v)
+	})
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateHoldingID() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateHoldingID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvestmentTradeUpsertOne) SetKind(v string) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateKind() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetTradeDate sets the "trade_date" field.
+func (u *InvestmentTradeUpsertOne) SetTradeDate(v time.Time) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetTradeDate(v)
+	})
+}
+
+// UpdateTradeDate sets the "trade_date" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateTradeDate() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateTradeDate()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InvestmentTradeUpsertOne) SetQuantity(v float64) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InvestmentTradeUpsertOne) AddQuantity(v float64) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateQuantity() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvestmentTradeUpsertOne) SetNote(v string) *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertOne) UpdateNote() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvestmentTradeUpsertOne) ClearNote() *InvestmentTradeUpsertOne {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *InvestmentTradeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvestmentTradeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvestmentTradeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvestmentTradeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvestmentTradeUpsertOne.ID is not supported by MySQL driver. Use InvestmentTradeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvestmentTradeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvestmentTradeCreateBulk is the builder for creating many InvestmentTrade entities in bulk.
+type InvestmentTradeCreateBulk struct {
+	config
+	err      error
+	builders []*InvestmentTradeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvestmentTrade entities in the database.
+func (_c *InvestmentTradeCreateBulk) Save(ctx context.Context) ([]*InvestmentTrade, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvestmentTrade, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvestmentTradeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvestmentTradeCreateBulk) SaveX(ctx context.Context) []*InvestmentTrade {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvestmentTradeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvestmentTradeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvestmentTrade.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvestmentTradeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvestmentTradeCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvestmentTradeUpsertBulk {
+	_c.conflict = opts
+	return &InvestmentTradeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvestmentTrade.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvestmentTradeCreateBulk) OnConflictColumns(columns ...string) *InvestmentTradeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvestmentTradeUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvestmentTradeUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvestmentTrade nodes.
+type InvestmentTradeUpsertBulk struct {
+	create *InvestmentTradeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvestmentTrade.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(investmenttrade.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvestmentTradeUpsertBulk) UpdateNewValues() *InvestmentTradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(investmenttrade.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(investmenttrade.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvestmentTrade.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvestmentTradeUpsertBulk) Ignore() *InvestmentTradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvestmentTradeUpsertBulk) DoNothing() *InvestmentTradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvestmentTradeCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvestmentTradeUpsertBulk) Update(set func(*InvestmentTradeUpsert)) *InvestmentTradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvestmentTradeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvestmentTradeUpsertBulk) SetUpdatedAt(v time.Time) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateUpdatedAt() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *InvestmentTradeUpsertBulk) SetPrice(v domain.Money) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *InvestmentTradeUpsertBulk) AddPrice(v domain.Money) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdatePrice() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetFees sets the "fees" field.
+func (u *InvestmentTradeUpsertBulk) SetFees(v domain.Money) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetFees(v)
+	})
+}
+
+// AddFees adds v to the "fees" field.
+func (u *InvestmentTradeUpsertBulk) AddFees(v domain.Money) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.AddFees(v)
+	})
+}
+
+// UpdateFees sets the "fees" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateFees() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateFees()
+	})
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (u *InvestmentTradeUpsertBulk) SetHoldingID(v uuid.UUID) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetHoldingID(v)
+	})
+}
+
+// UpdateHoldingID sets the "holding_id" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateHoldingID() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateHoldingID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvestmentTradeUpsertBulk) SetKind(v string) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateKind() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetTradeDate sets the "trade_date" field.
+func (u *InvestmentTradeUpsertBulk) SetTradeDate(v time.Time) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetTradeDate(v)
+	})
+}
+
+// UpdateTradeDate sets the "trade_date" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateTradeDate() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateTradeDate()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InvestmentTradeUpsertBulk) SetQuantity(v float64) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InvestmentTradeUpsertBulk) AddQuantity(v float64) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateQuantity() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvestmentTradeUpsertBulk) SetNote(v string) *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvestmentTradeUpsertBulk) UpdateNote() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvestmentTradeUpsertBulk) ClearNote() *InvestmentTradeUpsertBulk {
+	return u.Update(func(s *InvestmentTradeUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *InvestmentTradeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvestmentTradeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvestmentTradeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvestmentTradeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/investmenttrade"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvestmentTradeUpdate is the builder for updating InvestmentTrade entities.
+type InvestmentTradeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvestmentTradeMutation
+}
+
+// Where appends a list predicates to the InvestmentTradeUpdate builder.
+func (_u *InvestmentTradeUpdate) Where(ps ...predicate.InvestmentTrade) *InvestmentTradeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestmentTradeUpdate) SetUpdatedAt(v time.Time) *InvestmentTradeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *InvestmentTradeUpdate) SetPrice(v domain.Money) *InvestmentTradeUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillablePrice(v *domain.Money) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *InvestmentTradeUpdate) AddPrice(v domain.Money) *InvestmentTradeUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetFees sets the "fees" field.
+func (_u *InvestmentTradeUpdate) SetFees(v domain.Money) *InvestmentTradeUpdate {
+	_u.mutation.ResetFees()
+	_u.mutation.SetFees(v)
+	return _u
+}
+
+// SetNillableFees sets the "fees" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillableFees(v *domain.Money) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetFees(*v)
+	}
+	return _u
+}
+
+// AddFees adds value to the "fees" field.
+func (_u *InvestmentTradeUpdate) AddFees(v domain.Money) *InvestmentTradeUpdate {
+	_u.mutation.AddFees(v)
+	return _u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_u *InvestmentTradeUpdate) SetHoldingID(v uuid.UUID) *InvestmentTradeUpdate {
+	_u.mutation.SetHoldingID(v)
+	return _u
+}
+
+// SetNillableHoldingID sets the "holding_id" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillableHoldingID(v *uuid.UUID) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetHoldingID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvestmentTradeUpdate) SetKind(v string) *InvestmentTradeUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillableKind(v *string) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTradeDate sets the "trade_date" field.
+func (_u *InvestmentTradeUpdate) SetTradeDate(v time.Time) *InvestmentTradeUpdate {
+	_u.mutation.SetTradeDate(v)
+	return _u
+}
+
+// SetNillableTradeDate sets the "trade_date" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillableTradeDate(v *time.Time) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetTradeDate(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *InvestmentTradeUpdate) SetQuantity(v float64) *InvestmentTradeUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillableQuantity(v *float64) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *InvestmentTradeUpdate) AddQuantity(v float64) *InvestmentTradeUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *InvestmentTradeUpdate) SetNote(v string) *InvestmentTradeUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *InvestmentTradeUpdate) SetNillableNote(v *string) *InvestmentTradeUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *InvestmentTradeUpdate) ClearNote() *InvestmentTradeUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvestmentTradeUpdate) SetLedgerID(id uuid.UUID) *InvestmentTradeUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvestmentTradeUpdate) SetLedger(v *Ledger) *InvestmentTradeUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_u *InvestmentTradeUpdate) SetHolding(v *Holding) *InvestmentTradeUpdate {
+	return _u.SetHoldingID(v.ID)
+}
+
+// Mutation returns the InvestmentTradeMutation object of the builder.
+func (_u *InvestmentTradeUpdate) Mutation() *InvestmentTradeMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvestmentTradeUpdate) ClearLedger() *InvestmentTradeUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearHolding clears the "holding" edge to the Holding entity.
+func (_u *InvestmentTradeUpdate) ClearHolding() *InvestmentTradeUpdate {
+	_u.mutation.ClearHolding()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvestmentTradeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvestmentTradeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvestmentTradeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvestmentTradeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvestmentTradeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := investmenttrade.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvestmentTradeUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := investmenttrade.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := investmenttrade.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := investmenttrade.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentTrade.ledger"`)
+	}
+	if _u.mutation.HoldingCleared() && len(_u.mutation.HoldingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentTrade.holding"`)
+	}
+	return nil
+}
+
+func (_u *InvestmentTradeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(investmenttrade.Table, investmenttrade.Columns, sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmenttrade.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(investmenttrade.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(investmenttrade.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Fees(); ok {
+		_spec.SetField(investmenttrade.FieldFees, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFees(); ok {
+		_spec.AddField(investmenttrade.FieldFees, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(investmenttrade.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TradeDate(); ok {
+		_spec.SetField(investmenttrade.FieldTradeDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(investmenttrade.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(investmenttrade.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(investmenttrade.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(investmenttrade.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.LedgerTable,
+			Columns: []string{investmenttrade.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.LedgerTable,
+			Columns: []string{investmenttrade.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HoldingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.HoldingTable,
+			Columns: []string{investmenttrade.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.HoldingTable,
+			Columns: []string{investmenttrade.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{investmenttrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvestmentTradeUpdateOne is the builder for updating a single InvestmentTrade entity.
+type InvestmentTradeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvestmentTradeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestmentTradeUpdateOne) SetUpdatedAt(v time.Time) *InvestmentTradeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *InvestmentTradeUpdateOne) SetPrice(v domain.Money) *InvestmentTradeUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillablePrice(v *domain.Money) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *InvestmentTradeUpdateOne) AddPrice(v domain.Money) *InvestmentTradeUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetFees sets the "fees" field.
+func (_u *InvestmentTradeUpdateOne) SetFees(v domain.Money) *InvestmentTradeUpdateOne {
+	_u.mutation.ResetFees()
+	_u.mutation.SetFees(v)
+	return _u
+}
+
+// SetNillableFees sets the "fees" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillableFees(v *domain.Money) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetFees(*v)
+	}
+	return _u
+}
+
+// AddFees adds value to the "fees" field.
+func (_u *InvestmentTradeUpdateOne) AddFees(v domain.Money) *InvestmentTradeUpdateOne {
+	_u.mutation.AddFees(v)
+	return _u
+}
+
+// SetHoldingID sets the "holding_id" field.
+func (_u *InvestmentTradeUpdateOne) SetHoldingID(v uuid.UUID) *InvestmentTradeUpdateOne {
+	_u.mutation.SetHoldingID(v)
+	return _u
+}
+
+// SetNillableHoldingID sets the "holding_id" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillableHoldingID(v *uuid.UUID) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetHoldingID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvestmentTradeUpdateOne) SetKind(v string) *InvestmentTradeUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillableKind(v *string) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTradeDate sets the "trade_date" field.
+func (_u *InvestmentTradeUpdateOne) SetTradeDate(v time.Time) *InvestmentTradeUpdateOne {
+	_u.mutation.SetTradeDate(v)
+	return _u
+}
+
+// SetNillableTradeDate sets the "trade_date" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillableTradeDate(v *time.Time) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetTradeDate(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *InvestmentTradeUpdateOne) SetQuantity(v float64) *InvestmentTradeUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillableQuantity(v *float64) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *InvestmentTradeUpdateOne) AddQuantity(v float64) *InvestmentTradeUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *InvestmentTradeUpdateOne) SetNote(v string) *InvestmentTradeUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *InvestmentTradeUpdateOne) SetNillableNote(v *string) *InvestmentTradeUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *InvestmentTradeUpdateOne) ClearNote() *InvestmentTradeUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvestmentTradeUpdateOne) SetLedgerID(id uuid.UUID) *InvestmentTradeUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvestmentTradeUpdateOne) SetLedger(v *Ledger) *InvestmentTradeUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetHolding sets the "holding" edge to the Holding entity.
+func (_u *InvestmentTradeUpdateOne) SetHolding(v *Holding) *InvestmentTradeUpdateOne {
+	return _u.SetHoldingID(v.ID)
+}
+
+// Mutation returns the InvestmentTradeMutation object of the builder.
+func (_u *InvestmentTradeUpdateOne) Mutation() *InvestmentTradeMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvestmentTradeUpdateOne) ClearLedger() *InvestmentTradeUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearHolding clears the "holding" edge to the Holding entity.
+func (_u *InvestmentTradeUpdateOne) ClearHolding() *InvestmentTradeUpdateOne {
+	_u.mutation.ClearHolding()
+	return _u
+}
+
+// Where appends a list predicates to the InvestmentTradeUpdate builder.
+func (_u *InvestmentTradeUpdateOne) Where(ps ...predicate.InvestmentTrade) *InvestmentTradeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvestmentTradeUpdateOne) Select(field string, fields ...string) *InvestmentTradeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvestmentTrade entity.
+func (_u *InvestmentTradeUpdateOne) Save(ctx context.Context) (*InvestmentTrade, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvestmentTradeUpdateOne) SaveX(ctx context.Context) *InvestmentTrade {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvestmentTradeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvestmentTradeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvestmentTradeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := investmenttrade.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvestmentTradeUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := investmenttrade.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := investmenttrade.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := investmenttrade.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvestmentTrade.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentTrade.ledger"`)
+	}
+	if _u.mutation.HoldingCleared() && len(_u.mutation.HoldingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvestmentTrade.holding"`)
+	}
+	return nil
+}
+
+func (_u *InvestmentTradeUpdateOne) sqlSave(ctx context.Context) (_node *InvestmentTrade, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(investmenttrade.Table, investmenttrade.Columns, sqlgraph.NewFieldSpec(investmenttrade.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvestmentTrade.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, investmenttrade.FieldID)
+		for _, f := range fields {
+			if !investmenttrade.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != investmenttrade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investmenttrade.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(investmenttrade.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(investmenttrade.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Fees(); ok {
+		_spec.SetField(investmenttrade.FieldFees, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFees(); ok {
+		_spec.AddField(investmenttrade.FieldFees, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(investmenttrade.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TradeDate(); ok {
+		_spec.SetField(investmenttrade.FieldTradeDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(investmenttrade.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(investmenttrade.FieldQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(investmenttrade.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(investmenttrade.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.LedgerTable,
+			Columns: []string{investmenttrade.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.LedgerTable,
+			Columns: []string{investmenttrade.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HoldingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.HoldingTable,
+			Columns: []string{investmenttrade.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HoldingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   investmenttrade.HoldingTable,
+			Columns: []string{investmenttrade.HoldingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holding.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InvestmentTrade{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{investmenttrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,7 +4,10 @@ package ent
 
 import (
 	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/user"
 	"strings"
 	"time"
@@ -23,18 +26,26 @@ type Invoice struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// Status holds the value of the "status" field.
-	Status string `json:"status,omitempty"`
 	// Amount holds the value of the "amount" field.
-	Amount float64 `json:"amount,omitempty"`
+	Amount domain.Money `json:"amount,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// DueDate holds the value of the "due_date" field.
 	DueDate time.Time `json:"due_date,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+	// PaymentAccountID holds the value of the "payment_account_id" field.
+	PaymentAccountID *uuid.UUID `json:"payment_account_id,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *uuid.UUID `json:"created_by_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InvoiceQuery when eager-loading is set.
 	Edges        InvoiceEdges `json:"edges"`
-	user_id      *uuid.UUID
+	ledger_id    *uuid.UUID
 	selectValues sql.SelectValues
 }
 
@@ -42,11 +53,17 @@ type Invoice struct {
 type InvoiceEdges struct {
 	// Transactions holds the value of the transactions edge.
 	Transactions []*Transaction `json:"transactions,omitempty"`
-	// User holds the value of the user edge.
-	User *User `json:"user,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*InvoicePayment `json:"payments,omitempty"`
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// PaymentAccount holds the value of the payment_account edge.
+	PaymentAccount *Account `json:"payment_account,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // TransactionsOrErr returns the Transactions value or an error if the edge
@@ -58,15 +75,46 @@ func (e InvoiceEdges) TransactionsOrErr() ([]*Transaction, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
-// UserOrErr returns the User value or an error if the edge
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) PaymentsOrErr() ([]*InvoicePayment, error) {
+	if e.loadedTypes[1] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
-func (e InvoiceEdges) UserOrErr() (*User, error) {
-	if e.User != nil {
-		return e.User, nil
-	} else if e.loadedTypes[1] {
+func (e InvoiceEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: user.Label}
 	}
-	return nil, &NotLoadedError{edge: "user"}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// PaymentAccountOrErr returns the PaymentAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) PaymentAccountOrErr() (*Account, error) {
+	if e.PaymentAccount != nil {
+		return e.PaymentAccount, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_account"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -74,15 +122,17 @@ func (*Invoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case invoice.FieldPaymentAccountID, invoice.FieldCreatedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case invoice.FieldAmount:
-			values[i] = new(sql.NullFloat64)
-		case invoice.FieldStatus, invoice.FieldTitle:
+			values[i] = new(domain.Money)
+		case invoice.FieldTitle, invoice.FieldStatus:
 			values[i] = new(sql.NullString)
-		case invoice.FieldCreatedAt, invoice.FieldUpdatedAt, invoice.FieldDueDate:
+		case invoice.FieldCreatedAt, invoice.FieldUpdatedAt, invoice.FieldDueDate, invoice.FieldClosedAt, invoice.FieldPaidAt:
 			values[i] = new(sql.NullTime)
 		case invoice.FieldID:
 			values[i] = new(uuid.UUID)
-		case invoice.ForeignKeys[0]: // user_id
+		case invoice.ForeignKeys[0]: // ledger_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -117,17 +167,11 @@ func (_m *Invoice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case invoice.FieldStatus:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field status", values[i])
-			} else if value.Valid {
-				_m.Status = value.String
-			}
 		case invoice.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*domain.Money); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
-			} else if value.Valid {
-				_m.Amount = value.Float64
+			} else if value != nil {
+				_m.Amount = *value
 			}
 		case invoice.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -141,12 +185,46 @@ func (_m *Invoice) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DueDate = value.Time
 			}
+		case invoice.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case invoice.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case invoice.FieldPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[i])
+			} else if value.Valid {
+				_m.PaidAt = new(time.Time)
+				*_m.PaidAt = value.Time
+			}
+		case invoice.FieldPaymentAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_account_id", values[i])
+			} else if value.Valid {
+				_m.PaymentAccountID = new(uuid.UUID)
+				*_m.PaymentAccountID = *value.S.(*uuid.UUID)
+			}
+		case invoice.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByID = new(uuid.UUID)
+				*_m.CreatedByID = *value.S.(*uuid.UUID)
+			}
 		case invoice.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
 			} else if value.Valid {
-				_m.user_id = new(uuid.UUID)
-				*_m.user_id = *value.S.(*uuid.UUID)
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -166,9 +244,24 @@ func (_m *Invoice) QueryTransactions() *TransactionQuery {
 	return NewInvoiceClient(_m.config).QueryTransactions(_m)
 }
 
-// QueryUser queries the "user" edge of the Invoice entity.
-func (_m *Invoice) QueryUser() *UserQuery {
-	return NewInvoiceClient(_m.config).QueryUser(_m)
+// QueryPayments queries the "payments" edge of the Invoice entity.
+func (_m *Invoice) QueryPayments() *InvoicePaymentQuery {
+	return NewInvoiceClient(_m.config).QueryPayments(_m)
+}
+
+// QueryLedger queries the "ledger" edge of the Invoice entity.
+func (_m *Invoice) QueryLedger() *LedgerQuery {
+	return NewInvoiceClient(_m.config).QueryLedger(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the Invoice entity.
+func (_m *Invoice) QueryCreatedBy() *UserQuery {
+	return NewInvoiceClient(_m.config).QueryCreatedBy(_m)
+}
+
+// QueryPaymentAccount queries the "payment_account" edge of the Invoice entity.
+func (_m *Invoice) QueryPaymentAccount() *AccountQuery {
+	return NewInvoiceClient(_m.config).QueryPaymentAccount(_m)
 }
 
 // Update returns a builder for updating this Invoice.
@@ -200,9 +293,6 @@ func (_m *Invoice) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	builder.WriteString("status=")
-	builder.WriteString(_m.Status)
-	builder.WriteString(", ")
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
@@ -211,6 +301,29 @@ func (_m *Invoice) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("due_date=")
 	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PaidAt; v != nil {
+		builder.WriteString("paid_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PaymentAccountID; v != nil {
+		builder.WriteString("payment_account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
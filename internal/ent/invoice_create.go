@@ -6,11 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/transaction"
 	"frog-go/internal/ent/user"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +27,7 @@ type InvoiceCreate struct {
 	config
 	mutation *InvoiceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -51,6 +58,32 @@ func (_c *InvoiceCreate) SetNillableUpdatedAt(v *time.Time) *InvoiceCreate {
 	return _c
 }
 
+// SetAmount sets the "amount" field.
+func (_c *InvoiceCreate) SetAmount(v domain.Money) *InvoiceCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableAmount(v *domain.Money) *InvoiceCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *InvoiceCreate) SetTitle(v string) *InvoiceCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *InvoiceCreate) SetDueDate(v time.Time) *InvoiceCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *InvoiceCreate) SetStatus(v string) *InvoiceCreate {
 	_c.mutation.SetStatus(v)
@@ -65,29 +98,59 @@ func (_c *InvoiceCreate) SetNillableStatus(v *string) *InvoiceCreate {
 	return _c
 }
 
-// SetAmount sets the "amount" field.
-func (_c *InvoiceCreate) SetAmount(v float64) *InvoiceCreate {
-	_c.mutation.SetAmount(v)
+// SetClosedAt sets the "closed_at" field.
+func (_c *InvoiceCreate) SetClosedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetClosedAt(v)
 	return _c
 }
 
-// SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_c *InvoiceCreate) SetNillableAmount(v *float64) *InvoiceCreate {
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableClosedAt(v *time.Time) *InvoiceCreate {
 	if v != nil {
-		_c.SetAmount(*v)
+		_c.SetClosedAt(*v)
 	}
 	return _c
 }
 
-// SetTitle sets the "title" field.
-func (_c *InvoiceCreate) SetTitle(v string) *InvoiceCreate {
-	_c.mutation.SetTitle(v)
+// SetPaidAt sets the "paid_at" field.
+func (_c *InvoiceCreate) SetPaidAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetPaidAt(v)
 	return _c
 }
 
-// SetDueDate sets the "due_date" field.
-func (_c *InvoiceCreate) SetDueDate(v time.Time) *InvoiceCreate {
-	_c.mutation.SetDueDate(v)
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillablePaidAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetPaidAt(*v)
+	}
+	return _c
+}
+
+// SetPaymentAccountID sets the "payment_account_id" field.
+func (_c *InvoiceCreate) SetPaymentAccountID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetPaymentAccountID(v)
+	return _c
+}
+
+// SetNillablePaymentAccountID sets the "payment_account_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillablePaymentAccountID(v *uuid.UUID) *InvoiceCreate {
+	if v != nil {
+		_c.SetPaymentAccountID(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *InvoiceCreate) SetCreatedByID(v uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCreatedByID(v *uuid.UUID) *InvoiceCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
 	return _c
 }
 
@@ -120,15 +183,40 @@ func (_c *InvoiceCreate) AddTransactions(v ...*Transaction) *InvoiceCreate {
 	return _c.AddTransactionIDs(ids...)
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_c *InvoiceCreate) SetUserID(id uuid.UUID) *InvoiceCreate {
-	_c.mutation.SetUserID(id)
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by IDs.
+func (_c *InvoiceCreate) AddPaymentIDs(ids ...uuid.UUID) *InvoiceCreate {
+	_c.mutation.AddPaymentIDs(ids...)
+	return _c
+}
+
+// AddPayments adds the "payments" edges to the InvoicePayment entity.
+func (_c *InvoiceCreate) AddPayments(v ...*InvoicePayment) *InvoiceCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaymentIDs(ids...)
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *InvoiceCreate) SetLedgerID(id uuid.UUID) *InvoiceCreate {
+	_c.mutation.SetLedgerID(id)
 	return _c
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_c *InvoiceCreate) SetUser(v *User) *InvoiceCreate {
-	return _c.SetUserID(v.ID)
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *InvoiceCreate) SetLedger(v *Ledger) *InvoiceCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *InvoiceCreate) SetCreatedBy(v *User) *InvoiceCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// SetPaymentAccount sets the "payment_account" edge to the Account entity.
+func (_c *InvoiceCreate) SetPaymentAccount(v *Account) *InvoiceCreate {
+	return _c.SetPaymentAccountID(v.ID)
 }
 
 // Mutation returns the InvoiceMutation object of the builder.
@@ -174,14 +262,14 @@ func (_c *InvoiceCreate) defaults() {
 		v := invoice.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := _c.mutation.Status(); !ok {
-		v := invoice.DefaultStatus
-		_c.mutation.SetStatus(v)
-	}
 	if _, ok := _c.mutation.Amount(); !ok {
 		v := invoice.DefaultAmount
 		_c.mutation.SetAmount(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := invoice.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := invoice.DefaultID()
 		_c.mutation.SetID(v)
@@ -196,14 +284,6 @@ func (_c *InvoiceCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Invoice.updated_at"`)}
 	}
-	if _, ok := _c.mutation.Status(); !ok {
-		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
-	}
-	if v, ok := _c.mutation.Status(); ok {
-		if err := invoice.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Invoice.amount"`)}
 	}
@@ -218,8 +298,16 @@ func (_c *InvoiceCreate) check() error {
 	if _, ok := _c.mutation.DueDate(); !ok {
 		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "Invoice.due_date"`)}
 	}
-	if len(_c.mutation.UserIDs()) == 0 {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Invoice.user"`)}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Invoice.ledger"`)}
 	}
 	return nil
 }
@@ -252,6 +340,7 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_node = &Invoice{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -264,12 +353,8 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.Status(); ok {
-		_spec.SetField(invoice.FieldStatus, field.TypeString, value)
-		_node.Status = value
-	}
 	if value, ok := _c.mutation.Amount(); ok {
-		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(invoice.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
 	if value, ok := _c.mutation.Title(); ok {
@@ -280,6 +365,18 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
 		_node.DueDate = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(invoice.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = &value
+	}
 	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -296,12 +393,45 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   invoice.UserTable,
-			Columns: []string{invoice.UserColumn},
+			Table:   invoice.LedgerTable,
+			Columns: []string{invoice.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.CreatedByTable,
+			Columns: []string{invoice.CreatedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
@@ -310,17 +440,436 @@ func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_id = &nodes[0]
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaymentAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.PaymentAccountTable,
+			Columns: []string{invoice.PaymentAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PaymentAccountID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	_c.conflict = opts
+	return &InvoiceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceUpsert) SetUpdatedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateUpdatedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvoiceUpsert) SetAmount(v domain.Money) *InvoiceUpsert {
+	u.Set(invoice.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateAmount() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvoiceUpsert) AddAmount(v domain.Money) *InvoiceUpsert {
+	u.Add(invoice.FieldAmount, v)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *InvoiceUpsert) SetTitle(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTitle() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTitle)
+	return u
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *InvoiceUpsert) SetDueDate(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateDueDate() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldDueDate)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsert) SetStatus(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateStatus() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldStatus)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *InvoiceUpsert) SetClosedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateClosedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *InvoiceUpsert) ClearClosedAt() *InvoiceUpsert {
+	u.SetNull(invoice.FieldClosedAt)
+	return u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoiceUpsert) SetPaidAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldPaidAt, v)
+	return u
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePaidAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPaidAt)
+	return u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *InvoiceUpsert) ClearPaidAt() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPaidAt)
+	return u
+}
+
+// SetPaymentAccountID sets the "payment_account_id" field.
+func (u *InvoiceUpsert) SetPaymentAccountID(v uuid.UUID) *InvoiceUpsert {
+	u.Set(invoice.FieldPaymentAccountID, v)
+	return u
+}
+
+// UpdatePaymentAccountID sets the "payment_account_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePaymentAccountID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPaymentAccountID)
+	return u
+}
+
+// ClearPaymentAccountID clears the value of the "payment_account_id" field.
+func (u *InvoiceUpsert) ClearPaymentAccountID() *InvoiceUpsert {
+	u.SetNull(invoice.FieldPaymentAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoice.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoice.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedByID(); exists {
+			s.SetIgnore(invoice.FieldCreatedByID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceUpsertOne) SetUpdatedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateUpdatedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvoiceUpsertOne) SetAmount(v domain.Money) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvoiceUpsertOne) AddAmount(v domain.Money) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateAmount() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *InvoiceUpsertOne) SetTitle(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTitle() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *InvoiceUpsertOne) SetDueDate(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateDueDate() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsertOne) SetStatus(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateStatus() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *InvoiceUpsertOne) SetClosedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateClosedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *InvoiceUpsertOne) ClearClosedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoiceUpsertOne) SetPaidAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePaidAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *InvoiceUpsertOne) ClearPaidAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetPaymentAccountID sets the "payment_account_id" field.
+func (u *InvoiceUpsertOne) SetPaymentAccountID(v uuid.UUID) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaymentAccountID(v)
+	})
+}
+
+// UpdatePaymentAccountID sets the "payment_account_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePaymentAccountID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaymentAccountID()
+	})
+}
+
+// ClearPaymentAccountID clears the value of the "payment_account_id" field.
+func (u *InvoiceUpsertOne) ClearPaymentAccountID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPaymentAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvoiceUpsertOne.ID is not supported by MySQL driver. Use InvoiceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
 type InvoiceCreateBulk struct {
 	config
 	err      error
 	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Invoice entities in the database.
@@ -350,6 +899,7 @@ func (_c *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -399,3 +949,263 @@ func (_c *InvoiceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	_c.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoice.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoice.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedByID(); exists {
+				s.SetIgnore(invoice.FieldCreatedByID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoiceUpsertBulk) SetUpdatedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateUpdatedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvoiceUpsertBulk) SetAmount(v domain.Money) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvoiceUpsertBulk) AddAmount(v domain.Money) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateAmount() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *InvoiceUpsertBulk) SetTitle(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTitle() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *InvoiceUpsertBulk) SetDueDate(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateDueDate() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsertBulk) SetStatus(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateStatus() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *InvoiceUpsertBulk) SetClosedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateClosedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *InvoiceUpsertBulk) ClearClosedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoiceUpsertBulk) SetPaidAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePaidAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *InvoiceUpsertBulk) ClearPaidAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetPaymentAccountID sets the "payment_account_id" field.
+func (u *InvoiceUpsertBulk) SetPaymentAccountID(v uuid.UUID) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaymentAccountID(v)
+	})
+}
+
+// UpdatePaymentAccountID sets the "payment_account_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePaymentAccountID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaymentAccountID()
+	})
+}
+
+// ClearPaymentAccountID clears the value of the "payment_account_id" field.
+func (u *InvoiceUpsertBulk) ClearPaymentAccountID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearPaymentAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/predicate"
 	"frog-go/internal/ent/transaction"
 	"frog-go/internal/ent/user"
@@ -22,13 +25,16 @@ import (
 // InvoiceQuery is the builder for querying Invoice entities.
 type InvoiceQuery struct {
 	config
-	ctx              *QueryContext
-	order            []invoice.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Invoice
-	withTransactions *TransactionQuery
-	withUser         *UserQuery
-	withFKs          bool
+	ctx                *QueryContext
+	order              []invoice.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Invoice
+	withTransactions   *TransactionQuery
+	withPayments       *InvoicePaymentQuery
+	withLedger         *LedgerQuery
+	withCreatedBy      *UserQuery
+	withPaymentAccount *AccountQuery
+	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,8 +93,52 @@ func (_q *InvoiceQuery) QueryTransactions() *TransactionQuery {
 	return query
 }
 
-// QueryUser chains the current query on the "user" edge.
-func (_q *InvoiceQuery) QueryUser() *UserQuery {
+// QueryPayments chains the current query on the "payments" edge.
+func (_q *InvoiceQuery) QueryPayments() *InvoicePaymentQuery {
+	query := (&InvoicePaymentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(invoicepayment.Table, invoicepayment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, invoice.PaymentsTable, invoice.PaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLedger chains the current query on the "ledger" edge.
+func (_q *InvoiceQuery) QueryLedger() *LedgerQuery {
+	query := (&LedgerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(ledger.Table, ledger.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoice.LedgerTable, invoice.LedgerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedBy chains the current query on the "created_by" edge.
+func (_q *InvoiceQuery) QueryCreatedBy() *UserQuery {
 	query := (&UserClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
@@ -101,7 +151,29 @@ func (_q *InvoiceQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, invoice.UserTable, invoice.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoice.CreatedByTable, invoice.CreatedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaymentAccount chains the current query on the "payment_account" edge.
+func (_q *InvoiceQuery) QueryPaymentAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invoice.PaymentAccountTable, invoice.PaymentAccountColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -296,13 +368,16 @@ func (_q *InvoiceQuery) Clone() *InvoiceQuery {
 		return nil
 	}
 	return &InvoiceQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]invoice.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Invoice{}, _q.predicates...),
-		withTransactions: _q.withTransactions.Clone(),
-		withUser:         _q.withUser.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]invoice.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Invoice{}, _q.predicates...),
+		withTransactions:   _q.withTransactions.Clone(),
+		withPayments:       _q.withPayments.Clone(),
+		withLedger:         _q.withLedger.Clone(),
+		withCreatedBy:      _q.withCreatedBy.Clone(),
+		withPaymentAccount: _q.withPaymentAccount.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -320,14 +395,47 @@ func (_q *InvoiceQuery) WithTransactions(opts ...func(*TransactionQuery)) *Invoi
 	return _q
 }
 
-// WithUser tells the query-builder to eager-load the nodes that are connected to
-// the "user" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *InvoiceQuery) WithUser(opts ...func(*UserQuery)) *InvoiceQuery {
+// WithPayments tells the query-builder to eager-load the nodes that are connected to
+// the "payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithPayments(opts ...func(*InvoicePaymentQuery)) *InvoiceQuery {
+	query := (&InvoicePaymentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayments = query
+	return _q
+}
+
+// WithLedger tells the query-builder to eager-load the nodes that are connected to
+// the "ledger" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithLedger(opts ...func(*LedgerQuery)) *InvoiceQuery {
+	query := (&LedgerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLedger = query
+	return _q
+}
+
+// WithCreatedBy tells the query-builder to eager-load the nodes that are connected to
+// the "created_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithCreatedBy(opts ...func(*UserQuery)) *InvoiceQuery {
 	query := (&UserClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withUser = query
+	_q.withCreatedBy = query
+	return _q
+}
+
+// WithPaymentAccount tells the query-builder to eager-load the nodes that are connected to
+// the "payment_account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *InvoiceQuery) WithPaymentAccount(opts ...func(*AccountQuery)) *InvoiceQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaymentAccount = query
 	return _q
 }
 
@@ -410,12 +518,15 @@ func (_q *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 		nodes       = []*Invoice{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			_q.withTransactions != nil,
-			_q.withUser != nil,
+			_q.withPayments != nil,
+			_q.withLedger != nil,
+			_q.withCreatedBy != nil,
+			_q.withPaymentAccount != nil,
 		}
 	)
-	if _q.withUser != nil {
+	if _q.withLedger != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -446,9 +557,28 @@ func (_q *InvoiceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invo
 			return nil, err
 		}
 	}
-	if query := _q.withUser; query != nil {
-		if err := _q.loadUser(ctx, query, nodes, nil,
-			func(n *Invoice, e *User) { n.Edges.User = e }); err != nil {
+	if query := _q.withPayments; query != nil {
+		if err := _q.loadPayments(ctx, query, nodes,
+			func(n *Invoice) { n.Edges.Payments = []*InvoicePayment{} },
+			func(n *Invoice, e *InvoicePayment) { n.Edges.Payments = append(n.Edges.Payments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLedger; query != nil {
+		if err := _q.loadLedger(ctx, query, nodes, nil,
+			func(n *Invoice, e *Ledger) { n.Edges.Ledger = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCreatedBy; query != nil {
+		if err := _q.loadCreatedBy(ctx, query, nodes, nil,
+			func(n *Invoice, e *User) { n.Edges.CreatedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaymentAccount; query != nil {
+		if err := _q.loadPaymentAccount(ctx, query, nodes, nil,
+			func(n *Invoice, e *Account) { n.Edges.PaymentAccount = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -486,14 +616,77 @@ func (_q *InvoiceQuery) loadTransactions(ctx context.Context, query *Transaction
 	}
 	return nil
 }
-func (_q *InvoiceQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *User)) error {
+func (_q *InvoiceQuery) loadPayments(ctx context.Context, query *InvoicePaymentQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *InvoicePayment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Invoice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.InvoicePayment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(invoice.PaymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.invoice_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "invoice_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invoice_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *InvoiceQuery) loadLedger(ctx context.Context, query *LedgerQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *Ledger)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Invoice)
 	for i := range nodes {
-		if nodes[i].user_id == nil {
+		if nodes[i].ledger_id == nil {
 			continue
 		}
-		fk := *nodes[i].user_id
+		fk := *nodes[i].ledger_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ledger.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "ledger_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *InvoiceQuery) loadCreatedBy(ctx context.Context, query *UserQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Invoice)
+	for i := range nodes {
+		if nodes[i].CreatedByID == nil {
+			continue
+		}
+		fk := *nodes[i].CreatedByID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -510,7 +703,39 @@ func (_q *InvoiceQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "created_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *InvoiceQuery) loadPaymentAccount(ctx context.Context, query *AccountQuery, nodes []*Invoice, init func(*Invoice), assign func(*Invoice, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Invoice)
+	for i := range nodes {
+		if nodes[i].PaymentAccountID == nil {
+			continue
+		}
+		fk := *nodes[i].PaymentAccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payment_account_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -544,6 +769,12 @@ func (_q *InvoiceQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withCreatedBy != nil {
+			_spec.Node.AddColumnOnce(invoice.FieldCreatedByID)
+		}
+		if _q.withPaymentAccount != nil {
+			_spec.Node.AddColumnOnce(invoice.FieldPaymentAccountID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
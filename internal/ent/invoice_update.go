@@ -6,10 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/predicate"
 	"frog-go/internal/ent/transaction"
-	"frog-go/internal/ent/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -37,29 +40,15 @@ func (_u *InvoiceUpdate) SetUpdatedAt(v time.Time) *InvoiceUpdate {
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *InvoiceUpdate) SetStatus(v string) *InvoiceUpdate {
-	_u.mutation.SetStatus(v)
-	return _u
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *InvoiceUpdate) SetNillableStatus(v *string) *InvoiceUpdate {
-	if v != nil {
-		_u.SetStatus(*v)
-	}
-	return _u
-}
-
 // SetAmount sets the "amount" field.
-func (_u *InvoiceUpdate) SetAmount(v float64) *InvoiceUpdate {
+func (_u *InvoiceUpdate) SetAmount(v domain.Money) *InvoiceUpdate {
 	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *InvoiceUpdate) SetNillableAmount(v *float64) *InvoiceUpdate {
+func (_u *InvoiceUpdate) SetNillableAmount(v *domain.Money) *InvoiceUpdate {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
@@ -67,7 +56,7 @@ func (_u *InvoiceUpdate) SetNillableAmount(v *float64) *InvoiceUpdate {
 }
 
 // AddAmount adds value to the "amount" field.
-func (_u *InvoiceUpdate) AddAmount(v float64) *InvoiceUpdate {
+func (_u *InvoiceUpdate) AddAmount(v domain.Money) *InvoiceUpdate {
 	_u.mutation.AddAmount(v)
 	return _u
 }
@@ -100,6 +89,80 @@ func (_u *InvoiceUpdate) SetNillableDueDate(v *time.Time) *InvoiceUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *InvoiceUpdate) SetStatus(v string) *InvoiceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableStatus(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *InvoiceUpdate) SetClosedAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableClosedAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *InvoiceUpdate) ClearClosedAt() *InvoiceUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *InvoiceUpdate) SetPaidAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePaidAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *InvoiceUpdate) ClearPaidAt() *InvoiceUpdate {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetPaymentAccountID sets the "payment_account_id" field.
+func (_u *InvoiceUpdate) SetPaymentAccountID(v uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetPaymentAccountID(v)
+	return _u
+}
+
+// SetNillablePaymentAccountID sets the "payment_account_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePaymentAccountID(v *uuid.UUID) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPaymentAccountID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentAccountID clears the value of the "payment_account_id" field.
+func (_u *InvoiceUpdate) ClearPaymentAccountID() *InvoiceUpdate {
+	_u.mutation.ClearPaymentAccountID()
+	return _u
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (_u *InvoiceUpdate) AddTransactionIDs(ids ...uuid.UUID) *InvoiceUpdate {
 	_u.mutation.AddTransactionIDs(ids...)
@@ -115,15 +178,35 @@ func (_u *InvoiceUpdate) AddTransactions(v ...*Transaction) *InvoiceUpdate {
 	return _u.AddTransactionIDs(ids...)
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_u *InvoiceUpdate) SetUserID(id uuid.UUID) *InvoiceUpdate {
-	_u.mutation.SetUserID(id)
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by IDs.
+func (_u *InvoiceUpdate) AddPaymentIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	_u.mutation.AddPaymentIDs(ids...)
 	return _u
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_u *InvoiceUpdate) SetUser(v *User) *InvoiceUpdate {
-	return _u.SetUserID(v.ID)
+// AddPayments adds the "payments" edges to the InvoicePayment entity.
+func (_u *InvoiceUpdate) AddPayments(v ...*InvoicePayment) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvoiceUpdate) SetLedgerID(id uuid.UUID) *InvoiceUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvoiceUpdate) SetLedger(v *Ledger) *InvoiceUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetPaymentAccount sets the "payment_account" edge to the Account entity.
+func (_u *InvoiceUpdate) SetPaymentAccount(v *Account) *InvoiceUpdate {
+	return _u.SetPaymentAccountID(v.ID)
 }
 
 // Mutation returns the InvoiceMutation object of the builder.
@@ -152,9 +235,36 @@ func (_u *InvoiceUpdate) RemoveTransactions(v ...*Transaction) *InvoiceUpdate {
 	return _u.RemoveTransactionIDs(ids...)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (_u *InvoiceUpdate) ClearUser() *InvoiceUpdate {
-	_u.mutation.ClearUser()
+// ClearPayments clears all "payments" edges to the InvoicePayment entity.
+func (_u *InvoiceUpdate) ClearPayments() *InvoiceUpdate {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to InvoicePayment entities by IDs.
+func (_u *InvoiceUpdate) RemovePaymentIDs(ids ...uuid.UUID) *InvoiceUpdate {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to InvoicePayment entities.
+func (_u *InvoiceUpdate) RemovePayments(v ...*InvoicePayment) *InvoiceUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvoiceUpdate) ClearLedger() *InvoiceUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearPaymentAccount clears the "payment_account" edge to the Account entity.
+func (_u *InvoiceUpdate) ClearPaymentAccount() *InvoiceUpdate {
+	_u.mutation.ClearPaymentAccount()
 	return _u
 }
 
@@ -196,18 +306,18 @@ func (_u *InvoiceUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *InvoiceUpdate) check() error {
-	if v, ok := _u.mutation.Status(); ok {
-		if err := invoice.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Title(); ok {
 		if err := invoice.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Invoice.title": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Invoice.user"`)
+	if v, ok := _u.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.ledger"`)
 	}
 	return nil
 }
@@ -227,14 +337,11 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(invoice.FieldStatus, field.TypeString, value)
-	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(invoice.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedAmount(); ok {
-		_spec.AddField(invoice.FieldAmount, field.TypeFloat64, value)
+		_spec.AddField(invoice.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(invoice.FieldTitle, field.TypeString, value)
@@ -242,6 +349,21 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.DueDate(); ok {
 		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(invoice.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(invoice.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(invoice.FieldPaidAt, field.TypeTime)
+	}
 	if _u.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -287,28 +409,102 @@ func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UserCleared() {
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.LedgerTable,
+			Columns: []string{invoice.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.LedgerTable,
+			Columns: []string{invoice.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentAccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   invoice.UserTable,
-			Columns: []string{invoice.UserColumn},
+			Table:   invoice.PaymentAccountTable,
+			Columns: []string{invoice.PaymentAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PaymentAccountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   invoice.UserTable,
-			Columns: []string{invoice.UserColumn},
+			Table:   invoice.PaymentAccountTable,
+			Columns: []string{invoice.PaymentAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -342,29 +538,15 @@ func (_u *InvoiceUpdateOne) SetUpdatedAt(v time.Time) *InvoiceUpdateOne {
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *InvoiceUpdateOne) SetStatus(v string) *InvoiceUpdateOne {
-	_u.mutation.SetStatus(v)
-	return _u
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *InvoiceUpdateOne) SetNillableStatus(v *string) *InvoiceUpdateOne {
-	if v != nil {
-		_u.SetStatus(*v)
-	}
-	return _u
-}
-
 // SetAmount sets the "amount" field.
-func (_u *InvoiceUpdateOne) SetAmount(v float64) *InvoiceUpdateOne {
+func (_u *InvoiceUpdateOne) SetAmount(v domain.Money) *InvoiceUpdateOne {
 	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *InvoiceUpdateOne) SetNillableAmount(v *float64) *InvoiceUpdateOne {
+func (_u *InvoiceUpdateOne) SetNillableAmount(v *domain.Money) *InvoiceUpdateOne {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
@@ -372,7 +554,7 @@ func (_u *InvoiceUpdateOne) SetNillableAmount(v *float64) *InvoiceUpdateOne {
 }
 
 // AddAmount adds value to the "amount" field.
-func (_u *InvoiceUpdateOne) AddAmount(v float64) *InvoiceUpdateOne {
+func (_u *InvoiceUpdateOne) AddAmount(v domain.Money) *InvoiceUpdateOne {
 	_u.mutation.AddAmount(v)
 	return _u
 }
@@ -405,6 +587,80 @@ func (_u *InvoiceUpdateOne) SetNillableDueDate(v *time.Time) *InvoiceUpdateOne {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *InvoiceUpdateOne) SetStatus(v string) *InvoiceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableStatus(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *InvoiceUpdateOne) SetClosedAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableClosedAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *InvoiceUpdateOne) ClearClosedAt() *InvoiceUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *InvoiceUpdateOne) SetPaidAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePaidAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *InvoiceUpdateOne) ClearPaidAt() *InvoiceUpdateOne {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetPaymentAccountID sets the "payment_account_id" field.
+func (_u *InvoiceUpdateOne) SetPaymentAccountID(v uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetPaymentAccountID(v)
+	return _u
+}
+
+// SetNillablePaymentAccountID sets the "payment_account_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePaymentAccountID(v *uuid.UUID) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPaymentAccountID(*v)
+	}
+	return _u
+}
+
+// ClearPaymentAccountID clears the value of the "payment_account_id" field.
+func (_u *InvoiceUpdateOne) ClearPaymentAccountID() *InvoiceUpdateOne {
+	_u.mutation.ClearPaymentAccountID()
+	return _u
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (_u *InvoiceUpdateOne) AddTransactionIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
 	_u.mutation.AddTransactionIDs(ids...)
@@ -420,15 +676,35 @@ func (_u *InvoiceUpdateOne) AddTransactions(v ...*Transaction) *InvoiceUpdateOne
 	return _u.AddTransactionIDs(ids...)
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_u *InvoiceUpdateOne) SetUserID(id uuid.UUID) *InvoiceUpdateOne {
-	_u.mutation.SetUserID(id)
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by IDs.
+func (_u *InvoiceUpdateOne) AddPaymentIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.AddPaymentIDs(ids...)
 	return _u
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_u *InvoiceUpdateOne) SetUser(v *User) *InvoiceUpdateOne {
-	return _u.SetUserID(v.ID)
+// AddPayments adds the "payments" edges to the InvoicePayment entity.
+func (_u *InvoiceUpdateOne) AddPayments(v ...*InvoicePayment) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaymentIDs(ids...)
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *InvoiceUpdateOne) SetLedgerID(id uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *InvoiceUpdateOne) SetLedger(v *Ledger) *InvoiceUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetPaymentAccount sets the "payment_account" edge to the Account entity.
+func (_u *InvoiceUpdateOne) SetPaymentAccount(v *Account) *InvoiceUpdateOne {
+	return _u.SetPaymentAccountID(v.ID)
 }
 
 // Mutation returns the InvoiceMutation object of the builder.
@@ -457,9 +733,36 @@ func (_u *InvoiceUpdateOne) RemoveTransactions(v ...*Transaction) *InvoiceUpdate
 	return _u.RemoveTransactionIDs(ids...)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (_u *InvoiceUpdateOne) ClearUser() *InvoiceUpdateOne {
-	_u.mutation.ClearUser()
+// ClearPayments clears all "payments" edges to the InvoicePayment entity.
+func (_u *InvoiceUpdateOne) ClearPayments() *InvoiceUpdateOne {
+	_u.mutation.ClearPayments()
+	return _u
+}
+
+// RemovePaymentIDs removes the "payments" edge to InvoicePayment entities by IDs.
+func (_u *InvoiceUpdateOne) RemovePaymentIDs(ids ...uuid.UUID) *InvoiceUpdateOne {
+	_u.mutation.RemovePaymentIDs(ids...)
+	return _u
+}
+
+// RemovePayments removes "payments" edges to InvoicePayment entities.
+func (_u *InvoiceUpdateOne) RemovePayments(v ...*InvoicePayment) *InvoiceUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaymentIDs(ids...)
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *InvoiceUpdateOne) ClearLedger() *InvoiceUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearPaymentAccount clears the "payment_account" edge to the Account entity.
+func (_u *InvoiceUpdateOne) ClearPaymentAccount() *InvoiceUpdateOne {
+	_u.mutation.ClearPaymentAccount()
 	return _u
 }
 
@@ -514,18 +817,18 @@ func (_u *InvoiceUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *InvoiceUpdateOne) check() error {
-	if v, ok := _u.mutation.Status(); ok {
-		if err := invoice.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Title(); ok {
 		if err := invoice.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Invoice.title": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Invoice.user"`)
+	if v, ok := _u.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.ledger"`)
 	}
 	return nil
 }
@@ -562,14 +865,11 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(invoice.FieldStatus, field.TypeString, value)
-	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(invoice.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedAmount(); ok {
-		_spec.AddField(invoice.FieldAmount, field.TypeFloat64, value)
+		_spec.AddField(invoice.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(invoice.FieldTitle, field.TypeString, value)
@@ -577,6 +877,21 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 	if value, ok := _u.mutation.DueDate(); ok {
 		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(invoice.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(invoice.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(invoice.FieldPaidAt, field.TypeTime)
+	}
 	if _u.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -622,28 +937,102 @@ func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UserCleared() {
+	if _u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !_u.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.LedgerTable,
+			Columns: []string{invoice.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoice.LedgerTable,
+			Columns: []string{invoice.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaymentAccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   invoice.UserTable,
-			Columns: []string{invoice.UserColumn},
+			Table:   invoice.PaymentAccountTable,
+			Columns: []string{invoice.PaymentAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PaymentAccountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   invoice.UserTable,
-			Columns: []string{invoice.UserColumn},
+			Table:   invoice.PaymentAccountTable,
+			Columns: []string{invoice.PaymentAccountColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,878 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvoicePaymentCreate is the builder for creating a InvoicePayment entity.
+type InvoicePaymentCreate struct {
+	config
+	mutation *InvoicePaymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoicePaymentCreate) SetCreatedAt(v time.Time) *InvoicePaymentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableCreatedAt(v *time.Time) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvoicePaymentCreate) SetUpdatedAt(v time.Time) *InvoicePaymentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableUpdatedAt(v *time.Time) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *InvoicePaymentCreate) SetAmount(v domain.Money) *InvoicePaymentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_c *InvoicePaymentCreate) SetPaidAt(v time.Time) *InvoicePaymentCreate {
+	_c.mutation.SetPaidAt(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *InvoicePaymentCreate) SetKind(v string) *InvoicePaymentCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableKind(v *string) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *InvoicePaymentCreate) SetAccountID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableAccountID(v *uuid.UUID) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoicePaymentCreate) SetID(v uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *InvoicePaymentCreate) SetNillableID(v *uuid.UUID) *InvoicePaymentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_c *InvoicePaymentCreate) SetInvoiceID(id uuid.UUID) *InvoicePaymentCreate {
+	_c.mutation.SetInvoiceID(id)
+	return _c
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *InvoicePaymentCreate) SetInvoice(v *Invoice) *InvoicePaymentCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *InvoicePaymentCreate) SetAccount(v *Account) *InvoicePaymentCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (_c *InvoicePaymentCreate) Mutation() *InvoicePaymentMutation {
+	return _c.mutation
+}
+
+// Save creates the InvoicePayment in the database.
+func (_c *InvoicePaymentCreate) Save(ctx context.Context) (*InvoicePayment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoicePaymentCreate) SaveX(ctx context.Context) *InvoicePayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoicePaymentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoicePaymentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoicePaymentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoicepayment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := invoicepayment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := invoicepayment.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := invoicepayment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoicePaymentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvoicePayment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvoicePayment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "InvoicePayment.amount"`)}
+	}
+	if _, ok := _c.mutation.PaidAt(); !ok {
+		return &ValidationError{Name: "paid_at", err: errors.New(`ent: missing required field "InvoicePayment.paid_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "InvoicePayment.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := invoicepayment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvoicePayment.kind": %w`, err)}
+		}
+	}
+	if len(_c.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoicePayment.invoice"`)}
+	}
+	return nil
+}
+
+func (_c *InvoicePaymentCreate) sqlSave(ctx context.Context) (*InvoicePayment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoicePaymentCreate) createSpec() (*InvoicePayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoicePayment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoicepayment.Table, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoicepayment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicepayment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(invoicepayment.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.PaidAt(); ok {
+		_spec.SetField(invoicepayment.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(invoicepayment.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invoice_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.AccountTable,
+			Columns: []string{invoicepayment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoicePayment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoicePaymentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreate) OnConflict(opts ...sql.ConflictOption) *InvoicePaymentUpsertOne {
+	_c.conflict = opts
+	return &InvoicePaymentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreate) OnConflictColumns(columns ...string) *InvoicePaymentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoicePaymentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoicePaymentUpsertOne is the builder for "upsert"-ing
+	//  one InvoicePayment node.
+	InvoicePaymentUpsertOne struct {
+		create *InvoicePaymentCreate
+	}
+
+	// InvoicePaymentUpsert is the "OnConflict" setter.
+	InvoicePaymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoicePaymentUpsert) SetUpdatedAt(v time.Time) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateUpdatedAt() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvoicePaymentUpsert) SetAmount(v domain.Money) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateAmount() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvoicePaymentUpsert) AddAmount(v domain.Money) *InvoicePaymentUpsert {
+	u.Add(invoicepayment.FieldAmount, v)
+	return u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoicePaymentUpsert) SetPaidAt(v time.Time) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldPaidAt, v)
+	return u
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdatePaidAt() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldPaidAt)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoicePaymentUpsert) SetKind(v string) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateKind() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldKind)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *InvoicePaymentUpsert) SetAccountID(v uuid.UUID) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateAccountID() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *InvoicePaymentUpsert) ClearAccountID() *InvoicePaymentUpsert {
+	u.SetNull(invoicepayment.FieldAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertOne) UpdateNewValues() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoicepayment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoicepayment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoicePaymentUpsertOne) Ignore() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoicePaymentUpsertOne) DoNothing() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoicePaymentCreate.OnConflict
+// documentation for more info.
+func (u *InvoicePaymentUpsertOne) Update(set func(*InvoicePaymentUpsert)) *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoicePaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoicePaymentUpsertOne) SetUpdatedAt(v time.Time) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateUpdatedAt() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvoicePaymentUpsertOne) SetAmount(v domain.Money) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvoicePaymentUpsertOne) AddAmount(v domain.Money) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateAmount() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoicePaymentUpsertOne) SetPaidAt(v time.Time) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdatePaidAt() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoicePaymentUpsertOne) SetKind(v string) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateKind() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *InvoicePaymentUpsertOne) SetAccountID(v uuid.UUID) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateAccountID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *InvoicePaymentUpsertOne) ClearAccountID() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoicePaymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoicePaymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoicePaymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoicePaymentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvoicePaymentUpsertOne.ID is not supported by MySQL driver. Use InvoicePaymentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoicePaymentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoicePaymentCreateBulk is the builder for creating many InvoicePayment entities in bulk.
+type InvoicePaymentCreateBulk struct {
+	config
+	err      error
+	builders []*InvoicePaymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvoicePayment entities in the database.
+func (_c *InvoicePaymentCreateBulk) Save(ctx context.Context) ([]*InvoicePayment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InvoicePayment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoicePaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoicePaymentCreateBulk) SaveX(ctx context.Context) []*InvoicePayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoicePaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoicePaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoicePayment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoicePaymentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoicePaymentUpsertBulk {
+	_c.conflict = opts
+	return &InvoicePaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoicePaymentCreateBulk) OnConflictColumns(columns ...string) *InvoicePaymentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoicePaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoicePaymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvoicePayment nodes.
+type InvoicePaymentUpsertBulk struct {
+	create *InvoicePaymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertBulk) UpdateNewValues() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoicepayment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoicepayment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertBulk) Ignore() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoicePaymentUpsertBulk) DoNothing() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoicePaymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoicePaymentUpsertBulk) Update(set func(*InvoicePaymentUpsert)) *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoicePaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvoicePaymentUpsertBulk) SetUpdatedAt(v time.Time) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateUpdatedAt() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *InvoicePaymentUpsertBulk) SetAmount(v domain.Money) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *InvoicePaymentUpsertBulk) AddAmount(v domain.Money) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateAmount() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *InvoicePaymentUpsertBulk) SetPaidAt(v time.Time) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdatePaidAt() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoicePaymentUpsertBulk) SetKind(v string) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateKind() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *InvoicePaymentUpsertBulk) SetAccountID(v uuid.UUID) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateAccountID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *InvoicePaymentUpsertBulk) ClearAccountID() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoicePaymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoicePaymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoicePaymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoicePaymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,582 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InvoicePaymentUpdate is the builder for updating InvoicePayment entities.
+type InvoicePaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoicePaymentMutation
+}
+
+// Where appends a list predicates to the InvoicePaymentUpdate builder.
+func (_u *InvoicePaymentUpdate) Where(ps ...predicate.InvoicePayment) *InvoicePaymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvoicePaymentUpdate) SetUpdatedAt(v time.Time) *InvoicePaymentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *InvoicePaymentUpdate) SetAmount(v domain.Money) *InvoicePaymentUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableAmount(v *domain.Money) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *InvoicePaymentUpdate) AddAmount(v domain.Money) *InvoicePaymentUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *InvoicePaymentUpdate) SetPaidAt(v time.Time) *InvoicePaymentUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillablePaidAt(v *time.Time) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoicePaymentUpdate) SetKind(v string) *InvoicePaymentUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableKind(v *string) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *InvoicePaymentUpdate) SetAccountID(v uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdate) SetNillableAccountID(v *uuid.UUID) *InvoicePaymentUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *InvoicePaymentUpdate) ClearAccountID() *InvoicePaymentUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *InvoicePaymentUpdate) SetInvoiceID(id uuid.UUID) *InvoicePaymentUpdate {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdate) SetInvoice(v *Invoice) *InvoicePaymentUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *InvoicePaymentUpdate) SetAccount(v *Account) *InvoicePaymentUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (_u *InvoicePaymentUpdate) Mutation() *InvoicePaymentMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdate) ClearInvoice() *InvoicePaymentUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *InvoicePaymentUpdate) ClearAccount() *InvoicePaymentUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoicePaymentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoicePaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoicePaymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoicePaymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvoicePaymentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := invoicepayment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoicePaymentUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoicepayment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvoicePayment.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.invoice"`)
+	}
+	return nil
+}
+
+func (_u *InvoicePaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicepayment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(invoicepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(invoicepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(invoicepayment.FieldPaidAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoicepayment.FieldKind, field.TypeString, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.AccountTable,
+			Columns: []string{invoicepayment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.AccountTable,
+			Columns: []string{invoicepayment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoicePaymentUpdateOne is the builder for updating a single InvoicePayment entity.
+type InvoicePaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoicePaymentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvoicePaymentUpdateOne) SetUpdatedAt(v time.Time) *InvoicePaymentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *InvoicePaymentUpdateOne) SetAmount(v domain.Money) *InvoicePaymentUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableAmount(v *domain.Money) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *InvoicePaymentUpdateOne) AddAmount(v domain.Money) *InvoicePaymentUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *InvoicePaymentUpdateOne) SetPaidAt(v time.Time) *InvoicePaymentUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillablePaidAt(v *time.Time) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoicePaymentUpdateOne) SetKind(v string) *InvoicePaymentUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableKind(v *string) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *InvoicePaymentUpdateOne) SetAccountID(v uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *InvoicePaymentUpdateOne) SetNillableAccountID(v *uuid.UUID) *InvoicePaymentUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *InvoicePaymentUpdateOne) ClearAccountID() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *InvoicePaymentUpdateOne) SetInvoiceID(id uuid.UUID) *InvoicePaymentUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdateOne) SetInvoice(v *Invoice) *InvoicePaymentUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *InvoicePaymentUpdateOne) SetAccount(v *Account) *InvoicePaymentUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (_u *InvoicePaymentUpdateOne) Mutation() *InvoicePaymentMutation {
+	return _u.mutation
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *InvoicePaymentUpdateOne) ClearInvoice() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *InvoicePaymentUpdateOne) ClearAccount() *InvoicePaymentUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the InvoicePaymentUpdate builder.
+func (_u *InvoicePaymentUpdateOne) Where(ps ...predicate.InvoicePayment) *InvoicePaymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoicePaymentUpdateOne) Select(field string, fields ...string) *InvoicePaymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InvoicePayment entity.
+func (_u *InvoicePaymentUpdateOne) Save(ctx context.Context) (*InvoicePayment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoicePaymentUpdateOne) SaveX(ctx context.Context) *InvoicePayment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoicePaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoicePaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InvoicePaymentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := invoicepayment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoicePaymentUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoicepayment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvoicePayment.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.InvoiceCleared() && len(_u.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.invoice"`)
+	}
+	return nil
+}
+
+func (_u *InvoicePaymentUpdateOne) sqlSave(ctx context.Context) (_node *InvoicePayment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoicePayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicepayment.FieldID)
+		for _, f := range fields {
+			if !invoicepayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicepayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoicepayment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(invoicepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(invoicepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(invoicepayment.FieldPaidAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoicepayment.FieldKind, field.TypeString, value)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.AccountTable,
+			Columns: []string{invoicepayment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   invoicepayment.AccountTable,
+			Columns: []string{invoicepayment.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InvoicePayment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Ledger is the model entity for the Ledger schema.
+type Ledger struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Personal holds the value of the "personal" field.
+	Personal bool `json:"personal,omitempty"`
+	// CategoryModelVersion holds the value of the "category_model_version" field.
+	CategoryModelVersion int64 `json:"category_model_version,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerQuery when eager-loading is set.
+	Edges        LedgerEdges `json:"edges"`
+	owner_id     *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// LedgerEdges holds the relations/edges for other nodes in the graph.
+type LedgerEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*LedgerMember `json:"members,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*LedgerInvitation `json:"invitations,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// Accounts holds the value of the accounts edge.
+	Accounts []*Account `json:"accounts,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEdges) MembersOrErr() ([]*LedgerMember, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEdges) InvitationsOrErr() ([]*LedgerInvitation, error) {
+	if e.loadedTypes[2] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[3] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[4] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// AccountsOrErr returns the Accounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEdges) AccountsOrErr() ([]*Account, error) {
+	if e.loadedTypes[5] {
+		return e.Accounts, nil
+	}
+	return nil, &NotLoadedError{edge: "accounts"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e LedgerEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[6] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Ledger) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledger.FieldPersonal:
+			values[i] = new(sql.NullBool)
+		case ledger.FieldCategoryModelVersion:
+			values[i] = new(sql.NullInt64)
+		case ledger.FieldName:
+			values[i] = new(sql.NullString)
+		case ledger.FieldCreatedAt, ledger.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case ledger.FieldID:
+			values[i] = new(uuid.UUID)
+		case ledger.ForeignKeys[0]: // owner_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Ledger fields.
+func (_m *Ledger) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledger.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ledger.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ledger.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ledger.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ledger.FieldPersonal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field personal", values[i])
+			} else if value.Valid {
+				_m.Personal = value.Bool
+			}
+		case ledger.FieldCategoryModelVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_model_version", values[i])
+			} else if value.Valid {
+				_m.CategoryModelVersion = value.Int64
+			}
+		case ledger.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.owner_id = new(uuid.UUID)
+				*_m.owner_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Ledger.
+// This includes values selected through modifiers, order, etc.
+func (_m *Ledger) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Ledger entity.
+func (_m *Ledger) QueryOwner() *UserQuery {
+	return NewLedgerClient(_m.config).QueryOwner(_m)
+}
+
+// QueryMembers queries the "members" edge of the Ledger entity.
+func (_m *Ledger) QueryMembers() *LedgerMemberQuery {
+	return NewLedgerClient(_m.config).QueryMembers(_m)
+}
+
+// QueryInvitations queries the "invitations" edge of the Ledger entity.
+func (_m *Ledger) QueryInvitations() *LedgerInvitationQuery {
+	return NewLedgerClient(_m.config).QueryInvitations(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the Ledger entity.
+func (_m *Ledger) QueryTransactions() *TransactionQuery {
+	return NewLedgerClient(_m.config).QueryTransactions(_m)
+}
+
+// QueryInvoices queries the "invoices" edge of the Ledger entity.
+func (_m *Ledger) QueryInvoices() *InvoiceQuery {
+	return NewLedgerClient(_m.config).QueryInvoices(_m)
+}
+
+// QueryAccounts queries the "accounts" edge of the Ledger entity.
+func (_m *Ledger) QueryAccounts() *AccountQuery {
+	return NewLedgerClient(_m.config).QueryAccounts(_m)
+}
+
+// QueryCategories queries the "categories" edge of the Ledger entity.
+func (_m *Ledger) QueryCategories() *CategoryQuery {
+	return NewLedgerClient(_m.config).QueryCategories(_m)
+}
+
+// Update returns a builder for updating this Ledger.
+// Note that you need to call Ledger.Unwrap() before calling this method if this Ledger
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Ledger) Update() *LedgerUpdateOne {
+	return NewLedgerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Ledger entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Ledger) Unwrap() *Ledger {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Ledger is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Ledger) String() string {
+	var builder strings.Builder
+	builder.WriteString("Ledger(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("personal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Personal))
+	builder.WriteString(", ")
+	builder.WriteString("category_model_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryModelVersion))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Ledgers is a parsable slice of Ledger.
+type Ledgers []*Ledger
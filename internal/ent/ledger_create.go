@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/ledgermember"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LedgerCreate is the builder for creating a Ledger entity.
+type LedgerCreate struct {
+	config
+	mutation *LedgerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LedgerCreate) SetCreatedAt(v time.Time) *LedgerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LedgerCreate) SetNillableCreatedAt(v *time.Time) *LedgerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LedgerCreate) SetUpdatedAt(v time.Time) *LedgerCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LedgerCreate) SetNillableUpdatedAt(v *time.Time) *LedgerCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LedgerCreate) SetName(v string) *LedgerCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPersonal sets the "personal" field.
+func (_c *LedgerCreate) SetPersonal(v bool) *LedgerCreate {
+	_c.mutation.SetPersonal(v)
+	return _c
+}
+
+// SetNillablePersonal sets the "personal" field if the given value is not nil.
+func (_c *LedgerCreate) SetNillablePersonal(v *bool) *LedgerCreate {
+	if v != nil {
+		_c.SetPersonal(*v)
+	}
+	return _c
+}
+
+// SetCategoryModelVersion sets the "category_model_version" field.
+func (_c *LedgerCreate) SetCategoryModelVersion(v int64) *LedgerCreate {
+	_c.mutation.SetCategoryModelVersion(v)
+	return _c
+}
+
+// SetNillableCategoryModelVersion sets the "category_model_version" field if the given value is not nil.
+func (_c *LedgerCreate) SetNillableCategoryModelVersion(v *int64) *LedgerCreate {
+	if v != nil {
+		_c.SetCategoryModelVersion(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LedgerCreate) SetID(v uuid.UUID) *LedgerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LedgerCreate) SetNillableID(v *uuid.UUID) *LedgerCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *LedgerCreate) SetOwnerID(id uuid.UUID) *LedgerCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *LedgerCreate) SetOwner(v *User) *LedgerCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the LedgerMember entity by IDs.
+func (_c *LedgerCreate) AddMemberIDs(ids ...uuid.UUID) *LedgerCreate {
+	_c.mutation.AddMemberIDs(ids...)
+	return _c
+}
+
+// AddMembers adds the "members" edges to the LedgerMember entity.
+func (_c *LedgerCreate) AddMembers(v ...*LedgerMember) *LedgerCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the LedgerInvitation entity by IDs.
+func (_c *LedgerCreate) AddInvitationIDs(ids ...uuid.UUID) *LedgerCreate {
+	_c.mutation.AddInvitationIDs(ids...)
+	return _c
+}
+
+// AddInvitations adds the "invitations" edges to the LedgerInvitation entity.
+func (_c *LedgerCreate) AddInvitations(v ...*LedgerInvitation) *LedgerCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvitationIDs(ids...)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *LedgerCreate) AddTransactionIDs(ids ...uuid.UUID) *LedgerCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *LedgerCreate) AddTransactions(v ...*Transaction) *LedgerCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_c *LedgerCreate) AddInvoiceIDs(ids ...uuid.UUID) *LedgerCreate {
+	_c.mutation.AddInvoiceIDs(ids...)
+	return _c
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_c *LedgerCreate) AddInvoices(v ...*Invoice) *LedgerCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceIDs(ids...)
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
+func (_c *LedgerCreate) AddAccountIDs(ids ...uuid.UUID) *LedgerCreate {
+	_c.mutation.AddAccountIDs(ids...)
+	return _c
+}
+
+// AddAccounts adds the "accounts" edges to the Account entity.
+func (_c *LedgerCreate) AddAccounts(v ...*Account) *LedgerCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAccountIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_c *LedgerCreate) AddCategoryIDs(ids ...uuid.UUID) *LedgerCreate {
+	_c.mutation.AddCategoryIDs(ids...)
+	return _c
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_c *LedgerCreate) AddCategories(v ...*Category) *LedgerCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCategoryIDs(ids...)
+}
+
+// Mutation returns the LedgerMutation object of the builder.
+func (_c *LedgerCreate) Mutation() *LedgerMutation {
+	return _c.mutation
+}
+
+// Save creates the Ledger in the database.
+func (_c *LedgerCreate) Save(ctx context.Context) (*Ledger, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LedgerCreate) SaveX(ctx context.Context) *Ledger {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LedgerCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ledger.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ledger.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Personal(); !ok {
+		v := ledger.DefaultPersonal
+		_c.mutation.SetPersonal(v)
+	}
+	if _, ok := _c.mutation.CategoryModelVersion(); !ok {
+		v := ledger.DefaultCategoryModelVersion
+		_c.mutation.SetCategoryModelVersion(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ledger.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LedgerCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Ledger.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Ledger.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Ledger.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ledger.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ledger.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Personal(); !ok {
+		return &ValidationError{Name: "personal", err: errors.New(`ent: missing required field "Ledger.personal"`)}
+	}
+	if _, ok := _c.mutation.CategoryModelVersion(); !ok {
+		return &ValidationError{Name: "category_model_version", err: errors.New(`ent: missing required field "Ledger.category_model_version"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Ledger.owner"`)}
+	}
+	return nil
+}
+
+func (_c *LedgerCreate) sqlSave(ctx context.Context) (*Ledger, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LedgerCreate) createSpec() (*Ledger, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Ledger{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ledger.Table, sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ledger.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledger.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ledger.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Personal(); ok {
+		_spec.SetField(ledger.FieldPersonal, field.TypeBool, value)
+		_node.Personal = value
+	}
+	if value, ok := _c.mutation.CategoryModelVersion(); ok {
+		_spec.SetField(ledger.FieldCategoryModelVersion, field.TypeInt64, value)
+		_node.CategoryModelVersion = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledger.OwnerTable,
+			Columns: []string{ledger.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.owner_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ledger.MembersTable,
+			Columns: []string{ledger.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgermember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ledger.InvitationsTable,
+			Columns: []string{ledger.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ledger.TransactionsTable,
+			Columns: []string{ledger.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ledger.InvoicesTable,
+			Columns: []string{ledger.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ledger.AccountsTable,
+			Columns: []string{ledger.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ledger.CategoriesTable,
+			Columns: []string{ledger.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Ledger.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LedgerCreate) OnConflict(opts ...sql.ConflictOption) *LedgerUpsertOne {
+	_c.conflict = opts
+	return &LedgerUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Ledger.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LedgerCreate) OnConflictColumns(columns ...string) *LedgerUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LedgerUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LedgerUpsertOne is the builder for "upsert"-ing
+	//  one Ledger node.
+	LedgerUpsertOne struct {
+		create *LedgerCreate
+	}
+
+	// LedgerUpsert is the "OnConflict" setter.
+	LedgerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerUpsert) SetUpdatedAt(v time.Time) *LedgerUpsert {
+	u.Set(ledger.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateUpdatedAt() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LedgerUpsert) SetName(v string) *LedgerUpsert {
+	u.Set(ledger.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateName() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldName)
+	return u
+}
+
+// SetCategoryModelVersion sets the "category_model_version" field.
+func (u *LedgerUpsert) SetCategoryModelVersion(v int64) *LedgerUpsert {
+	u.Set(ledger.FieldCategoryModelVersion, v)
+	return u
+}
+
+// UpdateCategoryModelVersion sets the "category_model_version" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateCategoryModelVersion() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldCategoryModelVersion)
+	return u
+}
+
+// AddCategoryModelVersion adds v to the "category_model_version" field.
+func (u *LedgerUpsert) AddCategoryModelVersion(v int64) *LedgerUpsert {
+	u.Add(ledger.FieldCategoryModelVersion, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Ledger.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledger.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerUpsertOne) UpdateNewValues() *LedgerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledger.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledger.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Personal(); exists {
+			s.SetIgnore(ledger.FieldPersonal)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Ledger.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerUpsertOne) Ignore() *LedgerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerUpsertOne) DoNothing() *LedgerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerCreate.OnConflict
+// documentation for more info.
+func (u *LedgerUpsertOne) Update(set func(*LedgerUpsert)) *LedgerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerUpsertOne) SetUpdatedAt(v time.Time) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateUpdatedAt() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LedgerUpsertOne) SetName(v string) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateName() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategoryModelVersion sets the "category_model_version" field.
+func (u *LedgerUpsertOne) SetCategoryModelVersion(v int64) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetCategoryModelVersion(v)
+	})
+}
+
+// AddCategoryModelVersion adds v to the "category_model_version" field.
+func (u *LedgerUpsertOne) AddCategoryModelVersion(v int64) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.AddCategoryModelVersion(v)
+	})
+}
+
+// UpdateCategoryModelVersion sets the "category_model_version" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateCategoryModelVersion() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateCategoryModelVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LedgerUpsertOne.ID is not supported by MySQL driver. Use LedgerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerCreateBulk is the builder for creating many Ledger entities in bulk.
+type LedgerCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Ledger entities in the database.
+func (_c *LedgerCreateBulk) Save(ctx context.Context) ([]*Ledger, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Ledger, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LedgerCreateBulk) SaveX(ctx context.Context) []*Ledger {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Ledger.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LedgerCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerUpsertBulk {
+	_c.conflict = opts
+	return &LedgerUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Ledger.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LedgerCreateBulk) OnConflictColumns(columns ...string) *LedgerUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LedgerUpsertBulk{
+		create: _c,
+	}
+}
+
+// LedgerUpsertBulk is the builder for "upsert"-ing
+// a bulk of Ledger nodes.
+type LedgerUpsertBulk struct {
+	create *LedgerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Ledger.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledger.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerUpsertBulk) UpdateNewValues() *LedgerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledger.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledger.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Personal(); exists {
+				s.SetIgnore(ledger.FieldPersonal)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Ledger.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerUpsertBulk) Ignore() *LedgerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerUpsertBulk) DoNothing() *LedgerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerUpsertBulk) Update(set func(*LedgerUpsert)) *LedgerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerUpsertBulk) SetUpdatedAt(v time.Time) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateUpdatedAt() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LedgerUpsertBulk) SetName(v string) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateName() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategoryModelVersion sets the "category_model_version" field.
+func (u *LedgerUpsertBulk) SetCategoryModelVersion(v int64) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetCategoryModelVersion(v)
+	})
+}
+
+// AddCategoryModelVersion adds v to the "category_model_version" field.
+func (u *LedgerUpsertBulk) AddCategoryModelVersion(v int64) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.AddCategoryModelVersion(v)
+	})
+}
+
+// UpdateCategoryModelVersion sets the "category_model_version" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateCategoryModelVersion() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateCategoryModelVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
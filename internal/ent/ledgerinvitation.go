@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LedgerInvitation is the model entity for the LedgerInvitation schema.
+type LedgerInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// LedgerID holds the value of the "ledger_id" field.
+	LedgerID uuid.UUID `json:"ledger_id,omitempty"`
+	// InviteeID holds the value of the "invitee_id" field.
+	InviteeID uuid.UUID `json:"invitee_id,omitempty"`
+	// InvitedByID holds the value of the "invited_by_id" field.
+	InvitedByID uuid.UUID `json:"invited_by_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerInvitationQuery when eager-loading is set.
+	Edges        LedgerInvitationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LedgerInvitationEdges holds the relations/edges for other nodes in the graph.
+type LedgerInvitationEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Invitee holds the value of the invitee edge.
+	Invitee *User `json:"invitee,omitempty"`
+	// InvitedBy holds the value of the invited_by edge.
+	InvitedBy *User `json:"invited_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerInvitationEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// InviteeOrErr returns the Invitee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerInvitationEdges) InviteeOrErr() (*User, error) {
+	if e.Invitee != nil {
+		return e.Invitee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "invitee"}
+}
+
+// InvitedByOrErr returns the InvitedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerInvitationEdges) InvitedByOrErr() (*User, error) {
+	if e.InvitedBy != nil {
+		return e.InvitedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "invited_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerinvitation.FieldRole, ledgerinvitation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case ledgerinvitation.FieldCreatedAt, ledgerinvitation.FieldUpdatedAt, ledgerinvitation.FieldExpiresAt, ledgerinvitation.FieldRespondedAt:
+			values[i] = new(sql.NullTime)
+		case ledgerinvitation.FieldID, ledgerinvitation.FieldLedgerID, ledgerinvitation.FieldInviteeID, ledgerinvitation.FieldInvitedByID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerInvitation fields.
+func (_m *LedgerInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerinvitation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ledgerinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ledgerinvitation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ledgerinvitation.FieldLedgerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value != nil {
+				_m.LedgerID = *value
+			}
+		case ledgerinvitation.FieldInviteeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invitee_id", values[i])
+			} else if value != nil {
+				_m.InviteeID = *value
+			}
+		case ledgerinvitation.FieldInvitedByID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by_id", values[i])
+			} else if value != nil {
+				_m.InvitedByID = *value
+			}
+		case ledgerinvitation.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case ledgerinvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case ledgerinvitation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case ledgerinvitation.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerInvitation.
+// This includes values selected through modifiers, order, etc.
+func (_m *LedgerInvitation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the LedgerInvitation entity.
+func (_m *LedgerInvitation) QueryLedger() *LedgerQuery {
+	return NewLedgerInvitationClient(_m.config).QueryLedger(_m)
+}
+
+// QueryInvitee queries the "invitee" edge of the LedgerInvitation entity.
+func (_m *LedgerInvitation) QueryInvitee() *UserQuery {
+	return NewLedgerInvitationClient(_m.config).QueryInvitee(_m)
+}
+
+// QueryInvitedBy queries the "invited_by" edge of the LedgerInvitation entity.
+func (_m *LedgerInvitation) QueryInvitedBy() *UserQuery {
+	return NewLedgerInvitationClient(_m.config).QueryInvitedBy(_m)
+}
+
+// Update returns a builder for updating this LedgerInvitation.
+// Note that you need to call LedgerInvitation.Unwrap() before calling this method if this LedgerInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LedgerInvitation) Update() *LedgerInvitationUpdateOne {
+	return NewLedgerInvitationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LedgerInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LedgerInvitation) Unwrap() *LedgerInvitation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LedgerInvitation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LedgerInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ledger_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LedgerID))
+	builder.WriteString(", ")
+	builder.WriteString("invitee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviteeID))
+	builder.WriteString(", ")
+	builder.WriteString("invited_by_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvitedByID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerInvitations is a parsable slice of LedgerInvitation.
+type LedgerInvitations []*LedgerInvitation
@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerinvitation
+
+import (
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LedgerID applies equality check predicate on the "ledger_id" field. It's identical to LedgerIDEQ.
+func LedgerID(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// InviteeID applies equality check predicate on the "invitee_id" field. It's identical to InviteeIDEQ.
+func InviteeID(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldInviteeID, v))
+}
+
+// InvitedByID applies equality check predicate on the "invited_by_id" field. It's identical to InvitedByIDEQ.
+func InvitedByID(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldInvitedByID, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldRole, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// LedgerIDEQ applies the EQ predicate on the "ledger_id" field.
+func LedgerIDEQ(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// LedgerIDNEQ applies the NEQ predicate on the "ledger_id" field.
+func LedgerIDNEQ(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldLedgerID, v))
+}
+
+// LedgerIDIn applies the In predicate on the "ledger_id" field.
+func LedgerIDIn(vs ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDNotIn applies the NotIn predicate on the "ledger_id" field.
+func LedgerIDNotIn(vs ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldLedgerID, vs...))
+}
+
+// InviteeIDEQ applies the EQ predicate on the "invitee_id" field.
+func InviteeIDEQ(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldInviteeID, v))
+}
+
+// InviteeIDNEQ applies the NEQ predicate on the "invitee_id" field.
+func InviteeIDNEQ(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldInviteeID, v))
+}
+
+// InviteeIDIn applies the In predicate on the "invitee_id" field.
+func InviteeIDIn(vs ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldInviteeID, vs...))
+}
+
+// InviteeIDNotIn applies the NotIn predicate on the "invitee_id" field.
+func InviteeIDNotIn(vs ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldInviteeID, vs...))
+}
+
+// InvitedByIDEQ applies the EQ predicate on the "invited_by_id" field.
+func InvitedByIDEQ(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldInvitedByID, v))
+}
+
+// InvitedByIDNEQ applies the NEQ predicate on the "invited_by_id" field.
+func InvitedByIDNEQ(v uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldInvitedByID, v))
+}
+
+// InvitedByIDIn applies the In predicate on the "invited_by_id" field.
+func InvitedByIDIn(vs ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldInvitedByID, vs...))
+}
+
+// InvitedByIDNotIn applies the NotIn predicate on the "invited_by_id" field.
+func InvitedByIDNotIn(vs ...uuid.UUID) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldInvitedByID, vs...))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldContainsFold(FieldRole, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitee applies the HasEdge predicate on the "invitee" edge.
+func HasInvitee() predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InviteeTable, InviteeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInviteeWith applies the HasEdge predicate on the "invitee" edge with a given conditions (other predicates).
+func HasInviteeWith(preds ...predicate.User) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(func(s *sql.Selector) {
+		step := newInviteeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitedBy applies the HasEdge predicate on the "invited_by" edge.
+func HasInvitedBy() predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InvitedByTable, InvitedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitedByWith applies the HasEdge predicate on the "invited_by" edge with a given conditions (other predicates).
+func HasInvitedByWith(preds ...predicate.User) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(func(s *sql.Selector) {
+		step := newInvitedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LedgerInvitation) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LedgerInvitation) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LedgerInvitation) predicate.LedgerInvitation {
+	return predicate.LedgerInvitation(sql.NotPredicates(p))
+}
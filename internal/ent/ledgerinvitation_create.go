@@ -0,0 +1,1035 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LedgerInvitationCreate is the builder for creating a LedgerInvitation entity.
+type LedgerInvitationCreate struct {
+	config
+	mutation *LedgerInvitationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LedgerInvitationCreate) SetCreatedAt(v time.Time) *LedgerInvitationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LedgerInvitationCreate) SetNillableCreatedAt(v *time.Time) *LedgerInvitationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LedgerInvitationCreate) SetUpdatedAt(v time.Time) *LedgerInvitationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LedgerInvitationCreate) SetNillableUpdatedAt(v *time.Time) *LedgerInvitationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (_c *LedgerInvitationCreate) SetLedgerID(v uuid.UUID) *LedgerInvitationCreate {
+	_c.mutation.SetLedgerID(v)
+	return _c
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_c *LedgerInvitationCreate) SetInviteeID(v uuid.UUID) *LedgerInvitationCreate {
+	_c.mutation.SetInviteeID(v)
+	return _c
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (_c *LedgerInvitationCreate) SetInvitedByID(v uuid.UUID) *LedgerInvitationCreate {
+	_c.mutation.SetInvitedByID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *LedgerInvitationCreate) SetRole(v string) *LedgerInvitationCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LedgerInvitationCreate) SetStatus(v string) *LedgerInvitationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LedgerInvitationCreate) SetNillableStatus(v *string) *LedgerInvitationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *LedgerInvitationCreate) SetExpiresAt(v time.Time) *LedgerInvitationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *LedgerInvitationCreate) SetRespondedAt(v time.Time) *LedgerInvitationCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *LedgerInvitationCreate) SetNillableRespondedAt(v *time.Time) *LedgerInvitationCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LedgerInvitationCreate) SetID(v uuid.UUID) *LedgerInvitationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LedgerInvitationCreate) SetNillableID(v *uuid.UUID) *LedgerInvitationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *LedgerInvitationCreate) SetLedger(v *Ledger) *LedgerInvitationCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (_c *LedgerInvitationCreate) SetInvitee(v *User) *LedgerInvitationCreate {
+	return _c.SetInviteeID(v.ID)
+}
+
+// SetInvitedBy sets the "invited_by" edge to the User entity.
+func (_c *LedgerInvitationCreate) SetInvitedBy(v *User) *LedgerInvitationCreate {
+	return _c.SetInvitedByID(v.ID)
+}
+
+// Mutation returns the LedgerInvitationMutation object of the builder.
+func (_c *LedgerInvitationCreate) Mutation() *LedgerInvitationMutation {
+	return _c.mutation
+}
+
+// Save creates the LedgerInvitation in the database.
+func (_c *LedgerInvitationCreate) Save(ctx context.Context) (*LedgerInvitation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LedgerInvitationCreate) SaveX(ctx context.Context) *LedgerInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerInvitationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerInvitationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LedgerInvitationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ledgerinvitation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ledgerinvitation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ledgerinvitation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ledgerinvitation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LedgerInvitationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerInvitation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LedgerInvitation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.LedgerID(); !ok {
+		return &ValidationError{Name: "ledger_id", err: errors.New(`ent: missing required field "LedgerInvitation.ledger_id"`)}
+	}
+	if _, ok := _c.mutation.InviteeID(); !ok {
+		return &ValidationError{Name: "invitee_id", err: errors.New(`ent: missing required field "LedgerInvitation.invitee_id"`)}
+	}
+	if _, ok := _c.mutation.InvitedByID(); !ok {
+		return &ValidationError{Name: "invited_by_id", err: errors.New(`ent: missing required field "LedgerInvitation.invited_by_id"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "LedgerInvitation.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := ledgerinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LedgerInvitation.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LedgerInvitation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := ledgerinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LedgerInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "LedgerInvitation.expires_at"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "LedgerInvitation.ledger"`)}
+	}
+	if len(_c.mutation.InviteeIDs()) == 0 {
+		return &ValidationError{Name: "invitee", err: errors.New(`ent: missing required edge "LedgerInvitation.invitee"`)}
+	}
+	if len(_c.mutation.InvitedByIDs()) == 0 {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required edge "LedgerInvitation.invited_by"`)}
+	}
+	return nil
+}
+
+func (_c *LedgerInvitationCreate) sqlSave(ctx context.Context) (*LedgerInvitation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LedgerInvitationCreate) createSpec() (*LedgerInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerInvitation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerinvitation.Table, sqlgraph.NewFieldSpec(ledgerinvitation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(ledgerinvitation.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ledgerinvitation.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.LedgerTable,
+			Columns: []string{ledgerinvitation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LedgerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InviteeTable,
+			Columns: []string{ledgerinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InviteeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InvitedByTable,
+			Columns: []string{ledgerinvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvitedByID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerInvitation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerInvitationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LedgerInvitationCreate) OnConflict(opts ...sql.ConflictOption) *LedgerInvitationUpsertOne {
+	_c.conflict = opts
+	return &LedgerInvitationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerInvitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LedgerInvitationCreate) OnConflictColumns(columns ...string) *LedgerInvitationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LedgerInvitationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LedgerInvitationUpsertOne is the builder for "upsert"-ing
+	//  one LedgerInvitation node.
+	LedgerInvitationUpsertOne struct {
+		create *LedgerInvitationCreate
+	}
+
+	// LedgerInvitationUpsert is the "OnConflict" setter.
+	LedgerInvitationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerInvitationUpsert) SetUpdatedAt(v time.Time) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateUpdatedAt() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldUpdatedAt)
+	return u
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (u *LedgerInvitationUpsert) SetLedgerID(v uuid.UUID) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldLedgerID, v)
+	return u
+}
+
+// UpdateLedgerID sets the "ledger_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateLedgerID() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldLedgerID)
+	return u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (u *LedgerInvitationUpsert) SetInviteeID(v uuid.UUID) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldInviteeID, v)
+	return u
+}
+
+// UpdateInviteeID sets the "invitee_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateInviteeID() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldInviteeID)
+	return u
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (u *LedgerInvitationUpsert) SetInvitedByID(v uuid.UUID) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldInvitedByID, v)
+	return u
+}
+
+// UpdateInvitedByID sets the "invited_by_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateInvitedByID() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldInvitedByID)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *LedgerInvitationUpsert) SetRole(v string) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateRole() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldRole)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *LedgerInvitationUpsert) SetStatus(v string) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateStatus() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LedgerInvitationUpsert) SetExpiresAt(v time.Time) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateExpiresAt() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldExpiresAt)
+	return u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *LedgerInvitationUpsert) SetRespondedAt(v time.Time) *LedgerInvitationUpsert {
+	u.Set(ledgerinvitation.FieldRespondedAt, v)
+	return u
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsert) UpdateRespondedAt() *LedgerInvitationUpsert {
+	u.SetExcluded(ledgerinvitation.FieldRespondedAt)
+	return u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *LedgerInvitationUpsert) ClearRespondedAt() *LedgerInvitationUpsert {
+	u.SetNull(ledgerinvitation.FieldRespondedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LedgerInvitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerinvitation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerInvitationUpsertOne) UpdateNewValues() *LedgerInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledgerinvitation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledgerinvitation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerInvitation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerInvitationUpsertOne) Ignore() *LedgerInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerInvitationUpsertOne) DoNothing() *LedgerInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerInvitationCreate.OnConflict
+// documentation for more info.
+func (u *LedgerInvitationUpsertOne) Update(set func(*LedgerInvitationUpsert)) *LedgerInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerInvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerInvitationUpsertOne) SetUpdatedAt(v time.Time) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateUpdatedAt() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (u *LedgerInvitationUpsertOne) SetLedgerID(v uuid.UUID) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetLedgerID(v)
+	})
+}
+
+// UpdateLedgerID sets the "ledger_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateLedgerID() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateLedgerID()
+	})
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (u *LedgerInvitationUpsertOne) SetInviteeID(v uuid.UUID) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetInviteeID(v)
+	})
+}
+
+// UpdateInviteeID sets the "invitee_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateInviteeID() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateInviteeID()
+	})
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (u *LedgerInvitationUpsertOne) SetInvitedByID(v uuid.UUID) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetInvitedByID(v)
+	})
+}
+
+// UpdateInvitedByID sets the "invited_by_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateInvitedByID() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateInvitedByID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *LedgerInvitationUpsertOne) SetRole(v string) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateRole() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *LedgerInvitationUpsertOne) SetStatus(v string) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateStatus() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LedgerInvitationUpsertOne) SetExpiresAt(v time.Time) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateExpiresAt() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *LedgerInvitationUpsertOne) SetRespondedAt(v time.Time) *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetRespondedAt(v)
+	})
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertOne) UpdateRespondedAt() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateRespondedAt()
+	})
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *LedgerInvitationUpsertOne) ClearRespondedAt() *LedgerInvitationUpsertOne {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.ClearRespondedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerInvitationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerInvitationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerInvitationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerInvitationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LedgerInvitationUpsertOne.ID is not supported by MySQL driver. Use LedgerInvitationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerInvitationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerInvitationCreateBulk is the builder for creating many LedgerInvitation entities in bulk.
+type LedgerInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerInvitationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerInvitation entities in the database.
+func (_c *LedgerInvitationCreateBulk) Save(ctx context.Context) ([]*LedgerInvitation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LedgerInvitation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LedgerInvitationCreateBulk) SaveX(ctx context.Context) []*LedgerInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerInvitation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerInvitationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LedgerInvitationCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerInvitationUpsertBulk {
+	_c.conflict = opts
+	return &LedgerInvitationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerInvitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LedgerInvitationCreateBulk) OnConflictColumns(columns ...string) *LedgerInvitationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LedgerInvitationUpsertBulk{
+		create: _c,
+	}
+}
+
+// LedgerInvitationUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerInvitation nodes.
+type LedgerInvitationUpsertBulk struct {
+	create *LedgerInvitationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerInvitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerinvitation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerInvitationUpsertBulk) UpdateNewValues() *LedgerInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledgerinvitation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledgerinvitation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerInvitation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerInvitationUpsertBulk) Ignore() *LedgerInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerInvitationUpsertBulk) DoNothing() *LedgerInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerInvitationCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerInvitationUpsertBulk) Update(set func(*LedgerInvitationUpsert)) *LedgerInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerInvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerInvitationUpsertBulk) SetUpdatedAt(v time.Time) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateUpdatedAt() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (u *LedgerInvitationUpsertBulk) SetLedgerID(v uuid.UUID) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetLedgerID(v)
+	})
+}
+
+// UpdateLedgerID sets the "ledger_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateLedgerID() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateLedgerID()
+	})
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (u *LedgerInvitationUpsertBulk) SetInviteeID(v uuid.UUID) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetInviteeID(v)
+	})
+}
+
+// UpdateInviteeID sets the "invitee_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateInviteeID() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateInviteeID()
+	})
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (u *LedgerInvitationUpsertBulk) SetInvitedByID(v uuid.UUID) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetInvitedByID(v)
+	})
+}
+
+// UpdateInvitedByID sets the "invited_by_id" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateInvitedByID() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateInvitedByID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *LedgerInvitationUpsertBulk) SetRole(v string) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateRole() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *LedgerInvitationUpsertBulk) SetStatus(v string) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateStatus() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *LedgerInvitationUpsertBulk) SetExpiresAt(v time.Time) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateExpiresAt() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *LedgerInvitationUpsertBulk) SetRespondedAt(v time.Time) *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.SetRespondedAt(v)
+	})
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *LedgerInvitationUpsertBulk) UpdateRespondedAt() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.UpdateRespondedAt()
+	})
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *LedgerInvitationUpsertBulk) ClearRespondedAt() *LedgerInvitationUpsertBulk {
+	return u.Update(func(s *LedgerInvitationUpsert) {
+		s.ClearRespondedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerInvitationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerInvitationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerInvitationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerInvitationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
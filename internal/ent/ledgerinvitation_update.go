@@ -0,0 +1,747 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LedgerInvitationUpdate is the builder for updating LedgerInvitation entities.
+type LedgerInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LedgerInvitationMutation
+}
+
+// Where appends a list predicates to the LedgerInvitationUpdate builder.
+func (_u *LedgerInvitationUpdate) Where(ps ...predicate.LedgerInvitation) *LedgerInvitationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LedgerInvitationUpdate) SetUpdatedAt(v time.Time) *LedgerInvitationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (_u *LedgerInvitationUpdate) SetLedgerID(v uuid.UUID) *LedgerInvitationUpdate {
+	_u.mutation.SetLedgerID(v)
+	return _u
+}
+
+// SetNillableLedgerID sets the "ledger_id" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableLedgerID(v *uuid.UUID) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetLedgerID(*v)
+	}
+	return _u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_u *LedgerInvitationUpdate) SetInviteeID(v uuid.UUID) *LedgerInvitationUpdate {
+	_u.mutation.SetInviteeID(v)
+	return _u
+}
+
+// SetNillableInviteeID sets the "invitee_id" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableInviteeID(v *uuid.UUID) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetInviteeID(*v)
+	}
+	return _u
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (_u *LedgerInvitationUpdate) SetInvitedByID(v uuid.UUID) *LedgerInvitationUpdate {
+	_u.mutation.SetInvitedByID(v)
+	return _u
+}
+
+// SetNillableInvitedByID sets the "invited_by_id" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableInvitedByID(v *uuid.UUID) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetInvitedByID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *LedgerInvitationUpdate) SetRole(v string) *LedgerInvitationUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableRole(v *string) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LedgerInvitationUpdate) SetStatus(v string) *LedgerInvitationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableStatus(v *string) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LedgerInvitationUpdate) SetExpiresAt(v time.Time) *LedgerInvitationUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableExpiresAt(v *time.Time) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *LedgerInvitationUpdate) SetRespondedAt(v time.Time) *LedgerInvitationUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *LedgerInvitationUpdate) SetNillableRespondedAt(v *time.Time) *LedgerInvitationUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *LedgerInvitationUpdate) ClearRespondedAt() *LedgerInvitationUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LedgerInvitationUpdate) SetLedger(v *Ledger) *LedgerInvitationUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (_u *LedgerInvitationUpdate) SetInvitee(v *User) *LedgerInvitationUpdate {
+	return _u.SetInviteeID(v.ID)
+}
+
+// SetInvitedBy sets the "invited_by" edge to the User entity.
+func (_u *LedgerInvitationUpdate) SetInvitedBy(v *User) *LedgerInvitationUpdate {
+	return _u.SetInvitedByID(v.ID)
+}
+
+// Mutation returns the LedgerInvitationMutation object of the builder.
+func (_u *LedgerInvitationUpdate) Mutation() *LedgerInvitationMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LedgerInvitationUpdate) ClearLedger() *LedgerInvitationUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (_u *LedgerInvitationUpdate) ClearInvitee() *LedgerInvitationUpdate {
+	_u.mutation.ClearInvitee()
+	return _u
+}
+
+// ClearInvitedBy clears the "invited_by" edge to the User entity.
+func (_u *LedgerInvitationUpdate) ClearInvitedBy() *LedgerInvitationUpdate {
+	_u.mutation.ClearInvitedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LedgerInvitationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LedgerInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LedgerInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LedgerInvitationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LedgerInvitationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ledgerinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LedgerInvitationUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := ledgerinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LedgerInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ledgerinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LedgerInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerInvitation.ledger"`)
+	}
+	if _u.mutation.InviteeCleared() && len(_u.mutation.InviteeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerInvitation.invitee"`)
+	}
+	if _u.mutation.InvitedByCleared() && len(_u.mutation.InvitedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerInvitation.invited_by"`)
+	}
+	return nil
+}
+
+func (_u *LedgerInvitationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgerinvitation.Table, ledgerinvitation.Columns, sqlgraph.NewFieldSpec(ledgerinvitation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(ledgerinvitation.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ledgerinvitation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(ledgerinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.LedgerTable,
+			Columns: []string{ledgerinvitation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.LedgerTable,
+			Columns: []string{ledgerinvitation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InviteeTable,
+			Columns: []string{ledgerinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InviteeTable,
+			Columns: []string{ledgerinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InvitedByTable,
+			Columns: []string{ledgerinvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InvitedByTable,
+			Columns: []string{ledgerinvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LedgerInvitationUpdateOne is the builder for updating a single LedgerInvitation entity.
+type LedgerInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LedgerInvitationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LedgerInvitationUpdateOne) SetUpdatedAt(v time.Time) *LedgerInvitationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (_u *LedgerInvitationUpdateOne) SetLedgerID(v uuid.UUID) *LedgerInvitationUpdateOne {
+	_u.mutation.SetLedgerID(v)
+	return _u
+}
+
+// SetNillableLedgerID sets the "ledger_id" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableLedgerID(v *uuid.UUID) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetLedgerID(*v)
+	}
+	return _u
+}
+
+// SetInviteeID sets the "invitee_id" field.
+func (_u *LedgerInvitationUpdateOne) SetInviteeID(v uuid.UUID) *LedgerInvitationUpdateOne {
+	_u.mutation.SetInviteeID(v)
+	return _u
+}
+
+// SetNillableInviteeID sets the "invitee_id" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableInviteeID(v *uuid.UUID) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetInviteeID(*v)
+	}
+	return _u
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (_u *LedgerInvitationUpdateOne) SetInvitedByID(v uuid.UUID) *LedgerInvitationUpdateOne {
+	_u.mutation.SetInvitedByID(v)
+	return _u
+}
+
+// SetNillableInvitedByID sets the "invited_by_id" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableInvitedByID(v *uuid.UUID) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetInvitedByID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *LedgerInvitationUpdateOne) SetRole(v string) *LedgerInvitationUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableRole(v *string) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LedgerInvitationUpdateOne) SetStatus(v string) *LedgerInvitationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableStatus(v *string) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *LedgerInvitationUpdateOne) SetExpiresAt(v time.Time) *LedgerInvitationUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableExpiresAt(v *time.Time) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *LedgerInvitationUpdateOne) SetRespondedAt(v time.Time) *LedgerInvitationUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *LedgerInvitationUpdateOne) SetNillableRespondedAt(v *time.Time) *LedgerInvitationUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *LedgerInvitationUpdateOne) ClearRespondedAt() *LedgerInvitationUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LedgerInvitationUpdateOne) SetLedger(v *Ledger) *LedgerInvitationUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (_u *LedgerInvitationUpdateOne) SetInvitee(v *User) *LedgerInvitationUpdateOne {
+	return _u.SetInviteeID(v.ID)
+}
+
+// SetInvitedBy sets the "invited_by" edge to the User entity.
+func (_u *LedgerInvitationUpdateOne) SetInvitedBy(v *User) *LedgerInvitationUpdateOne {
+	return _u.SetInvitedByID(v.ID)
+}
+
+// Mutation returns the LedgerInvitationMutation object of the builder.
+func (_u *LedgerInvitationUpdateOne) Mutation() *LedgerInvitationMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LedgerInvitationUpdateOne) ClearLedger() *LedgerInvitationUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (_u *LedgerInvitationUpdateOne) ClearInvitee() *LedgerInvitationUpdateOne {
+	_u.mutation.ClearInvitee()
+	return _u
+}
+
+// ClearInvitedBy clears the "invited_by" edge to the User entity.
+func (_u *LedgerInvitationUpdateOne) ClearInvitedBy() *LedgerInvitationUpdateOne {
+	_u.mutation.ClearInvitedBy()
+	return _u
+}
+
+// Where appends a list predicates to the LedgerInvitationUpdate builder.
+func (_u *LedgerInvitationUpdateOne) Where(ps ...predicate.LedgerInvitation) *LedgerInvitationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LedgerInvitationUpdateOne) Select(field string, fields ...string) *LedgerInvitationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LedgerInvitation entity.
+func (_u *LedgerInvitationUpdateOne) Save(ctx context.Context) (*LedgerInvitation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LedgerInvitationUpdateOne) SaveX(ctx context.Context) *LedgerInvitation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LedgerInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LedgerInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LedgerInvitationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ledgerinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LedgerInvitationUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := ledgerinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LedgerInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ledgerinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LedgerInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerInvitation.ledger"`)
+	}
+	if _u.mutation.InviteeCleared() && len(_u.mutation.InviteeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerInvitation.invitee"`)
+	}
+	if _u.mutation.InvitedByCleared() && len(_u.mutation.InvitedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LedgerInvitation.invited_by"`)
+	}
+	return nil
+}
+
+func (_u *LedgerInvitationUpdateOne) sqlSave(ctx context.Context) (_node *LedgerInvitation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgerinvitation.Table, ledgerinvitation.Columns, sqlgraph.NewFieldSpec(ledgerinvitation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LedgerInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgerinvitation.FieldID)
+		for _, f := range fields {
+			if !ledgerinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ledgerinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(ledgerinvitation.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ledgerinvitation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(ledgerinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(ledgerinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.LedgerTable,
+			Columns: []string{ledgerinvitation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.LedgerTable,
+			Columns: []string{ledgerinvitation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InviteeTable,
+			Columns: []string{ledgerinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InviteeTable,
+			Columns: []string{ledgerinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InvitedByTable,
+			Columns: []string{ledgerinvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgerinvitation.InvitedByTable,
+			Columns: []string{ledgerinvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LedgerInvitation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,760 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgermember"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LedgerMemberCreate is the builder for creating a LedgerMember entity.
+type LedgerMemberCreate struct {
+	config
+	mutation *LedgerMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LedgerMemberCreate) SetCreatedAt(v time.Time) *LedgerMemberCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LedgerMemberCreate) SetNillableCreatedAt(v *time.Time) *LedgerMemberCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LedgerMemberCreate) SetUpdatedAt(v time.Time) *LedgerMemberCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LedgerMemberCreate) SetNillableUpdatedAt(v *time.Time) *LedgerMemberCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (_c *LedgerMemberCreate) SetLedgerID(v uuid.UUID) *LedgerMemberCreate {
+	_c.mutation.SetLedgerID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LedgerMemberCreate) SetUserID(v uuid.UUID) *LedgerMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *LedgerMemberCreate) SetRole(v string) *LedgerMemberCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LedgerMemberCreate) SetID(v uuid.UUID) *LedgerMemberCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LedgerMemberCreate) SetNillableID(v *uuid.UUID) *LedgerMemberCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *LedgerMemberCreate) SetLedger(v *Ledger) *LedgerMemberCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LedgerMemberCreate) SetUser(v *User) *LedgerMemberCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LedgerMemberMutation object of the builder.
+func (_c *LedgerMemberCreate) Mutation() *LedgerMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the LedgerMember in the database.
+func (_c *LedgerMemberCreate) Save(ctx context.Context) (*LedgerMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LedgerMemberCreate) SaveX(ctx context.Context) *LedgerMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LedgerMemberCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ledgermember.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ledgermember.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ledgermember.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LedgerMemberCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerMember.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LedgerMember.updated_at"`)}
+	}
+	if _, ok := _c.mutation.LedgerID(); !ok {
+		return &ValidationError{Name: "ledger_id", err: errors.New(`ent: missing required field "LedgerMember.ledger_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LedgerMember.user_id"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "LedgerMember.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := ledgermember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LedgerMember.role": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "LedgerMember.ledger"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LedgerMember.user"`)}
+	}
+	return nil
+}
+
+func (_c *LedgerMemberCreate) sqlSave(ctx context.Context) (*LedgerMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LedgerMemberCreate) createSpec() (*LedgerMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ledgermember.Table, sqlgraph.NewFieldSpec(ledgermember.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgermember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ledgermember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(ledgermember.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgermember.LedgerTable,
+			Columns: []string{ledgermember.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LedgerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ledgermember.UserTable,
+			Columns: []string{ledgermember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LedgerMemberCreate) OnConflict(opts ...sql.ConflictOption) *LedgerMemberUpsertOne {
+	_c.conflict = opts
+	return &LedgerMemberUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LedgerMemberCreate) OnConflictColumns(columns ...string) *LedgerMemberUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LedgerMemberUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LedgerMemberUpsertOne is the builder for "upsert"-ing
+	//  one LedgerMember node.
+	LedgerMemberUpsertOne struct {
+		create *LedgerMemberCreate
+	}
+
+	// LedgerMemberUpsert is the "OnConflict" setter.
+	LedgerMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerMemberUpsert) SetUpdatedAt(v time.Time) *LedgerMemberUpsert {
+	u.Set(ledgermember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerMemberUpsert) UpdateUpdatedAt() *LedgerMemberUpsert {
+	u.SetExcluded(ledgermember.FieldUpdatedAt)
+	return u
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (u *LedgerMemberUpsert) SetLedgerID(v uuid.UUID) *LedgerMemberUpsert {
+	u.Set(ledgermember.FieldLedgerID, v)
+	return u
+}
+
+// UpdateLedgerID sets the "ledger_id" field to the value that was provided on create.
+func (u *LedgerMemberUpsert) UpdateLedgerID() *LedgerMemberUpsert {
+	u.SetExcluded(ledgermember.FieldLedgerID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LedgerMemberUpsert) SetUserID(v uuid.UUID) *LedgerMemberUpsert {
+	u.Set(ledgermember.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LedgerMemberUpsert) UpdateUserID() *LedgerMemberUpsert {
+	u.SetExcluded(ledgermember.FieldUserID)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *LedgerMemberUpsert) SetRole(v string) *LedgerMemberUpsert {
+	u.Set(ledgermember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *LedgerMemberUpsert) UpdateRole() *LedgerMemberUpsert {
+	u.SetExcluded(ledgermember.FieldRole)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LedgerMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgermember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerMemberUpsertOne) UpdateNewValues() *LedgerMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledgermember.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledgermember.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerMemberUpsertOne) Ignore() *LedgerMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerMemberUpsertOne) DoNothing() *LedgerMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerMemberCreate.OnConflict
+// documentation for more info.
+func (u *LedgerMemberUpsertOne) Update(set func(*LedgerMemberUpsert)) *LedgerMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerMemberUpsertOne) SetUpdatedAt(v time.Time) *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerMemberUpsertOne) UpdateUpdatedAt() *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (u *LedgerMemberUpsertOne) SetLedgerID(v uuid.UUID) *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetLedgerID(v)
+	})
+}
+
+// UpdateLedgerID sets the "ledger_id" field to the value that was provided on create.
+func (u *LedgerMemberUpsertOne) UpdateLedgerID() *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateLedgerID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LedgerMemberUpsertOne) SetUserID(v uuid.UUID) *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LedgerMemberUpsertOne) UpdateUserID() *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *LedgerMemberUpsertOne) SetRole(v string) *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *LedgerMemberUpsertOne) UpdateRole() *LedgerMemberUpsertOne {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerMemberUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LedgerMemberUpsertOne.ID is not supported by MySQL driver. Use LedgerMemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerMemberUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerMemberCreateBulk is the builder for creating many LedgerMember entities in bulk.
+type LedgerMemberCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerMember entities in the database.
+func (_c *LedgerMemberCreateBulk) Save(ctx context.Context) ([]*LedgerMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LedgerMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LedgerMemberCreateBulk) SaveX(ctx context.Context) []*LedgerMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LedgerMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerMemberUpsertBulk {
+	_c.conflict = opts
+	return &LedgerMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LedgerMemberCreateBulk) OnConflictColumns(columns ...string) *LedgerMemberUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LedgerMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// LedgerMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerMember nodes.
+type LedgerMemberUpsertBulk struct {
+	create *LedgerMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgermember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerMemberUpsertBulk) UpdateNewValues() *LedgerMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledgermember.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledgermember.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerMemberUpsertBulk) Ignore() *LedgerMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerMemberUpsertBulk) DoNothing() *LedgerMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerMemberUpsertBulk) Update(set func(*LedgerMemberUpsert)) *LedgerMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LedgerMemberUpsertBulk) SetUpdatedAt(v time.Time) *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LedgerMemberUpsertBulk) UpdateUpdatedAt() *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (u *LedgerMemberUpsertBulk) SetLedgerID(v uuid.UUID) *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetLedgerID(v)
+	})
+}
+
+// UpdateLedgerID sets the "ledger_id" field to the value that was provided on create.
+func (u *LedgerMemberUpsertBulk) UpdateLedgerID() *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateLedgerID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LedgerMemberUpsertBulk) SetUserID(v uuid.UUID) *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LedgerMemberUpsertBulk) UpdateUserID() *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *LedgerMemberUpsertBulk) SetRole(v string) *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *LedgerMemberUpsertBulk) UpdateRole() *LedgerMemberUpsertBulk {
+	return u.Update(func(s *LedgerMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LedgerMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LedgerMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Loan is the model entity for the Loan schema.
+type Loan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Principal holds the value of the "principal" field.
+	Principal domain.Money `json:"principal,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// InterestRate holds the value of the "interest_rate" field.
+	InterestRate float64 `json:"interest_rate,omitempty"`
+	// RatePeriod holds the value of the "rate_period" field.
+	RatePeriod string `json:"rate_period,omitempty"`
+	// TermMonths holds the value of the "term_months" field.
+	TermMonths int `json:"term_months,omitempty"`
+	// System holds the value of the "system" field.
+	System string `json:"system,omitempty"`
+	// FirstPaymentDate holds the value of the "first_payment_date" field.
+	FirstPaymentDate time.Time `json:"first_payment_date,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID *uuid.UUID `json:"account_id,omitempty"`
+	// InterestCategoryID holds the value of the "interest_category_id" field.
+	InterestCategoryID *uuid.UUID `json:"interest_category_id,omitempty"`
+	// PrincipalCategoryID holds the value of the "principal_category_id" field.
+	PrincipalCategoryID *uuid.UUID `json:"principal_category_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanQuery when eager-loading is set.
+	Edges        LoanEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// LoanEdges holds the relations/edges for other nodes in the graph.
+type LoanEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// InterestCategory holds the value of the interest_category edge.
+	InterestCategory *Category `json:"interest_category,omitempty"`
+	// PrincipalCategory holds the value of the principal_category edge.
+	PrincipalCategory *Category `json:"principal_category,omitempty"`
+	// Installments holds the value of the installments edge.
+	Installments []*LoanInstallment `json:"installments,omitempty"`
+	// Prepayments holds the value of the prepayments edge.
+	Prepayments []*LoanPrepayment `json:"prepayments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// InterestCategoryOrErr returns the InterestCategory value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) InterestCategoryOrErr() (*Category, error) {
+	if e.InterestCategory != nil {
+		return e.InterestCategory, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "interest_category"}
+}
+
+// PrincipalCategoryOrErr returns the PrincipalCategory value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) PrincipalCategoryOrErr() (*Category, error) {
+	if e.PrincipalCategory != nil {
+		return e.PrincipalCategory, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "principal_category"}
+}
+
+// InstallmentsOrErr returns the Installments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) InstallmentsOrErr() ([]*LoanInstallment, error) {
+	if e.loadedTypes[4] {
+		return e.Installments, nil
+	}
+	return nil, &NotLoadedError{edge: "installments"}
+}
+
+// PrepaymentsOrErr returns the Prepayments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LoanEdges) PrepaymentsOrErr() ([]*LoanPrepayment, error) {
+	if e.loadedTypes[5] {
+		return e.Prepayments, nil
+	}
+	return nil, &NotLoadedError{edge: "prepayments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Loan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loan.FieldAccountID, loan.FieldInterestCategoryID, loan.FieldPrincipalCategoryID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case loan.FieldPrincipal:
+			values[i] = new(domain.Money)
+		case loan.FieldInterestRate:
+			values[i] = new(sql.NullFloat64)
+		case loan.FieldTermMonths:
+			values[i] = new(sql.NullInt64)
+		case loan.FieldName, loan.FieldRatePeriod, loan.FieldSystem, loan.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case loan.FieldCreatedAt, loan.FieldUpdatedAt, loan.FieldFirstPaymentDate:
+			values[i] = new(sql.NullTime)
+		case loan.FieldID:
+			values[i] = new(uuid.UUID)
+		case loan.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Loan fields.
+func (_m *Loan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loan.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case loan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case loan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case loan.FieldPrincipal:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field principal", values[i])
+			} else if value != nil {
+				_m.Principal = *value
+			}
+		case loan.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case loan.FieldInterestRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field interest_rate", values[i])
+			} else if value.Valid {
+				_m.InterestRate = value.Float64
+			}
+		case loan.FieldRatePeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_period", values[i])
+			} else if value.Valid {
+				_m.RatePeriod = value.String
+			}
+		case loan.FieldTermMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field term_months", values[i])
+			} else if value.Valid {
+				_m.TermMonths = int(value.Int64)
+			}
+		case loan.FieldSystem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field system", values[i])
+			} else if value.Valid {
+				_m.System = value.String
+			}
+		case loan.FieldFirstPaymentDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_payment_date", values[i])
+			} else if value.Valid {
+				_m.FirstPaymentDate = value.Time
+			}
+		case loan.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case loan.FieldAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = new(uuid.UUID)
+				*_m.AccountID = *value.S.(*uuid.UUID)
+			}
+		case loan.FieldInterestCategoryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field interest_category_id", values[i])
+			} else if value.Valid {
+				_m.InterestCategoryID = new(uuid.UUID)
+				*_m.InterestCategoryID = *value.S.(*uuid.UUID)
+			}
+		case loan.FieldPrincipalCategoryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field principal_category_id", values[i])
+			} else if value.Valid {
+				_m.PrincipalCategoryID = new(uuid.UUID)
+				*_m.PrincipalCategoryID = *value.S.(*uuid.UUID)
+			}
+		case loan.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Loan.
+// This includes values selected through modifiers, order, etc.
+func (_m *Loan) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Loan entity.
+func (_m *Loan) QueryLedger() *LedgerQuery {
+	return NewLoanClient(_m.config).QueryLedger(_m)
+}
+
+// QueryAccount queries the "account" edge of the Loan entity.
+func (_m *Loan) QueryAccount() *AccountQuery {
+	return NewLoanClient(_m.config).QueryAccount(_m)
+}
+
+// QueryInterestCategory queries the "interest_category" edge of the Loan entity.
+func (_m *Loan) QueryInterestCategory() *CategoryQuery {
+	return NewLoanClient(_m.config).QueryInterestCategory(_m)
+}
+
+// QueryPrincipalCategory queries the "principal_category" edge of the Loan entity.
+func (_m *Loan) QueryPrincipalCategory() *CategoryQuery {
+	return NewLoanClient(_m.config).QueryPrincipalCategory(_m)
+}
+
+// QueryInstallments queries the "installments" edge of the Loan entity.
+func (_m *Loan) QueryInstallments() *LoanInstallmentQuery {
+	return NewLoanClient(_m.config).QueryInstallments(_m)
+}
+
+// QueryPrepayments queries the "prepayments" edge of the Loan entity.
+func (_m *Loan) QueryPrepayments() *LoanPrepaymentQuery {
+	return NewLoanClient(_m.config).QueryPrepayments(_m)
+}
+
+// Update returns a builder for updating this Loan.
+// Note that you need to call Loan.Unwrap() before calling this method if this Loan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Loan) Update() *LoanUpdateOne {
+	return NewLoanClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Loan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Loan) Unwrap() *Loan {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Loan is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Loan) String() string {
+	var builder strings.Builder
+	builder.WriteString("Loan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("principal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Principal))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("interest_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InterestRate))
+	builder.WriteString(", ")
+	builder.WriteString("rate_period=")
+	builder.WriteString(_m.RatePeriod)
+	builder.WriteString(", ")
+	builder.WriteString("term_months=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TermMonths))
+	builder.WriteString(", ")
+	builder.WriteString("system=")
+	builder.WriteString(_m.System)
+	builder.WriteString(", ")
+	builder.WriteString("first_payment_date=")
+	builder.WriteString(_m.FirstPaymentDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	if v := _m.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.InterestCategoryID; v != nil {
+		builder.WriteString("interest_category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PrincipalCategoryID; v != nil {
+		builder.WriteString("principal_category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Loans is a parsable slice of Loan.
+type Loans []*Loan
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the loan type in the database.
+	Label = "loan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPrincipal holds the string denoting the principal field in the database.
+	FieldPrincipal = "principal"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldInterestRate holds the string denoting the interest_rate field in the database.
+	FieldInterestRate = "interest_rate"
+	// FieldRatePeriod holds the string denoting the rate_period field in the database.
+	FieldRatePeriod = "rate_period"
+	// FieldTermMonths holds the string denoting the term_months field in the database.
+	FieldTermMonths = "term_months"
+	// FieldSystem holds the string denoting the system field in the database.
+	FieldSystem = "system"
+	// FieldFirstPaymentDate holds the string denoting the first_payment_date field in the database.
+	FieldFirstPaymentDate = "first_payment_date"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldInterestCategoryID holds the string denoting the interest_category_id field in the database.
+	FieldInterestCategoryID = "interest_category_id"
+	// FieldPrincipalCategoryID holds the string denoting the principal_category_id field in the database.
+	FieldPrincipalCategoryID = "principal_category_id"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeInterestCategory holds the string denoting the interest_category edge name in mutations.
+	EdgeInterestCategory = "interest_category"
+	// EdgePrincipalCategory holds the string denoting the principal_category edge name in mutations.
+	EdgePrincipalCategory = "principal_category"
+	// EdgeInstallments holds the string denoting the installments edge name in mutations.
+	EdgeInstallments = "installments"
+	// EdgePrepayments holds the string denoting the prepayments edge name in mutations.
+	EdgePrepayments = "prepayments"
+	// Table holds the table name of the loan in the database.
+	Table = "loans"
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "loans"
+	// LedgerInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgerInverseTable = "ledgers"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "ledger_id"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "loans"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// InterestCategoryTable is the table that holds the interest_category relation/edge.
+	InterestCategoryTable = "loans"
+	// InterestCategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	InterestCategoryInverseTable = "categories"
+	// InterestCategoryColumn is the table column denoting the interest_category relation/edge.
+	InterestCategoryColumn = "interest_category_id"
+	// PrincipalCategoryTable is the table that holds the principal_category relation/edge.
+	PrincipalCategoryTable = "loans"
+	// PrincipalCategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	PrincipalCategoryInverseTable = "categories"
+	// PrincipalCategoryColumn is the table column denoting the principal_category relation/edge.
+	PrincipalCategoryColumn = "principal_category_id"
+	// InstallmentsTable is the table that holds the installments relation/edge.
+	InstallmentsTable = "loan_installments"
+	// InstallmentsInverseTable is the table name for the LoanInstallment entity.
+	// It exists in this package in order to avoid circular dependency with the "loaninstallment" package.
+	InstallmentsInverseTable = "loan_installments"
+	// InstallmentsColumn is the table column denoting the installments relation/edge.
+	InstallmentsColumn = "loan_id"
+	// PrepaymentsTable is the table that holds the prepayments relation/edge.
+	PrepaymentsTable = "loan_prepayments"
+	// PrepaymentsInverseTable is the table name for the LoanPrepayment entity.
+	// It exists in this package in order to avoid circular dependency with the "loanprepayment" package.
+	PrepaymentsInverseTable = "loan_prepayments"
+	// PrepaymentsColumn is the table column denoting the prepayments relation/edge.
+	PrepaymentsColumn = "loan_id"
+)
+
+// Columns holds all SQL columns for loan fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPrincipal,
+	FieldName,
+	FieldInterestRate,
+	FieldRatePeriod,
+	FieldTermMonths,
+	FieldSystem,
+	FieldFirstPaymentDate,
+	FieldCurrency,
+	FieldAccountID,
+	FieldInterestCategoryID,
+	FieldPrincipalCategoryID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "loans"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ledger_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// InterestRateValidator is a validator for the "interest_rate" field. It is called by the builders before save.
+	InterestRateValidator func(float64) error
+	// DefaultRatePeriod holds the default value on creation for the "rate_period" field.
+	DefaultRatePeriod string
+	// RatePeriodValidator is a validator for the "rate_period" field. It is called by the builders before save.
+	RatePeriodValidator func(string) error
+	// TermMonthsValidator is a validator for the "term_months" field. It is called by the builders before save.
+	TermMonthsValidator func(int) error
+	// SystemValidator is a validator for the "system" field. It is called by the builders before save.
+	SystemValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Loan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPrincipal orders the results by the principal field.
+func ByPrincipal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrincipal, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByInterestRate orders the results by the interest_rate field.
+func ByInterestRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterestRate, opts...).ToFunc()
+}
+
+// ByRatePeriod orders the results by the rate_period field.
+func ByRatePeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRatePeriod, opts...).ToFunc()
+}
+
+// ByTermMonths orders the results by the term_months field.
+func ByTermMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTermMonths, opts...).ToFunc()
+}
+
+// BySystem orders the results by the system field.
+func BySystem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSystem, opts...).ToFunc()
+}
+
+// ByFirstPaymentDate orders the results by the first_payment_date field.
+func ByFirstPaymentDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstPaymentDate, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByInterestCategoryID orders the results by the interest_category_id field.
+func ByInterestCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterestCategoryID, opts...).ToFunc()
+}
+
+// ByPrincipalCategoryID orders the results by the principal_category_id field.
+func ByPrincipalCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrincipalCategoryID, opts...).ToFunc()
+}
+
+// ByLedgerField orders the results by ledger field.
+func ByLedgerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInterestCategoryField orders the results by interest_category field.
+func ByInterestCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInterestCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPrincipalCategoryField orders the results by principal_category field.
+func ByPrincipalCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrincipalCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInstallmentsCount orders the results by installments count.
+func ByInstallmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInstallmentsStep(), opts...)
+	}
+}
+
+// ByInstallments orders the results by installments terms.
+func ByInstallments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInstallmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPrepaymentsCount orders the results by prepayments count.
+func ByPrepaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrepaymentsStep(), opts...)
+	}
+}
+
+// ByPrepayments orders the results by prepayments terms.
+func ByPrepayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrepaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+	)
+}
+func newInterestCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InterestCategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InterestCategoryTable, InterestCategoryColumn),
+	)
+}
+func newPrincipalCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrincipalCategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PrincipalCategoryTable, PrincipalCategoryColumn),
+	)
+}
+func newInstallmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InstallmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, InstallmentsTable, InstallmentsColumn),
+	)
+}
+func newPrepaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrepaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PrepaymentsTable, PrepaymentsColumn),
+	)
+}
@@ -0,0 +1,866 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loan
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Principal applies equality check predicate on the "principal" field. It's identical to PrincipalEQ.
+func Principal(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldName, v))
+}
+
+// InterestRate applies equality check predicate on the "interest_rate" field. It's identical to InterestRateEQ.
+func InterestRate(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldInterestRate, v))
+}
+
+// RatePeriod applies equality check predicate on the "rate_period" field. It's identical to RatePeriodEQ.
+func RatePeriod(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldRatePeriod, v))
+}
+
+// TermMonths applies equality check predicate on the "term_months" field. It's identical to TermMonthsEQ.
+func TermMonths(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldTermMonths, v))
+}
+
+// System applies equality check predicate on the "system" field. It's identical to SystemEQ.
+func System(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldSystem, v))
+}
+
+// FirstPaymentDate applies equality check predicate on the "first_payment_date" field. It's identical to FirstPaymentDateEQ.
+func FirstPaymentDate(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldFirstPaymentDate, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCurrency, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldAccountID, v))
+}
+
+// InterestCategoryID applies equality check predicate on the "interest_category_id" field. It's identical to InterestCategoryIDEQ.
+func InterestCategoryID(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldInterestCategoryID, v))
+}
+
+// PrincipalCategoryID applies equality check predicate on the "principal_category_id" field. It's identical to PrincipalCategoryIDEQ.
+func PrincipalCategoryID(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPrincipalCategoryID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PrincipalEQ applies the EQ predicate on the "principal" field.
+func PrincipalEQ(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// PrincipalNEQ applies the NEQ predicate on the "principal" field.
+func PrincipalNEQ(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldPrincipal, v))
+}
+
+// PrincipalIn applies the In predicate on the "principal" field.
+func PrincipalIn(vs ...domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldPrincipal, vs...))
+}
+
+// PrincipalNotIn applies the NotIn predicate on the "principal" field.
+func PrincipalNotIn(vs ...domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldPrincipal, vs...))
+}
+
+// PrincipalGT applies the GT predicate on the "principal" field.
+func PrincipalGT(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldPrincipal, v))
+}
+
+// PrincipalGTE applies the GTE predicate on the "principal" field.
+func PrincipalGTE(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldPrincipal, v))
+}
+
+// PrincipalLT applies the LT predicate on the "principal" field.
+func PrincipalLT(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldPrincipal, v))
+}
+
+// PrincipalLTE applies the LTE predicate on the "principal" field.
+func PrincipalLTE(v domain.Money) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldPrincipal, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldName, v))
+}
+
+// InterestRateEQ applies the EQ predicate on the "interest_rate" field.
+func InterestRateEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldInterestRate, v))
+}
+
+// InterestRateNEQ applies the NEQ predicate on the "interest_rate" field.
+func InterestRateNEQ(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldInterestRate, v))
+}
+
+// InterestRateIn applies the In predicate on the "interest_rate" field.
+func InterestRateIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldInterestRate, vs...))
+}
+
+// InterestRateNotIn applies the NotIn predicate on the "interest_rate" field.
+func InterestRateNotIn(vs ...float64) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldInterestRate, vs...))
+}
+
+// InterestRateGT applies the GT predicate on the "interest_rate" field.
+func InterestRateGT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldInterestRate, v))
+}
+
+// InterestRateGTE applies the GTE predicate on the "interest_rate" field.
+func InterestRateGTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldInterestRate, v))
+}
+
+// InterestRateLT applies the LT predicate on the "interest_rate" field.
+func InterestRateLT(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldInterestRate, v))
+}
+
+// InterestRateLTE applies the LTE predicate on the "interest_rate" field.
+func InterestRateLTE(v float64) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldInterestRate, v))
+}
+
+// RatePeriodEQ applies the EQ predicate on the "rate_period" field.
+func RatePeriodEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldRatePeriod, v))
+}
+
+// RatePeriodNEQ applies the NEQ predicate on the "rate_period" field.
+func RatePeriodNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldRatePeriod, v))
+}
+
+// RatePeriodIn applies the In predicate on the "rate_period" field.
+func RatePeriodIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldRatePeriod, vs...))
+}
+
+// RatePeriodNotIn applies the NotIn predicate on the "rate_period" field.
+func RatePeriodNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldRatePeriod, vs...))
+}
+
+// RatePeriodGT applies the GT predicate on the "rate_period" field.
+func RatePeriodGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldRatePeriod, v))
+}
+
+// RatePeriodGTE applies the GTE predicate on the "rate_period" field.
+func RatePeriodGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldRatePeriod, v))
+}
+
+// RatePeriodLT applies the LT predicate on the "rate_period" field.
+func RatePeriodLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldRatePeriod, v))
+}
+
+// RatePeriodLTE applies the LTE predicate on the "rate_period" field.
+func RatePeriodLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldRatePeriod, v))
+}
+
+// RatePeriodContains applies the Contains predicate on the "rate_period" field.
+func RatePeriodContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldRatePeriod, v))
+}
+
+// RatePeriodHasPrefix applies the HasPrefix predicate on the "rate_period" field.
+func RatePeriodHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldRatePeriod, v))
+}
+
+// RatePeriodHasSuffix applies the HasSuffix predicate on the "rate_period" field.
+func RatePeriodHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldRatePeriod, v))
+}
+
+// RatePeriodEqualFold applies the EqualFold predicate on the "rate_period" field.
+func RatePeriodEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldRatePeriod, v))
+}
+
+// RatePeriodContainsFold applies the ContainsFold predicate on the "rate_period" field.
+func RatePeriodContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldRatePeriod, v))
+}
+
+// TermMonthsEQ applies the EQ predicate on the "term_months" field.
+func TermMonthsEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldTermMonths, v))
+}
+
+// TermMonthsNEQ applies the NEQ predicate on the "term_months" field.
+func TermMonthsNEQ(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldTermMonths, v))
+}
+
+// TermMonthsIn applies the In predicate on the "term_months" field.
+func TermMonthsIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldTermMonths, vs...))
+}
+
+// TermMonthsNotIn applies the NotIn predicate on the "term_months" field.
+func TermMonthsNotIn(vs ...int) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldTermMonths, vs...))
+}
+
+// TermMonthsGT applies the GT predicate on the "term_months" field.
+func TermMonthsGT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldTermMonths, v))
+}
+
+// TermMonthsGTE applies the GTE predicate on the "term_months" field.
+func TermMonthsGTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldTermMonths, v))
+}
+
+// TermMonthsLT applies the LT predicate on the "term_months" field.
+func TermMonthsLT(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldTermMonths, v))
+}
+
+// TermMonthsLTE applies the LTE predicate on the "term_months" field.
+func TermMonthsLTE(v int) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldTermMonths, v))
+}
+
+// SystemEQ applies the EQ predicate on the "system" field.
+func SystemEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldSystem, v))
+}
+
+// SystemNEQ applies the NEQ predicate on the "system" field.
+func SystemNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldSystem, v))
+}
+
+// SystemIn applies the In predicate on the "system" field.
+func SystemIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldSystem, vs...))
+}
+
+// SystemNotIn applies the NotIn predicate on the "system" field.
+func SystemNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldSystem, vs...))
+}
+
+// SystemGT applies the GT predicate on the "system" field.
+func SystemGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldSystem, v))
+}
+
+// SystemGTE applies the GTE predicate on the "system" field.
+func SystemGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldSystem, v))
+}
+
+// SystemLT applies the LT predicate on the "system" field.
+func SystemLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldSystem, v))
+}
+
+// SystemLTE applies the LTE predicate on the "system" field.
+func SystemLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldSystem, v))
+}
+
+// SystemContains applies the Contains predicate on the "system" field.
+func SystemContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldSystem, v))
+}
+
+// SystemHasPrefix applies the HasPrefix predicate on the "system" field.
+func SystemHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldSystem, v))
+}
+
+// SystemHasSuffix applies the HasSuffix predicate on the "system" field.
+func SystemHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldSystem, v))
+}
+
+// SystemEqualFold applies the EqualFold predicate on the "system" field.
+func SystemEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldSystem, v))
+}
+
+// SystemContainsFold applies the ContainsFold predicate on the "system" field.
+func SystemContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldSystem, v))
+}
+
+// FirstPaymentDateEQ applies the EQ predicate on the "first_payment_date" field.
+func FirstPaymentDateEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldFirstPaymentDate, v))
+}
+
+// FirstPaymentDateNEQ applies the NEQ predicate on the "first_payment_date" field.
+func FirstPaymentDateNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldFirstPaymentDate, v))
+}
+
+// FirstPaymentDateIn applies the In predicate on the "first_payment_date" field.
+func FirstPaymentDateIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldFirstPaymentDate, vs...))
+}
+
+// FirstPaymentDateNotIn applies the NotIn predicate on the "first_payment_date" field.
+func FirstPaymentDateNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldFirstPaymentDate, vs...))
+}
+
+// FirstPaymentDateGT applies the GT predicate on the "first_payment_date" field.
+func FirstPaymentDateGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldFirstPaymentDate, v))
+}
+
+// FirstPaymentDateGTE applies the GTE predicate on the "first_payment_date" field.
+func FirstPaymentDateGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldFirstPaymentDate, v))
+}
+
+// FirstPaymentDateLT applies the LT predicate on the "first_payment_date" field.
+func FirstPaymentDateLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldFirstPaymentDate, v))
+}
+
+// FirstPaymentDateLTE applies the LTE predicate on the "first_payment_date" field.
+func FirstPaymentDateLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldFirstPaymentDate, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldAccountID))
+}
+
+// InterestCategoryIDEQ applies the EQ predicate on the "interest_category_id" field.
+func InterestCategoryIDEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldInterestCategoryID, v))
+}
+
+// InterestCategoryIDNEQ applies the NEQ predicate on the "interest_category_id" field.
+func InterestCategoryIDNEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldInterestCategoryID, v))
+}
+
+// InterestCategoryIDIn applies the In predicate on the "interest_category_id" field.
+func InterestCategoryIDIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldInterestCategoryID, vs...))
+}
+
+// InterestCategoryIDNotIn applies the NotIn predicate on the "interest_category_id" field.
+func InterestCategoryIDNotIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldInterestCategoryID, vs...))
+}
+
+// InterestCategoryIDIsNil applies the IsNil predicate on the "interest_category_id" field.
+func InterestCategoryIDIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldInterestCategoryID))
+}
+
+// InterestCategoryIDNotNil applies the NotNil predicate on the "interest_category_id" field.
+func InterestCategoryIDNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldInterestCategoryID))
+}
+
+// PrincipalCategoryIDEQ applies the EQ predicate on the "principal_category_id" field.
+func PrincipalCategoryIDEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldPrincipalCategoryID, v))
+}
+
+// PrincipalCategoryIDNEQ applies the NEQ predicate on the "principal_category_id" field.
+func PrincipalCategoryIDNEQ(v uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldPrincipalCategoryID, v))
+}
+
+// PrincipalCategoryIDIn applies the In predicate on the "principal_category_id" field.
+func PrincipalCategoryIDIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldPrincipalCategoryID, vs...))
+}
+
+// PrincipalCategoryIDNotIn applies the NotIn predicate on the "principal_category_id" field.
+func PrincipalCategoryIDNotIn(vs ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldPrincipalCategoryID, vs...))
+}
+
+// PrincipalCategoryIDIsNil applies the IsNil predicate on the "principal_category_id" field.
+func PrincipalCategoryIDIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldPrincipalCategoryID))
+}
+
+// PrincipalCategoryIDNotNil applies the NotNil predicate on the "principal_category_id" field.
+func PrincipalCategoryIDNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldPrincipalCategoryID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInterestCategory applies the HasEdge predicate on the "interest_category" edge.
+func HasInterestCategory() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InterestCategoryTable, InterestCategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInterestCategoryWith applies the HasEdge predicate on the "interest_category" edge with a given conditions (other predicates).
+func HasInterestCategoryWith(preds ...predicate.Category) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newInterestCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrincipalCategory applies the HasEdge predicate on the "principal_category" edge.
+func HasPrincipalCategory() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PrincipalCategoryTable, PrincipalCategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrincipalCategoryWith applies the HasEdge predicate on the "principal_category" edge with a given conditions (other predicates).
+func HasPrincipalCategoryWith(preds ...predicate.Category) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newPrincipalCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInstallments applies the HasEdge predicate on the "installments" edge.
+func HasInstallments() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, InstallmentsTable, InstallmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInstallmentsWith applies the HasEdge predicate on the "installments" edge with a given conditions (other predicates).
+func HasInstallmentsWith(preds ...predicate.LoanInstallment) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newInstallmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrepayments applies the HasEdge predicate on the "prepayments" edge.
+func HasPrepayments() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PrepaymentsTable, PrepaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrepaymentsWith applies the HasEdge predicate on the "prepayments" edge with a given conditions (other predicates).
+func HasPrepaymentsWith(preds ...predicate.LoanPrepayment) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newPrepaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.NotPredicates(p))
+}
@@ -0,0 +1,1477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/loanprepayment"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoanCreate is the builder for creating a Loan entity.
+type LoanCreate struct {
+	config
+	mutation *LoanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoanCreate) SetCreatedAt(v time.Time) *LoanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableCreatedAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LoanCreate) SetUpdatedAt(v time.Time) *LoanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableUpdatedAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPrincipal sets the "principal" field.
+func (_c *LoanCreate) SetPrincipal(v domain.Money) *LoanCreate {
+	_c.mutation.SetPrincipal(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LoanCreate) SetName(v string) *LoanCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetInterestRate sets the "interest_rate" field.
+func (_c *LoanCreate) SetInterestRate(v float64) *LoanCreate {
+	_c.mutation.SetInterestRate(v)
+	return _c
+}
+
+// SetRatePeriod sets the "rate_period" field.
+func (_c *LoanCreate) SetRatePeriod(v string) *LoanCreate {
+	_c.mutation.SetRatePeriod(v)
+	return _c
+}
+
+// SetNillableRatePeriod sets the "rate_period" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableRatePeriod(v *string) *LoanCreate {
+	if v != nil {
+		_c.SetRatePeriod(*v)
+	}
+	return _c
+}
+
+// SetTermMonths sets the "term_months" field.
+func (_c *LoanCreate) SetTermMonths(v int) *LoanCreate {
+	_c.mutation.SetTermMonths(v)
+	return _c
+}
+
+// SetSystem sets the "system" field.
+func (_c *LoanCreate) SetSystem(v string) *LoanCreate {
+	_c.mutation.SetSystem(v)
+	return _c
+}
+
+// SetFirstPaymentDate sets the "first_payment_date" field.
+func (_c *LoanCreate) SetFirstPaymentDate(v time.Time) *LoanCreate {
+	_c.mutation.SetFirstPaymentDate(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *LoanCreate) SetCurrency(v string) *LoanCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableCurrency(v *string) *LoanCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *LoanCreate) SetAccountID(v uuid.UUID) *LoanCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableAccountID(v *uuid.UUID) *LoanCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetInterestCategoryID sets the "interest_category_id" field.
+func (_c *LoanCreate) SetInterestCategoryID(v uuid.UUID) *LoanCreate {
+	_c.mutation.SetInterestCategoryID(v)
+	return _c
+}
+
+// SetNillableInterestCategoryID sets the "interest_category_id" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableInterestCategoryID(v *uuid.UUID) *LoanCreate {
+	if v != nil {
+		_c.SetInterestCategoryID(*v)
+	}
+	return _c
+}
+
+// SetPrincipalCategoryID sets the "principal_category_id" field.
+func (_c *LoanCreate) SetPrincipalCategoryID(v uuid.UUID) *LoanCreate {
+	_c.mutation.SetPrincipalCategoryID(v)
+	return _c
+}
+
+// SetNillablePrincipalCategoryID sets the "principal_category_id" field if the given value is not nil.
+func (_c *LoanCreate) SetNillablePrincipalCategoryID(v *uuid.UUID) *LoanCreate {
+	if v != nil {
+		_c.SetPrincipalCategoryID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoanCreate) SetID(v uuid.UUID) *LoanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableID(v *uuid.UUID) *LoanCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *LoanCreate) SetLedgerID(id uuid.UUID) *LoanCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *LoanCreate) SetLedger(v *Ledger) *LoanCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *LoanCreate) SetAccount(v *Account) *LoanCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetInterestCategory sets the "interest_category" edge to the Category entity.
+func (_c *LoanCreate) SetInterestCategory(v *Category) *LoanCreate {
+	return _c.SetInterestCategoryID(v.ID)
+}
+
+// SetPrincipalCategory sets the "principal_category" edge to the Category entity.
+func (_c *LoanCreate) SetPrincipalCategory(v *Category) *LoanCreate {
+	return _c.SetPrincipalCategoryID(v.ID)
+}
+
+// AddInstallmentIDs adds the "installments" edge to the LoanInstallment entity by IDs.
+func (_c *LoanCreate) AddInstallmentIDs(ids ...uuid.UUID) *LoanCreate {
+	_c.mutation.AddInstallmentIDs(ids...)
+	return _c
+}
+
+// AddInstallments adds the "installments" edges to the LoanInstallment entity.
+func (_c *LoanCreate) AddInstallments(v ...*LoanInstallment) *LoanCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInstallmentIDs(ids...)
+}
+
+// AddPrepaymentIDs adds the "prepayments" edge to the LoanPrepayment entity by IDs.
+func (_c *LoanCreate) AddPrepaymentIDs(ids ...uuid.UUID) *LoanCreate {
+	_c.mutation.AddPrepaymentIDs(ids...)
+	return _c
+}
+
+// AddPrepayments adds the "prepayments" edges to the LoanPrepayment entity.
+func (_c *LoanCreate) AddPrepayments(v ...*LoanPrepayment) *LoanCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPrepaymentIDs(ids...)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (_c *LoanCreate) Mutation() *LoanMutation {
+	return _c.mutation
+}
+
+// Save creates the Loan in the database.
+func (_c *LoanCreate) Save(ctx context.Context) (*Loan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoanCreate) SaveX(ctx context.Context) *Loan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoanCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loan.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := loan.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RatePeriod(); !ok {
+		v := loan.DefaultRatePeriod
+		_c.mutation.SetRatePeriod(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := loan.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := loan.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoanCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Loan.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Loan.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Principal(); !ok {
+		return &ValidationError{Name: "principal", err: errors.New(`ent: missing required field "Loan.principal"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Loan.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := loan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Loan.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InterestRate(); !ok {
+		return &ValidationError{Name: "interest_rate", err: errors.New(`ent: missing required field "Loan.interest_rate"`)}
+	}
+	if v, ok := _c.mutation.InterestRate(); ok {
+		if err := loan.InterestRateValidator(v); err != nil {
+			return &ValidationError{Name: "interest_rate", err: fmt.Errorf(`ent: validator failed for field "Loan.interest_rate": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RatePeriod(); !ok {
+		return &ValidationError{Name: "rate_period", err: errors.New(`ent: missing required field "Loan.rate_period"`)}
+	}
+	if v, ok := _c.mutation.RatePeriod(); ok {
+		if err := loan.RatePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "rate_period", err: fmt.Errorf(`ent: validator failed for field "Loan.rate_period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TermMonths(); !ok {
+		return &ValidationError{Name: "term_months", err: errors.New(`ent: missing required field "Loan.term_months"`)}
+	}
+	if v, ok := _c.mutation.TermMonths(); ok {
+		if err := loan.TermMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "term_months", err: fmt.Errorf(`ent: validator failed for field "Loan.term_months": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.System(); !ok {
+		return &ValidationError{Name: "system", err: errors.New(`ent: missing required field "Loan.system"`)}
+	}
+	if v, ok := _c.mutation.System(); ok {
+		if err := loan.SystemValidator(v); err != nil {
+			return &ValidationError{Name: "system", err: fmt.Errorf(`ent: validator failed for field "Loan.system": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstPaymentDate(); !ok {
+		return &ValidationError{Name: "first_payment_date", err: errors.New(`ent: missing required field "Loan.first_payment_date"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Loan.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := loan.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Loan.currency": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Loan.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *LoanCreate) sqlSave(ctx context.Context) (*Loan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoanCreate) createSpec() (*Loan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Loan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loan.Table, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(loan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Principal(); ok {
+		_spec.SetField(loan.FieldPrincipal, field.TypeInt64, value)
+		_node.Principal = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(loan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.InterestRate(); ok {
+		_spec.SetField(loan.FieldInterestRate, field.TypeFloat64, value)
+		_node.InterestRate = value
+	}
+	if value, ok := _c.mutation.RatePeriod(); ok {
+		_spec.SetField(loan.FieldRatePeriod, field.TypeString, value)
+		_node.RatePeriod = value
+	}
+	if value, ok := _c.mutation.TermMonths(); ok {
+		_spec.SetField(loan.FieldTermMonths, field.TypeInt, value)
+		_node.TermMonths = value
+	}
+	if value, ok := _c.mutation.System(); ok {
+		_spec.SetField(loan.FieldSystem, field.TypeString, value)
+		_node.System = value
+	}
+	if value, ok := _c.mutation.FirstPaymentDate(); ok {
+		_spec.SetField(loan.FieldFirstPaymentDate, field.TypeTime, value)
+		_node.FirstPaymentDate = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(loan.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.LedgerTable,
+			Columns: []string{loan.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.AccountTable,
+			Columns: []string{loan.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InterestCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.InterestCategoryTable,
+			Columns: []string{loan.InterestCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InterestCategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PrincipalCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.PrincipalCategoryTable,
+			Columns: []string{loan.PrincipalCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PrincipalCategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InstallmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PrepaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Loan.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoanUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoanCreate) OnConflict(opts ...sql.ConflictOption) *LoanUpsertOne {
+	_c.conflict = opts
+	return &LoanUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Loan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoanCreate) OnConflictColumns(columns ...string) *LoanUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoanUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LoanUpsertOne is the builder for "upsert"-ing
+	//  one Loan node.
+	LoanUpsertOne struct {
+		create *LoanCreate
+	}
+
+	// LoanUpsert is the "OnConflict" setter.
+	LoanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanUpsert) SetUpdatedAt(v time.Time) *LoanUpsert {
+	u.Set(loan.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateUpdatedAt() *LoanUpsert {
+	u.SetExcluded(loan.FieldUpdatedAt)
+	return u
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *LoanUpsert) SetPrincipal(v domain.Money) *LoanUpsert {
+	u.Set(loan.FieldPrincipal, v)
+	return u
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *LoanUpsert) UpdatePrincipal() *LoanUpsert {
+	u.SetExcluded(loan.FieldPrincipal)
+	return u
+}
+
+// AddPrincipal adds v to the "principal" field.
+func (u *LoanUpsert) AddPrincipal(v domain.Money) *LoanUpsert {
+	u.Add(loan.FieldPrincipal, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LoanUpsert) SetName(v string) *LoanUpsert {
+	u.Set(loan.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateName() *LoanUpsert {
+	u.SetExcluded(loan.FieldName)
+	return u
+}
+
+// SetInterestRate sets the "interest_rate" field.
+func (u *LoanUpsert) SetInterestRate(v float64) *LoanUpsert {
+	u.Set(loan.FieldInterestRate, v)
+	return u
+}
+
+// UpdateInterestRate sets the "interest_rate" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateInterestRate() *LoanUpsert {
+	u.SetExcluded(loan.FieldInterestRate)
+	return u
+}
+
+// AddInterestRate adds v to the "interest_rate" field.
+func (u *LoanUpsert) AddInterestRate(v float64) *LoanUpsert {
+	u.Add(loan.FieldInterestRate, v)
+	return u
+}
+
+// SetRatePeriod sets the "rate_period" field.
+func (u *LoanUpsert) SetRatePeriod(v string) *LoanUpsert {
+	u.Set(loan.FieldRatePeriod, v)
+	return u
+}
+
+// UpdateRatePeriod sets the "rate_period" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateRatePeriod() *LoanUpsert {
+	u.SetExcluded(loan.FieldRatePeriod)
+	return u
+}
+
+// SetTermMonths sets the "term_months" field.
+func (u *LoanUpsert) SetTermMonths(v int) *LoanUpsert {
+	u.Set(loan.FieldTermMonths, v)
+	return u
+}
+
+// UpdateTermMonths sets the "term_months" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateTermMonths() *LoanUpsert {
+	u.SetExcluded(loan.FieldTermMonths)
+	return u
+}
+
+// AddTermMonths adds v to the "term_months" field.
+func (u *LoanUpsert) AddTermMonths(v int) *LoanUpsert {
+	u.Add(loan.FieldTermMonths, v)
+	return u
+}
+
+// SetSystem sets the "system" field.
+func (u *LoanUpsert) SetSystem(v string) *LoanUpsert {
+	u.Set(loan.FieldSystem, v)
+	return u
+}
+
+// UpdateSystem sets the "system" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateSystem() *LoanUpsert {
+	u.SetExcluded(loan.FieldSystem)
+	return u
+}
+
+// SetFirstPaymentDate sets the "first_payment_date" field.
+func (u *LoanUpsert) SetFirstPaymentDate(v time.Time) *LoanUpsert {
+	u.Set(loan.FieldFirstPaymentDate, v)
+	return u
+}
+
+// UpdateFirstPaymentDate sets the "first_payment_date" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateFirstPaymentDate() *LoanUpsert {
+	u.SetExcluded(loan.FieldFirstPaymentDate)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *LoanUpsert) SetCurrency(v string) *LoanUpsert {
+	u.Set(loan.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateCurrency() *LoanUpsert {
+	u.SetExcluded(loan.FieldCurrency)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *LoanUpsert) SetAccountID(v uuid.UUID) *LoanUpsert {
+	u.Set(loan.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateAccountID() *LoanUpsert {
+	u.SetExcluded(loan.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *LoanUpsert) ClearAccountID() *LoanUpsert {
+	u.SetNull(loan.FieldAccountID)
+	return u
+}
+
+// SetInterestCategoryID sets the "interest_category_id" field.
+func (u *LoanUpsert) SetInterestCategoryID(v uuid.UUID) *LoanUpsert {
+	u.Set(loan.FieldInterestCategoryID, v)
+	return u
+}
+
+// UpdateInterestCategoryID sets the "interest_category_id" field to the value that was provided on create.
+func (u *LoanUpsert) UpdateInterestCategoryID() *LoanUpsert {
+	u.SetExcluded(loan.FieldInterestCategoryID)
+	return u
+}
+
+// ClearInterestCategoryID clears the value of the "interest_category_id" field.
+func (u *LoanUpsert) ClearInterestCategoryID() *LoanUpsert {
+	u.SetNull(loan.FieldInterestCategoryID)
+	return u
+}
+
+// SetPrincipalCategoryID sets the "principal_category_id" field.
+func (u *LoanUpsert) SetPrincipalCategoryID(v uuid.UUID) *LoanUpsert {
+	u.Set(loan.FieldPrincipalCategoryID, v)
+	return u
+}
+
+// UpdatePrincipalCategoryID sets the "principal_category_id" field to the value that was provided on create.
+func (u *LoanUpsert) UpdatePrincipalCategoryID() *LoanUpsert {
+	u.SetExcluded(loan.FieldPrincipalCategoryID)
+	return u
+}
+
+// ClearPrincipalCategoryID clears the value of the "principal_category_id" field.
+func (u *LoanUpsert) ClearPrincipalCategoryID() *LoanUpsert {
+	u.SetNull(loan.FieldPrincipalCategoryID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Loan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoanUpsertOne) UpdateNewValues() *LoanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(loan.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(loan.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Loan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoanUpsertOne) Ignore() *LoanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoanUpsertOne) DoNothing() *LoanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoanCreate.OnConflict
+// documentation for more info.
+func (u *LoanUpsertOne) Update(set func(*LoanUpsert)) *LoanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanUpsertOne) SetUpdatedAt(v time.Time) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateUpdatedAt() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *LoanUpsertOne) SetPrincipal(v domain.Money) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetPrincipal(v)
+	})
+}
+
+// AddPrincipal adds v to the "principal" field.
+func (u *LoanUpsertOne) AddPrincipal(v domain.Money) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.AddPrincipal(v)
+	})
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdatePrincipal() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdatePrincipal()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LoanUpsertOne) SetName(v string) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateName() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetInterestRate sets the "interest_rate" field.
+func (u *LoanUpsertOne) SetInterestRate(v float64) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetInterestRate(v)
+	})
+}
+
+// AddInterestRate adds v to the "interest_rate" field.
+func (u *LoanUpsertOne) AddInterestRate(v float64) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.AddInterestRate(v)
+	})
+}
+
+// UpdateInterestRate sets the "interest_rate" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateInterestRate() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateInterestRate()
+	})
+}
+
+// SetRatePeriod sets the "rate_period" field.
+func (u *LoanUpsertOne) SetRatePeriod(v string) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetRatePeriod(v)
+	})
+}
+
+// UpdateRatePeriod sets the "rate_period" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateRatePeriod() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateRatePeriod()
+	})
+}
+
+// SetTermMonths sets the "term_months" field.
+func (u *LoanUpsertOne) SetTermMonths(v int) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetTermMonths(v)
+	})
+}
+
+// AddTermMonths adds v to the "term_months" field.
+func (u *LoanUpsertOne) AddTermMonths(v int) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.AddTermMonths(v)
+	})
+}
+
+// UpdateTermMonths sets the "term_months" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateTermMonths() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateTermMonths()
+	})
+}
+
+// SetSystem sets the "system" field.
+func (u *LoanUpsertOne) SetSystem(v string) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetSystem(v)
+	})
+}
+
+// UpdateSystem sets the "system" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateSystem() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateSystem()
+	})
+}
+
+// SetFirstPaymentDate sets the "first_payment_date" field.
+func (u *LoanUpsertOne) SetFirstPaymentDate(v time.Time) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetFirstPaymentDate(v)
+	})
+}
+
+// UpdateFirstPaymentDate sets the "first_payment_date" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateFirstPaymentDate() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateFirstPaymentDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *LoanUpsertOne) SetCurrency(v string) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateCurrency() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *LoanUpsertOne) SetAccountID(v uuid.UUID) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateAccountID() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *LoanUpsertOne) ClearAccountID() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetInterestCategoryID sets the "interest_category_id" field.
+func (u *LoanUpsertOne) SetInterestCategoryID(v uuid.UUID) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetInterestCategoryID(v)
+	})
+}
+
+// UpdateInterestCategoryID sets the "interest_category_id" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdateInterestCategoryID() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateInterestCategoryID()
+	})
+}
+
+// ClearInterestCategoryID clears the value of the "interest_category_id" field.
+func (u *LoanUpsertOne) ClearInterestCategoryID() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.ClearInterestCategoryID()
+	})
+}
+
+// SetPrincipalCategoryID sets the "principal_category_id" field.
+func (u *LoanUpsertOne) SetPrincipalCategoryID(v uuid.UUID) *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetPrincipalCategoryID(v)
+	})
+}
+
+// UpdatePrincipalCategoryID sets the "principal_category_id" field to the value that was provided on create.
+func (u *LoanUpsertOne) UpdatePrincipalCategoryID() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdatePrincipalCategoryID()
+	})
+}
+
+// ClearPrincipalCategoryID clears the value of the "principal_category_id" field.
+func (u *LoanUpsertOne) ClearPrincipalCategoryID() *LoanUpsertOne {
+	return u.Update(func(s *LoanUpsert) {
+		s.ClearPrincipalCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *LoanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoanUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LoanUpsertOne.ID is not supported by MySQL driver. Use LoanUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoanUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoanCreateBulk is the builder for creating many Loan entities in bulk.
+type LoanCreateBulk struct {
+	config
+	err      error
+	builders []*LoanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Loan entities in the database.
+func (_c *LoanCreateBulk) Save(ctx context.Context) ([]*Loan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Loan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoanCreateBulk) SaveX(ctx context.Context) []*Loan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Loan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoanUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoanCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoanUpsertBulk {
+	_c.conflict = opts
+	return &LoanUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Loan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoanCreateBulk) OnConflictColumns(columns ...string) *LoanUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoanUpsertBulk{
+		create: _c,
+	}
+}
+
+// LoanUpsertBulk is the builder for "upsert"-ing
+// a bulk of Loan nodes.
+type LoanUpsertBulk struct {
+	create *LoanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Loan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoanUpsertBulk) UpdateNewValues() *LoanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(loan.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(loan.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Loan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoanUpsertBulk) Ignore() *LoanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoanUpsertBulk) DoNothing() *LoanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoanCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoanUpsertBulk) Update(set func(*LoanUpsert)) *LoanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanUpsertBulk) SetUpdatedAt(v time.Time) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateUpdatedAt() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *LoanUpsertBulk) SetPrincipal(v domain.Money) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetPrincipal(v)
+	})
+}
+
+// AddPrincipal adds v to the "principal" field.
+func (u *LoanUpsertBulk) AddPrincipal(v domain.Money) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.AddPrincipal(v)
+	})
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdatePrincipal() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdatePrincipal()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LoanUpsertBulk) SetName(v string) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateName() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetInterestRate sets the "interest_rate" field.
+func (u *LoanUpsertBulk) SetInterestRate(v float64) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetInterestRate(v)
+	})
+}
+
+// AddInterestRate adds v to the "interest_rate" field.
+func (u *LoanUpsertBulk) AddInterestRate(v float64) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.AddInterestRate(v)
+	})
+}
+
+// UpdateInterestRate sets the "interest_rate" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateInterestRate() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateInterestRate()
+	})
+}
+
+// SetRatePeriod sets the "rate_period" field.
+func (u *LoanUpsertBulk) SetRatePeriod(v string) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetRatePeriod(v)
+	})
+}
+
+// UpdateRatePeriod sets the "rate_period" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateRatePeriod() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateRatePeriod()
+	})
+}
+
+// SetTermMonths sets the "term_months" field.
+func (u *LoanUpsertBulk) SetTermMonths(v int) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetTermMonths(v)
+	})
+}
+
+// AddTermMonths adds v to the "term_months" field.
+func (u *LoanUpsertBulk) AddTermMonths(v int) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.AddTermMonths(v)
+	})
+}
+
+// UpdateTermMonths sets the "term_months" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateTermMonths() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateTermMonths()
+	})
+}
+
+// SetSystem sets the "system" field.
+func (u *LoanUpsertBulk) SetSystem(v string) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetSystem(v)
+	})
+}
+
+// UpdateSystem sets the "system" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateSystem() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateSystem()
+	})
+}
+
+// SetFirstPaymentDate sets the "first_payment_date" field.
+func (u *LoanUpsertBulk) SetFirstPaymentDate(v time.Time) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetFirstPaymentDate(v)
+	})
+}
+
+// UpdateFirstPaymentDate sets the "first_payment_date" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateFirstPaymentDate() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateFirstPaymentDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *LoanUpsertBulk) SetCurrency(v string) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateCurrency() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *LoanUpsertBulk) SetAccountID(v uuid.UUID) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateAccountID() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *LoanUpsertBulk) ClearAccountID() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetInterestCategoryID sets the "interest_category_id" field.
+func (u *LoanUpsertBulk) SetInterestCategoryID(v uuid.UUID) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetInterestCategoryID(v)
+	})
+}
+
+// UpdateInterestCategoryID sets the "interest_category_id" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdateInterestCategoryID() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdateInterestCategoryID()
+	})
+}
+
+// ClearInterestCategoryID clears the value of the "interest_category_id" field.
+func (u *LoanUpsertBulk) ClearInterestCategoryID() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.ClearInterestCategoryID()
+	})
+}
+
+// SetPrincipalCategoryID sets the "principal_category_id" field.
+func (u *LoanUpsertBulk) SetPrincipalCategoryID(v uuid.UUID) *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.SetPrincipalCategoryID(v)
+	})
+}
+
+// UpdatePrincipalCategoryID sets the "principal_category_id" field to the value that was provided on create.
+func (u *LoanUpsertBulk) UpdatePrincipalCategoryID() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.UpdatePrincipalCategoryID()
+	})
+}
+
+// ClearPrincipalCategoryID clears the value of the "principal_category_id" field.
+func (u *LoanUpsertBulk) ClearPrincipalCategoryID() *LoanUpsertBulk {
+	return u.Update(func(s *LoanUpsert) {
+		s.ClearPrincipalCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *LoanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
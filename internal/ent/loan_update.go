@@ -0,0 +1,1409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoanUpdate is the builder for updating Loan entities.
+type LoanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanMutation
+}
+
+// Where appends a list predicates to the LoanUpdate builder.
+func (_u *LoanUpdate) Where(ps ...predicate.Loan) *LoanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanUpdate) SetUpdatedAt(v time.Time) *LoanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrincipal sets the "principal" field.
+func (_u *LoanUpdate) SetPrincipal(v domain.Money) *LoanUpdate {
+	_u.mutation.ResetPrincipal()
+	_u.mutation.SetPrincipal(v)
+	return _u
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillablePrincipal(v *domain.Money) *LoanUpdate {
+	if v != nil {
+		_u.SetPrincipal(*v)
+	}
+	return _u
+}
+
+// AddPrincipal adds value to the "principal" field.
+func (_u *LoanUpdate) AddPrincipal(v domain.Money) *LoanUpdate {
+	_u.mutation.AddPrincipal(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LoanUpdate) SetName(v string) *LoanUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableName(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetInterestRate sets the "interest_rate" field.
+func (_u *LoanUpdate) SetInterestRate(v float64) *LoanUpdate {
+	_u.mutation.ResetInterestRate()
+	_u.mutation.SetInterestRate(v)
+	return _u
+}
+
+// SetNillableInterestRate sets the "interest_rate" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableInterestRate(v *float64) *LoanUpdate {
+	if v != nil {
+		_u.SetInterestRate(*v)
+	}
+	return _u
+}
+
+// AddInterestRate adds value to the "interest_rate" field.
+func (_u *LoanUpdate) AddInterestRate(v float64) *LoanUpdate {
+	_u.mutation.AddInterestRate(v)
+	return _u
+}
+
+// SetRatePeriod sets the "rate_period" field.
+func (_u *LoanUpdate) SetRatePeriod(v string) *LoanUpdate {
+	_u.mutation.SetRatePeriod(v)
+	return _u
+}
+
+// SetNillableRatePeriod sets the "rate_period" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableRatePeriod(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetRatePeriod(*v)
+	}
+	return _u
+}
+
+// SetTermMonths sets the "term_months" field.
+func (_u *LoanUpdate) SetTermMonths(v int) *LoanUpdate {
+	_u.mutation.ResetTermMonths()
+	_u.mutation.SetTermMonths(v)
+	return _u
+}
+
+// SetNillableTermMonths sets the "term_months" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableTermMonths(v *int) *LoanUpdate {
+	if v != nil {
+		_u.SetTermMonths(*v)
+	}
+	return _u
+}
+
+// AddTermMonths adds value to the "term_months" field.
+func (_u *LoanUpdate) AddTermMonths(v int) *LoanUpdate {
+	_u.mutation.AddTermMonths(v)
+	return _u
+}
+
+// SetSystem sets the "system" field.
+func (_u *LoanUpdate) SetSystem(v string) *LoanUpdate {
+	_u.mutation.SetSystem(v)
+	return _u
+}
+
+// SetNillableSystem sets the "system" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableSystem(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetSystem(*v)
+	}
+	return _u
+}
+
+// SetFirstPaymentDate sets the "first_payment_date" field.
+func (_u *LoanUpdate) SetFirstPaymentDate(v time.Time) *LoanUpdate {
+	_u.mutation.SetFirstPaymentDate(v)
+	return _u
+}
+
+// SetNillableFirstPaymentDate sets the "first_payment_date" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableFirstPaymentDate(v *time.Time) *LoanUpdate {
+	if v != nil {
+		_u.SetFirstPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *LoanUpdate) SetCurrency(v string) *LoanUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableCurrency(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *LoanUpdate) SetAccountID(v uuid.UUID) *LoanUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableAccountID(v *uuid.UUID) *LoanUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *LoanUpdate) ClearAccountID() *LoanUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetInterestCategoryID sets the "interest_category_id" field.
+func (_u *LoanUpdate) SetInterestCategoryID(v uuid.UUID) *LoanUpdate {
+	_u.mutation.SetInterestCategoryID(v)
+	return _u
+}
+
+// SetNillableInterestCategoryID sets the "interest_category_id" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableInterestCategoryID(v *uuid.UUID) *LoanUpdate {
+	if v != nil {
+		_u.SetInterestCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearInterestCategoryID clears the value of the "interest_category_id" field.
+func (_u *LoanUpdate) ClearInterestCategoryID() *LoanUpdate {
+	_u.mutation.ClearInterestCategoryID()
+	return _u
+}
+
+// SetPrincipalCategoryID sets the "principal_category_id" field.
+func (_u *LoanUpdate) SetPrincipalCategoryID(v uuid.UUID) *LoanUpdate {
+	_u.mutation.SetPrincipalCategoryID(v)
+	return _u
+}
+
+// SetNillablePrincipalCategoryID sets the "principal_category_id" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillablePrincipalCategoryID(v *uuid.UUID) *LoanUpdate {
+	if v != nil {
+		_u.SetPrincipalCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearPrincipalCategoryID clears the value of the "principal_category_id" field.
+func (_u *LoanUpdate) ClearPrincipalCategoryID() *LoanUpdate {
+	_u.mutation.ClearPrincipalCategoryID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *LoanUpdate) SetLedgerID(id uuid.UUID) *LoanUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LoanUpdate) SetLedger(v *Ledger) *LoanUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *LoanUpdate) SetAccount(v *Account) *LoanUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetInterestCategory sets the "interest_category" edge to the Category entity.
+func (_u *LoanUpdate) SetInterestCategory(v *Category) *LoanUpdate {
+	return _u.SetInterestCategoryID(v.ID)
+}
+
+// SetPrincipalCategory sets the "principal_category" edge to the Category entity.
+func (_u *LoanUpdate) SetPrincipalCategory(v *Category) *LoanUpdate {
+	return _u.SetPrincipalCategoryID(v.ID)
+}
+
+// AddInstallmentIDs adds the "installments" edge to the LoanInstallment entity by IDs.
+func (_u *LoanUpdate) AddInstallmentIDs(ids ...uuid.UUID) *LoanUpdate {
+	_u.mutation.AddInstallmentIDs(ids...)
+	return _u
+}
+
+// AddInstallments adds the "installments" edges to the LoanInstallment entity.
+func (_u *LoanUpdate) AddInstallments(v ...*LoanInstallment) *LoanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInstallmentIDs(ids...)
+}
+
+// AddPrepaymentIDs adds the "prepayments" edge to the LoanPrepayment entity by IDs.
+func (_u *LoanUpdate) AddPrepaymentIDs(ids ...uuid.UUID) *LoanUpdate {
+	_u.mutation.AddPrepaymentIDs(ids...)
+	return _u
+}
+
+// AddPrepayments adds the "prepayments" edges to the LoanPrepayment entity.
+func (_u *LoanUpdate) AddPrepayments(v ...*LoanPrepayment) *LoanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrepaymentIDs(ids...)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (_u *LoanUpdate) Mutation() *LoanMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LoanUpdate) ClearLedger() *LoanUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *LoanUpdate) ClearAccount() *LoanUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearInterestCategory clears the "interest_category" edge to the Category entity.
+func (_u *LoanUpdate) ClearInterestCategory() *LoanUpdate {
+	_u.mutation.ClearInterestCategory()
+	return _u
+}
+
+// ClearPrincipalCategory clears the "principal_category" edge to the Category entity.
+func (_u *LoanUpdate) ClearPrincipalCategory() *LoanUpdate {
+	_u.mutation.ClearPrincipalCategory()
+	return _u
+}
+
+// ClearInstallments clears all "installments" edges to the LoanInstallment entity.
+func (_u *LoanUpdate) ClearInstallments() *LoanUpdate {
+	_u.mutation.ClearInstallments()
+	return _u
+}
+
+// RemoveInstallmentIDs removes the "installments" edge to LoanInstallment entities by IDs.
+func (_u *LoanUpdate) RemoveInstallmentIDs(ids ...uuid.UUID) *LoanUpdate {
+	_u.mutation.RemoveInstallmentIDs(ids...)
+	return _u
+}
+
+// RemoveInstallments removes "installments" edges to LoanInstallment entities.
+func (_u *LoanUpdate) RemoveInstallments(v ...*LoanInstallment) *LoanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInstallmentIDs(ids...)
+}
+
+// ClearPrepayments clears all "prepayments" edges to the LoanPrepayment entity.
+func (_u *LoanUpdate) ClearPrepayments() *LoanUpdate {
+	_u.mutation.ClearPrepayments()
+	return _u
+}
+
+// RemovePrepaymentIDs removes the "prepayments" edge to LoanPrepayment entities by IDs.
+func (_u *LoanUpdate) RemovePrepaymentIDs(ids ...uuid.UUID) *LoanUpdate {
+	_u.mutation.RemovePrepaymentIDs(ids...)
+	return _u
+}
+
+// RemovePrepayments removes "prepayments" edges to LoanPrepayment entities.
+func (_u *LoanUpdate) RemovePrepayments(v ...*LoanPrepayment) *LoanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrepaymentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoanUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := loan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Loan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InterestRate(); ok {
+		if err := loan.InterestRateValidator(v); err != nil {
+			return &ValidationError{Name: "interest_rate", err: fmt.Errorf(`ent: validator failed for field "Loan.interest_rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RatePeriod(); ok {
+		if err := loan.RatePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "rate_period", err: fmt.Errorf(`ent: validator failed for field "Loan.rate_period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TermMonths(); ok {
+		if err := loan.TermMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "term_months", err: fmt.Errorf(`ent: validator failed for field "Loan.term_months": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.System(); ok {
+		if err := loan.SystemValidator(v); err != nil {
+			return &ValidationError{Name: "system", err: fmt.Errorf(`ent: validator failed for field "Loan.system": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := loan.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Loan.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Loan.ledger"`)
+	}
+	return nil
+}
+
+func (_u *LoanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Principal(); ok {
+		_spec.SetField(loan.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrincipal(); ok {
+		_spec.AddField(loan.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(loan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InterestRate(); ok {
+		_spec.SetField(loan.FieldInterestRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInterestRate(); ok {
+		_spec.AddField(loan.FieldInterestRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RatePeriod(); ok {
+		_spec.SetField(loan.FieldRatePeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TermMonths(); ok {
+		_spec.SetField(loan.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTermMonths(); ok {
+		_spec.AddField(loan.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.System(); ok {
+		_spec.SetField(loan.FieldSystem, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FirstPaymentDate(); ok {
+		_spec.SetField(loan.FieldFirstPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(loan.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.LedgerTable,
+			Columns: []string{loan.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.LedgerTable,
+			Columns: []string{loan.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.AccountTable,
+			Columns: []string{loan.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.AccountTable,
+			Columns: []string{loan.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InterestCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.InterestCategoryTable,
+			Columns: []string{loan.InterestCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InterestCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.InterestCategoryTable,
+			Columns: []string{loan.InterestCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrincipalCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.PrincipalCategoryTable,
+			Columns: []string{loan.PrincipalCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrincipalCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.PrincipalCategoryTable,
+			Columns: []string{loan.PrincipalCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInstallmentsIDs(); len(nodes) > 0 && !_u.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InstallmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrepaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPrepaymentsIDs(); len(nodes) > 0 && !_u.mutation.PrepaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrepaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoanUpdateOne is the builder for updating a single Loan entity.
+type LoanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanUpdateOne) SetUpdatedAt(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrincipal sets the "principal" field.
+func (_u *LoanUpdateOne) SetPrincipal(v domain.Money) *LoanUpdateOne {
+	_u.mutation.ResetPrincipal()
+	_u.mutation.SetPrincipal(v)
+	return _u
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillablePrincipal(v *domain.Money) *LoanUpdateOne {
+	if v != nil {
+		_u.SetPrincipal(*v)
+	}
+	return _u
+}
+
+// AddPrincipal adds value to the "principal" field.
+func (_u *LoanUpdateOne) AddPrincipal(v domain.Money) *LoanUpdateOne {
+	_u.mutation.AddPrincipal(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LoanUpdateOne) SetName(v string) *LoanUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableName(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetInterestRate sets the "interest_rate" field.
+func (_u *LoanUpdateOne) SetInterestRate(v float64) *LoanUpdateOne {
+	_u.mutation.ResetInterestRate()
+	_u.mutation.SetInterestRate(v)
+	return _u
+}
+
+// SetNillableInterestRate sets the "interest_rate" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableInterestRate(v *float64) *LoanUpdateOne {
+	if v != nil {
+		_u.SetInterestRate(*v)
+	}
+	return _u
+}
+
+// AddInterestRate adds value to the "interest_rate" field.
+func (_u *LoanUpdateOne) AddInterestRate(v float64) *LoanUpdateOne {
+	_u.mutation.AddInterestRate(v)
+	return _u
+}
+
+// SetRatePeriod sets the "rate_period" field.
+func (_u *LoanUpdateOne) SetRatePeriod(v string) *LoanUpdateOne {
+	_u.mutation.SetRatePeriod(v)
+	return _u
+}
+
+// SetNillableRatePeriod sets the "rate_period" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableRatePeriod(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetRatePeriod(*v)
+	}
+	return _u
+}
+
+// SetTermMonths sets the "term_months" field.
+func (_u *LoanUpdateOne) SetTermMonths(v int) *LoanUpdateOne {
+	_u.mutation.ResetTermMonths()
+	_u.mutation.SetTermMonths(v)
+	return _u
+}
+
+// SetNillableTermMonths sets the "term_months" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableTermMonths(v *int) *LoanUpdateOne {
+	if v != nil {
+		_u.SetTermMonths(*v)
+	}
+	return _u
+}
+
+// AddTermMonths adds value to the "term_months" field.
+func (_u *LoanUpdateOne) AddTermMonths(v int) *LoanUpdateOne {
+	_u.mutation.AddTermMonths(v)
+	return _u
+}
+
+// SetSystem sets the "system" field.
+func (_u *LoanUpdateOne) SetSystem(v string) *LoanUpdateOne {
+	_u.mutation.SetSystem(v)
+	return _u
+}
+
+// SetNillableSystem sets the "system" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableSystem(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetSystem(*v)
+	}
+	return _u
+}
+
+// SetFirstPaymentDate sets the "first_payment_date" field.
+func (_u *LoanUpdateOne) SetFirstPaymentDate(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetFirstPaymentDate(v)
+	return _u
+}
+
+// SetNillableFirstPaymentDate sets the "first_payment_date" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableFirstPaymentDate(v *time.Time) *LoanUpdateOne {
+	if v != nil {
+		_u.SetFirstPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *LoanUpdateOne) SetCurrency(v string) *LoanUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableCurrency(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *LoanUpdateOne) SetAccountID(v uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableAccountID(v *uuid.UUID) *LoanUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *LoanUpdateOne) ClearAccountID() *LoanUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetInterestCategoryID sets the "interest_category_id" field.
+func (_u *LoanUpdateOne) SetInterestCategoryID(v uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetInterestCategoryID(v)
+	return _u
+}
+
+// SetNillableInterestCategoryID sets the "interest_category_id" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableInterestCategoryID(v *uuid.UUID) *LoanUpdateOne {
+	if v != nil {
+		_u.SetInterestCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearInterestCategoryID clears the value of the "interest_category_id" field.
+func (_u *LoanUpdateOne) ClearInterestCategoryID() *LoanUpdateOne {
+	_u.mutation.ClearInterestCategoryID()
+	return _u
+}
+
+// SetPrincipalCategoryID sets the "principal_category_id" field.
+func (_u *LoanUpdateOne) SetPrincipalCategoryID(v uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetPrincipalCategoryID(v)
+	return _u
+}
+
+// SetNillablePrincipalCategoryID sets the "principal_category_id" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillablePrincipalCategoryID(v *uuid.UUID) *LoanUpdateOne {
+	if v != nil {
+		_u.SetPrincipalCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearPrincipalCategoryID clears the value of the "principal_category_id" field.
+func (_u *LoanUpdateOne) ClearPrincipalCategoryID() *LoanUpdateOne {
+	_u.mutation.ClearPrincipalCategoryID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *LoanUpdateOne) SetLedgerID(id uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LoanUpdateOne) SetLedger(v *Ledger) *LoanUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *LoanUpdateOne) SetAccount(v *Account) *LoanUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetInterestCategory sets the "interest_category" edge to the Category entity.
+func (_u *LoanUpdateOne) SetInterestCategory(v *Category) *LoanUpdateOne {
+	return _u.SetInterestCategoryID(v.ID)
+}
+
+// SetPrincipalCategory sets the "principal_category" edge to the Category entity.
+func (_u *LoanUpdateOne) SetPrincipalCategory(v *Category) *LoanUpdateOne {
+	return _u.SetPrincipalCategoryID(v.ID)
+}
+
+// AddInstallmentIDs adds the "installments" edge to the LoanInstallment entity by IDs.
+func (_u *LoanUpdateOne) AddInstallmentIDs(ids ...uuid.UUID) *LoanUpdateOne {
+	_u.mutation.AddInstallmentIDs(ids...)
+	return _u
+}
+
+// AddInstallments adds the "installments" edges to the LoanInstallment entity.
+func (_u *LoanUpdateOne) AddInstallments(v ...*LoanInstallment) *LoanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInstallmentIDs(ids...)
+}
+
+// AddPrepaymentIDs adds the "prepayments" edge to the LoanPrepayment entity by IDs.
+func (_u *LoanUpdateOne) AddPrepaymentIDs(ids ...uuid.UUID) *LoanUpdateOne {
+	_u.mutation.AddPrepaymentIDs(ids...)
+	return _u
+}
+
+// AddPrepayments adds the "prepayments" edges to the LoanPrepayment entity.
+func (_u *LoanUpdateOne) AddPrepayments(v ...*LoanPrepayment) *LoanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrepaymentIDs(ids...)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (_u *LoanUpdateOne) Mutation() *LoanMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LoanUpdateOne) ClearLedger() *LoanUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *LoanUpdateOne) ClearAccount() *LoanUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearInterestCategory clears the "interest_category" edge to the Category entity.
+func (_u *LoanUpdateOne) ClearInterestCategory() *LoanUpdateOne {
+	_u.mutation.ClearInterestCategory()
+	return _u
+}
+
+// ClearPrincipalCategory clears the "principal_category" edge to the Category entity.
+func (_u *LoanUpdateOne) ClearPrincipalCategory() *LoanUpdateOne {
+	_u.mutation.ClearPrincipalCategory()
+	return _u
+}
+
+// ClearInstallments clears all "installments" edges to the LoanInstallment entity.
+func (_u *LoanUpdateOne) ClearInstallments() *LoanUpdateOne {
+	_u.mutation.ClearInstallments()
+	return _u
+}
+
+// RemoveInstallmentIDs removes the "installments" edge to LoanInstallment entities by IDs.
+func (_u *LoanUpdateOne) RemoveInstallmentIDs(ids ...uuid.UUID) *LoanUpdateOne {
+	_u.mutation.RemoveInstallmentIDs(ids...)
+	return _u
+}
+
+// RemoveInstallments removes "installments" edges to LoanInstallment entities.
+func (_u *LoanUpdateOne) RemoveInstallments(v ...*LoanInstallment) *LoanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInstallmentIDs(ids...)
+}
+
+// ClearPrepayments clears all "prepayments" edges to the LoanPrepayment entity.
+func (_u *LoanUpdateOne) ClearPrepayments() *LoanUpdateOne {
+	_u.mutation.ClearPrepayments()
+	return _u
+}
+
+// RemovePrepaymentIDs removes the "prepayments" edge to LoanPrepayment entities by IDs.
+func (_u *LoanUpdateOne) RemovePrepaymentIDs(ids ...uuid.UUID) *LoanUpdateOne {
+	_u.mutation.RemovePrepaymentIDs(ids...)
+	return _u
+}
+
+// RemovePrepayments removes "prepayments" edges to LoanPrepayment entities.
+func (_u *LoanUpdateOne) RemovePrepayments(v ...*LoanPrepayment) *LoanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrepaymentIDs(ids...)
+}
+
+// Where appends a list predicates to the LoanUpdate builder.
+func (_u *LoanUpdateOne) Where(ps ...predicate.Loan) *LoanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoanUpdateOne) Select(field string, fields ...string) *LoanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Loan entity.
+func (_u *LoanUpdateOne) Save(ctx context.Context) (*Loan, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanUpdateOne) SaveX(ctx context.Context) *Loan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := loan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Loan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InterestRate(); ok {
+		if err := loan.InterestRateValidator(v); err != nil {
+			return &ValidationError{Name: "interest_rate", err: fmt.Errorf(`ent: validator failed for field "Loan.interest_rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RatePeriod(); ok {
+		if err := loan.RatePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "rate_period", err: fmt.Errorf(`ent: validator failed for field "Loan.rate_period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TermMonths(); ok {
+		if err := loan.TermMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "term_months", err: fmt.Errorf(`ent: validator failed for field "Loan.term_months": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.System(); ok {
+		if err := loan.SystemValidator(v); err != nil {
+			return &ValidationError{Name: "system", err: fmt.Errorf(`ent: validator failed for field "Loan.system": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := loan.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Loan.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Loan.ledger"`)
+	}
+	return nil
+}
+
+func (_u *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Loan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loan.FieldID)
+		for _, f := range fields {
+			if !loan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Principal(); ok {
+		_spec.SetField(loan.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrincipal(); ok {
+		_spec.AddField(loan.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(loan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InterestRate(); ok {
+		_spec.SetField(loan.FieldInterestRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInterestRate(); ok {
+		_spec.AddField(loan.FieldInterestRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RatePeriod(); ok {
+		_spec.SetField(loan.FieldRatePeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TermMonths(); ok {
+		_spec.SetField(loan.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTermMonths(); ok {
+		_spec.AddField(loan.FieldTermMonths, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.System(); ok {
+		_spec.SetField(loan.FieldSystem, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FirstPaymentDate(); ok {
+		_spec.SetField(loan.FieldFirstPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(loan.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.LedgerTable,
+			Columns: []string{loan.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.LedgerTable,
+			Columns: []string{loan.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.AccountTable,
+			Columns: []string{loan.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.AccountTable,
+			Columns: []string{loan.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InterestCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.InterestCategoryTable,
+			Columns: []string{loan.InterestCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InterestCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.InterestCategoryTable,
+			Columns: []string{loan.InterestCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrincipalCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.PrincipalCategoryTable,
+			Columns: []string{loan.PrincipalCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrincipalCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loan.PrincipalCategoryTable,
+			Columns: []string{loan.PrincipalCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInstallmentsIDs(); len(nodes) > 0 && !_u.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InstallmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.InstallmentsTable,
+			Columns: []string{loan.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PrepaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPrepaymentsIDs(); len(nodes) > 0 && !_u.mutation.PrepaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrepaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   loan.PrepaymentsTable,
+			Columns: []string{loan.PrepaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Loan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LoanInstallment is the model entity for the LoanInstallment schema.
+type LoanInstallment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Payment holds the value of the "payment" field.
+	Payment domain.Money `json:"payment,omitempty"`
+	// Interest holds the value of the "interest" field.
+	Interest domain.Money `json:"interest,omitempty"`
+	// Principal holds the value of the "principal" field.
+	Principal domain.Money `json:"principal,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance domain.Money `json:"balance,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID uuid.UUID `json:"loan_id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number int `json:"number,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanInstallmentQuery when eager-loading is set.
+	Edges        LoanInstallmentEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// LoanInstallmentEdges holds the relations/edges for other nodes in the graph.
+type LoanInstallmentEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanInstallmentEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanInstallmentEdges) LoanOrErr() (*Loan, error) {
+	if e.Loan != nil {
+		return e.Loan, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: loan.Label}
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanInstallmentEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoanInstallment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loaninstallment.FieldTransactionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case loaninstallment.FieldPayment, loaninstallment.FieldInterest, loaninstallment.FieldPrincipal, loaninstallment.FieldBalance:
+			values[i] = new(domain.Money)
+		case loaninstallment.FieldNumber:
+			values[i] = new(sql.NullInt64)
+		case loaninstallment.FieldCreatedAt, loaninstallment.FieldUpdatedAt, loaninstallment.FieldDueDate:
+			values[i] = new(sql.NullTime)
+		case loaninstallment.FieldID, loaninstallment.FieldLoanID:
+			values[i] = new(uuid.UUID)
+		case loaninstallment.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoanInstallment fields.
+func (_m *LoanInstallment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loaninstallment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case loaninstallment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case loaninstallment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case loaninstallment.FieldPayment:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field payment", values[i])
+			} else if value != nil {
+				_m.Payment = *value
+			}
+		case loaninstallment.FieldInterest:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field interest", values[i])
+			} else if value != nil {
+				_m.Interest = *value
+			}
+		case loaninstallment.FieldPrincipal:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field principal", values[i])
+			} else if value != nil {
+				_m.Principal = *value
+			}
+		case loaninstallment.FieldBalance:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value != nil {
+				_m.Balance = *value
+			}
+		case loaninstallment.FieldLoanID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value != nil {
+				_m.LoanID = *value
+			}
+		case loaninstallment.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = int(value.Int64)
+			}
+		case loaninstallment.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = value.Time
+			}
+		case loaninstallment.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = new(uuid.UUID)
+				*_m.TransactionID = *value.S.(*uuid.UUID)
+			}
+		case loaninstallment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoanInstallment.
+// This includes values selected through modifiers, order, etc.
+func (_m *LoanInstallment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the LoanInstallment entity.
+func (_m *LoanInstallment) QueryLedger() *LedgerQuery {
+	return NewLoanInstallmentClient(_m.config).QueryLedger(_m)
+}
+
+// QueryLoan queries the "loan" edge of the LoanInstallment entity.
+func (_m *LoanInstallment) QueryLoan() *LoanQuery {
+	return NewLoanInstallmentClient(_m.config).QueryLoan(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the LoanInstallment entity.
+func (_m *LoanInstallment) QueryTransaction() *TransactionQuery {
+	return NewLoanInstallmentClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this LoanInstallment.
+// Note that you need to call LoanInstallment.Unwrap() before calling this method if this LoanInstallment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LoanInstallment) Update() *LoanInstallmentUpdateOne {
+	return NewLoanInstallmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LoanInstallment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LoanInstallment) Unwrap() *LoanInstallment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoanInstallment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LoanInstallment) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoanInstallment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("payment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payment))
+	builder.WriteString(", ")
+	builder.WriteString("interest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Interest))
+	builder.WriteString(", ")
+	builder.WriteString("principal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Principal))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Number))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoanInstallments is a parsable slice of LoanInstallment.
+type LoanInstallments []*LoanInstallment
@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loaninstallment
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Payment applies equality check predicate on the "payment" field. It's identical to PaymentEQ.
+func Payment(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldPayment, v))
+}
+
+// Interest applies equality check predicate on the "interest" field. It's identical to InterestEQ.
+func Interest(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldInterest, v))
+}
+
+// Principal applies equality check predicate on the "principal" field. It's identical to PrincipalEQ.
+func Principal(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldBalance, v))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldLoanID, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldNumber, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldDueDate, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PaymentEQ applies the EQ predicate on the "payment" field.
+func PaymentEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldPayment, v))
+}
+
+// PaymentNEQ applies the NEQ predicate on the "payment" field.
+func PaymentNEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldPayment, v))
+}
+
+// PaymentIn applies the In predicate on the "payment" field.
+func PaymentIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldPayment, vs...))
+}
+
+// PaymentNotIn applies the NotIn predicate on the "payment" field.
+func PaymentNotIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldPayment, vs...))
+}
+
+// PaymentGT applies the GT predicate on the "payment" field.
+func PaymentGT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldPayment, v))
+}
+
+// PaymentGTE applies the GTE predicate on the "payment" field.
+func PaymentGTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldPayment, v))
+}
+
+// PaymentLT applies the LT predicate on the "payment" field.
+func PaymentLT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldPayment, v))
+}
+
+// PaymentLTE applies the LTE predicate on the "payment" field.
+func PaymentLTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldPayment, v))
+}
+
+// InterestEQ applies the EQ predicate on the "interest" field.
+func InterestEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldInterest, v))
+}
+
+// InterestNEQ applies the NEQ predicate on the "interest" field.
+func InterestNEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldInterest, v))
+}
+
+// InterestIn applies the In predicate on the "interest" field.
+func InterestIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldInterest, vs...))
+}
+
+// InterestNotIn applies the NotIn predicate on the "interest" field.
+func InterestNotIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldInterest, vs...))
+}
+
+// InterestGT applies the GT predicate on the "interest" field.
+func InterestGT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldInterest, v))
+}
+
+// InterestGTE applies the GTE predicate on the "interest" field.
+func InterestGTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldInterest, v))
+}
+
+// InterestLT applies the LT predicate on the "interest" field.
+func InterestLT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldInterest, v))
+}
+
+// InterestLTE applies the LTE predicate on the "interest" field.
+func InterestLTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldInterest, v))
+}
+
+// PrincipalEQ applies the EQ predicate on the "principal" field.
+func PrincipalEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// PrincipalNEQ applies the NEQ predicate on the "principal" field.
+func PrincipalNEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldPrincipal, v))
+}
+
+// PrincipalIn applies the In predicate on the "principal" field.
+func PrincipalIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldPrincipal, vs...))
+}
+
+// PrincipalNotIn applies the NotIn predicate on the "principal" field.
+func PrincipalNotIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldPrincipal, vs...))
+}
+
+// PrincipalGT applies the GT predicate on the "principal" field.
+func PrincipalGT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldPrincipal, v))
+}
+
+// PrincipalGTE applies the GTE predicate on the "principal" field.
+func PrincipalGTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldPrincipal, v))
+}
+
+// PrincipalLT applies the LT predicate on the "principal" field.
+func PrincipalLT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldPrincipal, v))
+}
+
+// PrincipalLTE applies the LTE predicate on the "principal" field.
+func PrincipalLTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldPrincipal, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v domain.Money) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldBalance, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldNumber, v))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldLTE(FieldDueDate, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...uuid.UUID) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.FieldNotNull(FieldTransactionID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.LoanInstallment {
+	return predicate.LoanInstallment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.LoanInstallment {
+	return predicate.LoanInstallment(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.LoanInstallment {
+	return predicate.LoanInstallment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.LoanInstallment {
+	return predicate.LoanInstallment(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.LoanInstallment {
+	return predicate.LoanInstallment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.LoanInstallment {
+	return predicate.LoanInstallment(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoanInstallment) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoanInstallment) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoanInstallment) predicate.LoanInstallment {
+	return predicate.LoanInstallment(sql.NotPredicates(p))
+}
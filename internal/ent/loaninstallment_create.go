@@ -0,0 +1,1180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoanInstallmentCreate is the builder for creating a LoanInstallment entity.
+type LoanInstallmentCreate struct {
+	config
+	mutation *LoanInstallmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoanInstallmentCreate) SetCreatedAt(v time.Time) *LoanInstallmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoanInstallmentCreate) SetNillableCreatedAt(v *time.Time) *LoanInstallmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LoanInstallmentCreate) SetUpdatedAt(v time.Time) *LoanInstallmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LoanInstallmentCreate) SetNillableUpdatedAt(v *time.Time) *LoanInstallmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPayment sets the "payment" field.
+func (_c *LoanInstallmentCreate) SetPayment(v domain.Money) *LoanInstallmentCreate {
+	_c.mutation.SetPayment(v)
+	return _c
+}
+
+// SetInterest sets the "interest" field.
+func (_c *LoanInstallmentCreate) SetInterest(v domain.Money) *LoanInstallmentCreate {
+	_c.mutation.SetInterest(v)
+	return _c
+}
+
+// SetPrincipal sets the "principal" field.
+func (_c *LoanInstallmentCreate) SetPrincipal(v domain.Money) *LoanInstallmentCreate {
+	_c.mutation.SetPrincipal(v)
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *LoanInstallmentCreate) SetBalance(v domain.Money) *LoanInstallmentCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetLoanID sets the "loan_id" field.
+func (_c *LoanInstallmentCreate) SetLoanID(v uuid.UUID) *LoanInstallmentCreate {
+	_c.mutation.SetLoanID(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *LoanInstallmentCreate) SetNumber(v int) *LoanInstallmentCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *LoanInstallmentCreate) SetDueDate(v time.Time) *LoanInstallmentCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *LoanInstallmentCreate) SetTransactionID(v uuid.UUID) *LoanInstallmentCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *LoanInstallmentCreate) SetNillableTransactionID(v *uuid.UUID) *LoanInstallmentCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoanInstallmentCreate) SetID(v uuid.UUID) *LoanInstallmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LoanInstallmentCreate) SetNillableID(v *uuid.UUID) *LoanInstallmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *LoanInstallmentCreate) SetLedgerID(id uuid.UUID) *LoanInstallmentCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *LoanInstallmentCreate) SetLedger(v *Ledger) *LoanInstallmentCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_c *LoanInstallmentCreate) SetLoan(v *Loan) *LoanInstallmentCreate {
+	return _c.SetLoanID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *LoanInstallmentCreate) SetTransaction(v *Transaction) *LoanInstallmentCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the LoanInstallmentMutation object of the builder.
+func (_c *LoanInstallmentCreate) Mutation() *LoanInstallmentMutation {
+	return _c.mutation
+}
+
+// Save creates the LoanInstallment in the database.
+func (_c *LoanInstallmentCreate) Save(ctx context.Context) (*LoanInstallment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoanInstallmentCreate) SaveX(ctx context.Context) *LoanInstallment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanInstallmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanInstallmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoanInstallmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loaninstallment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := loaninstallment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := loaninstallment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoanInstallmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoanInstallment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LoanInstallment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Payment(); !ok {
+		return &ValidationError{Name: "payment", err: errors.New(`ent: missing required field "LoanInstallment.payment"`)}
+	}
+	if _, ok := _c.mutation.Interest(); !ok {
+		return &ValidationError{Name: "interest", err: errors.New(`ent: missing required field "LoanInstallment.interest"`)}
+	}
+	if _, ok := _c.mutation.Principal(); !ok {
+		return &ValidationError{Name: "principal", err: errors.New(`ent: missing required field "LoanInstallment.principal"`)}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "LoanInstallment.balance"`)}
+	}
+	if _, ok := _c.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "LoanInstallment.loan_id"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "LoanInstallment.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := loaninstallment.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "LoanInstallment.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "LoanInstallment.due_date"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "LoanInstallment.ledger"`)}
+	}
+	if len(_c.mutation.LoanIDs()) == 0 {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "LoanInstallment.loan"`)}
+	}
+	return nil
+}
+
+func (_c *LoanInstallmentCreate) sqlSave(ctx context.Context) (*LoanInstallment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoanInstallmentCreate) createSpec() (*LoanInstallment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoanInstallment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loaninstallment.Table, sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loaninstallment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(loaninstallment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Payment(); ok {
+		_spec.SetField(loaninstallment.FieldPayment, field.TypeInt64, value)
+		_node.Payment = value
+	}
+	if value, ok := _c.mutation.Interest(); ok {
+		_spec.SetField(loaninstallment.FieldInterest, field.TypeInt64, value)
+		_node.Interest = value
+	}
+	if value, ok := _c.mutation.Principal(); ok {
+		_spec.SetField(loaninstallment.FieldPrincipal, field.TypeInt64, value)
+		_node.Principal = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(loaninstallment.FieldBalance, field.TypeInt64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(loaninstallment.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(loaninstallment.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LedgerTable,
+			Columns: []string{loaninstallment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LoanTable,
+			Columns: []string{loaninstallment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.TransactionTable,
+			Columns: []string{loaninstallment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoanInstallment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoanInstallmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoanInstallmentCreate) OnConflict(opts ...sql.ConflictOption) *LoanInstallmentUpsertOne {
+	_c.conflict = opts
+	return &LoanInstallmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoanInstallment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoanInstallmentCreate) OnConflictColumns(columns ...string) *LoanInstallmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoanInstallmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LoanInstallmentUpsertOne is the builder for "upsert"-ing
+	//  one LoanInstallment node.
+	LoanInstallmentUpsertOne struct {
+		create *LoanInstallmentCreate
+	}
+
+	// LoanInstallmentUpsert is the "OnConflict" setter.
+	LoanInstallmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanInstallmentUpsert) SetUpdatedAt(v time.Time) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateUpdatedAt() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldUpdatedAt)
+	return u
+}
+
+// SetPayment sets the "payment" field.
+func (u *LoanInstallmentUpsert) SetPayment(v domain.Money) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldPayment, v)
+	return u
+}
+
+// UpdatePayment sets the "payment" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdatePayment() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldPayment)
+	return u
+}
+
+// AddPayment adds v to the "payment" field.
+func (u *LoanInstallmentUpsert) AddPayment(v domain.Money) *LoanInstallmentUpsert {
+	u.Add(loaninstallment.FieldPayment, v)
+	return u
+}
+
+// SetInterest sets the "interest" field.
+func (u *LoanInstallmentUpsert) SetInterest(v domain.Money) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldInterest, v)
+	return u
+}
+
+// UpdateInterest sets the "interest" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateInterest() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldInterest)
+	return u
+}
+
+// AddInterest adds v to the "interest" field.
+func (u *LoanInstallmentUpsert) AddInterest(v domain.Money) *LoanInstallmentUpsert {
+	u.Add(loaninstallment.FieldInterest, v)
+	return u
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *LoanInstallmentUpsert) SetPrincipal(v domain.Money) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldPrincipal, v)
+	return u
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdatePrincipal() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldPrincipal)
+	return u
+}
+
+// AddPrincipal adds v to the "principal" field.
+func (u *LoanInstallmentUpsert) AddPrincipal(v domain.Money) *LoanInstallmentUpsert {
+	u.Add(loaninstallment.FieldPrincipal, v)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *LoanInstallmentUpsert) SetBalance(v domain.Money) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateBalance() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LoanInstallmentUpsert) AddBalance(v domain.Money) *LoanInstallmentUpsert {
+	u.Add(loaninstallment.FieldBalance, v)
+	return u
+}
+
+// SetLoanID sets the "loan_id" field.
+func (u *LoanInstallmentUpsert) SetLoanID(v uuid.UUID) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldLoanID, v)
+	return u
+}
+
+// UpdateLoanID sets the "loan_id" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateLoanID() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldLoanID)
+	return u
+}
+
+// SetNumber sets the "number" field.
+func (u *LoanInstallmentUpsert) SetNumber(v int) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldNumber, v)
+	return u
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateNumber() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldNumber)
+	return u
+}
+
+// AddNumber adds v to the "number" field.
+func (u *LoanInstallmentUpsert) AddNumber(v int) *LoanInstallmentUpsert {
+	u.Add(loaninstallment.FieldNumber, v)
+	return u
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *LoanInstallmentUpsert) SetDueDate(v time.Time) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateDueDate() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldDueDate)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *LoanInstallmentUpsert) SetTransactionID(v uuid.UUID) *LoanInstallmentUpsert {
+	u.Set(loaninstallment.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *LoanInstallmentUpsert) UpdateTransactionID() *LoanInstallmentUpsert {
+	u.SetExcluded(loaninstallment.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *LoanInstallmentUpsert) ClearTransactionID() *LoanInstallmentUpsert {
+	u.SetNull(loaninstallment.FieldTransactionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LoanInstallment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loaninstallment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoanInstallmentUpsertOne) UpdateNewValues() *LoanInstallmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(loaninstallment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(loaninstallment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoanInstallment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoanInstallmentUpsertOne) Ignore() *LoanInstallmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoanInstallmentUpsertOne) DoNothing() *LoanInstallmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoanInstallmentCreate.OnConflict
+// documentation for more info.
+func (u *LoanInstallmentUpsertOne) Update(set func(*LoanInstallmentUpsert)) *LoanInstallmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoanInstallmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanInstallmentUpsertOne) SetUpdatedAt(v time.Time) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateUpdatedAt() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPayment sets the "payment" field.
+func (u *LoanInstallmentUpsertOne) SetPayment(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetPayment(v)
+	})
+}
+
+// AddPayment adds v to the "payment" field.
+func (u *LoanInstallmentUpsertOne) AddPayment(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddPayment(v)
+	})
+}
+
+// UpdatePayment sets the "payment" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdatePayment() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdatePayment()
+	})
+}
+
+// SetInterest sets the "interest" field.
+func (u *LoanInstallmentUpsertOne) SetInterest(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetInterest(v)
+	})
+}
+
+// AddInterest adds v to the "interest" field.
+func (u *LoanInstallmentUpsertOne) AddInterest(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddInterest(v)
+	})
+}
+
+// UpdateInterest sets the "interest" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateInterest() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateInterest()
+	})
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *LoanInstallmentUpsertOne) SetPrincipal(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetPrincipal(v)
+	})
+}
+
+// AddPrincipal adds v to the "principal" field.
+func (u *LoanInstallmentUpsertOne) AddPrincipal(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddPrincipal(v)
+	})
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdatePrincipal() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdatePrincipal()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LoanInstallmentUpsertOne) SetBalance(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LoanInstallmentUpsertOne) AddBalance(v domain.Money) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateBalance() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetLoanID sets the "loan_id" field.
+func (u *LoanInstallmentUpsertOne) SetLoanID(v uuid.UUID) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetLoanID(v)
+	})
+}
+
+// UpdateLoanID sets the "loan_id" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateLoanID() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateLoanID()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *LoanInstallmentUpsertOne) SetNumber(v int) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// AddNumber adds v to the "number" field.
+func (u *LoanInstallmentUpsertOne) AddNumber(v int) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateNumber() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *LoanInstallmentUpsertOne) SetDueDate(v time.Time) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateDueDate() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *LoanInstallmentUpsertOne) SetTransactionID(v uuid.UUID) *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertOne) UpdateTransactionID() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *LoanInstallmentUpsertOne) ClearTransactionID() *LoanInstallmentUpsertOne {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *LoanInstallmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoanInstallmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoanInstallmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoanInstallmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LoanInstallmentUpsertOne.ID is not supported by MySQL driver. Use LoanInstallmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoanInstallmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoanInstallmentCreateBulk is the builder for creating many LoanInstallment entities in bulk.
+type LoanInstallmentCreateBulk struct {
+	config
+	err      error
+	builders []*LoanInstallmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoanInstallment entities in the database.
+func (_c *LoanInstallmentCreateBulk) Save(ctx context.Context) ([]*LoanInstallment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoanInstallment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanInstallmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoanInstallmentCreateBulk) SaveX(ctx context.Context) []*LoanInstallment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanInstallmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanInstallmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoanInstallment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoanInstallmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoanInstallmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoanInstallmentUpsertBulk {
+	_c.conflict = opts
+	return &LoanInstallmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoanInstallment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoanInstallmentCreateBulk) OnConflictColumns(columns ...string) *LoanInstallmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoanInstallmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// LoanInstallmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoanInstallment nodes.
+type LoanInstallmentUpsertBulk struct {
+	create *LoanInstallmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoanInstallment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loaninstallment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoanInstallmentUpsertBulk) UpdateNewValues() *LoanInstallmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(loaninstallment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(loaninstallment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoanInstallment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoanInstallmentUpsertBulk) Ignore() *LoanInstallmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoanInstallmentUpsertBulk) DoNothing() *LoanInstallmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoanInstallmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoanInstallmentUpsertBulk) Update(set func(*LoanInstallmentUpsert)) *LoanInstallmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoanInstallmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanInstallmentUpsertBulk) SetUpdatedAt(v time.Time) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateUpdatedAt() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPayment sets the "payment" field.
+func (u *LoanInstallmentUpsertBulk) SetPayment(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetPayment(v)
+	})
+}
+
+// AddPayment adds v to the "payment" field.
+func (u *LoanInstallmentUpsertBulk) AddPayment(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddPayment(v)
+	})
+}
+
+// UpdatePayment sets the "payment" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdatePayment() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdatePayment()
+	})
+}
+
+// SetInterest sets the "interest" field.
+func (u *LoanInstallmentUpsertBulk) SetInterest(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetInterest(v)
+	})
+}
+
+// AddInterest adds v to the "interest" field.
+func (u *LoanInstallmentUpsertBulk) AddInterest(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddInterest(v)
+	})
+}
+
+// UpdateInterest sets the "interest" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateInterest() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateInterest()
+	})
+}
+
+// SetPrincipal sets the "principal" field.
+func (u *LoanInstallmentUpsertBulk) SetPrincipal(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetPrincipal(v)
+	})
+}
+
+// AddPrincipal adds v to the "principal" field.
+func (u *LoanInstallmentUpsertBulk) AddPrincipal(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddPrincipal(v)
+	})
+}
+
+// UpdatePrincipal sets the "principal" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdatePrincipal() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdatePrincipal()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LoanInstallmentUpsertBulk) SetBalance(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LoanInstallmentUpsertBulk) AddBalance(v domain.Money) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateBalance() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetLoanID sets the "loan_id" field.
+func (u *LoanInstallmentUpsertBulk) SetLoanID(v uuid.UUID) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetLoanID(v)
+	})
+}
+
+// UpdateLoanID sets the "loan_id" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateLoanID() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateLoanID()
+	})
+}
+
+// SetNumber sets the "number" field.
+func (u *LoanInstallmentUpsertBulk) SetNumber(v int) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetNumber(v)
+	})
+}
+
+// AddNumber adds v to the "number" field.
+func (u *LoanInstallmentUpsertBulk) AddNumber(v int) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.AddNumber(v)
+	})
+}
+
+// UpdateNumber sets the "number" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateNumber() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateNumber()
+	})
+}
+
+// SetDueDate sets the "due_date" field.
+func (u *LoanInstallmentUpsertBulk) SetDueDate(v time.Time) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateDueDate() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *LoanInstallmentUpsertBulk) SetTransactionID(v uuid.UUID) *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *LoanInstallmentUpsertBulk) UpdateTransactionID() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *LoanInstallmentUpsertBulk) ClearTransactionID() *LoanInstallmentUpsertBulk {
+	return u.Update(func(s *LoanInstallmentUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *LoanInstallmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoanInstallmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoanInstallmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoanInstallmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
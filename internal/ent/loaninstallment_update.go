@@ -0,0 +1,879 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoanInstallmentUpdate is the builder for updating LoanInstallment entities.
+type LoanInstallmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanInstallmentMutation
+}
+
+// Where appends a list predicates to the LoanInstallmentUpdate builder.
+func (_u *LoanInstallmentUpdate) Where(ps ...predicate.LoanInstallment) *LoanInstallmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanInstallmentUpdate) SetUpdatedAt(v time.Time) *LoanInstallmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPayment sets the "payment" field.
+func (_u *LoanInstallmentUpdate) SetPayment(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.ResetPayment()
+	_u.mutation.SetPayment(v)
+	return _u
+}
+
+// SetNillablePayment sets the "payment" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillablePayment(v *domain.Money) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetPayment(*v)
+	}
+	return _u
+}
+
+// AddPayment adds value to the "payment" field.
+func (_u *LoanInstallmentUpdate) AddPayment(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.AddPayment(v)
+	return _u
+}
+
+// SetInterest sets the "interest" field.
+func (_u *LoanInstallmentUpdate) SetInterest(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.ResetInterest()
+	_u.mutation.SetInterest(v)
+	return _u
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillableInterest(v *domain.Money) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetInterest(*v)
+	}
+	return _u
+}
+
+// AddInterest adds value to the "interest" field.
+func (_u *LoanInstallmentUpdate) AddInterest(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.AddInterest(v)
+	return _u
+}
+
+// SetPrincipal sets the "principal" field.
+func (_u *LoanInstallmentUpdate) SetPrincipal(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.ResetPrincipal()
+	_u.mutation.SetPrincipal(v)
+	return _u
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillablePrincipal(v *domain.Money) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetPrincipal(*v)
+	}
+	return _u
+}
+
+// AddPrincipal adds value to the "principal" field.
+func (_u *LoanInstallmentUpdate) AddPrincipal(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.AddPrincipal(v)
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *LoanInstallmentUpdate) SetBalance(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillableBalance(v *domain.Money) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *LoanInstallmentUpdate) AddBalance(v domain.Money) *LoanInstallmentUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetLoanID sets the "loan_id" field.
+func (_u *LoanInstallmentUpdate) SetLoanID(v uuid.UUID) *LoanInstallmentUpdate {
+	_u.mutation.SetLoanID(v)
+	return _u
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillableLoanID(v *uuid.UUID) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetLoanID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *LoanInstallmentUpdate) SetNumber(v int) *LoanInstallmentUpdate {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillableNumber(v *int) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *LoanInstallmentUpdate) AddNumber(v int) *LoanInstallmentUpdate {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *LoanInstallmentUpdate) SetDueDate(v time.Time) *LoanInstallmentUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillableDueDate(v *time.Time) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *LoanInstallmentUpdate) SetTransactionID(v uuid.UUID) *LoanInstallmentUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *LoanInstallmentUpdate) SetNillableTransactionID(v *uuid.UUID) *LoanInstallmentUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *LoanInstallmentUpdate) ClearTransactionID() *LoanInstallmentUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *LoanInstallmentUpdate) SetLedgerID(id uuid.UUID) *LoanInstallmentUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LoanInstallmentUpdate) SetLedger(v *Ledger) *LoanInstallmentUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_u *LoanInstallmentUpdate) SetLoan(v *Loan) *LoanInstallmentUpdate {
+	return _u.SetLoanID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *LoanInstallmentUpdate) SetTransaction(v *Transaction) *LoanInstallmentUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the LoanInstallmentMutation object of the builder.
+func (_u *LoanInstallmentUpdate) Mutation() *LoanInstallmentMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LoanInstallmentUpdate) ClearLedger() *LoanInstallmentUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (_u *LoanInstallmentUpdate) ClearLoan() *LoanInstallmentUpdate {
+	_u.mutation.ClearLoan()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *LoanInstallmentUpdate) ClearTransaction() *LoanInstallmentUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoanInstallmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanInstallmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoanInstallmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanInstallmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanInstallmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loaninstallment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanInstallmentUpdate) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := loaninstallment.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "LoanInstallment.number": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanInstallment.ledger"`)
+	}
+	if _u.mutation.LoanCleared() && len(_u.mutation.LoanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanInstallment.loan"`)
+	}
+	return nil
+}
+
+func (_u *LoanInstallmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loaninstallment.Table, loaninstallment.Columns, sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loaninstallment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Payment(); ok {
+		_spec.SetField(loaninstallment.FieldPayment, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPayment(); ok {
+		_spec.AddField(loaninstallment.FieldPayment, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Interest(); ok {
+		_spec.SetField(loaninstallment.FieldInterest, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInterest(); ok {
+		_spec.AddField(loaninstallment.FieldInterest, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Principal(); ok {
+		_spec.SetField(loaninstallment.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrincipal(); ok {
+		_spec.AddField(loaninstallment.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(loaninstallment.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(loaninstallment.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(loaninstallment.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(loaninstallment.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(loaninstallment.FieldDueDate, field.TypeTime, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LedgerTable,
+			Columns: []string{loaninstallment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LedgerTable,
+			Columns: []string{loaninstallment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LoanTable,
+			Columns: []string{loaninstallment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LoanTable,
+			Columns: []string{loaninstallment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.TransactionTable,
+			Columns: []string{loaninstallment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.TransactionTable,
+			Columns: []string{loaninstallment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loaninstallment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoanInstallmentUpdateOne is the builder for updating a single LoanInstallment entity.
+type LoanInstallmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanInstallmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanInstallmentUpdateOne) SetUpdatedAt(v time.Time) *LoanInstallmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPayment sets the "payment" field.
+func (_u *LoanInstallmentUpdateOne) SetPayment(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.ResetPayment()
+	_u.mutation.SetPayment(v)
+	return _u
+}
+
+// SetNillablePayment sets the "payment" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillablePayment(v *domain.Money) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetPayment(*v)
+	}
+	return _u
+}
+
+// AddPayment adds value to the "payment" field.
+func (_u *LoanInstallmentUpdateOne) AddPayment(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.AddPayment(v)
+	return _u
+}
+
+// SetInterest sets the "interest" field.
+func (_u *LoanInstallmentUpdateOne) SetInterest(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.ResetInterest()
+	_u.mutation.SetInterest(v)
+	return _u
+}
+
+// SetNillableInterest sets the "interest" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillableInterest(v *domain.Money) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetInterest(*v)
+	}
+	return _u
+}
+
+// AddInterest adds value to the "interest" field.
+func (_u *LoanInstallmentUpdateOne) AddInterest(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.AddInterest(v)
+	return _u
+}
+
+// SetPrincipal sets the "principal" field.
+func (_u *LoanInstallmentUpdateOne) SetPrincipal(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.ResetPrincipal()
+	_u.mutation.SetPrincipal(v)
+	return _u
+}
+
+// SetNillablePrincipal sets the "principal" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillablePrincipal(v *domain.Money) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetPrincipal(*v)
+	}
+	return _u
+}
+
+// AddPrincipal adds value to the "principal" field.
+func (_u *LoanInstallmentUpdateOne) AddPrincipal(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.AddPrincipal(v)
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *LoanInstallmentUpdateOne) SetBalance(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillableBalance(v *domain.Money) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *LoanInstallmentUpdateOne) AddBalance(v domain.Money) *LoanInstallmentUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetLoanID sets the "loan_id" field.
+func (_u *LoanInstallmentUpdateOne) SetLoanID(v uuid.UUID) *LoanInstallmentUpdateOne {
+	_u.mutation.SetLoanID(v)
+	return _u
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillableLoanID(v *uuid.UUID) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetLoanID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *LoanInstallmentUpdateOne) SetNumber(v int) *LoanInstallmentUpdateOne {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillableNumber(v *int) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *LoanInstallmentUpdateOne) AddNumber(v int) *LoanInstallmentUpdateOne {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *LoanInstallmentUpdateOne) SetDueDate(v time.Time) *LoanInstallmentUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillableDueDate(v *time.Time) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *LoanInstallmentUpdateOne) SetTransactionID(v uuid.UUID) *LoanInstallmentUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *LoanInstallmentUpdateOne) SetNillableTransactionID(v *uuid.UUID) *LoanInstallmentUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *LoanInstallmentUpdateOne) ClearTransactionID() *LoanInstallmentUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *LoanInstallmentUpdateOne) SetLedgerID(id uuid.UUID) *LoanInstallmentUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LoanInstallmentUpdateOne) SetLedger(v *Ledger) *LoanInstallmentUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_u *LoanInstallmentUpdateOne) SetLoan(v *Loan) *LoanInstallmentUpdateOne {
+	return _u.SetLoanID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *LoanInstallmentUpdateOne) SetTransaction(v *Transaction) *LoanInstallmentUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the LoanInstallmentMutation object of the builder.
+func (_u *LoanInstallmentUpdateOne) Mutation() *LoanInstallmentMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LoanInstallmentUpdateOne) ClearLedger() *LoanInstallmentUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (_u *LoanInstallmentUpdateOne) ClearLoan() *LoanInstallmentUpdateOne {
+	_u.mutation.ClearLoan()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *LoanInstallmentUpdateOne) ClearTransaction() *LoanInstallmentUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the LoanInstallmentUpdate builder.
+func (_u *LoanInstallmentUpdateOne) Where(ps ...predicate.LoanInstallment) *LoanInstallmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoanInstallmentUpdateOne) Select(field string, fields ...string) *LoanInstallmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoanInstallment entity.
+func (_u *LoanInstallmentUpdateOne) Save(ctx context.Context) (*LoanInstallment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanInstallmentUpdateOne) SaveX(ctx context.Context) *LoanInstallment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoanInstallmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanInstallmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanInstallmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loaninstallment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanInstallmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := loaninstallment.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "LoanInstallment.number": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanInstallment.ledger"`)
+	}
+	if _u.mutation.LoanCleared() && len(_u.mutation.LoanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanInstallment.loan"`)
+	}
+	return nil
+}
+
+func (_u *LoanInstallmentUpdateOne) sqlSave(ctx context.Context) (_node *LoanInstallment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loaninstallment.Table, loaninstallment.Columns, sqlgraph.NewFieldSpec(loaninstallment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoanInstallment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loaninstallment.FieldID)
+		for _, f := range fields {
+			if !loaninstallment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loaninstallment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loaninstallment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Payment(); ok {
+		_spec.SetField(loaninstallment.FieldPayment, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPayment(); ok {
+		_spec.AddField(loaninstallment.FieldPayment, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Interest(); ok {
+		_spec.SetField(loaninstallment.FieldInterest, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInterest(); ok {
+		_spec.AddField(loaninstallment.FieldInterest, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Principal(); ok {
+		_spec.SetField(loaninstallment.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPrincipal(); ok {
+		_spec.AddField(loaninstallment.FieldPrincipal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(loaninstallment.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(loaninstallment.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(loaninstallment.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(loaninstallment.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(loaninstallment.FieldDueDate, field.TypeTime, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LedgerTable,
+			Columns: []string{loaninstallment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LedgerTable,
+			Columns: []string{loaninstallment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LoanTable,
+			Columns: []string{loaninstallment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.LoanTable,
+			Columns: []string{loaninstallment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.TransactionTable,
+			Columns: []string{loaninstallment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loaninstallment.TransactionTable,
+			Columns: []string{loaninstallment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoanInstallment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loaninstallment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/transaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LoanPrepayment is the model entity for the LoanPrepayment schema.
+type LoanPrepayment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount domain.Money `json:"amount,omitempty"`
+	// InterestSaved holds the value of the "interest_saved" field.
+	InterestSaved domain.Money `json:"interest_saved,omitempty"`
+	// LoanID holds the value of the "loan_id" field.
+	LoanID uuid.UUID `json:"loan_id,omitempty"`
+	// PaymentDate holds the value of the "payment_date" field.
+	PaymentDate time.Time `json:"payment_date,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanPrepaymentQuery when eager-loading is set.
+	Edges        LoanPrepaymentEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// LoanPrepaymentEdges holds the relations/edges for other nodes in the graph.
+type LoanPrepaymentEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanPrepaymentEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanPrepaymentEdges) LoanOrErr() (*Loan, error) {
+	if e.Loan != nil {
+		return e.Loan, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: loan.Label}
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanPrepaymentEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoanPrepayment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loanprepayment.FieldTransactionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case loanprepayment.FieldAmount, loanprepayment.FieldInterestSaved:
+			values[i] = new(domain.Money)
+		case loanprepayment.FieldMode:
+			values[i] = new(sql.NullString)
+		case loanprepayment.FieldCreatedAt, loanprepayment.FieldUpdatedAt, loanprepayment.FieldPaymentDate:
+			values[i] = new(sql.NullTime)
+		case loanprepayment.FieldID, loanprepayment.FieldLoanID:
+			values[i] = new(uuid.UUID)
+		case loanprepayment.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoanPrepayment fields.
+func (_m *LoanPrepayment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loanprepayment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case loanprepayment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case loanprepayment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case loanprepayment.FieldAmount:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case loanprepayment.FieldInterestSaved:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field interest_saved", values[i])
+			} else if value != nil {
+				_m.InterestSaved = *value
+			}
+		case loanprepayment.FieldLoanID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field loan_id", values[i])
+			} else if value != nil {
+				_m.LoanID = *value
+			}
+		case loanprepayment.FieldPaymentDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_date", values[i])
+			} else if value.Valid {
+				_m.PaymentDate = value.Time
+			}
+		case loanprepayment.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = value.String
+			}
+		case loanprepayment.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = new(uuid.UUID)
+				*_m.TransactionID = *value.S.(*uuid.UUID)
+			}
+		case loanprepayment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoanPrepayment.
+// This includes values selected through modifiers, order, etc.
+func (_m *LoanPrepayment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the LoanPrepayment entity.
+func (_m *LoanPrepayment) QueryLedger() *LedgerQuery {
+	return NewLoanPrepaymentClient(_m.config).QueryLedger(_m)
+}
+
+// QueryLoan queries the "loan" edge of the LoanPrepayment entity.
+func (_m *LoanPrepayment) QueryLoan() *LoanQuery {
+	return NewLoanPrepaymentClient(_m.config).QueryLoan(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the LoanPrepayment entity.
+func (_m *LoanPrepayment) QueryTransaction() *TransactionQuery {
+	return NewLoanPrepaymentClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this LoanPrepayment.
+// Note that you need to call LoanPrepayment.Unwrap() before calling this method if this LoanPrepayment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LoanPrepayment) Update() *LoanPrepaymentUpdateOne {
+	return NewLoanPrepaymentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LoanPrepayment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LoanPrepayment) Unwrap() *LoanPrepayment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoanPrepayment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LoanPrepayment) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoanPrepayment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("interest_saved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InterestSaved))
+	builder.WriteString(", ")
+	builder.WriteString("loan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoanID))
+	builder.WriteString(", ")
+	builder.WriteString("payment_date=")
+	builder.WriteString(_m.PaymentDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(_m.Mode)
+	builder.WriteString(", ")
+	if v := _m.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoanPrepayments is a parsable slice of LoanPrepayment.
+type LoanPrepayments []*LoanPrepayment
@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loanprepayment
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// InterestSaved applies equality check predicate on the "interest_saved" field. It's identical to InterestSavedEQ.
+func InterestSaved(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldInterestSaved, v))
+}
+
+// LoanID applies equality check predicate on the "loan_id" field. It's identical to LoanIDEQ.
+func LoanID(v uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldLoanID, v))
+}
+
+// PaymentDate applies equality check predicate on the "payment_date" field. It's identical to PaymentDateEQ.
+func PaymentDate(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldMode, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldAmount, v))
+}
+
+// InterestSavedEQ applies the EQ predicate on the "interest_saved" field.
+func InterestSavedEQ(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldInterestSaved, v))
+}
+
+// InterestSavedNEQ applies the NEQ predicate on the "interest_saved" field.
+func InterestSavedNEQ(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldInterestSaved, v))
+}
+
+// InterestSavedIn applies the In predicate on the "interest_saved" field.
+func InterestSavedIn(vs ...domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldInterestSaved, vs...))
+}
+
+// InterestSavedNotIn applies the NotIn predicate on the "interest_saved" field.
+func InterestSavedNotIn(vs ...domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldInterestSaved, vs...))
+}
+
+// InterestSavedGT applies the GT predicate on the "interest_saved" field.
+func InterestSavedGT(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldInterestSaved, v))
+}
+
+// InterestSavedGTE applies the GTE predicate on the "interest_saved" field.
+func InterestSavedGTE(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldInterestSaved, v))
+}
+
+// InterestSavedLT applies the LT predicate on the "interest_saved" field.
+func InterestSavedLT(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldInterestSaved, v))
+}
+
+// InterestSavedLTE applies the LTE predicate on the "interest_saved" field.
+func InterestSavedLTE(v domain.Money) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldInterestSaved, v))
+}
+
+// LoanIDEQ applies the EQ predicate on the "loan_id" field.
+func LoanIDEQ(v uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldLoanID, v))
+}
+
+// LoanIDNEQ applies the NEQ predicate on the "loan_id" field.
+func LoanIDNEQ(v uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldLoanID, v))
+}
+
+// LoanIDIn applies the In predicate on the "loan_id" field.
+func LoanIDIn(vs ...uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldLoanID, vs...))
+}
+
+// LoanIDNotIn applies the NotIn predicate on the "loan_id" field.
+func LoanIDNotIn(vs ...uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldLoanID, vs...))
+}
+
+// PaymentDateEQ applies the EQ predicate on the "payment_date" field.
+func PaymentDateEQ(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateNEQ applies the NEQ predicate on the "payment_date" field.
+func PaymentDateNEQ(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldPaymentDate, v))
+}
+
+// PaymentDateIn applies the In predicate on the "payment_date" field.
+func PaymentDateIn(vs ...time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateNotIn applies the NotIn predicate on the "payment_date" field.
+func PaymentDateNotIn(vs ...time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldPaymentDate, vs...))
+}
+
+// PaymentDateGT applies the GT predicate on the "payment_date" field.
+func PaymentDateGT(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldPaymentDate, v))
+}
+
+// PaymentDateGTE applies the GTE predicate on the "payment_date" field.
+func PaymentDateGTE(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldPaymentDate, v))
+}
+
+// PaymentDateLT applies the LT predicate on the "payment_date" field.
+func PaymentDateLT(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldPaymentDate, v))
+}
+
+// PaymentDateLTE applies the LTE predicate on the "payment_date" field.
+func PaymentDateLTE(v time.Time) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldPaymentDate, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldContainsFold(FieldMode, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...uuid.UUID) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.FieldNotNull(FieldTransactionID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoanPrepayment) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoanPrepayment) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoanPrepayment) predicate.LoanPrepayment {
+	return predicate.LoanPrepayment(sql.NotPredicates(p))
+}
@@ -0,0 +1,1014 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoanPrepaymentCreate is the builder for creating a LoanPrepayment entity.
+type LoanPrepaymentCreate struct {
+	config
+	mutation *LoanPrepaymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoanPrepaymentCreate) SetCreatedAt(v time.Time) *LoanPrepaymentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoanPrepaymentCreate) SetNillableCreatedAt(v *time.Time) *LoanPrepaymentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LoanPrepaymentCreate) SetUpdatedAt(v time.Time) *LoanPrepaymentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LoanPrepaymentCreate) SetNillableUpdatedAt(v *time.Time) *LoanPrepaymentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *LoanPrepaymentCreate) SetAmount(v domain.Money) *LoanPrepaymentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetInterestSaved sets the "interest_saved" field.
+func (_c *LoanPrepaymentCreate) SetInterestSaved(v domain.Money) *LoanPrepaymentCreate {
+	_c.mutation.SetInterestSaved(v)
+	return _c
+}
+
+// SetLoanID sets the "loan_id" field.
+func (_c *LoanPrepaymentCreate) SetLoanID(v uuid.UUID) *LoanPrepaymentCreate {
+	_c.mutation.SetLoanID(v)
+	return _c
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_c *LoanPrepaymentCreate) SetPaymentDate(v time.Time) *LoanPrepaymentCreate {
+	_c.mutation.SetPaymentDate(v)
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *LoanPrepaymentCreate) SetMode(v string) *LoanPrepaymentCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *LoanPrepaymentCreate) SetTransactionID(v uuid.UUID) *LoanPrepaymentCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *LoanPrepaymentCreate) SetNillableTransactionID(v *uuid.UUID) *LoanPrepaymentCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoanPrepaymentCreate) SetID(v uuid.UUID) *LoanPrepaymentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LoanPrepaymentCreate) SetNillableID(v *uuid.UUID) *LoanPrepaymentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *LoanPrepaymentCreate) SetLedgerID(id uuid.UUID) *LoanPrepaymentCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *LoanPrepaymentCreate) SetLedger(v *Ledger) *LoanPrepaymentCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_c *LoanPrepaymentCreate) SetLoan(v *Loan) *LoanPrepaymentCreate {
+	return _c.SetLoanID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *LoanPrepaymentCreate) SetTransaction(v *Transaction) *LoanPrepaymentCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the LoanPrepaymentMutation object of the builder.
+func (_c *LoanPrepaymentCreate) Mutation() *LoanPrepaymentMutation {
+	return _c.mutation
+}
+
+// Save creates the LoanPrepayment in the database.
+func (_c *LoanPrepaymentCreate) Save(ctx context.Context) (*LoanPrepayment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoanPrepaymentCreate) SaveX(ctx context.Context) *LoanPrepayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanPrepaymentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanPrepaymentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoanPrepaymentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loanprepayment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := loanprepayment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := loanprepayment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoanPrepaymentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoanPrepayment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LoanPrepayment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "LoanPrepayment.amount"`)}
+	}
+	if _, ok := _c.mutation.InterestSaved(); !ok {
+		return &ValidationError{Name: "interest_saved", err: errors.New(`ent: missing required field "LoanPrepayment.interest_saved"`)}
+	}
+	if _, ok := _c.mutation.LoanID(); !ok {
+		return &ValidationError{Name: "loan_id", err: errors.New(`ent: missing required field "LoanPrepayment.loan_id"`)}
+	}
+	if _, ok := _c.mutation.PaymentDate(); !ok {
+		return &ValidationError{Name: "payment_date", err: errors.New(`ent: missing required field "LoanPrepayment.payment_date"`)}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "LoanPrepayment.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := loanprepayment.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "LoanPrepayment.mode": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "LoanPrepayment.ledger"`)}
+	}
+	if len(_c.mutation.LoanIDs()) == 0 {
+		return &ValidationError{Name: "loan", err: errors.New(`ent: missing required edge "LoanPrepayment.loan"`)}
+	}
+	return nil
+}
+
+func (_c *LoanPrepaymentCreate) sqlSave(ctx context.Context) (*LoanPrepayment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoanPrepaymentCreate) createSpec() (*LoanPrepayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoanPrepayment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loanprepayment.Table, sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loanprepayment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(loanprepayment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(loanprepayment.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.InterestSaved(); ok {
+		_spec.SetField(loanprepayment.FieldInterestSaved, field.TypeInt64, value)
+		_node.InterestSaved = value
+	}
+	if value, ok := _c.mutation.PaymentDate(); ok {
+		_spec.SetField(loanprepayment.FieldPaymentDate, field.TypeTime, value)
+		_node.PaymentDate = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(loanprepayment.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LedgerTable,
+			Columns: []string{loanprepayment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LoanTable,
+			Columns: []string{loanprepayment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LoanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.TransactionTable,
+			Columns: []string{loanprepayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoanPrepayment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoanPrepaymentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoanPrepaymentCreate) OnConflict(opts ...sql.ConflictOption) *LoanPrepaymentUpsertOne {
+	_c.conflict = opts
+	return &LoanPrepaymentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoanPrepayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoanPrepaymentCreate) OnConflictColumns(columns ...string) *LoanPrepaymentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoanPrepaymentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LoanPrepaymentUpsertOne is the builder for "upsert"-ing
+	//  one LoanPrepayment node.
+	LoanPrepaymentUpsertOne struct {
+		create *LoanPrepaymentCreate
+	}
+
+	// LoanPrepaymentUpsert is the "OnConflict" setter.
+	LoanPrepaymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanPrepaymentUpsert) SetUpdatedAt(v time.Time) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdateUpdatedAt() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *LoanPrepaymentUpsert) SetAmount(v domain.Money) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdateAmount() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *LoanPrepaymentUpsert) AddAmount(v domain.Money) *LoanPrepaymentUpsert {
+	u.Add(loanprepayment.FieldAmount, v)
+	return u
+}
+
+// SetInterestSaved sets the "interest_saved" field.
+func (u *LoanPrepaymentUpsert) SetInterestSaved(v domain.Money) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldInterestSaved, v)
+	return u
+}
+
+// UpdateInterestSaved sets the "interest_saved" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdateInterestSaved() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldInterestSaved)
+	return u
+}
+
+// AddInterestSaved adds v to the "interest_saved" field.
+func (u *LoanPrepaymentUpsert) AddInterestSaved(v domain.Money) *LoanPrepaymentUpsert {
+	u.Add(loanprepayment.FieldInterestSaved, v)
+	return u
+}
+
+// SetLoanID sets the "loan_id" field.
+func (u *LoanPrepaymentUpsert) SetLoanID(v uuid.UUID) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldLoanID, v)
+	return u
+}
+
+// UpdateLoanID sets the "loan_id" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdateLoanID() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldLoanID)
+	return u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *LoanPrepaymentUpsert) SetPaymentDate(v time.Time) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldPaymentDate, v)
+	return u
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdatePaymentDate() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldPaymentDate)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *LoanPrepaymentUpsert) SetMode(v string) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdateMode() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldMode)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *LoanPrepaymentUpsert) SetTransactionID(v uuid.UUID) *LoanPrepaymentUpsert {
+	u.Set(loanprepayment.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsert) UpdateTransactionID() *LoanPrepaymentUpsert {
+	u.SetExcluded(loanprepayment.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *LoanPrepaymentUpsert) ClearTransactionID() *LoanPrepaymentUpsert {
+	u.SetNull(loanprepayment.FieldTransactionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LoanPrepayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loanprepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoanPrepaymentUpsertOne) UpdateNewValues() *LoanPrepaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(loanprepayment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(loanprepayment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoanPrepayment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoanPrepaymentUpsertOne) Ignore() *LoanPrepaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoanPrepaymentUpsertOne) DoNothing() *LoanPrepaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoanPrepaymentCreate.OnConflict
+// documentation for more info.
+func (u *LoanPrepaymentUpsertOne) Update(set func(*LoanPrepaymentUpsert)) *LoanPrepaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoanPrepaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanPrepaymentUpsertOne) SetUpdatedAt(v time.Time) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdateUpdatedAt() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *LoanPrepaymentUpsertOne) SetAmount(v domain.Money) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *LoanPrepaymentUpsertOne) AddAmount(v domain.Money) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdateAmount() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetInterestSaved sets the "interest_saved" field.
+func (u *LoanPrepaymentUpsertOne) SetInterestSaved(v domain.Money) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetInterestSaved(v)
+	})
+}
+
+// AddInterestSaved adds v to the "interest_saved" field.
+func (u *LoanPrepaymentUpsertOne) AddInterestSaved(v domain.Money) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.AddInterestSaved(v)
+	})
+}
+
+// UpdateInterestSaved sets the "interest_saved" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdateInterestSaved() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateInterestSaved()
+	})
+}
+
+// SetLoanID sets the "loan_id" field.
+func (u *LoanPrepaymentUpsertOne) SetLoanID(v uuid.UUID) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetLoanID(v)
+	})
+}
+
+// UpdateLoanID sets the "loan_id" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdateLoanID() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateLoanID()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *LoanPrepaymentUpsertOne) SetPaymentDate(v time.Time) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdatePaymentDate() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *LoanPrepaymentUpsertOne) SetMode(v string) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdateMode() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *LoanPrepaymentUpsertOne) SetTransactionID(v uuid.UUID) *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertOne) UpdateTransactionID() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *LoanPrepaymentUpsertOne) ClearTransactionID() *LoanPrepaymentUpsertOne {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *LoanPrepaymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoanPrepaymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoanPrepaymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoanPrepaymentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LoanPrepaymentUpsertOne.ID is not supported by MySQL driver. Use LoanPrepaymentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoanPrepaymentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoanPrepaymentCreateBulk is the builder for creating many LoanPrepayment entities in bulk.
+type LoanPrepaymentCreateBulk struct {
+	config
+	err      error
+	builders []*LoanPrepaymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoanPrepayment entities in the database.
+func (_c *LoanPrepaymentCreateBulk) Save(ctx context.Context) ([]*LoanPrepayment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LoanPrepayment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanPrepaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoanPrepaymentCreateBulk) SaveX(ctx context.Context) []*LoanPrepayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanPrepaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanPrepaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoanPrepayment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoanPrepaymentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LoanPrepaymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoanPrepaymentUpsertBulk {
+	_c.conflict = opts
+	return &LoanPrepaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoanPrepayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LoanPrepaymentCreateBulk) OnConflictColumns(columns ...string) *LoanPrepaymentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LoanPrepaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// LoanPrepaymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoanPrepayment nodes.
+type LoanPrepaymentUpsertBulk struct {
+	create *LoanPrepaymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoanPrepayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loanprepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoanPrepaymentUpsertBulk) UpdateNewValues() *LoanPrepaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(loanprepayment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(loanprepayment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoanPrepayment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoanPrepaymentUpsertBulk) Ignore() *LoanPrepaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoanPrepaymentUpsertBulk) DoNothing() *LoanPrepaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoanPrepaymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoanPrepaymentUpsertBulk) Update(set func(*LoanPrepaymentUpsert)) *LoanPrepaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoanPrepaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoanPrepaymentUpsertBulk) SetUpdatedAt(v time.Time) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdateUpdatedAt() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *LoanPrepaymentUpsertBulk) SetAmount(v domain.Money) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *LoanPrepaymentUpsertBulk) AddAmount(v domain.Money) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdateAmount() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetInterestSaved sets the "interest_saved" field.
+func (u *LoanPrepaymentUpsertBulk) SetInterestSaved(v domain.Money) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetInterestSaved(v)
+	})
+}
+
+// AddInterestSaved adds v to the "interest_saved" field.
+func (u *LoanPrepaymentUpsertBulk) AddInterestSaved(v domain.Money) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.AddInterestSaved(v)
+	})
+}
+
+// UpdateInterestSaved sets the "interest_saved" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdateInterestSaved() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateInterestSaved()
+	})
+}
+
+// SetLoanID sets the "loan_id" field.
+func (u *LoanPrepaymentUpsertBulk) SetLoanID(v uuid.UUID) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetLoanID(v)
+	})
+}
+
+// UpdateLoanID sets the "loan_id" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdateLoanID() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateLoanID()
+	})
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (u *LoanPrepaymentUpsertBulk) SetPaymentDate(v time.Time) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetPaymentDate(v)
+	})
+}
+
+// UpdatePaymentDate sets the "payment_date" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdatePaymentDate() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdatePaymentDate()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *LoanPrepaymentUpsertBulk) SetMode(v string) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdateMode() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *LoanPrepaymentUpsertBulk) SetTransactionID(v uuid.UUID) *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *LoanPrepaymentUpsertBulk) UpdateTransactionID() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *LoanPrepaymentUpsertBulk) ClearTransactionID() *LoanPrepaymentUpsertBulk {
+	return u.Update(func(s *LoanPrepaymentUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *LoanPrepaymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoanPrepaymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoanPrepaymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoanPrepaymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
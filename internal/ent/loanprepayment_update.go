@@ -0,0 +1,751 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoanPrepaymentUpdate is the builder for updating LoanPrepayment entities.
+type LoanPrepaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanPrepaymentMutation
+}
+
+// Where appends a list predicates to the LoanPrepaymentUpdate builder.
+func (_u *LoanPrepaymentUpdate) Where(ps ...predicate.LoanPrepayment) *LoanPrepaymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanPrepaymentUpdate) SetUpdatedAt(v time.Time) *LoanPrepaymentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *LoanPrepaymentUpdate) SetAmount(v domain.Money) *LoanPrepaymentUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdate) SetNillableAmount(v *domain.Money) *LoanPrepaymentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *LoanPrepaymentUpdate) AddAmount(v domain.Money) *LoanPrepaymentUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetInterestSaved sets the "interest_saved" field.
+func (_u *LoanPrepaymentUpdate) SetInterestSaved(v domain.Money) *LoanPrepaymentUpdate {
+	_u.mutation.ResetInterestSaved()
+	_u.mutation.SetInterestSaved(v)
+	return _u
+}
+
+// SetNillableInterestSaved sets the "interest_saved" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdate) SetNillableInterestSaved(v *domain.Money) *LoanPrepaymentUpdate {
+	if v != nil {
+		_u.SetInterestSaved(*v)
+	}
+	return _u
+}
+
+// AddInterestSaved adds value to the "interest_saved" field.
+func (_u *LoanPrepaymentUpdate) AddInterestSaved(v domain.Money) *LoanPrepaymentUpdate {
+	_u.mutation.AddInterestSaved(v)
+	return _u
+}
+
+// SetLoanID sets the "loan_id" field.
+func (_u *LoanPrepaymentUpdate) SetLoanID(v uuid.UUID) *LoanPrepaymentUpdate {
+	_u.mutation.SetLoanID(v)
+	return _u
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdate) SetNillableLoanID(v *uuid.UUID) *LoanPrepaymentUpdate {
+	if v != nil {
+		_u.SetLoanID(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *LoanPrepaymentUpdate) SetPaymentDate(v time.Time) *LoanPrepaymentUpdate {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdate) SetNillablePaymentDate(v *time.Time) *LoanPrepaymentUpdate {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *LoanPrepaymentUpdate) SetMode(v string) *LoanPrepaymentUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdate) SetNillableMode(v *string) *LoanPrepaymentUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *LoanPrepaymentUpdate) SetTransactionID(v uuid.UUID) *LoanPrepaymentUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdate) SetNillableTransactionID(v *uuid.UUID) *LoanPrepaymentUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *LoanPrepaymentUpdate) ClearTransactionID() *LoanPrepaymentUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *LoanPrepaymentUpdate) SetLedgerID(id uuid.UUID) *LoanPrepaymentUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LoanPrepaymentUpdate) SetLedger(v *Ledger) *LoanPrepaymentUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_u *LoanPrepaymentUpdate) SetLoan(v *Loan) *LoanPrepaymentUpdate {
+	return _u.SetLoanID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *LoanPrepaymentUpdate) SetTransaction(v *Transaction) *LoanPrepaymentUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the LoanPrepaymentMutation object of the builder.
+func (_u *LoanPrepaymentUpdate) Mutation() *LoanPrepaymentMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LoanPrepaymentUpdate) ClearLedger() *LoanPrepaymentUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (_u *LoanPrepaymentUpdate) ClearLoan() *LoanPrepaymentUpdate {
+	_u.mutation.ClearLoan()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *LoanPrepaymentUpdate) ClearTransaction() *LoanPrepaymentUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoanPrepaymentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanPrepaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoanPrepaymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanPrepaymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanPrepaymentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loanprepayment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanPrepaymentUpdate) check() error {
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := loanprepayment.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "LoanPrepayment.mode": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanPrepayment.ledger"`)
+	}
+	if _u.mutation.LoanCleared() && len(_u.mutation.LoanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanPrepayment.loan"`)
+	}
+	return nil
+}
+
+func (_u *LoanPrepaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanprepayment.Table, loanprepayment.Columns, sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loanprepayment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(loanprepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(loanprepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.InterestSaved(); ok {
+		_spec.SetField(loanprepayment.FieldInterestSaved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInterestSaved(); ok {
+		_spec.AddField(loanprepayment.FieldInterestSaved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(loanprepayment.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(loanprepayment.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LedgerTable,
+			Columns: []string{loanprepayment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LedgerTable,
+			Columns: []string{loanprepayment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LoanTable,
+			Columns: []string{loanprepayment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LoanTable,
+			Columns: []string{loanprepayment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.TransactionTable,
+			Columns: []string{loanprepayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.TransactionTable,
+			Columns: []string{loanprepayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanprepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoanPrepaymentUpdateOne is the builder for updating a single LoanPrepayment entity.
+type LoanPrepaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanPrepaymentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanPrepaymentUpdateOne) SetUpdatedAt(v time.Time) *LoanPrepaymentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *LoanPrepaymentUpdateOne) SetAmount(v domain.Money) *LoanPrepaymentUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdateOne) SetNillableAmount(v *domain.Money) *LoanPrepaymentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *LoanPrepaymentUpdateOne) AddAmount(v domain.Money) *LoanPrepaymentUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetInterestSaved sets the "interest_saved" field.
+func (_u *LoanPrepaymentUpdateOne) SetInterestSaved(v domain.Money) *LoanPrepaymentUpdateOne {
+	_u.mutation.ResetInterestSaved()
+	_u.mutation.SetInterestSaved(v)
+	return _u
+}
+
+// SetNillableInterestSaved sets the "interest_saved" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdateOne) SetNillableInterestSaved(v *domain.Money) *LoanPrepaymentUpdateOne {
+	if v != nil {
+		_u.SetInterestSaved(*v)
+	}
+	return _u
+}
+
+// AddInterestSaved adds value to the "interest_saved" field.
+func (_u *LoanPrepaymentUpdateOne) AddInterestSaved(v domain.Money) *LoanPrepaymentUpdateOne {
+	_u.mutation.AddInterestSaved(v)
+	return _u
+}
+
+// SetLoanID sets the "loan_id" field.
+func (_u *LoanPrepaymentUpdateOne) SetLoanID(v uuid.UUID) *LoanPrepaymentUpdateOne {
+	_u.mutation.SetLoanID(v)
+	return _u
+}
+
+// SetNillableLoanID sets the "loan_id" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdateOne) SetNillableLoanID(v *uuid.UUID) *LoanPrepaymentUpdateOne {
+	if v != nil {
+		_u.SetLoanID(*v)
+	}
+	return _u
+}
+
+// SetPaymentDate sets the "payment_date" field.
+func (_u *LoanPrepaymentUpdateOne) SetPaymentDate(v time.Time) *LoanPrepaymentUpdateOne {
+	_u.mutation.SetPaymentDate(v)
+	return _u
+}
+
+// SetNillablePaymentDate sets the "payment_date" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdateOne) SetNillablePaymentDate(v *time.Time) *LoanPrepaymentUpdateOne {
+	if v != nil {
+		_u.SetPaymentDate(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *LoanPrepaymentUpdateOne) SetMode(v string) *LoanPrepaymentUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdateOne) SetNillableMode(v *string) *LoanPrepaymentUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *LoanPrepaymentUpdateOne) SetTransactionID(v uuid.UUID) *LoanPrepaymentUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *LoanPrepaymentUpdateOne) SetNillableTransactionID(v *uuid.UUID) *LoanPrepaymentUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *LoanPrepaymentUpdateOne) ClearTransactionID() *LoanPrepaymentUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *LoanPrepaymentUpdateOne) SetLedgerID(id uuid.UUID) *LoanPrepaymentUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *LoanPrepaymentUpdateOne) SetLedger(v *Ledger) *LoanPrepaymentUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_u *LoanPrepaymentUpdateOne) SetLoan(v *Loan) *LoanPrepaymentUpdateOne {
+	return _u.SetLoanID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *LoanPrepaymentUpdateOne) SetTransaction(v *Transaction) *LoanPrepaymentUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the LoanPrepaymentMutation object of the builder.
+func (_u *LoanPrepaymentUpdateOne) Mutation() *LoanPrepaymentMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *LoanPrepaymentUpdateOne) ClearLedger() *LoanPrepaymentUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (_u *LoanPrepaymentUpdateOne) ClearLoan() *LoanPrepaymentUpdateOne {
+	_u.mutation.ClearLoan()
+	return _u
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *LoanPrepaymentUpdateOne) ClearTransaction() *LoanPrepaymentUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the LoanPrepaymentUpdate builder.
+func (_u *LoanPrepaymentUpdateOne) Where(ps ...predicate.LoanPrepayment) *LoanPrepaymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoanPrepaymentUpdateOne) Select(field string, fields ...string) *LoanPrepaymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoanPrepayment entity.
+func (_u *LoanPrepaymentUpdateOne) Save(ctx context.Context) (*LoanPrepayment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanPrepaymentUpdateOne) SaveX(ctx context.Context) *LoanPrepayment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoanPrepaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanPrepaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanPrepaymentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loanprepayment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanPrepaymentUpdateOne) check() error {
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := loanprepayment.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "LoanPrepayment.mode": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanPrepayment.ledger"`)
+	}
+	if _u.mutation.LoanCleared() && len(_u.mutation.LoanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LoanPrepayment.loan"`)
+	}
+	return nil
+}
+
+func (_u *LoanPrepaymentUpdateOne) sqlSave(ctx context.Context) (_node *LoanPrepayment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loanprepayment.Table, loanprepayment.Columns, sqlgraph.NewFieldSpec(loanprepayment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoanPrepayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loanprepayment.FieldID)
+		for _, f := range fields {
+			if !loanprepayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loanprepayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loanprepayment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(loanprepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(loanprepayment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.InterestSaved(); ok {
+		_spec.SetField(loanprepayment.FieldInterestSaved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInterestSaved(); ok {
+		_spec.AddField(loanprepayment.FieldInterestSaved, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PaymentDate(); ok {
+		_spec.SetField(loanprepayment.FieldPaymentDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(loanprepayment.FieldMode, field.TypeString, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LedgerTable,
+			Columns: []string{loanprepayment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LedgerTable,
+			Columns: []string{loanprepayment.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LoanTable,
+			Columns: []string{loanprepayment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.LoanTable,
+			Columns: []string{loanprepayment.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.TransactionTable,
+			Columns: []string{loanprepayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loanprepayment.TransactionTable,
+			Columns: []string{loanprepayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoanPrepayment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loanprepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
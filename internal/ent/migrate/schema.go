@@ -3,51 +3,1214 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AccountsColumns holds the columns for the "accounts" table.
+	AccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "account_type", Type: field.TypeString, Default: "checking"},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BRL"},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AccountsTable holds the schema information for the "accounts" table.
+	AccountsTable = &schema.Table{
+		Name:       "accounts",
+		Columns:    AccountsColumns,
+		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_ledgers_ledger",
+				Columns:    []*schema.Column{AccountsColumns[6]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "accounts_users_created_by",
+				Columns:    []*schema.Column{AccountsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AssetsColumns holds the columns for the "assets" table.
+	AssetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "class", Type: field.TypeString, Default: "asset"},
+		{Name: "kind", Type: field.TypeString, Default: "other"},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BRL"},
+		{Name: "ledger_id", Type: field.TypeUUID},
+	}
+	// AssetsTable holds the schema information for the "assets" table.
+	AssetsTable = &schema.Table{
+		Name:       "assets",
+		Columns:    AssetsColumns,
+		PrimaryKey: []*schema.Column{AssetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "assets_ledgers_ledger",
+				Columns:    []*schema.Column{AssetsColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "blob_key", Type: field.TypeString},
+		{Name: "import_job_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "transaction_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_ledgers_ledger",
+				Columns:    []*schema.Column{AttachmentsColumns[8]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "attachments_transactions_transaction",
+				Columns:    []*schema.Column{AttachmentsColumns[9]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "attachments_invoices_invoice",
+				Columns:    []*schema.Column{AttachmentsColumns[10]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[9]},
+			},
+			{
+				Name:    "attachment_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[10]},
+			},
+			{
+				Name:    "attachment_import_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[7]},
+			},
+			{
+				Name:    "attachment_blob_key",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[6]},
+			},
+		},
+	}
+	// BudgetsColumns holds the columns for the "budgets" table.
+	BudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "month", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "amount", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "percentage", Type: field.TypeInt, Nullable: true},
+		{Name: "alerted_threshold", Type: field.TypeInt, Default: 0},
+		{Name: "alerted_month", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "category_id", Type: field.TypeUUID},
+	}
+	// BudgetsTable holds the schema information for the "budgets" table.
+	BudgetsTable = &schema.Table{
+		Name:       "budgets",
+		Columns:    BudgetsColumns,
+		PrimaryKey: []*schema.Column{BudgetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budgets_ledgers_ledger",
+				Columns:    []*schema.Column{BudgetsColumns[8]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "budgets_categories_category",
+				Columns:    []*schema.Column{BudgetsColumns[9]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budget_category_id_month_ledger_id",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetsColumns[9], BudgetsColumns[3], BudgetsColumns[8]},
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "name", Type: field.TypeString, Unique: true, Size: 255},
-		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7},
-		{Name: "suggested_percentage", Type: field.TypeInt, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7},
+		{Name: "suggested_percentage", Type: field.TypeInt, Nullable: true},
+		{Name: "tax_group", Type: field.TypeString, Nullable: true},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_ledgers_ledger",
+				Columns:    []*schema.Column{CategoriesColumns[8]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "categories_users_created_by",
+				Columns:    []*schema.Column{CategoriesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[10]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_name_ledger_id",
+				Unique:  true,
+				Columns: []*schema.Column{CategoriesColumns[3], CategoriesColumns[8]},
+			},
+			{
+				Name:    "category_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[10]},
+			},
+		},
+	}
+	// EnvelopeAllocationsColumns holds the columns for the "envelope_allocations" table.
+	EnvelopeAllocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "month", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "transfer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "category_id", Type: field.TypeUUID},
+	}
+	// EnvelopeAllocationsTable holds the schema information for the "envelope_allocations" table.
+	EnvelopeAllocationsTable = &schema.Table{
+		Name:       "envelope_allocations",
+		Columns:    EnvelopeAllocationsColumns,
+		PrimaryKey: []*schema.Column{EnvelopeAllocationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "envelope_allocations_ledgers_ledger",
+				Columns:    []*schema.Column{EnvelopeAllocationsColumns[8]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "envelope_allocations_categories_category",
+				Columns:    []*schema.Column{EnvelopeAllocationsColumns[9]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envelopeallocation_month_ledger_id",
+				Unique:  false,
+				Columns: []*schema.Column{EnvelopeAllocationsColumns[4], EnvelopeAllocationsColumns[8]},
+			},
+			{
+				Name:    "envelopeallocation_category_id",
+				Unique:  false,
+				Columns: []*schema.Column{EnvelopeAllocationsColumns[9]},
+			},
+			{
+				Name:    "envelopeallocation_transfer_id",
+				Unique:  false,
+				Columns: []*schema.Column{EnvelopeAllocationsColumns[6]},
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "from_currency", Type: field.TypeString, Size: 3},
+		{Name: "to_currency", Type: field.TypeString, Size: 3},
+		{Name: "rate", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(18,8)"}},
+		{Name: "rate_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ledger_id", Type: field.TypeUUID},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "exchange_rates_ledgers_ledger",
+				Columns:    []*schema.Column{ExchangeRatesColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangerate_from_currency_to_currency_rate_date_ledger_id",
+				Unique:  true,
+				Columns: []*schema.Column{ExchangeRatesColumns[3], ExchangeRatesColumns[4], ExchangeRatesColumns[6], ExchangeRatesColumns[7]},
+			},
+		},
+	}
+	// GoalsColumns holds the columns for the "goals" table.
+	GoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "target_amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "initial_amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "target_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "tag_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// GoalsTable holds the schema information for the "goals" table.
+	GoalsTable = &schema.Table{
+		Name:       "goals",
+		Columns:    GoalsColumns,
+		PrimaryKey: []*schema.Column{GoalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goals_ledgers_ledger",
+				Columns:    []*schema.Column{GoalsColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "goals_accounts_account",
+				Columns:    []*schema.Column{GoalsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "goals_categories_category",
+				Columns:    []*schema.Column{GoalsColumns[9]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "goals_tags_tag",
+				Columns:    []*schema.Column{GoalsColumns[10]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// HoldingsColumns holds the columns for the "holdings" table.
+	HoldingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "ticker", Type: field.TypeString, Size: 32},
+		{Name: "asset_class", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BRL"},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// HoldingsTable holds the schema information for the "holdings" table.
+	HoldingsTable = &schema.Table{
+		Name:       "holdings",
+		Columns:    HoldingsColumns,
+		PrimaryKey: []*schema.Column{HoldingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "holdings_ledgers_ledger",
+				Columns:    []*schema.Column{HoldingsColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "holdings_accounts_account",
+				Columns:    []*schema.Column{HoldingsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "holding_ticker_ledger_id",
+				Unique:  true,
+				Columns: []*schema.Column{HoldingsColumns[4], HoldingsColumns[7]},
+			},
+		},
+	}
+	// ImportJobsColumns holds the columns for the "import_jobs" table.
+	ImportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "total", Type: field.TypeInt},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ImportJobsTable holds the schema information for the "import_jobs" table.
+	ImportJobsTable = &schema.Table{
+		Name:       "import_jobs",
+		Columns:    ImportJobsColumns,
+		PrimaryKey: []*schema.Column{ImportJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_jobs_users_user",
+				Columns:    []*schema.Column{ImportJobsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// InvestmentIncomesColumns holds the columns for the "investment_incomes" table.
+	InvestmentIncomesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "payment_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "holding_id", Type: field.TypeUUID},
+		{Name: "transaction_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// InvestmentIncomesTable holds the schema information for the "investment_incomes" table.
+	InvestmentIncomesTable = &schema.Table{
+		Name:       "investment_incomes",
+		Columns:    InvestmentIncomesColumns,
+		PrimaryKey: []*schema.Column{InvestmentIncomesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "investment_incomes_ledgers_ledger",
+				Columns:    []*schema.Column{InvestmentIncomesColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "investment_incomes_holdings_holding",
+				Columns:    []*schema.Column{InvestmentIncomesColumns[8]},
+				RefColumns: []*schema.Column{HoldingsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "investment_incomes_transactions_transaction",
+				Columns:    []*schema.Column{InvestmentIncomesColumns[9]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "investmentincome_holding_id_payment_date",
+				Unique:  false,
+				Columns: []*schema.Column{InvestmentIncomesColumns[8], InvestmentIncomesColumns[5]},
+			},
+		},
+	}
+	// InvestmentQuotesColumns holds the columns for the "investment_quotes" table.
+	InvestmentQuotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "price", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "quote_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "holding_id", Type: field.TypeUUID},
+	}
+	// InvestmentQuotesTable holds the schema information for the "investment_quotes" table.
+	InvestmentQuotesTable = &schema.Table{
+		Name:       "investment_quotes",
+		Columns:    InvestmentQuotesColumns,
+		PrimaryKey: []*schema.Column{InvestmentQuotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "investment_quotes_ledgers_ledger",
+				Columns:    []*schema.Column{InvestmentQuotesColumns[5]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "investment_quotes_holdings_holding",
+				Columns:    []*schema.Column{InvestmentQuotesColumns[6]},
+				RefColumns: []*schema.Column{HoldingsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "investmentquote_holding_id_quote_date",
+				Unique:  true,
+				Columns: []*schema.Column{InvestmentQuotesColumns[6], InvestmentQuotesColumns[4]},
+			},
+		},
+	}
+	// InvestmentTradesColumns holds the columns for the "investment_trades" table.
+	InvestmentTradesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "price", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "fees", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "trade_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "quantity", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(24,8)"}},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "holding_id", Type: field.TypeUUID},
+	}
+	// InvestmentTradesTable holds the schema information for the "investment_trades" table.
+	InvestmentTradesTable = &schema.Table{
+		Name:       "investment_trades",
+		Columns:    InvestmentTradesColumns,
+		PrimaryKey: []*schema.Column{InvestmentTradesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "investment_trades_ledgers_ledger",
+				Columns:    []*schema.Column{InvestmentTradesColumns[9]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "investment_trades_holdings_holding",
+				Columns:    []*schema.Column{InvestmentTradesColumns[10]},
+				RefColumns: []*schema.Column{HoldingsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "investmenttrade_holding_id_trade_date",
+				Unique:  false,
+				Columns: []*schema.Column{InvestmentTradesColumns[10], InvestmentTradesColumns[6]},
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "payment_account_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_ledgers_ledger",
+				Columns:    []*schema.Column{InvoicesColumns[9]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "invoices_users_created_by",
+				Columns:    []*schema.Column{InvoicesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invoices_accounts_payment_account",
+				Columns:    []*schema.Column{InvoicesColumns[11]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// InvoicePaymentsColumns holds the columns for the "invoice_payments" table.
+	InvoicePaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "paid_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString, Default: "payment"},
+		{Name: "invoice_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// InvoicePaymentsTable holds the schema information for the "invoice_payments" table.
+	InvoicePaymentsTable = &schema.Table{
+		Name:       "invoice_payments",
+		Columns:    InvoicePaymentsColumns,
+		PrimaryKey: []*schema.Column{InvoicePaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoice_payments_invoices_invoice",
+				Columns:    []*schema.Column{InvoicePaymentsColumns[6]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "invoice_payments_accounts_account",
+				Columns:    []*schema.Column{InvoicePaymentsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicepayment_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[6]},
+			},
+		},
+	}
+	// LedgersColumns holds the columns for the "ledgers" table.
+	LedgersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "personal", Type: field.TypeBool, Default: false},
+		{Name: "category_model_version", Type: field.TypeInt64, Default: 0},
+		{Name: "owner_id", Type: field.TypeUUID},
+	}
+	// LedgersTable holds the schema information for the "ledgers" table.
+	LedgersTable = &schema.Table{
+		Name:       "ledgers",
+		Columns:    LedgersColumns,
+		PrimaryKey: []*schema.Column{LedgersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledgers_users_owner",
+				Columns:    []*schema.Column{LedgersColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledger_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgersColumns[6]},
+			},
+			{
+				Name:    "ledger_owner_id_personal",
+				Unique:  true,
+				Columns: []*schema.Column{LedgersColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "personal",
+				},
+			},
+		},
+	}
+	// LedgerInvitationsColumns holds the columns for the "ledger_invitations" table.
+	LedgerInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "invitee_id", Type: field.TypeUUID},
+		{Name: "invited_by_id", Type: field.TypeUUID},
+	}
+	// LedgerInvitationsTable holds the schema information for the "ledger_invitations" table.
+	LedgerInvitationsTable = &schema.Table{
+		Name:       "ledger_invitations",
+		Columns:    LedgerInvitationsColumns,
+		PrimaryKey: []*schema.Column{LedgerInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_invitations_ledgers_ledger",
+				Columns:    []*schema.Column{LedgerInvitationsColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ledger_invitations_users_invitee",
+				Columns:    []*schema.Column{LedgerInvitationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ledger_invitations_users_invited_by",
+				Columns:    []*schema.Column{LedgerInvitationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerinvitation_ledger_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerInvitationsColumns[7], LedgerInvitationsColumns[4]},
+			},
+			{
+				Name:    "ledgerinvitation_invitee_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerInvitationsColumns[8], LedgerInvitationsColumns[4]},
+			},
+		},
+	}
+	// LedgerMembersColumns holds the columns for the "ledger_members" table.
+	LedgerMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeString},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// LedgerMembersTable holds the schema information for the "ledger_members" table.
+	LedgerMembersTable = &schema.Table{
+		Name:       "ledger_members",
+		Columns:    LedgerMembersColumns,
+		PrimaryKey: []*schema.Column{LedgerMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_members_ledgers_ledger",
+				Columns:    []*schema.Column{LedgerMembersColumns[4]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ledger_members_users_user",
+				Columns:    []*schema.Column{LedgerMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgermember_ledger_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{LedgerMembersColumns[4], LedgerMembersColumns[5]},
+			},
+			{
+				Name:    "ledgermember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerMembersColumns[5]},
+			},
+		},
+	}
+	// LoansColumns holds the columns for the "loans" table.
+	LoansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "principal", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "interest_rate", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(9,4)"}},
+		{Name: "rate_period", Type: field.TypeString, Default: "monthly"},
+		{Name: "term_months", Type: field.TypeInt},
+		{Name: "system", Type: field.TypeString},
+		{Name: "first_payment_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BRL"},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "interest_category_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "principal_category_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// LoansTable holds the schema information for the "loans" table.
+	LoansTable = &schema.Table{
+		Name:       "loans",
+		Columns:    LoansColumns,
+		PrimaryKey: []*schema.Column{LoansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loans_ledgers_ledger",
+				Columns:    []*schema.Column{LoansColumns[11]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "loans_accounts_account",
+				Columns:    []*schema.Column{LoansColumns[12]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "loans_categories_interest_category",
+				Columns:    []*schema.Column{LoansColumns[13]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "loans_categories_principal_category",
+				Columns:    []*schema.Column{LoansColumns[14]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// LoanInstallmentsColumns holds the columns for the "loan_installments" table.
+	LoanInstallmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "payment", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "interest", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "principal", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "balance", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "due_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "loan_id", Type: field.TypeUUID},
+		{Name: "transaction_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// LoanInstallmentsTable holds the schema information for the "loan_installments" table.
+	LoanInstallmentsTable = &schema.Table{
+		Name:       "loan_installments",
+		Columns:    LoanInstallmentsColumns,
+		PrimaryKey: []*schema.Column{LoanInstallmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loan_installments_ledgers_ledger",
+				Columns:    []*schema.Column{LoanInstallmentsColumns[9]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "loan_installments_loans_loan",
+				Columns:    []*schema.Column{LoanInstallmentsColumns[10]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "loan_installments_transactions_transaction",
+				Columns:    []*schema.Column{LoanInstallmentsColumns[11]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loaninstallment_loan_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{LoanInstallmentsColumns[10], LoanInstallmentsColumns[7]},
+			},
+		},
+	}
+	// LoanPrepaymentsColumns holds the columns for the "loan_prepayments" table.
+	LoanPrepaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "interest_saved", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "payment_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "mode", Type: field.TypeString},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "loan_id", Type: field.TypeUUID},
+		{Name: "transaction_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// LoanPrepaymentsTable holds the schema information for the "loan_prepayments" table.
+	LoanPrepaymentsTable = &schema.Table{
+		Name:       "loan_prepayments",
+		Columns:    LoanPrepaymentsColumns,
+		PrimaryKey: []*schema.Column{LoanPrepaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loan_prepayments_ledgers_ledger",
+				Columns:    []*schema.Column{LoanPrepaymentsColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "loan_prepayments_loans_loan",
+				Columns:    []*schema.Column{LoanPrepaymentsColumns[8]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "loan_prepayments_transactions_transaction",
+				Columns:    []*schema.Column{LoanPrepaymentsColumns[9]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loanprepayment_loan_id_payment_date",
+				Unique:  false,
+				Columns: []*schema.Column{LoanPrepaymentsColumns[8], LoanPrepaymentsColumns[5]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "body", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_user",
+				Columns:    []*schema.Column{NotificationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_created_at_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[9]},
+			},
+			{
+				Name:    "notification_key_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationsColumns[4], NotificationsColumns[9]},
+			},
+		},
+	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "reference_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "body", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "not_before", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
 	}
-	// CategoriesTable holds the schema information for the "categories" table.
-	CategoriesTable = &schema.Table{
-		Name:       "categories",
-		Columns:    CategoriesColumns,
-		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_deliveries_users_user",
+				Columns:    []*schema.Column{NotificationDeliveriesColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_key_channel_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[4], NotificationDeliveriesColumns[5], NotificationDeliveriesColumns[15]},
+			},
+			{
+				Name:    "notificationdelivery_status",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[10]},
+			},
+		},
 	}
-	// InvoicesColumns holds the columns for the "invoices" table.
-	InvoicesColumns = []*schema.Column{
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "status", Type: field.TypeString, Default: "pending"},
-		{Name: "amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
-		{Name: "title", Type: field.TypeString, Size: 255},
-		{Name: "due_date", Type: field.TypeTime},
+		{Name: "lead_days", Type: field.TypeInt, Default: 3},
+		{Name: "quiet_start", Type: field.TypeString, Nullable: true, Size: 5},
+		{Name: "quiet_end", Type: field.TypeString, Nullable: true, Size: 5},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Default: "America/Sao_Paulo"},
+		{Name: "email_enabled", Type: field.TypeBool, Default: false},
+		{Name: "webhook_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "webhook_secret", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "inapp_enabled", Type: field.TypeBool, Default: true},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
-	// InvoicesTable holds the schema information for the "invoices" table.
-	InvoicesTable = &schema.Table{
-		Name:       "invoices",
-		Columns:    InvoicesColumns,
-		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_users_user",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[11]},
+			},
+		},
+	}
+	// PayeesColumns holds the columns for the "payees" table.
+	PayeesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "normalized_name", Type: field.TypeString, Size: 255},
+		{Name: "aliases", Type: field.TypeJSON},
+		{Name: "document", Type: field.TypeString, Nullable: true},
+		{Name: "ledger_id", Type: field.TypeUUID},
+	}
+	// PayeesTable holds the schema information for the "payees" table.
+	PayeesTable = &schema.Table{
+		Name:       "payees",
+		Columns:    PayeesColumns,
+		PrimaryKey: []*schema.Column{PayeesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payees_ledgers_ledger",
+				Columns:    []*schema.Column{PayeesColumns[7]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payee_normalized_name_ledger_id",
+				Unique:  true,
+				Columns: []*schema.Column{PayeesColumns[4], PayeesColumns[7]},
+			},
+		},
+	}
+	// RecurringRulesColumns holds the columns for the "recurring_rules" table.
+	RecurringRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "record_type", Type: field.TypeString, Default: "expense"},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BRL"},
+		{Name: "frequency", Type: field.TypeString},
+		{Name: "start_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// RecurringRulesTable holds the schema information for the "recurring_rules" table.
+	RecurringRulesTable = &schema.Table{
+		Name:       "recurring_rules",
+		Columns:    RecurringRulesColumns,
+		PrimaryKey: []*schema.Column{RecurringRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recurring_rules_ledgers_ledger",
+				Columns:    []*schema.Column{RecurringRulesColumns[10]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "recurring_rules_accounts_account",
+				Columns:    []*schema.Column{RecurringRulesColumns[11]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "recurring_rules_categories_category",
+				Columns:    []*schema.Column{RecurringRulesColumns[12]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// RulesColumns holds the columns for the "rules" table.
+	RulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "title_contains", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "title_regex", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "amount_min", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "amount_max", Type: field.TypeInt64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "record_type", Type: field.TypeString, Nullable: true},
+		{Name: "action_record_type", Type: field.TypeString, Nullable: true},
+		{Name: "action_status", Type: field.TypeString, Nullable: true},
+		{Name: "skip", Type: field.TypeBool, Default: false},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// RulesTable holds the schema information for the "rules" table.
+	RulesTable = &schema.Table{
+		Name:       "rules",
+		Columns:    RulesColumns,
+		PrimaryKey: []*schema.Column{RulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rules_ledgers_ledger",
+				Columns:    []*schema.Column{RulesColumns[14]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "rules_invoices_invoice",
+				Columns:    []*schema.Column{RulesColumns[15]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "rules_categories_category",
+				Columns:    []*schema.Column{RulesColumns[16]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rule_priority_ledger_id",
+				Unique:  false,
+				Columns: []*schema.Column{RulesColumns[4], RulesColumns[14]},
+			},
+		},
+	}
+	// SettlementsColumns holds the columns for the "settlements" table.
+	SettlementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "settled_at", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "from_user_id", Type: field.TypeUUID},
+		{Name: "to_user_id", Type: field.TypeUUID},
+	}
+	// SettlementsTable holds the schema information for the "settlements" table.
+	SettlementsTable = &schema.Table{
+		Name:       "settlements",
+		Columns:    SettlementsColumns,
+		PrimaryKey: []*schema.Column{SettlementsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "invoices_users_user",
-				Columns:    []*schema.Column{InvoicesColumns[7]},
+				Symbol:     "settlements_ledgers_ledger",
+				Columns:    []*schema.Column{SettlementsColumns[6]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "settlements_users_from_user",
+				Columns:    []*schema.Column{SettlementsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "settlements_users_to_user",
+				Columns:    []*schema.Column{SettlementsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlement_settled_at_ledger_id",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementsColumns[4], SettlementsColumns[6]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7},
+		{Name: "ledger_id", Type: field.TypeUUID},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_ledgers_ledger",
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_name_ledger_id",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[3], TagsColumns[5]},
+			},
+		},
 	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
@@ -56,12 +1219,23 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "record_type", Type: field.TypeString, Default: "expense"},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
-		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
 		{Name: "title", Type: field.TypeString, Size: 255},
 		{Name: "record_date", Type: field.TypeTime},
-		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BRL"},
+		{Name: "exchange_rate", Type: field.TypeFloat64, Default: 1, SchemaType: map[string]string{"postgres": "decimal(18,8)"}},
+		{Name: "category_source", Type: field.TypeString, Nullable: true},
+		{Name: "share_mode", Type: field.TypeString, Nullable: true},
+		{Name: "installment_number", Type: field.TypeInt, Nullable: true},
+		{Name: "installment_count", Type: field.TypeInt, Nullable: true},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "created_by_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "invoice_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "payee_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "recurring_rule_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "transfer_account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "paid_by_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
 	TransactionsTable = &schema.Table{
@@ -70,23 +1244,53 @@ var (
 		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "transactions_users_user",
-				Columns:    []*schema.Column{TransactionsColumns[8]},
-				RefColumns: []*schema.Column{UsersColumns[0]},
+				Symbol:     "transactions_ledgers_ledger",
+				Columns:    []*schema.Column{TransactionsColumns[14]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "transactions_users_created_by",
+				Columns:    []*schema.Column{TransactionsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "transactions_invoices_invoice",
-				Columns:    []*schema.Column{TransactionsColumns[9]},
+				Columns:    []*schema.Column{TransactionsColumns[16]},
 				RefColumns: []*schema.Column{InvoicesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "transactions_categories_category",
-				Columns:    []*schema.Column{TransactionsColumns[10]},
+				Columns:    []*schema.Column{TransactionsColumns[17]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "transactions_payees_payee",
+				Columns:    []*schema.Column{TransactionsColumns[18]},
+				RefColumns: []*schema.Column{PayeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_recurring_rules_recurring_rule",
+				Columns:    []*schema.Column{TransactionsColumns[19]},
+				RefColumns: []*schema.Column{RecurringRulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_accounts_transfer_account",
+				Columns:    []*schema.Column{TransactionsColumns[20]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_users_paid_by",
+				Columns:    []*schema.Column{TransactionsColumns[21]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -102,17 +1306,116 @@ var (
 			{
 				Name:    "transaction_invoice_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[9]},
+				Columns: []*schema.Column{TransactionsColumns[16]},
 			},
 			{
 				Name:    "transaction_category_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[10]},
+				Columns: []*schema.Column{TransactionsColumns[17]},
+			},
+			{
+				Name:    "transaction_payee_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[18]},
+			},
+			{
+				Name:    "transaction_recurring_rule_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[19]},
+			},
+			{
+				Name:    "transaction_transfer_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[20]},
 			},
 			{
 				Name:    "transaction_record_date_record_type_category_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[7], TransactionsColumns[3], TransactionsColumns[10]},
+				Columns: []*schema.Column{TransactionsColumns[7], TransactionsColumns[3], TransactionsColumns[17]},
+			},
+		},
+	}
+	// TransactionParticipantsColumns holds the columns for the "transaction_participants" table.
+	TransactionParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "percentage", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(5,2)"}},
+		{Name: "transaction_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// TransactionParticipantsTable holds the schema information for the "transaction_participants" table.
+	TransactionParticipantsTable = &schema.Table{
+		Name:       "transaction_participants",
+		Columns:    TransactionParticipantsColumns,
+		PrimaryKey: []*schema.Column{TransactionParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transaction_participants_transactions_transaction",
+				Columns:    []*schema.Column{TransactionParticipantsColumns[5]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transaction_participants_users_user",
+				Columns:    []*schema.Column{TransactionParticipantsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transactionparticipant_transaction_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TransactionParticipantsColumns[5], TransactionParticipantsColumns[6]},
+			},
+			{
+				Name:    "transactionparticipant_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionParticipantsColumns[6]},
+			},
+		},
+	}
+	// TransactionSplitsColumns holds the columns for the "transaction_splits" table.
+	TransactionSplitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "transaction_id", Type: field.TypeUUID},
+		{Name: "category_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// TransactionSplitsTable holds the schema information for the "transaction_splits" table.
+	TransactionSplitsTable = &schema.Table{
+		Name:       "transaction_splits",
+		Columns:    TransactionSplitsColumns,
+		PrimaryKey: []*schema.Column{TransactionSplitsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transaction_splits_transactions_transaction",
+				Columns:    []*schema.Column{TransactionSplitsColumns[5]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transaction_splits_categories_category",
+				Columns:    []*schema.Column{TransactionSplitsColumns[6]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transactionsplit_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionSplitsColumns[5]},
+			},
+			{
+				Name:    "transactionsplit_category_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionSplitsColumns[6]},
 			},
 		},
 	}
@@ -126,6 +1429,7 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true, Size: 255},
 		{Name: "password_hash", Type: field.TypeString, Size: 255},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "base_currency", Type: field.TypeString, Size: 3, Default: "BRL"},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -133,18 +1437,205 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// ValuationsColumns holds the columns for the "valuations" table.
+	ValuationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "decimal(18,2)"}},
+		{Name: "value_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ledger_id", Type: field.TypeUUID},
+		{Name: "asset_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ValuationsTable holds the schema information for the "valuations" table.
+	ValuationsTable = &schema.Table{
+		Name:       "valuations",
+		Columns:    ValuationsColumns,
+		PrimaryKey: []*schema.Column{ValuationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "valuations_ledgers_ledger",
+				Columns:    []*schema.Column{ValuationsColumns[6]},
+				RefColumns: []*schema.Column{LedgersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "valuations_assets_asset",
+				Columns:    []*schema.Column{ValuationsColumns[7]},
+				RefColumns: []*schema.Column{AssetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "valuations_accounts_account",
+				Columns:    []*schema.Column{ValuationsColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "valuation_asset_id_value_date",
+				Unique:  true,
+				Columns: []*schema.Column{ValuationsColumns[7], ValuationsColumns[4]},
+			},
+			{
+				Name:    "valuation_account_id_value_date",
+				Unique:  true,
+				Columns: []*schema.Column{ValuationsColumns[8], ValuationsColumns[4]},
+			},
+			{
+				Name:    "valuation_value_date_ledger_id",
+				Unique:  false,
+				Columns: []*schema.Column{ValuationsColumns[4], ValuationsColumns[6]},
+			},
+		},
+	}
+	// TransactionTagsColumns holds the columns for the "transaction_tags" table.
+	TransactionTagsColumns = []*schema.Column{
+		{Name: "transaction_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// TransactionTagsTable holds the schema information for the "transaction_tags" table.
+	TransactionTagsTable = &schema.Table{
+		Name:       "transaction_tags",
+		Columns:    TransactionTagsColumns,
+		PrimaryKey: []*schema.Column{TransactionTagsColumns[0], TransactionTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transaction_tags_transaction_id",
+				Columns:    []*schema.Column{TransactionTagsColumns[0]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transaction_tags_tag_id",
+				Columns:    []*schema.Column{TransactionTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountsTable,
+		AssetsTable,
+		AttachmentsTable,
+		BudgetsTable,
 		CategoriesTable,
+		EnvelopeAllocationsTable,
+		ExchangeRatesTable,
+		GoalsTable,
+		HoldingsTable,
+		ImportJobsTable,
+		InvestmentIncomesTable,
+		InvestmentQuotesTable,
+		InvestmentTradesTable,
 		InvoicesTable,
+		InvoicePaymentsTable,
+		LedgersTable,
+		LedgerInvitationsTable,
+		LedgerMembersTable,
+		LoansTable,
+		LoanInstallmentsTable,
+		LoanPrepaymentsTable,
+		NotificationsTable,
+		NotificationDeliveriesTable,
+		NotificationPreferencesTable,
+		PayeesTable,
+		RecurringRulesTable,
+		RulesTable,
+		SettlementsTable,
+		TagsTable,
 		TransactionsTable,
+		TransactionParticipantsTable,
+		TransactionSplitsTable,
 		UsersTable,
+		ValuationsTable,
+		TransactionTagsTable,
 	}
 )
 
 func init() {
-	InvoicesTable.ForeignKeys[0].RefTable = UsersTable
-	TransactionsTable.ForeignKeys[0].RefTable = UsersTable
-	TransactionsTable.ForeignKeys[1].RefTable = InvoicesTable
-	TransactionsTable.ForeignKeys[2].RefTable = CategoriesTable
+	AccountsTable.ForeignKeys[0].RefTable = LedgersTable
+	AccountsTable.ForeignKeys[1].RefTable = UsersTable
+	AssetsTable.ForeignKeys[0].RefTable = LedgersTable
+	AttachmentsTable.ForeignKeys[0].RefTable = LedgersTable
+	AttachmentsTable.ForeignKeys[1].RefTable = TransactionsTable
+	AttachmentsTable.ForeignKeys[2].RefTable = InvoicesTable
+	BudgetsTable.ForeignKeys[0].RefTable = LedgersTable
+	BudgetsTable.ForeignKeys[1].RefTable = CategoriesTable
+	CategoriesTable.ForeignKeys[0].RefTable = LedgersTable
+	CategoriesTable.ForeignKeys[1].RefTable = UsersTable
+	CategoriesTable.ForeignKeys[2].RefTable = CategoriesTable
+	EnvelopeAllocationsTable.ForeignKeys[0].RefTable = LedgersTable
+	EnvelopeAllocationsTable.ForeignKeys[1].RefTable = CategoriesTable
+	ExchangeRatesTable.ForeignKeys[0].RefTable = LedgersTable
+	GoalsTable.ForeignKeys[0].RefTable = LedgersTable
+	GoalsTable.ForeignKeys[1].RefTable = AccountsTable
+	GoalsTable.ForeignKeys[2].RefTable = CategoriesTable
+	GoalsTable.ForeignKeys[3].RefTable = TagsTable
+	HoldingsTable.ForeignKeys[0].RefTable = LedgersTable
+	HoldingsTable.ForeignKeys[1].RefTable = AccountsTable
+	ImportJobsTable.ForeignKeys[0].RefTable = UsersTable
+	InvestmentIncomesTable.ForeignKeys[0].RefTable = LedgersTable
+	InvestmentIncomesTable.ForeignKeys[1].RefTable = HoldingsTable
+	InvestmentIncomesTable.ForeignKeys[2].RefTable = TransactionsTable
+	InvestmentQuotesTable.ForeignKeys[0].RefTable = LedgersTable
+	InvestmentQuotesTable.ForeignKeys[1].RefTable = HoldingsTable
+	InvestmentTradesTable.ForeignKeys[0].RefTable = LedgersTable
+	InvestmentTradesTable.ForeignKeys[1].RefTable = HoldingsTable
+	InvoicesTable.ForeignKeys[0].RefTable = LedgersTable
+	InvoicesTable.ForeignKeys[1].RefTable = UsersTable
+	InvoicesTable.ForeignKeys[2].RefTable = AccountsTable
+	InvoicePaymentsTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoicePaymentsTable.ForeignKeys[1].RefTable = AccountsTable
+	LedgersTable.ForeignKeys[0].RefTable = UsersTable
+	LedgerInvitationsTable.ForeignKeys[0].RefTable = LedgersTable
+	LedgerInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	LedgerInvitationsTable.ForeignKeys[2].RefTable = UsersTable
+	LedgerMembersTable.ForeignKeys[0].RefTable = LedgersTable
+	LedgerMembersTable.ForeignKeys[1].RefTable = UsersTable
+	LoansTable.ForeignKeys[0].RefTable = LedgersTable
+	LoansTable.ForeignKeys[1].RefTable = AccountsTable
+	LoansTable.ForeignKeys[2].RefTable = CategoriesTable
+	LoansTable.ForeignKeys[3].RefTable = CategoriesTable
+	LoanInstallmentsTable.ForeignKeys[0].RefTable = LedgersTable
+	LoanInstallmentsTable.ForeignKeys[1].RefTable = LoansTable
+	LoanInstallmentsTable.ForeignKeys[2].RefTable = TransactionsTable
+	LoanPrepaymentsTable.ForeignKeys[0].RefTable = LedgersTable
+	LoanPrepaymentsTable.ForeignKeys[1].RefTable = LoansTable
+	LoanPrepaymentsTable.ForeignKeys[2].RefTable = TransactionsTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationDeliveriesTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	PayeesTable.ForeignKeys[0].RefTable = LedgersTable
+	RecurringRulesTable.ForeignKeys[0].RefTable = LedgersTable
+	RecurringRulesTable.ForeignKeys[1].RefTable = AccountsTable
+	RecurringRulesTable.ForeignKeys[2].RefTable = CategoriesTable
+	RulesTable.ForeignKeys[0].RefTable = LedgersTable
+	RulesTable.ForeignKeys[1].RefTable = InvoicesTable
+	RulesTable.ForeignKeys[2].RefTable = CategoriesTable
+	SettlementsTable.ForeignKeys[0].RefTable = LedgersTable
+	SettlementsTable.ForeignKeys[1].RefTable = UsersTable
+	SettlementsTable.ForeignKeys[2].RefTable = UsersTable
+	TagsTable.ForeignKeys[0].RefTable = LedgersTable
+	TransactionsTable.ForeignKeys[0].RefTable = LedgersTable
+	TransactionsTable.ForeignKeys[1].RefTable = UsersTable
+	TransactionsTable.ForeignKeys[2].RefTable = InvoicesTable
+	TransactionsTable.ForeignKeys[3].RefTable = CategoriesTable
+	TransactionsTable.ForeignKeys[4].RefTable = PayeesTable
+	TransactionsTable.ForeignKeys[5].RefTable = RecurringRulesTable
+	TransactionsTable.ForeignKeys[6].RefTable = AccountsTable
+	TransactionsTable.ForeignKeys[7].RefTable = UsersTable
+	TransactionParticipantsTable.ForeignKeys[0].RefTable = TransactionsTable
+	TransactionParticipantsTable.ForeignKeys[1].RefTable = UsersTable
+	TransactionSplitsTable.ForeignKeys[0].RefTable = TransactionsTable
+	TransactionSplitsTable.ForeignKeys[1].RefTable = CategoriesTable
+	ValuationsTable.ForeignKeys[0].RefTable = LedgersTable
+	ValuationsTable.ForeignKeys[1].RefTable = AssetsTable
+	ValuationsTable.ForeignKeys[2].RefTable = AccountsTable
+	TransactionTagsTable.ForeignKeys[0].RefTable = TransactionsTable
+	TransactionTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
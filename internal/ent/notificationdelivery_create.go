@@ -0,0 +1,1406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/notificationdelivery"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationDeliveryCreate is the builder for creating a NotificationDelivery entity.
+type NotificationDeliveryCreate struct {
+	config
+	mutation *NotificationDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationDeliveryCreate) SetCreatedAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableCreatedAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NotificationDeliveryCreate) SetUpdatedAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableUpdatedAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *NotificationDeliveryCreate) SetKind(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *NotificationDeliveryCreate) SetKey(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *NotificationDeliveryCreate) SetChannel(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_c *NotificationDeliveryCreate) SetReferenceID(v uuid.UUID) *NotificationDeliveryCreate {
+	_c.mutation.SetReferenceID(v)
+	return _c
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableReferenceID(v *uuid.UUID) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetReferenceID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *NotificationDeliveryCreate) SetTitle(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *NotificationDeliveryCreate) SetBody(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *NotificationDeliveryCreate) SetPayload(v map[string]interface{}) *NotificationDeliveryCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *NotificationDeliveryCreate) SetStatus(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableStatus(v *string) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *NotificationDeliveryCreate) SetAttempts(v int) *NotificationDeliveryCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableAttempts(v *int) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *NotificationDeliveryCreate) SetLastError(v string) *NotificationDeliveryCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableLastError(v *string) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *NotificationDeliveryCreate) SetSentAt(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableSentAt(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_c *NotificationDeliveryCreate) SetNotBefore(v time.Time) *NotificationDeliveryCreate {
+	_c.mutation.SetNotBefore(v)
+	return _c
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableNotBefore(v *time.Time) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetNotBefore(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationDeliveryCreate) SetID(v uuid.UUID) *NotificationDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationDeliveryCreate) SetNillableID(v *uuid.UUID) *NotificationDeliveryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *NotificationDeliveryCreate) SetUserID(id uuid.UUID) *NotificationDeliveryCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *NotificationDeliveryCreate) SetUser(v *User) *NotificationDeliveryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (_c *NotificationDeliveryCreate) Mutation() *NotificationDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationDelivery in the database.
+func (_c *NotificationDeliveryCreate) Save(ctx context.Context) (*NotificationDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationDeliveryCreate) SaveX(ctx context.Context) *NotificationDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := notificationdelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := notificationdelivery.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationdelivery.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationDeliveryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationDelivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationDelivery.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "NotificationDelivery.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := notificationdelivery.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "NotificationDelivery.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := notificationdelivery.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "NotificationDelivery.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := notificationdelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "NotificationDelivery.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := notificationdelivery.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "NotificationDelivery.body"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NotificationDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "NotificationDelivery.attempts"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationDelivery.user"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationDeliveryCreate) sqlSave(ctx context.Context) (*NotificationDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationDeliveryCreate) createSpec() (*NotificationDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdelivery.Table, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(notificationdelivery.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(notificationdelivery.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(notificationdelivery.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.ReferenceID(); ok {
+		_spec.SetField(notificationdelivery.FieldReferenceID, field.TypeUUID, value)
+		_node.ReferenceID = &value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(notificationdelivery.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(notificationdelivery.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(notificationdelivery.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(notificationdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(notificationdelivery.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.NotBefore(); ok {
+		_spec.SetField(notificationdelivery.FieldNotBefore, field.TypeTime, value)
+		_node.NotBefore = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDelivery.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *NotificationDeliveryUpsertOne {
+	_c.conflict = opts
+	return &NotificationDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationDeliveryCreate) OnConflictColumns(columns ...string) *NotificationDeliveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one NotificationDelivery node.
+	NotificationDeliveryUpsertOne struct {
+		create *NotificationDeliveryCreate
+	}
+
+	// NotificationDeliveryUpsert is the "OnConflict" setter.
+	NotificationDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsert) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateUpdatedAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldUpdatedAt)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *NotificationDeliveryUpsert) SetKind(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateKind() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldKind)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *NotificationDeliveryUpsert) SetKey(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateKey() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldKey)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *NotificationDeliveryUpsert) SetChannel(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateChannel() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldChannel)
+	return u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *NotificationDeliveryUpsert) SetReferenceID(v uuid.UUID) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldReferenceID, v)
+	return u
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateReferenceID() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldReferenceID)
+	return u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *NotificationDeliveryUpsert) ClearReferenceID() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldReferenceID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationDeliveryUpsert) SetTitle(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateTitle() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldTitle)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationDeliveryUpsert) SetBody(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateBody() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldBody)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *NotificationDeliveryUpsert) SetPayload(v map[string]interface{}) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdatePayload() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldPayload)
+	return u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *NotificationDeliveryUpsert) ClearPayload() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldPayload)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsert) SetStatus(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateStatus() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsert) SetAttempts(v int) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateAttempts() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsert) AddAttempts(v int) *NotificationDeliveryUpsert {
+	u.Add(notificationdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *NotificationDeliveryUpsert) SetLastError(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateLastError() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *NotificationDeliveryUpsert) ClearLastError() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldLastError)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *NotificationDeliveryUpsert) SetSentAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateSentAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldSentAt)
+	return u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *NotificationDeliveryUpsert) ClearSentAt() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldSentAt)
+	return u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (u *NotificationDeliveryUpsert) SetNotBefore(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldNotBefore, v)
+	return u
+}
+
+// UpdateNotBefore sets the "not_before" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateNotBefore() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldNotBefore)
+	return u
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (u *NotificationDeliveryUpsert) ClearNotBefore() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldNotBefore)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertOne) UpdateNewValues() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationdelivery.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationDeliveryUpsertOne) Ignore() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDeliveryUpsertOne) DoNothing() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *NotificationDeliveryUpsertOne) Update(set func(*NotificationDeliveryUpsert)) *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsertOne) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateUpdatedAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *NotificationDeliveryUpsertOne) SetKind(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateKind() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *NotificationDeliveryUpsertOne) SetKey(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateKey() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *NotificationDeliveryUpsertOne) SetChannel(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateChannel() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *NotificationDeliveryUpsertOne) SetReferenceID(v uuid.UUID) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateReferenceID() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *NotificationDeliveryUpsertOne) ClearReferenceID() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationDeliveryUpsertOne) SetTitle(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateTitle() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationDeliveryUpsertOne) SetBody(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateBody() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *NotificationDeliveryUpsertOne) SetPayload(v map[string]interface{}) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdatePayload() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *NotificationDeliveryUpsertOne) ClearPayload() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsertOne) SetStatus(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateStatus() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsertOne) SetAttempts(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsertOne) AddAttempts(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateAttempts() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *NotificationDeliveryUpsertOne) SetLastError(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateLastError() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *NotificationDeliveryUpsertOne) ClearLastError() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *NotificationDeliveryUpsertOne) SetSentAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateSentAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *NotificationDeliveryUpsertOne) ClearSentAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetNotBefore sets the "not_before" field.
+func (u *NotificationDeliveryUpsertOne) SetNotBefore(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetNotBefore(v)
+	})
+}
+
+// UpdateNotBefore sets the "not_before" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateNotBefore() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateNotBefore()
+	})
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (u *NotificationDeliveryUpsertOne) ClearNotBefore() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearNotBefore()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationDeliveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationDeliveryUpsertOne.ID is not supported by MySQL driver. Use NotificationDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationDeliveryCreateBulk is the builder for creating many NotificationDelivery entities in bulk.
+type NotificationDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationDelivery entities in the database.
+func (_c *NotificationDeliveryCreateBulk) Save(ctx context.Context) ([]*NotificationDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationDeliveryCreateBulk) SaveX(ctx context.Context) []*NotificationDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationDeliveryUpsertBulk {
+	_c.conflict = opts
+	return &NotificationDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationDeliveryCreateBulk) OnConflictColumns(columns ...string) *NotificationDeliveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationDelivery nodes.
+type NotificationDeliveryUpsertBulk struct {
+	create *NotificationDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertBulk) UpdateNewValues() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationdelivery.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertBulk) Ignore() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDeliveryUpsertBulk) DoNothing() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationDeliveryUpsertBulk) Update(set func(*NotificationDeliveryUpsert)) *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateUpdatedAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *NotificationDeliveryUpsertBulk) SetKind(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateKind() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *NotificationDeliveryUpsertBulk) SetKey(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateKey() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *NotificationDeliveryUpsertBulk) SetChannel(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateChannel() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (u *NotificationDeliveryUpsertBulk) SetReferenceID(v uuid.UUID) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetReferenceID(v)
+	})
+}
+
+// UpdateReferenceID sets the "reference_id" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateReferenceID() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateReferenceID()
+	})
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (u *NotificationDeliveryUpsertBulk) ClearReferenceID() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearReferenceID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationDeliveryUpsertBulk) SetTitle(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateTitle() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationDeliveryUpsertBulk) SetBody(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateBody() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *NotificationDeliveryUpsertBulk) SetPayload(v map[string]interface{}) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdatePayload() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (u *NotificationDeliveryUpsertBulk) ClearPayload() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearPayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsertBulk) SetStatus(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateStatus() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsertBulk) SetAttempts(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsertBulk) AddAttempts(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateAttempts() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *NotificationDeliveryUpsertBulk) SetLastError(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateLastError() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *NotificationDeliveryUpsertBulk) ClearLastError() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetSentAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateSentAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *NotificationDeliveryUpsertBulk) ClearSentAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetNotBefore sets the "not_before" field.
+func (u *NotificationDeliveryUpsertBulk) SetNotBefore(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetNotBefore(v)
+	})
+}
+
+// UpdateNotBefore sets the "not_before" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateNotBefore() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateNotBefore()
+	})
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (u *NotificationDeliveryUpsertBulk) ClearNotBefore() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearNotBefore()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
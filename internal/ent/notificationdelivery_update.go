@@ -0,0 +1,880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/notificationdelivery"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationDeliveryUpdate is the builder for updating NotificationDelivery entities.
+type NotificationDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (_u *NotificationDeliveryUpdate) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationDeliveryUpdate) SetUpdatedAt(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *NotificationDeliveryUpdate) SetKind(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableKind(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *NotificationDeliveryUpdate) SetKey(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableKey(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *NotificationDeliveryUpdate) SetChannel(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableChannel(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_u *NotificationDeliveryUpdate) SetReferenceID(v uuid.UUID) *NotificationDeliveryUpdate {
+	_u.mutation.SetReferenceID(v)
+	return _u
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableReferenceID(v *uuid.UUID) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetReferenceID(*v)
+	}
+	return _u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (_u *NotificationDeliveryUpdate) ClearReferenceID() *NotificationDeliveryUpdate {
+	_u.mutation.ClearReferenceID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *NotificationDeliveryUpdate) SetTitle(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableTitle(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *NotificationDeliveryUpdate) SetBody(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableBody(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *NotificationDeliveryUpdate) SetPayload(v map[string]interface{}) *NotificationDeliveryUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *NotificationDeliveryUpdate) ClearPayload() *NotificationDeliveryUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NotificationDeliveryUpdate) SetStatus(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableStatus(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NotificationDeliveryUpdate) SetAttempts(v int) *NotificationDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableAttempts(v *int) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NotificationDeliveryUpdate) AddAttempts(v int) *NotificationDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *NotificationDeliveryUpdate) SetLastError(v string) *NotificationDeliveryUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableLastError(v *string) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *NotificationDeliveryUpdate) ClearLastError() *NotificationDeliveryUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *NotificationDeliveryUpdate) SetSentAt(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableSentAt(v *time.Time) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *NotificationDeliveryUpdate) ClearSentAt() *NotificationDeliveryUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_u *NotificationDeliveryUpdate) SetNotBefore(v time.Time) *NotificationDeliveryUpdate {
+	_u.mutation.SetNotBefore(v)
+	return _u
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdate) SetNillableNotBefore(v *time.Time) *NotificationDeliveryUpdate {
+	if v != nil {
+		_u.SetNotBefore(*v)
+	}
+	return _u
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (_u *NotificationDeliveryUpdate) ClearNotBefore() *NotificationDeliveryUpdate {
+	_u.mutation.ClearNotBefore()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *NotificationDeliveryUpdate) SetUserID(id uuid.UUID) *NotificationDeliveryUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdate) SetUser(v *User) *NotificationDeliveryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (_u *NotificationDeliveryUpdate) Mutation() *NotificationDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdate) ClearUser() *NotificationDeliveryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := notificationdelivery.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := notificationdelivery.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := notificationdelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := notificationdelivery.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notificationdelivery.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(notificationdelivery.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(notificationdelivery.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReferenceID(); ok {
+		_spec.SetField(notificationdelivery.FieldReferenceID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldReferenceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(notificationdelivery.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(notificationdelivery.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(notificationdelivery.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(notificationdelivery.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(notificationdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(notificationdelivery.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NotBefore(); ok {
+		_spec.SetField(notificationdelivery.FieldNotBefore, field.TypeTime, value)
+	}
+	if _u.mutation.NotBeforeCleared() {
+		_spec.ClearField(notificationdelivery.FieldNotBefore, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationDeliveryUpdateOne is the builder for updating a single NotificationDelivery entity.
+type NotificationDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationDeliveryUpdateOne) SetUpdatedAt(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *NotificationDeliveryUpdateOne) SetKind(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableKind(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *NotificationDeliveryUpdateOne) SetKey(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableKey(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *NotificationDeliveryUpdateOne) SetChannel(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableChannel(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (_u *NotificationDeliveryUpdateOne) SetReferenceID(v uuid.UUID) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetReferenceID(v)
+	return _u
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableReferenceID(v *uuid.UUID) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetReferenceID(*v)
+	}
+	return _u
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (_u *NotificationDeliveryUpdateOne) ClearReferenceID() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearReferenceID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *NotificationDeliveryUpdateOne) SetTitle(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableTitle(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *NotificationDeliveryUpdateOne) SetBody(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableBody(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *NotificationDeliveryUpdateOne) SetPayload(v map[string]interface{}) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *NotificationDeliveryUpdateOne) ClearPayload() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NotificationDeliveryUpdateOne) SetStatus(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableStatus(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *NotificationDeliveryUpdateOne) SetAttempts(v int) *NotificationDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableAttempts(v *int) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *NotificationDeliveryUpdateOne) AddAttempts(v int) *NotificationDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *NotificationDeliveryUpdateOne) SetLastError(v string) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableLastError(v *string) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *NotificationDeliveryUpdateOne) ClearLastError() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *NotificationDeliveryUpdateOne) SetSentAt(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableSentAt(v *time.Time) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *NotificationDeliveryUpdateOne) ClearSentAt() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_u *NotificationDeliveryUpdateOne) SetNotBefore(v time.Time) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetNotBefore(v)
+	return _u
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_u *NotificationDeliveryUpdateOne) SetNillableNotBefore(v *time.Time) *NotificationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetNotBefore(*v)
+	}
+	return _u
+}
+
+// ClearNotBefore clears the value of the "not_before" field.
+func (_u *NotificationDeliveryUpdateOne) ClearNotBefore() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearNotBefore()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *NotificationDeliveryUpdateOne) SetUserID(id uuid.UUID) *NotificationDeliveryUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdateOne) SetUser(v *User) *NotificationDeliveryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (_u *NotificationDeliveryUpdateOne) Mutation() *NotificationDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationDeliveryUpdateOne) ClearUser() *NotificationDeliveryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (_u *NotificationDeliveryUpdateOne) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationDeliveryUpdateOne) Select(field string, fields ...string) *NotificationDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationDelivery entity.
+func (_u *NotificationDeliveryUpdateOne) Save(ctx context.Context) (*NotificationDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdateOne) SaveX(ctx context.Context) *NotificationDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := notificationdelivery.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := notificationdelivery.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := notificationdelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := notificationdelivery.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *NotificationDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdelivery.FieldID)
+		for _, f := range fields {
+			if !notificationdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notificationdelivery.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(notificationdelivery.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(notificationdelivery.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReferenceID(); ok {
+		_spec.SetField(notificationdelivery.FieldReferenceID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReferenceIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldReferenceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(notificationdelivery.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(notificationdelivery.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(notificationdelivery.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(notificationdelivery.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(notificationdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(notificationdelivery.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NotBefore(); ok {
+		_spec.SetField(notificationdelivery.FieldNotBefore, field.TypeTime, value)
+	}
+	if _u.mutation.NotBeforeCleared() {
+		_spec.ClearField(notificationdelivery.FieldNotBefore, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.UserTable,
+			Columns: []string{notificationdelivery.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
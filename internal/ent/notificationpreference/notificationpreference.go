@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the notificationpreference type in the database.
+	Label = "notification_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldLeadDays holds the string denoting the lead_days field in the database.
+	FieldLeadDays = "lead_days"
+	// FieldQuietStart holds the string denoting the quiet_start field in the database.
+	FieldQuietStart = "quiet_start"
+	// FieldQuietEnd holds the string denoting the quiet_end field in the database.
+	FieldQuietEnd = "quiet_end"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldEmailEnabled holds the string denoting the email_enabled field in the database.
+	FieldEmailEnabled = "email_enabled"
+	// FieldWebhookURL holds the string denoting the webhook_url field in the database.
+	FieldWebhookURL = "webhook_url"
+	// FieldWebhookSecret holds the string denoting the webhook_secret field in the database.
+	FieldWebhookSecret = "webhook_secret"
+	// FieldInappEnabled holds the string denoting the inapp_enabled field in the database.
+	FieldInappEnabled = "inapp_enabled"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the notificationpreference in the database.
+	Table = "notification_preferences"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "notification_preferences"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for notificationpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldLeadDays,
+	FieldQuietStart,
+	FieldQuietEnd,
+	FieldTimezone,
+	FieldEmailEnabled,
+	FieldWebhookURL,
+	FieldWebhookSecret,
+	FieldInappEnabled,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "notification_preferences"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultLeadDays holds the default value on creation for the "lead_days" field.
+	DefaultLeadDays int
+	// QuietStartValidator is a validator for the "quiet_start" field. It is called by the builders before save.
+	QuietStartValidator func(string) error
+	// QuietEndValidator is a validator for the "quiet_end" field. It is called by the builders before save.
+	QuietEndValidator func(string) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// DefaultEmailEnabled holds the default value on creation for the "email_enabled" field.
+	DefaultEmailEnabled bool
+	// WebhookURLValidator is a validator for the "webhook_url" field. It is called by the builders before save.
+	WebhookURLValidator func(string) error
+	// WebhookSecretValidator is a validator for the "webhook_secret" field. It is called by the builders before save.
+	WebhookSecretValidator func(string) error
+	// DefaultInappEnabled holds the default value on creation for the "inapp_enabled" field.
+	DefaultInappEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the NotificationPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByLeadDays orders the results by the lead_days field.
+func ByLeadDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadDays, opts...).ToFunc()
+}
+
+// ByQuietStart orders the results by the quiet_start field.
+func ByQuietStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietStart, opts...).ToFunc()
+}
+
+// ByQuietEnd orders the results by the quiet_end field.
+func ByQuietEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietEnd, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByEmailEnabled orders the results by the email_enabled field.
+func ByEmailEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailEnabled, opts...).ToFunc()
+}
+
+// ByWebhookURL orders the results by the webhook_url field.
+func ByWebhookURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookURL, opts...).ToFunc()
+}
+
+// ByWebhookSecret orders the results by the webhook_secret field.
+func ByWebhookSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookSecret, opts...).ToFunc()
+}
+
+// ByInappEnabled orders the results by the inapp_enabled field.
+func ByInappEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInappEnabled, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,1201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/notificationpreference"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotificationPreferenceCreate) SetCreatedAt(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableCreatedAt(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NotificationPreferenceCreate) SetUpdatedAt(v time.Time) *NotificationPreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableUpdatedAt(v *time.Time) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (_c *NotificationPreferenceCreate) SetLeadDays(v int) *NotificationPreferenceCreate {
+	_c.mutation.SetLeadDays(v)
+	return _c
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableLeadDays(v *int) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetLeadDays(*v)
+	}
+	return _c
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (_c *NotificationPreferenceCreate) SetQuietStart(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetQuietStart(v)
+	return _c
+}
+
+// SetNillableQuietStart sets the "quiet_start" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableQuietStart(v *string) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetQuietStart(*v)
+	}
+	return _c
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (_c *NotificationPreferenceCreate) SetQuietEnd(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetQuietEnd(v)
+	return _c
+}
+
+// SetNillableQuietEnd sets the "quiet_end" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableQuietEnd(v *string) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetQuietEnd(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *NotificationPreferenceCreate) SetTimezone(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableTimezone(v *string) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (_c *NotificationPreferenceCreate) SetEmailEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetEmailEnabled(v)
+	return _c
+}
+
+// SetNillableEmailEnabled sets the "email_enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableEmailEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetEmailEnabled(*v)
+	}
+	return _c
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_c *NotificationPreferenceCreate) SetWebhookURL(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetWebhookURL(v)
+	return _c
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableWebhookURL(v *string) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetWebhookURL(*v)
+	}
+	return _c
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (_c *NotificationPreferenceCreate) SetWebhookSecret(v string) *NotificationPreferenceCreate {
+	_c.mutation.SetWebhookSecret(v)
+	return _c
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableWebhookSecret(v *string) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetWebhookSecret(*v)
+	}
+	return _c
+}
+
+// SetInappEnabled sets the "inapp_enabled" field.
+func (_c *NotificationPreferenceCreate) SetInappEnabled(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetInappEnabled(v)
+	return _c
+}
+
+// SetNillableInappEnabled sets the "inapp_enabled" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableInappEnabled(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetInappEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationPreferenceCreate) SetID(v uuid.UUID) *NotificationPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableID(v *uuid.UUID) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *NotificationPreferenceCreate) SetUserID(id uuid.UUID) *NotificationPreferenceCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *NotificationPreferenceCreate) SetUser(v *User) *NotificationPreferenceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_c *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (_c *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notificationpreference.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.LeadDays(); !ok {
+		v := notificationpreference.DefaultLeadDays
+		_c.mutation.SetLeadDays(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := notificationpreference.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.EmailEnabled(); !ok {
+		v := notificationpreference.DefaultEmailEnabled
+		_c.mutation.SetEmailEnabled(v)
+	}
+	if _, ok := _c.mutation.InappEnabled(); !ok {
+		v := notificationpreference.DefaultInappEnabled
+		_c.mutation.SetInappEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationpreference.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationPreferenceCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationPreference.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationPreference.updated_at"`)}
+	}
+	if _, ok := _c.mutation.LeadDays(); !ok {
+		return &ValidationError{Name: "lead_days", err: errors.New(`ent: missing required field "NotificationPreference.lead_days"`)}
+	}
+	if v, ok := _c.mutation.QuietStart(); ok {
+		if err := notificationpreference.QuietStartValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_start", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_start": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.QuietEnd(); ok {
+		if err := notificationpreference.QuietEndValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_end", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_end": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "NotificationPreference.timezone"`)}
+	}
+	if v, ok := _c.mutation.Timezone(); ok {
+		if err := notificationpreference.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.timezone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EmailEnabled(); !ok {
+		return &ValidationError{Name: "email_enabled", err: errors.New(`ent: missing required field "NotificationPreference.email_enabled"`)}
+	}
+	if v, ok := _c.mutation.WebhookURL(); ok {
+		if err := notificationpreference.WebhookURLValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_url", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.webhook_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.WebhookSecret(); ok {
+		if err := notificationpreference.WebhookSecretValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_secret", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.webhook_secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InappEnabled(); !ok {
+		return &ValidationError{Name: "inapp_enabled", err: errors.New(`ent: missing required field "NotificationPreference.inapp_enabled"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationPreference.user"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationpreference.Table, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.LeadDays(); ok {
+		_spec.SetField(notificationpreference.FieldLeadDays, field.TypeInt, value)
+		_node.LeadDays = value
+	}
+	if value, ok := _c.mutation.QuietStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietStart, field.TypeString, value)
+		_node.QuietStart = &value
+	}
+	if value, ok := _c.mutation.QuietEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietEnd, field.TypeString, value)
+		_node.QuietEnd = &value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(notificationpreference.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.EmailEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldEmailEnabled, field.TypeBool, value)
+		_node.EmailEnabled = value
+	}
+	if value, ok := _c.mutation.WebhookURL(); ok {
+		_spec.SetField(notificationpreference.FieldWebhookURL, field.TypeString, value)
+		_node.WebhookURL = &value
+	}
+	if value, ok := _c.mutation.WebhookSecret(); ok {
+		_spec.SetField(notificationpreference.FieldWebhookSecret, field.TypeString, value)
+		_node.WebhookSecret = &value
+	}
+	if value, ok := _c.mutation.InappEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldInappEnabled, field.TypeBool, value)
+		_node.InappEnabled = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertOne {
+	_c.conflict = opts
+	return &NotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreate) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one NotificationPreference node.
+	NotificationPreferenceUpsertOne struct {
+		create *NotificationPreferenceCreate
+	}
+
+	// NotificationPreferenceUpsert is the "OnConflict" setter.
+	NotificationPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationPreferenceUpsert) SetUpdatedAt(v time.Time) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateUpdatedAt() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldUpdatedAt)
+	return u
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (u *NotificationPreferenceUpsert) SetLeadDays(v int) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldLeadDays, v)
+	return u
+}
+
+// UpdateLeadDays sets the "lead_days" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateLeadDays() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldLeadDays)
+	return u
+}
+
+// AddLeadDays adds v to the "lead_days" field.
+func (u *NotificationPreferenceUpsert) AddLeadDays(v int) *NotificationPreferenceUpsert {
+	u.Add(notificationpreference.FieldLeadDays, v)
+	return u
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (u *NotificationPreferenceUpsert) SetQuietStart(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldQuietStart, v)
+	return u
+}
+
+// UpdateQuietStart sets the "quiet_start" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateQuietStart() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldQuietStart)
+	return u
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (u *NotificationPreferenceUpsert) ClearQuietStart() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldQuietStart)
+	return u
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (u *NotificationPreferenceUpsert) SetQuietEnd(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldQuietEnd, v)
+	return u
+}
+
+// UpdateQuietEnd sets the "quiet_end" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateQuietEnd() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldQuietEnd)
+	return u
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (u *NotificationPreferenceUpsert) ClearQuietEnd() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldQuietEnd)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *NotificationPreferenceUpsert) SetTimezone(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateTimezone() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldTimezone)
+	return u
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (u *NotificationPreferenceUpsert) SetEmailEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldEmailEnabled, v)
+	return u
+}
+
+// UpdateEmailEnabled sets the "email_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateEmailEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldEmailEnabled)
+	return u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *NotificationPreferenceUpsert) SetWebhookURL(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldWebhookURL, v)
+	return u
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateWebhookURL() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldWebhookURL)
+	return u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *NotificationPreferenceUpsert) ClearWebhookURL() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldWebhookURL)
+	return u
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *NotificationPreferenceUpsert) SetWebhookSecret(v string) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldWebhookSecret, v)
+	return u
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateWebhookSecret() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldWebhookSecret)
+	return u
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *NotificationPreferenceUpsert) ClearWebhookSecret() *NotificationPreferenceUpsert {
+	u.SetNull(notificationpreference.FieldWebhookSecret)
+	return u
+}
+
+// SetInappEnabled sets the "inapp_enabled" field.
+func (u *NotificationPreferenceUpsert) SetInappEnabled(v bool) *NotificationPreferenceUpsert {
+	u.Set(notificationpreference.FieldInappEnabled, v)
+	return u
+}
+
+// UpdateInappEnabled sets the "inapp_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsert) UpdateInappEnabled() *NotificationPreferenceUpsert {
+	u.SetExcluded(notificationpreference.FieldInappEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertOne) UpdateNewValues() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationpreference.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationpreference.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationPreferenceUpsertOne) Ignore() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertOne) DoNothing() *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertOne) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationPreferenceUpsertOne) SetUpdatedAt(v time.Time) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateUpdatedAt() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (u *NotificationPreferenceUpsertOne) SetLeadDays(v int) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetLeadDays(v)
+	})
+}
+
+// AddLeadDays adds v to the "lead_days" field.
+func (u *NotificationPreferenceUpsertOne) AddLeadDays(v int) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.AddLeadDays(v)
+	})
+}
+
+// UpdateLeadDays sets the "lead_days" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateLeadDays() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateLeadDays()
+	})
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (u *NotificationPreferenceUpsertOne) SetQuietStart(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetQuietStart(v)
+	})
+}
+
+// UpdateQuietStart sets the "quiet_start" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateQuietStart() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateQuietStart()
+	})
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (u *NotificationPreferenceUpsertOne) ClearQuietStart() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearQuietStart()
+	})
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (u *NotificationPreferenceUpsertOne) SetQuietEnd(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetQuietEnd(v)
+	})
+}
+
+// UpdateQuietEnd sets the "quiet_end" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateQuietEnd() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateQuietEnd()
+	})
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (u *NotificationPreferenceUpsertOne) ClearQuietEnd() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearQuietEnd()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *NotificationPreferenceUpsertOne) SetTimezone(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateTimezone() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetEmailEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetEmailEnabled(v)
+	})
+}
+
+// UpdateEmailEnabled sets the "email_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateEmailEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateEmailEnabled()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *NotificationPreferenceUpsertOne) SetWebhookURL(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateWebhookURL() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *NotificationPreferenceUpsertOne) ClearWebhookURL() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *NotificationPreferenceUpsertOne) SetWebhookSecret(v string) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetWebhookSecret(v)
+	})
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateWebhookSecret() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateWebhookSecret()
+	})
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *NotificationPreferenceUpsertOne) ClearWebhookSecret() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearWebhookSecret()
+	})
+}
+
+// SetInappEnabled sets the "inapp_enabled" field.
+func (u *NotificationPreferenceUpsertOne) SetInappEnabled(v bool) *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetInappEnabled(v)
+	})
+}
+
+// UpdateInappEnabled sets the "inapp_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertOne) UpdateInappEnabled() *NotificationPreferenceUpsertOne {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateInappEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationPreferenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationPreferenceUpsertOne.ID is not supported by MySQL driver. Use NotificationPreferenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (_c *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationPreferenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationPreferenceUpsertBulk {
+	_c.conflict = opts
+	return &NotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationPreferenceCreateBulk) OnConflictColumns(columns ...string) *NotificationPreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationPreference nodes.
+type NotificationPreferenceUpsertBulk struct {
+	create *NotificationPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertBulk) UpdateNewValues() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationpreference.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationpreference.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationPreferenceUpsertBulk) Ignore() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationPreferenceUpsertBulk) DoNothing() *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationPreferenceUpsertBulk) Update(set func(*NotificationPreferenceUpsert)) *NotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationPreferenceUpsertBulk) SetUpdatedAt(v time.Time) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateUpdatedAt() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (u *NotificationPreferenceUpsertBulk) SetLeadDays(v int) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetLeadDays(v)
+	})
+}
+
+// AddLeadDays adds v to the "lead_days" field.
+func (u *NotificationPreferenceUpsertBulk) AddLeadDays(v int) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.AddLeadDays(v)
+	})
+}
+
+// UpdateLeadDays sets the "lead_days" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateLeadDays() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateLeadDays()
+	})
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (u *NotificationPreferenceUpsertBulk) SetQuietStart(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetQuietStart(v)
+	})
+}
+
+// UpdateQuietStart sets the "quiet_start" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateQuietStart() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateQuietStart()
+	})
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (u *NotificationPreferenceUpsertBulk) ClearQuietStart() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearQuietStart()
+	})
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (u *NotificationPreferenceUpsertBulk) SetQuietEnd(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetQuietEnd(v)
+	})
+}
+
+// UpdateQuietEnd sets the "quiet_end" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateQuietEnd() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateQuietEnd()
+	})
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (u *NotificationPreferenceUpsertBulk) ClearQuietEnd() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearQuietEnd()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *NotificationPreferenceUpsertBulk) SetTimezone(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateTimezone() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetEmailEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetEmailEnabled(v)
+	})
+}
+
+// UpdateEmailEnabled sets the "email_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateEmailEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateEmailEnabled()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *NotificationPreferenceUpsertBulk) SetWebhookURL(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateWebhookURL() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *NotificationPreferenceUpsertBulk) ClearWebhookURL() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (u *NotificationPreferenceUpsertBulk) SetWebhookSecret(v string) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetWebhookSecret(v)
+	})
+}
+
+// UpdateWebhookSecret sets the "webhook_secret" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateWebhookSecret() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateWebhookSecret()
+	})
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (u *NotificationPreferenceUpsertBulk) ClearWebhookSecret() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.ClearWebhookSecret()
+	})
+}
+
+// SetInappEnabled sets the "inapp_enabled" field.
+func (u *NotificationPreferenceUpsertBulk) SetInappEnabled(v bool) *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.SetInappEnabled(v)
+	})
+}
+
+// UpdateInappEnabled sets the "inapp_enabled" field to the value that was provided on create.
+func (u *NotificationPreferenceUpsertBulk) UpdateInappEnabled() *NotificationPreferenceUpsertBulk {
+	return u.Update(func(s *NotificationPreferenceUpsert) {
+		s.UpdateInappEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,742 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/notificationpreference"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceUpdate is the builder for updating NotificationPreference entities.
+type NotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdate) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdate) SetUpdatedAt(v time.Time) *NotificationPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (_u *NotificationPreferenceUpdate) SetLeadDays(v int) *NotificationPreferenceUpdate {
+	_u.mutation.ResetLeadDays()
+	_u.mutation.SetLeadDays(v)
+	return _u
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableLeadDays(v *int) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetLeadDays(*v)
+	}
+	return _u
+}
+
+// AddLeadDays adds value to the "lead_days" field.
+func (_u *NotificationPreferenceUpdate) AddLeadDays(v int) *NotificationPreferenceUpdate {
+	_u.mutation.AddLeadDays(v)
+	return _u
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (_u *NotificationPreferenceUpdate) SetQuietStart(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetQuietStart(v)
+	return _u
+}
+
+// SetNillableQuietStart sets the "quiet_start" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableQuietStart(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetQuietStart(*v)
+	}
+	return _u
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (_u *NotificationPreferenceUpdate) ClearQuietStart() *NotificationPreferenceUpdate {
+	_u.mutation.ClearQuietStart()
+	return _u
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (_u *NotificationPreferenceUpdate) SetQuietEnd(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetQuietEnd(v)
+	return _u
+}
+
+// SetNillableQuietEnd sets the "quiet_end" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableQuietEnd(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetQuietEnd(*v)
+	}
+	return _u
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (_u *NotificationPreferenceUpdate) ClearQuietEnd() *NotificationPreferenceUpdate {
+	_u.mutation.ClearQuietEnd()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *NotificationPreferenceUpdate) SetTimezone(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableTimezone(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (_u *NotificationPreferenceUpdate) SetEmailEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetEmailEnabled(v)
+	return _u
+}
+
+// SetNillableEmailEnabled sets the "email_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableEmailEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetEmailEnabled(*v)
+	}
+	return _u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_u *NotificationPreferenceUpdate) SetWebhookURL(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetWebhookURL(v)
+	return _u
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableWebhookURL(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (_u *NotificationPreferenceUpdate) ClearWebhookURL() *NotificationPreferenceUpdate {
+	_u.mutation.ClearWebhookURL()
+	return _u
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (_u *NotificationPreferenceUpdate) SetWebhookSecret(v string) *NotificationPreferenceUpdate {
+	_u.mutation.SetWebhookSecret(v)
+	return _u
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableWebhookSecret(v *string) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetWebhookSecret(*v)
+	}
+	return _u
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (_u *NotificationPreferenceUpdate) ClearWebhookSecret() *NotificationPreferenceUpdate {
+	_u.mutation.ClearWebhookSecret()
+	return _u
+}
+
+// SetInappEnabled sets the "inapp_enabled" field.
+func (_u *NotificationPreferenceUpdate) SetInappEnabled(v bool) *NotificationPreferenceUpdate {
+	_u.mutation.SetInappEnabled(v)
+	return _u
+}
+
+// SetNillableInappEnabled sets the "inapp_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdate) SetNillableInappEnabled(v *bool) *NotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetInappEnabled(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *NotificationPreferenceUpdate) SetUserID(id uuid.UUID) *NotificationPreferenceUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdate) SetUser(v *User) *NotificationPreferenceUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdate) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdate) ClearUser() *NotificationPreferenceUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.QuietStart(); ok {
+		if err := notificationpreference.QuietStartValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_start", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_start": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuietEnd(); ok {
+		if err := notificationpreference.QuietEndValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_end", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_end": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := notificationpreference.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WebhookURL(); ok {
+		if err := notificationpreference.WebhookURLValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_url", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.webhook_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WebhookSecret(); ok {
+		if err := notificationpreference.WebhookSecretValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_secret", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.webhook_secret": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LeadDays(); ok {
+		_spec.SetField(notificationpreference.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadDays(); ok {
+		_spec.AddField(notificationpreference.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QuietStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietStart, field.TypeString, value)
+	}
+	if _u.mutation.QuietStartCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietStart, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietEnd, field.TypeString, value)
+	}
+	if _u.mutation.QuietEndCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietEnd, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(notificationpreference.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldEmailEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebhookURL(); ok {
+		_spec.SetField(notificationpreference.FieldWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.WebhookURLCleared() {
+		_spec.ClearField(notificationpreference.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.WebhookSecret(); ok {
+		_spec.SetField(notificationpreference.FieldWebhookSecret, field.TypeString, value)
+	}
+	if _u.mutation.WebhookSecretCleared() {
+		_spec.ClearField(notificationpreference.FieldWebhookSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.InappEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldInappEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationPreferenceUpdateOne is the builder for updating a single NotificationPreference entity.
+type NotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationPreferenceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationPreferenceUpdateOne) SetUpdatedAt(v time.Time) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLeadDays sets the "lead_days" field.
+func (_u *NotificationPreferenceUpdateOne) SetLeadDays(v int) *NotificationPreferenceUpdateOne {
+	_u.mutation.ResetLeadDays()
+	_u.mutation.SetLeadDays(v)
+	return _u
+}
+
+// SetNillableLeadDays sets the "lead_days" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableLeadDays(v *int) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetLeadDays(*v)
+	}
+	return _u
+}
+
+// AddLeadDays adds value to the "lead_days" field.
+func (_u *NotificationPreferenceUpdateOne) AddLeadDays(v int) *NotificationPreferenceUpdateOne {
+	_u.mutation.AddLeadDays(v)
+	return _u
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (_u *NotificationPreferenceUpdateOne) SetQuietStart(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetQuietStart(v)
+	return _u
+}
+
+// SetNillableQuietStart sets the "quiet_start" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableQuietStart(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetQuietStart(*v)
+	}
+	return _u
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (_u *NotificationPreferenceUpdateOne) ClearQuietStart() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearQuietStart()
+	return _u
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (_u *NotificationPreferenceUpdateOne) SetQuietEnd(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetQuietEnd(v)
+	return _u
+}
+
+// SetNillableQuietEnd sets the "quiet_end" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableQuietEnd(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetQuietEnd(*v)
+	}
+	return _u
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (_u *NotificationPreferenceUpdateOne) ClearQuietEnd() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearQuietEnd()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *NotificationPreferenceUpdateOne) SetTimezone(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableTimezone(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetEmailEnabled sets the "email_enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetEmailEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetEmailEnabled(v)
+	return _u
+}
+
+// SetNillableEmailEnabled sets the "email_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableEmailEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetEmailEnabled(*v)
+	}
+	return _u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_u *NotificationPreferenceUpdateOne) SetWebhookURL(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetWebhookURL(v)
+	return _u
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableWebhookURL(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (_u *NotificationPreferenceUpdateOne) ClearWebhookURL() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearWebhookURL()
+	return _u
+}
+
+// SetWebhookSecret sets the "webhook_secret" field.
+func (_u *NotificationPreferenceUpdateOne) SetWebhookSecret(v string) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetWebhookSecret(v)
+	return _u
+}
+
+// SetNillableWebhookSecret sets the "webhook_secret" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableWebhookSecret(v *string) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetWebhookSecret(*v)
+	}
+	return _u
+}
+
+// ClearWebhookSecret clears the value of the "webhook_secret" field.
+func (_u *NotificationPreferenceUpdateOne) ClearWebhookSecret() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearWebhookSecret()
+	return _u
+}
+
+// SetInappEnabled sets the "inapp_enabled" field.
+func (_u *NotificationPreferenceUpdateOne) SetInappEnabled(v bool) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetInappEnabled(v)
+	return _u
+}
+
+// SetNillableInappEnabled sets the "inapp_enabled" field if the given value is not nil.
+func (_u *NotificationPreferenceUpdateOne) SetNillableInappEnabled(v *bool) *NotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetInappEnabled(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *NotificationPreferenceUpdateOne) SetUserID(id uuid.UUID) *NotificationPreferenceUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdateOne) SetUser(v *User) *NotificationPreferenceUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_u *NotificationPreferenceUpdateOne) Mutation() *NotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationPreferenceUpdateOne) ClearUser() *NotificationPreferenceUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationPreferenceUpdate builder.
+func (_u *NotificationPreferenceUpdateOne) Where(ps ...predicate.NotificationPreference) *NotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationPreferenceUpdateOne) Select(field string, fields ...string) *NotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationPreference entity.
+func (_u *NotificationPreferenceUpdateOne) Save(ctx context.Context) (*NotificationPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) SaveX(ctx context.Context) *NotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.QuietStart(); ok {
+		if err := notificationpreference.QuietStartValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_start", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_start": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuietEnd(); ok {
+		if err := notificationpreference.QuietEndValidator(v); err != nil {
+			return &ValidationError{Name: "quiet_end", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.quiet_end": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := notificationpreference.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WebhookURL(); ok {
+		if err := notificationpreference.WebhookURLValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_url", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.webhook_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WebhookSecret(); ok {
+		if err := notificationpreference.WebhookSecretValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_secret", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.webhook_secret": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationPreference.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *NotificationPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationpreference.Table, notificationpreference.Columns, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationpreference.FieldID)
+		for _, f := range fields {
+			if !notificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LeadDays(); ok {
+		_spec.SetField(notificationpreference.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadDays(); ok {
+		_spec.AddField(notificationpreference.FieldLeadDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QuietStart(); ok {
+		_spec.SetField(notificationpreference.FieldQuietStart, field.TypeString, value)
+	}
+	if _u.mutation.QuietStartCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietStart, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietEnd(); ok {
+		_spec.SetField(notificationpreference.FieldQuietEnd, field.TypeString, value)
+	}
+	if _u.mutation.QuietEndCleared() {
+		_spec.ClearField(notificationpreference.FieldQuietEnd, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(notificationpreference.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldEmailEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebhookURL(); ok {
+		_spec.SetField(notificationpreference.FieldWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.WebhookURLCleared() {
+		_spec.ClearField(notificationpreference.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.WebhookSecret(); ok {
+		_spec.SetField(notificationpreference.FieldWebhookSecret, field.TypeString, value)
+	}
+	if _u.mutation.WebhookSecretCleared() {
+		_spec.ClearField(notificationpreference.FieldWebhookSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.InappEnabled(); ok {
+		_spec.SetField(notificationpreference.FieldInappEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payee
+
+import (
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldName, v))
+}
+
+// NormalizedName applies equality check predicate on the "normalized_name" field. It's identical to NormalizedNameEQ.
+func NormalizedName(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// Document applies equality check predicate on the "document" field. It's identical to DocumentEQ.
+func Document(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldDocument, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContainsFold(FieldName, v))
+}
+
+// NormalizedNameEQ applies the EQ predicate on the "normalized_name" field.
+func NormalizedNameEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameNEQ applies the NEQ predicate on the "normalized_name" field.
+func NormalizedNameNEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameIn applies the In predicate on the "normalized_name" field.
+func NormalizedNameIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameNotIn applies the NotIn predicate on the "normalized_name" field.
+func NormalizedNameNotIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameGT applies the GT predicate on the "normalized_name" field.
+func NormalizedNameGT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldNormalizedName, v))
+}
+
+// NormalizedNameGTE applies the GTE predicate on the "normalized_name" field.
+func NormalizedNameGTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameLT applies the LT predicate on the "normalized_name" field.
+func NormalizedNameLT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldNormalizedName, v))
+}
+
+// NormalizedNameLTE applies the LTE predicate on the "normalized_name" field.
+func NormalizedNameLTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameContains applies the Contains predicate on the "normalized_name" field.
+func NormalizedNameContains(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContains(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasPrefix applies the HasPrefix predicate on the "normalized_name" field.
+func NormalizedNameHasPrefix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasPrefix(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasSuffix applies the HasSuffix predicate on the "normalized_name" field.
+func NormalizedNameHasSuffix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasSuffix(FieldNormalizedName, v))
+}
+
+// NormalizedNameEqualFold applies the EqualFold predicate on the "normalized_name" field.
+func NormalizedNameEqualFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEqualFold(FieldNormalizedName, v))
+}
+
+// NormalizedNameContainsFold applies the ContainsFold predicate on the "normalized_name" field.
+func NormalizedNameContainsFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContainsFold(FieldNormalizedName, v))
+}
+
+// DocumentEQ applies the EQ predicate on the "document" field.
+func DocumentEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEQ(FieldDocument, v))
+}
+
+// DocumentNEQ applies the NEQ predicate on the "document" field.
+func DocumentNEQ(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldNEQ(FieldDocument, v))
+}
+
+// DocumentIn applies the In predicate on the "document" field.
+func DocumentIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldIn(FieldDocument, vs...))
+}
+
+// DocumentNotIn applies the NotIn predicate on the "document" field.
+func DocumentNotIn(vs ...string) predicate.Payee {
+	return predicate.Payee(sql.FieldNotIn(FieldDocument, vs...))
+}
+
+// DocumentGT applies the GT predicate on the "document" field.
+func DocumentGT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGT(FieldDocument, v))
+}
+
+// DocumentGTE applies the GTE predicate on the "document" field.
+func DocumentGTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldGTE(FieldDocument, v))
+}
+
+// DocumentLT applies the LT predicate on the "document" field.
+func DocumentLT(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLT(FieldDocument, v))
+}
+
+// DocumentLTE applies the LTE predicate on the "document" field.
+func DocumentLTE(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldLTE(FieldDocument, v))
+}
+
+// DocumentContains applies the Contains predicate on the "document" field.
+func DocumentContains(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContains(FieldDocument, v))
+}
+
+// DocumentHasPrefix applies the HasPrefix predicate on the "document" field.
+func DocumentHasPrefix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasPrefix(FieldDocument, v))
+}
+
+// DocumentHasSuffix applies the HasSuffix predicate on the "document" field.
+func DocumentHasSuffix(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldHasSuffix(FieldDocument, v))
+}
+
+// DocumentIsNil applies the IsNil predicate on the "document" field.
+func DocumentIsNil() predicate.Payee {
+	return predicate.Payee(sql.FieldIsNull(FieldDocument))
+}
+
+// DocumentNotNil applies the NotNil predicate on the "document" field.
+func DocumentNotNil() predicate.Payee {
+	return predicate.Payee(sql.FieldNotNull(FieldDocument))
+}
+
+// DocumentEqualFold applies the EqualFold predicate on the "document" field.
+func DocumentEqualFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldEqualFold(FieldDocument, v))
+}
+
+// DocumentContainsFold applies the ContainsFold predicate on the "document" field.
+func DocumentContainsFold(v string) predicate.Payee {
+	return predicate.Payee(sql.FieldContainsFold(FieldDocument, v))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Payee {
+	return predicate.Payee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Payee {
+	return predicate.Payee(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.Payee {
+	return predicate.Payee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.Transaction) predicate.Payee {
+	return predicate.Payee(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Payee) predicate.Payee {
+	return predicate.Payee(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Payee) predicate.Payee {
+	return predicate.Payee(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Payee) predicate.Payee {
+	return predicate.Payee(sql.NotPredicates(p))
+}
@@ -0,0 +1,867 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PayeeCreate is the builder for creating a Payee entity.
+type PayeeCreate struct {
+	config
+	mutation *PayeeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayeeCreate) SetCreatedAt(v time.Time) *PayeeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableCreatedAt(v *time.Time) *PayeeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayeeCreate) SetUpdatedAt(v time.Time) *PayeeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableUpdatedAt(v *time.Time) *PayeeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PayeeCreate) SetName(v string) *PayeeCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (_c *PayeeCreate) SetNormalizedName(v string) *PayeeCreate {
+	_c.mutation.SetNormalizedName(v)
+	return _c
+}
+
+// SetAliases sets the "aliases" field.
+func (_c *PayeeCreate) SetAliases(v []string) *PayeeCreate {
+	_c.mutation.SetAliases(v)
+	return _c
+}
+
+// SetDocument sets the "document" field.
+func (_c *PayeeCreate) SetDocument(v string) *PayeeCreate {
+	_c.mutation.SetDocument(v)
+	return _c
+}
+
+// SetNillableDocument sets the "document" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableDocument(v *string) *PayeeCreate {
+	if v != nil {
+		_c.SetDocument(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PayeeCreate) SetID(v uuid.UUID) *PayeeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableID(v *uuid.UUID) *PayeeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *PayeeCreate) SetLedgerID(id uuid.UUID) *PayeeCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *PayeeCreate) SetLedger(v *Ledger) *PayeeCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *PayeeCreate) AddTransactionIDs(ids ...uuid.UUID) *PayeeCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *PayeeCreate) AddTransactions(v ...*Transaction) *PayeeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (_c *PayeeCreate) Mutation() *PayeeMutation {
+	return _c.mutation
+}
+
+// Save creates the Payee in the database.
+func (_c *PayeeCreate) Save(ctx context.Context) (*Payee, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayeeCreate) SaveX(ctx context.Context) *Payee {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayeeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := payee.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := payee.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Aliases(); !ok {
+		v := payee.DefaultAliases
+		_c.mutation.SetAliases(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := payee.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayeeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Payee.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Payee.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Payee.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := payee.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Payee.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NormalizedName(); !ok {
+		return &ValidationError{Name: "normalized_name", err: errors.New(`ent: missing required field "Payee.normalized_name"`)}
+	}
+	if v, ok := _c.mutation.NormalizedName(); ok {
+		if err := payee.NormalizedNameValidator(v); err != nil {
+			return &ValidationError{Name: "normalized_name", err: fmt.Errorf(`ent: validator failed for field "Payee.normalized_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Aliases(); !ok {
+		return &ValidationError{Name: "aliases", err: errors.New(`ent: missing required field "Payee.aliases"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Payee.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *PayeeCreate) sqlSave(ctx context.Context) (*Payee, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayeeCreate) createSpec() (*Payee, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Payee{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payee.Table, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payee.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(payee.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.NormalizedName(); ok {
+		_spec.SetField(payee.FieldNormalizedName, field.TypeString, value)
+		_node.NormalizedName = value
+	}
+	if value, ok := _c.mutation.Aliases(); ok {
+		_spec.SetField(payee.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	if value, ok := _c.mutation.Document(); ok {
+		_spec.SetField(payee.FieldDocument, field.TypeString, value)
+		_node.Document = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   payee.LedgerTable,
+			Columns: []string{payee.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Payee.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeeCreate) OnConflict(opts ...sql.ConflictOption) *PayeeUpsertOne {
+	_c.conflict = opts
+	return &PayeeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Payee.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeeCreate) OnConflictColumns(columns ...string) *PayeeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PayeeUpsertOne is the builder for "upsert"-ing
+	//  one Payee node.
+	PayeeUpsertOne struct {
+		create *PayeeCreate
+	}
+
+	// PayeeUpsert is the "OnConflict" setter.
+	PayeeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeeUpsert) SetUpdatedAt(v time.Time) *PayeeUpsert {
+	u.Set(payee.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeeUpsert) UpdateUpdatedAt() *PayeeUpsert {
+	u.SetExcluded(payee.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PayeeUpsert) SetName(v string) *PayeeUpsert {
+	u.Set(payee.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PayeeUpsert) UpdateName() *PayeeUpsert {
+	u.SetExcluded(payee.FieldName)
+	return u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *PayeeUpsert) SetNormalizedName(v string) *PayeeUpsert {
+	u.Set(payee.FieldNormalizedName, v)
+	return u
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *PayeeUpsert) UpdateNormalizedName() *PayeeUpsert {
+	u.SetExcluded(payee.FieldNormalizedName)
+	return u
+}
+
+// SetAliases sets the "aliases" field.
+func (u *PayeeUpsert) SetAliases(v []string) *PayeeUpsert {
+	u.Set(payee.FieldAliases, v)
+	return u
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *PayeeUpsert) UpdateAliases() *PayeeUpsert {
+	u.SetExcluded(payee.FieldAliases)
+	return u
+}
+
+// SetDocument sets the "document" field.
+func (u *PayeeUpsert) SetDocument(v string) *PayeeUpsert {
+	u.Set(payee.FieldDocument, v)
+	return u
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *PayeeUpsert) UpdateDocument() *PayeeUpsert {
+	u.SetExcluded(payee.FieldDocument)
+	return u
+}
+
+// ClearDocument clears the value of the "document" field.
+func (u *PayeeUpsert) ClearDocument() *PayeeUpsert {
+	u.SetNull(payee.FieldDocument)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Payee.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payee.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeeUpsertOne) UpdateNewValues() *PayeeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(payee.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(payee.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Payee.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PayeeUpsertOne) Ignore() *PayeeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeeUpsertOne) DoNothing() *PayeeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeeCreate.OnConflict
+// documentation for more info.
+func (u *PayeeUpsertOne) Update(set func(*PayeeUpsert)) *PayeeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeeUpsertOne) SetUpdatedAt(v time.Time) *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeeUpsertOne) UpdateUpdatedAt() *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PayeeUpsertOne) SetName(v string) *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PayeeUpsertOne) UpdateName() *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *PayeeUpsertOne) SetNormalizedName(v string) *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *PayeeUpsertOne) UpdateNormalizedName() *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *PayeeUpsertOne) SetAliases(v []string) *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *PayeeUpsertOne) UpdateAliases() *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// SetDocument sets the "document" field.
+func (u *PayeeUpsertOne) SetDocument(v string) *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetDocument(v)
+	})
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *PayeeUpsertOne) UpdateDocument() *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateDocument()
+	})
+}
+
+// ClearDocument clears the value of the "document" field.
+func (u *PayeeUpsertOne) ClearDocument() *PayeeUpsertOne {
+	return u.Update(func(s *PayeeUpsert) {
+		s.ClearDocument()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PayeeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PayeeUpsertOne.ID is not supported by MySQL driver. Use PayeeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PayeeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PayeeCreateBulk is the builder for creating many Payee entities in bulk.
+type PayeeCreateBulk struct {
+	config
+	err      error
+	builders []*PayeeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Payee entities in the database.
+func (_c *PayeeCreateBulk) Save(ctx context.Context) ([]*Payee, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Payee, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayeeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayeeCreateBulk) SaveX(ctx context.Context) []*Payee {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Payee.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PayeeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PayeeCreateBulk) OnConflict(opts ...sql.ConflictOption) *PayeeUpsertBulk {
+	_c.conflict = opts
+	return &PayeeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Payee.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PayeeCreateBulk) OnConflictColumns(columns ...string) *PayeeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PayeeUpsertBulk{
+		create: _c,
+	}
+}
+
+// PayeeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Payee nodes.
+type PayeeUpsertBulk struct {
+	create *PayeeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Payee.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(payee.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PayeeUpsertBulk) UpdateNewValues() *PayeeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(payee.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(payee.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Payee.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PayeeUpsertBulk) Ignore() *PayeeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PayeeUpsertBulk) DoNothing() *PayeeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PayeeCreateBulk.OnConflict
+// documentation for more info.
+func (u *PayeeUpsertBulk) Update(set func(*PayeeUpsert)) *PayeeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PayeeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PayeeUpsertBulk) SetUpdatedAt(v time.Time) *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PayeeUpsertBulk) UpdateUpdatedAt() *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PayeeUpsertBulk) SetName(v string) *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PayeeUpsertBulk) UpdateName() *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *PayeeUpsertBulk) SetNormalizedName(v string) *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *PayeeUpsertBulk) UpdateNormalizedName() *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *PayeeUpsertBulk) SetAliases(v []string) *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *PayeeUpsertBulk) UpdateAliases() *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// SetDocument sets the "document" field.
+func (u *PayeeUpsertBulk) SetDocument(v string) *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.SetDocument(v)
+	})
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *PayeeUpsertBulk) UpdateDocument() *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.UpdateDocument()
+	})
+}
+
+// ClearDocument clears the value of the "document" field.
+func (u *PayeeUpsertBulk) ClearDocument() *PayeeUpsertBulk {
+	return u.Update(func(s *PayeeUpsert) {
+		s.ClearDocument()
+	})
+}
+
+// Exec executes the query.
+func (u *PayeeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PayeeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PayeeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PayeeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
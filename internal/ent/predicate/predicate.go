@@ -6,14 +6,104 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Account is the predicate function for account builders.
+type Account func(*sql.Selector)
+
+// Asset is the predicate function for asset builders.
+type Asset func(*sql.Selector)
+
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// Budget is the predicate function for budget builders.
+type Budget func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
+// EnvelopeAllocation is the predicate function for envelopeallocation builders.
+type EnvelopeAllocation func(*sql.Selector)
+
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
+// Goal is the predicate function for goal builders.
+type Goal func(*sql.Selector)
+
+// Holding is the predicate function for holding builders.
+type Holding func(*sql.Selector)
+
+// ImportJob is the predicate function for importjob builders.
+type ImportJob func(*sql.Selector)
+
+// InvestmentIncome is the predicate function for investmentincome builders.
+type InvestmentIncome func(*sql.Selector)
+
+// InvestmentQuote is the predicate function for investmentquote builders.
+type InvestmentQuote func(*sql.Selector)
+
+// InvestmentTrade is the predicate function for investmenttrade builders.
+type InvestmentTrade func(*sql.Selector)
+
 // Invoice is the predicate function for invoice builders.
 type Invoice func(*sql.Selector)
 
+// InvoicePayment is the predicate function for invoicepayment builders.
+type InvoicePayment func(*sql.Selector)
+
+// Ledger is the predicate function for ledger builders.
+type Ledger func(*sql.Selector)
+
+// LedgerInvitation is the predicate function for ledgerinvitation builders.
+type LedgerInvitation func(*sql.Selector)
+
+// LedgerMember is the predicate function for ledgermember builders.
+type LedgerMember func(*sql.Selector)
+
+// Loan is the predicate function for loan builders.
+type Loan func(*sql.Selector)
+
+// LoanInstallment is the predicate function for loaninstallment builders.
+type LoanInstallment func(*sql.Selector)
+
+// LoanPrepayment is the predicate function for loanprepayment builders.
+type LoanPrepayment func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// Payee is the predicate function for payee builders.
+type Payee func(*sql.Selector)
+
+// RecurringRule is the predicate function for recurringrule builders.
+type RecurringRule func(*sql.Selector)
+
+// Rule is the predicate function for rule builders.
+type Rule func(*sql.Selector)
+
+// Settlement is the predicate function for settlement builders.
+type Settlement func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
+// TransactionParticipant is the predicate function for transactionparticipant builders.
+type TransactionParticipant func(*sql.Selector)
+
+// TransactionSplit is the predicate function for transactionsplit builders.
+type TransactionSplit func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Valuation is the predicate function for valuation builders.
+type Valuation func(*sql.Selector)
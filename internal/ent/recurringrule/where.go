@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurringrule
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RecordType applies equality check predicate on the "record_type" field. It's identical to RecordTypeEQ.
+func RecordType(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldRecordType, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldAmount, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldTitle, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Frequency applies equality check predicate on the "frequency" field. It's identical to FrequencyEQ.
+func Frequency(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldFrequency, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldEndDate, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// RecordTypeEQ applies the EQ predicate on the "record_type" field.
+func RecordTypeEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldRecordType, v))
+}
+
+// RecordTypeNEQ applies the NEQ predicate on the "record_type" field.
+func RecordTypeNEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldRecordType, v))
+}
+
+// RecordTypeIn applies the In predicate on the "record_type" field.
+func RecordTypeIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldRecordType, vs...))
+}
+
+// RecordTypeNotIn applies the NotIn predicate on the "record_type" field.
+func RecordTypeNotIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldRecordType, vs...))
+}
+
+// RecordTypeGT applies the GT predicate on the "record_type" field.
+func RecordTypeGT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldRecordType, v))
+}
+
+// RecordTypeGTE applies the GTE predicate on the "record_type" field.
+func RecordTypeGTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldRecordType, v))
+}
+
+// RecordTypeLT applies the LT predicate on the "record_type" field.
+func RecordTypeLT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldRecordType, v))
+}
+
+// RecordTypeLTE applies the LTE predicate on the "record_type" field.
+func RecordTypeLTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldRecordType, v))
+}
+
+// RecordTypeContains applies the Contains predicate on the "record_type" field.
+func RecordTypeContains(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContains(FieldRecordType, v))
+}
+
+// RecordTypeHasPrefix applies the HasPrefix predicate on the "record_type" field.
+func RecordTypeHasPrefix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasPrefix(FieldRecordType, v))
+}
+
+// RecordTypeHasSuffix applies the HasSuffix predicate on the "record_type" field.
+func RecordTypeHasSuffix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasSuffix(FieldRecordType, v))
+}
+
+// RecordTypeEqualFold applies the EqualFold predicate on the "record_type" field.
+func RecordTypeEqualFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEqualFold(FieldRecordType, v))
+}
+
+// RecordTypeContainsFold applies the ContainsFold predicate on the "record_type" field.
+func RecordTypeContainsFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContainsFold(FieldRecordType, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v domain.Money) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldAmount, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// FrequencyEQ applies the EQ predicate on the "frequency" field.
+func FrequencyEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldFrequency, v))
+}
+
+// FrequencyNEQ applies the NEQ predicate on the "frequency" field.
+func FrequencyNEQ(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldFrequency, v))
+}
+
+// FrequencyIn applies the In predicate on the "frequency" field.
+func FrequencyIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldFrequency, vs...))
+}
+
+// FrequencyNotIn applies the NotIn predicate on the "frequency" field.
+func FrequencyNotIn(vs ...string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldFrequency, vs...))
+}
+
+// FrequencyGT applies the GT predicate on the "frequency" field.
+func FrequencyGT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldFrequency, v))
+}
+
+// FrequencyGTE applies the GTE predicate on the "frequency" field.
+func FrequencyGTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldFrequency, v))
+}
+
+// FrequencyLT applies the LT predicate on the "frequency" field.
+func FrequencyLT(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldFrequency, v))
+}
+
+// FrequencyLTE applies the LTE predicate on the "frequency" field.
+func FrequencyLTE(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldFrequency, v))
+}
+
+// FrequencyContains applies the Contains predicate on the "frequency" field.
+func FrequencyContains(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContains(FieldFrequency, v))
+}
+
+// FrequencyHasPrefix applies the HasPrefix predicate on the "frequency" field.
+func FrequencyHasPrefix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasPrefix(FieldFrequency, v))
+}
+
+// FrequencyHasSuffix applies the HasSuffix predicate on the "frequency" field.
+func FrequencyHasSuffix(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldHasSuffix(FieldFrequency, v))
+}
+
+// FrequencyEqualFold applies the EqualFold predicate on the "frequency" field.
+func FrequencyEqualFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEqualFold(FieldFrequency, v))
+}
+
+// FrequencyContainsFold applies the ContainsFold predicate on the "frequency" field.
+func FrequencyContainsFold(v string) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldContainsFold(FieldFrequency, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIsNull(FieldEndDate))
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotNull(FieldEndDate))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotNull(FieldAccountID))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uuid.UUID) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.RecurringRule {
+	return predicate.RecurringRule(sql.FieldNotNull(FieldCategoryID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.Transaction) predicate.RecurringRule {
+	return predicate.RecurringRule(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecurringRule) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecurringRule) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecurringRule) predicate.RecurringRule {
+	return predicate.RecurringRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecurringRuleCreate is the builder for creating a RecurringRule entity.
+type RecurringRuleCreate struct {
+	config
+	mutation *RecurringRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecurringRuleCreate) SetCreatedAt(v time.Time) *RecurringRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableCreatedAt(v *time.Time) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RecurringRuleCreate) SetUpdatedAt(v time.Time) *RecurringRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableUpdatedAt(v *time.Time) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetRecordType sets the "record_type" field.
+func (_c *RecurringRuleCreate) SetRecordType(v string) *RecurringRuleCreate {
+	_c.mutation.SetRecordType(v)
+	return _c
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableRecordType(v *string) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetRecordType(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *RecurringRuleCreate) SetAmount(v domain.Money) *RecurringRuleCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *RecurringRuleCreate) SetTitle(v string) *RecurringRuleCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *RecurringRuleCreate) SetCurrency(v string) *RecurringRuleCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableCurrency(v *string) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetFrequency sets the "frequency" field.
+func (_c *RecurringRuleCreate) SetFrequency(v string) *RecurringRuleCreate {
+	_c.mutation.SetFrequency(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *RecurringRuleCreate) SetStartDate(v time.Time) *RecurringRuleCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *RecurringRuleCreate) SetEndDate(v time.Time) *RecurringRuleCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableEndDate(v *time.Time) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetEndDate(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *RecurringRuleCreate) SetAccountID(v uuid.UUID) *RecurringRuleCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableAccountID(v *uuid.UUID) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *RecurringRuleCreate) SetCategoryID(v uuid.UUID) *RecurringRuleCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableCategoryID(v *uuid.UUID) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RecurringRuleCreate) SetID(v uuid.UUID) *RecurringRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RecurringRuleCreate) SetNillableID(v *uuid.UUID) *RecurringRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *RecurringRuleCreate) SetLedgerID(id uuid.UUID) *RecurringRuleCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *RecurringRuleCreate) SetLedger(v *Ledger) *RecurringRuleCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *RecurringRuleCreate) SetAccount(v *Account) *RecurringRuleCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *RecurringRuleCreate) SetCategory(v *Category) *RecurringRuleCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *RecurringRuleCreate) AddTransactionIDs(ids ...uuid.UUID) *RecurringRuleCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *RecurringRuleCreate) AddTransactions(v ...*Transaction) *RecurringRuleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the RecurringRuleMutation object of the builder.
+func (_c *RecurringRuleCreate) Mutation() *RecurringRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RecurringRule in the database.
+func (_c *RecurringRuleCreate) Save(ctx context.Context) (*RecurringRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecurringRuleCreate) SaveX(ctx context.Context) *RecurringRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurringRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurringRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecurringRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := recurringrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := recurringrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RecordType(); !ok {
+		v := recurringrule.DefaultRecordType
+		_c.mutation.SetRecordType(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := recurringrule.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := recurringrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecurringRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurringRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RecurringRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.RecordType(); !ok {
+		return &ValidationError{Name: "record_type", err: errors.New(`ent: missing required field "RecurringRule.record_type"`)}
+	}
+	if v, ok := _c.mutation.RecordType(); ok {
+		if err := recurringrule.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.record_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "RecurringRule.amount"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "RecurringRule.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := recurringrule.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "RecurringRule.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := recurringrule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "RecurringRule.frequency"`)}
+	}
+	if v, ok := _c.mutation.Frequency(); ok {
+		if err := recurringrule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.frequency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "RecurringRule.start_date"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "RecurringRule.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *RecurringRuleCreate) sqlSave(ctx context.Context) (*RecurringRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecurringRuleCreate) createSpec() (*RecurringRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecurringRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recurringrule.Table, sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recurringrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.RecordType(); ok {
+		_spec.SetField(recurringrule.FieldRecordType, field.TypeString, value)
+		_node.RecordType = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(recurringrule.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(recurringrule.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(recurringrule.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Frequency(); ok {
+		_spec.SetField(recurringrule.FieldFrequency, field.TypeString, value)
+		_node.Frequency = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(recurringrule.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(recurringrule.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.LedgerTable,
+			Columns: []string{recurringrule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.AccountTable,
+			Columns: []string{recurringrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.CategoryTable,
+			Columns: []string{recurringrule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RecurringRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RecurringRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RecurringRuleCreate) OnConflict(opts ...sql.ConflictOption) *RecurringRuleUpsertOne {
+	_c.conflict = opts
+	return &RecurringRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RecurringRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RecurringRuleCreate) OnConflictColumns(columns ...string) *RecurringRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RecurringRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RecurringRuleUpsertOne is the builder for "upsert"-ing
+	//  one RecurringRule node.
+	RecurringRuleUpsertOne struct {
+		create *RecurringRuleCreate
+	}
+
+	// RecurringRuleUpsert is the "OnConflict" setter.
+	RecurringRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RecurringRuleUpsert) SetUpdatedAt(v time.Time) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateUpdatedAt() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldUpdatedAt)
+	return u
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *RecurringRuleUpsert) SetRecordType(v string) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldRecordType, v)
+	return u
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateRecordType() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldRecordType)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *RecurringRuleUpsert) SetAmount(v domain.Money) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateAmount() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *RecurringRuleUpsert) AddAmount(v domain.Money) *RecurringRuleUpsert {
+	u.Add(recurringrule.FieldAmount, v)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *RecurringRuleUpsert) SetTitle(v string) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateTitle() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldTitle)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *RecurringRuleUpsert) SetCurrency(v string) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateCurrency() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldCurrency)
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *RecurringRuleUpsert) SetFrequency(v string) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldFrequency, v)
+	return u
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateFrequency() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldFrequency)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *RecurringRuleUpsert) SetStartDate(v time.Time) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateStartDate() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *RecurringRuleUpsert) SetEndDate(v time.Time) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateEndDate() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldEndDate)
+	return u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *RecurringRuleUpsert) ClearEndDate() *RecurringRuleUpsert {
+	u.SetNull(recurringrule.FieldEndDate)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *RecurringRuleUpsert) SetAccountID(v uuid.UUID) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateAccountID() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *RecurringRuleUpsert) ClearAccountID() *RecurringRuleUpsert {
+	u.SetNull(recurringrule.FieldAccountID)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *RecurringRuleUpsert) SetCategoryID(v uuid.UUID) *RecurringRuleUpsert {
+	u.Set(recurringrule.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *RecurringRuleUpsert) UpdateCategoryID() *RecurringRuleUpsert {
+	u.SetExcluded(recurringrule.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *RecurringRuleUpsert) ClearCategoryID() *RecurringRuleUpsert {
+	u.SetNull(recurringrule.FieldCategoryID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RecurringRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(recurringrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RecurringRuleUpsertOne) UpdateNewValues() *RecurringRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(recurringrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(recurringrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RecurringRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RecurringRuleUpsertOne) Ignore() *RecurringRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RecurringRuleUpsertOne) DoNothing() *RecurringRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RecurringRuleCreate.OnConflict
+// documentation for more info.
+func (u *RecurringRuleUpsertOne) Update(set func(*RecurringRuleUpsert)) *RecurringRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RecurringRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RecurringRuleUpsertOne) SetUpdatedAt(v time.Time) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateUpdatedAt() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *RecurringRuleUpsertOne) SetRecordType(v string) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetRecordType(v)
+	})
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateRecordType() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateRecordType()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *RecurringRuleUpsertOne) SetAmount(v domain.Money) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *RecurringRuleUpsertOne) AddAmount(v domain.Money) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateAmount() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *RecurringRuleUpsertOne) SetTitle(v string) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateTitle() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *RecurringRuleUpsertOne) SetCurrency(v string) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateCurrency() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *RecurringRuleUpsertOne) SetFrequency(v string) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateFrequency() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *RecurringRuleUpsertOne) SetStartDate(v time.Time) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateStartDate() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *RecurringRuleUpsertOne) SetEndDate(v time.Time) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateEndDate() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *RecurringRuleUpsertOne) ClearEndDate() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *RecurringRuleUpsertOne) SetAccountID(v uuid.UUID) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateAccountID() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *RecurringRuleUpsertOne) ClearAccountID() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *RecurringRuleUpsertOne) SetCategoryID(v uuid.UUID) *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *RecurringRuleUpsertOne) UpdateCategoryID() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *RecurringRuleUpsertOne) ClearCategoryID() *RecurringRuleUpsertOne {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *RecurringRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RecurringRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RecurringRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RecurringRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RecurringRuleUpsertOne.ID is not supported by MySQL driver. Use RecurringRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RecurringRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RecurringRuleCreateBulk is the builder for creating many RecurringRule entities in bulk.
+type RecurringRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RecurringRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RecurringRule entities in the database.
+func (_c *RecurringRuleCreateBulk) Save(ctx context.Context) ([]*RecurringRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecurringRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecurringRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecurringRuleCreateBulk) SaveX(ctx context.Context) []*RecurringRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurringRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurringRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RecurringRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RecurringRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RecurringRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RecurringRuleUpsertBulk {
+	_c.conflict = opts
+	return &RecurringRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RecurringRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RecurringRuleCreateBulk) OnConflictColumns(columns ...string) *RecurringRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RecurringRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RecurringRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RecurringRule nodes.
+type RecurringRuleUpsertBulk struct {
+	create *RecurringRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RecurringRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(recurringrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RecurringRuleUpsertBulk) UpdateNewValues() *RecurringRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(recurringrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(recurringrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RecurringRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RecurringRuleUpsertBulk) Ignore() *RecurringRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RecurringRuleUpsertBulk) DoNothing() *RecurringRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RecurringRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RecurringRuleUpsertBulk) Update(set func(*RecurringRuleUpsert)) *RecurringRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RecurringRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RecurringRuleUpsertBulk) SetUpdatedAt(v time.Time) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateUpdatedAt() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *RecurringRuleUpsertBulk) SetRecordType(v string) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetRecordType(v)
+	})
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateRecordType() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateRecordType()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *RecurringRuleUpsertBulk) SetAmount(v domain.Money) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *RecurringRuleUpsertBulk) AddAmount(v domain.Money) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateAmount() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *RecurringRuleUpsertBulk) SetTitle(v string) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateTitle() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *RecurringRuleUpsertBulk) SetCurrency(v string) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateCurrency() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *RecurringRuleUpsertBulk) SetFrequency(v string) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateFrequency() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *RecurringRuleUpsertBulk) SetStartDate(v time.Time) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateStartDate() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *RecurringRuleUpsertBulk) SetEndDate(v time.Time) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateEndDate() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *RecurringRuleUpsertBulk) ClearEndDate() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *RecurringRuleUpsertBulk) SetAccountID(v uuid.UUID) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateAccountID() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *RecurringRuleUpsertBulk) ClearAccountID() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *RecurringRuleUpsertBulk) SetCategoryID(v uuid.UUID) *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *RecurringRuleUpsertBulk) UpdateCategoryID() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *RecurringRuleUpsertBulk) ClearCategoryID() *RecurringRuleUpsertBulk {
+	return u.Update(func(s *RecurringRuleUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *RecurringRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RecurringRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RecurringRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RecurringRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
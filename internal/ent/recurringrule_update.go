@@ -0,0 +1,1050 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/transaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecurringRuleUpdate is the builder for updating RecurringRule entities.
+type RecurringRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecurringRuleMutation
+}
+
+// Where appends a list predicates to the RecurringRuleUpdate builder.
+func (_u *RecurringRuleUpdate) Where(ps ...predicate.RecurringRule) *RecurringRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurringRuleUpdate) SetUpdatedAt(v time.Time) *RecurringRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRecordType sets the "record_type" field.
+func (_u *RecurringRuleUpdate) SetRecordType(v string) *RecurringRuleUpdate {
+	_u.mutation.SetRecordType(v)
+	return _u
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableRecordType(v *string) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetRecordType(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *RecurringRuleUpdate) SetAmount(v domain.Money) *RecurringRuleUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableAmount(v *domain.Money) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *RecurringRuleUpdate) AddAmount(v domain.Money) *RecurringRuleUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *RecurringRuleUpdate) SetTitle(v string) *RecurringRuleUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableTitle(v *string) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *RecurringRuleUpdate) SetCurrency(v string) *RecurringRuleUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableCurrency(v *string) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *RecurringRuleUpdate) SetFrequency(v string) *RecurringRuleUpdate {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableFrequency(v *string) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *RecurringRuleUpdate) SetStartDate(v time.Time) *RecurringRuleUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableStartDate(v *time.Time) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *RecurringRuleUpdate) SetEndDate(v time.Time) *RecurringRuleUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableEndDate(v *time.Time) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (_u *RecurringRuleUpdate) ClearEndDate() *RecurringRuleUpdate {
+	_u.mutation.ClearEndDate()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *RecurringRuleUpdate) SetAccountID(v uuid.UUID) *RecurringRuleUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableAccountID(v *uuid.UUID) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *RecurringRuleUpdate) ClearAccountID() *RecurringRuleUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *RecurringRuleUpdate) SetCategoryID(v uuid.UUID) *RecurringRuleUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *RecurringRuleUpdate) SetNillableCategoryID(v *uuid.UUID) *RecurringRuleUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *RecurringRuleUpdate) ClearCategoryID() *RecurringRuleUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *RecurringRuleUpdate) SetLedgerID(id uuid.UUID) *RecurringRuleUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *RecurringRuleUpdate) SetLedger(v *Ledger) *RecurringRuleUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *RecurringRuleUpdate) SetAccount(v *Account) *RecurringRuleUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *RecurringRuleUpdate) SetCategory(v *Category) *RecurringRuleUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *RecurringRuleUpdate) AddTransactionIDs(ids ...uuid.UUID) *RecurringRuleUpdate {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *RecurringRuleUpdate) AddTransactions(v ...*Transaction) *RecurringRuleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the RecurringRuleMutation object of the builder.
+func (_u *RecurringRuleUpdate) Mutation() *RecurringRuleMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *RecurringRuleUpdate) ClearLedger() *RecurringRuleUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *RecurringRuleUpdate) ClearAccount() *RecurringRuleUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *RecurringRuleUpdate) ClearCategory() *RecurringRuleUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *RecurringRuleUpdate) ClearTransactions() *RecurringRuleUpdate {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *RecurringRuleUpdate) RemoveTransactionIDs(ids ...uuid.UUID) *RecurringRuleUpdate {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *RecurringRuleUpdate) RemoveTransactions(v ...*Transaction) *RecurringRuleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecurringRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurringRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecurringRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurringRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurringRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := recurringrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringRuleUpdate) check() error {
+	if v, ok := _u.mutation.RecordType(); ok {
+		if err := recurringrule.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.record_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := recurringrule.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := recurringrule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Frequency(); ok {
+		if err := recurringrule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.frequency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurringRule.ledger"`)
+	}
+	return nil
+}
+
+func (_u *RecurringRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringrule.Table, recurringrule.Columns, sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RecordType(); ok {
+		_spec.SetField(recurringrule.FieldRecordType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(recurringrule.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(recurringrule.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(recurringrule.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(recurringrule.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(recurringrule.FieldFrequency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(recurringrule.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(recurringrule.FieldEndDate, field.TypeTime, value)
+	}
+	if _u.mutation.EndDateCleared() {
+		_spec.ClearField(recurringrule.FieldEndDate, field.TypeTime)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.LedgerTable,
+			Columns: []string{recurringrule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.LedgerTable,
+			Columns: []string{recurringrule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.AccountTable,
+			Columns: []string{recurringrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.AccountTable,
+			Columns: []string{recurringrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.CategoryTable,
+			Columns: []string{recurringrule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.CategoryTable,
+			Columns: []string{recurringrule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecurringRuleUpdateOne is the builder for updating a single RecurringRule entity.
+type RecurringRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecurringRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurringRuleUpdateOne) SetUpdatedAt(v time.Time) *RecurringRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRecordType sets the "record_type" field.
+func (_u *RecurringRuleUpdateOne) SetRecordType(v string) *RecurringRuleUpdateOne {
+	_u.mutation.SetRecordType(v)
+	return _u
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableRecordType(v *string) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetRecordType(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *RecurringRuleUpdateOne) SetAmount(v domain.Money) *RecurringRuleUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableAmount(v *domain.Money) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *RecurringRuleUpdateOne) AddAmount(v domain.Money) *RecurringRuleUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *RecurringRuleUpdateOne) SetTitle(v string) *RecurringRuleUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableTitle(v *string) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *RecurringRuleUpdateOne) SetCurrency(v string) *RecurringRuleUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableCurrency(v *string) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *RecurringRuleUpdateOne) SetFrequency(v string) *RecurringRuleUpdateOne {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableFrequency(v *string) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *RecurringRuleUpdateOne) SetStartDate(v time.Time) *RecurringRuleUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableStartDate(v *time.Time) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *RecurringRuleUpdateOne) SetEndDate(v time.Time) *RecurringRuleUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableEndDate(v *time.Time) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (_u *RecurringRuleUpdateOne) ClearEndDate() *RecurringRuleUpdateOne {
+	_u.mutation.ClearEndDate()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *RecurringRuleUpdateOne) SetAccountID(v uuid.UUID) *RecurringRuleUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableAccountID(v *uuid.UUID) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *RecurringRuleUpdateOne) ClearAccountID() *RecurringRuleUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *RecurringRuleUpdateOne) SetCategoryID(v uuid.UUID) *RecurringRuleUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *RecurringRuleUpdateOne) SetNillableCategoryID(v *uuid.UUID) *RecurringRuleUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *RecurringRuleUpdateOne) ClearCategoryID() *RecurringRuleUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *RecurringRuleUpdateOne) SetLedgerID(id uuid.UUID) *RecurringRuleUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *RecurringRuleUpdateOne) SetLedger(v *Ledger) *RecurringRuleUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *RecurringRuleUpdateOne) SetAccount(v *Account) *RecurringRuleUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *RecurringRuleUpdateOne) SetCategory(v *Category) *RecurringRuleUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *RecurringRuleUpdateOne) AddTransactionIDs(ids ...uuid.UUID) *RecurringRuleUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *RecurringRuleUpdateOne) AddTransactions(v ...*Transaction) *RecurringRuleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the RecurringRuleMutation object of the builder.
+func (_u *RecurringRuleUpdateOne) Mutation() *RecurringRuleMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *RecurringRuleUpdateOne) ClearLedger() *RecurringRuleUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *RecurringRuleUpdateOne) ClearAccount() *RecurringRuleUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *RecurringRuleUpdateOne) ClearCategory() *RecurringRuleUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *RecurringRuleUpdateOne) ClearTransactions() *RecurringRuleUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *RecurringRuleUpdateOne) RemoveTransactionIDs(ids ...uuid.UUID) *RecurringRuleUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *RecurringRuleUpdateOne) RemoveTransactions(v ...*Transaction) *RecurringRuleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the RecurringRuleUpdate builder.
+func (_u *RecurringRuleUpdateOne) Where(ps ...predicate.RecurringRule) *RecurringRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecurringRuleUpdateOne) Select(field string, fields ...string) *RecurringRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecurringRule entity.
+func (_u *RecurringRuleUpdateOne) Save(ctx context.Context) (*RecurringRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurringRuleUpdateOne) SaveX(ctx context.Context) *RecurringRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecurringRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurringRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurringRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := recurringrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.RecordType(); ok {
+		if err := recurringrule.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.record_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := recurringrule.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := recurringrule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Frequency(); ok {
+		if err := recurringrule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringRule.frequency": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurringRule.ledger"`)
+	}
+	return nil
+}
+
+func (_u *RecurringRuleUpdateOne) sqlSave(ctx context.Context) (_node *RecurringRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringrule.Table, recurringrule.Columns, sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecurringRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurringrule.FieldID)
+		for _, f := range fields {
+			if !recurringrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recurringrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RecordType(); ok {
+		_spec.SetField(recurringrule.FieldRecordType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(recurringrule.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(recurringrule.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(recurringrule.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(recurringrule.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(recurringrule.FieldFrequency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(recurringrule.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(recurringrule.FieldEndDate, field.TypeTime, value)
+	}
+	if _u.mutation.EndDateCleared() {
+		_spec.ClearField(recurringrule.FieldEndDate, field.TypeTime)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.LedgerTable,
+			Columns: []string{recurringrule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.LedgerTable,
+			Columns: []string{recurringrule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.AccountTable,
+			Columns: []string{recurringrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.AccountTable,
+			Columns: []string{recurringrule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.CategoryTable,
+			Columns: []string{recurringrule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringrule.CategoryTable,
+			Columns: []string{recurringrule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   recurringrule.TransactionsTable,
+			Columns: []string{recurringrule.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RecurringRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
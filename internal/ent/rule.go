@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/rule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Rule is the model entity for the Rule schema.
+type Rule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// TitleContains holds the value of the "title_contains" field.
+	TitleContains *string `json:"title_contains,omitempty"`
+	// TitleRegex holds the value of the "title_regex" field.
+	TitleRegex *string `json:"title_regex,omitempty"`
+	// AmountMin holds the value of the "amount_min" field.
+	AmountMin *domain.Money `json:"amount_min,omitempty"`
+	// AmountMax holds the value of the "amount_max" field.
+	AmountMax *domain.Money `json:"amount_max,omitempty"`
+	// RecordType holds the value of the "record_type" field.
+	RecordType *string `json:"record_type,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID *uuid.UUID `json:"invoice_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *uuid.UUID `json:"category_id,omitempty"`
+	// ActionRecordType holds the value of the "action_record_type" field.
+	ActionRecordType *string `json:"action_record_type,omitempty"`
+	// ActionStatus holds the value of the "action_status" field.
+	ActionStatus *string `json:"action_status,omitempty"`
+	// Skip holds the value of the "skip" field.
+	Skip bool `json:"skip,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RuleQuery when eager-loading is set.
+	Edges        RuleEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// RuleEdges holds the relations/edges for other nodes in the graph.
+type RuleEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldAmountMin, rule.FieldAmountMax:
+			values[i] = &sql.NullScanner{S: new(domain.Money)}
+		case rule.FieldInvoiceID, rule.FieldCategoryID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case rule.FieldEnabled, rule.FieldSkip:
+			values[i] = new(sql.NullBool)
+		case rule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case rule.FieldName, rule.FieldTitleContains, rule.FieldTitleRegex, rule.FieldRecordType, rule.FieldActionRecordType, rule.FieldActionStatus:
+			values[i] = new(sql.NullString)
+		case rule.FieldCreatedAt, rule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case rule.FieldID:
+			values[i] = new(uuid.UUID)
+		case rule.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rule fields.
+func (_m *Rule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case rule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case rule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case rule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case rule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case rule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case rule.FieldTitleContains:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_contains", values[i])
+			} else if value.Valid {
+				_m.TitleContains = new(string)
+				*_m.TitleContains = value.String
+			}
+		case rule.FieldTitleRegex:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_regex", values[i])
+			} else if value.Valid {
+				_m.TitleRegex = new(string)
+				*_m.TitleRegex = value.String
+			}
+		case rule.FieldAmountMin:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_min", values[i])
+			} else if value.Valid {
+				_m.AmountMin = new(domain.Money)
+				*_m.AmountMin = *value.S.(*domain.Money)
+			}
+		case rule.FieldAmountMax:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field amount_max", values[i])
+			} else if value.Valid {
+				_m.AmountMax = new(domain.Money)
+				*_m.AmountMax = *value.S.(*domain.Money)
+			}
+		case rule.FieldRecordType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field record_type", values[i])
+			} else if value.Valid {
+				_m.RecordType = new(string)
+				*_m.RecordType = value.String
+			}
+		case rule.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				_m.InvoiceID = new(uuid.UUID)
+				*_m.InvoiceID = *value.S.(*uuid.UUID)
+			}
+		case rule.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(uuid.UUID)
+				*_m.CategoryID = *value.S.(*uuid.UUID)
+			}
+		case rule.FieldActionRecordType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_record_type", values[i])
+			} else if value.Valid {
+				_m.ActionRecordType = new(string)
+				*_m.ActionRecordType = value.String
+			}
+		case rule.FieldActionStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_status", values[i])
+			} else if value.Valid {
+				_m.ActionStatus = new(string)
+				*_m.ActionStatus = value.String
+			}
+		case rule.FieldSkip:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field skip", values[i])
+			} else if value.Valid {
+				_m.Skip = value.Bool
+			}
+		case rule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rule.
+// This includes values selected through modifiers, order, etc.
+func (_m *Rule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Rule entity.
+func (_m *Rule) QueryLedger() *LedgerQuery {
+	return NewRuleClient(_m.config).QueryLedger(_m)
+}
+
+// QueryInvoice queries the "invoice" edge of the Rule entity.
+func (_m *Rule) QueryInvoice() *InvoiceQuery {
+	return NewRuleClient(_m.config).QueryInvoice(_m)
+}
+
+// QueryCategory queries the "category" edge of the Rule entity.
+func (_m *Rule) QueryCategory() *CategoryQuery {
+	return NewRuleClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this Rule.
+// Note that you need to call Rule.Unwrap() before calling this method if this Rule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Rule) Update() *RuleUpdateOne {
+	return NewRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Rule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Rule) Unwrap() *Rule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Rule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.TitleContains; v != nil {
+		builder.WriteString("title_contains=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TitleRegex; v != nil {
+		builder.WriteString("title_regex=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AmountMin; v != nil {
+		builder.WriteString("amount_min=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AmountMax; v != nil {
+		builder.WriteString("amount_max=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RecordType; v != nil {
+		builder.WriteString("record_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.InvoiceID; v != nil {
+		builder.WriteString("invoice_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ActionRecordType; v != nil {
+		builder.WriteString("action_record_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ActionStatus; v != nil {
+		builder.WriteString("action_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("skip=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Skip))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Rules is a parsable slice of Rule.
+type Rules []*Rule
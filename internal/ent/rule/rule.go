@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the rule type in the database.
+	Label = "rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldTitleContains holds the string denoting the title_contains field in the database.
+	FieldTitleContains = "title_contains"
+	// FieldTitleRegex holds the string denoting the title_regex field in the database.
+	FieldTitleRegex = "title_regex"
+	// FieldAmountMin holds the string denoting the amount_min field in the database.
+	FieldAmountMin = "amount_min"
+	// FieldAmountMax holds the string denoting the amount_max field in the database.
+	FieldAmountMax = "amount_max"
+	// FieldRecordType holds the string denoting the record_type field in the database.
+	FieldRecordType = "record_type"
+	// FieldInvoiceID holds the string denoting the invoice_id field in the database.
+	FieldInvoiceID = "invoice_id"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldActionRecordType holds the string denoting the action_record_type field in the database.
+	FieldActionRecordType = "action_record_type"
+	// FieldActionStatus holds the string denoting the action_status field in the database.
+	FieldActionStatus = "action_status"
+	// FieldSkip holds the string denoting the skip field in the database.
+	FieldSkip = "skip"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// Table holds the table name of the rule in the database.
+	Table = "rules"
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "rules"
+	// LedgerInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgerInverseTable = "ledgers"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "ledger_id"
+	// InvoiceTable is the table that holds the invoice relation/edge.
+	InvoiceTable = "rules"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_id"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "rules"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_id"
+)
+
+// Columns holds all SQL columns for rule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldPriority,
+	FieldEnabled,
+	FieldTitleContains,
+	FieldTitleRegex,
+	FieldAmountMin,
+	FieldAmountMax,
+	FieldRecordType,
+	FieldInvoiceID,
+	FieldCategoryID,
+	FieldActionRecordType,
+	FieldActionStatus,
+	FieldSkip,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "rules"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"ledger_id",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// TitleContainsValidator is a validator for the "title_contains" field. It is called by the builders before save.
+	TitleContainsValidator func(string) error
+	// TitleRegexValidator is a validator for the "title_regex" field. It is called by the builders before save.
+	TitleRegexValidator func(string) error
+	// RecordTypeValidator is a validator for the "record_type" field. It is called by the builders before save.
+	RecordTypeValidator func(string) error
+	// ActionRecordTypeValidator is a validator for the "action_record_type" field. It is called by the builders before save.
+	ActionRecordTypeValidator func(string) error
+	// ActionStatusValidator is a validator for the "action_status" field. It is called by the builders before save.
+	ActionStatusValidator func(string) error
+	// DefaultSkip holds the default value on creation for the "skip" field.
+	DefaultSkip bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Rule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByTitleContains orders the results by the title_contains field.
+func ByTitleContains(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleContains, opts...).ToFunc()
+}
+
+// ByTitleRegex orders the results by the title_regex field.
+func ByTitleRegex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleRegex, opts...).ToFunc()
+}
+
+// ByAmountMin orders the results by the amount_min field.
+func ByAmountMin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountMin, opts...).ToFunc()
+}
+
+// ByAmountMax orders the results by the amount_max field.
+func ByAmountMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmountMax, opts...).ToFunc()
+}
+
+// ByRecordType orders the results by the record_type field.
+func ByRecordType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordType, opts...).ToFunc()
+}
+
+// ByInvoiceID orders the results by the invoice_id field.
+func ByInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceID, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByActionRecordType orders the results by the action_record_type field.
+func ByActionRecordType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActionRecordType, opts...).ToFunc()
+}
+
+// ByActionStatus orders the results by the action_status field.
+func ByActionStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActionStatus, opts...).ToFunc()
+}
+
+// BySkip orders the results by the skip field.
+func BySkip(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkip, opts...).ToFunc()
+}
+
+// ByLedgerField orders the results by ledger field.
+func ByLedgerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvoiceField orders the results by invoice field.
+func ByInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+	)
+}
+func newInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InvoiceTable, InvoiceColumn),
+	)
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+	)
+}
@@ -0,0 +1,957 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldName, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldPriority, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// TitleContains applies equality check predicate on the "title_contains" field. It's identical to TitleContainsEQ.
+func TitleContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldTitleContains, v))
+}
+
+// TitleRegex applies equality check predicate on the "title_regex" field. It's identical to TitleRegexEQ.
+func TitleRegex(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldTitleRegex, v))
+}
+
+// AmountMin applies equality check predicate on the "amount_min" field. It's identical to AmountMinEQ.
+func AmountMin(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldAmountMin, v))
+}
+
+// AmountMax applies equality check predicate on the "amount_max" field. It's identical to AmountMaxEQ.
+func AmountMax(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldAmountMax, v))
+}
+
+// RecordType applies equality check predicate on the "record_type" field. It's identical to RecordTypeEQ.
+func RecordType(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldRecordType, v))
+}
+
+// InvoiceID applies equality check predicate on the "invoice_id" field. It's identical to InvoiceIDEQ.
+func InvoiceID(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// ActionRecordType applies equality check predicate on the "action_record_type" field. It's identical to ActionRecordTypeEQ.
+func ActionRecordType(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldActionRecordType, v))
+}
+
+// ActionStatus applies equality check predicate on the "action_status" field. It's identical to ActionStatusEQ.
+func ActionStatus(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldActionStatus, v))
+}
+
+// Skip applies equality check predicate on the "skip" field. It's identical to SkipEQ.
+func Skip(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldSkip, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldName, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldPriority, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// TitleContainsEQ applies the EQ predicate on the "title_contains" field.
+func TitleContainsEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldTitleContains, v))
+}
+
+// TitleContainsNEQ applies the NEQ predicate on the "title_contains" field.
+func TitleContainsNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldTitleContains, v))
+}
+
+// TitleContainsIn applies the In predicate on the "title_contains" field.
+func TitleContainsIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldTitleContains, vs...))
+}
+
+// TitleContainsNotIn applies the NotIn predicate on the "title_contains" field.
+func TitleContainsNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldTitleContains, vs...))
+}
+
+// TitleContainsGT applies the GT predicate on the "title_contains" field.
+func TitleContainsGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldTitleContains, v))
+}
+
+// TitleContainsGTE applies the GTE predicate on the "title_contains" field.
+func TitleContainsGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldTitleContains, v))
+}
+
+// TitleContainsLT applies the LT predicate on the "title_contains" field.
+func TitleContainsLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldTitleContains, v))
+}
+
+// TitleContainsLTE applies the LTE predicate on the "title_contains" field.
+func TitleContainsLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldTitleContains, v))
+}
+
+// TitleContainsContains applies the Contains predicate on the "title_contains" field.
+func TitleContainsContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldTitleContains, v))
+}
+
+// TitleContainsHasPrefix applies the HasPrefix predicate on the "title_contains" field.
+func TitleContainsHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldTitleContains, v))
+}
+
+// TitleContainsHasSuffix applies the HasSuffix predicate on the "title_contains" field.
+func TitleContainsHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldTitleContains, v))
+}
+
+// TitleContainsIsNil applies the IsNil predicate on the "title_contains" field.
+func TitleContainsIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldTitleContains))
+}
+
+// TitleContainsNotNil applies the NotNil predicate on the "title_contains" field.
+func TitleContainsNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldTitleContains))
+}
+
+// TitleContainsEqualFold applies the EqualFold predicate on the "title_contains" field.
+func TitleContainsEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldTitleContains, v))
+}
+
+// TitleContainsContainsFold applies the ContainsFold predicate on the "title_contains" field.
+func TitleContainsContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldTitleContains, v))
+}
+
+// TitleRegexEQ applies the EQ predicate on the "title_regex" field.
+func TitleRegexEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldTitleRegex, v))
+}
+
+// TitleRegexNEQ applies the NEQ predicate on the "title_regex" field.
+func TitleRegexNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldTitleRegex, v))
+}
+
+// TitleRegexIn applies the In predicate on the "title_regex" field.
+func TitleRegexIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldTitleRegex, vs...))
+}
+
+// TitleRegexNotIn applies the NotIn predicate on the "title_regex" field.
+func TitleRegexNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldTitleRegex, vs...))
+}
+
+// TitleRegexGT applies the GT predicate on the "title_regex" field.
+func TitleRegexGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldTitleRegex, v))
+}
+
+// TitleRegexGTE applies the GTE predicate on the "title_regex" field.
+func TitleRegexGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldTitleRegex, v))
+}
+
+// TitleRegexLT applies the LT predicate on the "title_regex" field.
+func TitleRegexLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldTitleRegex, v))
+}
+
+// TitleRegexLTE applies the LTE predicate on the "title_regex" field.
+func TitleRegexLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldTitleRegex, v))
+}
+
+// TitleRegexContains applies the Contains predicate on the "title_regex" field.
+func TitleRegexContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldTitleRegex, v))
+}
+
+// TitleRegexHasPrefix applies the HasPrefix predicate on the "title_regex" field.
+func TitleRegexHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldTitleRegex, v))
+}
+
+// TitleRegexHasSuffix applies the HasSuffix predicate on the "title_regex" field.
+func TitleRegexHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldTitleRegex, v))
+}
+
+// TitleRegexIsNil applies the IsNil predicate on the "title_regex" field.
+func TitleRegexIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldTitleRegex))
+}
+
+// TitleRegexNotNil applies the NotNil predicate on the "title_regex" field.
+func TitleRegexNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldTitleRegex))
+}
+
+// TitleRegexEqualFold applies the EqualFold predicate on the "title_regex" field.
+func TitleRegexEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldTitleRegex, v))
+}
+
+// TitleRegexContainsFold applies the ContainsFold predicate on the "title_regex" field.
+func TitleRegexContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldTitleRegex, v))
+}
+
+// AmountMinEQ applies the EQ predicate on the "amount_min" field.
+func AmountMinEQ(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldAmountMin, v))
+}
+
+// AmountMinNEQ applies the NEQ predicate on the "amount_min" field.
+func AmountMinNEQ(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldAmountMin, v))
+}
+
+// AmountMinIn applies the In predicate on the "amount_min" field.
+func AmountMinIn(vs ...domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldAmountMin, vs...))
+}
+
+// AmountMinNotIn applies the NotIn predicate on the "amount_min" field.
+func AmountMinNotIn(vs ...domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldAmountMin, vs...))
+}
+
+// AmountMinGT applies the GT predicate on the "amount_min" field.
+func AmountMinGT(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldAmountMin, v))
+}
+
+// AmountMinGTE applies the GTE predicate on the "amount_min" field.
+func AmountMinGTE(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldAmountMin, v))
+}
+
+// AmountMinLT applies the LT predicate on the "amount_min" field.
+func AmountMinLT(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldAmountMin, v))
+}
+
+// AmountMinLTE applies the LTE predicate on the "amount_min" field.
+func AmountMinLTE(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldAmountMin, v))
+}
+
+// AmountMinIsNil applies the IsNil predicate on the "amount_min" field.
+func AmountMinIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldAmountMin))
+}
+
+// AmountMinNotNil applies the NotNil predicate on the "amount_min" field.
+func AmountMinNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldAmountMin))
+}
+
+// AmountMaxEQ applies the EQ predicate on the "amount_max" field.
+func AmountMaxEQ(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldAmountMax, v))
+}
+
+// AmountMaxNEQ applies the NEQ predicate on the "amount_max" field.
+func AmountMaxNEQ(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldAmountMax, v))
+}
+
+// AmountMaxIn applies the In predicate on the "amount_max" field.
+func AmountMaxIn(vs ...domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldAmountMax, vs...))
+}
+
+// AmountMaxNotIn applies the NotIn predicate on the "amount_max" field.
+func AmountMaxNotIn(vs ...domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldAmountMax, vs...))
+}
+
+// AmountMaxGT applies the GT predicate on the "amount_max" field.
+func AmountMaxGT(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldAmountMax, v))
+}
+
+// AmountMaxGTE applies the GTE predicate on the "amount_max" field.
+func AmountMaxGTE(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldAmountMax, v))
+}
+
+// AmountMaxLT applies the LT predicate on the "amount_max" field.
+func AmountMaxLT(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldAmountMax, v))
+}
+
+// AmountMaxLTE applies the LTE predicate on the "amount_max" field.
+func AmountMaxLTE(v domain.Money) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldAmountMax, v))
+}
+
+// AmountMaxIsNil applies the IsNil predicate on the "amount_max" field.
+func AmountMaxIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldAmountMax))
+}
+
+// AmountMaxNotNil applies the NotNil predicate on the "amount_max" field.
+func AmountMaxNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldAmountMax))
+}
+
+// RecordTypeEQ applies the EQ predicate on the "record_type" field.
+func RecordTypeEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldRecordType, v))
+}
+
+// RecordTypeNEQ applies the NEQ predicate on the "record_type" field.
+func RecordTypeNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldRecordType, v))
+}
+
+// RecordTypeIn applies the In predicate on the "record_type" field.
+func RecordTypeIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldRecordType, vs...))
+}
+
+// RecordTypeNotIn applies the NotIn predicate on the "record_type" field.
+func RecordTypeNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldRecordType, vs...))
+}
+
+// RecordTypeGT applies the GT predicate on the "record_type" field.
+func RecordTypeGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldRecordType, v))
+}
+
+// RecordTypeGTE applies the GTE predicate on the "record_type" field.
+func RecordTypeGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldRecordType, v))
+}
+
+// RecordTypeLT applies the LT predicate on the "record_type" field.
+func RecordTypeLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldRecordType, v))
+}
+
+// RecordTypeLTE applies the LTE predicate on the "record_type" field.
+func RecordTypeLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldRecordType, v))
+}
+
+// RecordTypeContains applies the Contains predicate on the "record_type" field.
+func RecordTypeContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldRecordType, v))
+}
+
+// RecordTypeHasPrefix applies the HasPrefix predicate on the "record_type" field.
+func RecordTypeHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldRecordType, v))
+}
+
+// RecordTypeHasSuffix applies the HasSuffix predicate on the "record_type" field.
+func RecordTypeHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldRecordType, v))
+}
+
+// RecordTypeIsNil applies the IsNil predicate on the "record_type" field.
+func RecordTypeIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldRecordType))
+}
+
+// RecordTypeNotNil applies the NotNil predicate on the "record_type" field.
+func RecordTypeNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldRecordType))
+}
+
+// RecordTypeEqualFold applies the EqualFold predicate on the "record_type" field.
+func RecordTypeEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldRecordType, v))
+}
+
+// RecordTypeContainsFold applies the ContainsFold predicate on the "record_type" field.
+func RecordTypeContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldRecordType, v))
+}
+
+// InvoiceIDEQ applies the EQ predicate on the "invoice_id" field.
+func InvoiceIDEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDNEQ applies the NEQ predicate on the "invoice_id" field.
+func InvoiceIDNEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldInvoiceID, v))
+}
+
+// InvoiceIDIn applies the In predicate on the "invoice_id" field.
+func InvoiceIDIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDNotIn applies the NotIn predicate on the "invoice_id" field.
+func InvoiceIDNotIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldInvoiceID, vs...))
+}
+
+// InvoiceIDIsNil applies the IsNil predicate on the "invoice_id" field.
+func InvoiceIDIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldInvoiceID))
+}
+
+// InvoiceIDNotNil applies the NotNil predicate on the "invoice_id" field.
+func InvoiceIDNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldInvoiceID))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldCategoryID))
+}
+
+// ActionRecordTypeEQ applies the EQ predicate on the "action_record_type" field.
+func ActionRecordTypeEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeNEQ applies the NEQ predicate on the "action_record_type" field.
+func ActionRecordTypeNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeIn applies the In predicate on the "action_record_type" field.
+func ActionRecordTypeIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldActionRecordType, vs...))
+}
+
+// ActionRecordTypeNotIn applies the NotIn predicate on the "action_record_type" field.
+func ActionRecordTypeNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldActionRecordType, vs...))
+}
+
+// ActionRecordTypeGT applies the GT predicate on the "action_record_type" field.
+func ActionRecordTypeGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeGTE applies the GTE predicate on the "action_record_type" field.
+func ActionRecordTypeGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeLT applies the LT predicate on the "action_record_type" field.
+func ActionRecordTypeLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeLTE applies the LTE predicate on the "action_record_type" field.
+func ActionRecordTypeLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeContains applies the Contains predicate on the "action_record_type" field.
+func ActionRecordTypeContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeHasPrefix applies the HasPrefix predicate on the "action_record_type" field.
+func ActionRecordTypeHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeHasSuffix applies the HasSuffix predicate on the "action_record_type" field.
+func ActionRecordTypeHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeIsNil applies the IsNil predicate on the "action_record_type" field.
+func ActionRecordTypeIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldActionRecordType))
+}
+
+// ActionRecordTypeNotNil applies the NotNil predicate on the "action_record_type" field.
+func ActionRecordTypeNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldActionRecordType))
+}
+
+// ActionRecordTypeEqualFold applies the EqualFold predicate on the "action_record_type" field.
+func ActionRecordTypeEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldActionRecordType, v))
+}
+
+// ActionRecordTypeContainsFold applies the ContainsFold predicate on the "action_record_type" field.
+func ActionRecordTypeContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldActionRecordType, v))
+}
+
+// ActionStatusEQ applies the EQ predicate on the "action_status" field.
+func ActionStatusEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldActionStatus, v))
+}
+
+// ActionStatusNEQ applies the NEQ predicate on the "action_status" field.
+func ActionStatusNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldActionStatus, v))
+}
+
+// ActionStatusIn applies the In predicate on the "action_status" field.
+func ActionStatusIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldActionStatus, vs...))
+}
+
+// ActionStatusNotIn applies the NotIn predicate on the "action_status" field.
+func ActionStatusNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldActionStatus, vs...))
+}
+
+// ActionStatusGT applies the GT predicate on the "action_status" field.
+func ActionStatusGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldActionStatus, v))
+}
+
+// ActionStatusGTE applies the GTE predicate on the "action_status" field.
+func ActionStatusGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldActionStatus, v))
+}
+
+// ActionStatusLT applies the LT predicate on the "action_status" field.
+func ActionStatusLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldActionStatus, v))
+}
+
+// ActionStatusLTE applies the LTE predicate on the "action_status" field.
+func ActionStatusLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldActionStatus, v))
+}
+
+// ActionStatusContains applies the Contains predicate on the "action_status" field.
+func ActionStatusContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldActionStatus, v))
+}
+
+// ActionStatusHasPrefix applies the HasPrefix predicate on the "action_status" field.
+func ActionStatusHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldActionStatus, v))
+}
+
+// ActionStatusHasSuffix applies the HasSuffix predicate on the "action_status" field.
+func ActionStatusHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldActionStatus, v))
+}
+
+// ActionStatusIsNil applies the IsNil predicate on the "action_status" field.
+func ActionStatusIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldActionStatus))
+}
+
+// ActionStatusNotNil applies the NotNil predicate on the "action_status" field.
+func ActionStatusNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldActionStatus))
+}
+
+// ActionStatusEqualFold applies the EqualFold predicate on the "action_status" field.
+func ActionStatusEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldActionStatus, v))
+}
+
+// ActionStatusContainsFold applies the ContainsFold predicate on the "action_status" field.
+func ActionStatusContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldActionStatus, v))
+}
+
+// SkipEQ applies the EQ predicate on the "skip" field.
+func SkipEQ(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldSkip, v))
+}
+
+// SkipNEQ applies the NEQ predicate on the "skip" field.
+func SkipNEQ(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldSkip, v))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InvoiceTable, InvoiceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := newInvoiceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Rule) predicate.Rule {
+	return predicate.Rule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Rule) predicate.Rule {
+	return predicate.Rule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Rule) predicate.Rule {
+	return predicate.Rule(sql.NotPredicates(p))
+}
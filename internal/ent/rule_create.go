@@ -0,0 +1,1663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/rule"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RuleCreate is the builder for creating a Rule entity.
+type RuleCreate struct {
+	config
+	mutation *RuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RuleCreate) SetCreatedAt(v time.Time) *RuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableCreatedAt(v *time.Time) *RuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RuleCreate) SetUpdatedAt(v time.Time) *RuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableUpdatedAt(v *time.Time) *RuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *RuleCreate) SetName(v string) *RuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *RuleCreate) SetPriority(v int) *RuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *RuleCreate) SetNillablePriority(v *int) *RuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RuleCreate) SetEnabled(v bool) *RuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableEnabled(v *bool) *RuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetTitleContains sets the "title_contains" field.
+func (_c *RuleCreate) SetTitleContains(v string) *RuleCreate {
+	_c.mutation.SetTitleContains(v)
+	return _c
+}
+
+// SetNillableTitleContains sets the "title_contains" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableTitleContains(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetTitleContains(*v)
+	}
+	return _c
+}
+
+// SetTitleRegex sets the "title_regex" field.
+func (_c *RuleCreate) SetTitleRegex(v string) *RuleCreate {
+	_c.mutation.SetTitleRegex(v)
+	return _c
+}
+
+// SetNillableTitleRegex sets the "title_regex" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableTitleRegex(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetTitleRegex(*v)
+	}
+	return _c
+}
+
+// SetAmountMin sets the "amount_min" field.
+func (_c *RuleCreate) SetAmountMin(v domain.Money) *RuleCreate {
+	_c.mutation.SetAmountMin(v)
+	return _c
+}
+
+// SetNillableAmountMin sets the "amount_min" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableAmountMin(v *domain.Money) *RuleCreate {
+	if v != nil {
+		_c.SetAmountMin(*v)
+	}
+	return _c
+}
+
+// SetAmountMax sets the "amount_max" field.
+func (_c *RuleCreate) SetAmountMax(v domain.Money) *RuleCreate {
+	_c.mutation.SetAmountMax(v)
+	return _c
+}
+
+// SetNillableAmountMax sets the "amount_max" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableAmountMax(v *domain.Money) *RuleCreate {
+	if v != nil {
+		_c.SetAmountMax(*v)
+	}
+	return _c
+}
+
+// SetRecordType sets the "record_type" field.
+func (_c *RuleCreate) SetRecordType(v string) *RuleCreate {
+	_c.mutation.SetRecordType(v)
+	return _c
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableRecordType(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetRecordType(*v)
+	}
+	return _c
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_c *RuleCreate) SetInvoiceID(v uuid.UUID) *RuleCreate {
+	_c.mutation.SetInvoiceID(v)
+	return _c
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableInvoiceID(v *uuid.UUID) *RuleCreate {
+	if v != nil {
+		_c.SetInvoiceID(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *RuleCreate) SetCategoryID(v uuid.UUID) *RuleCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableCategoryID(v *uuid.UUID) *RuleCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetActionRecordType sets the "action_record_type" field.
+func (_c *RuleCreate) SetActionRecordType(v string) *RuleCreate {
+	_c.mutation.SetActionRecordType(v)
+	return _c
+}
+
+// SetNillableActionRecordType sets the "action_record_type" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableActionRecordType(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetActionRecordType(*v)
+	}
+	return _c
+}
+
+// SetActionStatus sets the "action_status" field.
+func (_c *RuleCreate) SetActionStatus(v string) *RuleCreate {
+	_c.mutation.SetActionStatus(v)
+	return _c
+}
+
+// SetNillableActionStatus sets the "action_status" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableActionStatus(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetActionStatus(*v)
+	}
+	return _c
+}
+
+// SetSkip sets the "skip" field.
+func (_c *RuleCreate) SetSkip(v bool) *RuleCreate {
+	_c.mutation.SetSkip(v)
+	return _c
+}
+
+// SetNillableSkip sets the "skip" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableSkip(v *bool) *RuleCreate {
+	if v != nil {
+		_c.SetSkip(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RuleCreate) SetID(v uuid.UUID) *RuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableID(v *uuid.UUID) *RuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *RuleCreate) SetLedgerID(id uuid.UUID) *RuleCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *RuleCreate) SetLedger(v *Ledger) *RuleCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_c *RuleCreate) SetInvoice(v *Invoice) *RuleCreate {
+	return _c.SetInvoiceID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *RuleCreate) SetCategory(v *Category) *RuleCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_c *RuleCreate) Mutation() *RuleMutation {
+	return _c.mutation
+}
+
+// Save creates the Rule in the database.
+func (_c *RuleCreate) Save(ctx context.Context) (*Rule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RuleCreate) SaveX(ctx context.Context) *Rule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := rule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := rule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := rule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Skip(); !ok {
+		v := rule.DefaultSkip
+		_c.mutation.SetSkip(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := rule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Rule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Rule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Rule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Rule.priority"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Rule.enabled"`)}
+	}
+	if v, ok := _c.mutation.TitleContains(); ok {
+		if err := rule.TitleContainsValidator(v); err != nil {
+			return &ValidationError{Name: "title_contains", err: fmt.Errorf(`ent: validator failed for field "Rule.title_contains": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TitleRegex(); ok {
+		if err := rule.TitleRegexValidator(v); err != nil {
+			return &ValidationError{Name: "title_regex", err: fmt.Errorf(`ent: validator failed for field "Rule.title_regex": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RecordType(); ok {
+		if err := rule.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "Rule.record_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ActionRecordType(); ok {
+		if err := rule.ActionRecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "action_record_type", err: fmt.Errorf(`ent: validator failed for field "Rule.action_record_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ActionStatus(); ok {
+		if err := rule.ActionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "action_status", err: fmt.Errorf(`ent: validator failed for field "Rule.action_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Skip(); !ok {
+		return &ValidationError{Name: "skip", err: errors.New(`ent: missing required field "Rule.skip"`)}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Rule.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *RuleCreate) sqlSave(ctx context.Context) (*Rule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RuleCreate) createSpec() (*Rule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rule.Table, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(rule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(rule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(rule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(rule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.TitleContains(); ok {
+		_spec.SetField(rule.FieldTitleContains, field.TypeString, value)
+		_node.TitleContains = &value
+	}
+	if value, ok := _c.mutation.TitleRegex(); ok {
+		_spec.SetField(rule.FieldTitleRegex, field.TypeString, value)
+		_node.TitleRegex = &value
+	}
+	if value, ok := _c.mutation.AmountMin(); ok {
+		_spec.SetField(rule.FieldAmountMin, field.TypeInt64, value)
+		_node.AmountMin = &value
+	}
+	if value, ok := _c.mutation.AmountMax(); ok {
+		_spec.SetField(rule.FieldAmountMax, field.TypeInt64, value)
+		_node.AmountMax = &value
+	}
+	if value, ok := _c.mutation.RecordType(); ok {
+		_spec.SetField(rule.FieldRecordType, field.TypeString, value)
+		_node.RecordType = &value
+	}
+	if value, ok := _c.mutation.ActionRecordType(); ok {
+		_spec.SetField(rule.FieldActionRecordType, field.TypeString, value)
+		_node.ActionRecordType = &value
+	}
+	if value, ok := _c.mutation.ActionStatus(); ok {
+		_spec.SetField(rule.FieldActionStatus, field.TypeString, value)
+		_node.ActionStatus = &value
+	}
+	if value, ok := _c.mutation.Skip(); ok {
+		_spec.SetField(rule.FieldSkip, field.TypeBool, value)
+		_node.Skip = value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.LedgerTable,
+			Columns: []string{rule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.InvoiceTable,
+			Columns: []string{rule.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.CategoryTable,
+			Columns: []string{rule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Rule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RuleCreate) OnConflict(opts ...sql.ConflictOption) *RuleUpsertOne {
+	_c.conflict = opts
+	return &RuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RuleCreate) OnConflictColumns(columns ...string) *RuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RuleUpsertOne is the builder for "upsert"-ing
+	//  one Rule node.
+	RuleUpsertOne struct {
+		create *RuleCreate
+	}
+
+	// RuleUpsert is the "OnConflict" setter.
+	RuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RuleUpsert) SetUpdatedAt(v time.Time) *RuleUpsert {
+	u.Set(rule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateUpdatedAt() *RuleUpsert {
+	u.SetExcluded(rule.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RuleUpsert) SetName(v string) *RuleUpsert {
+	u.Set(rule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateName() *RuleUpsert {
+	u.SetExcluded(rule.FieldName)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *RuleUpsert) SetPriority(v int) *RuleUpsert {
+	u.Set(rule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RuleUpsert) UpdatePriority() *RuleUpsert {
+	u.SetExcluded(rule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RuleUpsert) AddPriority(v int) *RuleUpsert {
+	u.Add(rule.FieldPriority, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RuleUpsert) SetEnabled(v bool) *RuleUpsert {
+	u.Set(rule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateEnabled() *RuleUpsert {
+	u.SetExcluded(rule.FieldEnabled)
+	return u
+}
+
+// SetTitleContains sets the "title_contains" field.
+func (u *RuleUpsert) SetTitleContains(v string) *RuleUpsert {
+	u.Set(rule.FieldTitleContains, v)
+	return u
+}
+
+// UpdateTitleContains sets the "title_contains" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateTitleContains() *RuleUpsert {
+	u.SetExcluded(rule.FieldTitleContains)
+	return u
+}
+
+// ClearTitleContains clears the value of the "title_contains" field.
+func (u *RuleUpsert) ClearTitleContains() *RuleUpsert {
+	u.SetNull(rule.FieldTitleContains)
+	return u
+}
+
+// SetTitleRegex sets the "title_regex" field.
+func (u *RuleUpsert) SetTitleRegex(v string) *RuleUpsert {
+	u.Set(rule.FieldTitleRegex, v)
+	return u
+}
+
+// UpdateTitleRegex sets the "title_regex" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateTitleRegex() *RuleUpsert {
+	u.SetExcluded(rule.FieldTitleRegex)
+	return u
+}
+
+// ClearTitleRegex clears the value of the "title_regex" field.
+func (u *RuleUpsert) ClearTitleRegex() *RuleUpsert {
+	u.SetNull(rule.FieldTitleRegex)
+	return u
+}
+
+// SetAmountMin sets the "amount_min" field.
+func (u *RuleUpsert) SetAmountMin(v domain.Money) *RuleUpsert {
+	u.Set(rule.FieldAmountMin, v)
+	return u
+}
+
+// UpdateAmountMin sets the "amount_min" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateAmountMin() *RuleUpsert {
+	u.SetExcluded(rule.FieldAmountMin)
+	return u
+}
+
+// AddAmountMin adds v to the "amount_min" field.
+func (u *RuleUpsert) AddAmountMin(v domain.Money) *RuleUpsert {
+	u.Add(rule.FieldAmountMin, v)
+	return u
+}
+
+// ClearAmountMin clears the value of the "amount_min" field.
+func (u *RuleUpsert) ClearAmountMin() *RuleUpsert {
+	u.SetNull(rule.FieldAmountMin)
+	return u
+}
+
+// SetAmountMax sets the "amount_max" field.
+func (u *RuleUpsert) SetAmountMax(v domain.Money) *RuleUpsert {
+	u.Set(rule.FieldAmountMax, v)
+	return u
+}
+
+// UpdateAmountMax sets the "amount_max" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateAmountMax() *RuleUpsert {
+	u.SetExcluded(rule.FieldAmountMax)
+	return u
+}
+
+// AddAmountMax adds v to the "amount_max" field.
+func (u *RuleUpsert) AddAmountMax(v domain.Money) *RuleUpsert {
+	u.Add(rule.FieldAmountMax, v)
+	return u
+}
+
+// ClearAmountMax clears the value of the "amount_max" field.
+func (u *RuleUpsert) ClearAmountMax() *RuleUpsert {
+	u.SetNull(rule.FieldAmountMax)
+	return u
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *RuleUpsert) SetRecordType(v string) *RuleUpsert {
+	u.Set(rule.FieldRecordType, v)
+	return u
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateRecordType() *RuleUpsert {
+	u.SetExcluded(rule.FieldRecordType)
+	return u
+}
+
+// ClearRecordType clears the value of the "record_type" field.
+func (u *RuleUpsert) ClearRecordType() *RuleUpsert {
+	u.SetNull(rule.FieldRecordType)
+	return u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *RuleUpsert) SetInvoiceID(v uuid.UUID) *RuleUpsert {
+	u.Set(rule.FieldInvoiceID, v)
+	return u
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateInvoiceID() *RuleUpsert {
+	u.SetExcluded(rule.FieldInvoiceID)
+	return u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (u *RuleUpsert) ClearInvoiceID() *RuleUpsert {
+	u.SetNull(rule.FieldInvoiceID)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *RuleUpsert) SetCategoryID(v uuid.UUID) *RuleUpsert {
+	u.Set(rule.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateCategoryID() *RuleUpsert {
+	u.SetExcluded(rule.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *RuleUpsert) ClearCategoryID() *RuleUpsert {
+	u.SetNull(rule.FieldCategoryID)
+	return u
+}
+
+// SetActionRecordType sets the "action_record_type" field.
+func (u *RuleUpsert) SetActionRecordType(v string) *RuleUpsert {
+	u.Set(rule.FieldActionRecordType, v)
+	return u
+}
+
+// UpdateActionRecordType sets the "action_record_type" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateActionRecordType() *RuleUpsert {
+	u.SetExcluded(rule.FieldActionRecordType)
+	return u
+}
+
+// ClearActionRecordType clears the value of the "action_record_type" field.
+func (u *RuleUpsert) ClearActionRecordType() *RuleUpsert {
+	u.SetNull(rule.FieldActionRecordType)
+	return u
+}
+
+// SetActionStatus sets the "action_status" field.
+func (u *RuleUpsert) SetActionStatus(v string) *RuleUpsert {
+	u.Set(rule.FieldActionStatus, v)
+	return u
+}
+
+// UpdateActionStatus sets the "action_status" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateActionStatus() *RuleUpsert {
+	u.SetExcluded(rule.FieldActionStatus)
+	return u
+}
+
+// ClearActionStatus clears the value of the "action_status" field.
+func (u *RuleUpsert) ClearActionStatus() *RuleUpsert {
+	u.SetNull(rule.FieldActionStatus)
+	return u
+}
+
+// SetSkip sets the "skip" field.
+func (u *RuleUpsert) SetSkip(v bool) *RuleUpsert {
+	u.Set(rule.FieldSkip, v)
+	return u
+}
+
+// UpdateSkip sets the "skip" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateSkip() *RuleUpsert {
+	u.SetExcluded(rule.FieldSkip)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RuleUpsertOne) UpdateNewValues() *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RuleUpsertOne) Ignore() *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RuleUpsertOne) DoNothing() *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RuleCreate.OnConflict
+// documentation for more info.
+func (u *RuleUpsertOne) Update(set func(*RuleUpsert)) *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RuleUpsertOne) SetUpdatedAt(v time.Time) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateUpdatedAt() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RuleUpsertOne) SetName(v string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateName() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RuleUpsertOne) SetPriority(v int) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RuleUpsertOne) AddPriority(v int) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdatePriority() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RuleUpsertOne) SetEnabled(v bool) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateEnabled() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetTitleContains sets the "title_contains" field.
+func (u *RuleUpsertOne) SetTitleContains(v string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetTitleContains(v)
+	})
+}
+
+// UpdateTitleContains sets the "title_contains" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateTitleContains() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateTitleContains()
+	})
+}
+
+// ClearTitleContains clears the value of the "title_contains" field.
+func (u *RuleUpsertOne) ClearTitleContains() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearTitleContains()
+	})
+}
+
+// SetTitleRegex sets the "title_regex" field.
+func (u *RuleUpsertOne) SetTitleRegex(v string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetTitleRegex(v)
+	})
+}
+
+// UpdateTitleRegex sets the "title_regex" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateTitleRegex() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateTitleRegex()
+	})
+}
+
+// ClearTitleRegex clears the value of the "title_regex" field.
+func (u *RuleUpsertOne) ClearTitleRegex() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearTitleRegex()
+	})
+}
+
+// SetAmountMin sets the "amount_min" field.
+func (u *RuleUpsertOne) SetAmountMin(v domain.Money) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetAmountMin(v)
+	})
+}
+
+// AddAmountMin adds v to the "amount_min" field.
+func (u *RuleUpsertOne) AddAmountMin(v domain.Money) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddAmountMin(v)
+	})
+}
+
+// UpdateAmountMin sets the "amount_min" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateAmountMin() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateAmountMin()
+	})
+}
+
+// ClearAmountMin clears the value of the "amount_min" field.
+func (u *RuleUpsertOne) ClearAmountMin() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearAmountMin()
+	})
+}
+
+// SetAmountMax sets the "amount_max" field.
+func (u *RuleUpsertOne) SetAmountMax(v domain.Money) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetAmountMax(v)
+	})
+}
+
+// AddAmountMax adds v to the "amount_max" field.
+func (u *RuleUpsertOne) AddAmountMax(v domain.Money) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddAmountMax(v)
+	})
+}
+
+// UpdateAmountMax sets the "amount_max" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateAmountMax() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateAmountMax()
+	})
+}
+
+// ClearAmountMax clears the value of the "amount_max" field.
+func (u *RuleUpsertOne) ClearAmountMax() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearAmountMax()
+	})
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *RuleUpsertOne) SetRecordType(v string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetRecordType(v)
+	})
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateRecordType() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateRecordType()
+	})
+}
+
+// ClearRecordType clears the value of the "record_type" field.
+func (u *RuleUpsertOne) ClearRecordType() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearRecordType()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *RuleUpsertOne) SetInvoiceID(v uuid.UUID) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateInvoiceID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (u *RuleUpsertOne) ClearInvoiceID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearInvoiceID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *RuleUpsertOne) SetCategoryID(v uuid.UUID) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateCategoryID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *RuleUpsertOne) ClearCategoryID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetActionRecordType sets the "action_record_type" field.
+func (u *RuleUpsertOne) SetActionRecordType(v string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetActionRecordType(v)
+	})
+}
+
+// UpdateActionRecordType sets the "action_record_type" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateActionRecordType() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateActionRecordType()
+	})
+}
+
+// ClearActionRecordType clears the value of the "action_record_type" field.
+func (u *RuleUpsertOne) ClearActionRecordType() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearActionRecordType()
+	})
+}
+
+// SetActionStatus sets the "action_status" field.
+func (u *RuleUpsertOne) SetActionStatus(v string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetActionStatus(v)
+	})
+}
+
+// UpdateActionStatus sets the "action_status" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateActionStatus() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateActionStatus()
+	})
+}
+
+// ClearActionStatus clears the value of the "action_status" field.
+func (u *RuleUpsertOne) ClearActionStatus() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearActionStatus()
+	})
+}
+
+// SetSkip sets the "skip" field.
+func (u *RuleUpsertOne) SetSkip(v bool) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetSkip(v)
+	})
+}
+
+// UpdateSkip sets the "skip" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateSkip() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateSkip()
+	})
+}
+
+// Exec executes the query.
+func (u *RuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RuleUpsertOne.ID is not supported by MySQL driver. Use RuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RuleCreateBulk is the builder for creating many Rule entities in bulk.
+type RuleCreateBulk struct {
+	config
+	err      error
+	builders []*RuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Rule entities in the database.
+func (_c *RuleCreateBulk) Save(ctx context.Context) ([]*Rule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Rule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RuleCreateBulk) SaveX(ctx context.Context) []*Rule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Rule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RuleUpsertBulk {
+	_c.conflict = opts
+	return &RuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RuleCreateBulk) OnConflictColumns(columns ...string) *RuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Rule nodes.
+type RuleUpsertBulk struct {
+	create *RuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RuleUpsertBulk) UpdateNewValues() *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RuleUpsertBulk) Ignore() *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RuleUpsertBulk) DoNothing() *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RuleUpsertBulk) Update(set func(*RuleUpsert)) *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RuleUpsertBulk) SetUpdatedAt(v time.Time) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateUpdatedAt() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RuleUpsertBulk) SetName(v string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateName() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RuleUpsertBulk) SetPriority(v int) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RuleUpsertBulk) AddPriority(v int) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdatePriority() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RuleUpsertBulk) SetEnabled(v bool) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateEnabled() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetTitleContains sets the "title_contains" field.
+func (u *RuleUpsertBulk) SetTitleContains(v string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetTitleContains(v)
+	})
+}
+
+// UpdateTitleContains sets the "title_contains" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateTitleContains() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateTitleContains()
+	})
+}
+
+// ClearTitleContains clears the value of the "title_contains" field.
+func (u *RuleUpsertBulk) ClearTitleContains() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearTitleContains()
+	})
+}
+
+// SetTitleRegex sets the "title_regex" field.
+func (u *RuleUpsertBulk) SetTitleRegex(v string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetTitleRegex(v)
+	})
+}
+
+// UpdateTitleRegex sets the "title_regex" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateTitleRegex() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateTitleRegex()
+	})
+}
+
+// ClearTitleRegex clears the value of the "title_regex" field.
+func (u *RuleUpsertBulk) ClearTitleRegex() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearTitleRegex()
+	})
+}
+
+// SetAmountMin sets the "amount_min" field.
+func (u *RuleUpsertBulk) SetAmountMin(v domain.Money) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetAmountMin(v)
+	})
+}
+
+// AddAmountMin adds v to the "amount_min" field.
+func (u *RuleUpsertBulk) AddAmountMin(v domain.Money) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddAmountMin(v)
+	})
+}
+
+// UpdateAmountMin sets the "amount_min" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateAmountMin() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateAmountMin()
+	})
+}
+
+// ClearAmountMin clears the value of the "amount_min" field.
+func (u *RuleUpsertBulk) ClearAmountMin() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearAmountMin()
+	})
+}
+
+// SetAmountMax sets the "amount_max" field.
+func (u *RuleUpsertBulk) SetAmountMax(v domain.Money) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetAmountMax(v)
+	})
+}
+
+// AddAmountMax adds v to the "amount_max" field.
+func (u *RuleUpsertBulk) AddAmountMax(v domain.Money) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddAmountMax(v)
+	})
+}
+
+// UpdateAmountMax sets the "amount_max" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateAmountMax() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateAmountMax()
+	})
+}
+
+// ClearAmountMax clears the value of the "amount_max" field.
+func (u *RuleUpsertBulk) ClearAmountMax() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearAmountMax()
+	})
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *RuleUpsertBulk) SetRecordType(v string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetRecordType(v)
+	})
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateRecordType() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateRecordType()
+	})
+}
+
+// ClearRecordType clears the value of the "record_type" field.
+func (u *RuleUpsertBulk) ClearRecordType() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearRecordType()
+	})
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (u *RuleUpsertBulk) SetInvoiceID(v uuid.UUID) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetInvoiceID(v)
+	})
+}
+
+// UpdateInvoiceID sets the "invoice_id" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateInvoiceID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateInvoiceID()
+	})
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (u *RuleUpsertBulk) ClearInvoiceID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearInvoiceID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *RuleUpsertBulk) SetCategoryID(v uuid.UUID) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateCategoryID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *RuleUpsertBulk) ClearCategoryID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetActionRecordType sets the "action_record_type" field.
+func (u *RuleUpsertBulk) SetActionRecordType(v string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetActionRecordType(v)
+	})
+}
+
+// UpdateActionRecordType sets the "action_record_type" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateActionRecordType() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateActionRecordType()
+	})
+}
+
+// ClearActionRecordType clears the value of the "action_record_type" field.
+func (u *RuleUpsertBulk) ClearActionRecordType() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearActionRecordType()
+	})
+}
+
+// SetActionStatus sets the "action_status" field.
+func (u *RuleUpsertBulk) SetActionStatus(v string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetActionStatus(v)
+	})
+}
+
+// UpdateActionStatus sets the "action_status" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateActionStatus() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateActionStatus()
+	})
+}
+
+// ClearActionStatus clears the value of the "action_status" field.
+func (u *RuleUpsertBulk) ClearActionStatus() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearActionStatus()
+	})
+}
+
+// SetSkip sets the "skip" field.
+func (u *RuleUpsertBulk) SetSkip(v bool) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetSkip(v)
+	})
+}
+
+// UpdateSkip sets the "skip" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateSkip() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateSkip()
+	})
+}
+
+// Exec executes the query.
+func (u *RuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/rule"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RuleUpdate is the builder for updating Rule entities.
+type RuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (_u *RuleUpdate) Where(ps ...predicate.Rule) *RuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RuleUpdate) SetUpdatedAt(v time.Time) *RuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RuleUpdate) SetName(v string) *RuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableName(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RuleUpdate) SetPriority(v int) *RuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillablePriority(v *int) *RuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RuleUpdate) AddPriority(v int) *RuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RuleUpdate) SetEnabled(v bool) *RuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableEnabled(v *bool) *RuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetTitleContains sets the "title_contains" field.
+func (_u *RuleUpdate) SetTitleContains(v string) *RuleUpdate {
+	_u.mutation.SetTitleContains(v)
+	return _u
+}
+
+// SetNillableTitleContains sets the "title_contains" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableTitleContains(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetTitleContains(*v)
+	}
+	return _u
+}
+
+// ClearTitleContains clears the value of the "title_contains" field.
+func (_u *RuleUpdate) ClearTitleContains() *RuleUpdate {
+	_u.mutation.ClearTitleContains()
+	return _u
+}
+
+// SetTitleRegex sets the "title_regex" field.
+func (_u *RuleUpdate) SetTitleRegex(v string) *RuleUpdate {
+	_u.mutation.SetTitleRegex(v)
+	return _u
+}
+
+// SetNillableTitleRegex sets the "title_regex" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableTitleRegex(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetTitleRegex(*v)
+	}
+	return _u
+}
+
+// ClearTitleRegex clears the value of the "title_regex" field.
+func (_u *RuleUpdate) ClearTitleRegex() *RuleUpdate {
+	_u.mutation.ClearTitleRegex()
+	return _u
+}
+
+// SetAmountMin sets the "amount_min" field.
+func (_u *RuleUpdate) SetAmountMin(v domain.Money) *RuleUpdate {
+	_u.mutation.ResetAmountMin()
+	_u.mutation.SetAmountMin(v)
+	return _u
+}
+
+// SetNillableAmountMin sets the "amount_min" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableAmountMin(v *domain.Money) *RuleUpdate {
+	if v != nil {
+		_u.SetAmountMin(*v)
+	}
+	return _u
+}
+
+// AddAmountMin adds value to the "amount_min" field.
+func (_u *RuleUpdate) AddAmountMin(v domain.Money) *RuleUpdate {
+	_u.mutation.AddAmountMin(v)
+	return _u
+}
+
+// ClearAmountMin clears the value of the "amount_min" field.
+func (_u *RuleUpdate) ClearAmountMin() *RuleUpdate {
+	_u.mutation.ClearAmountMin()
+	return _u
+}
+
+// SetAmountMax sets the "amount_max" field.
+func (_u *RuleUpdate) SetAmountMax(v domain.Money) *RuleUpdate {
+	_u.mutation.ResetAmountMax()
+	_u.mutation.SetAmountMax(v)
+	return _u
+}
+
+// SetNillableAmountMax sets the "amount_max" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableAmountMax(v *domain.Money) *RuleUpdate {
+	if v != nil {
+		_u.SetAmountMax(*v)
+	}
+	return _u
+}
+
+// AddAmountMax adds value to the "amount_max" field.
+func (_u *RuleUpdate) AddAmountMax(v domain.Money) *RuleUpdate {
+	_u.mutation.AddAmountMax(v)
+	return _u
+}
+
+// ClearAmountMax clears the value of the "amount_max" field.
+func (_u *RuleUpdate) ClearAmountMax() *RuleUpdate {
+	_u.mutation.ClearAmountMax()
+	return _u
+}
+
+// SetRecordType sets the "record_type" field.
+func (_u *RuleUpdate) SetRecordType(v string) *RuleUpdate {
+	_u.mutation.SetRecordType(v)
+	return _u
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableRecordType(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetRecordType(*v)
+	}
+	return _u
+}
+
+// ClearRecordType clears the value of the "record_type" field.
+func (_u *RuleUpdate) ClearRecordType() *RuleUpdate {
+	_u.mutation.ClearRecordType()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *RuleUpdate) SetInvoiceID(v uuid.UUID) *RuleUpdate {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableInvoiceID(v *uuid.UUID) *RuleUpdate {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *RuleUpdate) ClearInvoiceID() *RuleUpdate {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *RuleUpdate) SetCategoryID(v uuid.UUID) *RuleUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableCategoryID(v *uuid.UUID) *RuleUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *RuleUpdate) ClearCategoryID() *RuleUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetActionRecordType sets the "action_record_type" field.
+func (_u *RuleUpdate) SetActionRecordType(v string) *RuleUpdate {
+	_u.mutation.SetActionRecordType(v)
+	return _u
+}
+
+// SetNillableActionRecordType sets the "action_record_type" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableActionRecordType(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetActionRecordType(*v)
+	}
+	return _u
+}
+
+// ClearActionRecordType clears the value of the "action_record_type" field.
+func (_u *RuleUpdate) ClearActionRecordType() *RuleUpdate {
+	_u.mutation.ClearActionRecordType()
+	return _u
+}
+
+// SetActionStatus sets the "action_status" field.
+func (_u *RuleUpdate) SetActionStatus(v string) *RuleUpdate {
+	_u.mutation.SetActionStatus(v)
+	return _u
+}
+
+// SetNillableActionStatus sets the "action_status" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableActionStatus(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetActionStatus(*v)
+	}
+	return _u
+}
+
+// ClearActionStatus clears the value of the "action_status" field.
+func (_u *RuleUpdate) ClearActionStatus() *RuleUpdate {
+	_u.mutation.ClearActionStatus()
+	return _u
+}
+
+// SetSkip sets the "skip" field.
+func (_u *RuleUpdate) SetSkip(v bool) *RuleUpdate {
+	_u.mutation.SetSkip(v)
+	return _u
+}
+
+// SetNillableSkip sets the "skip" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableSkip(v *bool) *RuleUpdate {
+	if v != nil {
+		_u.SetSkip(*v)
+	}
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *RuleUpdate) SetLedgerID(id uuid.UUID) *RuleUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *RuleUpdate) SetLedger(v *Ledger) *RuleUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *RuleUpdate) SetInvoice(v *Invoice) *RuleUpdate {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *RuleUpdate) SetCategory(v *Category) *RuleUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_u *RuleUpdate) Mutation() *RuleMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *RuleUpdate) ClearLedger() *RuleUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *RuleUpdate) ClearInvoice() *RuleUpdate {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *RuleUpdate) ClearCategory() *RuleUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TitleContains(); ok {
+		if err := rule.TitleContainsValidator(v); err != nil {
+			return &ValidationError{Name: "title_contains", err: fmt.Errorf(`ent: validator failed for field "Rule.title_contains": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TitleRegex(); ok {
+		if err := rule.TitleRegexValidator(v); err != nil {
+			return &ValidationError{Name: "title_regex", err: fmt.Errorf(`ent: validator failed for field "Rule.title_regex": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RecordType(); ok {
+		if err := rule.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "Rule.record_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActionRecordType(); ok {
+		if err := rule.ActionRecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "action_record_type", err: fmt.Errorf(`ent: validator failed for field "Rule.action_record_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActionStatus(); ok {
+		if err := rule.ActionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "action_status", err: fmt.Errorf(`ent: validator failed for field "Rule.action_status": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rule.ledger"`)
+	}
+	return nil
+}
+
+func (_u *RuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rule.Table, rule.Columns, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(rule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(rule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(rule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(rule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TitleContains(); ok {
+		_spec.SetField(rule.FieldTitleContains, field.TypeString, value)
+	}
+	if _u.mutation.TitleContainsCleared() {
+		_spec.ClearField(rule.FieldTitleContains, field.TypeString)
+	}
+	if value, ok := _u.mutation.TitleRegex(); ok {
+		_spec.SetField(rule.FieldTitleRegex, field.TypeString, value)
+	}
+	if _u.mutation.TitleRegexCleared() {
+		_spec.ClearField(rule.FieldTitleRegex, field.TypeString)
+	}
+	if value, ok := _u.mutation.AmountMin(); ok {
+		_spec.SetField(rule.FieldAmountMin, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountMin(); ok {
+		_spec.AddField(rule.FieldAmountMin, field.TypeInt64, value)
+	}
+	if _u.mutation.AmountMinCleared() {
+		_spec.ClearField(rule.FieldAmountMin, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AmountMax(); ok {
+		_spec.SetField(rule.FieldAmountMax, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountMax(); ok {
+		_spec.AddField(rule.FieldAmountMax, field.TypeInt64, value)
+	}
+	if _u.mutation.AmountMaxCleared() {
+		_spec.ClearField(rule.FieldAmountMax, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RecordType(); ok {
+		_spec.SetField(rule.FieldRecordType, field.TypeString, value)
+	}
+	if _u.mutation.RecordTypeCleared() {
+		_spec.ClearField(rule.FieldRecordType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActionRecordType(); ok {
+		_spec.SetField(rule.FieldActionRecordType, field.TypeString, value)
+	}
+	if _u.mutation.ActionRecordTypeCleared() {
+		_spec.ClearField(rule.FieldActionRecordType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActionStatus(); ok {
+		_spec.SetField(rule.FieldActionStatus, field.TypeString, value)
+	}
+	if _u.mutation.ActionStatusCleared() {
+		_spec.ClearField(rule.FieldActionStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.Skip(); ok {
+		_spec.SetField(rule.FieldSkip, field.TypeBool, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.LedgerTable,
+			Columns: []string{rule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.LedgerTable,
+			Columns: []string{rule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.InvoiceTable,
+			Columns: []string{rule.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.InvoiceTable,
+			Columns: []string{rule.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.CategoryTable,
+			Columns: []string{rule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.CategoryTable,
+			Columns: []string{rule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RuleUpdateOne is the builder for updating a single Rule entity.
+type RuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RuleUpdateOne) SetUpdatedAt(v time.Time) *RuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RuleUpdateOne) SetName(v string) *RuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableName(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RuleUpdateOne) SetPriority(v int) *RuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillablePriority(v *int) *RuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RuleUpdateOne) AddPriority(v int) *RuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RuleUpdateOne) SetEnabled(v bool) *RuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableEnabled(v *bool) *RuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetTitleContains sets the "title_contains" field.
+func (_u *RuleUpdateOne) SetTitleContains(v string) *RuleUpdateOne {
+	_u.mutation.SetTitleContains(v)
+	return _u
+}
+
+// SetNillableTitleContains sets the "title_contains" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableTitleContains(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetTitleContains(*v)
+	}
+	return _u
+}
+
+// ClearTitleContains clears the value of the "title_contains" field.
+func (_u *RuleUpdateOne) ClearTitleContains() *RuleUpdateOne {
+	_u.mutation.ClearTitleContains()
+	return _u
+}
+
+// SetTitleRegex sets the "title_regex" field.
+func (_u *RuleUpdateOne) SetTitleRegex(v string) *RuleUpdateOne {
+	_u.mutation.SetTitleRegex(v)
+	return _u
+}
+
+// SetNillableTitleRegex sets the "title_regex" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableTitleRegex(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetTitleRegex(*v)
+	}
+	return _u
+}
+
+// ClearTitleRegex clears the value of the "title_regex" field.
+func (_u *RuleUpdateOne) ClearTitleRegex() *RuleUpdateOne {
+	_u.mutation.ClearTitleRegex()
+	return _u
+}
+
+// SetAmountMin sets the "amount_min" field.
+func (_u *RuleUpdateOne) SetAmountMin(v domain.Money) *RuleUpdateOne {
+	_u.mutation.ResetAmountMin()
+	_u.mutation.SetAmountMin(v)
+	return _u
+}
+
+// SetNillableAmountMin sets the "amount_min" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableAmountMin(v *domain.Money) *RuleUpdateOne {
+	if v != nil {
+		_u.SetAmountMin(*v)
+	}
+	return _u
+}
+
+// AddAmountMin adds value to the "amount_min" field.
+func (_u *RuleUpdateOne) AddAmountMin(v domain.Money) *RuleUpdateOne {
+	_u.mutation.AddAmountMin(v)
+	return _u
+}
+
+// ClearAmountMin clears the value of the "amount_min" field.
+func (_u *RuleUpdateOne) ClearAmountMin() *RuleUpdateOne {
+	_u.mutation.ClearAmountMin()
+	return _u
+}
+
+// SetAmountMax sets the "amount_max" field.
+func (_u *RuleUpdateOne) SetAmountMax(v domain.Money) *RuleUpdateOne {
+	_u.mutation.ResetAmountMax()
+	_u.mutation.SetAmountMax(v)
+	return _u
+}
+
+// SetNillableAmountMax sets the "amount_max" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableAmountMax(v *domain.Money) *RuleUpdateOne {
+	if v != nil {
+		_u.SetAmountMax(*v)
+	}
+	return _u
+}
+
+// AddAmountMax adds value to the "amount_max" field.
+func (_u *RuleUpdateOne) AddAmountMax(v domain.Money) *RuleUpdateOne {
+	_u.mutation.AddAmountMax(v)
+	return _u
+}
+
+// ClearAmountMax clears the value of the "amount_max" field.
+func (_u *RuleUpdateOne) ClearAmountMax() *RuleUpdateOne {
+	_u.mutation.ClearAmountMax()
+	return _u
+}
+
+// SetRecordType sets the "record_type" field.
+func (_u *RuleUpdateOne) SetRecordType(v string) *RuleUpdateOne {
+	_u.mutation.SetRecordType(v)
+	return _u
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableRecordType(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetRecordType(*v)
+	}
+	return _u
+}
+
+// ClearRecordType clears the value of the "record_type" field.
+func (_u *RuleUpdateOne) ClearRecordType() *RuleUpdateOne {
+	_u.mutation.ClearRecordType()
+	return _u
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (_u *RuleUpdateOne) SetInvoiceID(v uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetInvoiceID(v)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableInvoiceID(v *uuid.UUID) *RuleUpdateOne {
+	if v != nil {
+		_u.SetInvoiceID(*v)
+	}
+	return _u
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (_u *RuleUpdateOne) ClearInvoiceID() *RuleUpdateOne {
+	_u.mutation.ClearInvoiceID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *RuleUpdateOne) SetCategoryID(v uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableCategoryID(v *uuid.UUID) *RuleUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *RuleUpdateOne) ClearCategoryID() *RuleUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetActionRecordType sets the "action_record_type" field.
+func (_u *RuleUpdateOne) SetActionRecordType(v string) *RuleUpdateOne {
+	_u.mutation.SetActionRecordType(v)
+	return _u
+}
+
+// SetNillableActionRecordType sets the "action_record_type" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableActionRecordType(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetActionRecordType(*v)
+	}
+	return _u
+}
+
+// ClearActionRecordType clears the value of the "action_record_type" field.
+func (_u *RuleUpdateOne) ClearActionRecordType() *RuleUpdateOne {
+	_u.mutation.ClearActionRecordType()
+	return _u
+}
+
+// SetActionStatus sets the "action_status" field.
+func (_u *RuleUpdateOne) SetActionStatus(v string) *RuleUpdateOne {
+	_u.mutation.SetActionStatus(v)
+	return _u
+}
+
+// SetNillableActionStatus sets the "action_status" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableActionStatus(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetActionStatus(*v)
+	}
+	return _u
+}
+
+// ClearActionStatus clears the value of the "action_status" field.
+func (_u *RuleUpdateOne) ClearActionStatus() *RuleUpdateOne {
+	_u.mutation.ClearActionStatus()
+	return _u
+}
+
+// SetSkip sets the "skip" field.
+func (_u *RuleUpdateOne) SetSkip(v bool) *RuleUpdateOne {
+	_u.mutation.SetSkip(v)
+	return _u
+}
+
+// SetNillableSkip sets the "skip" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableSkip(v *bool) *RuleUpdateOne {
+	if v != nil {
+		_u.SetSkip(*v)
+	}
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *RuleUpdateOne) SetLedgerID(id uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *RuleUpdateOne) SetLedger(v *Ledger) *RuleUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *RuleUpdateOne) SetInvoice(v *Invoice) *RuleUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *RuleUpdateOne) SetCategory(v *Category) *RuleUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_u *RuleUpdateOne) Mutation() *RuleMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *RuleUpdateOne) ClearLedger() *RuleUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *RuleUpdateOne) ClearInvoice() *RuleUpdateOne {
+	_u.mutation.ClearInvoice()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *RuleUpdateOne) ClearCategory() *RuleUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (_u *RuleUpdateOne) Where(ps ...predicate.Rule) *RuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RuleUpdateOne) Select(field string, fields ...string) *RuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Rule entity.
+func (_u *RuleUpdateOne) Save(ctx context.Context) (*Rule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleUpdateOne) SaveX(ctx context.Context) *Rule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TitleContains(); ok {
+		if err := rule.TitleContainsValidator(v); err != nil {
+			return &ValidationError{Name: "title_contains", err: fmt.Errorf(`ent: validator failed for field "Rule.title_contains": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TitleRegex(); ok {
+		if err := rule.TitleRegexValidator(v); err != nil {
+			return &ValidationError{Name: "title_regex", err: fmt.Errorf(`ent: validator failed for field "Rule.title_regex": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RecordType(); ok {
+		if err := rule.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "Rule.record_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActionRecordType(); ok {
+		if err := rule.ActionRecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "action_record_type", err: fmt.Errorf(`ent: validator failed for field "Rule.action_record_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActionStatus(); ok {
+		if err := rule.ActionStatusValidator(v); err != nil {
+			return &ValidationError{Name: "action_status", err: fmt.Errorf(`ent: validator failed for field "Rule.action_status": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rule.ledger"`)
+	}
+	return nil
+}
+
+func (_u *RuleUpdateOne) sqlSave(ctx context.Context) (_node *Rule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rule.Table, rule.Columns, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rule.FieldID)
+		for _, f := range fields {
+			if !rule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(rule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(rule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(rule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(rule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TitleContains(); ok {
+		_spec.SetField(rule.FieldTitleContains, field.TypeString, value)
+	}
+	if _u.mutation.TitleContainsCleared() {
+		_spec.ClearField(rule.FieldTitleContains, field.TypeString)
+	}
+	if value, ok := _u.mutation.TitleRegex(); ok {
+		_spec.SetField(rule.FieldTitleRegex, field.TypeString, value)
+	}
+	if _u.mutation.TitleRegexCleared() {
+		_spec.ClearField(rule.FieldTitleRegex, field.TypeString)
+	}
+	if value, ok := _u.mutation.AmountMin(); ok {
+		_spec.SetField(rule.FieldAmountMin, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountMin(); ok {
+		_spec.AddField(rule.FieldAmountMin, field.TypeInt64, value)
+	}
+	if _u.mutation.AmountMinCleared() {
+		_spec.ClearField(rule.FieldAmountMin, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AmountMax(); ok {
+		_spec.SetField(rule.FieldAmountMax, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmountMax(); ok {
+		_spec.AddField(rule.FieldAmountMax, field.TypeInt64, value)
+	}
+	if _u.mutation.AmountMaxCleared() {
+		_spec.ClearField(rule.FieldAmountMax, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RecordType(); ok {
+		_spec.SetField(rule.FieldRecordType, field.TypeString, value)
+	}
+	if _u.mutation.RecordTypeCleared() {
+		_spec.ClearField(rule.FieldRecordType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActionRecordType(); ok {
+		_spec.SetField(rule.FieldActionRecordType, field.TypeString, value)
+	}
+	if _u.mutation.ActionRecordTypeCleared() {
+		_spec.ClearField(rule.FieldActionRecordType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActionStatus(); ok {
+		_spec.SetField(rule.FieldActionStatus, field.TypeString, value)
+	}
+	if _u.mutation.ActionStatusCleared() {
+		_spec.ClearField(rule.FieldActionStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.Skip(); ok {
+		_spec.SetField(rule.FieldSkip, field.TypeBool, value)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.LedgerTable,
+			Columns: []string{rule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.LedgerTable,
+			Columns: []string{rule.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.InvoiceTable,
+			Columns: []string{rule.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.InvoiceTable,
+			Columns: []string{rule.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.CategoryTable,
+			Columns: []string{rule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   rule.CategoryTable,
+			Columns: []string{rule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Rule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,11 +3,42 @@
 package ent
 
 import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/attachment"
+	"frog-go/internal/ent/budget"
 	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/envelopeallocation"
+	"frog-go/internal/ent/exchangerate"
+	"frog-go/internal/ent/goal"
+	"frog-go/internal/ent/holding"
+	"frog-go/internal/ent/importjob"
+	"frog-go/internal/ent/investmentincome"
+	"frog-go/internal/ent/investmentquote"
+	"frog-go/internal/ent/investmenttrade"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/invoicepayment"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/ledgerinvitation"
+	"frog-go/internal/ent/ledgermember"
+	"frog-go/internal/ent/loan"
+	"frog-go/internal/ent/loaninstallment"
+	"frog-go/internal/ent/loanprepayment"
+	"frog-go/internal/ent/notification"
+	"frog-go/internal/ent/notificationdelivery"
+	"frog-go/internal/ent/notificationpreference"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/rule"
 	"frog-go/internal/ent/schemas"
+	"frog-go/internal/ent/settlement"
+	"frog-go/internal/ent/tag"
 	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/transactionsplit"
 	"frog-go/internal/ent/user"
+	"frog-go/internal/ent/valuation"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +48,260 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accountMixin := schemas.Account{}.Mixin()
+	accountMixinFields0 := accountMixin[0].Fields()
+	_ = accountMixinFields0
+	accountMixinFields1 := accountMixin[1].Fields()
+	_ = accountMixinFields1
+	accountFields := schemas.Account{}.Fields()
+	_ = accountFields
+	// accountDescCreatedAt is the schema descriptor for created_at field.
+	accountDescCreatedAt := accountMixinFields1[0].Descriptor()
+	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
+	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	// accountDescUpdatedAt is the schema descriptor for updated_at field.
+	accountDescUpdatedAt := accountMixinFields1[1].Descriptor()
+	// account.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	account.DefaultUpdatedAt = accountDescUpdatedAt.Default.(func() time.Time)
+	// account.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	account.UpdateDefaultUpdatedAt = accountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accountDescName is the schema descriptor for name field.
+	accountDescName := accountFields[0].Descriptor()
+	// account.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	account.NameValidator = func() func(string) error {
+		validators := accountDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountDescAccountType is the schema descriptor for account_type field.
+	accountDescAccountType := accountFields[1].Descriptor()
+	// account.DefaultAccountType holds the default value on creation for the account_type field.
+	account.DefaultAccountType = accountDescAccountType.Default.(string)
+	// account.AccountTypeValidator is a validator for the "account_type" field. It is called by the builders before save.
+	account.AccountTypeValidator = func() func(string) error {
+		validators := accountDescAccountType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(account_type string) error {
+			for _, fn := range fns {
+				if err := fn(account_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountDescCurrency is the schema descriptor for currency field.
+	accountDescCurrency := accountFields[2].Descriptor()
+	// account.DefaultCurrency holds the default value on creation for the currency field.
+	account.DefaultCurrency = accountDescCurrency.Default.(string)
+	// account.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	account.CurrencyValidator = func() func(string) error {
+		validators := accountDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountDescID is the schema descriptor for id field.
+	accountDescID := accountMixinFields0[0].Descriptor()
+	// account.DefaultID holds the default value on creation for the id field.
+	account.DefaultID = accountDescID.Default.(func() uuid.UUID)
+	assetMixin := schemas.Asset{}.Mixin()
+	assetMixinFields0 := assetMixin[0].Fields()
+	_ = assetMixinFields0
+	assetMixinFields1 := assetMixin[1].Fields()
+	_ = assetMixinFields1
+	assetFields := schemas.Asset{}.Fields()
+	_ = assetFields
+	// assetDescCreatedAt is the schema descriptor for created_at field.
+	assetDescCreatedAt := assetMixinFields1[0].Descriptor()
+	// asset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	asset.DefaultCreatedAt = assetDescCreatedAt.Default.(func() time.Time)
+	// assetDescUpdatedAt is the schema descriptor for updated_at field.
+	assetDescUpdatedAt := assetMixinFields1[1].Descriptor()
+	// asset.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	asset.DefaultUpdatedAt = assetDescUpdatedAt.Default.(func() time.Time)
+	// asset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	asset.UpdateDefaultUpdatedAt = assetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// assetDescName is the schema descriptor for name field.
+	assetDescName := assetFields[0].Descriptor()
+	// asset.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	asset.NameValidator = func() func(string) error {
+		validators := assetDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// assetDescClass is the schema descriptor for class field.
+	assetDescClass := assetFields[1].Descriptor()
+	// asset.DefaultClass holds the default value on creation for the class field.
+	asset.DefaultClass = assetDescClass.Default.(string)
+	// asset.ClassValidator is a validator for the "class" field. It is called by the builders before save.
+	asset.ClassValidator = func() func(string) error {
+		validators := assetDescClass.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(class string) error {
+			for _, fn := range fns {
+				if err := fn(class); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// assetDescKind is the schema descriptor for kind field.
+	assetDescKind := assetFields[2].Descriptor()
+	// asset.DefaultKind holds the default value on creation for the kind field.
+	asset.DefaultKind = assetDescKind.Default.(string)
+	// asset.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	asset.KindValidator = func() func(string) error {
+		validators := assetDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// assetDescCurrency is the schema descriptor for currency field.
+	assetDescCurrency := assetFields[3].Descriptor()
+	// asset.DefaultCurrency holds the default value on creation for the currency field.
+	asset.DefaultCurrency = assetDescCurrency.Default.(string)
+	// asset.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	asset.CurrencyValidator = func() func(string) error {
+		validators := assetDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// assetDescID is the schema descriptor for id field.
+	assetDescID := assetMixinFields0[0].Descriptor()
+	// asset.DefaultID holds the default value on creation for the id field.
+	asset.DefaultID = assetDescID.Default.(func() uuid.UUID)
+	attachmentMixin := schemas.Attachment{}.Mixin()
+	attachmentMixinFields0 := attachmentMixin[0].Fields()
+	_ = attachmentMixinFields0
+	attachmentMixinFields1 := attachmentMixin[1].Fields()
+	_ = attachmentMixinFields1
+	attachmentFields := schemas.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentMixinFields1[0].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescUpdatedAt is the schema descriptor for updated_at field.
+	attachmentDescUpdatedAt := attachmentMixinFields1[1].Descriptor()
+	// attachment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attachment.DefaultUpdatedAt = attachmentDescUpdatedAt.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attachment.UpdateDefaultUpdatedAt = attachmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[0].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = func() func(string) error {
+		validators := attachmentDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescContentType is the schema descriptor for content_type field.
+	attachmentDescContentType := attachmentFields[1].Descriptor()
+	// attachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	attachment.ContentTypeValidator = attachmentDescContentType.Validators[0].(func(string) error)
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[2].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescBlobKey is the schema descriptor for blob_key field.
+	attachmentDescBlobKey := attachmentFields[3].Descriptor()
+	// attachment.BlobKeyValidator is a validator for the "blob_key" field. It is called by the builders before save.
+	attachment.BlobKeyValidator = attachmentDescBlobKey.Validators[0].(func(string) error)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentMixinFields0[0].Descriptor()
+	// attachment.DefaultID holds the default value on creation for the id field.
+	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	budgetMixin := schemas.Budget{}.Mixin()
+	budgetMixinFields0 := budgetMixin[0].Fields()
+	_ = budgetMixinFields0
+	budgetMixinFields1 := budgetMixin[1].Fields()
+	_ = budgetMixinFields1
+	budgetFields := schemas.Budget{}.Fields()
+	_ = budgetFields
+	// budgetDescCreatedAt is the schema descriptor for created_at field.
+	budgetDescCreatedAt := budgetMixinFields1[0].Descriptor()
+	// budget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budget.DefaultCreatedAt = budgetDescCreatedAt.Default.(func() time.Time)
+	// budgetDescUpdatedAt is the schema descriptor for updated_at field.
+	budgetDescUpdatedAt := budgetMixinFields1[1].Descriptor()
+	// budget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	budget.DefaultUpdatedAt = budgetDescUpdatedAt.Default.(func() time.Time)
+	// budget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	budget.UpdateDefaultUpdatedAt = budgetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// budgetDescAlertedThreshold is the schema descriptor for alerted_threshold field.
+	budgetDescAlertedThreshold := budgetFields[4].Descriptor()
+	// budget.DefaultAlertedThreshold holds the default value on creation for the alerted_threshold field.
+	budget.DefaultAlertedThreshold = budgetDescAlertedThreshold.Default.(int)
+	// budgetDescID is the schema descriptor for id field.
+	budgetDescID := budgetMixinFields0[0].Descriptor()
+	// budget.DefaultID holds the default value on creation for the id field.
+	budget.DefaultID = budgetDescID.Default.(func() uuid.UUID)
 	categoryMixin := schemas.Category{}.Mixin()
 	categoryMixinFields0 := categoryMixin[0].Fields()
 	_ = categoryMixinFields0
@@ -56,10 +341,394 @@ func init() {
 	categoryDescColor := categoryFields[2].Descriptor()
 	// category.ColorValidator is a validator for the "color" field. It is called by the builders before save.
 	category.ColorValidator = categoryDescColor.Validators[0].(func(string) error)
+	// categoryDescTaxGroup is the schema descriptor for tax_group field.
+	categoryDescTaxGroup := categoryFields[5].Descriptor()
+	// category.TaxGroupValidator is a validator for the "tax_group" field. It is called by the builders before save.
+	category.TaxGroupValidator = categoryDescTaxGroup.Validators[0].(func(string) error)
 	// categoryDescID is the schema descriptor for id field.
 	categoryDescID := categoryMixinFields0[0].Descriptor()
 	// category.DefaultID holds the default value on creation for the id field.
 	category.DefaultID = categoryDescID.Default.(func() uuid.UUID)
+	envelopeallocationMixin := schemas.EnvelopeAllocation{}.Mixin()
+	envelopeallocationMixinFields0 := envelopeallocationMixin[0].Fields()
+	_ = envelopeallocationMixinFields0
+	envelopeallocationMixinFields1 := envelopeallocationMixin[1].Fields()
+	_ = envelopeallocationMixinFields1
+	envelopeallocationFields := schemas.EnvelopeAllocation{}.Fields()
+	_ = envelopeallocationFields
+	// envelopeallocationDescCreatedAt is the schema descriptor for created_at field.
+	envelopeallocationDescCreatedAt := envelopeallocationMixinFields1[0].Descriptor()
+	// envelopeallocation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envelopeallocation.DefaultCreatedAt = envelopeallocationDescCreatedAt.Default.(func() time.Time)
+	// envelopeallocationDescUpdatedAt is the schema descriptor for updated_at field.
+	envelopeallocationDescUpdatedAt := envelopeallocationMixinFields1[1].Descriptor()
+	// envelopeallocation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	envelopeallocation.DefaultUpdatedAt = envelopeallocationDescUpdatedAt.Default.(func() time.Time)
+	// envelopeallocation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	envelopeallocation.UpdateDefaultUpdatedAt = envelopeallocationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// envelopeallocationDescKind is the schema descriptor for kind field.
+	envelopeallocationDescKind := envelopeallocationFields[2].Descriptor()
+	// envelopeallocation.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	envelopeallocation.KindValidator = envelopeallocationDescKind.Validators[0].(func(string) error)
+	// envelopeallocationDescNote is the schema descriptor for note field.
+	envelopeallocationDescNote := envelopeallocationFields[4].Descriptor()
+	// envelopeallocation.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	envelopeallocation.NoteValidator = envelopeallocationDescNote.Validators[0].(func(string) error)
+	// envelopeallocationDescID is the schema descriptor for id field.
+	envelopeallocationDescID := envelopeallocationMixinFields0[0].Descriptor()
+	// envelopeallocation.DefaultID holds the default value on creation for the id field.
+	envelopeallocation.DefaultID = envelopeallocationDescID.Default.(func() uuid.UUID)
+	exchangerateMixin := schemas.ExchangeRate{}.Mixin()
+	exchangerateMixinFields0 := exchangerateMixin[0].Fields()
+	_ = exchangerateMixinFields0
+	exchangerateMixinFields1 := exchangerateMixin[1].Fields()
+	_ = exchangerateMixinFields1
+	exchangerateFields := schemas.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescCreatedAt is the schema descriptor for created_at field.
+	exchangerateDescCreatedAt := exchangerateMixinFields1[0].Descriptor()
+	// exchangerate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exchangerate.DefaultCreatedAt = exchangerateDescCreatedAt.Default.(func() time.Time)
+	// exchangerateDescUpdatedAt is the schema descriptor for updated_at field.
+	exchangerateDescUpdatedAt := exchangerateMixinFields1[1].Descriptor()
+	// exchangerate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exchangerate.DefaultUpdatedAt = exchangerateDescUpdatedAt.Default.(func() time.Time)
+	// exchangerate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exchangerate.UpdateDefaultUpdatedAt = exchangerateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exchangerateDescFromCurrency is the schema descriptor for from_currency field.
+	exchangerateDescFromCurrency := exchangerateFields[0].Descriptor()
+	// exchangerate.FromCurrencyValidator is a validator for the "from_currency" field. It is called by the builders before save.
+	exchangerate.FromCurrencyValidator = func() func(string) error {
+		validators := exchangerateDescFromCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(from_currency string) error {
+			for _, fn := range fns {
+				if err := fn(from_currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exchangerateDescToCurrency is the schema descriptor for to_currency field.
+	exchangerateDescToCurrency := exchangerateFields[1].Descriptor()
+	// exchangerate.ToCurrencyValidator is a validator for the "to_currency" field. It is called by the builders before save.
+	exchangerate.ToCurrencyValidator = func() func(string) error {
+		validators := exchangerateDescToCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(to_currency string) error {
+			for _, fn := range fns {
+				if err := fn(to_currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exchangerateDescRate is the schema descriptor for rate field.
+	exchangerateDescRate := exchangerateFields[2].Descriptor()
+	// exchangerate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	exchangerate.RateValidator = exchangerateDescRate.Validators[0].(func(float64) error)
+	// exchangerateDescID is the schema descriptor for id field.
+	exchangerateDescID := exchangerateMixinFields0[0].Descriptor()
+	// exchangerate.DefaultID holds the default value on creation for the id field.
+	exchangerate.DefaultID = exchangerateDescID.Default.(func() uuid.UUID)
+	goalMixin := schemas.Goal{}.Mixin()
+	goalMixinFields0 := goalMixin[0].Fields()
+	_ = goalMixinFields0
+	goalMixinFields1 := goalMixin[1].Fields()
+	_ = goalMixinFields1
+	goalMixinFields3 := goalMixin[3].Fields()
+	_ = goalMixinFields3
+	goalFields := schemas.Goal{}.Fields()
+	_ = goalFields
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalMixinFields1[0].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	// goalDescUpdatedAt is the schema descriptor for updated_at field.
+	goalDescUpdatedAt := goalMixinFields1[1].Descriptor()
+	// goal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
+	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// goalDescInitialAmount is the schema descriptor for initial_amount field.
+	goalDescInitialAmount := goalMixinFields3[0].Descriptor()
+	// goal.DefaultInitialAmount holds the default value on creation for the initial_amount field.
+	goal.DefaultInitialAmount = domain.Money(goalDescInitialAmount.Default.(int64))
+	// goalDescName is the schema descriptor for name field.
+	goalDescName := goalFields[0].Descriptor()
+	// goal.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	goal.NameValidator = func() func(string) error {
+		validators := goalDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// goalDescID is the schema descriptor for id field.
+	goalDescID := goalMixinFields0[0].Descriptor()
+	// goal.DefaultID holds the default value on creation for the id field.
+	goal.DefaultID = goalDescID.Default.(func() uuid.UUID)
+	holdingMixin := schemas.Holding{}.Mixin()
+	holdingMixinFields0 := holdingMixin[0].Fields()
+	_ = holdingMixinFields0
+	holdingMixinFields1 := holdingMixin[1].Fields()
+	_ = holdingMixinFields1
+	holdingFields := schemas.Holding{}.Fields()
+	_ = holdingFields
+	// holdingDescCreatedAt is the schema descriptor for created_at field.
+	holdingDescCreatedAt := holdingMixinFields1[0].Descriptor()
+	// holding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	holding.DefaultCreatedAt = holdingDescCreatedAt.Default.(func() time.Time)
+	// holdingDescUpdatedAt is the schema descriptor for updated_at field.
+	holdingDescUpdatedAt := holdingMixinFields1[1].Descriptor()
+	// holding.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	holding.DefaultUpdatedAt = holdingDescUpdatedAt.Default.(func() time.Time)
+	// holding.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	holding.UpdateDefaultUpdatedAt = holdingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// holdingDescName is the schema descriptor for name field.
+	holdingDescName := holdingFields[0].Descriptor()
+	// holding.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holding.NameValidator = func() func(string) error {
+		validators := holdingDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// holdingDescTicker is the schema descriptor for ticker field.
+	holdingDescTicker := holdingFields[1].Descriptor()
+	// holding.TickerValidator is a validator for the "ticker" field. It is called by the builders before save.
+	holding.TickerValidator = func() func(string) error {
+		validators := holdingDescTicker.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(ticker string) error {
+			for _, fn := range fns {
+				if err := fn(ticker); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// holdingDescAssetClass is the schema descriptor for asset_class field.
+	holdingDescAssetClass := holdingFields[2].Descriptor()
+	// holding.AssetClassValidator is a validator for the "asset_class" field. It is called by the builders before save.
+	holding.AssetClassValidator = func() func(string) error {
+		validators := holdingDescAssetClass.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(asset_class string) error {
+			for _, fn := range fns {
+				if err := fn(asset_class); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// holdingDescCurrency is the schema descriptor for currency field.
+	holdingDescCurrency := holdingFields[3].Descriptor()
+	// holding.DefaultCurrency holds the default value on creation for the currency field.
+	holding.DefaultCurrency = holdingDescCurrency.Default.(string)
+	// holding.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	holding.CurrencyValidator = func() func(string) error {
+		validators := holdingDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// holdingDescID is the schema descriptor for id field.
+	holdingDescID := holdingMixinFields0[0].Descriptor()
+	// holding.DefaultID holds the default value on creation for the id field.
+	holding.DefaultID = holdingDescID.Default.(func() uuid.UUID)
+	importjobMixin := schemas.ImportJob{}.Mixin()
+	importjobMixinFields0 := importjobMixin[0].Fields()
+	_ = importjobMixinFields0
+	importjobMixinFields1 := importjobMixin[1].Fields()
+	_ = importjobMixinFields1
+	importjobFields := schemas.ImportJob{}.Fields()
+	_ = importjobFields
+	// importjobDescCreatedAt is the schema descriptor for created_at field.
+	importjobDescCreatedAt := importjobMixinFields1[0].Descriptor()
+	// importjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importjob.DefaultCreatedAt = importjobDescCreatedAt.Default.(func() time.Time)
+	// importjobDescUpdatedAt is the schema descriptor for updated_at field.
+	importjobDescUpdatedAt := importjobMixinFields1[1].Descriptor()
+	// importjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	importjob.DefaultUpdatedAt = importjobDescUpdatedAt.Default.(func() time.Time)
+	// importjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	importjob.UpdateDefaultUpdatedAt = importjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// importjobDescFilename is the schema descriptor for filename field.
+	importjobDescFilename := importjobFields[0].Descriptor()
+	// importjob.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	importjob.FilenameValidator = importjobDescFilename.Validators[0].(func(string) error)
+	// importjobDescProcessed is the schema descriptor for processed field.
+	importjobDescProcessed := importjobFields[2].Descriptor()
+	// importjob.DefaultProcessed holds the default value on creation for the processed field.
+	importjob.DefaultProcessed = importjobDescProcessed.Default.(int)
+	// importjobDescFailed is the schema descriptor for failed field.
+	importjobDescFailed := importjobFields[3].Descriptor()
+	// importjob.DefaultFailed holds the default value on creation for the failed field.
+	importjob.DefaultFailed = importjobDescFailed.Default.(int)
+	// importjobDescID is the schema descriptor for id field.
+	importjobDescID := importjobMixinFields0[0].Descriptor()
+	// importjob.DefaultID holds the default value on creation for the id field.
+	importjob.DefaultID = importjobDescID.Default.(func() uuid.UUID)
+	investmentincomeMixin := schemas.InvestmentIncome{}.Mixin()
+	investmentincomeMixinFields0 := investmentincomeMixin[0].Fields()
+	_ = investmentincomeMixinFields0
+	investmentincomeMixinFields1 := investmentincomeMixin[1].Fields()
+	_ = investmentincomeMixinFields1
+	investmentincomeFields := schemas.InvestmentIncome{}.Fields()
+	_ = investmentincomeFields
+	// investmentincomeDescCreatedAt is the schema descriptor for created_at field.
+	investmentincomeDescCreatedAt := investmentincomeMixinFields1[0].Descriptor()
+	// investmentincome.DefaultCreatedAt holds the default value on creation for the created_at field.
+	investmentincome.DefaultCreatedAt = investmentincomeDescCreatedAt.Default.(func() time.Time)
+	// investmentincomeDescUpdatedAt is the schema descriptor for updated_at field.
+	investmentincomeDescUpdatedAt := investmentincomeMixinFields1[1].Descriptor()
+	// investmentincome.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	investmentincome.DefaultUpdatedAt = investmentincomeDescUpdatedAt.Default.(func() time.Time)
+	// investmentincome.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	investmentincome.UpdateDefaultUpdatedAt = investmentincomeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// investmentincomeDescKind is the schema descriptor for kind field.
+	investmentincomeDescKind := investmentincomeFields[1].Descriptor()
+	// investmentincome.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	investmentincome.KindValidator = func() func(string) error {
+		validators := investmentincomeDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// investmentincomeDescNote is the schema descriptor for note field.
+	investmentincomeDescNote := investmentincomeFields[3].Descriptor()
+	// investmentincome.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	investmentincome.NoteValidator = investmentincomeDescNote.Validators[0].(func(string) error)
+	// investmentincomeDescID is the schema descriptor for id field.
+	investmentincomeDescID := investmentincomeMixinFields0[0].Descriptor()
+	// investmentincome.DefaultID holds the default value on creation for the id field.
+	investmentincome.DefaultID = investmentincomeDescID.Default.(func() uuid.UUID)
+	investmentquoteMixin := schemas.InvestmentQuote{}.Mixin()
+	investmentquoteMixinFields0 := investmentquoteMixin[0].Fields()
+	_ = investmentquoteMixinFields0
+	investmentquoteMixinFields1 := investmentquoteMixin[1].Fields()
+	_ = investmentquoteMixinFields1
+	investmentquoteFields := schemas.InvestmentQuote{}.Fields()
+	_ = investmentquoteFields
+	// investmentquoteDescCreatedAt is the schema descriptor for created_at field.
+	investmentquoteDescCreatedAt := investmentquoteMixinFields1[0].Descriptor()
+	// investmentquote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	investmentquote.DefaultCreatedAt = investmentquoteDescCreatedAt.Default.(func() time.Time)
+	// investmentquoteDescUpdatedAt is the schema descriptor for updated_at field.
+	investmentquoteDescUpdatedAt := investmentquoteMixinFields1[1].Descriptor()
+	// investmentquote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	investmentquote.DefaultUpdatedAt = investmentquoteDescUpdatedAt.Default.(func() time.Time)
+	// investmentquote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	investmentquote.UpdateDefaultUpdatedAt = investmentquoteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// investmentquoteDescID is the schema descriptor for id field.
+	investmentquoteDescID := investmentquoteMixinFields0[0].Descriptor()
+	// investmentquote.DefaultID holds the default value on creation for the id field.
+	investmentquote.DefaultID = investmentquoteDescID.Default.(func() uuid.UUID)
+	investmenttradeMixin := schemas.InvestmentTrade{}.Mixin()
+	investmenttradeMixinFields0 := investmenttradeMixin[0].Fields()
+	_ = investmenttradeMixinFields0
+	investmenttradeMixinFields1 := investmenttradeMixin[1].Fields()
+	_ = investmenttradeMixinFields1
+	investmenttradeMixinFields3 := investmenttradeMixin[3].Fields()
+	_ = investmenttradeMixinFields3
+	investmenttradeFields := schemas.InvestmentTrade{}.Fields()
+	_ = investmenttradeFields
+	// investmenttradeDescCreatedAt is the schema descriptor for created_at field.
+	investmenttradeDescCreatedAt := investmenttradeMixinFields1[0].Descriptor()
+	// investmenttrade.DefaultCreatedAt holds the default value on creation for the created_at field.
+	investmenttrade.DefaultCreatedAt = investmenttradeDescCreatedAt.Default.(func() time.Time)
+	// investmenttradeDescUpdatedAt is the schema descriptor for updated_at field.
+	investmenttradeDescUpdatedAt := investmenttradeMixinFields1[1].Descriptor()
+	// investmenttrade.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	investmenttrade.DefaultUpdatedAt = investmenttradeDescUpdatedAt.Default.(func() time.Time)
+	// investmenttrade.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	investmenttrade.UpdateDefaultUpdatedAt = investmenttradeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// investmenttradeDescFees is the schema descriptor for fees field.
+	investmenttradeDescFees := investmenttradeMixinFields3[0].Descriptor()
+	// investmenttrade.DefaultFees holds the default value on creation for the fees field.
+	investmenttrade.DefaultFees = domain.Money(investmenttradeDescFees.Default.(int64))
+	// investmenttradeDescKind is the schema descriptor for kind field.
+	investmenttradeDescKind := investmenttradeFields[1].Descriptor()
+	// investmenttrade.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	investmenttrade.KindValidator = func() func(string) error {
+		validators := investmenttradeDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// investmenttradeDescQuantity is the schema descriptor for quantity field.
+	investmenttradeDescQuantity := investmenttradeFields[3].Descriptor()
+	// investmenttrade.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	investmenttrade.QuantityValidator = investmenttradeDescQuantity.Validators[0].(func(float64) error)
+	// investmenttradeDescNote is the schema descriptor for note field.
+	investmenttradeDescNote := investmenttradeFields[4].Descriptor()
+	// investmenttrade.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	investmenttrade.NoteValidator = investmenttradeDescNote.Validators[0].(func(string) error)
+	// investmenttradeDescID is the schema descriptor for id field.
+	investmenttradeDescID := investmenttradeMixinFields0[0].Descriptor()
+	// investmenttrade.DefaultID holds the default value on creation for the id field.
+	investmenttrade.DefaultID = investmenttradeDescID.Default.(func() uuid.UUID)
 	invoiceMixin := schemas.Invoice{}.Mixin()
 	invoiceMixinFields0 := invoiceMixin[0].Fields()
 	_ = invoiceMixinFields0
@@ -67,8 +736,6 @@ func init() {
 	_ = invoiceMixinFields1
 	invoiceMixinFields2 := invoiceMixin[2].Fields()
 	_ = invoiceMixinFields2
-	invoiceMixinFields3 := invoiceMixin[3].Fields()
-	_ = invoiceMixinFields3
 	invoiceFields := schemas.Invoice{}.Fields()
 	_ = invoiceFields
 	// invoiceDescCreatedAt is the schema descriptor for created_at field.
@@ -81,8 +748,30 @@ func init() {
 	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
 	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	invoice.UpdateDefaultUpdatedAt = invoiceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invoiceDescAmount is the schema descriptor for amount field.
+	invoiceDescAmount := invoiceMixinFields2[0].Descriptor()
+	// invoice.DefaultAmount holds the default value on creation for the amount field.
+	invoice.DefaultAmount = domain.Money(invoiceDescAmount.Default.(int64))
+	// invoiceDescTitle is the schema descriptor for title field.
+	invoiceDescTitle := invoiceFields[0].Descriptor()
+	// invoice.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	invoice.TitleValidator = func() func(string) error {
+		validators := invoiceDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// invoiceDescStatus is the schema descriptor for status field.
-	invoiceDescStatus := invoiceMixinFields2[0].Descriptor()
+	invoiceDescStatus := invoiceFields[2].Descriptor()
 	// invoice.DefaultStatus holds the default value on creation for the status field.
 	invoice.DefaultStatus = invoiceDescStatus.Default.(string)
 	// invoice.StatusValidator is a validator for the "status" field. It is called by the builders before save.
@@ -101,15 +790,409 @@ func init() {
 			return nil
 		}
 	}()
-	// invoiceDescAmount is the schema descriptor for amount field.
-	invoiceDescAmount := invoiceMixinFields3[0].Descriptor()
-	// invoice.DefaultAmount holds the default value on creation for the amount field.
-	invoice.DefaultAmount = invoiceDescAmount.Default.(float64)
-	// invoiceDescTitle is the schema descriptor for title field.
-	invoiceDescTitle := invoiceFields[0].Descriptor()
-	// invoice.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	invoice.TitleValidator = func() func(string) error {
-		validators := invoiceDescTitle.Validators
+	// invoiceDescID is the schema descriptor for id field.
+	invoiceDescID := invoiceMixinFields0[0].Descriptor()
+	// invoice.DefaultID holds the default value on creation for the id field.
+	invoice.DefaultID = invoiceDescID.Default.(func() uuid.UUID)
+	invoicepaymentMixin := schemas.InvoicePayment{}.Mixin()
+	invoicepaymentMixinFields0 := invoicepaymentMixin[0].Fields()
+	_ = invoicepaymentMixinFields0
+	invoicepaymentMixinFields1 := invoicepaymentMixin[1].Fields()
+	_ = invoicepaymentMixinFields1
+	invoicepaymentFields := schemas.InvoicePayment{}.Fields()
+	_ = invoicepaymentFields
+	// invoicepaymentDescCreatedAt is the schema descriptor for created_at field.
+	invoicepaymentDescCreatedAt := invoicepaymentMixinFields1[0].Descriptor()
+	// invoicepayment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoicepayment.DefaultCreatedAt = invoicepaymentDescCreatedAt.Default.(func() time.Time)
+	// invoicepaymentDescUpdatedAt is the schema descriptor for updated_at field.
+	invoicepaymentDescUpdatedAt := invoicepaymentMixinFields1[1].Descriptor()
+	// invoicepayment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invoicepayment.DefaultUpdatedAt = invoicepaymentDescUpdatedAt.Default.(func() time.Time)
+	// invoicepayment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invoicepayment.UpdateDefaultUpdatedAt = invoicepaymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invoicepaymentDescKind is the schema descriptor for kind field.
+	invoicepaymentDescKind := invoicepaymentFields[1].Descriptor()
+	// invoicepayment.DefaultKind holds the default value on creation for the kind field.
+	invoicepayment.DefaultKind = invoicepaymentDescKind.Default.(string)
+	// invoicepayment.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	invoicepayment.KindValidator = func() func(string) error {
+		validators := invoicepaymentDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// invoicepaymentDescID is the schema descriptor for id field.
+	invoicepaymentDescID := invoicepaymentMixinFields0[0].Descriptor()
+	// invoicepayment.DefaultID holds the default value on creation for the id field.
+	invoicepayment.DefaultID = invoicepaymentDescID.Default.(func() uuid.UUID)
+	ledgerMixin := schemas.Ledger{}.Mixin()
+	ledgerMixinFields0 := ledgerMixin[0].Fields()
+	_ = ledgerMixinFields0
+	ledgerMixinFields1 := ledgerMixin[1].Fields()
+	_ = ledgerMixinFields1
+	ledgerFields := schemas.Ledger{}.Fields()
+	_ = ledgerFields
+	// ledgerDescCreatedAt is the schema descriptor for created_at field.
+	ledgerDescCreatedAt := ledgerMixinFields1[0].Descriptor()
+	// ledger.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledger.DefaultCreatedAt = ledgerDescCreatedAt.Default.(func() time.Time)
+	// ledgerDescUpdatedAt is the schema descriptor for updated_at field.
+	ledgerDescUpdatedAt := ledgerMixinFields1[1].Descriptor()
+	// ledger.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ledger.DefaultUpdatedAt = ledgerDescUpdatedAt.Default.(func() time.Time)
+	// ledger.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ledger.UpdateDefaultUpdatedAt = ledgerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ledgerDescName is the schema descriptor for name field.
+	ledgerDescName := ledgerFields[0].Descriptor()
+	// ledger.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ledger.NameValidator = func() func(string) error {
+		validators := ledgerDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ledgerDescPersonal is the schema descriptor for personal field.
+	ledgerDescPersonal := ledgerFields[1].Descriptor()
+	// ledger.DefaultPersonal holds the default value on creation for the personal field.
+	ledger.DefaultPersonal = ledgerDescPersonal.Default.(bool)
+	// ledgerDescCategoryModelVersion is the schema descriptor for category_model_version field.
+	ledgerDescCategoryModelVersion := ledgerFields[2].Descriptor()
+	// ledger.DefaultCategoryModelVersion holds the default value on creation for the category_model_version field.
+	ledger.DefaultCategoryModelVersion = ledgerDescCategoryModelVersion.Default.(int64)
+	// ledgerDescID is the schema descriptor for id field.
+	ledgerDescID := ledgerMixinFields0[0].Descriptor()
+	// ledger.DefaultID holds the default value on creation for the id field.
+	ledger.DefaultID = ledgerDescID.Default.(func() uuid.UUID)
+	ledgerinvitationMixin := schemas.LedgerInvitation{}.Mixin()
+	ledgerinvitationMixinFields0 := ledgerinvitationMixin[0].Fields()
+	_ = ledgerinvitationMixinFields0
+	ledgerinvitationMixinFields1 := ledgerinvitationMixin[1].Fields()
+	_ = ledgerinvitationMixinFields1
+	ledgerinvitationFields := schemas.LedgerInvitation{}.Fields()
+	_ = ledgerinvitationFields
+	// ledgerinvitationDescCreatedAt is the schema descriptor for created_at field.
+	ledgerinvitationDescCreatedAt := ledgerinvitationMixinFields1[0].Descriptor()
+	// ledgerinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgerinvitation.DefaultCreatedAt = ledgerinvitationDescCreatedAt.Default.(func() time.Time)
+	// ledgerinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	ledgerinvitationDescUpdatedAt := ledgerinvitationMixinFields1[1].Descriptor()
+	// ledgerinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ledgerinvitation.DefaultUpdatedAt = ledgerinvitationDescUpdatedAt.Default.(func() time.Time)
+	// ledgerinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ledgerinvitation.UpdateDefaultUpdatedAt = ledgerinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ledgerinvitationDescRole is the schema descriptor for role field.
+	ledgerinvitationDescRole := ledgerinvitationFields[3].Descriptor()
+	// ledgerinvitation.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	ledgerinvitation.RoleValidator = func() func(string) error {
+		validators := ledgerinvitationDescRole.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(role string) error {
+			for _, fn := range fns {
+				if err := fn(role); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ledgerinvitationDescStatus is the schema descriptor for status field.
+	ledgerinvitationDescStatus := ledgerinvitationFields[4].Descriptor()
+	// ledgerinvitation.DefaultStatus holds the default value on creation for the status field.
+	ledgerinvitation.DefaultStatus = ledgerinvitationDescStatus.Default.(string)
+	// ledgerinvitation.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	ledgerinvitation.StatusValidator = func() func(string) error {
+		validators := ledgerinvitationDescStatus.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(status string) error {
+			for _, fn := range fns {
+				if err := fn(status); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ledgerinvitationDescID is the schema descriptor for id field.
+	ledgerinvitationDescID := ledgerinvitationMixinFields0[0].Descriptor()
+	// ledgerinvitation.DefaultID holds the default value on creation for the id field.
+	ledgerinvitation.DefaultID = ledgerinvitationDescID.Default.(func() uuid.UUID)
+	ledgermemberMixin := schemas.LedgerMember{}.Mixin()
+	ledgermemberMixinFields0 := ledgermemberMixin[0].Fields()
+	_ = ledgermemberMixinFields0
+	ledgermemberMixinFields1 := ledgermemberMixin[1].Fields()
+	_ = ledgermemberMixinFields1
+	ledgermemberFields := schemas.LedgerMember{}.Fields()
+	_ = ledgermemberFields
+	// ledgermemberDescCreatedAt is the schema descriptor for created_at field.
+	ledgermemberDescCreatedAt := ledgermemberMixinFields1[0].Descriptor()
+	// ledgermember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgermember.DefaultCreatedAt = ledgermemberDescCreatedAt.Default.(func() time.Time)
+	// ledgermemberDescUpdatedAt is the schema descriptor for updated_at field.
+	ledgermemberDescUpdatedAt := ledgermemberMixinFields1[1].Descriptor()
+	// ledgermember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ledgermember.DefaultUpdatedAt = ledgermemberDescUpdatedAt.Default.(func() time.Time)
+	// ledgermember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ledgermember.UpdateDefaultUpdatedAt = ledgermemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ledgermemberDescRole is the schema descriptor for role field.
+	ledgermemberDescRole := ledgermemberFields[2].Descriptor()
+	// ledgermember.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	ledgermember.RoleValidator = func() func(string) error {
+		validators := ledgermemberDescRole.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(role string) error {
+			for _, fn := range fns {
+				if err := fn(role); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ledgermemberDescID is the schema descriptor for id field.
+	ledgermemberDescID := ledgermemberMixinFields0[0].Descriptor()
+	// ledgermember.DefaultID holds the default value on creation for the id field.
+	ledgermember.DefaultID = ledgermemberDescID.Default.(func() uuid.UUID)
+	loanMixin := schemas.Loan{}.Mixin()
+	loanMixinFields0 := loanMixin[0].Fields()
+	_ = loanMixinFields0
+	loanMixinFields1 := loanMixin[1].Fields()
+	_ = loanMixinFields1
+	loanFields := schemas.Loan{}.Fields()
+	_ = loanFields
+	// loanDescCreatedAt is the schema descriptor for created_at field.
+	loanDescCreatedAt := loanMixinFields1[0].Descriptor()
+	// loan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loan.DefaultCreatedAt = loanDescCreatedAt.Default.(func() time.Time)
+	// loanDescUpdatedAt is the schema descriptor for updated_at field.
+	loanDescUpdatedAt := loanMixinFields1[1].Descriptor()
+	// loan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loan.DefaultUpdatedAt = loanDescUpdatedAt.Default.(func() time.Time)
+	// loan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loan.UpdateDefaultUpdatedAt = loanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loanDescName is the schema descriptor for name field.
+	loanDescName := loanFields[0].Descriptor()
+	// loan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	loan.NameValidator = func() func(string) error {
+		validators := loanDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// loanDescInterestRate is the schema descriptor for interest_rate field.
+	loanDescInterestRate := loanFields[1].Descriptor()
+	// loan.InterestRateValidator is a validator for the "interest_rate" field. It is called by the builders before save.
+	loan.InterestRateValidator = loanDescInterestRate.Validators[0].(func(float64) error)
+	// loanDescRatePeriod is the schema descriptor for rate_period field.
+	loanDescRatePeriod := loanFields[2].Descriptor()
+	// loan.DefaultRatePeriod holds the default value on creation for the rate_period field.
+	loan.DefaultRatePeriod = loanDescRatePeriod.Default.(string)
+	// loan.RatePeriodValidator is a validator for the "rate_period" field. It is called by the builders before save.
+	loan.RatePeriodValidator = func() func(string) error {
+		validators := loanDescRatePeriod.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(rate_period string) error {
+			for _, fn := range fns {
+				if err := fn(rate_period); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// loanDescTermMonths is the schema descriptor for term_months field.
+	loanDescTermMonths := loanFields[3].Descriptor()
+	// loan.TermMonthsValidator is a validator for the "term_months" field. It is called by the builders before save.
+	loan.TermMonthsValidator = loanDescTermMonths.Validators[0].(func(int) error)
+	// loanDescSystem is the schema descriptor for system field.
+	loanDescSystem := loanFields[4].Descriptor()
+	// loan.SystemValidator is a validator for the "system" field. It is called by the builders before save.
+	loan.SystemValidator = func() func(string) error {
+		validators := loanDescSystem.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(system string) error {
+			for _, fn := range fns {
+				if err := fn(system); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// loanDescCurrency is the schema descriptor for currency field.
+	loanDescCurrency := loanFields[6].Descriptor()
+	// loan.DefaultCurrency holds the default value on creation for the currency field.
+	loan.DefaultCurrency = loanDescCurrency.Default.(string)
+	// loan.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	loan.CurrencyValidator = func() func(string) error {
+		validators := loanDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// loanDescID is the schema descriptor for id field.
+	loanDescID := loanMixinFields0[0].Descriptor()
+	// loan.DefaultID holds the default value on creation for the id field.
+	loan.DefaultID = loanDescID.Default.(func() uuid.UUID)
+	loaninstallmentMixin := schemas.LoanInstallment{}.Mixin()
+	loaninstallmentMixinFields0 := loaninstallmentMixin[0].Fields()
+	_ = loaninstallmentMixinFields0
+	loaninstallmentMixinFields1 := loaninstallmentMixin[1].Fields()
+	_ = loaninstallmentMixinFields1
+	loaninstallmentFields := schemas.LoanInstallment{}.Fields()
+	_ = loaninstallmentFields
+	// loaninstallmentDescCreatedAt is the schema descriptor for created_at field.
+	loaninstallmentDescCreatedAt := loaninstallmentMixinFields1[0].Descriptor()
+	// loaninstallment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loaninstallment.DefaultCreatedAt = loaninstallmentDescCreatedAt.Default.(func() time.Time)
+	// loaninstallmentDescUpdatedAt is the schema descriptor for updated_at field.
+	loaninstallmentDescUpdatedAt := loaninstallmentMixinFields1[1].Descriptor()
+	// loaninstallment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loaninstallment.DefaultUpdatedAt = loaninstallmentDescUpdatedAt.Default.(func() time.Time)
+	// loaninstallment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loaninstallment.UpdateDefaultUpdatedAt = loaninstallmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loaninstallmentDescNumber is the schema descriptor for number field.
+	loaninstallmentDescNumber := loaninstallmentFields[1].Descriptor()
+	// loaninstallment.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	loaninstallment.NumberValidator = loaninstallmentDescNumber.Validators[0].(func(int) error)
+	// loaninstallmentDescID is the schema descriptor for id field.
+	loaninstallmentDescID := loaninstallmentMixinFields0[0].Descriptor()
+	// loaninstallment.DefaultID holds the default value on creation for the id field.
+	loaninstallment.DefaultID = loaninstallmentDescID.Default.(func() uuid.UUID)
+	loanprepaymentMixin := schemas.LoanPrepayment{}.Mixin()
+	loanprepaymentMixinFields0 := loanprepaymentMixin[0].Fields()
+	_ = loanprepaymentMixinFields0
+	loanprepaymentMixinFields1 := loanprepaymentMixin[1].Fields()
+	_ = loanprepaymentMixinFields1
+	loanprepaymentFields := schemas.LoanPrepayment{}.Fields()
+	_ = loanprepaymentFields
+	// loanprepaymentDescCreatedAt is the schema descriptor for created_at field.
+	loanprepaymentDescCreatedAt := loanprepaymentMixinFields1[0].Descriptor()
+	// loanprepayment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loanprepayment.DefaultCreatedAt = loanprepaymentDescCreatedAt.Default.(func() time.Time)
+	// loanprepaymentDescUpdatedAt is the schema descriptor for updated_at field.
+	loanprepaymentDescUpdatedAt := loanprepaymentMixinFields1[1].Descriptor()
+	// loanprepayment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loanprepayment.DefaultUpdatedAt = loanprepaymentDescUpdatedAt.Default.(func() time.Time)
+	// loanprepayment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loanprepayment.UpdateDefaultUpdatedAt = loanprepaymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loanprepaymentDescMode is the schema descriptor for mode field.
+	loanprepaymentDescMode := loanprepaymentFields[2].Descriptor()
+	// loanprepayment.ModeValidator is a validator for the "mode" field. It is called by the builders before save.
+	loanprepayment.ModeValidator = func() func(string) error {
+		validators := loanprepaymentDescMode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(mode string) error {
+			for _, fn := range fns {
+				if err := fn(mode); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// loanprepaymentDescID is the schema descriptor for id field.
+	loanprepaymentDescID := loanprepaymentMixinFields0[0].Descriptor()
+	// loanprepayment.DefaultID holds the default value on creation for the id field.
+	loanprepayment.DefaultID = loanprepaymentDescID.Default.(func() uuid.UUID)
+	notificationMixin := schemas.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationMixinFields1 := notificationMixin[1].Fields()
+	_ = notificationMixinFields1
+	notificationFields := schemas.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields1[0].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationMixinFields1[1].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationDescType is the schema descriptor for type field.
+	notificationDescType := notificationFields[0].Descriptor()
+	// notification.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	notification.TypeValidator = notificationDescType.Validators[0].(func(string) error)
+	// notificationDescKey is the schema descriptor for key field.
+	notificationDescKey := notificationFields[1].Descriptor()
+	// notification.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	notification.KeyValidator = func() func(string) error {
+		validators := notificationDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescTitle is the schema descriptor for title field.
+	notificationDescTitle := notificationFields[2].Descriptor()
+	// notification.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notification.TitleValidator = func() func(string) error {
+		validators := notificationDescTitle.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -123,10 +1206,453 @@ func init() {
 			return nil
 		}
 	}()
-	// invoiceDescID is the schema descriptor for id field.
-	invoiceDescID := invoiceMixinFields0[0].Descriptor()
-	// invoice.DefaultID holds the default value on creation for the id field.
-	invoice.DefaultID = invoiceDescID.Default.(func() uuid.UUID)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationdeliveryMixin := schemas.NotificationDelivery{}.Mixin()
+	notificationdeliveryMixinFields0 := notificationdeliveryMixin[0].Fields()
+	_ = notificationdeliveryMixinFields0
+	notificationdeliveryMixinFields1 := notificationdeliveryMixin[1].Fields()
+	_ = notificationdeliveryMixinFields1
+	notificationdeliveryFields := schemas.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notificationdeliveryDescCreatedAt := notificationdeliveryMixinFields1[0].Descriptor()
+	// notificationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdelivery.DefaultCreatedAt = notificationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notificationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdeliveryDescUpdatedAt := notificationdeliveryMixinFields1[1].Descriptor()
+	// notificationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdelivery.DefaultUpdatedAt = notificationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notificationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdelivery.UpdateDefaultUpdatedAt = notificationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationdeliveryDescKind is the schema descriptor for kind field.
+	notificationdeliveryDescKind := notificationdeliveryFields[0].Descriptor()
+	// notificationdelivery.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notificationdelivery.KindValidator = notificationdeliveryDescKind.Validators[0].(func(string) error)
+	// notificationdeliveryDescKey is the schema descriptor for key field.
+	notificationdeliveryDescKey := notificationdeliveryFields[1].Descriptor()
+	// notificationdelivery.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	notificationdelivery.KeyValidator = func() func(string) error {
+		validators := notificationdeliveryDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationdeliveryDescChannel is the schema descriptor for channel field.
+	notificationdeliveryDescChannel := notificationdeliveryFields[2].Descriptor()
+	// notificationdelivery.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	notificationdelivery.ChannelValidator = notificationdeliveryDescChannel.Validators[0].(func(string) error)
+	// notificationdeliveryDescTitle is the schema descriptor for title field.
+	notificationdeliveryDescTitle := notificationdeliveryFields[4].Descriptor()
+	// notificationdelivery.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notificationdelivery.TitleValidator = func() func(string) error {
+		validators := notificationdeliveryDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationdeliveryDescStatus is the schema descriptor for status field.
+	notificationdeliveryDescStatus := notificationdeliveryFields[7].Descriptor()
+	// notificationdelivery.DefaultStatus holds the default value on creation for the status field.
+	notificationdelivery.DefaultStatus = notificationdeliveryDescStatus.Default.(string)
+	// notificationdelivery.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	notificationdelivery.StatusValidator = notificationdeliveryDescStatus.Validators[0].(func(string) error)
+	// notificationdeliveryDescAttempts is the schema descriptor for attempts field.
+	notificationdeliveryDescAttempts := notificationdeliveryFields[8].Descriptor()
+	// notificationdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	notificationdelivery.DefaultAttempts = notificationdeliveryDescAttempts.Default.(int)
+	// notificationdeliveryDescID is the schema descriptor for id field.
+	notificationdeliveryDescID := notificationdeliveryMixinFields0[0].Descriptor()
+	// notificationdelivery.DefaultID holds the default value on creation for the id field.
+	notificationdelivery.DefaultID = notificationdeliveryDescID.Default.(func() uuid.UUID)
+	notificationpreferenceMixin := schemas.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceMixinFields1 := notificationpreferenceMixin[1].Fields()
+	_ = notificationpreferenceMixinFields1
+	notificationpreferenceFields := schemas.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	notificationpreferenceDescCreatedAt := notificationpreferenceMixinFields1[0].Descriptor()
+	// notificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationpreference.DefaultCreatedAt = notificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// notificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationpreferenceDescUpdatedAt := notificationpreferenceMixinFields1[1].Descriptor()
+	// notificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescLeadDays is the schema descriptor for lead_days field.
+	notificationpreferenceDescLeadDays := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultLeadDays holds the default value on creation for the lead_days field.
+	notificationpreference.DefaultLeadDays = notificationpreferenceDescLeadDays.Default.(int)
+	// notificationpreferenceDescQuietStart is the schema descriptor for quiet_start field.
+	notificationpreferenceDescQuietStart := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.QuietStartValidator is a validator for the "quiet_start" field. It is called by the builders before save.
+	notificationpreference.QuietStartValidator = notificationpreferenceDescQuietStart.Validators[0].(func(string) error)
+	// notificationpreferenceDescQuietEnd is the schema descriptor for quiet_end field.
+	notificationpreferenceDescQuietEnd := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.QuietEndValidator is a validator for the "quiet_end" field. It is called by the builders before save.
+	notificationpreference.QuietEndValidator = notificationpreferenceDescQuietEnd.Validators[0].(func(string) error)
+	// notificationpreferenceDescTimezone is the schema descriptor for timezone field.
+	notificationpreferenceDescTimezone := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultTimezone holds the default value on creation for the timezone field.
+	notificationpreference.DefaultTimezone = notificationpreferenceDescTimezone.Default.(string)
+	// notificationpreference.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	notificationpreference.TimezoneValidator = func() func(string) error {
+		validators := notificationpreferenceDescTimezone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(timezone string) error {
+			for _, fn := range fns {
+				if err := fn(timezone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationpreferenceDescEmailEnabled is the schema descriptor for email_enabled field.
+	notificationpreferenceDescEmailEnabled := notificationpreferenceFields[4].Descriptor()
+	// notificationpreference.DefaultEmailEnabled holds the default value on creation for the email_enabled field.
+	notificationpreference.DefaultEmailEnabled = notificationpreferenceDescEmailEnabled.Default.(bool)
+	// notificationpreferenceDescWebhookURL is the schema descriptor for webhook_url field.
+	notificationpreferenceDescWebhookURL := notificationpreferenceFields[5].Descriptor()
+	// notificationpreference.WebhookURLValidator is a validator for the "webhook_url" field. It is called by the builders before save.
+	notificationpreference.WebhookURLValidator = notificationpreferenceDescWebhookURL.Validators[0].(func(string) error)
+	// notificationpreferenceDescWebhookSecret is the schema descriptor for webhook_secret field.
+	notificationpreferenceDescWebhookSecret := notificationpreferenceFields[6].Descriptor()
+	// notificationpreference.WebhookSecretValidator is a validator for the "webhook_secret" field. It is called by the builders before save.
+	notificationpreference.WebhookSecretValidator = notificationpreferenceDescWebhookSecret.Validators[0].(func(string) error)
+	// notificationpreferenceDescInappEnabled is the schema descriptor for inapp_enabled field.
+	notificationpreferenceDescInappEnabled := notificationpreferenceFields[7].Descriptor()
+	// notificationpreference.DefaultInappEnabled holds the default value on creation for the inapp_enabled field.
+	notificationpreference.DefaultInappEnabled = notificationpreferenceDescInappEnabled.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() uuid.UUID)
+	payeeMixin := schemas.Payee{}.Mixin()
+	payeeMixinFields0 := payeeMixin[0].Fields()
+	_ = payeeMixinFields0
+	payeeMixinFields1 := payeeMixin[1].Fields()
+	_ = payeeMixinFields1
+	payeeFields := schemas.Payee{}.Fields()
+	_ = payeeFields
+	// payeeDescCreatedAt is the schema descriptor for created_at field.
+	payeeDescCreatedAt := payeeMixinFields1[0].Descriptor()
+	// payee.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payee.DefaultCreatedAt = payeeDescCreatedAt.Default.(func() time.Time)
+	// payeeDescUpdatedAt is the schema descriptor for updated_at field.
+	payeeDescUpdatedAt := payeeMixinFields1[1].Descriptor()
+	// payee.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payee.DefaultUpdatedAt = payeeDescUpdatedAt.Default.(func() time.Time)
+	// payee.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payee.UpdateDefaultUpdatedAt = payeeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// payeeDescName is the schema descriptor for name field.
+	payeeDescName := payeeFields[0].Descriptor()
+	// payee.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	payee.NameValidator = func() func(string) error {
+		validators := payeeDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// payeeDescNormalizedName is the schema descriptor for normalized_name field.
+	payeeDescNormalizedName := payeeFields[1].Descriptor()
+	// payee.NormalizedNameValidator is a validator for the "normalized_name" field. It is called by the builders before save.
+	payee.NormalizedNameValidator = func() func(string) error {
+		validators := payeeDescNormalizedName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(normalized_name string) error {
+			for _, fn := range fns {
+				if err := fn(normalized_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// payeeDescAliases is the schema descriptor for aliases field.
+	payeeDescAliases := payeeFields[2].Descriptor()
+	// payee.DefaultAliases holds the default value on creation for the aliases field.
+	payee.DefaultAliases = payeeDescAliases.Default.([]string)
+	// payeeDescID is the schema descriptor for id field.
+	payeeDescID := payeeMixinFields0[0].Descriptor()
+	// payee.DefaultID holds the default value on creation for the id field.
+	payee.DefaultID = payeeDescID.Default.(func() uuid.UUID)
+	recurringruleMixin := schemas.RecurringRule{}.Mixin()
+	recurringruleMixinFields0 := recurringruleMixin[0].Fields()
+	_ = recurringruleMixinFields0
+	recurringruleMixinFields1 := recurringruleMixin[1].Fields()
+	_ = recurringruleMixinFields1
+	recurringruleMixinFields2 := recurringruleMixin[2].Fields()
+	_ = recurringruleMixinFields2
+	recurringruleFields := schemas.RecurringRule{}.Fields()
+	_ = recurringruleFields
+	// recurringruleDescCreatedAt is the schema descriptor for created_at field.
+	recurringruleDescCreatedAt := recurringruleMixinFields1[0].Descriptor()
+	// recurringrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurringrule.DefaultCreatedAt = recurringruleDescCreatedAt.Default.(func() time.Time)
+	// recurringruleDescUpdatedAt is the schema descriptor for updated_at field.
+	recurringruleDescUpdatedAt := recurringruleMixinFields1[1].Descriptor()
+	// recurringrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recurringrule.DefaultUpdatedAt = recurringruleDescUpdatedAt.Default.(func() time.Time)
+	// recurringrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recurringrule.UpdateDefaultUpdatedAt = recurringruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// recurringruleDescRecordType is the schema descriptor for record_type field.
+	recurringruleDescRecordType := recurringruleMixinFields2[0].Descriptor()
+	// recurringrule.DefaultRecordType holds the default value on creation for the record_type field.
+	recurringrule.DefaultRecordType = recurringruleDescRecordType.Default.(string)
+	// recurringrule.RecordTypeValidator is a validator for the "record_type" field. It is called by the builders before save.
+	recurringrule.RecordTypeValidator = func() func(string) error {
+		validators := recurringruleDescRecordType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(record_type string) error {
+			for _, fn := range fns {
+				if err := fn(record_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringruleDescTitle is the schema descriptor for title field.
+	recurringruleDescTitle := recurringruleFields[0].Descriptor()
+	// recurringrule.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	recurringrule.TitleValidator = func() func(string) error {
+		validators := recurringruleDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringruleDescCurrency is the schema descriptor for currency field.
+	recurringruleDescCurrency := recurringruleFields[1].Descriptor()
+	// recurringrule.DefaultCurrency holds the default value on creation for the currency field.
+	recurringrule.DefaultCurrency = recurringruleDescCurrency.Default.(string)
+	// recurringrule.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	recurringrule.CurrencyValidator = func() func(string) error {
+		validators := recurringruleDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringruleDescFrequency is the schema descriptor for frequency field.
+	recurringruleDescFrequency := recurringruleFields[2].Descriptor()
+	// recurringrule.FrequencyValidator is a validator for the "frequency" field. It is called by the builders before save.
+	recurringrule.FrequencyValidator = func() func(string) error {
+		validators := recurringruleDescFrequency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(frequency string) error {
+			for _, fn := range fns {
+				if err := fn(frequency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringruleDescID is the schema descriptor for id field.
+	recurringruleDescID := recurringruleMixinFields0[0].Descriptor()
+	// recurringrule.DefaultID holds the default value on creation for the id field.
+	recurringrule.DefaultID = recurringruleDescID.Default.(func() uuid.UUID)
+	ruleMixin := schemas.Rule{}.Mixin()
+	ruleMixinFields0 := ruleMixin[0].Fields()
+	_ = ruleMixinFields0
+	ruleMixinFields1 := ruleMixin[1].Fields()
+	_ = ruleMixinFields1
+	ruleFields := schemas.Rule{}.Fields()
+	_ = ruleFields
+	// ruleDescCreatedAt is the schema descriptor for created_at field.
+	ruleDescCreatedAt := ruleMixinFields1[0].Descriptor()
+	// rule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rule.DefaultCreatedAt = ruleDescCreatedAt.Default.(func() time.Time)
+	// ruleDescUpdatedAt is the schema descriptor for updated_at field.
+	ruleDescUpdatedAt := ruleMixinFields1[1].Descriptor()
+	// rule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rule.DefaultUpdatedAt = ruleDescUpdatedAt.Default.(func() time.Time)
+	// rule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rule.UpdateDefaultUpdatedAt = ruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ruleDescName is the schema descriptor for name field.
+	ruleDescName := ruleFields[0].Descriptor()
+	// rule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	rule.NameValidator = func() func(string) error {
+		validators := ruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ruleDescPriority is the schema descriptor for priority field.
+	ruleDescPriority := ruleFields[1].Descriptor()
+	// rule.DefaultPriority holds the default value on creation for the priority field.
+	rule.DefaultPriority = ruleDescPriority.Default.(int)
+	// ruleDescEnabled is the schema descriptor for enabled field.
+	ruleDescEnabled := ruleFields[2].Descriptor()
+	// rule.DefaultEnabled holds the default value on creation for the enabled field.
+	rule.DefaultEnabled = ruleDescEnabled.Default.(bool)
+	// ruleDescTitleContains is the schema descriptor for title_contains field.
+	ruleDescTitleContains := ruleFields[3].Descriptor()
+	// rule.TitleContainsValidator is a validator for the "title_contains" field. It is called by the builders before save.
+	rule.TitleContainsValidator = ruleDescTitleContains.Validators[0].(func(string) error)
+	// ruleDescTitleRegex is the schema descriptor for title_regex field.
+	ruleDescTitleRegex := ruleFields[4].Descriptor()
+	// rule.TitleRegexValidator is a validator for the "title_regex" field. It is called by the builders before save.
+	rule.TitleRegexValidator = ruleDescTitleRegex.Validators[0].(func(string) error)
+	// ruleDescRecordType is the schema descriptor for record_type field.
+	ruleDescRecordType := ruleFields[7].Descriptor()
+	// rule.RecordTypeValidator is a validator for the "record_type" field. It is called by the builders before save.
+	rule.RecordTypeValidator = ruleDescRecordType.Validators[0].(func(string) error)
+	// ruleDescActionRecordType is the schema descriptor for action_record_type field.
+	ruleDescActionRecordType := ruleFields[10].Descriptor()
+	// rule.ActionRecordTypeValidator is a validator for the "action_record_type" field. It is called by the builders before save.
+	rule.ActionRecordTypeValidator = ruleDescActionRecordType.Validators[0].(func(string) error)
+	// ruleDescActionStatus is the schema descriptor for action_status field.
+	ruleDescActionStatus := ruleFields[11].Descriptor()
+	// rule.ActionStatusValidator is a validator for the "action_status" field. It is called by the builders before save.
+	rule.ActionStatusValidator = ruleDescActionStatus.Validators[0].(func(string) error)
+	// ruleDescSkip is the schema descriptor for skip field.
+	ruleDescSkip := ruleFields[12].Descriptor()
+	// rule.DefaultSkip holds the default value on creation for the skip field.
+	rule.DefaultSkip = ruleDescSkip.Default.(bool)
+	// ruleDescID is the schema descriptor for id field.
+	ruleDescID := ruleMixinFields0[0].Descriptor()
+	// rule.DefaultID holds the default value on creation for the id field.
+	rule.DefaultID = ruleDescID.Default.(func() uuid.UUID)
+	settlementMixin := schemas.Settlement{}.Mixin()
+	settlementMixinFields0 := settlementMixin[0].Fields()
+	_ = settlementMixinFields0
+	settlementMixinFields1 := settlementMixin[1].Fields()
+	_ = settlementMixinFields1
+	settlementFields := schemas.Settlement{}.Fields()
+	_ = settlementFields
+	// settlementDescCreatedAt is the schema descriptor for created_at field.
+	settlementDescCreatedAt := settlementMixinFields1[0].Descriptor()
+	// settlement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlement.DefaultCreatedAt = settlementDescCreatedAt.Default.(func() time.Time)
+	// settlementDescUpdatedAt is the schema descriptor for updated_at field.
+	settlementDescUpdatedAt := settlementMixinFields1[1].Descriptor()
+	// settlement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	settlement.DefaultUpdatedAt = settlementDescUpdatedAt.Default.(func() time.Time)
+	// settlement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	settlement.UpdateDefaultUpdatedAt = settlementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// settlementDescNote is the schema descriptor for note field.
+	settlementDescNote := settlementFields[3].Descriptor()
+	// settlement.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	settlement.NoteValidator = settlementDescNote.Validators[0].(func(string) error)
+	// settlementDescID is the schema descriptor for id field.
+	settlementDescID := settlementMixinFields0[0].Descriptor()
+	// settlement.DefaultID holds the default value on creation for the id field.
+	settlement.DefaultID = settlementDescID.Default.(func() uuid.UUID)
+	tagMixin := schemas.Tag{}.Mixin()
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagMixinFields1 := tagMixin[1].Fields()
+	_ = tagMixinFields1
+	tagFields := schemas.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagMixinFields1[0].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagMixinFields1[1].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[1].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagMixinFields0[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
 	transactionMixin := schemas.Transaction{}.Mixin()
 	transactionMixinFields0 := transactionMixin[0].Fields()
 	_ = transactionMixinFields0
@@ -206,10 +1732,98 @@ func init() {
 			return nil
 		}
 	}()
+	// transactionDescCurrency is the schema descriptor for currency field.
+	transactionDescCurrency := transactionFields[2].Descriptor()
+	// transaction.DefaultCurrency holds the default value on creation for the currency field.
+	transaction.DefaultCurrency = transactionDescCurrency.Default.(string)
+	// transaction.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	transaction.CurrencyValidator = func() func(string) error {
+		validators := transactionDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// transactionDescExchangeRate is the schema descriptor for exchange_rate field.
+	transactionDescExchangeRate := transactionFields[3].Descriptor()
+	// transaction.DefaultExchangeRate holds the default value on creation for the exchange_rate field.
+	transaction.DefaultExchangeRate = transactionDescExchangeRate.Default.(float64)
+	// transaction.ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	transaction.ExchangeRateValidator = transactionDescExchangeRate.Validators[0].(func(float64) error)
+	// transactionDescCategorySource is the schema descriptor for category_source field.
+	transactionDescCategorySource := transactionFields[4].Descriptor()
+	// transaction.CategorySourceValidator is a validator for the "category_source" field. It is called by the builders before save.
+	transaction.CategorySourceValidator = transactionDescCategorySource.Validators[0].(func(string) error)
+	// transactionDescShareMode is the schema descriptor for share_mode field.
+	transactionDescShareMode := transactionFields[6].Descriptor()
+	// transaction.ShareModeValidator is a validator for the "share_mode" field. It is called by the builders before save.
+	transaction.ShareModeValidator = transactionDescShareMode.Validators[0].(func(string) error)
+	// transactionDescInstallmentNumber is the schema descriptor for installment_number field.
+	transactionDescInstallmentNumber := transactionFields[8].Descriptor()
+	// transaction.InstallmentNumberValidator is a validator for the "installment_number" field. It is called by the builders before save.
+	transaction.InstallmentNumberValidator = transactionDescInstallmentNumber.Validators[0].(func(int) error)
+	// transactionDescInstallmentCount is the schema descriptor for installment_count field.
+	transactionDescInstallmentCount := transactionFields[9].Descriptor()
+	// transaction.InstallmentCountValidator is a validator for the "installment_count" field. It is called by the builders before save.
+	transaction.InstallmentCountValidator = transactionDescInstallmentCount.Validators[0].(func(int) error)
 	// transactionDescID is the schema descriptor for id field.
 	transactionDescID := transactionMixinFields0[0].Descriptor()
 	// transaction.DefaultID holds the default value on creation for the id field.
 	transaction.DefaultID = transactionDescID.Default.(func() uuid.UUID)
+	transactionparticipantMixin := schemas.TransactionParticipant{}.Mixin()
+	transactionparticipantMixinFields0 := transactionparticipantMixin[0].Fields()
+	_ = transactionparticipantMixinFields0
+	transactionparticipantMixinFields1 := transactionparticipantMixin[1].Fields()
+	_ = transactionparticipantMixinFields1
+	transactionparticipantFields := schemas.TransactionParticipant{}.Fields()
+	_ = transactionparticipantFields
+	// transactionparticipantDescCreatedAt is the schema descriptor for created_at field.
+	transactionparticipantDescCreatedAt := transactionparticipantMixinFields1[0].Descriptor()
+	// transactionparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transactionparticipant.DefaultCreatedAt = transactionparticipantDescCreatedAt.Default.(func() time.Time)
+	// transactionparticipantDescUpdatedAt is the schema descriptor for updated_at field.
+	transactionparticipantDescUpdatedAt := transactionparticipantMixinFields1[1].Descriptor()
+	// transactionparticipant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transactionparticipant.DefaultUpdatedAt = transactionparticipantDescUpdatedAt.Default.(func() time.Time)
+	// transactionparticipant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transactionparticipant.UpdateDefaultUpdatedAt = transactionparticipantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// transactionparticipantDescID is the schema descriptor for id field.
+	transactionparticipantDescID := transactionparticipantMixinFields0[0].Descriptor()
+	// transactionparticipant.DefaultID holds the default value on creation for the id field.
+	transactionparticipant.DefaultID = transactionparticipantDescID.Default.(func() uuid.UUID)
+	transactionsplitMixin := schemas.TransactionSplit{}.Mixin()
+	transactionsplitMixinFields0 := transactionsplitMixin[0].Fields()
+	_ = transactionsplitMixinFields0
+	transactionsplitMixinFields1 := transactionsplitMixin[1].Fields()
+	_ = transactionsplitMixinFields1
+	transactionsplitFields := schemas.TransactionSplit{}.Fields()
+	_ = transactionsplitFields
+	// transactionsplitDescCreatedAt is the schema descriptor for created_at field.
+	transactionsplitDescCreatedAt := transactionsplitMixinFields1[0].Descriptor()
+	// transactionsplit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transactionsplit.DefaultCreatedAt = transactionsplitDescCreatedAt.Default.(func() time.Time)
+	// transactionsplitDescUpdatedAt is the schema descriptor for updated_at field.
+	transactionsplitDescUpdatedAt := transactionsplitMixinFields1[1].Descriptor()
+	// transactionsplit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transactionsplit.DefaultUpdatedAt = transactionsplitDescUpdatedAt.Default.(func() time.Time)
+	// transactionsplit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transactionsplit.UpdateDefaultUpdatedAt = transactionsplitDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// transactionsplitDescNote is the schema descriptor for note field.
+	transactionsplitDescNote := transactionsplitFields[0].Descriptor()
+	// transactionsplit.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	transactionsplit.NoteValidator = transactionsplitDescNote.Validators[0].(func(string) error)
+	// transactionsplitDescID is the schema descriptor for id field.
+	transactionsplitDescID := transactionsplitMixinFields0[0].Descriptor()
+	// transactionsplit.DefaultID holds the default value on creation for the id field.
+	transactionsplit.DefaultID = transactionsplitDescID.Default.(func() uuid.UUID)
 	userMixin := schemas.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -275,8 +1889,53 @@ func init() {
 	userDescIsActive := userFields[4].Descriptor()
 	// user.DefaultIsActive holds the default value on creation for the is_active field.
 	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescBaseCurrency is the schema descriptor for base_currency field.
+	userDescBaseCurrency := userFields[5].Descriptor()
+	// user.DefaultBaseCurrency holds the default value on creation for the base_currency field.
+	user.DefaultBaseCurrency = userDescBaseCurrency.Default.(string)
+	// user.BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	user.BaseCurrencyValidator = func() func(string) error {
+		validators := userDescBaseCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(base_currency string) error {
+			for _, fn := range fns {
+				if err := fn(base_currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	valuationMixin := schemas.Valuation{}.Mixin()
+	valuationMixinFields0 := valuationMixin[0].Fields()
+	_ = valuationMixinFields0
+	valuationMixinFields1 := valuationMixin[1].Fields()
+	_ = valuationMixinFields1
+	valuationFields := schemas.Valuation{}.Fields()
+	_ = valuationFields
+	// valuationDescCreatedAt is the schema descriptor for created_at field.
+	valuationDescCreatedAt := valuationMixinFields1[0].Descriptor()
+	// valuation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	valuation.DefaultCreatedAt = valuationDescCreatedAt.Default.(func() time.Time)
+	// valuationDescUpdatedAt is the schema descriptor for updated_at field.
+	valuationDescUpdatedAt := valuationMixinFields1[1].Descriptor()
+	// valuation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	valuation.DefaultUpdatedAt = valuationDescUpdatedAt.Default.(func() time.Time)
+	// valuation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	valuation.UpdateDefaultUpdatedAt = valuationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// valuationDescNote is the schema descriptor for note field.
+	valuationDescNote := valuationFields[1].Descriptor()
+	// valuation.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	valuation.NoteValidator = valuationDescNote.Validators[0].(func(string) error)
+	// valuationDescID is the schema descriptor for id field.
+	valuationDescID := valuationMixinFields0[0].Descriptor()
+	// valuation.DefaultID holds the default value on creation for the id field.
+	valuation.DefaultID = valuationDescID.Default.(func() uuid.UUID)
 }
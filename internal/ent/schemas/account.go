@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+type Account struct {
+	ent.Schema
+}
+
+func (Account) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Account) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.String("account_type").
+			NotEmpty().
+			Default(string(domain.AccountChecking)).
+			Validate(func(s string) error {
+				if !domain.AccountType(s).IsValid() {
+					return fmt.Errorf("invalid account_type: %q", s)
+				}
+				return nil
+			}),
+		field.String("currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
+		// created_by_id é o membro do livro que criou a conta
+		field.UUID("created_by_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+	}
+}
+
+func (Account) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("created_by", User.Type).Unique().Immutable().Field("created_by_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
@@ -0,0 +1,57 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Asset é um bem (imóvel, veículo, investimento) ou uma dívida (financiamento, empréstimo)
+// fora das contas, cujo valor é acompanhado por avaliações datadas.
+type Asset struct {
+	ent.Schema
+}
+
+func (Asset) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Asset) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.String("class").
+			NotEmpty().
+			Default(string(domain.AssetClassAsset)).
+			Validate(func(s string) error {
+				if !domain.AssetClass(s).IsValid() {
+					return fmt.Errorf("invalid class: %q", s)
+				}
+				return nil
+			}),
+		field.String("kind").
+			NotEmpty().
+			Default(string(domain.AssetOther)).
+			Validate(func(s string) error {
+				if !domain.AssetKind(s).IsValid() {
+					return fmt.Errorf("invalid kind: %q", s)
+				}
+				return nil
+			}),
+		field.String("currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
+	}
+}
+
+func (Asset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("valuations", Valuation.Type).Ref("asset"),
+	}
+}
@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type Attachment struct {
+	ent.Schema
+}
+
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("filename").MaxLen(255).NotEmpty(),
+		field.String("content_type").NotEmpty(),
+		field.Int64("size").NonNegative(),
+		field.String("blob_key").NotEmpty(),
+		field.UUID("transaction_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("invoice_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("import_job_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transaction", Transaction.Type).Unique().Field("transaction_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invoice", Invoice.Type).Unique().Field("invoice_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("transaction"),
+		index.Edges("invoice"),
+		index.Fields("import_job_id"),
+		index.Fields("blob_key"),
+	}
+}
@@ -0,0 +1,50 @@
+package schemas
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type Budget struct {
+	ent.Schema
+}
+
+func (Budget) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Budget) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("category_id", uuid.UUID{}),
+		// month nulo indica um orçamento recorrente, válido em todos os meses sem orçamento próprio
+		field.Time("month").Optional().Nillable().SchemaType(map[string]string{"postgres": "date"}),
+		field.Int64("amount").GoType(domain.Money(0)).SchemaType(map[string]string{"postgres": "decimal(18,2)"}).Optional().Nillable(),
+		field.Int("percentage").Optional().Nillable(),
+		// último alerta emitido (80 ou 100) e o mês a que ele se refere
+		field.Int("alerted_threshold").Default(0),
+		field.Time("alerted_month").Optional().Nillable().SchemaType(map[string]string{"postgres": "date"}),
+	}
+}
+
+func (Budget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("category", Category.Type).Unique().Required().Field("category_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Budget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category_id", "month").Edges("ledger").Unique(),
+	}
+}
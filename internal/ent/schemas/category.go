@@ -1,10 +1,16 @@
 package schemas
 
 import (
+	"fmt"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/utils/mixins"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 type Category struct {
@@ -20,9 +26,38 @@ func (Category) Mixin() []ent.Mixin {
 
 func (Category) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name").Unique().MaxLen(255).NotEmpty(),
+		field.String("name").MaxLen(255).NotEmpty(),
 		field.String("description").Optional().Nillable(),
 		field.String("color").MaxLen(7).Optional().Nillable(),
 		field.Int("suggested_percentage").Optional().Nillable(),
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable(),
+		// tax_group vincula a categoria a um grupo da declaração do imposto de renda; as
+		// subcategorias sem grupo próprio herdam o do ancestral mais próximo
+		field.String("tax_group").
+			Optional().
+			Nillable().
+			Validate(func(s string) error {
+				if !domain.TaxGroup(s).IsValid() {
+					return fmt.Errorf("invalid tax_group: %q", s)
+				}
+				return nil
+			}),
+		// created_by_id é o membro do livro que criou a categoria
+		field.UUID("created_by_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+	}
+}
+
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("created_by", User.Type).Unique().Immutable().Field("created_by_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("children", Category.Type).From("parent").Unique().Field("parent_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("ledger").Unique(),
+		index.Fields("parent_id"),
 	}
 }
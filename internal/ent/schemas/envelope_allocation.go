@@ -0,0 +1,61 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EnvelopeAllocation é um lançamento no envelope de uma categoria em um mês. O histórico de
+// lançamentos forma o orçamento base zero: valores positivos atribuem dinheiro ao envelope e
+// negativos o retiram.
+type EnvelopeAllocation struct {
+	ent.Schema
+}
+
+func (EnvelopeAllocation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (EnvelopeAllocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("category_id", uuid.UUID{}),
+		field.Time("month").SchemaType(map[string]string{"postgres": "date"}),
+		field.String("kind").
+			Validate(func(s string) error {
+				if !domain.AllocationKind(s).IsValid() {
+					return fmt.Errorf("invalid kind: %q", s)
+				}
+				return nil
+			}),
+		// transfer_id liga os dois lados de uma movimentação entre envelopes
+		field.UUID("transfer_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("note").MaxLen(255).Optional().Nillable(),
+	}
+}
+
+func (EnvelopeAllocation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("category", Category.Type).Unique().Required().Field("category_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (EnvelopeAllocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("month").Edges("ledger"),
+		index.Fields("category_id"),
+		index.Fields("transfer_id"),
+	}
+}
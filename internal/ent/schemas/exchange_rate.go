@@ -0,0 +1,46 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ExchangeRate struct {
+	ent.Schema
+}
+
+func (ExchangeRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_currency").MaxLen(3).NotEmpty(),
+		field.String("to_currency").MaxLen(3).NotEmpty(),
+		field.Float("rate").
+			Positive().
+			SchemaType(map[string]string{"postgres": "decimal(18,8)"}),
+		field.Time("rate_date").
+			SchemaType(map[string]string{"postgres": "date"}),
+	}
+}
+
+func (ExchangeRate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("from_currency", "to_currency", "rate_date").Edges("ledger").Unique(),
+	}
+}
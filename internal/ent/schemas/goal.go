@@ -0,0 +1,47 @@
+package schemas
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+type Goal struct {
+	ent.Schema
+}
+
+func (Goal) Mixin() []ent.Mixin {
+	var defaultZero domain.Money
+
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "target_amount"},
+		// initial_amount é o que já estava guardado antes dos aportes registrados em transações
+		mixins.MoneyMixin{Name: "initial_amount", Default: &defaultZero},
+	}
+}
+
+func (Goal) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.Time("target_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.UUID("account_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("category_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("tag_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Goal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).Unique().Field("account_id"),
+		edge.To("category", Category.Type).Unique().Field("category_id"),
+		edge.To("tag", Tag.Type).Unique().Field("tag_id"),
+	}
+}
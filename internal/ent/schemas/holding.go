@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Holding é um investimento da carteira (título do Tesouro, CDB, ação), identificado pelo
+// ticker. A posição vem das operações de compra e venda e o preço das cotações gravadas.
+type Holding struct {
+	ent.Schema
+}
+
+func (Holding) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Holding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.String("ticker").MaxLen(32).NotEmpty(),
+		field.String("asset_class").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.InvestmentClass(s).IsValid() {
+					return fmt.Errorf("invalid asset_class: %q", s)
+				}
+				return nil
+			}),
+		field.String("currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
+		field.UUID("account_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Holding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).Unique().Field("account_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.From("trades", InvestmentTrade.Type).Ref("holding"),
+		edge.From("quotes", InvestmentQuote.Type).Ref("holding"),
+		edge.From("incomes", InvestmentIncome.Type).Ref("holding"),
+	}
+}
+
+func (Holding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ticker").Edges("ledger").Unique(),
+	}
+}
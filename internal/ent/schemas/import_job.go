@@ -0,0 +1,39 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJob acompanha o processamento das linhas de um arquivo importado. O ID é o do job
+// devolvido no upload.
+type ImportJob struct {
+	ent.Schema
+}
+
+func (ImportJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (ImportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("filename").MaxLen(255),
+		field.Int("total"),
+		field.Int("processed").Default(0),
+		field.Int("failed").Default(0),
+		field.Time("completed_at").Optional().Nillable(),
+	}
+}
+
+func (ImportJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().StorageKey(edge.Column("user_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
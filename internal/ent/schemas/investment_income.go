@@ -0,0 +1,59 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// InvestmentIncome é um provento (dividendos, cupom, JCP) recebido pelo investimento. Cada
+// provento gera uma transação de receita, referenciada em transaction_id.
+type InvestmentIncome struct {
+	ent.Schema
+}
+
+func (InvestmentIncome) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (InvestmentIncome) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("holding_id", uuid.UUID{}),
+		field.String("kind").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.IncomeKind(s).IsValid() {
+					return fmt.Errorf("invalid kind: %q", s)
+				}
+				return nil
+			}),
+		field.Time("payment_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.String("note").MaxLen(255).Optional().Nillable(),
+		field.UUID("transaction_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (InvestmentIncome) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("holding", Holding.Type).Unique().Required().Field("holding_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transaction", Transaction.Type).Unique().Field("transaction_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (InvestmentIncome) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("holding_id", "payment_date"),
+	}
+}
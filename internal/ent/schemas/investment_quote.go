@@ -0,0 +1,46 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// InvestmentQuote é o preço unitário do investimento em uma data, informado manualmente ou
+// importado de CSV. Os valores da carteira usam sempre a cotação mais recente.
+type InvestmentQuote struct {
+	ent.Schema
+}
+
+func (InvestmentQuote) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "price"},
+	}
+}
+
+func (InvestmentQuote) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("holding_id", uuid.UUID{}),
+		field.Time("quote_date").SchemaType(map[string]string{"postgres": "date"}),
+	}
+}
+
+func (InvestmentQuote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("holding", Holding.Type).Unique().Required().Field("holding_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (InvestmentQuote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("holding_id", "quote_date").Unique(),
+	}
+}
@@ -0,0 +1,63 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// InvestmentTrade é um lote de compra ou venda, com o preço unitário e as taxas na moeda
+// do investimento.
+type InvestmentTrade struct {
+	ent.Schema
+}
+
+func (InvestmentTrade) Mixin() []ent.Mixin {
+	var defaultZero domain.Money
+
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "price"},
+		mixins.MoneyMixin{Name: "fees", Default: &defaultZero},
+	}
+}
+
+func (InvestmentTrade) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("holding_id", uuid.UUID{}),
+		field.String("kind").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.TradeKind(s).IsValid() {
+					return fmt.Errorf("invalid kind: %q", s)
+				}
+				return nil
+			}),
+		field.Time("trade_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.Float("quantity").
+			Positive().
+			SchemaType(map[string]string{"postgres": "decimal(24,8)"}),
+		field.String("note").MaxLen(255).Optional().Nillable(),
+	}
+}
+
+func (InvestmentTrade) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("holding", Holding.Type).Unique().Required().Field("holding_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (InvestmentTrade) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("holding_id", "trade_date"),
+	}
+}
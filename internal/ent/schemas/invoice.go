@@ -1,12 +1,15 @@
 package schemas
 
 import (
+	"fmt"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/utils/mixins"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 type Invoice struct {
@@ -14,11 +17,10 @@ type Invoice struct {
 }
 
 func (Invoice) Mixin() []ent.Mixin {
-	defaultZero := 0.0
+	var defaultZero domain.Money
 	return []ent.Mixin{
 		mixins.UUIDMixin{},
 		mixins.TimestampsMixin{},
-		mixins.RecordStatusMixin{},
 		mixins.MoneyMixin{Name: "amount", Default: &defaultZero},
 	}
 }
@@ -27,12 +29,29 @@ func (Invoice) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").MaxLen(255).NotEmpty(),
 		field.Time("due_date"),
+		field.String("status").
+			NotEmpty().
+			Default(string(domain.InvoiceOpen)).
+			Validate(func(s string) error {
+				if !domain.InvoiceStatus(s).IsValid() {
+					return fmt.Errorf("invalid status: %q", s)
+				}
+				return nil
+			}),
+		field.Time("closed_at").Optional().Nillable(),
+		field.Time("paid_at").Optional().Nillable(),
+		field.UUID("payment_account_id", uuid.UUID{}).Optional().Nillable(),
+		// created_by_id é o membro do livro que lançou a fatura
+		field.UUID("created_by_id", uuid.UUID{}).Optional().Nillable().Immutable(),
 	}
 }
 
 func (Invoice) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("transactions", Transaction.Type).Ref("invoice"),
-		edge.To("user", User.Type).Unique().Required().StorageKey(edge.Column("user_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("payments", InvoicePayment.Type).Ref("invoice"),
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("created_by", User.Type).Unique().Immutable().Field("created_by_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("payment_account", Account.Type).Unique().Field("payment_account_id"),
 	}
 }
@@ -0,0 +1,55 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type InvoicePayment struct {
+	ent.Schema
+}
+
+func (InvoicePayment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (InvoicePayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("paid_at"),
+		field.String("kind").
+			NotEmpty().
+			Default(string(domain.PaymentKindPayment)).
+			Validate(func(s string) error {
+				if !domain.InvoicePaymentKind(s).IsValid() {
+					return fmt.Errorf("invalid kind: %q", s)
+				}
+				return nil
+			}),
+		field.UUID("account_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (InvoicePayment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("invoice", Invoice.Type).Unique().Required().StorageKey(edge.Column("invoice_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).Unique().Field("account_id"),
+	}
+}
+
+func (InvoicePayment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("invoice"),
+	}
+}
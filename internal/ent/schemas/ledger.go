@@ -0,0 +1,55 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Ledger é o dono dos lançamentos: transações, faturas, contas, categorias e os demais dados
+// financeiros pertencem a um livro, e os membros acessam o livro conforme o seu papel. Cada
+// usuário tem um livro pessoal, criado no cadastro, e pode criar outros para compartilhar.
+type Ledger struct {
+	ent.Schema
+}
+
+func (Ledger) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Ledger) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		// personal marca o livro usado quando a requisição não informa o header X-Ledger-ID
+		field.Bool("personal").Default(false).Immutable(),
+		// category_model_version muda a cada correção de categoria e invalida o classificador
+		// treinado em todas as instâncias da API e do worker.
+		field.Int64("category_model_version").Default(0),
+	}
+}
+
+func (Ledger) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).Unique().Required().StorageKey(edge.Column("owner_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("members", LedgerMember.Type).Ref("ledger"),
+		edge.From("invitations", LedgerInvitation.Type).Ref("ledger"),
+		edge.From("transactions", Transaction.Type).Ref("ledger"),
+		edge.From("invoices", Invoice.Type).Ref("ledger"),
+		edge.From("accounts", Account.Type).Ref("ledger"),
+		edge.From("categories", Category.Type).Ref("ledger"),
+	}
+}
+
+func (Ledger) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("owner"),
+		index.Edges("owner").Unique().Annotations(entsql.IndexWhere("personal")).StorageKey("ledger_owner_id_personal"),
+	}
+}
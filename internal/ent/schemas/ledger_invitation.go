@@ -0,0 +1,67 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type LedgerInvitation struct {
+	ent.Schema
+}
+
+func (LedgerInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (LedgerInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("ledger_id", uuid.UUID{}),
+		field.UUID("invitee_id", uuid.UUID{}),
+		field.UUID("invited_by_id", uuid.UUID{}),
+		field.String("role").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.LedgerRole(s).IsValid() {
+					return fmt.Errorf("invalid role: %q", s)
+				}
+				return nil
+			}),
+		field.String("status").
+			NotEmpty().
+			Default(string(domain.InvitationPending)).
+			Validate(func(s string) error {
+				if !domain.InvitationStatus(s).IsValid() {
+					return fmt.Errorf("invalid status: %q", s)
+				}
+				return nil
+			}),
+		field.Time("expires_at"),
+		field.Time("responded_at").Optional().Nillable(),
+	}
+}
+
+func (LedgerInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().Field("ledger_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitee", User.Type).Unique().Required().Field("invitee_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invited_by", User.Type).Unique().Required().Field("invited_by_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (LedgerInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ledger_id", "status"),
+		index.Fields("invitee_id", "status"),
+	}
+}
@@ -0,0 +1,54 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type LedgerMember struct {
+	ent.Schema
+}
+
+func (LedgerMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (LedgerMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("ledger_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("role").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.LedgerRole(s).IsValid() {
+					return fmt.Errorf("invalid role: %q", s)
+				}
+				return nil
+			}),
+	}
+}
+
+func (LedgerMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().Field("ledger_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("user", User.Type).Unique().Required().Field("user_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (LedgerMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ledger_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,71 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Loan é um empréstimo ou financiamento. O cronograma de parcelas é gerado na criação pelo
+// sistema de amortização (SAC ou Price) e recalculado a cada amortização extra.
+type Loan struct {
+	ent.Schema
+}
+
+func (Loan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "principal"},
+	}
+}
+
+func (Loan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		// interest_rate é a taxa de juros em percentual, ao mês ou ao ano conforme rate_period
+		field.Float("interest_rate").
+			Min(0).
+			SchemaType(map[string]string{"postgres": "decimal(9,4)"}),
+		field.String("rate_period").
+			NotEmpty().
+			Default(string(domain.RateMonthly)).
+			Validate(func(s string) error {
+				if !domain.RatePeriod(s).IsValid() {
+					return fmt.Errorf("invalid rate_period: %q", s)
+				}
+				return nil
+			}),
+		field.Int("term_months").Positive(),
+		field.String("system").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.LoanSystem(s).IsValid() {
+					return fmt.Errorf("invalid system: %q", s)
+				}
+				return nil
+			}),
+		field.Time("first_payment_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.String("currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
+		field.UUID("account_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("interest_category_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("principal_category_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Loan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).Unique().Field("account_id"),
+		edge.To("interest_category", Category.Type).Unique().Field("interest_category_id"),
+		edge.To("principal_category", Category.Type).Unique().Field("principal_category_id"),
+		edge.From("installments", LoanInstallment.Type).Ref("loan"),
+		edge.From("prepayments", LoanPrepayment.Type).Ref("loan"),
+	}
+}
@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LoanInstallment é uma parcela do cronograma, com a divisão entre juros e amortização e o
+// saldo devedor após o pagamento. Cada parcela tem uma transação pendente vinculada.
+type LoanInstallment struct {
+	ent.Schema
+}
+
+func (LoanInstallment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "payment"},
+		mixins.MoneyMixin{Name: "interest"},
+		mixins.MoneyMixin{Name: "principal"},
+		mixins.MoneyMixin{Name: "balance"},
+	}
+}
+
+func (LoanInstallment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("loan_id", uuid.UUID{}),
+		field.Int("number").Positive(),
+		field.Time("due_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.UUID("transaction_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (LoanInstallment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("loan", Loan.Type).Unique().Required().Field("loan_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transaction", Transaction.Type).Unique().Field("transaction_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (LoanInstallment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("loan_id", "number").Unique(),
+	}
+}
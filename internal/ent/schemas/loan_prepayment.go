@@ -0,0 +1,59 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LoanPrepayment é uma amortização extra. interest_saved guarda a diferença de juros entre o
+// cronograma substituído e o recalculado.
+type LoanPrepayment struct {
+	ent.Schema
+}
+
+func (LoanPrepayment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+		mixins.MoneyMixin{Name: "interest_saved"},
+	}
+}
+
+func (LoanPrepayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("loan_id", uuid.UUID{}),
+		field.Time("payment_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.String("mode").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.PrepaymentMode(s).IsValid() {
+					return fmt.Errorf("invalid mode: %q", s)
+				}
+				return nil
+			}),
+		field.UUID("transaction_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (LoanPrepayment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("loan", Loan.Type).Unique().Required().Field("loan_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transaction", Transaction.Type).Unique().Field("transaction_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (LoanPrepayment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("loan_id", "payment_date"),
+	}
+}
@@ -0,0 +1,56 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification é um aviso na caixa de entrada do app.
+type Notification struct {
+	ent.Schema
+}
+
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").
+			Validate(func(s string) error {
+				if !domain.NotificationKind(s).IsValid() {
+					return fmt.Errorf("invalid type: %q", s)
+				}
+				return nil
+			}),
+		// key é a chave do lembrete; com o usuário, garante um único aviso por lembrete
+		field.String("key").MaxLen(255).NotEmpty(),
+		field.String("title").MaxLen(255).NotEmpty(),
+		field.String("body"),
+		field.JSON("payload", map[string]any{}).Optional(),
+		field.Time("read_at").Optional().Nillable(),
+	}
+}
+
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().StorageKey(edge.Column("user_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").Edges("user"),
+		index.Fields("key").Edges("user").Unique(),
+	}
+}
@@ -0,0 +1,76 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+func (NotificationDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (NotificationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kind").
+			Validate(func(s string) error {
+				if !domain.NotificationKind(s).IsValid() {
+					return fmt.Errorf("invalid kind: %q", s)
+				}
+				return nil
+			}),
+		// key identifica o aviso; junto com o canal e o usuário, garante uma única entrega
+		field.String("key").MaxLen(255).NotEmpty(),
+		field.String("channel").
+			Validate(func(s string) error {
+				if !domain.NotificationChannel(s).IsValid() {
+					return fmt.Errorf("invalid channel: %q", s)
+				}
+				return nil
+			}),
+		field.UUID("reference_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("title").MaxLen(255).NotEmpty(),
+		field.String("body"),
+		field.JSON("payload", map[string]any{}).Optional(),
+		field.String("status").
+			Default(string(domain.DeliveryPending)).
+			Validate(func(s string) error {
+				if !domain.DeliveryStatus(s).IsValid() {
+					return fmt.Errorf("invalid status: %q", s)
+				}
+				return nil
+			}),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Optional().Nillable(),
+		field.Time("sent_at").Optional().Nillable(),
+		// not_before adia a entrega, como no horário de silêncio do usuário
+		field.Time("not_before").Optional().Nillable(),
+	}
+}
+
+func (NotificationDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().StorageKey(edge.Column("user_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "channel").Edges("user").Unique(),
+		index.Fields("status"),
+	}
+}
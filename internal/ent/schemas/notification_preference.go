@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type NotificationPreference struct {
+	ent.Schema
+}
+
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("lead_days").Default(domain.NotificationDefaultLeadDays),
+		// horário de silêncio no formato HH:MM, no fuso timezone
+		field.String("quiet_start").MaxLen(5).Optional().Nillable(),
+		field.String("quiet_end").MaxLen(5).Optional().Nillable(),
+		field.String("timezone").MaxLen(64).NotEmpty().Default(domain.NotificationDefaultTimezone),
+		field.Bool("email_enabled").Default(false),
+		field.String("webhook_url").MaxLen(2048).Optional().Nillable(),
+		field.String("webhook_secret").MaxLen(255).Optional().Nillable().Sensitive(),
+		field.Bool("inapp_enabled").Default(true),
+	}
+}
+
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().StorageKey(edge.Column("user_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user").Unique(),
+	}
+}
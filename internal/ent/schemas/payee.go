@@ -0,0 +1,45 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Payee struct {
+	ent.Schema
+}
+
+func (Payee) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Payee) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.String("normalized_name").MaxLen(255).NotEmpty(),
+		field.Strings("aliases").Default([]string{}),
+		// document é o CPF ou CNPJ do favorecido, só com os dígitos
+		field.String("document").Optional().Nillable(),
+	}
+}
+
+func (Payee) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("transactions", Transaction.Type).Ref("payee"),
+	}
+}
+
+func (Payee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("normalized_name").Edges("ledger").Unique(),
+	}
+}
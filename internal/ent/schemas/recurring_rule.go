@@ -0,0 +1,56 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RecurringRule é um lançamento que se repete com uma frequência fixa. As transações lançadas
+// para cada ocorrência apontam para a regra por recurring_rule_id.
+type RecurringRule struct {
+	ent.Schema
+}
+
+func (RecurringRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.RecordTypeMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (RecurringRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").MaxLen(255).NotEmpty(),
+		field.String("currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
+		field.String("frequency").
+			NotEmpty().
+			Validate(func(s string) error {
+				if !domain.RecurrenceFrequency(s).IsValid() {
+					return fmt.Errorf("invalid frequency: %q", s)
+				}
+				return nil
+			}),
+		field.Time("start_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.Time("end_date").Optional().Nillable().SchemaType(map[string]string{"postgres": "date"}),
+		field.UUID("account_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("category_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (RecurringRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).Unique().Field("account_id"),
+		edge.To("category", Category.Type).Unique().Field("category_id"),
+		edge.From("transactions", Transaction.Type).Ref("recurring_rule"),
+	}
+}
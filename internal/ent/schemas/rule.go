@@ -0,0 +1,85 @@
+package schemas
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type Rule struct {
+	ent.Schema
+}
+
+func (Rule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Rule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.Int("priority").Default(0),
+		field.Bool("enabled").Default(true),
+
+		// Condições
+		field.String("title_contains").MaxLen(255).Optional().Nillable(),
+		field.String("title_regex").MaxLen(255).Optional().Nillable(),
+		field.Int64("amount_min").GoType(domain.Money(0)).SchemaType(map[string]string{"postgres": "decimal(18,2)"}).Optional().Nillable(),
+		field.Int64("amount_max").GoType(domain.Money(0)).SchemaType(map[string]string{"postgres": "decimal(18,2)"}).Optional().Nillable(),
+		field.String("record_type").
+			Optional().
+			Nillable().
+			Validate(func(s string) error {
+				if !domain.RecordType(s).IsValid() {
+					return fmt.Errorf("invalid record_type: %q", s)
+				}
+				return nil
+			}),
+		field.UUID("invoice_id", uuid.UUID{}).Optional().Nillable(),
+
+		// Ações
+		field.UUID("category_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("action_record_type").
+			Optional().
+			Nillable().
+			Validate(func(s string) error {
+				if !domain.RecordType(s).IsValid() {
+					return fmt.Errorf("invalid action_record_type: %q", s)
+				}
+				return nil
+			}),
+		field.String("action_status").
+			Optional().
+			Nillable().
+			Validate(func(s string) error {
+				if !domain.TxnStatus(s).IsValid() {
+					return fmt.Errorf("invalid action_status: %q", s)
+				}
+				return nil
+			}),
+		field.Bool("skip").Default(false),
+	}
+}
+
+func (Rule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invoice", Invoice.Type).Unique().Field("invoice_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("category", Category.Type).Unique().Field("category_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Rule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("ledger").Fields("priority"),
+	}
+}
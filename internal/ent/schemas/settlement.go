@@ -0,0 +1,49 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Settlement é um pagamento entre membros do livro que abate as dívidas das despesas
+// divididas, na moeda base.
+type Settlement struct {
+	ent.Schema
+}
+
+func (Settlement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (Settlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("from_user_id", uuid.UUID{}),
+		field.UUID("to_user_id", uuid.UUID{}),
+		field.Time("settled_at"),
+		field.String("note").MaxLen(255).Optional().Nillable(),
+	}
+}
+
+func (Settlement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("from_user", User.Type).Unique().Required().Field("from_user_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("to_user", User.Type).Unique().Required().Field("to_user_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Settlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settled_at").Edges("ledger"),
+	}
+}
@@ -0,0 +1,42 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Tag struct {
+	ent.Schema
+}
+
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+	}
+}
+
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.String("color").MaxLen(7).Optional().Nillable(),
+	}
+}
+
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("transactions", Transaction.Type).Ref("tags"),
+	}
+}
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("ledger").Unique(),
+	}
+}
@@ -1,6 +1,8 @@
 package schemas
 
 import (
+	"fmt"
+	"frog-go/internal/core/domain"
 	"frog-go/internal/utils/mixins"
 
 	"entgo.io/ent"
@@ -8,6 +10,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 type Transaction struct {
@@ -28,14 +31,57 @@ func (Transaction) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").MaxLen(255).NotEmpty(),
 		field.Time("record_date"),
+		field.String("currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
+		// exchange_rate converte amount para a moeda base do usuário na data do lançamento
+		field.Float("exchange_rate").
+			Positive().
+			Default(1).
+			SchemaType(map[string]string{"postgres": "decimal(18,8)"}),
+		field.String("category_source").
+			Optional().
+			Nillable().
+			Validate(func(s string) error {
+				if !domain.CategorySource(s).IsValid() {
+					return fmt.Errorf("invalid category_source: %q", s)
+				}
+				return nil
+			}),
+		// paid_by_id e share_mode só são preenchidos em despesas divididas entre membros do livro
+		field.UUID("paid_by_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("share_mode").
+			Optional().
+			Nillable().
+			Validate(func(s string) error {
+				if !domain.ShareMode(s).IsValid() {
+					return fmt.Errorf("invalid share_mode: %q", s)
+				}
+				return nil
+			}),
+		// created_by_id é o membro do livro que fez o lançamento
+		field.UUID("created_by_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		// installment_number e installment_count marcam a parcela de uma compra parcelada no cartão
+		field.Int("installment_number").Optional().Nillable().Positive(),
+		field.Int("installment_count").Optional().Nillable().Positive(),
+		// recurring_rule_id vincula a transação à regra recorrente da qual ela é uma ocorrência
+		field.UUID("recurring_rule_id", uuid.UUID{}).Optional().Nillable(),
+		// transfer_account_id é a conta do livro que recebe o dinheiro em uma transferência
+		field.UUID("transfer_account_id", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 
 func (Transaction) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("user", User.Type).Unique().Required().StorageKey(edge.Column("user_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("created_by", User.Type).Unique().Immutable().Field("created_by_id").Annotations(entsql.OnDelete(entsql.SetNull)),
 		edge.To("invoice", Invoice.Type).Unique().StorageKey(edge.Column("invoice_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("category", Category.Type).Unique().StorageKey(edge.Column("category_id")),
+		edge.To("payee", Payee.Type).Unique().StorageKey(edge.Column("payee_id")),
+		edge.To("recurring_rule", RecurringRule.Type).Unique().Field("recurring_rule_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("transfer_account", Account.Type).Unique().Field("transfer_account_id").Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("tags", Tag.Type),
+		edge.From("splits", TransactionSplit.Type).Ref("transaction"),
+		edge.To("paid_by", User.Type).Unique().Field("paid_by_id"),
+		edge.From("participants", TransactionParticipant.Type).Ref("transaction"),
 		// TODO: ver se tem como deixar category obrigatorio na modelagem, acredito q talvez n de por estar usando um hook para popular no create
 	}
 }
@@ -46,6 +92,9 @@ func (Transaction) Indexes() []ent.Index {
 		index.Fields("record_type"),
 		index.Edges("invoice"),
 		index.Edges("category"),
+		index.Edges("payee"),
+		index.Edges("recurring_rule"),
+		index.Edges("transfer_account"),
 		index.Edges("category").Fields("record_date", "record_type"),
 	}
 }
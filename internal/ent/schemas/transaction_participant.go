@@ -0,0 +1,51 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TransactionParticipant é a parte de um membro do livro em uma despesa dividida, na moeda
+// da transação.
+type TransactionParticipant struct {
+	ent.Schema
+}
+
+func (TransactionParticipant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (TransactionParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("transaction_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Float("percentage").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{"postgres": "decimal(5,2)"}),
+	}
+}
+
+func (TransactionParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("transaction", Transaction.Type).Unique().Required().Field("transaction_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("user", User.Type).Unique().Required().Field("user_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (TransactionParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("transaction_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,45 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type TransactionSplit struct {
+	ent.Schema
+}
+
+func (TransactionSplit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (TransactionSplit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("note").MaxLen(255).Optional().Nillable(),
+		field.UUID("category_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (TransactionSplit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("transaction", Transaction.Type).Unique().Required().StorageKey(edge.Column("transaction_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("category", Category.Type).Unique().Field("category_id"),
+	}
+}
+
+func (TransactionSplit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("transaction"),
+		index.Fields("category_id"),
+	}
+}
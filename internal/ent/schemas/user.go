@@ -1,6 +1,7 @@
 package schemas
 
 import (
+	"frog-go/internal/core/domain"
 	"frog-go/internal/utils/mixins"
 
 	"entgo.io/ent"
@@ -26,12 +27,12 @@ func (User) Fields() []ent.Field {
 		field.String("email").Unique().Optional().MaxLen(255),
 		field.String("password_hash").Sensitive().NotEmpty().MaxLen(255),
 		field.Bool("is_active").Default(true),
+		field.String("base_currency").MaxLen(3).NotEmpty().Default(domain.DefaultCurrency),
 	}
 }
 
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("transactions", Transaction.Type).Ref("user"),
-		edge.From("invoices", Invoice.Type).Ref("user"),
+		edge.From("ledgers", Ledger.Type).Ref("owner"),
 	}
 }
@@ -0,0 +1,51 @@
+package schemas
+
+import (
+	"frog-go/internal/utils/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Valuation é o valor de um bem, de uma dívida ou o saldo de uma conta em uma data, na moeda
+// do item. Apenas um de asset_id e account_id é preenchido.
+type Valuation struct {
+	ent.Schema
+}
+
+func (Valuation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.UUIDMixin{},
+		mixins.TimestampsMixin{},
+		mixins.MoneyMixin{Name: "amount"},
+	}
+}
+
+func (Valuation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("value_date").SchemaType(map[string]string{"postgres": "date"}),
+		field.String("note").MaxLen(255).Optional().Nillable(),
+		field.UUID("asset_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("account_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (Valuation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ledger", Ledger.Type).Unique().Required().StorageKey(edge.Column("ledger_id")).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("asset", Asset.Type).Unique().Field("asset_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("account", Account.Type).Unique().Field("account_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Valuation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset_id", "value_date").Unique(),
+		index.Fields("account_id", "value_date").Unique(),
+		index.Fields("value_date").Edges("ledger"),
+	}
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/settlement"
+	"frog-go/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Settlement is the model entity for the Settlement schema.
+type Settlement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount domain.Money `json:"amount,omitempty"`
+	// FromUserID holds the value of the "from_user_id" field.
+	FromUserID uuid.UUID `json:"from_user_id,omitempty"`
+	// ToUserID holds the value of the "to_user_id" field.
+	ToUserID uuid.UUID `json:"to_user_id,omitempty"`
+	// SettledAt holds the value of the "settled_at" field.
+	SettledAt time.Time `json:"settled_at,omitempty"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SettlementQuery when eager-loading is set.
+	Edges        SettlementEdges `json:"edges"`
+	ledger_id    *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// SettlementEdges holds the relations/edges for other nodes in the graph.
+type SettlementEdges struct {
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// FromUser holds the value of the from_user edge.
+	FromUser *User `json:"from_user,omitempty"`
+	// ToUser holds the value of the to_user edge.
+	ToUser *User `json:"to_user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// FromUserOrErr returns the FromUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) FromUserOrErr() (*User, error) {
+	if e.FromUser != nil {
+		return e.FromUser, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "from_user"}
+}
+
+// ToUserOrErr returns the ToUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) ToUserOrErr() (*User, error) {
+	if e.ToUser != nil {
+		return e.ToUser, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "to_user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Settlement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlement.FieldAmount:
+			values[i] = new(domain.Money)
+		case settlement.FieldNote:
+			values[i] = new(sql.NullString)
+		case settlement.FieldCreatedAt, settlement.FieldUpdatedAt, settlement.FieldSettledAt:
+			values[i] = new(sql.NullTime)
+		case settlement.FieldID, settlement.FieldFromUserID, settlement.FieldToUserID:
+			values[i] = new(uuid.UUID)
+		case settlement.ForeignKeys[0]: // ledger_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Settlement fields.
+func (_m *Settlement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case settlement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case settlement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case settlement.FieldAmount:
+			if value, ok := values[i].(*domain.Money); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case settlement.FieldFromUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field from_user_id", values[i])
+			} else if value != nil {
+				_m.FromUserID = *value
+			}
+		case settlement.FieldToUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field to_user_id", values[i])
+			} else if value != nil {
+				_m.ToUserID = *value
+			}
+		case settlement.FieldSettledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[i])
+			} else if value.Valid {
+				_m.SettledAt = value.Time
+			}
+		case settlement.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = new(string)
+				*_m.Note = value.String
+			}
+		case settlement.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Settlement.
+// This includes values selected through modifiers, order, etc.
+func (_m *Settlement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLedger queries the "ledger" edge of the Settlement entity.
+func (_m *Settlement) QueryLedger() *LedgerQuery {
+	return NewSettlementClient(_m.config).QueryLedger(_m)
+}
+
+// QueryFromUser queries the "from_user" edge of the Settlement entity.
+func (_m *Settlement) QueryFromUser() *UserQuery {
+	return NewSettlementClient(_m.config).QueryFromUser(_m)
+}
+
+// QueryToUser queries the "to_user" edge of the Settlement entity.
+func (_m *Settlement) QueryToUser() *UserQuery {
+	return NewSettlementClient(_m.config).QueryToUser(_m)
+}
+
+// Update returns a builder for updating this Settlement.
+// Note that you need to call Settlement.Unwrap() before calling this method if this Settlement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Settlement) Update() *SettlementUpdateOne {
+	return NewSettlementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Settlement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Settlement) Unwrap() *Settlement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Settlement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Settlement) String() string {
+	var builder strings.Builder
+	builder.WriteString("Settlement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("from_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromUserID))
+	builder.WriteString(", ")
+	builder.WriteString("to_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToUserID))
+	builder.WriteString(", ")
+	builder.WriteString("settled_at=")
+	builder.WriteString(_m.SettledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Settlements is a parsable slice of Settlement.
+type Settlements []*Settlement
@@ -0,0 +1,943 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/settlement"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SettlementCreate is the builder for creating a Settlement entity.
+type SettlementCreate struct {
+	config
+	mutation *SettlementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SettlementCreate) SetCreatedAt(v time.Time) *SettlementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SettlementCreate) SetNillableCreatedAt(v *time.Time) *SettlementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SettlementCreate) SetUpdatedAt(v time.Time) *SettlementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SettlementCreate) SetNillableUpdatedAt(v *time.Time) *SettlementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *SettlementCreate) SetAmount(v domain.Money) *SettlementCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_c *SettlementCreate) SetFromUserID(v uuid.UUID) *SettlementCreate {
+	_c.mutation.SetFromUserID(v)
+	return _c
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (_c *SettlementCreate) SetToUserID(v uuid.UUID) *SettlementCreate {
+	_c.mutation.SetToUserID(v)
+	return _c
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_c *SettlementCreate) SetSettledAt(v time.Time) *SettlementCreate {
+	_c.mutation.SetSettledAt(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *SettlementCreate) SetNote(v string) *SettlementCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *SettlementCreate) SetNillableNote(v *string) *SettlementCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SettlementCreate) SetID(v uuid.UUID) *SettlementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SettlementCreate) SetNillableID(v *uuid.UUID) *SettlementCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *SettlementCreate) SetLedgerID(id uuid.UUID) *SettlementCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *SettlementCreate) SetLedger(v *Ledger) *SettlementCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetFromUser sets the "from_user" edge to the User entity.
+func (_c *SettlementCreate) SetFromUser(v *User) *SettlementCreate {
+	return _c.SetFromUserID(v.ID)
+}
+
+// SetToUser sets the "to_user" edge to the User entity.
+func (_c *SettlementCreate) SetToUser(v *User) *SettlementCreate {
+	return _c.SetToUserID(v.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (_c *SettlementCreate) Mutation() *SettlementMutation {
+	return _c.mutation
+}
+
+// Save creates the Settlement in the database.
+func (_c *SettlementCreate) Save(ctx context.Context) (*Settlement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettlementCreate) SaveX(ctx context.Context) *Settlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettlementCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := settlement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := settlement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := settlement.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettlementCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Settlement.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Settlement.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Settlement.amount"`)}
+	}
+	if _, ok := _c.mutation.FromUserID(); !ok {
+		return &ValidationError{Name: "from_user_id", err: errors.New(`ent: missing required field "Settlement.from_user_id"`)}
+	}
+	if _, ok := _c.mutation.ToUserID(); !ok {
+		return &ValidationError{Name: "to_user_id", err: errors.New(`ent: missing required field "Settlement.to_user_id"`)}
+	}
+	if _, ok := _c.mutation.SettledAt(); !ok {
+		return &ValidationError{Name: "settled_at", err: errors.New(`ent: missing required field "Settlement.settled_at"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := settlement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Settlement.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Settlement.ledger"`)}
+	}
+	if len(_c.mutation.FromUserIDs()) == 0 {
+		return &ValidationError{Name: "from_user", err: errors.New(`ent: missing required edge "Settlement.from_user"`)}
+	}
+	if len(_c.mutation.ToUserIDs()) == 0 {
+		return &ValidationError{Name: "to_user", err: errors.New(`ent: missing required edge "Settlement.to_user"`)}
+	}
+	return nil
+}
+
+func (_c *SettlementCreate) sqlSave(ctx context.Context) (*Settlement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettlementCreate) createSpec() (*Settlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Settlement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settlement.Table, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(settlement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.SettledAt(); ok {
+		_spec.SetField(settlement.FieldSettledAt, field.TypeTime, value)
+		_node.SettledAt = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(settlement.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.LedgerTable,
+			Columns: []string{settlement.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.FromUserTable,
+			Columns: []string{settlement.FromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FromUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.ToUserTable,
+			Columns: []string{settlement.ToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ToUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Settlement.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementCreate) OnConflict(opts ...sql.ConflictOption) *SettlementUpsertOne {
+	_c.conflict = opts
+	return &SettlementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementCreate) OnConflictColumns(columns ...string) *SettlementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SettlementUpsertOne is the builder for "upsert"-ing
+	//  one Settlement node.
+	SettlementUpsertOne struct {
+		create *SettlementCreate
+	}
+
+	// SettlementUpsert is the "OnConflict" setter.
+	SettlementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementUpsert) SetUpdatedAt(v time.Time) *SettlementUpsert {
+	u.Set(settlement.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateUpdatedAt() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *SettlementUpsert) SetAmount(v domain.Money) *SettlementUpsert {
+	u.Set(settlement.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateAmount() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SettlementUpsert) AddAmount(v domain.Money) *SettlementUpsert {
+	u.Add(settlement.FieldAmount, v)
+	return u
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (u *SettlementUpsert) SetFromUserID(v uuid.UUID) *SettlementUpsert {
+	u.Set(settlement.FieldFromUserID, v)
+	return u
+}
+
+// UpdateFromUserID sets the "from_user_id" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateFromUserID() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldFromUserID)
+	return u
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (u *SettlementUpsert) SetToUserID(v uuid.UUID) *SettlementUpsert {
+	u.Set(settlement.FieldToUserID, v)
+	return u
+}
+
+// UpdateToUserID sets the "to_user_id" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateToUserID() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldToUserID)
+	return u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (u *SettlementUpsert) SetSettledAt(v time.Time) *SettlementUpsert {
+	u.Set(settlement.FieldSettledAt, v)
+	return u
+}
+
+// UpdateSettledAt sets the "settled_at" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateSettledAt() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldSettledAt)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *SettlementUpsert) SetNote(v string) *SettlementUpsert {
+	u.Set(settlement.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateNote() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *SettlementUpsert) ClearNote() *SettlementUpsert {
+	u.SetNull(settlement.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementUpsertOne) UpdateNewValues() *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(settlement.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(settlement.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SettlementUpsertOne) Ignore() *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementUpsertOne) DoNothing() *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementCreate.OnConflict
+// documentation for more info.
+func (u *SettlementUpsertOne) Update(set func(*SettlementUpsert)) *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementUpsertOne) SetUpdatedAt(v time.Time) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateUpdatedAt() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SettlementUpsertOne) SetAmount(v domain.Money) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SettlementUpsertOne) AddAmount(v domain.Money) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateAmount() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (u *SettlementUpsertOne) SetFromUserID(v uuid.UUID) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetFromUserID(v)
+	})
+}
+
+// UpdateFromUserID sets the "from_user_id" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateFromUserID() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateFromUserID()
+	})
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (u *SettlementUpsertOne) SetToUserID(v uuid.UUID) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetToUserID(v)
+	})
+}
+
+// UpdateToUserID sets the "to_user_id" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateToUserID() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateToUserID()
+	})
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (u *SettlementUpsertOne) SetSettledAt(v time.Time) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetSettledAt(v)
+	})
+}
+
+// UpdateSettledAt sets the "settled_at" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateSettledAt() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateSettledAt()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *SettlementUpsertOne) SetNote(v string) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateNote() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *SettlementUpsertOne) ClearNote() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SettlementUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SettlementUpsertOne.ID is not supported by MySQL driver. Use SettlementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SettlementUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SettlementCreateBulk is the builder for creating many Settlement entities in bulk.
+type SettlementCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Settlement entities in the database.
+func (_c *SettlementCreateBulk) Save(ctx context.Context) ([]*Settlement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Settlement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettlementCreateBulk) SaveX(ctx context.Context) []*Settlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Settlement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SettlementCreateBulk) OnConflict(opts ...sql.ConflictOption) *SettlementUpsertBulk {
+	_c.conflict = opts
+	return &SettlementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SettlementCreateBulk) OnConflictColumns(columns ...string) *SettlementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SettlementUpsertBulk{
+		create: _c,
+	}
+}
+
+// SettlementUpsertBulk is the builder for "upsert"-ing
+// a bulk of Settlement nodes.
+type SettlementUpsertBulk struct {
+	create *SettlementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementUpsertBulk) UpdateNewValues() *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(settlement.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(settlement.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SettlementUpsertBulk) Ignore() *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementUpsertBulk) DoNothing() *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementCreateBulk.OnConflict
+// documentation for more info.
+func (u *SettlementUpsertBulk) Update(set func(*SettlementUpsert)) *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SettlementUpsertBulk) SetUpdatedAt(v time.Time) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateUpdatedAt() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SettlementUpsertBulk) SetAmount(v domain.Money) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SettlementUpsertBulk) AddAmount(v domain.Money) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateAmount() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (u *SettlementUpsertBulk) SetFromUserID(v uuid.UUID) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetFromUserID(v)
+	})
+}
+
+// UpdateFromUserID sets the "from_user_id" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateFromUserID() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateFromUserID()
+	})
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (u *SettlementUpsertBulk) SetToUserID(v uuid.UUID) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetToUserID(v)
+	})
+}
+
+// UpdateToUserID sets the "to_user_id" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateToUserID() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateToUserID()
+	})
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (u *SettlementUpsertBulk) SetSettledAt(v time.Time) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetSettledAt(v)
+	})
+}
+
+// UpdateSettledAt sets the "settled_at" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateSettledAt() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateSettledAt()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *SettlementUpsertBulk) SetNote(v string) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateNote() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *SettlementUpsertBulk) ClearNote() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SettlementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
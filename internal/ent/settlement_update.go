@@ -0,0 +1,708 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/settlement"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SettlementUpdate is the builder for updating Settlement entities.
+type SettlementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettlementMutation
+}
+
+// Where appends a list predicates to the SettlementUpdate builder.
+func (_u *SettlementUpdate) Where(ps ...predicate.Settlement) *SettlementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementUpdate) SetUpdatedAt(v time.Time) *SettlementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SettlementUpdate) SetAmount(v domain.Money) *SettlementUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableAmount(v *domain.Money) *SettlementUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SettlementUpdate) AddAmount(v domain.Money) *SettlementUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_u *SettlementUpdate) SetFromUserID(v uuid.UUID) *SettlementUpdate {
+	_u.mutation.SetFromUserID(v)
+	return _u
+}
+
+// SetNillableFromUserID sets the "from_user_id" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableFromUserID(v *uuid.UUID) *SettlementUpdate {
+	if v != nil {
+		_u.SetFromUserID(*v)
+	}
+	return _u
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (_u *SettlementUpdate) SetToUserID(v uuid.UUID) *SettlementUpdate {
+	_u.mutation.SetToUserID(v)
+	return _u
+}
+
+// SetNillableToUserID sets the "to_user_id" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableToUserID(v *uuid.UUID) *SettlementUpdate {
+	if v != nil {
+		_u.SetToUserID(*v)
+	}
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *SettlementUpdate) SetSettledAt(v time.Time) *SettlementUpdate {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableSettledAt(v *time.Time) *SettlementUpdate {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *SettlementUpdate) SetNote(v string) *SettlementUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableNote(v *string) *SettlementUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *SettlementUpdate) ClearNote() *SettlementUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *SettlementUpdate) SetLedgerID(id uuid.UUID) *SettlementUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *SettlementUpdate) SetLedger(v *Ledger) *SettlementUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetFromUser sets the "from_user" edge to the User entity.
+func (_u *SettlementUpdate) SetFromUser(v *User) *SettlementUpdate {
+	return _u.SetFromUserID(v.ID)
+}
+
+// SetToUser sets the "to_user" edge to the User entity.
+func (_u *SettlementUpdate) SetToUser(v *User) *SettlementUpdate {
+	return _u.SetToUserID(v.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (_u *SettlementUpdate) Mutation() *SettlementMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *SettlementUpdate) ClearLedger() *SettlementUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearFromUser clears the "from_user" edge to the User entity.
+func (_u *SettlementUpdate) ClearFromUser() *SettlementUpdate {
+	_u.mutation.ClearFromUser()
+	return _u
+}
+
+// ClearToUser clears the "to_user" edge to the User entity.
+func (_u *SettlementUpdate) ClearToUser() *SettlementUpdate {
+	_u.mutation.ClearToUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SettlementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SettlementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementUpdate) check() error {
+	if v, ok := _u.mutation.Note(); ok {
+		if err := settlement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Settlement.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.ledger"`)
+	}
+	if _u.mutation.FromUserCleared() && len(_u.mutation.FromUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.from_user"`)
+	}
+	if _u.mutation.ToUserCleared() && len(_u.mutation.ToUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.to_user"`)
+	}
+	return nil
+}
+
+func (_u *SettlementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(settlement.FieldSettledAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(settlement.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(settlement.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.LedgerTable,
+			Columns: []string{settlement.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.LedgerTable,
+			Columns: []string{settlement.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FromUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.FromUserTable,
+			Columns: []string{settlement.FromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.FromUserTable,
+			Columns: []string{settlement.FromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.ToUserTable,
+			Columns: []string{settlement.ToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.ToUserTable,
+			Columns: []string{settlement.ToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SettlementUpdateOne is the builder for updating a single Settlement entity.
+type SettlementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SettlementUpdateOne) SetUpdatedAt(v time.Time) *SettlementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SettlementUpdateOne) SetAmount(v domain.Money) *SettlementUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableAmount(v *domain.Money) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SettlementUpdateOne) AddAmount(v domain.Money) *SettlementUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_u *SettlementUpdateOne) SetFromUserID(v uuid.UUID) *SettlementUpdateOne {
+	_u.mutation.SetFromUserID(v)
+	return _u
+}
+
+// SetNillableFromUserID sets the "from_user_id" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableFromUserID(v *uuid.UUID) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetFromUserID(*v)
+	}
+	return _u
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (_u *SettlementUpdateOne) SetToUserID(v uuid.UUID) *SettlementUpdateOne {
+	_u.mutation.SetToUserID(v)
+	return _u
+}
+
+// SetNillableToUserID sets the "to_user_id" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableToUserID(v *uuid.UUID) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetToUserID(*v)
+	}
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *SettlementUpdateOne) SetSettledAt(v time.Time) *SettlementUpdateOne {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableSettledAt(v *time.Time) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *SettlementUpdateOne) SetNote(v string) *SettlementUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableNote(v *string) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *SettlementUpdateOne) ClearNote() *SettlementUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *SettlementUpdateOne) SetLedgerID(id uuid.UUID) *SettlementUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *SettlementUpdateOne) SetLedger(v *Ledger) *SettlementUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetFromUser sets the "from_user" edge to the User entity.
+func (_u *SettlementUpdateOne) SetFromUser(v *User) *SettlementUpdateOne {
+	return _u.SetFromUserID(v.ID)
+}
+
+// SetToUser sets the "to_user" edge to the User entity.
+func (_u *SettlementUpdateOne) SetToUser(v *User) *SettlementUpdateOne {
+	return _u.SetToUserID(v.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (_u *SettlementUpdateOne) Mutation() *SettlementMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *SettlementUpdateOne) ClearLedger() *SettlementUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearFromUser clears the "from_user" edge to the User entity.
+func (_u *SettlementUpdateOne) ClearFromUser() *SettlementUpdateOne {
+	_u.mutation.ClearFromUser()
+	return _u
+}
+
+// ClearToUser clears the "to_user" edge to the User entity.
+func (_u *SettlementUpdateOne) ClearToUser() *SettlementUpdateOne {
+	_u.mutation.ClearToUser()
+	return _u
+}
+
+// Where appends a list predicates to the SettlementUpdate builder.
+func (_u *SettlementUpdateOne) Where(ps ...predicate.Settlement) *SettlementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SettlementUpdateOne) Select(field string, fields ...string) *SettlementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Settlement entity.
+func (_u *SettlementUpdateOne) Save(ctx context.Context) (*Settlement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementUpdateOne) SaveX(ctx context.Context) *Settlement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SettlementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SettlementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := settlement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementUpdateOne) check() error {
+	if v, ok := _u.mutation.Note(); ok {
+		if err := settlement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Settlement.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.ledger"`)
+	}
+	if _u.mutation.FromUserCleared() && len(_u.mutation.FromUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.from_user"`)
+	}
+	if _u.mutation.ToUserCleared() && len(_u.mutation.ToUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.to_user"`)
+	}
+	return nil
+}
+
+func (_u *SettlementUpdateOne) sqlSave(ctx context.Context) (_node *Settlement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Settlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlement.FieldID)
+		for _, f := range fields {
+			if !settlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(settlement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(settlement.FieldSettledAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(settlement.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(settlement.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.LedgerTable,
+			Columns: []string{settlement.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.LedgerTable,
+			Columns: []string{settlement.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FromUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.FromUserTable,
+			Columns: []string{settlement.FromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.FromUserTable,
+			Columns: []string{settlement.FromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.ToUserTable,
+			Columns: []string{settlement.ToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   settlement.ToUserTable,
+			Columns: []string{settlement.ToUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Settlement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,8 +4,13 @@ package ent
 
 import (
 	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/category"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/recurringrule"
 	"frog-go/internal/ent/transaction"
 	"frog-go/internal/ent/user"
 	"strings"
@@ -30,42 +35,90 @@ type Transaction struct {
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// Amount holds the value of the "amount" field.
-	Amount float64 `json:"amount,omitempty"`
+	Amount domain.Money `json:"amount,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// RecordDate holds the value of the "record_date" field.
 	RecordDate time.Time `json:"record_date,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate float64 `json:"exchange_rate,omitempty"`
+	// CategorySource holds the value of the "category_source" field.
+	CategorySource *string `json:"category_source,omitempty"`
+	// PaidByID holds the value of the "paid_by_id" field.
+	PaidByID *uuid.UUID `json:"paid_by_id,omitempty"`
+	// ShareMode holds the value of the "share_mode" field.
+	ShareMode *string `json:"share_mode,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *uuid.UUID `json:"created_by_id,omitempty"`
+	// InstallmentNumber holds the value of the "installment_number" field.
+	InstallmentNumber *int `json:"installment_number,omitempty"`
+	// InstallmentCount holds the value of the "installment_count" field.
+	InstallmentCount *int `json:"installment_count,omitempty"`
+	// RecurringRuleID holds the value of the "recurring_rule_id" field.
+	RecurringRuleID *uuid.UUID `json:"recurring_rule_id,omitempty"`
+	// TransferAccountID holds the value of the "transfer_account_id" field.
+	TransferAccountID *uuid.UUID `json:"transfer_account_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransactionQuery when eager-loading is set.
 	Edges        TransactionEdges `json:"edges"`
-	user_id      *uuid.UUID
+	ledger_id    *uuid.UUID
 	invoice_id   *uuid.UUID
 	category_id  *uuid.UUID
+	payee_id     *uuid.UUID
 	selectValues sql.SelectValues
 }
 
 // TransactionEdges holds the relations/edges for other nodes in the graph.
 type TransactionEdges struct {
-	// User holds the value of the user edge.
-	User *User `json:"user,omitempty"`
+	// Ledger holds the value of the ledger edge.
+	Ledger *Ledger `json:"ledger,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
 	// Invoice holds the value of the invoice edge.
 	Invoice *Invoice `json:"invoice,omitempty"`
 	// Category holds the value of the category edge.
 	Category *Category `json:"category,omitempty"`
+	// Payee holds the value of the payee edge.
+	Payee *Payee `json:"payee,omitempty"`
+	// RecurringRule holds the value of the recurring_rule edge.
+	RecurringRule *RecurringRule `json:"recurring_rule,omitempty"`
+	// TransferAccount holds the value of the transfer_account edge.
+	TransferAccount *Account `json:"transfer_account,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Splits holds the value of the splits edge.
+	Splits []*TransactionSplit `json:"splits,omitempty"`
+	// PaidBy holds the value of the paid_by edge.
+	PaidBy *User `json:"paid_by,omitempty"`
+	// Participants holds the value of the participants edge.
+	Participants []*TransactionParticipant `json:"participants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [11]bool
 }
 
-// UserOrErr returns the User value or an error if the edge
+// LedgerOrErr returns the Ledger value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
-func (e TransactionEdges) UserOrErr() (*User, error) {
-	if e.User != nil {
-		return e.User, nil
+func (e TransactionEdges) LedgerOrErr() (*Ledger, error) {
+	if e.Ledger != nil {
+		return e.Ledger, nil
 	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ledger.Label}
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: user.Label}
 	}
-	return nil, &NotLoadedError{edge: "user"}
+	return nil, &NotLoadedError{edge: "created_by"}
 }
 
 // InvoiceOrErr returns the Invoice value or an error if the edge
@@ -73,7 +126,7 @@ func (e TransactionEdges) UserOrErr() (*User, error) {
 func (e TransactionEdges) InvoiceOrErr() (*Invoice, error) {
 	if e.Invoice != nil {
 		return e.Invoice, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: invoice.Label}
 	}
 	return nil, &NotLoadedError{edge: "invoice"}
@@ -84,31 +137,110 @@ func (e TransactionEdges) InvoiceOrErr() (*Invoice, error) {
 func (e TransactionEdges) CategoryOrErr() (*Category, error) {
 	if e.Category != nil {
 		return e.Category, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: category.Label}
 	}
 	return nil, &NotLoadedError{edge: "category"}
 }
 
+// PayeeOrErr returns the Payee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) PayeeOrErr() (*Payee, error) {
+	if e.Payee != nil {
+		return e.Payee, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: payee.Label}
+	}
+	return nil, &NotLoadedError{edge: "payee"}
+}
+
+// RecurringRuleOrErr returns the RecurringRule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) RecurringRuleOrErr() (*RecurringRule, error) {
+	if e.RecurringRule != nil {
+		return e.RecurringRule, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: recurringrule.Label}
+	}
+	return nil, &NotLoadedError{edge: "recurring_rule"}
+}
+
+// TransferAccountOrErr returns the TransferAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) TransferAccountOrErr() (*Account, error) {
+	if e.TransferAccount != nil {
+		return e.TransferAccount, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "transfer_account"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[7] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// SplitsOrErr returns the Splits value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) SplitsOrErr() ([]*TransactionSplit, error) {
+	if e.loadedTypes[8] {
+		return e.Splits, nil
+	}
+	return nil, &NotLoadedError{edge: "splits"}
+}
+
+// PaidByOrErr returns the PaidBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) PaidByOrErr() (*User, error) {
+	if e.PaidBy != nil {
+		return e.PaidBy, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "paid_by"}
+}
+
+// ParticipantsOrErr returns the Participants value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) ParticipantsOrErr() ([]*TransactionParticipant, error) {
+	if e.loadedTypes[10] {
+		return e.Participants, nil
+	}
+	return nil, &NotLoadedError{edge: "participants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case transaction.FieldPaidByID, transaction.FieldCreatedByID, transaction.FieldRecurringRuleID, transaction.FieldTransferAccountID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case transaction.FieldAmount:
+			values[i] = new(domain.Money)
+		case transaction.FieldExchangeRate:
 			values[i] = new(sql.NullFloat64)
-		case transaction.FieldRecordType, transaction.FieldStatus, transaction.FieldTitle:
+		case transaction.FieldInstallmentNumber, transaction.FieldInstallmentCount:
+			values[i] = new(sql.NullInt64)
+		case transaction.FieldRecordType, transaction.FieldStatus, transaction.FieldTitle, transaction.FieldCurrency, transaction.FieldCategorySource, transaction.FieldShareMode:
 			values[i] = new(sql.NullString)
 		case transaction.FieldCreatedAt, transaction.FieldUpdatedAt, transaction.FieldRecordDate:
 			values[i] = new(sql.NullTime)
 		case transaction.FieldID:
 			values[i] = new(uuid.UUID)
-		case transaction.ForeignKeys[0]: // user_id
+		case transaction.ForeignKeys[0]: // ledger_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case transaction.ForeignKeys[1]: // invoice_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case transaction.ForeignKeys[2]: // category_id
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case transaction.ForeignKeys[3]: // payee_id
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -155,10 +287,10 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 				_m.Status = value.String
 			}
 		case transaction.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*domain.Money); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
-			} else if value.Valid {
-				_m.Amount = value.Float64
+			} else if value != nil {
+				_m.Amount = *value
 			}
 		case transaction.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -172,12 +304,80 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RecordDate = value.Time
 			}
+		case transaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case transaction.FieldExchangeRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value.Valid {
+				_m.ExchangeRate = value.Float64
+			}
+		case transaction.FieldCategorySource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category_source", values[i])
+			} else if value.Valid {
+				_m.CategorySource = new(string)
+				*_m.CategorySource = value.String
+			}
+		case transaction.FieldPaidByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_by_id", values[i])
+			} else if value.Valid {
+				_m.PaidByID = new(uuid.UUID)
+				*_m.PaidByID = *value.S.(*uuid.UUID)
+			}
+		case transaction.FieldShareMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_mode", values[i])
+			} else if value.Valid {
+				_m.ShareMode = new(string)
+				*_m.ShareMode = value.String
+			}
+		case transaction.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByID = new(uuid.UUID)
+				*_m.CreatedByID = *value.S.(*uuid.UUID)
+			}
+		case transaction.FieldInstallmentNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field installment_number", values[i])
+			} else if value.Valid {
+				_m.InstallmentNumber = new(int)
+				*_m.InstallmentNumber = int(value.Int64)
+			}
+		case transaction.FieldInstallmentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field installment_count", values[i])
+			} else if value.Valid {
+				_m.InstallmentCount = new(int)
+				*_m.InstallmentCount = int(value.Int64)
+			}
+		case transaction.FieldRecurringRuleID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field recurring_rule_id", values[i])
+			} else if value.Valid {
+				_m.RecurringRuleID = new(uuid.UUID)
+				*_m.RecurringRuleID = *value.S.(*uuid.UUID)
+			}
+		case transaction.FieldTransferAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_account_id", values[i])
+			} else if value.Valid {
+				_m.TransferAccountID = new(uuid.UUID)
+				*_m.TransferAccountID = *value.S.(*uuid.UUID)
+			}
 		case transaction.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
 			} else if value.Valid {
-				_m.user_id = new(uuid.UUID)
-				*_m.user_id = *value.S.(*uuid.UUID)
+				_m.ledger_id = new(uuid.UUID)
+				*_m.ledger_id = *value.S.(*uuid.UUID)
 			}
 		case transaction.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
@@ -193,6 +393,13 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 				_m.category_id = new(uuid.UUID)
 				*_m.category_id = *value.S.(*uuid.UUID)
 			}
+		case transaction.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payee_id", values[i])
+			} else if value.Valid {
+				_m.payee_id = new(uuid.UUID)
+				*_m.payee_id = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -206,9 +413,14 @@ func (_m *Transaction) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryUser queries the "user" edge of the Transaction entity.
-func (_m *Transaction) QueryUser() *UserQuery {
-	return NewTransactionClient(_m.config).QueryUser(_m)
+// QueryLedger queries the "ledger" edge of the Transaction entity.
+func (_m *Transaction) QueryLedger() *LedgerQuery {
+	return NewTransactionClient(_m.config).QueryLedger(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the Transaction entity.
+func (_m *Transaction) QueryCreatedBy() *UserQuery {
+	return NewTransactionClient(_m.config).QueryCreatedBy(_m)
 }
 
 // QueryInvoice queries the "invoice" edge of the Transaction entity.
@@ -221,6 +433,41 @@ func (_m *Transaction) QueryCategory() *CategoryQuery {
 	return NewTransactionClient(_m.config).QueryCategory(_m)
 }
 
+// QueryPayee queries the "payee" edge of the Transaction entity.
+func (_m *Transaction) QueryPayee() *PayeeQuery {
+	return NewTransactionClient(_m.config).QueryPayee(_m)
+}
+
+// QueryRecurringRule queries the "recurring_rule" edge of the Transaction entity.
+func (_m *Transaction) QueryRecurringRule() *RecurringRuleQuery {
+	return NewTransactionClient(_m.config).QueryRecurringRule(_m)
+}
+
+// QueryTransferAccount queries the "transfer_account" edge of the Transaction entity.
+func (_m *Transaction) QueryTransferAccount() *AccountQuery {
+	return NewTransactionClient(_m.config).QueryTransferAccount(_m)
+}
+
+// QueryTags queries the "tags" edge of the Transaction entity.
+func (_m *Transaction) QueryTags() *TagQuery {
+	return NewTransactionClient(_m.config).QueryTags(_m)
+}
+
+// QuerySplits queries the "splits" edge of the Transaction entity.
+func (_m *Transaction) QuerySplits() *TransactionSplitQuery {
+	return NewTransactionClient(_m.config).QuerySplits(_m)
+}
+
+// QueryPaidBy queries the "paid_by" edge of the Transaction entity.
+func (_m *Transaction) QueryPaidBy() *UserQuery {
+	return NewTransactionClient(_m.config).QueryPaidBy(_m)
+}
+
+// QueryParticipants queries the "participants" edge of the Transaction entity.
+func (_m *Transaction) QueryParticipants() *TransactionParticipantQuery {
+	return NewTransactionClient(_m.config).QueryParticipants(_m)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -264,6 +511,52 @@ func (_m *Transaction) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("record_date=")
 	builder.WriteString(_m.RecordDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeRate))
+	builder.WriteString(", ")
+	if v := _m.CategorySource; v != nil {
+		builder.WriteString("category_source=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PaidByID; v != nil {
+		builder.WriteString("paid_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ShareMode; v != nil {
+		builder.WriteString("share_mode=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.InstallmentNumber; v != nil {
+		builder.WriteString("installment_number=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.InstallmentCount; v != nil {
+		builder.WriteString("installment_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RecurringRuleID; v != nil {
+		builder.WriteString("recurring_rule_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TransferAccountID; v != nil {
+		builder.WriteString("transfer_account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
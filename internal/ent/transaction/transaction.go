@@ -29,21 +29,64 @@ const (
 	FieldTitle = "title"
 	// FieldRecordDate holds the string denoting the record_date field in the database.
 	FieldRecordDate = "record_date"
-	// EdgeUser holds the string denoting the user edge name in mutations.
-	EdgeUser = "user"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldCategorySource holds the string denoting the category_source field in the database.
+	FieldCategorySource = "category_source"
+	// FieldPaidByID holds the string denoting the paid_by_id field in the database.
+	FieldPaidByID = "paid_by_id"
+	// FieldShareMode holds the string denoting the share_mode field in the database.
+	FieldShareMode = "share_mode"
+	// FieldCreatedByID holds the string denoting the created_by_id field in the database.
+	FieldCreatedByID = "created_by_id"
+	// FieldInstallmentNumber holds the string denoting the installment_number field in the database.
+	FieldInstallmentNumber = "installment_number"
+	// FieldInstallmentCount holds the string denoting the installment_count field in the database.
+	FieldInstallmentCount = "installment_count"
+	// FieldRecurringRuleID holds the string denoting the recurring_rule_id field in the database.
+	FieldRecurringRuleID = "recurring_rule_id"
+	// FieldTransferAccountID holds the string denoting the transfer_account_id field in the database.
+	FieldTransferAccountID = "transfer_account_id"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeCreatedBy holds the string denoting the created_by edge name in mutations.
+	EdgeCreatedBy = "created_by"
 	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
 	EdgeInvoice = "invoice"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
+	// EdgePayee holds the string denoting the payee edge name in mutations.
+	EdgePayee = "payee"
+	// EdgeRecurringRule holds the string denoting the recurring_rule edge name in mutations.
+	EdgeRecurringRule = "recurring_rule"
+	// EdgeTransferAccount holds the string denoting the transfer_account edge name in mutations.
+	EdgeTransferAccount = "transfer_account"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeSplits holds the string denoting the splits edge name in mutations.
+	EdgeSplits = "splits"
+	// EdgePaidBy holds the string denoting the paid_by edge name in mutations.
+	EdgePaidBy = "paid_by"
+	// EdgeParticipants holds the string denoting the participants edge name in mutations.
+	EdgeParticipants = "participants"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
-	// UserTable is the table that holds the user relation/edge.
-	UserTable = "transactions"
-	// UserInverseTable is the table name for the User entity.
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "transactions"
+	// LedgerInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgerInverseTable = "ledgers"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "ledger_id"
+	// CreatedByTable is the table that holds the created_by relation/edge.
+	CreatedByTable = "transactions"
+	// CreatedByInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
-	UserInverseTable = "users"
-	// UserColumn is the table column denoting the user relation/edge.
-	UserColumn = "user_id"
+	CreatedByInverseTable = "users"
+	// CreatedByColumn is the table column denoting the created_by relation/edge.
+	CreatedByColumn = "created_by_id"
 	// InvoiceTable is the table that holds the invoice relation/edge.
 	InvoiceTable = "transactions"
 	// InvoiceInverseTable is the table name for the Invoice entity.
@@ -58,6 +101,53 @@ const (
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
 	CategoryColumn = "category_id"
+	// PayeeTable is the table that holds the payee relation/edge.
+	PayeeTable = "transactions"
+	// PayeeInverseTable is the table name for the Payee entity.
+	// It exists in this package in order to avoid circular dependency with the "payee" package.
+	PayeeInverseTable = "payees"
+	// PayeeColumn is the table column denoting the payee relation/edge.
+	PayeeColumn = "payee_id"
+	// RecurringRuleTable is the table that holds the recurring_rule relation/edge.
+	RecurringRuleTable = "transactions"
+	// RecurringRuleInverseTable is the table name for the RecurringRule entity.
+	// It exists in this package in order to avoid circular dependency with the "recurringrule" package.
+	RecurringRuleInverseTable = "recurring_rules"
+	// RecurringRuleColumn is the table column denoting the recurring_rule relation/edge.
+	RecurringRuleColumn = "recurring_rule_id"
+	// TransferAccountTable is the table that holds the transfer_account relation/edge.
+	TransferAccountTable = "transactions"
+	// TransferAccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	TransferAccountInverseTable = "accounts"
+	// TransferAccountColumn is the table column denoting the transfer_account relation/edge.
+	TransferAccountColumn = "transfer_account_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "transaction_tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// SplitsTable is the table that holds the splits relation/edge.
+	SplitsTable = "transaction_splits"
+	// SplitsInverseTable is the table name for the TransactionSplit entity.
+	// It exists in this package in order to avoid circular dependency with the "transactionsplit" package.
+	SplitsInverseTable = "transaction_splits"
+	// SplitsColumn is the table column denoting the splits relation/edge.
+	SplitsColumn = "transaction_id"
+	// PaidByTable is the table that holds the paid_by relation/edge.
+	PaidByTable = "transactions"
+	// PaidByInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	PaidByInverseTable = "users"
+	// PaidByColumn is the table column denoting the paid_by relation/edge.
+	PaidByColumn = "paid_by_id"
+	// ParticipantsTable is the table that holds the participants relation/edge.
+	ParticipantsTable = "transaction_participants"
+	// ParticipantsInverseTable is the table name for the TransactionParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "transactionparticipant" package.
+	ParticipantsInverseTable = "transaction_participants"
+	// ParticipantsColumn is the table column denoting the participants relation/edge.
+	ParticipantsColumn = "transaction_id"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -70,16 +160,33 @@ var Columns = []string{
 	FieldAmount,
 	FieldTitle,
 	FieldRecordDate,
+	FieldCurrency,
+	FieldExchangeRate,
+	FieldCategorySource,
+	FieldPaidByID,
+	FieldShareMode,
+	FieldCreatedByID,
+	FieldInstallmentNumber,
+	FieldInstallmentCount,
+	FieldRecurringRuleID,
+	FieldTransferAccountID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "transactions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
-	"user_id",
+	"ledger_id",
 	"invoice_id",
 	"category_id",
+	"payee_id",
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"transaction_id", "tag_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -112,6 +219,22 @@ var (
 	StatusValidator func(string) error
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultExchangeRate holds the default value on creation for the "exchange_rate" field.
+	DefaultExchangeRate float64
+	// ExchangeRateValidator is a validator for the "exchange_rate" field. It is called by the builders before save.
+	ExchangeRateValidator func(float64) error
+	// CategorySourceValidator is a validator for the "category_source" field. It is called by the builders before save.
+	CategorySourceValidator func(string) error
+	// ShareModeValidator is a validator for the "share_mode" field. It is called by the builders before save.
+	ShareModeValidator func(string) error
+	// InstallmentNumberValidator is a validator for the "installment_number" field. It is called by the builders before save.
+	InstallmentNumberValidator func(int) error
+	// InstallmentCountValidator is a validator for the "installment_count" field. It is called by the builders before save.
+	InstallmentCountValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -159,10 +282,67 @@ func ByRecordDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRecordDate, opts...).ToFunc()
 }
 
-// ByUserField orders the results by user field.
-func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
+// ByCategorySource orders the results by the category_source field.
+func ByCategorySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategorySource, opts...).ToFunc()
+}
+
+// ByPaidByID orders the results by the paid_by_id field.
+func ByPaidByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidByID, opts...).ToFunc()
+}
+
+// ByShareMode orders the results by the share_mode field.
+func ByShareMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareMode, opts...).ToFunc()
+}
+
+// ByCreatedByID orders the results by the created_by_id field.
+func ByCreatedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedByID, opts...).ToFunc()
+}
+
+// ByInstallmentNumber orders the results by the installment_number field.
+func ByInstallmentNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstallmentNumber, opts...).ToFunc()
+}
+
+// ByInstallmentCount orders the results by the installment_count field.
+func ByInstallmentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstallmentCount, opts...).ToFunc()
+}
+
+// ByRecurringRuleID orders the results by the recurring_rule_id field.
+func ByRecurringRuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurringRuleID, opts...).ToFunc()
+}
+
+// ByTransferAccountID orders the results by the transfer_account_id field.
+func ByTransferAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferAccountID, opts...).ToFunc()
+}
+
+// ByLedgerField orders the results by ledger field.
+func ByLedgerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreatedByField orders the results by created_by field.
+func ByCreatedByField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborTerms(s, newCreatedByStep(), sql.OrderByField(field, opts...))
 	}
 }
 
@@ -179,11 +359,88 @@ func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
 	}
 }
-func newUserStep() *sqlgraph.Step {
+
+// ByPayeeField orders the results by payee field.
+func ByPayeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecurringRuleField orders the results by recurring_rule field.
+func ByRecurringRuleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurringRuleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransferAccountField orders the results by transfer_account field.
+func ByTransferAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySplitsCount orders the results by splits count.
+func BySplitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSplitsStep(), opts...)
+	}
+}
+
+// BySplits orders the results by splits terms.
+func BySplits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSplitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaidByField orders the results by paid_by field.
+func ByPaidByField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaidByStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParticipantsCount orders the results by participants count.
+func ByParticipantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParticipantsStep(), opts...)
+	}
+}
+
+// ByParticipants orders the results by participants terms.
+func ByParticipants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParticipantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
+	)
+}
+func newCreatedByStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(UserInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		sqlgraph.To(CreatedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
 	)
 }
 func newInvoiceStep() *sqlgraph.Step {
@@ -200,3 +457,52 @@ func newCategoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, CategoryTable, CategoryColumn),
 	)
 }
+func newPayeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PayeeTable, PayeeColumn),
+	)
+}
+func newRecurringRuleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurringRuleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RecurringRuleTable, RecurringRuleColumn),
+	)
+}
+func newTransferAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferAccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TransferAccountTable, TransferAccountColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newSplitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SplitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SplitsTable, SplitsColumn),
+	)
+}
+func newPaidByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaidByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PaidByTable, PaidByColumn),
+	)
+}
+func newParticipantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParticipantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ParticipantsTable, ParticipantsColumn),
+	)
+}
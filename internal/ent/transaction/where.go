@@ -3,6 +3,7 @@
 package transaction
 
 import (
+	"frog-go/internal/core/domain"
 	"frog-go/internal/ent/predicate"
 	"time"
 
@@ -77,7 +78,7 @@ func Status(v string) predicate.Transaction {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v float64) predicate.Transaction {
+func Amount(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
@@ -91,6 +92,56 @@ func RecordDate(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldRecordDate, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// CategorySource applies equality check predicate on the "category_source" field. It's identical to CategorySourceEQ.
+func CategorySource(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCategorySource, v))
+}
+
+// PaidByID applies equality check predicate on the "paid_by_id" field. It's identical to PaidByIDEQ.
+func PaidByID(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPaidByID, v))
+}
+
+// ShareMode applies equality check predicate on the "share_mode" field. It's identical to ShareModeEQ.
+func ShareMode(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldShareMode, v))
+}
+
+// CreatedByID applies equality check predicate on the "created_by_id" field. It's identical to CreatedByIDEQ.
+func CreatedByID(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// InstallmentNumber applies equality check predicate on the "installment_number" field. It's identical to InstallmentNumberEQ.
+func InstallmentNumber(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentCount applies equality check predicate on the "installment_count" field. It's identical to InstallmentCountEQ.
+func InstallmentCount(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInstallmentCount, v))
+}
+
+// RecurringRuleID applies equality check predicate on the "recurring_rule_id" field. It's identical to RecurringRuleIDEQ.
+func RecurringRuleID(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldRecurringRuleID, v))
+}
+
+// TransferAccountID applies equality check predicate on the "transfer_account_id" field. It's identical to TransferAccountIDEQ.
+func TransferAccountID(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferAccountID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -302,42 +353,42 @@ func StatusContainsFold(v string) predicate.Transaction {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v float64) predicate.Transaction {
+func AmountEQ(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v float64) predicate.Transaction {
+func AmountNEQ(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNEQ(FieldAmount, v))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...float64) predicate.Transaction {
+func AmountIn(vs ...domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldIn(FieldAmount, vs...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...float64) predicate.Transaction {
+func AmountNotIn(vs ...domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldAmount, vs...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v float64) predicate.Transaction {
+func AmountGT(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldGT(FieldAmount, v))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v float64) predicate.Transaction {
+func AmountGTE(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldGTE(FieldAmount, v))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v float64) predicate.Transaction {
+func AmountLT(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLT(FieldAmount, v))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v float64) predicate.Transaction {
+func AmountLTE(v domain.Money) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldAmount, v))
 }
 
@@ -446,21 +497,519 @@ func RecordDateLTE(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldRecordDate, v))
 }
 
-// HasUser applies the HasEdge predicate on the "user" edge.
-func HasUser() predicate.Transaction {
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExchangeRate, v))
+}
+
+// CategorySourceEQ applies the EQ predicate on the "category_source" field.
+func CategorySourceEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCategorySource, v))
+}
+
+// CategorySourceNEQ applies the NEQ predicate on the "category_source" field.
+func CategorySourceNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCategorySource, v))
+}
+
+// CategorySourceIn applies the In predicate on the "category_source" field.
+func CategorySourceIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCategorySource, vs...))
+}
+
+// CategorySourceNotIn applies the NotIn predicate on the "category_source" field.
+func CategorySourceNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCategorySource, vs...))
+}
+
+// CategorySourceGT applies the GT predicate on the "category_source" field.
+func CategorySourceGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCategorySource, v))
+}
+
+// CategorySourceGTE applies the GTE predicate on the "category_source" field.
+func CategorySourceGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCategorySource, v))
+}
+
+// CategorySourceLT applies the LT predicate on the "category_source" field.
+func CategorySourceLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCategorySource, v))
+}
+
+// CategorySourceLTE applies the LTE predicate on the "category_source" field.
+func CategorySourceLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCategorySource, v))
+}
+
+// CategorySourceContains applies the Contains predicate on the "category_source" field.
+func CategorySourceContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCategorySource, v))
+}
+
+// CategorySourceHasPrefix applies the HasPrefix predicate on the "category_source" field.
+func CategorySourceHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCategorySource, v))
+}
+
+// CategorySourceHasSuffix applies the HasSuffix predicate on the "category_source" field.
+func CategorySourceHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCategorySource, v))
+}
+
+// CategorySourceIsNil applies the IsNil predicate on the "category_source" field.
+func CategorySourceIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldCategorySource))
+}
+
+// CategorySourceNotNil applies the NotNil predicate on the "category_source" field.
+func CategorySourceNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldCategorySource))
+}
+
+// CategorySourceEqualFold applies the EqualFold predicate on the "category_source" field.
+func CategorySourceEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCategorySource, v))
+}
+
+// CategorySourceContainsFold applies the ContainsFold predicate on the "category_source" field.
+func CategorySourceContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCategorySource, v))
+}
+
+// PaidByIDEQ applies the EQ predicate on the "paid_by_id" field.
+func PaidByIDEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPaidByID, v))
+}
+
+// PaidByIDNEQ applies the NEQ predicate on the "paid_by_id" field.
+func PaidByIDNEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldPaidByID, v))
+}
+
+// PaidByIDIn applies the In predicate on the "paid_by_id" field.
+func PaidByIDIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldPaidByID, vs...))
+}
+
+// PaidByIDNotIn applies the NotIn predicate on the "paid_by_id" field.
+func PaidByIDNotIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldPaidByID, vs...))
+}
+
+// PaidByIDIsNil applies the IsNil predicate on the "paid_by_id" field.
+func PaidByIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldPaidByID))
+}
+
+// PaidByIDNotNil applies the NotNil predicate on the "paid_by_id" field.
+func PaidByIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldPaidByID))
+}
+
+// ShareModeEQ applies the EQ predicate on the "share_mode" field.
+func ShareModeEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldShareMode, v))
+}
+
+// ShareModeNEQ applies the NEQ predicate on the "share_mode" field.
+func ShareModeNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldShareMode, v))
+}
+
+// ShareModeIn applies the In predicate on the "share_mode" field.
+func ShareModeIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldShareMode, vs...))
+}
+
+// ShareModeNotIn applies the NotIn predicate on the "share_mode" field.
+func ShareModeNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldShareMode, vs...))
+}
+
+// ShareModeGT applies the GT predicate on the "share_mode" field.
+func ShareModeGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldShareMode, v))
+}
+
+// ShareModeGTE applies the GTE predicate on the "share_mode" field.
+func ShareModeGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldShareMode, v))
+}
+
+// ShareModeLT applies the LT predicate on the "share_mode" field.
+func ShareModeLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldShareMode, v))
+}
+
+// ShareModeLTE applies the LTE predicate on the "share_mode" field.
+func ShareModeLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldShareMode, v))
+}
+
+// ShareModeContains applies the Contains predicate on the "share_mode" field.
+func ShareModeContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldShareMode, v))
+}
+
+// ShareModeHasPrefix applies the HasPrefix predicate on the "share_mode" field.
+func ShareModeHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldShareMode, v))
+}
+
+// ShareModeHasSuffix applies the HasSuffix predicate on the "share_mode" field.
+func ShareModeHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldShareMode, v))
+}
+
+// ShareModeIsNil applies the IsNil predicate on the "share_mode" field.
+func ShareModeIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldShareMode))
+}
+
+// ShareModeNotNil applies the NotNil predicate on the "share_mode" field.
+func ShareModeNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldShareMode))
+}
+
+// ShareModeEqualFold applies the EqualFold predicate on the "share_mode" field.
+func ShareModeEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldShareMode, v))
+}
+
+// ShareModeContainsFold applies the ContainsFold predicate on the "share_mode" field.
+func ShareModeContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldShareMode, v))
+}
+
+// CreatedByIDEQ applies the EQ predicate on the "created_by_id" field.
+func CreatedByIDEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDNEQ applies the NEQ predicate on the "created_by_id" field.
+func CreatedByIDNEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCreatedByID, v))
+}
+
+// CreatedByIDIn applies the In predicate on the "created_by_id" field.
+func CreatedByIDIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDNotIn applies the NotIn predicate on the "created_by_id" field.
+func CreatedByIDNotIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCreatedByID, vs...))
+}
+
+// CreatedByIDIsNil applies the IsNil predicate on the "created_by_id" field.
+func CreatedByIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldCreatedByID))
+}
+
+// CreatedByIDNotNil applies the NotNil predicate on the "created_by_id" field.
+func CreatedByIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldCreatedByID))
+}
+
+// InstallmentNumberEQ applies the EQ predicate on the "installment_number" field.
+func InstallmentNumberEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberNEQ applies the NEQ predicate on the "installment_number" field.
+func InstallmentNumberNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberIn applies the In predicate on the "installment_number" field.
+func InstallmentNumberIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldInstallmentNumber, vs...))
+}
+
+// InstallmentNumberNotIn applies the NotIn predicate on the "installment_number" field.
+func InstallmentNumberNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldInstallmentNumber, vs...))
+}
+
+// InstallmentNumberGT applies the GT predicate on the "installment_number" field.
+func InstallmentNumberGT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberGTE applies the GTE predicate on the "installment_number" field.
+func InstallmentNumberGTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberLT applies the LT predicate on the "installment_number" field.
+func InstallmentNumberLT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberLTE applies the LTE predicate on the "installment_number" field.
+func InstallmentNumberLTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberIsNil applies the IsNil predicate on the "installment_number" field.
+func InstallmentNumberIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldInstallmentNumber))
+}
+
+// InstallmentNumberNotNil applies the NotNil predicate on the "installment_number" field.
+func InstallmentNumberNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldInstallmentNumber))
+}
+
+// InstallmentCountEQ applies the EQ predicate on the "installment_count" field.
+func InstallmentCountEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInstallmentCount, v))
+}
+
+// InstallmentCountNEQ applies the NEQ predicate on the "installment_count" field.
+func InstallmentCountNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldInstallmentCount, v))
+}
+
+// InstallmentCountIn applies the In predicate on the "installment_count" field.
+func InstallmentCountIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldInstallmentCount, vs...))
+}
+
+// InstallmentCountNotIn applies the NotIn predicate on the "installment_count" field.
+func InstallmentCountNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldInstallmentCount, vs...))
+}
+
+// InstallmentCountGT applies the GT predicate on the "installment_count" field.
+func InstallmentCountGT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldInstallmentCount, v))
+}
+
+// InstallmentCountGTE applies the GTE predicate on the "installment_count" field.
+func InstallmentCountGTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldInstallmentCount, v))
+}
+
+// InstallmentCountLT applies the LT predicate on the "installment_count" field.
+func InstallmentCountLT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldInstallmentCount, v))
+}
+
+// InstallmentCountLTE applies the LTE predicate on the "installment_count" field.
+func InstallmentCountLTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldInstallmentCount, v))
+}
+
+// InstallmentCountIsNil applies the IsNil predicate on the "installment_count" field.
+func InstallmentCountIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldInstallmentCount))
+}
+
+// InstallmentCountNotNil applies the NotNil predicate on the "installment_count" field.
+func InstallmentCountNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldInstallmentCount))
+}
+
+// RecurringRuleIDEQ applies the EQ predicate on the "recurring_rule_id" field.
+func RecurringRuleIDEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldRecurringRuleID, v))
+}
+
+// RecurringRuleIDNEQ applies the NEQ predicate on the "recurring_rule_id" field.
+func RecurringRuleIDNEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldRecurringRuleID, v))
+}
+
+// RecurringRuleIDIn applies the In predicate on the "recurring_rule_id" field.
+func RecurringRuleIDIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldRecurringRuleID, vs...))
+}
+
+// RecurringRuleIDNotIn applies the NotIn predicate on the "recurring_rule_id" field.
+func RecurringRuleIDNotIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldRecurringRuleID, vs...))
+}
+
+// RecurringRuleIDIsNil applies the IsNil predicate on the "recurring_rule_id" field.
+func RecurringRuleIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldRecurringRuleID))
+}
+
+// RecurringRuleIDNotNil applies the NotNil predicate on the "recurring_rule_id" field.
+func RecurringRuleIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldRecurringRuleID))
+}
+
+// TransferAccountIDEQ applies the EQ predicate on the "transfer_account_id" field.
+func TransferAccountIDEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferAccountID, v))
+}
+
+// TransferAccountIDNEQ applies the NEQ predicate on the "transfer_account_id" field.
+func TransferAccountIDNEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldTransferAccountID, v))
+}
+
+// TransferAccountIDIn applies the In predicate on the "transfer_account_id" field.
+func TransferAccountIDIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldTransferAccountID, vs...))
+}
+
+// TransferAccountIDNotIn applies the NotIn predicate on the "transfer_account_id" field.
+func TransferAccountIDNotIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldTransferAccountID, vs...))
+}
+
+// TransferAccountIDIsNil applies the IsNil predicate on the "transfer_account_id" field.
+func TransferAccountIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldTransferAccountID))
+}
+
+// TransferAccountIDNotNil applies the NotNil predicate on the "transfer_account_id" field.
+func TransferAccountIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldTransferAccountID))
+}
+
+// HasLedger applies the HasEdge predicate on the "ledger" edge.
+func HasLedger() predicate.Transaction {
 	return predicate.Transaction(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, LedgerTable, LedgerColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
-func HasUserWith(preds ...predicate.User) predicate.Transaction {
+// HasLedgerWith applies the HasEdge predicate on the "ledger" edge with a given conditions (other predicates).
+func HasLedgerWith(preds ...predicate.Ledger) predicate.Transaction {
 	return predicate.Transaction(func(s *sql.Selector) {
-		step := newUserStep()
+		step := newLedgerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.User) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newCreatedByStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -515,6 +1064,167 @@ func HasCategoryWith(preds ...predicate.Category) predicate.Transaction {
 	})
 }
 
+// HasPayee applies the HasEdge predicate on the "payee" edge.
+func HasPayee() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PayeeTable, PayeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPayeeWith applies the HasEdge predicate on the "payee" edge with a given conditions (other predicates).
+func HasPayeeWith(preds ...predicate.Payee) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newPayeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecurringRule applies the HasEdge predicate on the "recurring_rule" edge.
+func HasRecurringRule() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RecurringRuleTable, RecurringRuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurringRuleWith applies the HasEdge predicate on the "recurring_rule" edge with a given conditions (other predicates).
+func HasRecurringRuleWith(preds ...predicate.RecurringRule) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newRecurringRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransferAccount applies the HasEdge predicate on the "transfer_account" edge.
+func HasTransferAccount() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TransferAccountTable, TransferAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransferAccountWith applies the HasEdge predicate on the "transfer_account" edge with a given conditions (other predicates).
+func HasTransferAccountWith(preds ...predicate.Account) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newTransferAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSplits applies the HasEdge predicate on the "splits" edge.
+func HasSplits() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SplitsTable, SplitsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSplitsWith applies the HasEdge predicate on the "splits" edge with a given conditions (other predicates).
+func HasSplitsWith(preds ...predicate.TransactionSplit) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newSplitsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaidBy applies the HasEdge predicate on the "paid_by" edge.
+func HasPaidBy() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PaidByTable, PaidByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaidByWith applies the HasEdge predicate on the "paid_by" edge with a given conditions (other predicates).
+func HasPaidByWith(preds ...predicate.User) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newPaidByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParticipants applies the HasEdge predicate on the "participants" edge.
+func HasParticipants() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipantsWith applies the HasEdge predicate on the "participants" edge with a given conditions (other predicates).
+func HasParticipantsWith(preds ...predicate.TransactionParticipant) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newParticipantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Transaction) predicate.Transaction {
 	return predicate.Transaction(sql.AndPredicates(predicates...))
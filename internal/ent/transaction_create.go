@@ -6,12 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/category"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/tag"
 	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/transactionsplit"
 	"frog-go/internal/ent/user"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -22,6 +32,7 @@ type TransactionCreate struct {
 	config
 	mutation *TransactionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -81,7 +92,7 @@ func (_c *TransactionCreate) SetNillableStatus(v *string) *TransactionCreate {
 }
 
 // SetAmount sets the "amount" field.
-func (_c *TransactionCreate) SetAmount(v float64) *TransactionCreate {
+func (_c *TransactionCreate) SetAmount(v domain.Money) *TransactionCreate {
 	_c.mutation.SetAmount(v)
 	return _c
 }
@@ -98,6 +109,146 @@ func (_c *TransactionCreate) SetRecordDate(v time.Time) *TransactionCreate {
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *TransactionCreate) SetCurrency(v string) *TransactionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCurrency(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *TransactionCreate) SetExchangeRate(v float64) *TransactionCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableExchangeRate(v *float64) *TransactionCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
+// SetCategorySource sets the "category_source" field.
+func (_c *TransactionCreate) SetCategorySource(v string) *TransactionCreate {
+	_c.mutation.SetCategorySource(v)
+	return _c
+}
+
+// SetNillableCategorySource sets the "category_source" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCategorySource(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetCategorySource(*v)
+	}
+	return _c
+}
+
+// SetPaidByID sets the "paid_by_id" field.
+func (_c *TransactionCreate) SetPaidByID(v uuid.UUID) *TransactionCreate {
+	_c.mutation.SetPaidByID(v)
+	return _c
+}
+
+// SetNillablePaidByID sets the "paid_by_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillablePaidByID(v *uuid.UUID) *TransactionCreate {
+	if v != nil {
+		_c.SetPaidByID(*v)
+	}
+	return _c
+}
+
+// SetShareMode sets the "share_mode" field.
+func (_c *TransactionCreate) SetShareMode(v string) *TransactionCreate {
+	_c.mutation.SetShareMode(v)
+	return _c
+}
+
+// SetNillableShareMode sets the "share_mode" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableShareMode(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetShareMode(*v)
+	}
+	return _c
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (_c *TransactionCreate) SetCreatedByID(v uuid.UUID) *TransactionCreate {
+	_c.mutation.SetCreatedByID(v)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCreatedByID(v *uuid.UUID) *TransactionCreate {
+	if v != nil {
+		_c.SetCreatedByID(*v)
+	}
+	return _c
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (_c *TransactionCreate) SetInstallmentNumber(v int) *TransactionCreate {
+	_c.mutation.SetInstallmentNumber(v)
+	return _c
+}
+
+// SetNillableInstallmentNumber sets the "installment_number" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableInstallmentNumber(v *int) *TransactionCreate {
+	if v != nil {
+		_c.SetInstallmentNumber(*v)
+	}
+	return _c
+}
+
+// SetInstallmentCount sets the "installment_count" field.
+func (_c *TransactionCreate) SetInstallmentCount(v int) *TransactionCreate {
+	_c.mutation.SetInstallmentCount(v)
+	return _c
+}
+
+// SetNillableInstallmentCount sets the "installment_count" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableInstallmentCount(v *int) *TransactionCreate {
+	if v != nil {
+		_c.SetInstallmentCount(*v)
+	}
+	return _c
+}
+
+// SetRecurringRuleID sets the "recurring_rule_id" field.
+func (_c *TransactionCreate) SetRecurringRuleID(v uuid.UUID) *TransactionCreate {
+	_c.mutation.SetRecurringRuleID(v)
+	return _c
+}
+
+// SetNillableRecurringRuleID sets the "recurring_rule_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableRecurringRuleID(v *uuid.UUID) *TransactionCreate {
+	if v != nil {
+		_c.SetRecurringRuleID(*v)
+	}
+	return _c
+}
+
+// SetTransferAccountID sets the "transfer_account_id" field.
+func (_c *TransactionCreate) SetTransferAccountID(v uuid.UUID) *TransactionCreate {
+	_c.mutation.SetTransferAccountID(v)
+	return _c
+}
+
+// SetNillableTransferAccountID sets the "transfer_account_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableTransferAccountID(v *uuid.UUID) *TransactionCreate {
+	if v != nil {
+		_c.SetTransferAccountID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TransactionCreate) SetID(v uuid.UUID) *TransactionCreate {
 	_c.mutation.SetID(v)
@@ -112,15 +263,20 @@ func (_c *TransactionCreate) SetNillableID(v *uuid.UUID) *TransactionCreate {
 	return _c
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_c *TransactionCreate) SetUserID(id uuid.UUID) *TransactionCreate {
-	_c.mutation.SetUserID(id)
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *TransactionCreate) SetLedgerID(id uuid.UUID) *TransactionCreate {
+	_c.mutation.SetLedgerID(id)
 	return _c
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_c *TransactionCreate) SetUser(v *User) *TransactionCreate {
-	return _c.SetUserID(v.ID)
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *TransactionCreate) SetLedger(v *Ledger) *TransactionCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (_c *TransactionCreate) SetCreatedBy(v *User) *TransactionCreate {
+	return _c.SetCreatedByID(v.ID)
 }
 
 // SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
@@ -161,6 +317,85 @@ func (_c *TransactionCreate) SetCategory(v *Category) *TransactionCreate {
 	return _c.SetCategoryID(v.ID)
 }
 
+// SetPayeeID sets the "payee" edge to the Payee entity by ID.
+func (_c *TransactionCreate) SetPayeeID(id uuid.UUID) *TransactionCreate {
+	_c.mutation.SetPayeeID(id)
+	return _c
+}
+
+// SetNillablePayeeID sets the "payee" edge to the Payee entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillablePayeeID(id *uuid.UUID) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetPayeeID(*id)
+	}
+	return _c
+}
+
+// SetPayee sets the "payee" edge to the Payee entity.
+func (_c *TransactionCreate) SetPayee(v *Payee) *TransactionCreate {
+	return _c.SetPayeeID(v.ID)
+}
+
+// SetRecurringRule sets the "recurring_rule" edge to the RecurringRule entity.
+func (_c *TransactionCreate) SetRecurringRule(v *RecurringRule) *TransactionCreate {
+	return _c.SetRecurringRuleID(v.ID)
+}
+
+// SetTransferAccount sets the "transfer_account" edge to the Account entity.
+func (_c *TransactionCreate) SetTransferAccount(v *Account) *TransactionCreate {
+	return _c.SetTransferAccountID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *TransactionCreate) AddTagIDs(ids ...uuid.UUID) *TransactionCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *TransactionCreate) AddTags(v ...*Tag) *TransactionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by IDs.
+func (_c *TransactionCreate) AddSplitIDs(ids ...uuid.UUID) *TransactionCreate {
+	_c.mutation.AddSplitIDs(ids...)
+	return _c
+}
+
+// AddSplits adds the "splits" edges to the TransactionSplit entity.
+func (_c *TransactionCreate) AddSplits(v ...*TransactionSplit) *TransactionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSplitIDs(ids...)
+}
+
+// SetPaidBy sets the "paid_by" edge to the User entity.
+func (_c *TransactionCreate) SetPaidBy(v *User) *TransactionCreate {
+	return _c.SetPaidByID(v.ID)
+}
+
+// AddParticipantIDs adds the "participants" edge to the TransactionParticipant entity by IDs.
+func (_c *TransactionCreate) AddParticipantIDs(ids ...uuid.UUID) *TransactionCreate {
+	_c.mutation.AddParticipantIDs(ids...)
+	return _c
+}
+
+// AddParticipants adds the "participants" edges to the TransactionParticipant entity.
+func (_c *TransactionCreate) AddParticipants(v ...*TransactionParticipant) *TransactionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParticipantIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_c *TransactionCreate) Mutation() *TransactionMutation {
 	return _c.mutation
@@ -212,6 +447,14 @@ func (_c *TransactionCreate) defaults() {
 		v := transaction.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := transaction.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		v := transaction.DefaultExchangeRate
+		_c.mutation.SetExchangeRate(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := transaction.DefaultID()
 		_c.mutation.SetID(v)
@@ -256,8 +499,44 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.RecordDate(); !ok {
 		return &ValidationError{Name: "record_date", err: errors.New(`ent: missing required field "Transaction.record_date"`)}
 	}
-	if len(_c.mutation.UserIDs()) == 0 {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Transaction.user"`)}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Transaction.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExchangeRate(); !ok {
+		return &ValidationError{Name: "exchange_rate", err: errors.New(`ent: missing required field "Transaction.exchange_rate"`)}
+	}
+	if v, ok := _c.mutation.ExchangeRate(); ok {
+		if err := transaction.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "Transaction.exchange_rate": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CategorySource(); ok {
+		if err := transaction.CategorySourceValidator(v); err != nil {
+			return &ValidationError{Name: "category_source", err: fmt.Errorf(`ent: validator failed for field "Transaction.category_source": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ShareMode(); ok {
+		if err := transaction.ShareModeValidator(v); err != nil {
+			return &ValidationError{Name: "share_mode", err: fmt.Errorf(`ent: validator failed for field "Transaction.share_mode": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.InstallmentNumber(); ok {
+		if err := transaction.InstallmentNumberValidator(v); err != nil {
+			return &ValidationError{Name: "installment_number", err: fmt.Errorf(`ent: validator failed for field "Transaction.installment_number": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.InstallmentCount(); ok {
+		if err := transaction.InstallmentCountValidator(v); err != nil {
+			return &ValidationError{Name: "installment_count", err: fmt.Errorf(`ent: validator failed for field "Transaction.installment_count": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Transaction.ledger"`)}
 	}
 	return nil
 }
@@ -290,6 +569,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node = &Transaction{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(transaction.Table, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -311,7 +591,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.Status = value
 	}
 	if value, ok := _c.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
 	if value, ok := _c.mutation.Title(); ok {
@@ -322,12 +602,53 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldRecordDate, field.TypeTime, value)
 		_node.RecordDate = value
 	}
-	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+		_node.ExchangeRate = value
+	}
+	if value, ok := _c.mutation.CategorySource(); ok {
+		_spec.SetField(transaction.FieldCategorySource, field.TypeString, value)
+		_node.CategorySource = &value
+	}
+	if value, ok := _c.mutation.ShareMode(); ok {
+		_spec.SetField(transaction.FieldShareMode, field.TypeString, value)
+		_node.ShareMode = &value
+	}
+	if value, ok := _c.mutation.InstallmentNumber(); ok {
+		_spec.SetField(transaction.FieldInstallmentNumber, field.TypeInt, value)
+		_node.InstallmentNumber = &value
+	}
+	if value, ok := _c.mutation.InstallmentCount(); ok {
+		_spec.SetField(transaction.FieldInstallmentCount, field.TypeInt, value)
+		_node.InstallmentCount = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.LedgerTable,
+			Columns: []string{transaction.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
+			Table:   transaction.CreatedByTable,
+			Columns: []string{transaction.CreatedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
@@ -336,7 +657,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_id = &nodes[0]
+		_node.CreatedByID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.InvoiceIDs(); len(nodes) > 0 {
@@ -373,43 +694,835 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.category_id = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PayeeTable,
+			Columns: []string{transaction.PayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payee_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecurringRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.RecurringRuleTable,
+			Columns: []string{transaction.RecurringRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RecurringRuleID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransferAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.TransferAccountTable,
+			Columns: []string{transaction.TransferAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransferAccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaidByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PaidByTable,
+			Columns: []string{transaction.PaidByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PaidByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
-type TransactionCreateBulk struct {
-	config
-	err      error
-	builders []*TransactionCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionCreate) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertOne {
+	_c.conflict = opts
+	return &TransactionUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Transaction entities in the database.
-func (_c *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionCreate) OnConflictColumns(columns ...string) *TransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Transaction, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*TransactionMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
+}
+
+type (
+	// TransactionUpsertOne is the builder for "upsert"-ing
+	//  one Transaction node.
+	TransactionUpsertOne struct {
+		create *TransactionCreate
+	}
+
+	// TransactionUpsert is the "OnConflict" setter.
+	TransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionUpsert) SetUpdatedAt(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateUpdatedAt() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldUpdatedAt)
+	return u
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *TransactionUpsert) SetRecordType(v string) *TransactionUpsert {
+	u.Set(transaction.FieldRecordType, v)
+	return u
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateRecordType() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldRecordType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsert) SetStatus(v string) *TransactionUpsert {
+	u.Set(transaction.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateStatus() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldStatus)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsert) SetAmount(v domain.Money) *TransactionUpsert {
+	u.Set(transaction.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAmount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsert) AddAmount(v domain.Money) *TransactionUpsert {
+	u.Add(transaction.FieldAmount, v)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *TransactionUpsert) SetTitle(v string) *TransactionUpsert {
+	u.Set(transaction.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateTitle() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldTitle)
+	return u
+}
+
+// SetRecordDate sets the "record_date" field.
+func (u *TransactionUpsert) SetRecordDate(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldRecordDate, v)
+	return u
+}
+
+// UpdateRecordDate sets the "record_date" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateRecordDate() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldRecordDate)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsert) SetCurrency(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCurrency() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCurrency)
+	return u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *TransactionUpsert) SetExchangeRate(v float64) *TransactionUpsert {
+	u.Set(transaction.FieldExchangeRate, v)
+	return u
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateExchangeRate() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldExchangeRate)
+	return u
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *TransactionUpsert) AddExchangeRate(v float64) *TransactionUpsert {
+	u.Add(transaction.FieldExchangeRate, v)
+	return u
+}
+
+// SetCategorySource sets the "category_source" field.
+func (u *TransactionUpsert) SetCategorySource(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCategorySource, v)
+	return u
+}
+
+// UpdateCategorySource sets the "category_source" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCategorySource() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCategorySource)
+	return u
+}
+
+// ClearCategorySource clears the value of the "category_source" field.
+func (u *TransactionUpsert) ClearCategorySource() *TransactionUpsert {
+	u.SetNull(transaction.FieldCategorySource)
+	return u
+}
+
+// SetPaidByID sets the "paid_by_id" field.
+func (u *TransactionUpsert) SetPaidByID(v uuid.UUID) *TransactionUpsert {
+	u.Set(transaction.FieldPaidByID, v)
+	return u
+}
+
+// UpdatePaidByID sets the "paid_by_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdatePaidByID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldPaidByID)
+	return u
+}
+
+// ClearPaidByID clears the value of the "paid_by_id" field.
+func (u *TransactionUpsert) ClearPaidByID() *TransactionUpsert {
+	u.SetNull(transaction.FieldPaidByID)
+	return u
+}
+
+// SetShareMode sets the "share_mode" field.
+func (u *TransactionUpsert) SetShareMode(v string) *TransactionUpsert {
+	u.Set(transaction.FieldShareMode, v)
+	return u
+}
+
+// UpdateShareMode sets the "share_mode" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateShareMode() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldShareMode)
+	return u
+}
+
+// ClearShareMode clears the value of the "share_mode" field.
+func (u *TransactionUpsert) ClearShareMode() *TransactionUpsert {
+	u.SetNull(transaction.FieldShareMode)
+	return u
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (u *TransactionUpsert) SetInstallmentNumber(v int) *TransactionUpsert {
+	u.Set(transaction.FieldInstallmentNumber, v)
+	return u
+}
+
+// UpdateInstallmentNumber sets the "installment_number" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateInstallmentNumber() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldInstallmentNumber)
+	return u
+}
+
+// AddInstallmentNumber adds v to the "installment_number" field.
+func (u *TransactionUpsert) AddInstallmentNumber(v int) *TransactionUpsert {
+	u.Add(transaction.FieldInstallmentNumber, v)
+	return u
+}
+
+// ClearInstallmentNumber clears the value of the "installment_number" field.
+func (u *TransactionUpsert) ClearInstallmentNumber() *TransactionUpsert {
+	u.SetNull(transaction.FieldInstallmentNumber)
+	return u
+}
+
+// SetInstallmentCount sets the "installment_count" field.
+func (u *TransactionUpsert) SetInstallmentCount(v int) *TransactionUpsert {
+	u.Set(transaction.FieldInstallmentCount, v)
+	return u
+}
+
+// UpdateInstallmentCount sets the "installment_count" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateInstallmentCount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldInstallmentCount)
+	return u
+}
+
+// AddInstallmentCount adds v to the "installment_count" field.
+func (u *TransactionUpsert) AddInstallmentCount(v int) *TransactionUpsert {
+	u.Add(transaction.FieldInstallmentCount, v)
+	return u
+}
+
+// ClearInstallmentCount clears the value of the "installment_count" field.
+func (u *TransactionUpsert) ClearInstallmentCount() *TransactionUpsert {
+	u.SetNull(transaction.FieldInstallmentCount)
+	return u
+}
+
+// SetRecurringRuleID sets the "recurring_rule_id" field.
+func (u *TransactionUpsert) SetRecurringRuleID(v uuid.UUID) *TransactionUpsert {
+	u.Set(transaction.FieldRecurringRuleID, v)
+	return u
+}
+
+// UpdateRecurringRuleID sets the "recurring_rule_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateRecurringRuleID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldRecurringRuleID)
+	return u
+}
+
+// ClearRecurringRuleID clears the value of the "recurring_rule_id" field.
+func (u *TransactionUpsert) ClearRecurringRuleID() *TransactionUpsert {
+	u.SetNull(transaction.FieldRecurringRuleID)
+	return u
+}
+
+// SetTransferAccountID sets the "transfer_account_id" field.
+func (u *TransactionUpsert) SetTransferAccountID(v uuid.UUID) *TransactionUpsert {
+	u.Set(transaction.FieldTransferAccountID, v)
+	return u
+}
+
+// UpdateTransferAccountID sets the "transfer_account_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateTransferAccountID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldTransferAccountID)
+	return u
+}
+
+// ClearTransferAccountID clears the value of the "transfer_account_id" field.
+func (u *TransactionUpsert) ClearTransferAccountID() *TransactionUpsert {
+	u.SetNull(transaction.FieldTransferAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertOne) UpdateNewValues() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(transaction.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CreatedByID(); exists {
+			s.SetIgnore(transaction.FieldCreatedByID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransactionUpsertOne) Ignore() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertOne) DoNothing() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreate.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertOne) Update(set func(*TransactionUpsert)) *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionUpsertOne) SetUpdatedAt(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateUpdatedAt() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *TransactionUpsertOne) SetRecordType(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecordType(v)
+	})
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateRecordType() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecordType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertOne) SetStatus(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateStatus() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsertOne) SetAmount(v domain.Money) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsertOne) AddAmount(v domain.Money) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TransactionUpsertOne) SetTitle(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateTitle() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetRecordDate sets the "record_date" field.
+func (u *TransactionUpsertOne) SetRecordDate(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecordDate(v)
+	})
+}
+
+// UpdateRecordDate sets the "record_date" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateRecordDate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecordDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertOne) SetCurrency(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCurrency() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *TransactionUpsertOne) SetExchangeRate(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetExchangeRate(v)
+	})
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *TransactionUpsertOne) AddExchangeRate(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddExchangeRate(v)
+	})
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateExchangeRate() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateExchangeRate()
+	})
+}
+
+// SetCategorySource sets the "category_source" field.
+func (u *TransactionUpsertOne) SetCategorySource(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCategorySource(v)
+	})
+}
+
+// UpdateCategorySource sets the "category_source" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCategorySource() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCategorySource()
+	})
+}
+
+// ClearCategorySource clears the value of the "category_source" field.
+func (u *TransactionUpsertOne) ClearCategorySource() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCategorySource()
+	})
+}
+
+// SetPaidByID sets the "paid_by_id" field.
+func (u *TransactionUpsertOne) SetPaidByID(v uuid.UUID) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPaidByID(v)
+	})
+}
+
+// UpdatePaidByID sets the "paid_by_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdatePaidByID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePaidByID()
+	})
+}
+
+// ClearPaidByID clears the value of the "paid_by_id" field.
+func (u *TransactionUpsertOne) ClearPaidByID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPaidByID()
+	})
+}
+
+// SetShareMode sets the "share_mode" field.
+func (u *TransactionUpsertOne) SetShareMode(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetShareMode(v)
+	})
+}
+
+// UpdateShareMode sets the "share_mode" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateShareMode() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateShareMode()
+	})
+}
+
+// ClearShareMode clears the value of the "share_mode" field.
+func (u *TransactionUpsertOne) ClearShareMode() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearShareMode()
+	})
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (u *TransactionUpsertOne) SetInstallmentNumber(v int) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetInstallmentNumber(v)
+	})
+}
+
+// AddInstallmentNumber adds v to the "installment_number" field.
+func (u *TransactionUpsertOne) AddInstallmentNumber(v int) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddInstallmentNumber(v)
+	})
+}
+
+// UpdateInstallmentNumber sets the "installment_number" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateInstallmentNumber() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateInstallmentNumber()
+	})
+}
+
+// ClearInstallmentNumber clears the value of the "installment_number" field.
+func (u *TransactionUpsertOne) ClearInstallmentNumber() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearInstallmentNumber()
+	})
+}
+
+// SetInstallmentCount sets the "installment_count" field.
+func (u *TransactionUpsertOne) SetInstallmentCount(v int) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetInstallmentCount(v)
+	})
+}
+
+// AddInstallmentCount adds v to the "installment_count" field.
+func (u *TransactionUpsertOne) AddInstallmentCount(v int) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddInstallmentCount(v)
+	})
+}
+
+// UpdateInstallmentCount sets the "installment_count" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateInstallmentCount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateInstallmentCount()
+	})
+}
+
+// ClearInstallmentCount clears the value of the "installment_count" field.
+func (u *TransactionUpsertOne) ClearInstallmentCount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearInstallmentCount()
+	})
+}
+
+// SetRecurringRuleID sets the "recurring_rule_id" field.
+func (u *TransactionUpsertOne) SetRecurringRuleID(v uuid.UUID) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecurringRuleID(v)
+	})
+}
+
+// UpdateRecurringRuleID sets the "recurring_rule_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateRecurringRuleID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecurringRuleID()
+	})
+}
+
+// ClearRecurringRuleID clears the value of the "recurring_rule_id" field.
+func (u *TransactionUpsertOne) ClearRecurringRuleID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearRecurringRuleID()
+	})
+}
+
+// SetTransferAccountID sets the "transfer_account_id" field.
+func (u *TransactionUpsertOne) SetTransferAccountID(v uuid.UUID) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTransferAccountID(v)
+	})
+}
+
+// UpdateTransferAccountID sets the "transfer_account_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateTransferAccountID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTransferAccountID()
+	})
+}
+
+// ClearTransferAccountID clears the value of the "transfer_account_id" field.
+func (u *TransactionUpsertOne) ClearTransferAccountID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearTransferAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TransactionUpsertOne.ID is not supported by MySQL driver. Use TransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
+type TransactionCreateBulk struct {
+	config
+	err      error
+	builders []*TransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Transaction entities in the database.
+func (_c *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Transaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -459,3 +1572,410 @@ func (_c *TransactionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertBulk {
+	_c.conflict = opts
+	return &TransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionCreateBulk) OnConflictColumns(columns ...string) *TransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// TransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Transaction nodes.
+type TransactionUpsertBulk struct {
+	create *TransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) UpdateNewValues() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(transaction.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CreatedByID(); exists {
+				s.SetIgnore(transaction.FieldCreatedByID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) Ignore() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertBulk) DoNothing() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertBulk) Update(set func(*TransactionUpsert)) *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionUpsertBulk) SetUpdatedAt(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateUpdatedAt() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRecordType sets the "record_type" field.
+func (u *TransactionUpsertBulk) SetRecordType(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecordType(v)
+	})
+}
+
+// UpdateRecordType sets the "record_type" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateRecordType() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecordType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertBulk) SetStatus(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateStatus() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsertBulk) SetAmount(v domain.Money) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsertBulk) AddAmount(v domain.Money) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TransactionUpsertBulk) SetTitle(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateTitle() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetRecordDate sets the "record_date" field.
+func (u *TransactionUpsertBulk) SetRecordDate(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecordDate(v)
+	})
+}
+
+// UpdateRecordDate sets the "record_date" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateRecordDate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecordDate()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertBulk) SetCurrency(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCurrency() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *TransactionUpsertBulk) SetExchangeRate(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetExchangeRate(v)
+	})
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *TransactionUpsertBulk) AddExchangeRate(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddExchangeRate(v)
+	})
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateExchangeRate() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateExchangeRate()
+	})
+}
+
+// SetCategorySource sets the "category_source" field.
+func (u *TransactionUpsertBulk) SetCategorySource(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCategorySource(v)
+	})
+}
+
+// UpdateCategorySource sets the "category_source" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCategorySource() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCategorySource()
+	})
+}
+
+// ClearCategorySource clears the value of the "category_source" field.
+func (u *TransactionUpsertBulk) ClearCategorySource() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCategorySource()
+	})
+}
+
+// SetPaidByID sets the "paid_by_id" field.
+func (u *TransactionUpsertBulk) SetPaidByID(v uuid.UUID) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPaidByID(v)
+	})
+}
+
+// UpdatePaidByID sets the "paid_by_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdatePaidByID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePaidByID()
+	})
+}
+
+// ClearPaidByID clears the value of the "paid_by_id" field.
+func (u *TransactionUpsertBulk) ClearPaidByID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPaidByID()
+	})
+}
+
+// SetShareMode sets the "share_mode" field.
+func (u *TransactionUpsertBulk) SetShareMode(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetShareMode(v)
+	})
+}
+
+// UpdateShareMode sets the "share_mode" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateShareMode() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateShareMode()
+	})
+}
+
+// ClearShareMode clears the value of the "share_mode" field.
+func (u *TransactionUpsertBulk) ClearShareMode() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearShareMode()
+	})
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (u *TransactionUpsertBulk) SetInstallmentNumber(v int) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetInstallmentNumber(v)
+	})
+}
+
+// AddInstallmentNumber adds v to the "installment_number" field.
+func (u *TransactionUpsertBulk) AddInstallmentNumber(v int) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddInstallmentNumber(v)
+	})
+}
+
+// UpdateInstallmentNumber sets the "installment_number" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateInstallmentNumber() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateInstallmentNumber()
+	})
+}
+
+// ClearInstallmentNumber clears the value of the "installment_number" field.
+func (u *TransactionUpsertBulk) ClearInstallmentNumber() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearInstallmentNumber()
+	})
+}
+
+// SetInstallmentCount sets the "installment_count" field.
+func (u *TransactionUpsertBulk) SetInstallmentCount(v int) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetInstallmentCount(v)
+	})
+}
+
+// AddInstallmentCount adds v to the "installment_count" field.
+func (u *TransactionUpsertBulk) AddInstallmentCount(v int) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddInstallmentCount(v)
+	})
+}
+
+// UpdateInstallmentCount sets the "installment_count" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateInstallmentCount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateInstallmentCount()
+	})
+}
+
+// ClearInstallmentCount clears the value of the "installment_count" field.
+func (u *TransactionUpsertBulk) ClearInstallmentCount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearInstallmentCount()
+	})
+}
+
+// SetRecurringRuleID sets the "recurring_rule_id" field.
+func (u *TransactionUpsertBulk) SetRecurringRuleID(v uuid.UUID) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetRecurringRuleID(v)
+	})
+}
+
+// UpdateRecurringRuleID sets the "recurring_rule_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateRecurringRuleID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateRecurringRuleID()
+	})
+}
+
+// ClearRecurringRuleID clears the value of the "recurring_rule_id" field.
+func (u *TransactionUpsertBulk) ClearRecurringRuleID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearRecurringRuleID()
+	})
+}
+
+// SetTransferAccountID sets the "transfer_account_id" field.
+func (u *TransactionUpsertBulk) SetTransferAccountID(v uuid.UUID) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTransferAccountID(v)
+	})
+}
+
+// UpdateTransferAccountID sets the "transfer_account_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateTransferAccountID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTransferAccountID()
+	})
+}
+
+// ClearTransferAccountID clears the value of the "transfer_account_id" field.
+func (u *TransactionUpsertBulk) ClearTransferAccountID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearTransferAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
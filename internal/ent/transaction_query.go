@@ -4,11 +4,19 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/category"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
 	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/tag"
 	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/transactionsplit"
 	"frog-go/internal/ent/user"
 	"math"
 
@@ -22,14 +30,22 @@ import (
 // TransactionQuery is the builder for querying Transaction entities.
 type TransactionQuery struct {
 	config
-	ctx          *QueryContext
-	order        []transaction.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Transaction
-	withUser     *UserQuery
-	withInvoice  *InvoiceQuery
-	withCategory *CategoryQuery
-	withFKs      bool
+	ctx                 *QueryContext
+	order               []transaction.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Transaction
+	withLedger          *LedgerQuery
+	withCreatedBy       *UserQuery
+	withInvoice         *InvoiceQuery
+	withCategory        *CategoryQuery
+	withPayee           *PayeeQuery
+	withRecurringRule   *RecurringRuleQuery
+	withTransferAccount *AccountQuery
+	withTags            *TagQuery
+	withSplits          *TransactionSplitQuery
+	withPaidBy          *UserQuery
+	withParticipants    *TransactionParticipantQuery
+	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -66,8 +82,30 @@ func (_q *TransactionQuery) Order(o ...transaction.OrderOption) *TransactionQuer
 	return _q
 }
 
-// QueryUser chains the current query on the "user" edge.
-func (_q *TransactionQuery) QueryUser() *UserQuery {
+// QueryLedger chains the current query on the "ledger" edge.
+func (_q *TransactionQuery) QueryLedger() *LedgerQuery {
+	query := (&LedgerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(ledger.Table, ledger.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, transaction.LedgerTable, transaction.LedgerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedBy chains the current query on the "created_by" edge.
+func (_q *TransactionQuery) QueryCreatedBy() *UserQuery {
 	query := (&UserClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
@@ -80,7 +118,7 @@ func (_q *TransactionQuery) QueryUser() *UserQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, transaction.UserTable, transaction.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, transaction.CreatedByTable, transaction.CreatedByColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -132,6 +170,160 @@ func (_q *TransactionQuery) QueryCategory() *CategoryQuery {
 	return query
 }
 
+// QueryPayee chains the current query on the "payee" edge.
+func (_q *TransactionQuery) QueryPayee() *PayeeQuery {
+	query := (&PayeeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(payee.Table, payee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, transaction.PayeeTable, transaction.PayeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecurringRule chains the current query on the "recurring_rule" edge.
+func (_q *TransactionQuery) QueryRecurringRule() *RecurringRuleQuery {
+	query := (&RecurringRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(recurringrule.Table, recurringrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, transaction.RecurringRuleTable, transaction.RecurringRuleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransferAccount chains the current query on the "transfer_account" edge.
+func (_q *TransactionQuery) QueryTransferAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, transaction.TransferAccountTable, transaction.TransferAccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *TransactionQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, transaction.TagsTable, transaction.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySplits chains the current query on the "splits" edge.
+func (_q *TransactionQuery) QuerySplits() *TransactionSplitQuery {
+	query := (&TransactionSplitClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transactionsplit.Table, transactionsplit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, transaction.SplitsTable, transaction.SplitsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaidBy chains the current query on the "paid_by" edge.
+func (_q *TransactionQuery) QueryPaidBy() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, transaction.PaidByTable, transaction.PaidByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParticipants chains the current query on the "participants" edge.
+func (_q *TransactionQuery) QueryParticipants() *TransactionParticipantQuery {
+	query := (&TransactionParticipantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transactionparticipant.Table, transactionparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, transaction.ParticipantsTable, transaction.ParticipantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (_q *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -319,28 +511,47 @@ func (_q *TransactionQuery) Clone() *TransactionQuery {
 		return nil
 	}
 	return &TransactionQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]transaction.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Transaction{}, _q.predicates...),
-		withUser:     _q.withUser.Clone(),
-		withInvoice:  _q.withInvoice.Clone(),
-		withCategory: _q.withCategory.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]transaction.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Transaction{}, _q.predicates...),
+		withLedger:          _q.withLedger.Clone(),
+		withCreatedBy:       _q.withCreatedBy.Clone(),
+		withInvoice:         _q.withInvoice.Clone(),
+		withCategory:        _q.withCategory.Clone(),
+		withPayee:           _q.withPayee.Clone(),
+		withRecurringRule:   _q.withRecurringRule.Clone(),
+		withTransferAccount: _q.withTransferAccount.Clone(),
+		withTags:            _q.withTags.Clone(),
+		withSplits:          _q.withSplits.Clone(),
+		withPaidBy:          _q.withPaidBy.Clone(),
+		withParticipants:    _q.withParticipants.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
-// WithUser tells the query-builder to eager-load the nodes that are connected to
-// the "user" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TransactionQuery) WithUser(opts ...func(*UserQuery)) *TransactionQuery {
+// WithLedger tells the query-builder to eager-load the nodes that are connected to
+// the "ledger" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithLedger(opts ...func(*LedgerQuery)) *TransactionQuery {
+	query := (&LedgerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLedger = query
+	return _q
+}
+
+// WithCreatedBy tells the query-builder to eager-load the nodes that are connected to
+// the "created_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithCreatedBy(opts ...func(*UserQuery)) *TransactionQuery {
 	query := (&UserClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withUser = query
+	_q.withCreatedBy = query
 	return _q
 }
 
@@ -366,6 +577,83 @@ func (_q *TransactionQuery) WithCategory(opts ...func(*CategoryQuery)) *Transact
 	return _q
 }
 
+// WithPayee tells the query-builder to eager-load the nodes that are connected to
+// the "payee" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithPayee(opts ...func(*PayeeQuery)) *TransactionQuery {
+	query := (&PayeeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayee = query
+	return _q
+}
+
+// WithRecurringRule tells the query-builder to eager-load the nodes that are connected to
+// the "recurring_rule" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithRecurringRule(opts ...func(*RecurringRuleQuery)) *TransactionQuery {
+	query := (&RecurringRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecurringRule = query
+	return _q
+}
+
+// WithTransferAccount tells the query-builder to eager-load the nodes that are connected to
+// the "transfer_account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithTransferAccount(opts ...func(*AccountQuery)) *TransactionQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransferAccount = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithTags(opts ...func(*TagQuery)) *TransactionQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithSplits tells the query-builder to eager-load the nodes that are connected to
+// the "splits" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithSplits(opts ...func(*TransactionSplitQuery)) *TransactionQuery {
+	query := (&TransactionSplitClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSplits = query
+	return _q
+}
+
+// WithPaidBy tells the query-builder to eager-load the nodes that are connected to
+// the "paid_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithPaidBy(opts ...func(*UserQuery)) *TransactionQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaidBy = query
+	return _q
+}
+
+// WithParticipants tells the query-builder to eager-load the nodes that are connected to
+// the "participants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithParticipants(opts ...func(*TransactionParticipantQuery)) *TransactionQuery {
+	query := (&TransactionParticipantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParticipants = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,13 +733,21 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*Transaction{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
-			_q.withUser != nil,
+		loadedTypes = [11]bool{
+			_q.withLedger != nil,
+			_q.withCreatedBy != nil,
 			_q.withInvoice != nil,
 			_q.withCategory != nil,
+			_q.withPayee != nil,
+			_q.withRecurringRule != nil,
+			_q.withTransferAccount != nil,
+			_q.withTags != nil,
+			_q.withSplits != nil,
+			_q.withPaidBy != nil,
+			_q.withParticipants != nil,
 		}
 	)
-	if _q.withUser != nil || _q.withInvoice != nil || _q.withCategory != nil {
+	if _q.withLedger != nil || _q.withInvoice != nil || _q.withCategory != nil || _q.withPayee != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -475,9 +771,15 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withUser; query != nil {
-		if err := _q.loadUser(ctx, query, nodes, nil,
-			func(n *Transaction, e *User) { n.Edges.User = e }); err != nil {
+	if query := _q.withLedger; query != nil {
+		if err := _q.loadLedger(ctx, query, nodes, nil,
+			func(n *Transaction, e *Ledger) { n.Edges.Ledger = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCreatedBy; query != nil {
+		if err := _q.loadCreatedBy(ctx, query, nodes, nil,
+			func(n *Transaction, e *User) { n.Edges.CreatedBy = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -493,17 +795,96 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withPayee; query != nil {
+		if err := _q.loadPayee(ctx, query, nodes, nil,
+			func(n *Transaction, e *Payee) { n.Edges.Payee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecurringRule; query != nil {
+		if err := _q.loadRecurringRule(ctx, query, nodes, nil,
+			func(n *Transaction, e *RecurringRule) { n.Edges.RecurringRule = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransferAccount; query != nil {
+		if err := _q.loadTransferAccount(ctx, query, nodes, nil,
+			func(n *Transaction, e *Account) { n.Edges.TransferAccount = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Tags = []*Tag{} },
+			func(n *Transaction, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSplits; query != nil {
+		if err := _q.loadSplits(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Splits = []*TransactionSplit{} },
+			func(n *Transaction, e *TransactionSplit) { n.Edges.Splits = append(n.Edges.Splits, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaidBy; query != nil {
+		if err := _q.loadPaidBy(ctx, query, nodes, nil,
+			func(n *Transaction, e *User) { n.Edges.PaidBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withParticipants; query != nil {
+		if err := _q.loadParticipants(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Participants = []*TransactionParticipant{} },
+			func(n *Transaction, e *TransactionParticipant) {
+				n.Edges.Participants = append(n.Edges.Participants, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (_q *TransactionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *User)) error {
+func (_q *TransactionQuery) loadLedger(ctx context.Context, query *LedgerQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Ledger)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Transaction)
+	for i := range nodes {
+		if nodes[i].ledger_id == nil {
+			continue
+		}
+		fk := *nodes[i].ledger_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ledger.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "ledger_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadCreatedBy(ctx context.Context, query *UserQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *User)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Transaction)
 	for i := range nodes {
-		if nodes[i].user_id == nil {
+		if nodes[i].CreatedByID == nil {
 			continue
 		}
-		fk := *nodes[i].user_id
+		fk := *nodes[i].CreatedByID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -520,7 +901,7 @@ func (_q *TransactionQuery) loadUser(ctx context.Context, query *UserQuery, node
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "created_by_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -592,6 +973,256 @@ func (_q *TransactionQuery) loadCategory(ctx context.Context, query *CategoryQue
 	}
 	return nil
 }
+func (_q *TransactionQuery) loadPayee(ctx context.Context, query *PayeeQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Payee)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Transaction)
+	for i := range nodes {
+		if nodes[i].payee_id == nil {
+			continue
+		}
+		fk := *nodes[i].payee_id
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(payee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadRecurringRule(ctx context.Context, query *RecurringRuleQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *RecurringRule)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Transaction)
+	for i := range nodes {
+		if nodes[i].RecurringRuleID == nil {
+			continue
+		}
+		fk := *nodes[i].RecurringRuleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(recurringrule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "recurring_rule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadTransferAccount(ctx context.Context, query *AccountQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Transaction)
+	for i := range nodes {
+		if nodes[i].TransferAccountID == nil {
+			continue
+		}
+		fk := *nodes[i].TransferAccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transfer_account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Transaction)
+	nids := make(map[uuid.UUID]map[*Transaction]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(transaction.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(transaction.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(transaction.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(transaction.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Transaction]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadSplits(ctx context.Context, query *TransactionSplitQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *TransactionSplit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TransactionSplit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.SplitsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.transaction_id
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadPaidBy(ctx context.Context, query *UserQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Transaction)
+	for i := range nodes {
+		if nodes[i].PaidByID == nil {
+			continue
+		}
+		fk := *nodes[i].PaidByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "paid_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TransactionQuery) loadParticipants(ctx context.Context, query *TransactionParticipantQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *TransactionParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transactionparticipant.FieldTransactionID)
+	}
+	query.Where(predicate.TransactionParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.ParticipantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TransactionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -618,6 +1249,18 @@ func (_q *TransactionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withCreatedBy != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldCreatedByID)
+		}
+		if _q.withRecurringRule != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldRecurringRuleID)
+		}
+		if _q.withTransferAccount != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldTransferAccountID)
+		}
+		if _q.withPaidBy != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldPaidByID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
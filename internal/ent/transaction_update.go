@@ -6,10 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
 	"frog-go/internal/ent/category"
 	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/payee"
 	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/recurringrule"
+	"frog-go/internal/ent/tag"
 	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/transactionsplit"
 	"frog-go/internal/ent/user"
 	"time"
 
@@ -67,14 +75,14 @@ func (_u *TransactionUpdate) SetNillableStatus(v *string) *TransactionUpdate {
 }
 
 // SetAmount sets the "amount" field.
-func (_u *TransactionUpdate) SetAmount(v float64) *TransactionUpdate {
+func (_u *TransactionUpdate) SetAmount(v domain.Money) *TransactionUpdate {
 	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *TransactionUpdate) SetNillableAmount(v *float64) *TransactionUpdate {
+func (_u *TransactionUpdate) SetNillableAmount(v *domain.Money) *TransactionUpdate {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
@@ -82,7 +90,7 @@ func (_u *TransactionUpdate) SetNillableAmount(v *float64) *TransactionUpdate {
 }
 
 // AddAmount adds value to the "amount" field.
-func (_u *TransactionUpdate) AddAmount(v float64) *TransactionUpdate {
+func (_u *TransactionUpdate) AddAmount(v domain.Money) *TransactionUpdate {
 	_u.mutation.AddAmount(v)
 	return _u
 }
@@ -115,15 +123,204 @@ func (_u *TransactionUpdate) SetNillableRecordDate(v *time.Time) *TransactionUpd
 	return _u
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_u *TransactionUpdate) SetUserID(id uuid.UUID) *TransactionUpdate {
-	_u.mutation.SetUserID(id)
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdate) SetCurrency(v string) *TransactionUpdate {
+	_u.mutation.SetCurrency(v)
 	return _u
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_u *TransactionUpdate) SetUser(v *User) *TransactionUpdate {
-	return _u.SetUserID(v.ID)
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCurrency(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *TransactionUpdate) SetExchangeRate(v float64) *TransactionUpdate {
+	_u.mutation.ResetExchangeRate()
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableExchangeRate(v *float64) *TransactionUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// AddExchangeRate adds value to the "exchange_rate" field.
+func (_u *TransactionUpdate) AddExchangeRate(v float64) *TransactionUpdate {
+	_u.mutation.AddExchangeRate(v)
+	return _u
+}
+
+// SetCategorySource sets the "category_source" field.
+func (_u *TransactionUpdate) SetCategorySource(v string) *TransactionUpdate {
+	_u.mutation.SetCategorySource(v)
+	return _u
+}
+
+// SetNillableCategorySource sets the "category_source" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCategorySource(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetCategorySource(*v)
+	}
+	return _u
+}
+
+// ClearCategorySource clears the value of the "category_source" field.
+func (_u *TransactionUpdate) ClearCategorySource() *TransactionUpdate {
+	_u.mutation.ClearCategorySource()
+	return _u
+}
+
+// SetPaidByID sets the "paid_by_id" field.
+func (_u *TransactionUpdate) SetPaidByID(v uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetPaidByID(v)
+	return _u
+}
+
+// SetNillablePaidByID sets the "paid_by_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePaidByID(v *uuid.UUID) *TransactionUpdate {
+	if v != nil {
+		_u.SetPaidByID(*v)
+	}
+	return _u
+}
+
+// ClearPaidByID clears the value of the "paid_by_id" field.
+func (_u *TransactionUpdate) ClearPaidByID() *TransactionUpdate {
+	_u.mutation.ClearPaidByID()
+	return _u
+}
+
+// SetShareMode sets the "share_mode" field.
+func (_u *TransactionUpdate) SetShareMode(v string) *TransactionUpdate {
+	_u.mutation.SetShareMode(v)
+	return _u
+}
+
+// SetNillableShareMode sets the "share_mode" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableShareMode(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetShareMode(*v)
+	}
+	return _u
+}
+
+// ClearShareMode clears the value of the "share_mode" field.
+func (_u *TransactionUpdate) ClearShareMode() *TransactionUpdate {
+	_u.mutation.ClearShareMode()
+	return _u
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (_u *TransactionUpdate) SetInstallmentNumber(v int) *TransactionUpdate {
+	_u.mutation.ResetInstallmentNumber()
+	_u.mutation.SetInstallmentNumber(v)
+	return _u
+}
+
+// SetNillableInstallmentNumber sets the "installment_number" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableInstallmentNumber(v *int) *TransactionUpdate {
+	if v != nil {
+		_u.SetInstallmentNumber(*v)
+	}
+	return _u
+}
+
+// AddInstallmentNumber adds value to the "installment_number" field.
+func (_u *TransactionUpdate) AddInstallmentNumber(v int) *TransactionUpdate {
+	_u.mutation.AddInstallmentNumber(v)
+	return _u
+}
+
+// ClearInstallmentNumber clears the value of the "installment_number" field.
+func (_u *TransactionUpdate) ClearInstallmentNumber() *TransactionUpdate {
+	_u.mutation.ClearInstallmentNumber()
+	return _u
+}
+
+// SetInstallmentCount sets the "installment_count" field.
+func (_u *TransactionUpdate) SetInstallmentCount(v int) *TransactionUpdate {
+	_u.mutation.ResetInstallmentCount()
+	_u.mutation.SetInstallmentCount(v)
+	return _u
+}
+
+// SetNillableInstallmentCount sets the "installment_count" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableInstallmentCount(v *int) *TransactionUpdate {
+	if v != nil {
+		_u.SetInstallmentCount(*v)
+	}
+	return _u
+}
+
+// AddInstallmentCount adds value to the "installment_count" field.
+func (_u *TransactionUpdate) AddInstallmentCount(v int) *TransactionUpdate {
+	_u.mutation.AddInstallmentCount(v)
+	return _u
+}
+
+// ClearInstallmentCount clears the value of the "installment_count" field.
+func (_u *TransactionUpdate) ClearInstallmentCount() *TransactionUpdate {
+	_u.mutation.ClearInstallmentCount()
+	return _u
+}
+
+// SetRecurringRuleID sets the "recurring_rule_id" field.
+func (_u *TransactionUpdate) SetRecurringRuleID(v uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetRecurringRuleID(v)
+	return _u
+}
+
+// SetNillableRecurringRuleID sets the "recurring_rule_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableRecurringRuleID(v *uuid.UUID) *TransactionUpdate {
+	if v != nil {
+		_u.SetRecurringRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRecurringRuleID clears the value of the "recurring_rule_id" field.
+func (_u *TransactionUpdate) ClearRecurringRuleID() *TransactionUpdate {
+	_u.mutation.ClearRecurringRuleID()
+	return _u
+}
+
+// SetTransferAccountID sets the "transfer_account_id" field.
+func (_u *TransactionUpdate) SetTransferAccountID(v uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetTransferAccountID(v)
+	return _u
+}
+
+// SetNillableTransferAccountID sets the "transfer_account_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableTransferAccountID(v *uuid.UUID) *TransactionUpdate {
+	if v != nil {
+		_u.SetTransferAccountID(*v)
+	}
+	return _u
+}
+
+// ClearTransferAccountID clears the value of the "transfer_account_id" field.
+func (_u *TransactionUpdate) ClearTransferAccountID() *TransactionUpdate {
+	_u.mutation.ClearTransferAccountID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *TransactionUpdate) SetLedgerID(id uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *TransactionUpdate) SetLedger(v *Ledger) *TransactionUpdate {
+	return _u.SetLedgerID(v.ID)
 }
 
 // SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
@@ -164,14 +361,93 @@ func (_u *TransactionUpdate) SetCategory(v *Category) *TransactionUpdate {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetPayeeID sets the "payee" edge to the Payee entity by ID.
+func (_u *TransactionUpdate) SetPayeeID(id uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetPayeeID(id)
+	return _u
+}
+
+// SetNillablePayeeID sets the "payee" edge to the Payee entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePayeeID(id *uuid.UUID) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetPayeeID(*id)
+	}
+	return _u
+}
+
+// SetPayee sets the "payee" edge to the Payee entity.
+func (_u *TransactionUpdate) SetPayee(v *Payee) *TransactionUpdate {
+	return _u.SetPayeeID(v.ID)
+}
+
+// SetRecurringRule sets the "recurring_rule" edge to the RecurringRule entity.
+func (_u *TransactionUpdate) SetRecurringRule(v *RecurringRule) *TransactionUpdate {
+	return _u.SetRecurringRuleID(v.ID)
+}
+
+// SetTransferAccount sets the "transfer_account" edge to the Account entity.
+func (_u *TransactionUpdate) SetTransferAccount(v *Account) *TransactionUpdate {
+	return _u.SetTransferAccountID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TransactionUpdate) AddTagIDs(ids ...uuid.UUID) *TransactionUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TransactionUpdate) AddTags(v ...*Tag) *TransactionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by IDs.
+func (_u *TransactionUpdate) AddSplitIDs(ids ...uuid.UUID) *TransactionUpdate {
+	_u.mutation.AddSplitIDs(ids...)
+	return _u
+}
+
+// AddSplits adds the "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdate) AddSplits(v ...*TransactionSplit) *TransactionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSplitIDs(ids...)
+}
+
+// SetPaidBy sets the "paid_by" edge to the User entity.
+func (_u *TransactionUpdate) SetPaidBy(v *User) *TransactionUpdate {
+	return _u.SetPaidByID(v.ID)
+}
+
+// AddParticipantIDs adds the "participants" edge to the TransactionParticipant entity by IDs.
+func (_u *TransactionUpdate) AddParticipantIDs(ids ...uuid.UUID) *TransactionUpdate {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the TransactionParticipant entity.
+func (_u *TransactionUpdate) AddParticipants(v ...*TransactionParticipant) *TransactionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdate) Mutation() *TransactionMutation {
 	return _u.mutation
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (_u *TransactionUpdate) ClearUser() *TransactionUpdate {
-	_u.mutation.ClearUser()
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *TransactionUpdate) ClearLedger() *TransactionUpdate {
+	_u.mutation.ClearLedger()
 	return _u
 }
 
@@ -187,6 +463,93 @@ func (_u *TransactionUpdate) ClearCategory() *TransactionUpdate {
 	return _u
 }
 
+// ClearPayee clears the "payee" edge to the Payee entity.
+func (_u *TransactionUpdate) ClearPayee() *TransactionUpdate {
+	_u.mutation.ClearPayee()
+	return _u
+}
+
+// ClearRecurringRule clears the "recurring_rule" edge to the RecurringRule entity.
+func (_u *TransactionUpdate) ClearRecurringRule() *TransactionUpdate {
+	_u.mutation.ClearRecurringRule()
+	return _u
+}
+
+// ClearTransferAccount clears the "transfer_account" edge to the Account entity.
+func (_u *TransactionUpdate) ClearTransferAccount() *TransactionUpdate {
+	_u.mutation.ClearTransferAccount()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TransactionUpdate) ClearTags() *TransactionUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TransactionUpdate) RemoveTagIDs(ids ...uuid.UUID) *TransactionUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TransactionUpdate) RemoveTags(v ...*Tag) *TransactionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearSplits clears all "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdate) ClearSplits() *TransactionUpdate {
+	_u.mutation.ClearSplits()
+	return _u
+}
+
+// RemoveSplitIDs removes the "splits" edge to TransactionSplit entities by IDs.
+func (_u *TransactionUpdate) RemoveSplitIDs(ids ...uuid.UUID) *TransactionUpdate {
+	_u.mutation.RemoveSplitIDs(ids...)
+	return _u
+}
+
+// RemoveSplits removes "splits" edges to TransactionSplit entities.
+func (_u *TransactionUpdate) RemoveSplits(v ...*TransactionSplit) *TransactionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSplitIDs(ids...)
+}
+
+// ClearPaidBy clears the "paid_by" edge to the User entity.
+func (_u *TransactionUpdate) ClearPaidBy() *TransactionUpdate {
+	_u.mutation.ClearPaidBy()
+	return _u
+}
+
+// ClearParticipants clears all "participants" edges to the TransactionParticipant entity.
+func (_u *TransactionUpdate) ClearParticipants() *TransactionUpdate {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to TransactionParticipant entities by IDs.
+func (_u *TransactionUpdate) RemoveParticipantIDs(ids ...uuid.UUID) *TransactionUpdate {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to TransactionParticipant entities.
+func (_u *TransactionUpdate) RemoveParticipants(v ...*TransactionParticipant) *TransactionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -240,8 +603,38 @@ func (_u *TransactionUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Transaction.title": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Transaction.user"`)
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExchangeRate(); ok {
+		if err := transaction.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "Transaction.exchange_rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategorySource(); ok {
+		if err := transaction.CategorySourceValidator(v); err != nil {
+			return &ValidationError{Name: "category_source", err: fmt.Errorf(`ent: validator failed for field "Transaction.category_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShareMode(); ok {
+		if err := transaction.ShareModeValidator(v); err != nil {
+			return &ValidationError{Name: "share_mode", err: fmt.Errorf(`ent: validator failed for field "Transaction.share_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InstallmentNumber(); ok {
+		if err := transaction.InstallmentNumberValidator(v); err != nil {
+			return &ValidationError{Name: "installment_number", err: fmt.Errorf(`ent: validator failed for field "Transaction.installment_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InstallmentCount(); ok {
+		if err := transaction.InstallmentCountValidator(v); err != nil {
+			return &ValidationError{Name: "installment_count", err: fmt.Errorf(`ent: validator failed for field "Transaction.installment_count": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transaction.ledger"`)
 	}
 	return nil
 }
@@ -268,10 +661,10 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		_spec.SetField(transaction.FieldStatus, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedAmount(); ok {
-		_spec.AddField(transaction.FieldAmount, field.TypeFloat64, value)
+		_spec.AddField(transaction.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(transaction.FieldTitle, field.TypeString, value)
@@ -279,28 +672,67 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.RecordDate(); ok {
 		_spec.SetField(transaction.FieldRecordDate, field.TypeTime, value)
 	}
-	if _u.mutation.UserCleared() {
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExchangeRate(); ok {
+		_spec.AddField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CategorySource(); ok {
+		_spec.SetField(transaction.FieldCategorySource, field.TypeString, value)
+	}
+	if _u.mutation.CategorySourceCleared() {
+		_spec.ClearField(transaction.FieldCategorySource, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShareMode(); ok {
+		_spec.SetField(transaction.FieldShareMode, field.TypeString, value)
+	}
+	if _u.mutation.ShareModeCleared() {
+		_spec.ClearField(transaction.FieldShareMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstallmentNumber(); ok {
+		_spec.SetField(transaction.FieldInstallmentNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstallmentNumber(); ok {
+		_spec.AddField(transaction.FieldInstallmentNumber, field.TypeInt, value)
+	}
+	if _u.mutation.InstallmentNumberCleared() {
+		_spec.ClearField(transaction.FieldInstallmentNumber, field.TypeInt)
+	}
+	if value, ok := _u.mutation.InstallmentCount(); ok {
+		_spec.SetField(transaction.FieldInstallmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstallmentCount(); ok {
+		_spec.AddField(transaction.FieldInstallmentCount, field.TypeInt, value)
+	}
+	if _u.mutation.InstallmentCountCleared() {
+		_spec.ClearField(transaction.FieldInstallmentCount, field.TypeInt)
+	}
+	if _u.mutation.LedgerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
+			Table:   transaction.LedgerTable,
+			Columns: []string{transaction.LedgerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
+			Table:   transaction.LedgerTable,
+			Columns: []string{transaction.LedgerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -366,45 +798,296 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{transaction.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.PayeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PayeeTable,
+			Columns: []string{transaction.PayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// TransactionUpdateOne is the builder for updating a single Transaction entity.
-type TransactionUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TransactionMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TransactionUpdateOne) SetUpdatedAt(v time.Time) *TransactionUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetRecordType sets the "record_type" field.
-func (_u *TransactionUpdateOne) SetRecordType(v string) *TransactionUpdateOne {
-	_u.mutation.SetRecordType(v)
-	return _u
-}
-
-// SetNillableRecordType sets the "record_type" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableRecordType(v *string) *TransactionUpdateOne {
-	if v != nil {
-		_u.SetRecordType(*v)
+	if nodes := _u.mutation.PayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PayeeTable,
+			Columns: []string{transaction.PayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
+	if _u.mutation.RecurringRuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.RecurringRuleTable,
+			Columns: []string{transaction.RecurringRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurringRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.RecurringRuleTable,
+			Columns: []string{transaction.RecurringRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.TransferAccountTable,
+			Columns: []string{transaction.TransferAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.TransferAccountTable,
+			Columns: []string{transaction.TransferAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSplitsIDs(); len(nodes) > 0 && !_u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaidByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PaidByTable,
+			Columns: []string{transaction.PaidByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaidByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PaidByTable,
+			Columns: []string{transaction.PaidByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransactionUpdateOne is the builder for updating a single Transaction entity.
+type TransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransactionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionUpdateOne) SetUpdatedAt(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRecordType sets the "record_type" field.
+func (_u *TransactionUpdateOne) SetRecordType(v string) *TransactionUpdateOne {
+	_u.mutation.SetRecordType(v)
+	return _u
+}
+
+// SetNillableRecordType sets the "record_type" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRecordType(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetRecordType(*v)
+	}
+	return _u
+}
 
 // SetStatus sets the "status" field.
 func (_u *TransactionUpdateOne) SetStatus(v string) *TransactionUpdateOne {
@@ -421,14 +1104,14 @@ func (_u *TransactionUpdateOne) SetNillableStatus(v *string) *TransactionUpdateO
 }
 
 // SetAmount sets the "amount" field.
-func (_u *TransactionUpdateOne) SetAmount(v float64) *TransactionUpdateOne {
+func (_u *TransactionUpdateOne) SetAmount(v domain.Money) *TransactionUpdateOne {
 	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableAmount(v *float64) *TransactionUpdateOne {
+func (_u *TransactionUpdateOne) SetNillableAmount(v *domain.Money) *TransactionUpdateOne {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
@@ -436,109 +1119,464 @@ func (_u *TransactionUpdateOne) SetNillableAmount(v *float64) *TransactionUpdate
 }
 
 // AddAmount adds value to the "amount" field.
-func (_u *TransactionUpdateOne) AddAmount(v float64) *TransactionUpdateOne {
+func (_u *TransactionUpdateOne) AddAmount(v domain.Money) *TransactionUpdateOne {
 	_u.mutation.AddAmount(v)
 	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *TransactionUpdateOne) SetTitle(v string) *TransactionUpdateOne {
-	_u.mutation.SetTitle(v)
+// SetTitle sets the "title" field.
+func (_u *TransactionUpdateOne) SetTitle(v string) *TransactionUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableTitle(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetRecordDate sets the "record_date" field.
+func (_u *TransactionUpdateOne) SetRecordDate(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetRecordDate(v)
+	return _u
+}
+
+// SetNillableRecordDate sets the "record_date" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRecordDate(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetRecordDate(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdateOne) SetCurrency(v string) *TransactionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCurrency(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *TransactionUpdateOne) SetExchangeRate(v float64) *TransactionUpdateOne {
+	_u.mutation.ResetExchangeRate()
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableExchangeRate(v *float64) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// AddExchangeRate adds value to the "exchange_rate" field.
+func (_u *TransactionUpdateOne) AddExchangeRate(v float64) *TransactionUpdateOne {
+	_u.mutation.AddExchangeRate(v)
+	return _u
+}
+
+// SetCategorySource sets the "category_source" field.
+func (_u *TransactionUpdateOne) SetCategorySource(v string) *TransactionUpdateOne {
+	_u.mutation.SetCategorySource(v)
+	return _u
+}
+
+// SetNillableCategorySource sets the "category_source" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCategorySource(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCategorySource(*v)
+	}
+	return _u
+}
+
+// ClearCategorySource clears the value of the "category_source" field.
+func (_u *TransactionUpdateOne) ClearCategorySource() *TransactionUpdateOne {
+	_u.mutation.ClearCategorySource()
+	return _u
+}
+
+// SetPaidByID sets the "paid_by_id" field.
+func (_u *TransactionUpdateOne) SetPaidByID(v uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetPaidByID(v)
+	return _u
+}
+
+// SetNillablePaidByID sets the "paid_by_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePaidByID(v *uuid.UUID) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetPaidByID(*v)
+	}
+	return _u
+}
+
+// ClearPaidByID clears the value of the "paid_by_id" field.
+func (_u *TransactionUpdateOne) ClearPaidByID() *TransactionUpdateOne {
+	_u.mutation.ClearPaidByID()
+	return _u
+}
+
+// SetShareMode sets the "share_mode" field.
+func (_u *TransactionUpdateOne) SetShareMode(v string) *TransactionUpdateOne {
+	_u.mutation.SetShareMode(v)
+	return _u
+}
+
+// SetNillableShareMode sets the "share_mode" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableShareMode(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetShareMode(*v)
+	}
+	return _u
+}
+
+// ClearShareMode clears the value of the "share_mode" field.
+func (_u *TransactionUpdateOne) ClearShareMode() *TransactionUpdateOne {
+	_u.mutation.ClearShareMode()
+	return _u
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (_u *TransactionUpdateOne) SetInstallmentNumber(v int) *TransactionUpdateOne {
+	_u.mutation.ResetInstallmentNumber()
+	_u.mutation.SetInstallmentNumber(v)
+	return _u
+}
+
+// SetNillableInstallmentNumber sets the "installment_number" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableInstallmentNumber(v *int) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetInstallmentNumber(*v)
+	}
+	return _u
+}
+
+// AddInstallmentNumber adds value to the "installment_number" field.
+func (_u *TransactionUpdateOne) AddInstallmentNumber(v int) *TransactionUpdateOne {
+	_u.mutation.AddInstallmentNumber(v)
+	return _u
+}
+
+// ClearInstallmentNumber clears the value of the "installment_number" field.
+func (_u *TransactionUpdateOne) ClearInstallmentNumber() *TransactionUpdateOne {
+	_u.mutation.ClearInstallmentNumber()
+	return _u
+}
+
+// SetInstallmentCount sets the "installment_count" field.
+func (_u *TransactionUpdateOne) SetInstallmentCount(v int) *TransactionUpdateOne {
+	_u.mutation.ResetInstallmentCount()
+	_u.mutation.SetInstallmentCount(v)
+	return _u
+}
+
+// SetNillableInstallmentCount sets the "installment_count" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableInstallmentCount(v *int) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetInstallmentCount(*v)
+	}
+	return _u
+}
+
+// AddInstallmentCount adds value to the "installment_count" field.
+func (_u *TransactionUpdateOne) AddInstallmentCount(v int) *TransactionUpdateOne {
+	_u.mutation.AddInstallmentCount(v)
+	return _u
+}
+
+// ClearInstallmentCount clears the value of the "installment_count" field.
+func (_u *TransactionUpdateOne) ClearInstallmentCount() *TransactionUpdateOne {
+	_u.mutation.ClearInstallmentCount()
+	return _u
+}
+
+// SetRecurringRuleID sets the "recurring_rule_id" field.
+func (_u *TransactionUpdateOne) SetRecurringRuleID(v uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetRecurringRuleID(v)
+	return _u
+}
+
+// SetNillableRecurringRuleID sets the "recurring_rule_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRecurringRuleID(v *uuid.UUID) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetRecurringRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRecurringRuleID clears the value of the "recurring_rule_id" field.
+func (_u *TransactionUpdateOne) ClearRecurringRuleID() *TransactionUpdateOne {
+	_u.mutation.ClearRecurringRuleID()
+	return _u
+}
+
+// SetTransferAccountID sets the "transfer_account_id" field.
+func (_u *TransactionUpdateOne) SetTransferAccountID(v uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetTransferAccountID(v)
+	return _u
+}
+
+// SetNillableTransferAccountID sets the "transfer_account_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableTransferAccountID(v *uuid.UUID) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetTransferAccountID(*v)
+	}
+	return _u
+}
+
+// ClearTransferAccountID clears the value of the "transfer_account_id" field.
+func (_u *TransactionUpdateOne) ClearTransferAccountID() *TransactionUpdateOne {
+	_u.mutation.ClearTransferAccountID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *TransactionUpdateOne) SetLedgerID(id uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *TransactionUpdateOne) SetLedger(v *Ledger) *TransactionUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
+func (_u *TransactionUpdateOne) SetInvoiceID(id uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetInvoiceID(id)
+	return _u
+}
+
+// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableInvoiceID(id *uuid.UUID) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetInvoiceID(*id)
+	}
+	return _u
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (_u *TransactionUpdateOne) SetInvoice(v *Invoice) *TransactionUpdateOne {
+	return _u.SetInvoiceID(v.ID)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (_u *TransactionUpdateOne) SetCategoryID(id uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetCategoryID(id)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCategoryID(id *uuid.UUID) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetCategoryID(*id)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionUpdateOne) SetCategory(v *Category) *TransactionUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetPayeeID sets the "payee" edge to the Payee entity by ID.
+func (_u *TransactionUpdateOne) SetPayeeID(id uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetPayeeID(id)
+	return _u
+}
+
+// SetNillablePayeeID sets the "payee" edge to the Payee entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePayeeID(id *uuid.UUID) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetPayeeID(*id)
+	}
+	return _u
+}
+
+// SetPayee sets the "payee" edge to the Payee entity.
+func (_u *TransactionUpdateOne) SetPayee(v *Payee) *TransactionUpdateOne {
+	return _u.SetPayeeID(v.ID)
+}
+
+// SetRecurringRule sets the "recurring_rule" edge to the RecurringRule entity.
+func (_u *TransactionUpdateOne) SetRecurringRule(v *RecurringRule) *TransactionUpdateOne {
+	return _u.SetRecurringRuleID(v.ID)
+}
+
+// SetTransferAccount sets the "transfer_account" edge to the Account entity.
+func (_u *TransactionUpdateOne) SetTransferAccount(v *Account) *TransactionUpdateOne {
+	return _u.SetTransferAccountID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TransactionUpdateOne) AddTagIDs(ids ...uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TransactionUpdateOne) AddTags(v ...*Tag) *TransactionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by IDs.
+func (_u *TransactionUpdateOne) AddSplitIDs(ids ...uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.AddSplitIDs(ids...)
+	return _u
+}
+
+// AddSplits adds the "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdateOne) AddSplits(v ...*TransactionSplit) *TransactionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSplitIDs(ids...)
+}
+
+// SetPaidBy sets the "paid_by" edge to the User entity.
+func (_u *TransactionUpdateOne) SetPaidBy(v *User) *TransactionUpdateOne {
+	return _u.SetPaidByID(v.ID)
+}
+
+// AddParticipantIDs adds the "participants" edge to the TransactionParticipant entity by IDs.
+func (_u *TransactionUpdateOne) AddParticipantIDs(ids ...uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the TransactionParticipant entity.
+func (_u *TransactionUpdateOne) AddParticipants(v ...*TransactionParticipant) *TransactionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// Mutation returns the TransactionMutation object of the builder.
+func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *TransactionUpdateOne) ClearLedger() *TransactionUpdateOne {
+	_u.mutation.ClearLedger()
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableTitle(v *string) *TransactionUpdateOne {
-	if v != nil {
-		_u.SetTitle(*v)
-	}
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (_u *TransactionUpdateOne) ClearInvoice() *TransactionUpdateOne {
+	_u.mutation.ClearInvoice()
 	return _u
 }
 
-// SetRecordDate sets the "record_date" field.
-func (_u *TransactionUpdateOne) SetRecordDate(v time.Time) *TransactionUpdateOne {
-	_u.mutation.SetRecordDate(v)
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionUpdateOne) ClearCategory() *TransactionUpdateOne {
+	_u.mutation.ClearCategory()
 	return _u
 }
 
-// SetNillableRecordDate sets the "record_date" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableRecordDate(v *time.Time) *TransactionUpdateOne {
-	if v != nil {
-		_u.SetRecordDate(*v)
-	}
+// ClearPayee clears the "payee" edge to the Payee entity.
+func (_u *TransactionUpdateOne) ClearPayee() *TransactionUpdateOne {
+	_u.mutation.ClearPayee()
 	return _u
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (_u *TransactionUpdateOne) SetUserID(id uuid.UUID) *TransactionUpdateOne {
-	_u.mutation.SetUserID(id)
+// ClearRecurringRule clears the "recurring_rule" edge to the RecurringRule entity.
+func (_u *TransactionUpdateOne) ClearRecurringRule() *TransactionUpdateOne {
+	_u.mutation.ClearRecurringRule()
 	return _u
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (_u *TransactionUpdateOne) SetUser(v *User) *TransactionUpdateOne {
-	return _u.SetUserID(v.ID)
+// ClearTransferAccount clears the "transfer_account" edge to the Account entity.
+func (_u *TransactionUpdateOne) ClearTransferAccount() *TransactionUpdateOne {
+	_u.mutation.ClearTransferAccount()
+	return _u
 }
 
-// SetInvoiceID sets the "invoice" edge to the Invoice entity by ID.
-func (_u *TransactionUpdateOne) SetInvoiceID(id uuid.UUID) *TransactionUpdateOne {
-	_u.mutation.SetInvoiceID(id)
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TransactionUpdateOne) ClearTags() *TransactionUpdateOne {
+	_u.mutation.ClearTags()
 	return _u
 }
 
-// SetNillableInvoiceID sets the "invoice" edge to the Invoice entity by ID if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableInvoiceID(id *uuid.UUID) *TransactionUpdateOne {
-	if id != nil {
-		_u = _u.SetInvoiceID(*id)
-	}
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TransactionUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
 	return _u
 }
 
-// SetInvoice sets the "invoice" edge to the Invoice entity.
-func (_u *TransactionUpdateOne) SetInvoice(v *Invoice) *TransactionUpdateOne {
-	return _u.SetInvoiceID(v.ID)
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TransactionUpdateOne) RemoveTags(v ...*Tag) *TransactionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by ID.
-func (_u *TransactionUpdateOne) SetCategoryID(id uuid.UUID) *TransactionUpdateOne {
-	_u.mutation.SetCategoryID(id)
+// ClearSplits clears all "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdateOne) ClearSplits() *TransactionUpdateOne {
+	_u.mutation.ClearSplits()
 	return _u
 }
 
-// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableCategoryID(id *uuid.UUID) *TransactionUpdateOne {
-	if id != nil {
-		_u = _u.SetCategoryID(*id)
-	}
+// RemoveSplitIDs removes the "splits" edge to TransactionSplit entities by IDs.
+func (_u *TransactionUpdateOne) RemoveSplitIDs(ids ...uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.RemoveSplitIDs(ids...)
 	return _u
 }
 
-// SetCategory sets the "category" edge to the Category entity.
-func (_u *TransactionUpdateOne) SetCategory(v *Category) *TransactionUpdateOne {
-	return _u.SetCategoryID(v.ID)
+// RemoveSplits removes "splits" edges to TransactionSplit entities.
+func (_u *TransactionUpdateOne) RemoveSplits(v ...*TransactionSplit) *TransactionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSplitIDs(ids...)
 }
 
-// Mutation returns the TransactionMutation object of the builder.
-func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
-	return _u.mutation
+// ClearPaidBy clears the "paid_by" edge to the User entity.
+func (_u *TransactionUpdateOne) ClearPaidBy() *TransactionUpdateOne {
+	_u.mutation.ClearPaidBy()
+	return _u
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (_u *TransactionUpdateOne) ClearUser() *TransactionUpdateOne {
-	_u.mutation.ClearUser()
+// ClearParticipants clears all "participants" edges to the TransactionParticipant entity.
+func (_u *TransactionUpdateOne) ClearParticipants() *TransactionUpdateOne {
+	_u.mutation.ClearParticipants()
 	return _u
 }
 
-// ClearInvoice clears the "invoice" edge to the Invoice entity.
-func (_u *TransactionUpdateOne) ClearInvoice() *TransactionUpdateOne {
-	_u.mutation.ClearInvoice()
+// RemoveParticipantIDs removes the "participants" edge to TransactionParticipant entities by IDs.
+func (_u *TransactionUpdateOne) RemoveParticipantIDs(ids ...uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.RemoveParticipantIDs(ids...)
 	return _u
 }
 
-// ClearCategory clears the "category" edge to the Category entity.
-func (_u *TransactionUpdateOne) ClearCategory() *TransactionUpdateOne {
-	_u.mutation.ClearCategory()
-	return _u
+// RemoveParticipants removes "participants" edges to TransactionParticipant entities.
+func (_u *TransactionUpdateOne) RemoveParticipants(v ...*TransactionParticipant) *TransactionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
 }
 
 // Where appends a list predicates to the TransactionUpdate builder.
@@ -607,8 +1645,38 @@ func (_u *TransactionUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Transaction.title": %w`, err)}
 		}
 	}
-	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Transaction.user"`)
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExchangeRate(); ok {
+		if err := transaction.ExchangeRateValidator(v); err != nil {
+			return &ValidationError{Name: "exchange_rate", err: fmt.Errorf(`ent: validator failed for field "Transaction.exchange_rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CategorySource(); ok {
+		if err := transaction.CategorySourceValidator(v); err != nil {
+			return &ValidationError{Name: "category_source", err: fmt.Errorf(`ent: validator failed for field "Transaction.category_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ShareMode(); ok {
+		if err := transaction.ShareModeValidator(v); err != nil {
+			return &ValidationError{Name: "share_mode", err: fmt.Errorf(`ent: validator failed for field "Transaction.share_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InstallmentNumber(); ok {
+		if err := transaction.InstallmentNumberValidator(v); err != nil {
+			return &ValidationError{Name: "installment_number", err: fmt.Errorf(`ent: validator failed for field "Transaction.installment_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InstallmentCount(); ok {
+		if err := transaction.InstallmentCountValidator(v); err != nil {
+			return &ValidationError{Name: "installment_count", err: fmt.Errorf(`ent: validator failed for field "Transaction.installment_count": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transaction.ledger"`)
 	}
 	return nil
 }
@@ -652,10 +1720,10 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		_spec.SetField(transaction.FieldStatus, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.AddedAmount(); ok {
-		_spec.AddField(transaction.FieldAmount, field.TypeFloat64, value)
+		_spec.AddField(transaction.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(transaction.FieldTitle, field.TypeString, value)
@@ -663,28 +1731,67 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.RecordDate(); ok {
 		_spec.SetField(transaction.FieldRecordDate, field.TypeTime, value)
 	}
-	if _u.mutation.UserCleared() {
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExchangeRate(); ok {
+		_spec.AddField(transaction.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CategorySource(); ok {
+		_spec.SetField(transaction.FieldCategorySource, field.TypeString, value)
+	}
+	if _u.mutation.CategorySourceCleared() {
+		_spec.ClearField(transaction.FieldCategorySource, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShareMode(); ok {
+		_spec.SetField(transaction.FieldShareMode, field.TypeString, value)
+	}
+	if _u.mutation.ShareModeCleared() {
+		_spec.ClearField(transaction.FieldShareMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstallmentNumber(); ok {
+		_spec.SetField(transaction.FieldInstallmentNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstallmentNumber(); ok {
+		_spec.AddField(transaction.FieldInstallmentNumber, field.TypeInt, value)
+	}
+	if _u.mutation.InstallmentNumberCleared() {
+		_spec.ClearField(transaction.FieldInstallmentNumber, field.TypeInt)
+	}
+	if value, ok := _u.mutation.InstallmentCount(); ok {
+		_spec.SetField(transaction.FieldInstallmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstallmentCount(); ok {
+		_spec.AddField(transaction.FieldInstallmentCount, field.TypeInt, value)
+	}
+	if _u.mutation.InstallmentCountCleared() {
+		_spec.ClearField(transaction.FieldInstallmentCount, field.TypeInt)
+	}
+	if _u.mutation.LedgerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
+			Table:   transaction.LedgerTable,
+			Columns: []string{transaction.LedgerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
-			Table:   transaction.UserTable,
-			Columns: []string{transaction.UserColumn},
+			Table:   transaction.LedgerTable,
+			Columns: []string{transaction.LedgerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -750,6 +1857,257 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PayeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PayeeTable,
+			Columns: []string{transaction.PayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PayeeTable,
+			Columns: []string{transaction.PayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurringRuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.RecurringRuleTable,
+			Columns: []string{transaction.RecurringRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurringRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.RecurringRuleTable,
+			Columns: []string{transaction.RecurringRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.TransferAccountTable,
+			Columns: []string{transaction.TransferAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.TransferAccountTable,
+			Columns: []string{transaction.TransferAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSplitsIDs(); len(nodes) > 0 && !_u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaidByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PaidByTable,
+			Columns: []string{transaction.PaidByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaidByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transaction.PaidByTable,
+			Columns: []string{transaction.PaidByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   transaction.ParticipantsTable,
+			Columns: []string{transaction.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Transaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,874 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TransactionParticipantCreate is the builder for creating a TransactionParticipant entity.
+type TransactionParticipantCreate struct {
+	config
+	mutation *TransactionParticipantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TransactionParticipantCreate) SetCreatedAt(v time.Time) *TransactionParticipantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TransactionParticipantCreate) SetNillableCreatedAt(v *time.Time) *TransactionParticipantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TransactionParticipantCreate) SetUpdatedAt(v time.Time) *TransactionParticipantCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TransactionParticipantCreate) SetNillableUpdatedAt(v *time.Time) *TransactionParticipantCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *TransactionParticipantCreate) SetAmount(v domain.Money) *TransactionParticipantCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *TransactionParticipantCreate) SetTransactionID(v uuid.UUID) *TransactionParticipantCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TransactionParticipantCreate) SetUserID(v uuid.UUID) *TransactionParticipantCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPercentage sets the "percentage" field.
+func (_c *TransactionParticipantCreate) SetPercentage(v float64) *TransactionParticipantCreate {
+	_c.mutation.SetPercentage(v)
+	return _c
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_c *TransactionParticipantCreate) SetNillablePercentage(v *float64) *TransactionParticipantCreate {
+	if v != nil {
+		_c.SetPercentage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TransactionParticipantCreate) SetID(v uuid.UUID) *TransactionParticipantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TransactionParticipantCreate) SetNillableID(v *uuid.UUID) *TransactionParticipantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *TransactionParticipantCreate) SetTransaction(v *Transaction) *TransactionParticipantCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TransactionParticipantCreate) SetUser(v *User) *TransactionParticipantCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the TransactionParticipantMutation object of the builder.
+func (_c *TransactionParticipantCreate) Mutation() *TransactionParticipantMutation {
+	return _c.mutation
+}
+
+// Save creates the TransactionParticipant in the database.
+func (_c *TransactionParticipantCreate) Save(ctx context.Context) (*TransactionParticipant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransactionParticipantCreate) SaveX(ctx context.Context) *TransactionParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionParticipantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionParticipantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransactionParticipantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := transactionparticipant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := transactionparticipant.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := transactionparticipant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransactionParticipantCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TransactionParticipant.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TransactionParticipant.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "TransactionParticipant.amount"`)}
+	}
+	if _, ok := _c.mutation.TransactionID(); !ok {
+		return &ValidationError{Name: "transaction_id", err: errors.New(`ent: missing required field "TransactionParticipant.transaction_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TransactionParticipant.user_id"`)}
+	}
+	if len(_c.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "TransactionParticipant.transaction"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TransactionParticipant.user"`)}
+	}
+	return nil
+}
+
+func (_c *TransactionParticipantCreate) sqlSave(ctx context.Context) (*TransactionParticipant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransactionParticipantCreate) createSpec() (*TransactionParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransactionParticipant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transactionparticipant.Table, sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transactionparticipant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(transactionparticipant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(transactionparticipant.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Percentage(); ok {
+		_spec.SetField(transactionparticipant.FieldPercentage, field.TypeFloat64, value)
+		_node.Percentage = &value
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.TransactionTable,
+			Columns: []string{transactionparticipant.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.UserTable,
+			Columns: []string{transactionparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransactionParticipant.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionParticipantUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionParticipantCreate) OnConflict(opts ...sql.ConflictOption) *TransactionParticipantUpsertOne {
+	_c.conflict = opts
+	return &TransactionParticipantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransactionParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionParticipantCreate) OnConflictColumns(columns ...string) *TransactionParticipantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionParticipantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TransactionParticipantUpsertOne is the builder for "upsert"-ing
+	//  one TransactionParticipant node.
+	TransactionParticipantUpsertOne struct {
+		create *TransactionParticipantCreate
+	}
+
+	// TransactionParticipantUpsert is the "OnConflict" setter.
+	TransactionParticipantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionParticipantUpsert) SetUpdatedAt(v time.Time) *TransactionParticipantUpsert {
+	u.Set(transactionparticipant.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionParticipantUpsert) UpdateUpdatedAt() *TransactionParticipantUpsert {
+	u.SetExcluded(transactionparticipant.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionParticipantUpsert) SetAmount(v domain.Money) *TransactionParticipantUpsert {
+	u.Set(transactionparticipant.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionParticipantUpsert) UpdateAmount() *TransactionParticipantUpsert {
+	u.SetExcluded(transactionparticipant.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionParticipantUpsert) AddAmount(v domain.Money) *TransactionParticipantUpsert {
+	u.Add(transactionparticipant.FieldAmount, v)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *TransactionParticipantUpsert) SetTransactionID(v uuid.UUID) *TransactionParticipantUpsert {
+	u.Set(transactionparticipant.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *TransactionParticipantUpsert) UpdateTransactionID() *TransactionParticipantUpsert {
+	u.SetExcluded(transactionparticipant.FieldTransactionID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionParticipantUpsert) SetUserID(v uuid.UUID) *TransactionParticipantUpsert {
+	u.Set(transactionparticipant.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionParticipantUpsert) UpdateUserID() *TransactionParticipantUpsert {
+	u.SetExcluded(transactionparticipant.FieldUserID)
+	return u
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *TransactionParticipantUpsert) SetPercentage(v float64) *TransactionParticipantUpsert {
+	u.Set(transactionparticipant.FieldPercentage, v)
+	return u
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *TransactionParticipantUpsert) UpdatePercentage() *TransactionParticipantUpsert {
+	u.SetExcluded(transactionparticipant.FieldPercentage)
+	return u
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *TransactionParticipantUpsert) AddPercentage(v float64) *TransactionParticipantUpsert {
+	u.Add(transactionparticipant.FieldPercentage, v)
+	return u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *TransactionParticipantUpsert) ClearPercentage() *TransactionParticipantUpsert {
+	u.SetNull(transactionparticipant.FieldPercentage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TransactionParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transactionparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionParticipantUpsertOne) UpdateNewValues() *TransactionParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transactionparticipant.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(transactionparticipant.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransactionParticipant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransactionParticipantUpsertOne) Ignore() *TransactionParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionParticipantUpsertOne) DoNothing() *TransactionParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionParticipantCreate.OnConflict
+// documentation for more info.
+func (u *TransactionParticipantUpsertOne) Update(set func(*TransactionParticipantUpsert)) *TransactionParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionParticipantUpsertOne) SetUpdatedAt(v time.Time) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertOne) UpdateUpdatedAt() *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionParticipantUpsertOne) SetAmount(v domain.Money) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionParticipantUpsertOne) AddAmount(v domain.Money) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertOne) UpdateAmount() *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *TransactionParticipantUpsertOne) SetTransactionID(v uuid.UUID) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertOne) UpdateTransactionID() *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionParticipantUpsertOne) SetUserID(v uuid.UUID) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertOne) UpdateUserID() *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *TransactionParticipantUpsertOne) SetPercentage(v float64) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *TransactionParticipantUpsertOne) AddPercentage(v float64) *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertOne) UpdatePercentage() *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *TransactionParticipantUpsertOne) ClearPercentage() *TransactionParticipantUpsertOne {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.ClearPercentage()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionParticipantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionParticipantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionParticipantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransactionParticipantUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TransactionParticipantUpsertOne.ID is not supported by MySQL driver. Use TransactionParticipantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransactionParticipantUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransactionParticipantCreateBulk is the builder for creating many TransactionParticipant entities in bulk.
+type TransactionParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*TransactionParticipantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TransactionParticipant entities in the database.
+func (_c *TransactionParticipantCreateBulk) Save(ctx context.Context) ([]*TransactionParticipant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TransactionParticipant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransactionParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransactionParticipantCreateBulk) SaveX(ctx context.Context) []*TransactionParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransactionParticipant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionParticipantUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionParticipantCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransactionParticipantUpsertBulk {
+	_c.conflict = opts
+	return &TransactionParticipantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransactionParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionParticipantCreateBulk) OnConflictColumns(columns ...string) *TransactionParticipantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionParticipantUpsertBulk{
+		create: _c,
+	}
+}
+
+// TransactionParticipantUpsertBulk is the builder for "upsert"-ing
+// a bulk of TransactionParticipant nodes.
+type TransactionParticipantUpsertBulk struct {
+	create *TransactionParticipantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TransactionParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transactionparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionParticipantUpsertBulk) UpdateNewValues() *TransactionParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transactionparticipant.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(transactionparticipant.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransactionParticipant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransactionParticipantUpsertBulk) Ignore() *TransactionParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionParticipantUpsertBulk) DoNothing() *TransactionParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionParticipantCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransactionParticipantUpsertBulk) Update(set func(*TransactionParticipantUpsert)) *TransactionParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionParticipantUpsertBulk) SetUpdatedAt(v time.Time) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertBulk) UpdateUpdatedAt() *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionParticipantUpsertBulk) SetAmount(v domain.Money) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionParticipantUpsertBulk) AddAmount(v domain.Money) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertBulk) UpdateAmount() *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *TransactionParticipantUpsertBulk) SetTransactionID(v uuid.UUID) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertBulk) UpdateTransactionID() *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionParticipantUpsertBulk) SetUserID(v uuid.UUID) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertBulk) UpdateUserID() *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *TransactionParticipantUpsertBulk) SetPercentage(v float64) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *TransactionParticipantUpsertBulk) AddPercentage(v float64) *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *TransactionParticipantUpsertBulk) UpdatePercentage() *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (u *TransactionParticipantUpsertBulk) ClearPercentage() *TransactionParticipantUpsertBulk {
+	return u.Update(func(s *TransactionParticipantUpsert) {
+		s.ClearPercentage()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionParticipantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransactionParticipantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionParticipantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionParticipantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
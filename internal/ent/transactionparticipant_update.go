@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionparticipant"
+	"frog-go/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TransactionParticipantUpdate is the builder for updating TransactionParticipant entities.
+type TransactionParticipantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TransactionParticipantMutation
+}
+
+// Where appends a list predicates to the TransactionParticipantUpdate builder.
+func (_u *TransactionParticipantUpdate) Where(ps ...predicate.TransactionParticipant) *TransactionParticipantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionParticipantUpdate) SetUpdatedAt(v time.Time) *TransactionParticipantUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionParticipantUpdate) SetAmount(v domain.Money) *TransactionParticipantUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionParticipantUpdate) SetNillableAmount(v *domain.Money) *TransactionParticipantUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *TransactionParticipantUpdate) AddAmount(v domain.Money) *TransactionParticipantUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *TransactionParticipantUpdate) SetTransactionID(v uuid.UUID) *TransactionParticipantUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *TransactionParticipantUpdate) SetNillableTransactionID(v *uuid.UUID) *TransactionParticipantUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TransactionParticipantUpdate) SetUserID(v uuid.UUID) *TransactionParticipantUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TransactionParticipantUpdate) SetNillableUserID(v *uuid.UUID) *TransactionParticipantUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *TransactionParticipantUpdate) SetPercentage(v float64) *TransactionParticipantUpdate {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *TransactionParticipantUpdate) SetNillablePercentage(v *float64) *TransactionParticipantUpdate {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *TransactionParticipantUpdate) AddPercentage(v float64) *TransactionParticipantUpdate {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *TransactionParticipantUpdate) ClearPercentage() *TransactionParticipantUpdate {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *TransactionParticipantUpdate) SetTransaction(v *Transaction) *TransactionParticipantUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TransactionParticipantUpdate) SetUser(v *User) *TransactionParticipantUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TransactionParticipantMutation object of the builder.
+func (_u *TransactionParticipantUpdate) Mutation() *TransactionParticipantMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *TransactionParticipantUpdate) ClearTransaction() *TransactionParticipantUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TransactionParticipantUpdate) ClearUser() *TransactionParticipantUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransactionParticipantUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransactionParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionParticipantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TransactionParticipantUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := transactionparticipant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionParticipantUpdate) check() error {
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionParticipant.transaction"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionParticipant.user"`)
+	}
+	return nil
+}
+
+func (_u *TransactionParticipantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transactionparticipant.Table, transactionparticipant.Columns, sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transactionparticipant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transactionparticipant.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(transactionparticipant.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(transactionparticipant.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(transactionparticipant.FieldPercentage, field.TypeFloat64, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(transactionparticipant.FieldPercentage, field.TypeFloat64)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.TransactionTable,
+			Columns: []string{transactionparticipant.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.TransactionTable,
+			Columns: []string{transactionparticipant.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.UserTable,
+			Columns: []string{transactionparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.UserTable,
+			Columns: []string{transactionparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transactionparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransactionParticipantUpdateOne is the builder for updating a single TransactionParticipant entity.
+type TransactionParticipantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransactionParticipantMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionParticipantUpdateOne) SetUpdatedAt(v time.Time) *TransactionParticipantUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionParticipantUpdateOne) SetAmount(v domain.Money) *TransactionParticipantUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionParticipantUpdateOne) SetNillableAmount(v *domain.Money) *TransactionParticipantUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *TransactionParticipantUpdateOne) AddAmount(v domain.Money) *TransactionParticipantUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *TransactionParticipantUpdateOne) SetTransactionID(v uuid.UUID) *TransactionParticipantUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *TransactionParticipantUpdateOne) SetNillableTransactionID(v *uuid.UUID) *TransactionParticipantUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TransactionParticipantUpdateOne) SetUserID(v uuid.UUID) *TransactionParticipantUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TransactionParticipantUpdateOne) SetNillableUserID(v *uuid.UUID) *TransactionParticipantUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *TransactionParticipantUpdateOne) SetPercentage(v float64) *TransactionParticipantUpdateOne {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *TransactionParticipantUpdateOne) SetNillablePercentage(v *float64) *TransactionParticipantUpdateOne {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *TransactionParticipantUpdateOne) AddPercentage(v float64) *TransactionParticipantUpdateOne {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *TransactionParticipantUpdateOne) ClearPercentage() *TransactionParticipantUpdateOne {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *TransactionParticipantUpdateOne) SetTransaction(v *Transaction) *TransactionParticipantUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TransactionParticipantUpdateOne) SetUser(v *User) *TransactionParticipantUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TransactionParticipantMutation object of the builder.
+func (_u *TransactionParticipantUpdateOne) Mutation() *TransactionParticipantMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *TransactionParticipantUpdateOne) ClearTransaction() *TransactionParticipantUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TransactionParticipantUpdateOne) ClearUser() *TransactionParticipantUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TransactionParticipantUpdate builder.
+func (_u *TransactionParticipantUpdateOne) Where(ps ...predicate.TransactionParticipant) *TransactionParticipantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransactionParticipantUpdateOne) Select(field string, fields ...string) *TransactionParticipantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TransactionParticipant entity.
+func (_u *TransactionParticipantUpdateOne) Save(ctx context.Context) (*TransactionParticipant, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionParticipantUpdateOne) SaveX(ctx context.Context) *TransactionParticipant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransactionParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TransactionParticipantUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := transactionparticipant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionParticipantUpdateOne) check() error {
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionParticipant.transaction"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionParticipant.user"`)
+	}
+	return nil
+}
+
+func (_u *TransactionParticipantUpdateOne) sqlSave(ctx context.Context) (_node *TransactionParticipant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transactionparticipant.Table, transactionparticipant.Columns, sqlgraph.NewFieldSpec(transactionparticipant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TransactionParticipant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transactionparticipant.FieldID)
+		for _, f := range fields {
+			if !transactionparticipant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transactionparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transactionparticipant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transactionparticipant.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(transactionparticipant.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(transactionparticipant.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(transactionparticipant.FieldPercentage, field.TypeFloat64, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(transactionparticipant.FieldPercentage, field.TypeFloat64)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.TransactionTable,
+			Columns: []string{transactionparticipant.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.TransactionTable,
+			Columns: []string{transactionparticipant.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.UserTable,
+			Columns: []string{transactionparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionparticipant.UserTable,
+			Columns: []string{transactionparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TransactionParticipant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transactionparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
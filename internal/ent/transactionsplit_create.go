@@ -0,0 +1,838 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionsplit"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TransactionSplitCreate is the builder for creating a TransactionSplit entity.
+type TransactionSplitCreate struct {
+	config
+	mutation *TransactionSplitMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TransactionSplitCreate) SetCreatedAt(v time.Time) *TransactionSplitCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TransactionSplitCreate) SetNillableCreatedAt(v *time.Time) *TransactionSplitCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TransactionSplitCreate) SetUpdatedAt(v time.Time) *TransactionSplitCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TransactionSplitCreate) SetNillableUpdatedAt(v *time.Time) *TransactionSplitCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *TransactionSplitCreate) SetAmount(v domain.Money) *TransactionSplitCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *TransactionSplitCreate) SetNote(v string) *TransactionSplitCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *TransactionSplitCreate) SetNillableNote(v *string) *TransactionSplitCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *TransactionSplitCreate) SetCategoryID(v uuid.UUID) *TransactionSplitCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *TransactionSplitCreate) SetNillableCategoryID(v *uuid.UUID) *TransactionSplitCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TransactionSplitCreate) SetID(v uuid.UUID) *TransactionSplitCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TransactionSplitCreate) SetNillableID(v *uuid.UUID) *TransactionSplitCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_c *TransactionSplitCreate) SetTransactionID(id uuid.UUID) *TransactionSplitCreate {
+	_c.mutation.SetTransactionID(id)
+	return _c
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *TransactionSplitCreate) SetTransaction(v *Transaction) *TransactionSplitCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *TransactionSplitCreate) SetCategory(v *Category) *TransactionSplitCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the TransactionSplitMutation object of the builder.
+func (_c *TransactionSplitCreate) Mutation() *TransactionSplitMutation {
+	return _c.mutation
+}
+
+// Save creates the TransactionSplit in the database.
+func (_c *TransactionSplitCreate) Save(ctx context.Context) (*TransactionSplit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransactionSplitCreate) SaveX(ctx context.Context) *TransactionSplit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionSplitCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionSplitCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransactionSplitCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := transactionsplit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := transactionsplit.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := transactionsplit.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransactionSplitCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TransactionSplit.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TransactionSplit.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "TransactionSplit.amount"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := transactionsplit.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "TransactionSplit.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "TransactionSplit.transaction"`)}
+	}
+	return nil
+}
+
+func (_c *TransactionSplitCreate) sqlSave(ctx context.Context) (*TransactionSplit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransactionSplitCreate) createSpec() (*TransactionSplit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransactionSplit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transactionsplit.Table, sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transactionsplit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(transactionsplit.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(transactionsplit.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(transactionsplit.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.TransactionTable,
+			Columns: []string{transactionsplit.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transaction_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransactionSplit.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionSplitUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionSplitCreate) OnConflict(opts ...sql.ConflictOption) *TransactionSplitUpsertOne {
+	_c.conflict = opts
+	return &TransactionSplitUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransactionSplit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionSplitCreate) OnConflictColumns(columns ...string) *TransactionSplitUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionSplitUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TransactionSplitUpsertOne is the builder for "upsert"-ing
+	//  one TransactionSplit node.
+	TransactionSplitUpsertOne struct {
+		create *TransactionSplitCreate
+	}
+
+	// TransactionSplitUpsert is the "OnConflict" setter.
+	TransactionSplitUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionSplitUpsert) SetUpdatedAt(v time.Time) *TransactionSplitUpsert {
+	u.Set(transactionsplit.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionSplitUpsert) UpdateUpdatedAt() *TransactionSplitUpsert {
+	u.SetExcluded(transactionsplit.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionSplitUpsert) SetAmount(v domain.Money) *TransactionSplitUpsert {
+	u.Set(transactionsplit.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionSplitUpsert) UpdateAmount() *TransactionSplitUpsert {
+	u.SetExcluded(transactionsplit.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionSplitUpsert) AddAmount(v domain.Money) *TransactionSplitUpsert {
+	u.Add(transactionsplit.FieldAmount, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *TransactionSplitUpsert) SetNote(v string) *TransactionSplitUpsert {
+	u.Set(transactionsplit.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *TransactionSplitUpsert) UpdateNote() *TransactionSplitUpsert {
+	u.SetExcluded(transactionsplit.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *TransactionSplitUpsert) ClearNote() *TransactionSplitUpsert {
+	u.SetNull(transactionsplit.FieldNote)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *TransactionSplitUpsert) SetCategoryID(v uuid.UUID) *TransactionSplitUpsert {
+	u.Set(transactionsplit.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *TransactionSplitUpsert) UpdateCategoryID() *TransactionSplitUpsert {
+	u.SetExcluded(transactionsplit.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *TransactionSplitUpsert) ClearCategoryID() *TransactionSplitUpsert {
+	u.SetNull(transactionsplit.FieldCategoryID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TransactionSplit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transactionsplit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionSplitUpsertOne) UpdateNewValues() *TransactionSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transactionsplit.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(transactionsplit.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransactionSplit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransactionSplitUpsertOne) Ignore() *TransactionSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionSplitUpsertOne) DoNothing() *TransactionSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionSplitCreate.OnConflict
+// documentation for more info.
+func (u *TransactionSplitUpsertOne) Update(set func(*TransactionSplitUpsert)) *TransactionSplitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionSplitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionSplitUpsertOne) SetUpdatedAt(v time.Time) *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionSplitUpsertOne) UpdateUpdatedAt() *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionSplitUpsertOne) SetAmount(v domain.Money) *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionSplitUpsertOne) AddAmount(v domain.Money) *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionSplitUpsertOne) UpdateAmount() *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *TransactionSplitUpsertOne) SetNote(v string) *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *TransactionSplitUpsertOne) UpdateNote() *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *TransactionSplitUpsertOne) ClearNote() *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *TransactionSplitUpsertOne) SetCategoryID(v uuid.UUID) *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *TransactionSplitUpsertOne) UpdateCategoryID() *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *TransactionSplitUpsertOne) ClearCategoryID() *TransactionSplitUpsertOne {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionSplitUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionSplitCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionSplitUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransactionSplitUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TransactionSplitUpsertOne.ID is not supported by MySQL driver. Use TransactionSplitUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransactionSplitUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransactionSplitCreateBulk is the builder for creating many TransactionSplit entities in bulk.
+type TransactionSplitCreateBulk struct {
+	config
+	err      error
+	builders []*TransactionSplitCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TransactionSplit entities in the database.
+func (_c *TransactionSplitCreateBulk) Save(ctx context.Context) ([]*TransactionSplit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TransactionSplit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransactionSplitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransactionSplitCreateBulk) SaveX(ctx context.Context) []*TransactionSplit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionSplitCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionSplitCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransactionSplit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionSplitUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransactionSplitCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransactionSplitUpsertBulk {
+	_c.conflict = opts
+	return &TransactionSplitUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransactionSplit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransactionSplitCreateBulk) OnConflictColumns(columns ...string) *TransactionSplitUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransactionSplitUpsertBulk{
+		create: _c,
+	}
+}
+
+// TransactionSplitUpsertBulk is the builder for "upsert"-ing
+// a bulk of TransactionSplit nodes.
+type TransactionSplitUpsertBulk struct {
+	create *TransactionSplitCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TransactionSplit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transactionsplit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionSplitUpsertBulk) UpdateNewValues() *TransactionSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transactionsplit.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(transactionsplit.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransactionSplit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransactionSplitUpsertBulk) Ignore() *TransactionSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionSplitUpsertBulk) DoNothing() *TransactionSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionSplitCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransactionSplitUpsertBulk) Update(set func(*TransactionSplitUpsert)) *TransactionSplitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionSplitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransactionSplitUpsertBulk) SetUpdatedAt(v time.Time) *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransactionSplitUpsertBulk) UpdateUpdatedAt() *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionSplitUpsertBulk) SetAmount(v domain.Money) *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionSplitUpsertBulk) AddAmount(v domain.Money) *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionSplitUpsertBulk) UpdateAmount() *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *TransactionSplitUpsertBulk) SetNote(v string) *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *TransactionSplitUpsertBulk) UpdateNote() *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *TransactionSplitUpsertBulk) ClearNote() *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *TransactionSplitUpsertBulk) SetCategoryID(v uuid.UUID) *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *TransactionSplitUpsertBulk) UpdateCategoryID() *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *TransactionSplitUpsertBulk) ClearCategoryID() *TransactionSplitUpsertBulk {
+	return u.Update(func(s *TransactionSplitUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionSplitUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransactionSplitCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionSplitCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionSplitUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
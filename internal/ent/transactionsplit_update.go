@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/category"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/transaction"
+	"frog-go/internal/ent/transactionsplit"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TransactionSplitUpdate is the builder for updating TransactionSplit entities.
+type TransactionSplitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TransactionSplitMutation
+}
+
+// Where appends a list predicates to the TransactionSplitUpdate builder.
+func (_u *TransactionSplitUpdate) Where(ps ...predicate.TransactionSplit) *TransactionSplitUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionSplitUpdate) SetUpdatedAt(v time.Time) *TransactionSplitUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionSplitUpdate) SetAmount(v domain.Money) *TransactionSplitUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableAmount(v *domain.Money) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *TransactionSplitUpdate) AddAmount(v domain.Money) *TransactionSplitUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *TransactionSplitUpdate) SetNote(v string) *TransactionSplitUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableNote(v *string) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *TransactionSplitUpdate) ClearNote() *TransactionSplitUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *TransactionSplitUpdate) SetCategoryID(v uuid.UUID) *TransactionSplitUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableCategoryID(v *uuid.UUID) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *TransactionSplitUpdate) ClearCategoryID() *TransactionSplitUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *TransactionSplitUpdate) SetTransactionID(id uuid.UUID) *TransactionSplitUpdate {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *TransactionSplitUpdate) SetTransaction(v *Transaction) *TransactionSplitUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdate) SetCategory(v *Category) *TransactionSplitUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the TransactionSplitMutation object of the builder.
+func (_u *TransactionSplitUpdate) Mutation() *TransactionSplitMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *TransactionSplitUpdate) ClearTransaction() *TransactionSplitUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdate) ClearCategory() *TransactionSplitUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransactionSplitUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionSplitUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransactionSplitUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionSplitUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TransactionSplitUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := transactionsplit.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionSplitUpdate) check() error {
+	if v, ok := _u.mutation.Note(); ok {
+		if err := transactionsplit.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "TransactionSplit.note": %w`, err)}
+		}
+	}
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionSplit.transaction"`)
+	}
+	return nil
+}
+
+func (_u *TransactionSplitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transactionsplit.Table, transactionsplit.Columns, sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transactionsplit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transactionsplit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(transactionsplit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(transactionsplit.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(transactionsplit.FieldNote, field.TypeString)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.TransactionTable,
+			Columns: []string{transactionsplit.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.TransactionTable,
+			Columns: []string{transactionsplit.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transactionsplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransactionSplitUpdateOne is the builder for updating a single TransactionSplit entity.
+type TransactionSplitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransactionSplitMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionSplitUpdateOne) SetUpdatedAt(v time.Time) *TransactionSplitUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionSplitUpdateOne) SetAmount(v domain.Money) *TransactionSplitUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableAmount(v *domain.Money) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *TransactionSplitUpdateOne) AddAmount(v domain.Money) *TransactionSplitUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *TransactionSplitUpdateOne) SetNote(v string) *TransactionSplitUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableNote(v *string) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *TransactionSplitUpdateOne) ClearNote() *TransactionSplitUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *TransactionSplitUpdateOne) SetCategoryID(v uuid.UUID) *TransactionSplitUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableCategoryID(v *uuid.UUID) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *TransactionSplitUpdateOne) ClearCategoryID() *TransactionSplitUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetTransactionID sets the "transaction" edge to the Transaction entity by ID.
+func (_u *TransactionSplitUpdateOne) SetTransactionID(id uuid.UUID) *TransactionSplitUpdateOne {
+	_u.mutation.SetTransactionID(id)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *TransactionSplitUpdateOne) SetTransaction(v *Transaction) *TransactionSplitUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdateOne) SetCategory(v *Category) *TransactionSplitUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the TransactionSplitMutation object of the builder.
+func (_u *TransactionSplitUpdateOne) Mutation() *TransactionSplitMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *TransactionSplitUpdateOne) ClearTransaction() *TransactionSplitUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdateOne) ClearCategory() *TransactionSplitUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the TransactionSplitUpdate builder.
+func (_u *TransactionSplitUpdateOne) Where(ps ...predicate.TransactionSplit) *TransactionSplitUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransactionSplitUpdateOne) Select(field string, fields ...string) *TransactionSplitUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TransactionSplit entity.
+func (_u *TransactionSplitUpdateOne) Save(ctx context.Context) (*TransactionSplit, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionSplitUpdateOne) SaveX(ctx context.Context) *TransactionSplit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransactionSplitUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionSplitUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TransactionSplitUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := transactionsplit.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionSplitUpdateOne) check() error {
+	if v, ok := _u.mutation.Note(); ok {
+		if err := transactionsplit.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "TransactionSplit.note": %w`, err)}
+		}
+	}
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionSplit.transaction"`)
+	}
+	return nil
+}
+
+func (_u *TransactionSplitUpdateOne) sqlSave(ctx context.Context) (_node *TransactionSplit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transactionsplit.Table, transactionsplit.Columns, sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TransactionSplit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transactionsplit.FieldID)
+		for _, f := range fields {
+			if !transactionsplit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transactionsplit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transactionsplit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transactionsplit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(transactionsplit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(transactionsplit.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(transactionsplit.FieldNote, field.TypeString)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.TransactionTable,
+			Columns: []string{transactionsplit.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.TransactionTable,
+			Columns: []string{transactionsplit.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TransactionSplit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transactionsplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,14 +12,74 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Account is the client for interacting with the Account builders.
+	Account *AccountClient
+	// Asset is the client for interacting with the Asset builders.
+	Asset *AssetClient
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
+	// EnvelopeAllocation is the client for interacting with the EnvelopeAllocation builders.
+	EnvelopeAllocation *EnvelopeAllocationClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
+	// Holding is the client for interacting with the Holding builders.
+	Holding *HoldingClient
+	// ImportJob is the client for interacting with the ImportJob builders.
+	ImportJob *ImportJobClient
+	// InvestmentIncome is the client for interacting with the InvestmentIncome builders.
+	InvestmentIncome *InvestmentIncomeClient
+	// InvestmentQuote is the client for interacting with the InvestmentQuote builders.
+	InvestmentQuote *InvestmentQuoteClient
+	// InvestmentTrade is the client for interacting with the InvestmentTrade builders.
+	InvestmentTrade *InvestmentTradeClient
 	// Invoice is the client for interacting with the Invoice builders.
 	Invoice *InvoiceClient
+	// InvoicePayment is the client for interacting with the InvoicePayment builders.
+	InvoicePayment *InvoicePaymentClient
+	// Ledger is the client for interacting with the Ledger builders.
+	Ledger *LedgerClient
+	// LedgerInvitation is the client for interacting with the LedgerInvitation builders.
+	LedgerInvitation *LedgerInvitationClient
+	// LedgerMember is the client for interacting with the LedgerMember builders.
+	LedgerMember *LedgerMemberClient
+	// Loan is the client for interacting with the Loan builders.
+	Loan *LoanClient
+	// LoanInstallment is the client for interacting with the LoanInstallment builders.
+	LoanInstallment *LoanInstallmentClient
+	// LoanPrepayment is the client for interacting with the LoanPrepayment builders.
+	LoanPrepayment *LoanPrepaymentClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// Payee is the client for interacting with the Payee builders.
+	Payee *PayeeClient
+	// RecurringRule is the client for interacting with the RecurringRule builders.
+	RecurringRule *RecurringRuleClient
+	// Rule is the client for interacting with the Rule builders.
+	Rule *RuleClient
+	// Settlement is the client for interacting with the Settlement builders.
+	Settlement *SettlementClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
+	// TransactionParticipant is the client for interacting with the TransactionParticipant builders.
+	TransactionParticipant *TransactionParticipantClient
+	// TransactionSplit is the client for interacting with the TransactionSplit builders.
+	TransactionSplit *TransactionSplitClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Valuation is the client for interacting with the Valuation builders.
+	Valuation *ValuationClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +211,40 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Account = NewAccountClient(tx.config)
+	tx.Asset = NewAssetClient(tx.config)
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.Budget = NewBudgetClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
+	tx.EnvelopeAllocation = NewEnvelopeAllocationClient(tx.config)
+	tx.ExchangeRate = NewExchangeRateClient(tx.config)
+	tx.Goal = NewGoalClient(tx.config)
+	tx.Holding = NewHoldingClient(tx.config)
+	tx.ImportJob = NewImportJobClient(tx.config)
+	tx.InvestmentIncome = NewInvestmentIncomeClient(tx.config)
+	tx.InvestmentQuote = NewInvestmentQuoteClient(tx.config)
+	tx.InvestmentTrade = NewInvestmentTradeClient(tx.config)
 	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoicePayment = NewInvoicePaymentClient(tx.config)
+	tx.Ledger = NewLedgerClient(tx.config)
+	tx.LedgerInvitation = NewLedgerInvitationClient(tx.config)
+	tx.LedgerMember = NewLedgerMemberClient(tx.config)
+	tx.Loan = NewLoanClient(tx.config)
+	tx.LoanInstallment = NewLoanInstallmentClient(tx.config)
+	tx.LoanPrepayment = NewLoanPrepaymentClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.Payee = NewPayeeClient(tx.config)
+	tx.RecurringRule = NewRecurringRuleClient(tx.config)
+	tx.Rule = NewRuleClient(tx.config)
+	tx.Settlement = NewSettlementClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
+	tx.TransactionParticipant = NewTransactionParticipantClient(tx.config)
+	tx.TransactionSplit = NewTransactionSplitClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Valuation = NewValuationClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +254,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Category.QueryXXX(), the query will be executed
+// applies a query, for example: Account.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
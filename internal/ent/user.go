@@ -32,6 +32,8 @@ type User struct {
 	PasswordHash string `json:"-"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// BaseCurrency holds the value of the "base_currency" field.
+	BaseCurrency string `json:"base_currency,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -40,31 +42,20 @@ type User struct {
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
-	// Transactions holds the value of the transactions edge.
-	Transactions []*Transaction `json:"transactions,omitempty"`
-	// Invoices holds the value of the invoices edge.
-	Invoices []*Invoice `json:"invoices,omitempty"`
+	// Ledgers holds the value of the ledgers edge.
+	Ledgers []*Ledger `json:"ledgers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [1]bool
 }
 
-// TransactionsOrErr returns the Transactions value or an error if the edge
+// LedgersOrErr returns the Ledgers value or an error if the edge
 // was not loaded in eager-loading.
-func (e UserEdges) TransactionsOrErr() ([]*Transaction, error) {
+func (e UserEdges) LedgersOrErr() ([]*Ledger, error) {
 	if e.loadedTypes[0] {
-		return e.Transactions, nil
+		return e.Ledgers, nil
 	}
-	return nil, &NotLoadedError{edge: "transactions"}
-}
-
-// InvoicesOrErr returns the Invoices value or an error if the edge
-// was not loaded in eager-loading.
-func (e UserEdges) InvoicesOrErr() ([]*Invoice, error) {
-	if e.loadedTypes[1] {
-		return e.Invoices, nil
-	}
-	return nil, &NotLoadedError{edge: "invoices"}
+	return nil, &NotLoadedError{edge: "ledgers"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -74,7 +65,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case user.FieldName, user.FieldUsername, user.FieldEmail, user.FieldPasswordHash:
+		case user.FieldName, user.FieldUsername, user.FieldEmail, user.FieldPasswordHash, user.FieldBaseCurrency:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -143,6 +134,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case user.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				_m.BaseCurrency = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,14 +153,9 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryTransactions queries the "transactions" edge of the User entity.
-func (_m *User) QueryTransactions() *TransactionQuery {
-	return NewUserClient(_m.config).QueryTransactions(_m)
-}
-
-// QueryInvoices queries the "invoices" edge of the User entity.
-func (_m *User) QueryInvoices() *InvoiceQuery {
-	return NewUserClient(_m.config).QueryInvoices(_m)
+// QueryLedgers queries the "ledgers" edge of the User entity.
+func (_m *User) QueryLedgers() *LedgerQuery {
+	return NewUserClient(_m.config).QueryLedgers(_m)
 }
 
 // Update returns a builder for updating this User.
@@ -208,6 +200,9 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("base_currency=")
+	builder.WriteString(_m.BaseCurrency)
 	builder.WriteByte(')')
 	return builder.String()
 }
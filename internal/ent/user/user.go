@@ -29,26 +29,19 @@ const (
 	FieldPasswordHash = "password_hash"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
-	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
-	EdgeTransactions = "transactions"
-	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
-	EdgeInvoices = "invoices"
+	// FieldBaseCurrency holds the string denoting the base_currency field in the database.
+	FieldBaseCurrency = "base_currency"
+	// EdgeLedgers holds the string denoting the ledgers edge name in mutations.
+	EdgeLedgers = "ledgers"
 	// Table holds the table name of the user in the database.
 	Table = "users"
-	// TransactionsTable is the table that holds the transactions relation/edge.
-	TransactionsTable = "transactions"
-	// TransactionsInverseTable is the table name for the Transaction entity.
-	// It exists in this package in order to avoid circular dependency with the "transaction" package.
-	TransactionsInverseTable = "transactions"
-	// TransactionsColumn is the table column denoting the transactions relation/edge.
-	TransactionsColumn = "user_id"
-	// InvoicesTable is the table that holds the invoices relation/edge.
-	InvoicesTable = "invoices"
-	// InvoicesInverseTable is the table name for the Invoice entity.
-	// It exists in this package in order to avoid circular dependency with the "invoice" package.
-	InvoicesInverseTable = "invoices"
-	// InvoicesColumn is the table column denoting the invoices relation/edge.
-	InvoicesColumn = "user_id"
+	// LedgersTable is the table that holds the ledgers relation/edge.
+	LedgersTable = "ledgers"
+	// LedgersInverseTable is the table name for the Ledger entity.
+	// It exists in this package in order to avoid circular dependency with the "ledger" package.
+	LedgersInverseTable = "ledgers"
+	// LedgersColumn is the table column denoting the ledgers relation/edge.
+	LedgersColumn = "owner_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -61,6 +54,7 @@ var Columns = []string{
 	FieldEmail,
 	FieldPasswordHash,
 	FieldIsActive,
+	FieldBaseCurrency,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -90,6 +84,10 @@ var (
 	PasswordHashValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultBaseCurrency holds the default value on creation for the "base_currency" field.
+	DefaultBaseCurrency string
+	// BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	BaseCurrencyValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -137,44 +135,28 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
-// ByTransactionsCount orders the results by transactions count.
-func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
-	}
-}
-
-// ByTransactions orders the results by transactions terms.
-func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
-	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
-	}
+// ByBaseCurrency orders the results by the base_currency field.
+func ByBaseCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseCurrency, opts...).ToFunc()
 }
 
-// ByInvoicesCount orders the results by invoices count.
-func ByInvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+// ByLedgersCount orders the results by ledgers count.
+func ByLedgersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newInvoicesStep(), opts...)
+		sqlgraph.OrderByNeighborsCount(s, newLedgersStep(), opts...)
 	}
 }
 
-// ByInvoices orders the results by invoices terms.
-func ByInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByLedgers orders the results by ledgers terms.
+func ByLedgers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newInvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newLedgersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
-func newTransactionsStep() *sqlgraph.Step {
-	return sqlgraph.NewStep(
-		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(TransactionsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, true, TransactionsTable, TransactionsColumn),
-	)
-}
-func newInvoicesStep() *sqlgraph.Step {
+func newLedgersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(InvoicesInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, true, InvoicesTable, InvoicesColumn),
+		sqlgraph.To(LedgersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, LedgersTable, LedgersColumn),
 	)
 }
@@ -6,9 +6,8 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
-	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/predicate"
-	"frog-go/internal/ent/transaction"
 	"frog-go/internal/ent/user"
 	"math"
 
@@ -22,12 +21,11 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx              *QueryContext
-	order            []user.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.User
-	withTransactions *TransactionQuery
-	withInvoices     *InvoiceQuery
+	ctx         *QueryContext
+	order       []user.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.User
+	withLedgers *LedgerQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -64,9 +62,9 @@ func (_q *UserQuery) Order(o ...user.OrderOption) *UserQuery {
 	return _q
 }
 
-// QueryTransactions chains the current query on the "transactions" edge.
-func (_q *UserQuery) QueryTransactions() *TransactionQuery {
-	query := (&TransactionClient{config: _q.config}).Query()
+// QueryLedgers chains the current query on the "ledgers" edge.
+func (_q *UserQuery) QueryLedgers() *LedgerQuery {
+	query := (&LedgerClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -77,30 +75,8 @@ func (_q *UserQuery) QueryTransactions() *TransactionQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(transaction.Table, transaction.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.TransactionsTable, user.TransactionsColumn),
-		)
-		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
-		return fromU, nil
-	}
-	return query
-}
-
-// QueryInvoices chains the current query on the "invoices" edge.
-func (_q *UserQuery) QueryInvoices() *InvoiceQuery {
-	query := (&InvoiceClient{config: _q.config}).Query()
-	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
-		if err := _q.prepareQuery(ctx); err != nil {
-			return nil, err
-		}
-		selector := _q.sqlQuery(ctx)
-		if err := selector.Err(); err != nil {
-			return nil, err
-		}
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(invoice.Table, invoice.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.InvoicesTable, user.InvoicesColumn),
+			sqlgraph.To(ledger.Table, ledger.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.LedgersTable, user.LedgersColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -295,38 +271,26 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]user.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.User{}, _q.predicates...),
-		withTransactions: _q.withTransactions.Clone(),
-		withInvoices:     _q.withInvoices.Clone(),
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]user.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.User{}, _q.predicates...),
+		withLedgers: _q.withLedgers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
-// WithTransactions tells the query-builder to eager-load the nodes that are connected to
-// the "transactions" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *UserQuery) WithTransactions(opts ...func(*TransactionQuery)) *UserQuery {
-	query := (&TransactionClient{config: _q.config}).Query()
+// WithLedgers tells the query-builder to eager-load the nodes that are connected to
+// the "ledgers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLedgers(opts ...func(*LedgerQuery)) *UserQuery {
+	query := (&LedgerClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withTransactions = query
-	return _q
-}
-
-// WithInvoices tells the query-builder to eager-load the nodes that are connected to
-// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *UserQuery) WithInvoices(opts ...func(*InvoiceQuery)) *UserQuery {
-	query := (&InvoiceClient{config: _q.config}).Query()
-	for _, opt := range opts {
-		opt(query)
-	}
-	_q.withInvoices = query
+	_q.withLedgers = query
 	return _q
 }
 
@@ -408,9 +372,8 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
-			_q.withTransactions != nil,
-			_q.withInvoices != nil,
+		loadedTypes = [1]bool{
+			_q.withLedgers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -431,55 +394,17 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withTransactions; query != nil {
-		if err := _q.loadTransactions(ctx, query, nodes,
-			func(n *User) { n.Edges.Transactions = []*Transaction{} },
-			func(n *User, e *Transaction) { n.Edges.Transactions = append(n.Edges.Transactions, e) }); err != nil {
-			return nil, err
-		}
-	}
-	if query := _q.withInvoices; query != nil {
-		if err := _q.loadInvoices(ctx, query, nodes,
-			func(n *User) { n.Edges.Invoices = []*Invoice{} },
-			func(n *User, e *Invoice) { n.Edges.Invoices = append(n.Edges.Invoices, e) }); err != nil {
+	if query := _q.withLedgers; query != nil {
+		if err := _q.loadLedgers(ctx, query, nodes,
+			func(n *User) { n.Edges.Ledgers = []*Ledger{} },
+			func(n *User, e *Ledger) { n.Edges.Ledgers = append(n.Edges.Ledgers, e) }); err != nil {
 			return nil, err
 		}
 	}
 	return nodes, nil
 }
 
-func (_q *UserQuery) loadTransactions(ctx context.Context, query *TransactionQuery, nodes []*User, init func(*User), assign func(*User, *Transaction)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[uuid.UUID]*User)
-	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
-		}
-	}
-	query.withFKs = true
-	query.Where(predicate.Transaction(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.TransactionsColumn), fks...))
-	}))
-	neighbors, err := query.All(ctx)
-	if err != nil {
-		return err
-	}
-	for _, n := range neighbors {
-		fk := n.user_id
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
-		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
-		}
-		assign(node, n)
-	}
-	return nil
-}
-func (_q *UserQuery) loadInvoices(ctx context.Context, query *InvoiceQuery, nodes []*User, init func(*User), assign func(*User, *Invoice)) error {
+func (_q *UserQuery) loadLedgers(ctx context.Context, query *LedgerQuery, nodes []*User, init func(*User), assign func(*User, *Ledger)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -490,21 +415,21 @@ func (_q *UserQuery) loadInvoices(ctx context.Context, query *InvoiceQuery, node
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.Invoice(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.InvoicesColumn), fks...))
+	query.Where(predicate.Ledger(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LedgersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_id
+		fk := n.owner_id
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "owner_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -6,9 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"frog-go/internal/ent/invoice"
+	"frog-go/internal/ent/ledger"
 	"frog-go/internal/ent/predicate"
-	"frog-go/internal/ent/transaction"
 	"frog-go/internal/ent/user"
 	"time"
 
@@ -119,34 +118,33 @@ func (_u *UserUpdate) SetNillableIsActive(v *bool) *UserUpdate {
 	return _u
 }
 
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
-func (_u *UserUpdate) AddTransactionIDs(ids ...uuid.UUID) *UserUpdate {
-	_u.mutation.AddTransactionIDs(ids...)
+// SetBaseCurrency sets the "base_currency" field.
+func (_u *UserUpdate) SetBaseCurrency(v string) *UserUpdate {
+	_u.mutation.SetBaseCurrency(v)
 	return _u
 }
 
-// AddTransactions adds the "transactions" edges to the Transaction entity.
-func (_u *UserUpdate) AddTransactions(v ...*Transaction) *UserUpdate {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBaseCurrency(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetBaseCurrency(*v)
 	}
-	return _u.AddTransactionIDs(ids...)
+	return _u
 }
 
-// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
-func (_u *UserUpdate) AddInvoiceIDs(ids ...uuid.UUID) *UserUpdate {
-	_u.mutation.AddInvoiceIDs(ids...)
+// AddLedgerIDs adds the "ledgers" edge to the Ledger entity by IDs.
+func (_u *UserUpdate) AddLedgerIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddLedgerIDs(ids...)
 	return _u
 }
 
-// AddInvoices adds the "invoices" edges to the Invoice entity.
-func (_u *UserUpdate) AddInvoices(v ...*Invoice) *UserUpdate {
+// AddLedgers adds the "ledgers" edges to the Ledger entity.
+func (_u *UserUpdate) AddLedgers(v ...*Ledger) *UserUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddInvoiceIDs(ids...)
+	return _u.AddLedgerIDs(ids...)
 }
 
 // Mutation returns the UserMutation object of the builder.
@@ -154,46 +152,25 @@ func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
-// ClearTransactions clears all "transactions" edges to the Transaction entity.
-func (_u *UserUpdate) ClearTransactions() *UserUpdate {
-	_u.mutation.ClearTransactions()
-	return _u
-}
-
-// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
-func (_u *UserUpdate) RemoveTransactionIDs(ids ...uuid.UUID) *UserUpdate {
-	_u.mutation.RemoveTransactionIDs(ids...)
-	return _u
-}
-
-// RemoveTransactions removes "transactions" edges to Transaction entities.
-func (_u *UserUpdate) RemoveTransactions(v ...*Transaction) *UserUpdate {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemoveTransactionIDs(ids...)
-}
-
-// ClearInvoices clears all "invoices" edges to the Invoice entity.
-func (_u *UserUpdate) ClearInvoices() *UserUpdate {
-	_u.mutation.ClearInvoices()
+// ClearLedgers clears all "ledgers" edges to the Ledger entity.
+func (_u *UserUpdate) ClearLedgers() *UserUpdate {
+	_u.mutation.ClearLedgers()
 	return _u
 }
 
-// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
-func (_u *UserUpdate) RemoveInvoiceIDs(ids ...uuid.UUID) *UserUpdate {
-	_u.mutation.RemoveInvoiceIDs(ids...)
+// RemoveLedgerIDs removes the "ledgers" edge to Ledger entities by IDs.
+func (_u *UserUpdate) RemoveLedgerIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveLedgerIDs(ids...)
 	return _u
 }
 
-// RemoveInvoices removes "invoices" edges to Invoice entities.
-func (_u *UserUpdate) RemoveInvoices(v ...*Invoice) *UserUpdate {
+// RemoveLedgers removes "ledgers" edges to Ledger entities.
+func (_u *UserUpdate) RemoveLedgers(v ...*Ledger) *UserUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInvoiceIDs(ids...)
+	return _u.RemoveLedgerIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -254,6 +231,11 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BaseCurrency(); ok {
+		if err := user.BaseCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "base_currency", err: fmt.Errorf(`ent: validator failed for field "User.base_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -293,73 +275,31 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
-	if _u.mutation.TransactionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.TransactionsTable,
-			Columns: []string{user.TransactionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.TransactionsTable,
-			Columns: []string{user.TransactionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.TransactionsTable,
-			Columns: []string{user.TransactionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if value, ok := _u.mutation.BaseCurrency(); ok {
+		_spec.SetField(user.FieldBaseCurrency, field.TypeString, value)
 	}
-	if _u.mutation.InvoicesCleared() {
+	if _u.mutation.LedgersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvoicesTable,
-			Columns: []string{user.InvoicesColumn},
+			Table:   user.LedgersTable,
+			Columns: []string{user.LedgersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+	if nodes := _u.mutation.RemovedLedgersIDs(); len(nodes) > 0 && !_u.mutation.LedgersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvoicesTable,
-			Columns: []string{user.InvoicesColumn},
+			Table:   user.LedgersTable,
+			Columns: []string{user.LedgersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -367,15 +307,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LedgersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvoicesTable,
-			Columns: []string{user.InvoicesColumn},
+			Table:   user.LedgersTable,
+			Columns: []string{user.LedgersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -491,34 +431,33 @@ func (_u *UserUpdateOne) SetNillableIsActive(v *bool) *UserUpdateOne {
 	return _u
 }
 
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
-func (_u *UserUpdateOne) AddTransactionIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddTransactionIDs(ids...)
+// SetBaseCurrency sets the "base_currency" field.
+func (_u *UserUpdateOne) SetBaseCurrency(v string) *UserUpdateOne {
+	_u.mutation.SetBaseCurrency(v)
 	return _u
 }
 
-// AddTransactions adds the "transactions" edges to the Transaction entity.
-func (_u *UserUpdateOne) AddTransactions(v ...*Transaction) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableBaseCurrency sets the "base_currency" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBaseCurrency(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetBaseCurrency(*v)
 	}
-	return _u.AddTransactionIDs(ids...)
+	return _u
 }
 
-// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
-func (_u *UserUpdateOne) AddInvoiceIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddInvoiceIDs(ids...)
+// AddLedgerIDs adds the "ledgers" edge to the Ledger entity by IDs.
+func (_u *UserUpdateOne) AddLedgerIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddLedgerIDs(ids...)
 	return _u
 }
 
-// AddInvoices adds the "invoices" edges to the Invoice entity.
-func (_u *UserUpdateOne) AddInvoices(v ...*Invoice) *UserUpdateOne {
+// AddLedgers adds the "ledgers" edges to the Ledger entity.
+func (_u *UserUpdateOne) AddLedgers(v ...*Ledger) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddInvoiceIDs(ids...)
+	return _u.AddLedgerIDs(ids...)
 }
 
 // Mutation returns the UserMutation object of the builder.
@@ -526,46 +465,25 @@ func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
-// ClearTransactions clears all "transactions" edges to the Transaction entity.
-func (_u *UserUpdateOne) ClearTransactions() *UserUpdateOne {
-	_u.mutation.ClearTransactions()
-	return _u
-}
-
-// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
-func (_u *UserUpdateOne) RemoveTransactionIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveTransactionIDs(ids...)
-	return _u
-}
-
-// RemoveTransactions removes "transactions" edges to Transaction entities.
-func (_u *UserUpdateOne) RemoveTransactions(v ...*Transaction) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemoveTransactionIDs(ids...)
-}
-
-// ClearInvoices clears all "invoices" edges to the Invoice entity.
-func (_u *UserUpdateOne) ClearInvoices() *UserUpdateOne {
-	_u.mutation.ClearInvoices()
+// ClearLedgers clears all "ledgers" edges to the Ledger entity.
+func (_u *UserUpdateOne) ClearLedgers() *UserUpdateOne {
+	_u.mutation.ClearLedgers()
 	return _u
 }
 
-// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
-func (_u *UserUpdateOne) RemoveInvoiceIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveInvoiceIDs(ids...)
+// RemoveLedgerIDs removes the "ledgers" edge to Ledger entities by IDs.
+func (_u *UserUpdateOne) RemoveLedgerIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveLedgerIDs(ids...)
 	return _u
 }
 
-// RemoveInvoices removes "invoices" edges to Invoice entities.
-func (_u *UserUpdateOne) RemoveInvoices(v ...*Invoice) *UserUpdateOne {
+// RemoveLedgers removes "ledgers" edges to Ledger entities.
+func (_u *UserUpdateOne) RemoveLedgers(v ...*Ledger) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInvoiceIDs(ids...)
+	return _u.RemoveLedgerIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -639,6 +557,11 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BaseCurrency(); ok {
+		if err := user.BaseCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "base_currency", err: fmt.Errorf(`ent: validator failed for field "User.base_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -695,73 +618,31 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
-	if _u.mutation.TransactionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.TransactionsTable,
-			Columns: []string{user.TransactionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.TransactionsTable,
-			Columns: []string{user.TransactionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.TransactionsTable,
-			Columns: []string{user.TransactionsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeUUID),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if value, ok := _u.mutation.BaseCurrency(); ok {
+		_spec.SetField(user.FieldBaseCurrency, field.TypeString, value)
 	}
-	if _u.mutation.InvoicesCleared() {
+	if _u.mutation.LedgersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvoicesTable,
-			Columns: []string{user.InvoicesColumn},
+			Table:   user.LedgersTable,
+			Columns: []string{user.LedgersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+	if nodes := _u.mutation.RemovedLedgersIDs(); len(nodes) > 0 && !_u.mutation.LedgersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvoicesTable,
-			Columns: []string{user.InvoicesColumn},
+			Table:   user.LedgersTable,
+			Columns: []string{user.LedgersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -769,15 +650,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LedgersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvoicesTable,
-			Columns: []string{user.InvoicesColumn},
+			Table:   user.LedgersTable,
+			Columns: []string{user.LedgersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
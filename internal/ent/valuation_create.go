@@ -0,0 +1,988 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/valuation"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ValuationCreate is the builder for creating a Valuation entity.
+type ValuationCreate struct {
+	config
+	mutation *ValuationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ValuationCreate) SetCreatedAt(v time.Time) *ValuationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ValuationCreate) SetNillableCreatedAt(v *time.Time) *ValuationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ValuationCreate) SetUpdatedAt(v time.Time) *ValuationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ValuationCreate) SetNillableUpdatedAt(v *time.Time) *ValuationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *ValuationCreate) SetAmount(v domain.Money) *ValuationCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetValueDate sets the "value_date" field.
+func (_c *ValuationCreate) SetValueDate(v time.Time) *ValuationCreate {
+	_c.mutation.SetValueDate(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *ValuationCreate) SetNote(v string) *ValuationCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *ValuationCreate) SetNillableNote(v *string) *ValuationCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *ValuationCreate) SetAssetID(v uuid.UUID) *ValuationCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_c *ValuationCreate) SetNillableAssetID(v *uuid.UUID) *ValuationCreate {
+	if v != nil {
+		_c.SetAssetID(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ValuationCreate) SetAccountID(v uuid.UUID) *ValuationCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *ValuationCreate) SetNillableAccountID(v *uuid.UUID) *ValuationCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ValuationCreate) SetID(v uuid.UUID) *ValuationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ValuationCreate) SetNillableID(v *uuid.UUID) *ValuationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_c *ValuationCreate) SetLedgerID(id uuid.UUID) *ValuationCreate {
+	_c.mutation.SetLedgerID(id)
+	return _c
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_c *ValuationCreate) SetLedger(v *Ledger) *ValuationCreate {
+	return _c.SetLedgerID(v.ID)
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_c *ValuationCreate) SetAsset(v *Asset) *ValuationCreate {
+	return _c.SetAssetID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ValuationCreate) SetAccount(v *Account) *ValuationCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the ValuationMutation object of the builder.
+func (_c *ValuationCreate) Mutation() *ValuationMutation {
+	return _c.mutation
+}
+
+// Save creates the Valuation in the database.
+func (_c *ValuationCreate) Save(ctx context.Context) (*Valuation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ValuationCreate) SaveX(ctx context.Context) *Valuation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValuationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValuationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ValuationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := valuation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := valuation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := valuation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ValuationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Valuation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Valuation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Valuation.amount"`)}
+	}
+	if _, ok := _c.mutation.ValueDate(); !ok {
+		return &ValidationError{Name: "value_date", err: errors.New(`ent: missing required field "Valuation.value_date"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := valuation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Valuation.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.LedgerIDs()) == 0 {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required edge "Valuation.ledger"`)}
+	}
+	return nil
+}
+
+func (_c *ValuationCreate) sqlSave(ctx context.Context) (*Valuation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ValuationCreate) createSpec() (*Valuation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Valuation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(valuation.Table, sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(valuation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(valuation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(valuation.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.ValueDate(); ok {
+		_spec.SetField(valuation.FieldValueDate, field.TypeTime, value)
+		_node.ValueDate = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(valuation.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if nodes := _c.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.LedgerTable,
+			Columns: []string{valuation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ledger_id = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AssetTable,
+			Columns: []string{valuation.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AssetID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AccountTable,
+			Columns: []string{valuation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Valuation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ValuationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ValuationCreate) OnConflict(opts ...sql.ConflictOption) *ValuationUpsertOne {
+	_c.conflict = opts
+	return &ValuationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Valuation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ValuationCreate) OnConflictColumns(columns ...string) *ValuationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ValuationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ValuationUpsertOne is the builder for "upsert"-ing
+	//  one Valuation node.
+	ValuationUpsertOne struct {
+		create *ValuationCreate
+	}
+
+	// ValuationUpsert is the "OnConflict" setter.
+	ValuationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ValuationUpsert) SetUpdatedAt(v time.Time) *ValuationUpsert {
+	u.Set(valuation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ValuationUpsert) UpdateUpdatedAt() *ValuationUpsert {
+	u.SetExcluded(valuation.FieldUpdatedAt)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *ValuationUpsert) SetAmount(v domain.Money) *ValuationUpsert {
+	u.Set(valuation.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ValuationUpsert) UpdateAmount() *ValuationUpsert {
+	u.SetExcluded(valuation.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ValuationUpsert) AddAmount(v domain.Money) *ValuationUpsert {
+	u.Add(valuation.FieldAmount, v)
+	return u
+}
+
+// SetValueDate sets the "value_date" field.
+func (u *ValuationUpsert) SetValueDate(v time.Time) *ValuationUpsert {
+	u.Set(valuation.FieldValueDate, v)
+	return u
+}
+
+// UpdateValueDate sets the "value_date" field to the value that was provided on create.
+func (u *ValuationUpsert) UpdateValueDate() *ValuationUpsert {
+	u.SetExcluded(valuation.FieldValueDate)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *ValuationUpsert) SetNote(v string) *ValuationUpsert {
+	u.Set(valuation.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ValuationUpsert) UpdateNote() *ValuationUpsert {
+	u.SetExcluded(valuation.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ValuationUpsert) ClearNote() *ValuationUpsert {
+	u.SetNull(valuation.FieldNote)
+	return u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (u *ValuationUpsert) SetAssetID(v uuid.UUID) *ValuationUpsert {
+	u.Set(valuation.FieldAssetID, v)
+	return u
+}
+
+// UpdateAssetID sets the "asset_id" field to the value that was provided on create.
+func (u *ValuationUpsert) UpdateAssetID() *ValuationUpsert {
+	u.SetExcluded(valuation.FieldAssetID)
+	return u
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (u *ValuationUpsert) ClearAssetID() *ValuationUpsert {
+	u.SetNull(valuation.FieldAssetID)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ValuationUpsert) SetAccountID(v uuid.UUID) *ValuationUpsert {
+	u.Set(valuation.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ValuationUpsert) UpdateAccountID() *ValuationUpsert {
+	u.SetExcluded(valuation.FieldAccountID)
+	return u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *ValuationUpsert) ClearAccountID() *ValuationUpsert {
+	u.SetNull(valuation.FieldAccountID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Valuation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(valuation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ValuationUpsertOne) UpdateNewValues() *ValuationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(valuation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(valuation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Valuation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ValuationUpsertOne) Ignore() *ValuationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ValuationUpsertOne) DoNothing() *ValuationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ValuationCreate.OnConflict
+// documentation for more info.
+func (u *ValuationUpsertOne) Update(set func(*ValuationUpsert)) *ValuationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ValuationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ValuationUpsertOne) SetUpdatedAt(v time.Time) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ValuationUpsertOne) UpdateUpdatedAt() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ValuationUpsertOne) SetAmount(v domain.Money) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ValuationUpsertOne) AddAmount(v domain.Money) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ValuationUpsertOne) UpdateAmount() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetValueDate sets the "value_date" field.
+func (u *ValuationUpsertOne) SetValueDate(v time.Time) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetValueDate(v)
+	})
+}
+
+// UpdateValueDate sets the "value_date" field to the value that was provided on create.
+func (u *ValuationUpsertOne) UpdateValueDate() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateValueDate()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *ValuationUpsertOne) SetNote(v string) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ValuationUpsertOne) UpdateNote() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ValuationUpsertOne) ClearNote() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetAssetID sets the "asset_id" field.
+func (u *ValuationUpsertOne) SetAssetID(v uuid.UUID) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetAssetID(v)
+	})
+}
+
+// UpdateAssetID sets the "asset_id" field to the value that was provided on create.
+func (u *ValuationUpsertOne) UpdateAssetID() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateAssetID()
+	})
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (u *ValuationUpsertOne) ClearAssetID() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.ClearAssetID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ValuationUpsertOne) SetAccountID(v uuid.UUID) *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ValuationUpsertOne) UpdateAccountID() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *ValuationUpsertOne) ClearAccountID() *ValuationUpsertOne {
+	return u.Update(func(s *ValuationUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *ValuationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ValuationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ValuationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ValuationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ValuationUpsertOne.ID is not supported by MySQL driver. Use ValuationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ValuationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ValuationCreateBulk is the builder for creating many Valuation entities in bulk.
+type ValuationCreateBulk struct {
+	config
+	err      error
+	builders []*ValuationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Valuation entities in the database.
+func (_c *ValuationCreateBulk) Save(ctx context.Context) ([]*Valuation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Valuation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ValuationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ValuationCreateBulk) SaveX(ctx context.Context) []*Valuation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValuationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValuationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Valuation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ValuationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ValuationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ValuationUpsertBulk {
+	_c.conflict = opts
+	return &ValuationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Valuation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ValuationCreateBulk) OnConflictColumns(columns ...string) *ValuationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ValuationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ValuationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Valuation nodes.
+type ValuationUpsertBulk struct {
+	create *ValuationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Valuation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(valuation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ValuationUpsertBulk) UpdateNewValues() *ValuationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(valuation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(valuation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Valuation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ValuationUpsertBulk) Ignore() *ValuationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ValuationUpsertBulk) DoNothing() *ValuationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ValuationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ValuationUpsertBulk) Update(set func(*ValuationUpsert)) *ValuationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ValuationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ValuationUpsertBulk) SetUpdatedAt(v time.Time) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ValuationUpsertBulk) UpdateUpdatedAt() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ValuationUpsertBulk) SetAmount(v domain.Money) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ValuationUpsertBulk) AddAmount(v domain.Money) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ValuationUpsertBulk) UpdateAmount() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetValueDate sets the "value_date" field.
+func (u *ValuationUpsertBulk) SetValueDate(v time.Time) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetValueDate(v)
+	})
+}
+
+// UpdateValueDate sets the "value_date" field to the value that was provided on create.
+func (u *ValuationUpsertBulk) UpdateValueDate() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateValueDate()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *ValuationUpsertBulk) SetNote(v string) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ValuationUpsertBulk) UpdateNote() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ValuationUpsertBulk) ClearNote() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetAssetID sets the "asset_id" field.
+func (u *ValuationUpsertBulk) SetAssetID(v uuid.UUID) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetAssetID(v)
+	})
+}
+
+// UpdateAssetID sets the "asset_id" field to the value that was provided on create.
+func (u *ValuationUpsertBulk) UpdateAssetID() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateAssetID()
+	})
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (u *ValuationUpsertBulk) ClearAssetID() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.ClearAssetID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *ValuationUpsertBulk) SetAccountID(v uuid.UUID) *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *ValuationUpsertBulk) UpdateAccountID() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (u *ValuationUpsertBulk) ClearAccountID() *ValuationUpsertBulk {
+	return u.Update(func(s *ValuationUpsert) {
+		s.ClearAccountID()
+	})
+}
+
+// Exec executes the query.
+func (u *ValuationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ValuationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ValuationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ValuationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/ent/account"
+	"frog-go/internal/ent/asset"
+	"frog-go/internal/ent/ledger"
+	"frog-go/internal/ent/predicate"
+	"frog-go/internal/ent/valuation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ValuationUpdate is the builder for updating Valuation entities.
+type ValuationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ValuationMutation
+}
+
+// Where appends a list predicates to the ValuationUpdate builder.
+func (_u *ValuationUpdate) Where(ps ...predicate.Valuation) *ValuationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValuationUpdate) SetUpdatedAt(v time.Time) *ValuationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ValuationUpdate) SetAmount(v domain.Money) *ValuationUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ValuationUpdate) SetNillableAmount(v *domain.Money) *ValuationUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ValuationUpdate) AddAmount(v domain.Money) *ValuationUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *ValuationUpdate) SetValueDate(v time.Time) *ValuationUpdate {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *ValuationUpdate) SetNillableValueDate(v *time.Time) *ValuationUpdate {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ValuationUpdate) SetNote(v string) *ValuationUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ValuationUpdate) SetNillableNote(v *string) *ValuationUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *ValuationUpdate) ClearNote() *ValuationUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *ValuationUpdate) SetAssetID(v uuid.UUID) *ValuationUpdate {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *ValuationUpdate) SetNillableAssetID(v *uuid.UUID) *ValuationUpdate {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (_u *ValuationUpdate) ClearAssetID() *ValuationUpdate {
+	_u.mutation.ClearAssetID()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ValuationUpdate) SetAccountID(v uuid.UUID) *ValuationUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ValuationUpdate) SetNillableAccountID(v *uuid.UUID) *ValuationUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *ValuationUpdate) ClearAccountID() *ValuationUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *ValuationUpdate) SetLedgerID(id uuid.UUID) *ValuationUpdate {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *ValuationUpdate) SetLedger(v *Ledger) *ValuationUpdate {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_u *ValuationUpdate) SetAsset(v *Asset) *ValuationUpdate {
+	return _u.SetAssetID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ValuationUpdate) SetAccount(v *Account) *ValuationUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ValuationMutation object of the builder.
+func (_u *ValuationUpdate) Mutation() *ValuationMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *ValuationUpdate) ClearLedger() *ValuationUpdate {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (_u *ValuationUpdate) ClearAsset() *ValuationUpdate {
+	_u.mutation.ClearAsset()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ValuationUpdate) ClearAccount() *ValuationUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ValuationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValuationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ValuationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValuationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValuationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := valuation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValuationUpdate) check() error {
+	if v, ok := _u.mutation.Note(); ok {
+		if err := valuation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Valuation.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Valuation.ledger"`)
+	}
+	return nil
+}
+
+func (_u *ValuationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(valuation.Table, valuation.Columns, sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(valuation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(valuation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(valuation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(valuation.FieldValueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(valuation.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(valuation.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.LedgerTable,
+			Columns: []string{valuation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.LedgerTable,
+			Columns: []string{valuation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AssetTable,
+			Columns: []string{valuation.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AssetTable,
+			Columns: []string{valuation.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AccountTable,
+			Columns: []string{valuation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AccountTable,
+			Columns: []string{valuation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{valuation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ValuationUpdateOne is the builder for updating a single Valuation entity.
+type ValuationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ValuationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValuationUpdateOne) SetUpdatedAt(v time.Time) *ValuationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ValuationUpdateOne) SetAmount(v domain.Money) *ValuationUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ValuationUpdateOne) SetNillableAmount(v *domain.Money) *ValuationUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ValuationUpdateOne) AddAmount(v domain.Money) *ValuationUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *ValuationUpdateOne) SetValueDate(v time.Time) *ValuationUpdateOne {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *ValuationUpdateOne) SetNillableValueDate(v *time.Time) *ValuationUpdateOne {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ValuationUpdateOne) SetNote(v string) *ValuationUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ValuationUpdateOne) SetNillableNote(v *string) *ValuationUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *ValuationUpdateOne) ClearNote() *ValuationUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *ValuationUpdateOne) SetAssetID(v uuid.UUID) *ValuationUpdateOne {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *ValuationUpdateOne) SetNillableAssetID(v *uuid.UUID) *ValuationUpdateOne {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// ClearAssetID clears the value of the "asset_id" field.
+func (_u *ValuationUpdateOne) ClearAssetID() *ValuationUpdateOne {
+	_u.mutation.ClearAssetID()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *ValuationUpdateOne) SetAccountID(v uuid.UUID) *ValuationUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *ValuationUpdateOne) SetNillableAccountID(v *uuid.UUID) *ValuationUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *ValuationUpdateOne) ClearAccountID() *ValuationUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
+// SetLedgerID sets the "ledger" edge to the Ledger entity by ID.
+func (_u *ValuationUpdateOne) SetLedgerID(id uuid.UUID) *ValuationUpdateOne {
+	_u.mutation.SetLedgerID(id)
+	return _u
+}
+
+// SetLedger sets the "ledger" edge to the Ledger entity.
+func (_u *ValuationUpdateOne) SetLedger(v *Ledger) *ValuationUpdateOne {
+	return _u.SetLedgerID(v.ID)
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_u *ValuationUpdateOne) SetAsset(v *Asset) *ValuationUpdateOne {
+	return _u.SetAssetID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *ValuationUpdateOne) SetAccount(v *Account) *ValuationUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the ValuationMutation object of the builder.
+func (_u *ValuationUpdateOne) Mutation() *ValuationMutation {
+	return _u.mutation
+}
+
+// ClearLedger clears the "ledger" edge to the Ledger entity.
+func (_u *ValuationUpdateOne) ClearLedger() *ValuationUpdateOne {
+	_u.mutation.ClearLedger()
+	return _u
+}
+
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (_u *ValuationUpdateOne) ClearAsset() *ValuationUpdateOne {
+	_u.mutation.ClearAsset()
+	return _u
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *ValuationUpdateOne) ClearAccount() *ValuationUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the ValuationUpdate builder.
+func (_u *ValuationUpdateOne) Where(ps ...predicate.Valuation) *ValuationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ValuationUpdateOne) Select(field string, fields ...string) *ValuationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Valuation entity.
+func (_u *ValuationUpdateOne) Save(ctx context.Context) (*Valuation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValuationUpdateOne) SaveX(ctx context.Context) *Valuation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ValuationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValuationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValuationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := valuation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValuationUpdateOne) check() error {
+	if v, ok := _u.mutation.Note(); ok {
+		if err := valuation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Valuation.note": %w`, err)}
+		}
+	}
+	if _u.mutation.LedgerCleared() && len(_u.mutation.LedgerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Valuation.ledger"`)
+	}
+	return nil
+}
+
+func (_u *ValuationUpdateOne) sqlSave(ctx context.Context) (_node *Valuation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(valuation.Table, valuation.Columns, sqlgraph.NewFieldSpec(valuation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Valuation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, valuation.FieldID)
+		for _, f := range fields {
+			if !valuation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != valuation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(valuation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(valuation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(valuation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(valuation.FieldValueDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(valuation.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(valuation.FieldNote, field.TypeString)
+	}
+	if _u.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.LedgerTable,
+			Columns: []string{valuation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.LedgerTable,
+			Columns: []string{valuation.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AssetTable,
+			Columns: []string{valuation.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AssetTable,
+			Columns: []string{valuation.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AccountTable,
+			Columns: []string{valuation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   valuation.AccountTable,
+			Columns: []string{valuation.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Valuation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{valuation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountHandler struct {
+	service inbound.AccountService
+}
+
+func NewAccountHandler(service inbound.AccountService) *AccountHandler {
+	return &AccountHandler{service: service}
+}
+
+// CreateAccountHandler godoc
+// @Summary Cria uma nova conta
+// @Description Cria uma nova conta (corrente, poupança, cartão, etc.) com os dados fornecidos no corpo da requisição
+// @Tags Contas
+// @Accept json
+// @Produce json
+// @Param request body dto.AccountRequest true "Dados da conta"
+// @Success 201 {object} dto.AccountResponse
+// @Security BearerAuth
+// @Router /api/v1/accounts [post]
+func (h *AccountHandler) CreateAccountHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.AccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateAccount(ctx, ledgerID, userID, *input)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListAccountsHandler godoc
+// @Summary Lista contas com filtros e paginação
+// @Description Lista todas as contas do usuário aplicando filtros e paginação
+// @Tags Contas
+// @Accept json
+// @Produce json
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: name)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.AccountResponse
+// @Security BearerAuth
+// @Router /api/v1/accounts [get]
+func (h *AccountHandler) ListAccountsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":           true,
+		"name":         true,
+		"account_type": true,
+		"created_at":   true,
+		"updated_at":   true,
+	}
+
+	if err := pgn.ValidateOrderBy("name", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListAccounts(ctx, ledgerID, pgn)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
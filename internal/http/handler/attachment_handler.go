@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/core/domain"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/utilsctx"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AttachmentHandler struct {
+	service inbound.AttachmentService
+}
+
+func NewAttachmentHandler(service inbound.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{service: service}
+}
+
+// CreateTransactionAttachmentHandler godoc
+// @Summary Anexa um arquivo à transação
+// @Description Envia um comprovante (PDF ou foto) para a transação. O tipo é identificado pelo conteúdo e o tamanho máximo é de 10 MB
+// @Tags Anexos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID da transação"
+// @Param file formData file true "Arquivo a ser anexado"
+// @Success 201 {object} dto.AttachmentResponse
+// @Failure 413 {object} map[string]string "Arquivo maior que o permitido"
+// @Failure 415 {object} map[string]string "Tipo de arquivo não permitido"
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/attachments [post]
+func (h *AttachmentHandler) CreateTransactionAttachmentHandler(c *gin.Context) {
+	h.create(c, transactionAttachmentTarget)
+}
+
+// ListTransactionAttachmentsHandler godoc
+// @Summary Lista os anexos da transação
+// @Tags Anexos
+// @Produce json
+// @Param id path string true "ID da transação"
+// @Success 200 {array} dto.AttachmentResponse
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/attachments [get]
+func (h *AttachmentHandler) ListTransactionAttachmentsHandler(c *gin.Context) {
+	h.list(c, transactionAttachmentTarget)
+}
+
+// DownloadTransactionAttachmentHandler godoc
+// @Summary Baixa um anexo da transação
+// @Tags Anexos
+// @Produce octet-stream
+// @Param id path string true "ID da transação"
+// @Param attachment_id path string true "ID do anexo"
+// @Success 200 {file} file
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/attachments/{attachment_id} [get]
+func (h *AttachmentHandler) DownloadTransactionAttachmentHandler(c *gin.Context) {
+	h.download(c, transactionAttachmentTarget)
+}
+
+// DeleteTransactionAttachmentHandler godoc
+// @Summary Remove um anexo da transação
+// @Tags Anexos
+// @Param id path string true "ID da transação"
+// @Param attachment_id path string true "ID do anexo"
+// @Success 204
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/attachments/{attachment_id} [delete]
+func (h *AttachmentHandler) DeleteTransactionAttachmentHandler(c *gin.Context) {
+	h.delete(c, transactionAttachmentTarget)
+}
+
+// CreateInvoiceAttachmentHandler godoc
+// @Summary Anexa um arquivo à fatura
+// @Description Envia o arquivo da fatura ou do extrato (PDF, CSV, XLSX ou OFX). O tipo é identificado pelo conteúdo e o tamanho máximo é de 10 MB
+// @Tags Anexos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Param file formData file true "Arquivo a ser anexado"
+// @Success 201 {object} dto.AttachmentResponse
+// @Failure 413 {object} map[string]string "Arquivo maior que o permitido"
+// @Failure 415 {object} map[string]string "Tipo de arquivo não permitido"
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/attachments [post]
+func (h *AttachmentHandler) CreateInvoiceAttachmentHandler(c *gin.Context) {
+	h.create(c, invoiceAttachmentTarget)
+}
+
+// ListInvoiceAttachmentsHandler godoc
+// @Summary Lista os anexos da fatura
+// @Tags Anexos
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Success 200 {array} dto.AttachmentResponse
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/attachments [get]
+func (h *AttachmentHandler) ListInvoiceAttachmentsHandler(c *gin.Context) {
+	h.list(c, invoiceAttachmentTarget)
+}
+
+// DownloadInvoiceAttachmentHandler godoc
+// @Summary Baixa um anexo da fatura
+// @Tags Anexos
+// @Produce octet-stream
+// @Param id path string true "ID da fatura"
+// @Param attachment_id path string true "ID do anexo"
+// @Success 200 {file} file
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/attachments/{attachment_id} [get]
+func (h *AttachmentHandler) DownloadInvoiceAttachmentHandler(c *gin.Context) {
+	h.download(c, invoiceAttachmentTarget)
+}
+
+// DeleteInvoiceAttachmentHandler godoc
+// @Summary Remove um anexo da fatura
+// @Tags Anexos
+// @Param id path string true "ID da fatura"
+// @Param attachment_id path string true "ID do anexo"
+// @Success 204
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/attachments/{attachment_id} [delete]
+func (h *AttachmentHandler) DeleteInvoiceAttachmentHandler(c *gin.Context) {
+	h.delete(c, invoiceAttachmentTarget)
+}
+
+// ListImportAttachmentsHandler godoc
+// @Summary Lista o arquivo original de uma importação
+// @Description Retorna o arquivo enviado para o job de importação, arquivado no momento do upload
+// @Tags Upload
+// @Produce json
+// @Param job_id path string true "ID do job de importação"
+// @Success 200 {array} dto.AttachmentResponse
+// @Security BearerAuth
+// @Router /api/v1/upload/{job_id}/attachments [get]
+func (h *AttachmentHandler) ListImportAttachmentsHandler(c *gin.Context) {
+	h.list(c, importAttachmentTarget)
+}
+
+// DownloadImportAttachmentHandler godoc
+// @Summary Baixa o arquivo original de uma importação
+// @Tags Upload
+// @Produce octet-stream
+// @Param job_id path string true "ID do job de importação"
+// @Param attachment_id path string true "ID do anexo"
+// @Success 200 {file} file
+// @Security BearerAuth
+// @Router /api/v1/upload/{job_id}/attachments/{attachment_id} [get]
+func (h *AttachmentHandler) DownloadImportAttachmentHandler(c *gin.Context) {
+	h.download(c, importAttachmentTarget)
+}
+
+type attachmentTargetFunc func(c *gin.Context) (domain.AttachmentTarget, error)
+
+func transactionAttachmentTarget(c *gin.Context) (domain.AttachmentTarget, error) {
+	id, err := utils.ToUUID(c.Param("id"))
+	return domain.AttachmentTarget{TransactionID: &id}, err
+}
+
+func invoiceAttachmentTarget(c *gin.Context) (domain.AttachmentTarget, error) {
+	id, err := utils.ToUUID(c.Param("id"))
+	return domain.AttachmentTarget{InvoiceID: &id}, err
+}
+
+func importAttachmentTarget(c *gin.Context) (domain.AttachmentTarget, error) {
+	id, err := utils.ToUUID(c.Param("job_id"))
+	return domain.AttachmentTarget{ImportJobID: &id}, err
+}
+
+func (h *AttachmentHandler) create(c *gin.Context, targetOf attachmentTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, err := targetOf(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	file, fileHeader, err := c.Request.FormFile("file")
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, appError.InvalidParam("file", err)))
+		return
+	}
+	defer file.Close()
+
+	data, err := h.service.CreateAttachment(ctx, ledgerID, target, file, fileHeader)
+	if err != nil {
+		c.Error(attachmentError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+func (h *AttachmentHandler) list(c *gin.Context, targetOf attachmentTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, err := targetOf(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListAttachments(ctx, ledgerID, target)
+	if err != nil {
+		c.Error(attachmentError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+func (h *AttachmentHandler) download(c *gin.Context, targetOf attachmentTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, id, err := attachmentParams(c, targetOf)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, content, err := h.service.OpenAttachment(ctx, ledgerID, target, id)
+	if err != nil {
+		c.Error(attachmentError(err))
+		return
+	}
+	defer content.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": data.Filename})
+	c.DataFromReader(http.StatusOK, data.Size, data.ContentType, content, map[string]string{
+		"Content-Disposition": disposition,
+	})
+}
+
+func (h *AttachmentHandler) delete(c *gin.Context, targetOf attachmentTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, id, err := attachmentParams(c, targetOf)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteAttachmentByID(ctx, ledgerID, target, id); err != nil {
+		c.Error(attachmentError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func attachmentParams(c *gin.Context, targetOf attachmentTargetFunc) (domain.AttachmentTarget, uuid.UUID, error) {
+	target, err := targetOf(c)
+	if err != nil {
+		return target, uuid.Nil, err
+	}
+
+	id, err := utils.ToUUID(c.Param("attachment_id"))
+	return target, id, err
+}
+
+func attachmentError(err error) *appError.AppError {
+	switch {
+	case errors.Is(err, appError.ErrEmptyField):
+		return appError.NewAppError(http.StatusBadRequest, err)
+	case errors.Is(err, appError.ErrAttachmentTooLarge):
+		return appError.NewAppError(http.StatusRequestEntityTooLarge, err)
+	case errors.Is(err, appError.ErrAttachmentType):
+		return appError.NewAppError(http.StatusUnsupportedMediaType, err)
+	case errors.Is(err, appError.ErrNotFound), errors.Is(err, appError.ErrBlobNotFound):
+		return appError.NewAppError(http.StatusNotFound, err)
+	default:
+		return appError.NewAppError(http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,290 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetHandler struct {
+	service inbound.BudgetService
+}
+
+func NewBudgetHandler(service inbound.BudgetService) *BudgetHandler {
+	return &BudgetHandler{service: service}
+}
+
+// CreateBudgetHandler godoc
+// @Summary Cria um orçamento
+// @Description Cria o orçamento de uma categoria para um mês (month no formato 2026-10) ou, sem month, recorrente para todos os meses. O limite é um valor fixo (amount) ou um percentual da receita do mês (percentage); sem nenhum dos dois, vale o percentual sugerido da categoria
+// @Tags Orçamentos
+// @Accept json
+// @Produce json
+// @Param request body dto.BudgetRequest true "Dados do orçamento"
+// @Success 201 {object} dto.BudgetResponse
+// @Security BearerAuth
+// @Router /api/v1/budgets [post]
+func (h *BudgetHandler) CreateBudgetHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.BudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateBudget(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrBudgetConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrBudgetAmountRequired) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetBudgetByIDHandler godoc
+// @Summary Busca um orçamento por ID
+// @Tags Orçamentos
+// @Produce json
+// @Param id path string true "ID do orçamento"
+// @Success 200 {object} dto.BudgetResponse
+// @Security BearerAuth
+// @Router /api/v1/budgets/{id} [get]
+func (h *BudgetHandler) GetBudgetByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetBudgetByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListBudgetsHandler godoc
+// @Summary Lista orçamentos com filtros e paginação
+// @Tags Orçamentos
+// @Produce json
+// @Param category_ids query []string false "Filtrar por categorias"
+// @Param month query string false "Filtrar pelo mês (ex: 2026-10)"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: month)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.BudgetResponse
+// @Security BearerAuth
+// @Router /api/v1/budgets [get]
+func (h *BudgetHandler) ListBudgetsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.BudgetFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"month":      true,
+		"amount":     true,
+		"percentage": true,
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("created_at", config.OrderDesc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListBudgets(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// BudgetsStatusHandler godoc
+// @Summary Situação dos orçamentos no mês
+// @Description Para cada orçamento do mês (o do próprio mês prevalece sobre o recorrente) mostra o valor orçado, o gasto da categoria e de suas subcategorias, o saldo restante e a projeção de gasto no fim do mês pelo ritmo diário atual. Transações canceladas não contam
+// @Tags Orçamentos
+// @Produce json
+// @Param month query string false "Mês (ex: 2026-10); padrão é o mês atual"
+// @Success 200 {array} dto.BudgetStatusResponse
+// @Security BearerAuth
+// @Router /api/v1/budgets/status [get]
+func (h *BudgetHandler) BudgetsStatusHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.BudgetStatusFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.BudgetsStatus(ctx, ledgerID, flt)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// UpdateBudgetHandler godoc
+// @Summary Atualiza um orçamento
+// @Description Substitui os dados do orçamento; os alertas do mês recomeçam
+// @Tags Orçamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do orçamento"
+// @Param request body dto.BudgetRequest true "Dados atualizados do orçamento"
+// @Success 200 {object} dto.BudgetResponse
+// @Security BearerAuth
+// @Router /api/v1/budgets/{id} [put]
+func (h *BudgetHandler) UpdateBudgetHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.BudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateBudget(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrBudgetConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrBudgetAmountRequired) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteBudgetHandler godoc
+// @Summary Remove um orçamento
+// @Tags Orçamentos
+// @Param id path string true "ID do orçamento"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/budgets/{id} [delete]
+func (h *BudgetHandler) DeleteBudgetHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteBudgetByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CashFlowHandler struct {
+	service inbound.CashFlowService
+}
+
+func NewCashFlowHandler(service inbound.CashFlowService) *CashFlowHandler {
+	return &CashFlowHandler{service: service}
+}
+
+// CashFlowForecastHandler godoc
+// @Summary Previsão de fluxo de caixa
+// @Description Projeta o saldo diário de cada conta e o total, na moeda base, de hoje até days dias à frente (padrão 90, máximo 365). Parte da avaliação mais recente de cada conta e considera as transações pendentes, as parcelas de empréstimos, as faturas em aberto ou vencidas no vencimento, as ocorrências das regras recorrentes ainda não lançadas, as parcelas futuras das compras parceladas no cartão e a média diária dos gastos pagos por categoria nos últimos lookback_days dias (padrão 90), sem as compras no cartão e as ocorrências de regras recorrentes. Itens sem conta e os gastos estimados entram só no total. first_negative_date aponta o primeiro dia em que algum saldo fica negativo
+// @Tags Fluxo de caixa
+// @Produce json
+// @Param days query int false "Dias de previsão"
+// @Param lookback_days query int false "Dias usados na média de gastos variáveis"
+// @Success 200 {object} dto.CashFlowForecastResponse
+// @Failure 422 {object} map[string]string "Cotação não encontrada para a moeda de uma conta"
+// @Security BearerAuth
+// @Router /api/v1/cash-flow/forecast [get]
+func (h *CashFlowHandler) CashFlowForecastHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.CashFlowFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CashFlowForecast(ctx, ledgerID, flt)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		if errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
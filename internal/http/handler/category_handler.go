@@ -9,6 +9,7 @@ import (
 	"frog-go/internal/core/ports/inbound"
 	"frog-go/internal/utils"
 	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -30,10 +31,23 @@ func NewCategoryHandler(service inbound.CategoryService) *CategoryHandler {
 // @Produce json
 // @Param request body dto.CategoryRequest true "Dados da categoria"
 // @Success 201 {object} dto.CategoryResponse
+// @Failure 422 {object} map[string]string "Categoria pai inválida"
 // @Security BearerAuth
 // @Router /api/v1/categories [post]
 func (h *CategoryHandler) CreateCategoryHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	var req dto.CategoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
@@ -46,9 +60,9 @@ func (h *CategoryHandler) CreateCategoryHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.CreateCategory(ctx, *input)
+	data, err := h.service.CreateCategory(ctx, ledgerID, userID, *input)
 	if err != nil {
-		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		c.Error(categoryHierarchyError(err))
 		return
 	}
 
@@ -67,13 +81,19 @@ func (h *CategoryHandler) CreateCategoryHandler(c *gin.Context) {
 // @Router /api/v1/categories/{id} [get]
 func (h *CategoryHandler) GetCategoryByIDHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	id, err := utils.ToUUID(c.Param("id"))
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
 		return
 	}
 
-	data, err := h.service.GetCategoryByID(ctx, id)
+	data, err := h.service.GetCategoryByID(ctx, ledgerID, id)
 	if err != nil {
 		if errors.Is(err, appError.ErrNotFound) {
 			c.Error(appError.NewAppError(http.StatusNotFound, err))
@@ -101,6 +121,12 @@ func (h *CategoryHandler) GetCategoryByIDHandler(c *gin.Context) {
 // @Router /api/v1/categories [get]
 func (h *CategoryHandler) ListCategorysHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	pgn, err := pagination.NewPagination(c)
 
 	if err != nil {
@@ -121,7 +147,7 @@ func (h *CategoryHandler) ListCategorysHandler(c *gin.Context) {
 
 	fmt.Printf("%v", pgn)
 
-	response, total, err := h.service.ListCategories(ctx, pgn)
+	response, total, err := h.service.ListCategories(ctx, ledgerID, pgn)
 
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
@@ -142,10 +168,17 @@ func (h *CategoryHandler) ListCategorysHandler(c *gin.Context) {
 // @Param id path string true "ID da categoria"
 // @Param request body dto.CategoryRequest true "Dados atualizados da categoria"
 // @Success 200 {object} dto.CategoryResponse
+// @Failure 422 {object} map[string]string "Categoria pai inválida ou ciclo na hierarquia"
 // @Security BearerAuth
 // @Router /api/v1/categories/{id} [put]
 func (h *CategoryHandler) UpdateCategoryHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	id, err := utils.ToUUID(c.Param("id"))
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
@@ -164,9 +197,9 @@ func (h *CategoryHandler) UpdateCategoryHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.UpdateCategory(ctx, id, *input)
+	data, err := h.service.UpdateCategory(ctx, ledgerID, id, *input)
 	if err != nil {
-		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		c.Error(categoryHierarchyError(err))
 		return
 	}
 
@@ -185,13 +218,19 @@ func (h *CategoryHandler) UpdateCategoryHandler(c *gin.Context) {
 // @Router /api/v1/categories/{id} [delete]
 func (h *CategoryHandler) DeleteCategoryHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	id, err := utils.ToUUID(c.Param("id"))
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
 		return
 	}
 
-	err = h.service.DeleteCategoryByID(ctx, id)
+	err = h.service.DeleteCategoryByID(ctx, ledgerID, id)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
@@ -199,3 +238,72 @@ func (h *CategoryHandler) DeleteCategoryHandler(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// ListCategoryTreeHandler godoc
+// @Summary Lista categorias em árvore
+// @Description Retorna todas as categorias do usuário aninhadas a partir das categorias raiz
+// @Tags Categorias
+// @Produce json
+// @Success 200 {array} dto.CategoryResponse
+// @Security BearerAuth
+// @Router /api/v1/categories/tree [get]
+func (h *CategoryHandler) ListCategoryTreeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	response, err := h.service.ListCategoryTree(ctx, ledgerID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SuggestCategoryHandler godoc
+// @Summary Sugere uma categoria para um título
+// @Description Aplica as regras do usuário, o classificador treinado com o histórico e as palavras-chave, retornando a categoria escolhida e as alternativas mais prováveis
+// @Tags Categorias
+// @Produce json
+// @Param title query string true "Título da transação"
+// @Param amount query number false "Valor da transação"
+// @Success 200 {object} dto.CategorySuggestionResponse
+// @Security BearerAuth
+// @Router /api/v1/categories/suggest [get]
+func (h *CategoryHandler) SuggestCategoryHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.CategorySuggestFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, err := h.service.SuggestCategory(ctx, ledgerID, flt)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func categoryHierarchyError(err error) *appError.AppError {
+	switch {
+	case errors.Is(err, appError.ErrCategoryNotFound), errors.Is(err, appError.ErrCategoryCycle):
+		return appError.NewAppError(http.StatusUnprocessableEntity, err)
+	case errors.Is(err, appError.ErrNotFound):
+		return appError.NewAppError(http.StatusNotFound, err)
+	default:
+		return appError.NewAppError(http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DebtPlanHandler struct {
+	service inbound.DebtPlanService
+}
+
+func NewDebtPlanHandler(service inbound.DebtPlanService) *DebtPlanHandler {
+	return &DebtPlanHandler{service: service}
+}
+
+// PlanDebtsHandler godoc
+// @Summary Planeja a quitação das dívidas
+// @Description Simula mês a mês a quitação das faturas em aberto e dos empréstimos com o orçamento mensal informado. Todo mês os mínimos são pagos e o restante vai para a dívida prioritária: menor saldo no snowball, maior taxa no avalanche e a ordem de custom_order na estratégia custom. card_interest_rate é o percentual ao mês sobre o saldo das faturas e card_minimum_percentage o mínimo pago delas (padrão 15%). Retorna a data de quitação de cada dívida, os juros totais e a comparação entre as estratégias
+// @Tags Planejamento de dívidas
+// @Accept json
+// @Produce json
+// @Param request body dto.DebtPlanRequest true "Parâmetros do planejamento"
+// @Success 200 {object} dto.DebtPlanResponse
+// @Failure 422 {object} map[string]string "Orçamento insuficiente para os mínimos ou para quitar as dívidas no prazo máximo, ou cotação ausente para a moeda"
+// @Security BearerAuth
+// @Router /api/v1/debts/plan [post]
+func (h *DebtPlanHandler) PlanDebtsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.DebtPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.PlanDebts(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		if errors.Is(err, appError.ErrDebtBudgetTooLow) || errors.Is(err, appError.ErrDebtPlanTooLong) ||
+			errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
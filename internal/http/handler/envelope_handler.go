@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EnvelopeHandler struct {
+	service inbound.EnvelopeService
+}
+
+func NewEnvelopeHandler(service inbound.EnvelopeService) *EnvelopeHandler {
+	return &EnvelopeHandler{service: service}
+}
+
+// EnvelopesMonthHandler godoc
+// @Summary Envelopes do mês
+// @Description Orçamento base zero: para cada categoria mostra o saldo vindo dos meses anteriores, o valor atribuído no mês, o gasto e o disponível. to_be_assigned é a receita acumulada que ainda não foi atribuída a nenhum envelope. Transações canceladas não contam
+// @Tags Envelopes
+// @Produce json
+// @Param month query string false "Mês (ex: 2026-10); padrão é o mês atual"
+// @Success 200 {object} dto.EnvelopeMonthResponse
+// @Security BearerAuth
+// @Router /api/v1/envelopes [get]
+func (h *EnvelopeHandler) EnvelopesMonthHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.EnvelopeFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.EnvelopesMonth(ctx, ledgerID, flt)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// CreateEnvelopeAllocationHandler godoc
+// @Summary Atribui dinheiro a um envelope
+// @Description Atribui (valor positivo) ou retira (valor negativo) dinheiro do envelope de uma categoria no mês informado (ex: 2026-10)
+// @Tags Envelopes
+// @Accept json
+// @Produce json
+// @Param request body dto.EnvelopeAllocationRequest true "Dados da atribuição"
+// @Success 201 {object} dto.EnvelopeAllocationResponse
+// @Security BearerAuth
+// @Router /api/v1/envelopes/allocations [post]
+func (h *EnvelopeHandler) CreateEnvelopeAllocationHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.EnvelopeAllocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateEnvelopeAllocation(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrCategoryNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// MoveEnvelopeMoneyHandler godoc
+// @Summary Move dinheiro entre envelopes
+// @Description Retira o valor do envelope de origem e o atribui ao de destino no mesmo mês. Os dois lançamentos ficam ligados pelo mesmo transfer_id
+// @Tags Envelopes
+// @Accept json
+// @Produce json
+// @Param request body dto.EnvelopeMoveRequest true "Dados da movimentação"
+// @Success 201 {array} dto.EnvelopeAllocationResponse
+// @Security BearerAuth
+// @Router /api/v1/envelopes/moves [post]
+func (h *EnvelopeHandler) MoveEnvelopeMoneyHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.EnvelopeMoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.MoveEnvelopeMoney(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrCategoryNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListEnvelopeAllocationsHandler godoc
+// @Summary Lista o histórico de atribuições dos envelopes
+// @Tags Envelopes
+// @Produce json
+// @Param category_ids query []string false "Filtrar por categorias"
+// @Param kinds query []string false "Filtrar por tipo (assign, move)"
+// @Param month query string false "Filtrar pelo mês (ex: 2026-10)"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: month)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.EnvelopeAllocationResponse
+// @Security BearerAuth
+// @Router /api/v1/envelopes/allocations [get]
+func (h *EnvelopeHandler) ListEnvelopeAllocationsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.EnvelopeAllocationFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"month":      true,
+		"amount":     true,
+		"created_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("created_at", config.OrderDesc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListEnvelopeAllocations(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteEnvelopeAllocationHandler godoc
+// @Summary Remove uma atribuição
+// @Description Numa movimentação entre envelopes, os dois lançamentos são removidos juntos
+// @Tags Envelopes
+// @Param id path string true "ID da atribuição"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/envelopes/allocations/{id} [delete]
+func (h *EnvelopeHandler) DeleteEnvelopeAllocationHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteEnvelopeAllocationByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
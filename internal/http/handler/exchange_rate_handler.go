@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExchangeRateHandler struct {
+	service inbound.ExchangeRateService
+}
+
+func NewExchangeRateHandler(service inbound.ExchangeRateService) *ExchangeRateHandler {
+	return &ExchangeRateHandler{service: service}
+}
+
+// UpsertExchangeRateHandler godoc
+// @Summary Registra uma cotação
+// @Description Registra quanto 1 unidade de from_currency vale em to_currency na data. Uma cotação já existente para o mesmo par e data é substituída. Transações já lançadas mantêm a cotação com que foram gravadas
+// @Tags Cotações
+// @Accept json
+// @Produce json
+// @Param request body dto.ExchangeRateRequest true "Par de moedas, cotação e data"
+// @Success 200 {object} dto.ExchangeRateResponse
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates [post]
+func (h *ExchangeRateHandler) UpsertExchangeRateHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.ExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpsertExchangeRate(ctx, ledgerID, *input)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ImportExchangeRatesHandler godoc
+// @Summary Importa cotações de um CSV
+// @Description Importa cotações de um CSV com as colunas rate_date, from_currency, to_currency e rate. Nada é gravado se alguma linha for inválida
+// @Tags Cotações
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Arquivo CSV"
+// @Success 200 {object} dto.ExchangeRateImportResponse
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates/import [post]
+func (h *ExchangeRateHandler) ImportExchangeRatesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	file, _, err := c.Request.FormFile("file")
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, appError.InvalidParam("file", err)))
+		return
+	}
+	defer file.Close()
+
+	imported, err := h.service.ImportExchangeRates(ctx, ledgerID, file)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ExchangeRateImportResponse{Imported: imported})
+}
+
+// ListExchangeRatesHandler godoc
+// @Summary Lista cotações com filtros e paginação
+// @Tags Cotações
+// @Produce json
+// @Param from_currency query string false "Moeda de origem"
+// @Param to_currency query string false "Moeda de destino"
+// @Param start_date query string false "Data inicial"
+// @Param end_date query string false "Data final"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: rate_date)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.ExchangeRateResponse
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates [get]
+func (h *ExchangeRateHandler) ListExchangeRatesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.ExchangeRateFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"rate_date":     true,
+		"from_currency": true,
+		"to_currency":   true,
+		"rate":          true,
+		"created_at":    true,
+	}
+
+	if err := pgn.ValidateOrderBy("rate_date", config.OrderDesc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListExchangeRates(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteExchangeRateHandler godoc
+// @Summary Remove uma cotação
+// @Tags Cotações
+// @Param id path string true "ID da cotação"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/exchange-rates/{id} [delete]
+func (h *ExchangeRateHandler) DeleteExchangeRateHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteExchangeRateByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
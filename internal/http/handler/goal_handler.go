@@ -0,0 +1,303 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GoalHandler struct {
+	service inbound.GoalService
+}
+
+func NewGoalHandler(service inbound.GoalService) *GoalHandler {
+	return &GoalHandler{service: service}
+}
+
+// CreateGoalHandler godoc
+// @Summary Cria uma meta de economia
+// @Description Cria uma meta com valor e data alvo, vinculada a uma conta, a uma categoria ou a uma tag (basta um dos três). Os aportes são as transferências para a conta vinculada (transações com transfer_account_id igual a account_id) e as transações da categoria vinculada (e de suas subcategorias) ou com a tag vinculada, onde despesas somam e receitas contam como resgate. initial_amount é o que já estava guardado
+// @Tags Metas
+// @Accept json
+// @Produce json
+// @Param request body dto.GoalRequest true "Dados da meta"
+// @Success 201 {object} dto.GoalResponse
+// @Security BearerAuth
+// @Router /api/v1/goals [post]
+func (h *GoalHandler) CreateGoalHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.GoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateGoal(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrTagNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetGoalByIDHandler godoc
+// @Summary Busca uma meta por ID
+// @Tags Metas
+// @Produce json
+// @Param id path string true "ID da meta"
+// @Success 200 {object} dto.GoalResponse
+// @Security BearerAuth
+// @Router /api/v1/goals/{id} [get]
+func (h *GoalHandler) GetGoalByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetGoalByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListGoalsHandler godoc
+// @Summary Lista metas com paginação
+// @Tags Metas
+// @Produce json
+// @Param search query string false "Buscar pelo nome"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: target_date)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.GoalResponse
+// @Security BearerAuth
+// @Router /api/v1/goals [get]
+func (h *GoalHandler) ListGoalsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":            true,
+		"name":          true,
+		"target_amount": true,
+		"target_date":   true,
+		"created_at":    true,
+		"updated_at":    true,
+	}
+
+	if err := pgn.ValidateOrderBy("target_date", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListGoals(ctx, ledgerID, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GoalsProgressHandler godoc
+// @Summary Andamento de todas as metas
+// @Description Para cada meta mostra o valor guardado, o percentual concluído, o aporte mensal necessário para chegar ao valor na data alvo e a data prevista de conclusão pelo ritmo de aportes dos últimos 3 meses
+// @Tags Metas
+// @Produce json
+// @Success 200 {array} dto.GoalProgressResponse
+// @Security BearerAuth
+// @Router /api/v1/goals/progress [get]
+func (h *GoalHandler) GoalsProgressHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.GoalsProgress(ctx, ledgerID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GoalProgressHandler godoc
+// @Summary Andamento de uma meta
+// @Description Mostra o valor guardado, o percentual concluído, o aporte mensal necessário para chegar ao valor na data alvo e a data prevista de conclusão pelo ritmo de aportes dos últimos 3 meses
+// @Tags Metas
+// @Produce json
+// @Param id path string true "ID da meta"
+// @Success 200 {object} dto.GoalProgressResponse
+// @Security BearerAuth
+// @Router /api/v1/goals/{id}/progress [get]
+func (h *GoalHandler) GoalProgressHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GoalProgress(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// UpdateGoalHandler godoc
+// @Summary Atualiza uma meta
+// @Tags Metas
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da meta"
+// @Param request body dto.GoalRequest true "Dados atualizados da meta"
+// @Success 200 {object} dto.GoalResponse
+// @Security BearerAuth
+// @Router /api/v1/goals/{id} [put]
+func (h *GoalHandler) UpdateGoalHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.GoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateGoal(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrTagNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteGoalHandler godoc
+// @Summary Remove uma meta
+// @Tags Metas
+// @Param id path string true "ID da meta"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/goals/{id} [delete]
+func (h *GoalHandler) DeleteGoalHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteGoalByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
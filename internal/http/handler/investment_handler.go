@@ -0,0 +1,710 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InvestmentHandler struct {
+	service inbound.InvestmentService
+}
+
+func NewInvestmentHandler(service inbound.InvestmentService) *InvestmentHandler {
+	return &InvestmentHandler{service: service}
+}
+
+// PortfolioHandler godoc
+// @Summary Resumo da carteira de investimentos
+// @Description Retorna a posição de cada investimento pelo custo médio, avaliada pela cotação mais recente gravada (ou, sem cotações, pelo preço da última operação), com ganhos realizados e não realizados, proventos e a alocação por classe. Os totais vêm na moeda base, convertidos pela cotação de câmbio do dia
+// @Tags Investimentos
+// @Produce json
+// @Success 200 {object} dto.PortfolioResponse
+// @Failure 422 {object} map[string]string "Cotação de câmbio não encontrada para a moeda de um investimento"
+// @Security BearerAuth
+// @Router /api/v1/investments/portfolio [get]
+func (h *InvestmentHandler) PortfolioHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.Portfolio(ctx, ledgerID)
+	if err != nil {
+		if errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// CreateHoldingHandler godoc
+// @Summary Cria um investimento
+// @Description Cadastra um investimento da carteira. asset_class é treasury, fixed_income, stock, reit, etf, crypto ou other; o ticker é único por usuário. Sem moeda, o investimento assume a moeda base
+// @Tags Investimentos
+// @Accept json
+// @Produce json
+// @Param request body dto.HoldingRequest true "Dados do investimento"
+// @Success 201 {object} dto.HoldingResponse
+// @Failure 409 {object} map[string]string "Ticker já cadastrado"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings [post]
+func (h *InvestmentHandler) CreateHoldingHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.HoldingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateHolding(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrHoldingConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetHoldingByIDHandler godoc
+// @Summary Busca um investimento por ID
+// @Tags Investimentos
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Success 200 {object} dto.HoldingResponse
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id} [get]
+func (h *InvestmentHandler) GetHoldingByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetHoldingByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListHoldingsHandler godoc
+// @Summary Lista investimentos com paginação
+// @Tags Investimentos
+// @Produce json
+// @Param search query string false "Buscar pelo nome ou ticker"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: ticker)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.HoldingResponse
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings [get]
+func (h *InvestmentHandler) ListHoldingsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":          true,
+		"name":        true,
+		"ticker":      true,
+		"asset_class": true,
+		"created_at":  true,
+		"updated_at":  true,
+	}
+
+	if err := pgn.ValidateOrderBy("ticker", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListHoldings(ctx, ledgerID, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateHoldingHandler godoc
+// @Summary Atualiza um investimento
+// @Tags Investimentos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Param request body dto.HoldingRequest true "Dados atualizados do investimento"
+// @Success 200 {object} dto.HoldingResponse
+// @Failure 409 {object} map[string]string "Ticker já cadastrado"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id} [put]
+func (h *InvestmentHandler) UpdateHoldingHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.HoldingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateHolding(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrHoldingConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteHoldingHandler godoc
+// @Summary Remove um investimento
+// @Description Remove o investimento com as operações, cotações e proventos. As receitas já lançadas pelos proventos são mantidas
+// @Tags Investimentos
+// @Param id path string true "ID do investimento"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id} [delete]
+func (h *InvestmentHandler) DeleteHoldingHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteHoldingByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateInvestmentTradeHandler godoc
+// @Summary Registra uma compra ou venda
+// @Description Registra um lote de compra (buy) ou venda (sell) com a quantidade, o preço unitário e as taxas na moeda do investimento. Vendas acima da quantidade em carteira na data são recusadas
+// @Tags Investimentos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Param request body dto.InvestmentTradeRequest true "Dados da operação"
+// @Success 201 {object} dto.InvestmentTradeResponse
+// @Failure 422 {object} map[string]string "Quantidade insuficiente para a venda"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/trades [post]
+func (h *InvestmentHandler) CreateInvestmentTradeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.InvestmentTradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain(holdingID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateInvestmentTrade(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrInsufficientQuantity) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListInvestmentTradesHandler godoc
+// @Summary Lista as operações de um investimento
+// @Tags Investimentos
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Success 200 {array} dto.InvestmentTradeResponse
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/trades [get]
+func (h *InvestmentHandler) ListInvestmentTradesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListInvestmentTrades(ctx, ledgerID, holdingID)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteInvestmentTradeHandler godoc
+// @Summary Remove uma operação de um investimento
+// @Description Remove a operação desde que as vendas restantes continuem cobertas pelas compras
+// @Tags Investimentos
+// @Param id path string true "ID do investimento"
+// @Param trade_id path string true "ID da operação"
+// @Success 204 "Sem conteúdo"
+// @Failure 422 {object} map[string]string "Uma venda ficaria sem quantidade suficiente"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/trades/{trade_id} [delete]
+func (h *InvestmentHandler) DeleteInvestmentTradeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("trade_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteInvestmentTradeByID(ctx, ledgerID, holdingID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrInsufficientQuantity) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpsertInvestmentQuoteHandler godoc
+// @Summary Registra a cotação de um investimento
+// @Description Grava o preço unitário do investimento na data, substituindo a cotação já existente para a mesma data
+// @Tags Investimentos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Param request body dto.InvestmentQuoteRequest true "Data e preço"
+// @Success 200 {object} dto.InvestmentQuoteResponse
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/quotes [post]
+func (h *InvestmentHandler) UpsertInvestmentQuoteHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.InvestmentQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain(holdingID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpsertInvestmentQuote(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ImportInvestmentQuotesHandler godoc
+// @Summary Importa cotações de investimentos de um CSV
+// @Description Importa cotações de um CSV com as colunas ticker, quote_date e price. Nada é gravado se alguma linha for inválida ou citar um ticker que não está na carteira
+// @Tags Investimentos
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Arquivo CSV"
+// @Success 200 {object} dto.InvestmentQuoteImportResponse
+// @Failure 422 {object} map[string]string "Ticker não cadastrado"
+// @Security BearerAuth
+// @Router /api/v1/investments/quotes/import [post]
+func (h *InvestmentHandler) ImportInvestmentQuotesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	file, _, err := c.Request.FormFile("file")
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, appError.InvalidParam("file", err)))
+		return
+	}
+	defer file.Close()
+
+	imported, err := h.service.ImportInvestmentQuotes(ctx, ledgerID, file)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		if errors.Is(err, appError.ErrHoldingNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.InvestmentQuoteImportResponse{Imported: imported})
+}
+
+// ListInvestmentQuotesHandler godoc
+// @Summary Lista as cotações de um investimento
+// @Tags Investimentos
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Success 200 {array} dto.InvestmentQuoteResponse
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/quotes [get]
+func (h *InvestmentHandler) ListInvestmentQuotesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListInvestmentQuotes(ctx, ledgerID, holdingID)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteInvestmentQuoteHandler godoc
+// @Summary Remove uma cotação de um investimento
+// @Tags Investimentos
+// @Param id path string true "ID do investimento"
+// @Param quote_id path string true "ID da cotação"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/quotes/{quote_id} [delete]
+func (h *InvestmentHandler) DeleteInvestmentQuoteHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("quote_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteInvestmentQuoteByID(ctx, ledgerID, holdingID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateInvestmentIncomeHandler godoc
+// @Summary Registra um provento
+// @Description Registra dividendos (dividend), cupom (coupon) ou JCP (jcp) recebidos e lança a receita paga correspondente na moeda do investimento, com título como "Dividendos PETR4"
+// @Tags Investimentos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Param request body dto.InvestmentIncomeRequest true "Dados do provento"
+// @Success 201 {object} dto.InvestmentIncomeResponse
+// @Failure 422 {object} map[string]string "Categoria não encontrada ou cotação de câmbio ausente"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/incomes [post]
+func (h *InvestmentHandler) CreateInvestmentIncomeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.InvestmentIncomeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain(holdingID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateInvestmentIncome(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListInvestmentIncomesHandler godoc
+// @Summary Lista os proventos de um investimento
+// @Tags Investimentos
+// @Produce json
+// @Param id path string true "ID do investimento"
+// @Success 200 {array} dto.InvestmentIncomeResponse
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/incomes [get]
+func (h *InvestmentHandler) ListInvestmentIncomesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListInvestmentIncomes(ctx, ledgerID, holdingID)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteInvestmentIncomeHandler godoc
+// @Summary Remove um provento
+// @Description Remove o provento e a receita lançada por ele
+// @Tags Investimentos
+// @Param id path string true "ID do investimento"
+// @Param income_id path string true "ID do provento"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/investments/holdings/{id}/incomes/{income_id} [delete]
+func (h *InvestmentHandler) DeleteInvestmentIncomeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	holdingID, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("income_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteInvestmentIncomeByID(ctx, ledgerID, holdingID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
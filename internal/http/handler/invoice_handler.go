@@ -34,9 +34,16 @@ func NewInvoiceHandler(service inbound.InvoiceService) *InvoiceHandler {
 // @Router /api/v1/invoices [post]
 func (h *InvoiceHandler) CreateInvoiceHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	userID, err := utilsctx.GetUserID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
 	}
 
 	var req dto.InvoiceRequest
@@ -51,7 +58,7 @@ func (h *InvoiceHandler) CreateInvoiceHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.CreateInvoice(ctx, userID, *input)
+	data, err := h.service.CreateInvoice(ctx, ledgerID, userID, *input)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
@@ -72,7 +79,7 @@ func (h *InvoiceHandler) CreateInvoiceHandler(c *gin.Context) {
 // @Router /api/v1/invoices/{id} [get]
 func (h *InvoiceHandler) GetInvoiceByIDHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -83,7 +90,7 @@ func (h *InvoiceHandler) GetInvoiceByIDHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.GetInvoiceByID(ctx, userID, id)
+	data, err := h.service.GetInvoiceByID(ctx, ledgerID, id)
 	if err != nil {
 		if errors.Is(err, appError.ErrNotFound) {
 			c.Error(appError.NewAppError(http.StatusNotFound, err))
@@ -117,7 +124,7 @@ func (h *InvoiceHandler) GetInvoiceByIDHandler(c *gin.Context) {
 // @Router /api/v1/invoices [get]
 func (h *InvoiceHandler) ListInvoicesHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -151,7 +158,7 @@ func (h *InvoiceHandler) ListInvoicesHandler(c *gin.Context) {
 		return
 	}
 
-	response, total, err := h.service.ListInvoices(ctx, userID, flt, pgn)
+	response, total, err := h.service.ListInvoices(ctx, ledgerID, flt, pgn)
 
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
@@ -176,7 +183,7 @@ func (h *InvoiceHandler) ListInvoicesHandler(c *gin.Context) {
 // @Router /api/v1/invoices/{id} [put]
 func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -198,7 +205,7 @@ func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.UpdateInvoice(ctx, userID, id, *input)
+	data, err := h.service.UpdateInvoice(ctx, ledgerID, id, *input)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
@@ -219,7 +226,7 @@ func (h *InvoiceHandler) UpdateInvoiceHandler(c *gin.Context) {
 // @Router /api/v1/invoices/{id} [delete]
 func (h *InvoiceHandler) DeleteInvoiceHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -230,7 +237,7 @@ func (h *InvoiceHandler) DeleteInvoiceHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.service.DeleteInvoiceByID(ctx, userID, id)
+	err = h.service.DeleteInvoiceByID(ctx, ledgerID, id)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
@@ -261,7 +268,7 @@ func (h *InvoiceHandler) DeleteInvoiceHandler(c *gin.Context) {
 // @Router /api/v1/invoices/{id}/debts [get]
 func (h *InvoiceHandler) ListInvoiceDebtsHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -301,7 +308,7 @@ func (h *InvoiceHandler) ListInvoiceDebtsHandler(c *gin.Context) {
 		return
 	}
 
-	response, total, err := h.service.ListInvoiceDebts(ctx, userID, id, flt, pgn)
+	response, total, err := h.service.ListInvoiceDebts(ctx, ledgerID, id, flt, pgn)
 
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
@@ -312,3 +319,234 @@ func (h *InvoiceHandler) ListInvoiceDebtsHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// CloseInvoiceHandler godoc
+// @Summary Fecha uma fatura
+// @Description Fecha uma fatura aberta, congelando o valor e impedindo o lançamento de novas transações.
+// @Description Uma fatura que venceu antes de ser fechada também pode ser fechada e continua vencida.
+// @Description Com rollover, o saldo restante é lançado na próxima fatura aberta e a fatura fechada é quitada.
+// @Tags Faturas
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Param request body dto.InvoiceCloseRequest false "Opções de fechamento"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 409 {object} map[string]string "A fatura já foi fechada"
+// @Failure 422 {object} map[string]string "Nenhuma fatura aberta para receber o saldo"
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/close [post]
+func (h *InvoiceHandler) CloseInvoiceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.InvoiceCloseRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CloseInvoice(ctx, ledgerID, id, *input)
+	if err != nil {
+		c.Error(invoiceLifecycleError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// PayInvoiceHandler godoc
+// @Summary Paga uma fatura
+// @Description Quita o saldo restante de uma fatura fechada ou vencida e marca todas as suas transações como pagas
+// @Tags Faturas
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Param request body dto.InvoicePayRequest true "Data e conta do pagamento"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 409 {object} map[string]string "A fatura não pode ser paga"
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/pay [post]
+func (h *InvoiceHandler) PayInvoiceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.InvoicePayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.PayInvoice(ctx, ledgerID, id, *input)
+	if err != nil {
+		c.Error(invoiceLifecycleError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListInvoicePaymentsHandler godoc
+// @Summary Lista os pagamentos de uma fatura
+// @Description Retorna os pagamentos parciais e de rollover registrados na fatura
+// @Tags Faturas
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Success 200 {array} dto.InvoicePaymentResponse
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/payments [get]
+func (h *InvoiceHandler) ListInvoicePaymentsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, err := h.service.ListInvoicePayments(ctx, ledgerID, id)
+	if err != nil {
+		c.Error(invoiceLifecycleError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateInvoicePaymentHandler godoc
+// @Summary Registra um pagamento parcial
+// @Description Registra um pagamento na fatura. Se o saldo de uma fatura fechada for zerado, ela é marcada como paga
+// @Tags Faturas
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da fatura"
+// @Param request body dto.InvoicePaymentRequest true "Valor, data e conta do pagamento"
+// @Success 201 {object} dto.InvoicePaymentResponse
+// @Failure 400 {object} map[string]string "Valor maior que o saldo em aberto"
+// @Failure 409 {object} map[string]string "A fatura não aceita pagamentos"
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/payments [post]
+func (h *InvoiceHandler) CreateInvoicePaymentHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.InvoicePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateInvoicePayment(ctx, ledgerID, id, *input)
+	if err != nil {
+		c.Error(invoiceLifecycleError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// DeleteInvoicePaymentHandler godoc
+// @Summary Remove um pagamento da fatura
+// @Description Remove um pagamento parcial. Se a fatura estava paga, ela volta a ter saldo em aberto
+// @Tags Faturas
+// @Param id path string true "ID da fatura"
+// @Param payment_id path string true "ID do pagamento"
+// @Success 204
+// @Security BearerAuth
+// @Router /api/v1/invoices/{id}/payments/{payment_id} [delete]
+func (h *InvoiceHandler) DeleteInvoicePaymentHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	paymentID, err := utils.ToUUID(c.Param("payment_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteInvoicePaymentByID(ctx, ledgerID, id, paymentID); err != nil {
+		c.Error(invoiceLifecycleError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func invoiceLifecycleError(err error) *appError.AppError {
+	switch {
+	case errors.Is(err, appError.ErrInvalidStatusTransition):
+		return appError.NewAppError(http.StatusConflict, err)
+	case errors.Is(err, appError.ErrNoRolloverTarget):
+		return appError.NewAppError(http.StatusUnprocessableEntity, err)
+	case errors.Is(err, appError.ErrPaymentExceedsBalance):
+		return appError.NewAppError(http.StatusBadRequest, err)
+	case errors.Is(err, appError.ErrNotFound):
+		return appError.NewAppError(http.StatusNotFound, err)
+	default:
+		return appError.NewAppError(http.StatusInternalServerError, err)
+	}
+}
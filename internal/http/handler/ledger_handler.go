@@ -0,0 +1,526 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LedgerHandler struct {
+	service inbound.LedgerService
+}
+
+func NewLedgerHandler(service inbound.LedgerService) *LedgerHandler {
+	return &LedgerHandler{service: service}
+}
+
+// CreateLedgerHandler godoc
+// @Summary Cria um livro compartilhado
+// @Description Cria um livro vazio, com as categorias padrão, do qual o usuário autenticado é o dono (owner). Os lançamentos pertencem ao livro: o usuário e os membros convidados acessam o livro enviando o header X-Ledger-ID com o ID dele; sem o header, vale o livro pessoal
+// @Tags Livros
+// @Accept json
+// @Produce json
+// @Param request body dto.LedgerRequest true "Dados do livro"
+// @Success 201 {object} dto.LedgerResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers [post]
+func (h *LedgerHandler) CreateLedgerHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.LedgerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateLedger(ctx, userID, *input)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListLedgersHandler godoc
+// @Summary Lista os livros do usuário
+// @Description Lista os livros dos quais o usuário autenticado é membro, com o seu papel em cada um
+// @Tags Livros
+// @Produce json
+// @Success 200 {array} dto.LedgerResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers [get]
+func (h *LedgerHandler) ListLedgersHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.ListLedgers(ctx, userID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// UpdateLedgerHandler godoc
+// @Summary Renomeia um livro
+// @Tags Livros
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do livro"
+// @Param request body dto.LedgerRequest true "Dados do livro"
+// @Success 200 {object} dto.LedgerResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id} [put]
+func (h *LedgerHandler) UpdateLedgerHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.LedgerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateLedger(ctx, userID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrForbidden) {
+			c.Error(appError.NewAppError(http.StatusForbidden, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListLedgerMembersHandler godoc
+// @Summary Lista os membros de um livro
+// @Tags Livros
+// @Produce json
+// @Param id path string true "ID do livro"
+// @Success 200 {array} dto.LedgerMemberResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id}/members [get]
+func (h *LedgerHandler) ListLedgerMembersHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListLedgerMembers(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// UpdateLedgerMemberHandler godoc
+// @Summary Altera o papel de um membro
+// @Description Papéis: editor (altera lançamentos) e viewer (somente leitura). O papel owner é só do dono do livro: não pode ser dado a outro membro e o dono não o perde
+// @Tags Livros
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do livro"
+// @Param user_id path string true "ID do usuário membro"
+// @Param request body dto.LedgerMemberRequest true "Novo papel"
+// @Success 200 {object} dto.LedgerMemberResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id}/members/{user_id} [put]
+func (h *LedgerHandler) UpdateLedgerMemberHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	memberID, err := utils.ToUUID(c.Param("user_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.LedgerMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	role, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateLedgerMember(ctx, userID, id, memberID, role)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrForbidden) {
+			c.Error(appError.NewAppError(http.StatusForbidden, err))
+			return
+		}
+		if errors.Is(err, appError.ErrLedgerOwnerRole) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		if errors.Is(err, appError.ErrLedgerOwner) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteLedgerMemberHandler godoc
+// @Summary Remove um membro do livro
+// @Description Donos removem qualquer membro, exceto o dono dos lançamentos; os demais membros só podem sair do livro
+// @Tags Livros
+// @Param id path string true "ID do livro"
+// @Param user_id path string true "ID do usuário membro"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id}/members/{user_id} [delete]
+func (h *LedgerHandler) DeleteLedgerMemberHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	memberID, err := utils.ToUUID(c.Param("user_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteLedgerMember(ctx, userID, id, memberID); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrForbidden) {
+			c.Error(appError.NewAppError(http.StatusForbidden, err))
+			return
+		}
+		if errors.Is(err, appError.ErrLedgerOwner) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateLedgerInvitationHandler godoc
+// @Summary Convida um usuário para o livro
+// @Description Convida um usuário pelo username com o papel informado: editor (padrão) ou viewer. O convite vale por 7 dias
+// @Tags Livros
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do livro"
+// @Param request body dto.LedgerInvitationRequest true "Dados do convite"
+// @Success 201 {object} dto.LedgerInvitationResponse
+// @Failure 409 {object} map[string]string "O usuário já é membro ou tem convite pendente"
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id}/invitations [post]
+func (h *LedgerHandler) CreateLedgerInvitationHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.LedgerInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateLedgerInvitation(ctx, userID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrForbidden) {
+			c.Error(appError.NewAppError(http.StatusForbidden, err))
+			return
+		}
+		if errors.Is(err, appError.ErrLedgerMemberConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrLedgerOwnerRole) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		if errors.Is(err, appError.ErrInviteeNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListLedgerInvitationsHandler godoc
+// @Summary Lista os convites enviados pelo livro
+// @Tags Livros
+// @Produce json
+// @Param id path string true "ID do livro"
+// @Success 200 {array} dto.LedgerInvitationResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id}/invitations [get]
+func (h *LedgerHandler) ListLedgerInvitationsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListLedgerInvitations(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrForbidden) {
+			c.Error(appError.NewAppError(http.StatusForbidden, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// RevokeLedgerInvitationHandler godoc
+// @Summary Cancela um convite pendente
+// @Tags Livros
+// @Param id path string true "ID do livro"
+// @Param invitation_id path string true "ID do convite"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/ledgers/{id}/invitations/{invitation_id} [delete]
+func (h *LedgerHandler) RevokeLedgerInvitationHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	invitationID, err := utils.ToUUID(c.Param("invitation_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.RevokeLedgerInvitation(ctx, userID, id, invitationID); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrForbidden) {
+			c.Error(appError.NewAppError(http.StatusForbidden, err))
+			return
+		}
+		if errors.Is(err, appError.ErrInvitationNotPending) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListReceivedLedgerInvitationsHandler godoc
+// @Summary Lista os convites recebidos
+// @Description Lista os convites pendentes e ainda válidos do usuário autenticado
+// @Tags Livros
+// @Produce json
+// @Success 200 {array} dto.LedgerInvitationResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/invitations [get]
+func (h *LedgerHandler) ListReceivedLedgerInvitationsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.ListReceivedLedgerInvitations(ctx, userID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// AcceptLedgerInvitationHandler godoc
+// @Summary Aceita um convite
+// @Description O usuário entra no livro com o papel do convite
+// @Tags Livros
+// @Produce json
+// @Param invitation_id path string true "ID do convite"
+// @Success 200 {object} dto.LedgerInvitationResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/invitations/{invitation_id}/accept [post]
+func (h *LedgerHandler) AcceptLedgerInvitationHandler(c *gin.Context) {
+	h.respondLedgerInvitation(c, true)
+}
+
+// DeclineLedgerInvitationHandler godoc
+// @Summary Recusa um convite
+// @Tags Livros
+// @Produce json
+// @Param invitation_id path string true "ID do convite"
+// @Success 200 {object} dto.LedgerInvitationResponse
+// @Security BearerAuth
+// @Router /api/v1/ledgers/invitations/{invitation_id}/decline [post]
+func (h *LedgerHandler) DeclineLedgerInvitationHandler(c *gin.Context) {
+	h.respondLedgerInvitation(c, false)
+}
+
+func (h *LedgerHandler) respondLedgerInvitation(c *gin.Context, accept bool) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("invitation_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.RespondLedgerInvitation(ctx, userID, id, accept)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrInvitationNotPending) || errors.Is(err, appError.ErrLedgerMemberConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,406 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoanHandler struct {
+	service inbound.LoanService
+}
+
+func NewLoanHandler(service inbound.LoanService) *LoanHandler {
+	return &LoanHandler{service: service}
+}
+
+// CreateLoanHandler godoc
+// @Summary Cria um empréstimo ou financiamento
+// @Description Cadastra o empréstimo e gera o cronograma completo pelo sistema SAC (sac) ou Price (price). interest_rate é o percentual ao mês ou ao ano, conforme rate_period (monthly, yearly). Cada parcela vira uma despesa pendente dividida entre amortização e juros, nas categorias informadas
+// @Tags Empréstimos
+// @Accept json
+// @Produce json
+// @Param request body dto.LoanRequest true "Dados do empréstimo"
+// @Success 201 {object} dto.LoanResponse
+// @Failure 422 {object} map[string]string "Categoria não encontrada ou cotação ausente para a moeda"
+// @Security BearerAuth
+// @Router /api/v1/loans [post]
+func (h *LoanHandler) CreateLoanHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.LoanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateLoan(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// SimulateLoanHandler godoc
+// @Summary Simula um empréstimo
+// @Description Calcula o cronograma de parcelas com os mesmos dados da criação, sem gravar nada
+// @Tags Empréstimos
+// @Accept json
+// @Produce json
+// @Param request body dto.LoanRequest true "Dados do empréstimo"
+// @Success 200 {object} dto.LoanSimulationResponse
+// @Security BearerAuth
+// @Router /api/v1/loans/simulate [post]
+func (h *LoanHandler) SimulateLoanHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.LoanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.SimulateLoan(ctx, *input)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetLoanByIDHandler godoc
+// @Summary Busca um empréstimo por ID
+// @Tags Empréstimos
+// @Produce json
+// @Param id path string true "ID do empréstimo"
+// @Success 200 {object} dto.LoanResponse
+// @Security BearerAuth
+// @Router /api/v1/loans/{id} [get]
+func (h *LoanHandler) GetLoanByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetLoanByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListLoansHandler godoc
+// @Summary Lista empréstimos com paginação
+// @Tags Empréstimos
+// @Produce json
+// @Param search query string false "Buscar pelo nome"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: name)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.LoanResponse
+// @Security BearerAuth
+// @Router /api/v1/loans [get]
+func (h *LoanHandler) ListLoansHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":                 true,
+		"name":               true,
+		"principal":          true,
+		"first_payment_date": true,
+		"created_at":         true,
+		"updated_at":         true,
+	}
+
+	if err := pgn.ValidateOrderBy("name", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListLoans(ctx, ledgerID, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateLoanHandler godoc
+// @Summary Atualiza um empréstimo
+// @Description Altera o nome e a conta do empréstimo. As condições financeiras não mudam depois da criação e as transações já lançadas não são alteradas
+// @Tags Empréstimos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do empréstimo"
+// @Param request body dto.LoanUpdateRequest true "Dados atualizados do empréstimo"
+// @Success 200 {object} dto.LoanResponse
+// @Security BearerAuth
+// @Router /api/v1/loans/{id} [put]
+func (h *LoanHandler) UpdateLoanHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.LoanUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateLoan(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteLoanHandler godoc
+// @Summary Remove um empréstimo
+// @Description Remove o empréstimo e as despesas das parcelas ainda não pagas. Parcelas pagas e amortizações extras continuam nas transações
+// @Tags Empréstimos
+// @Param id path string true "ID do empréstimo"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/loans/{id} [delete]
+func (h *LoanHandler) DeleteLoanHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteLoanByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// LoanScheduleHandler godoc
+// @Summary Cronograma de um empréstimo
+// @Description Retorna as parcelas com juros, amortização e saldo devedor, a situação de cada uma pela transação vinculada, o saldo devedor atual e os juros pagos, previstos e economizados com amortizações extras
+// @Tags Empréstimos
+// @Produce json
+// @Param id path string true "ID do empréstimo"
+// @Success 200 {object} dto.LoanScheduleResponse
+// @Security BearerAuth
+// @Router /api/v1/loans/{id}/schedule [get]
+func (h *LoanHandler) LoanScheduleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.LoanSchedule(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// CreateLoanPrepaymentHandler godoc
+// @Summary Registra uma amortização extra
+// @Description Abate o valor do saldo devedor na data e recalcula as parcelas que vencem depois dela, reduzindo o prazo (reduce_term, padrão) ou a parcela (reduce_payment). As despesas pendentes dessas parcelas são substituídas, a amortização é lançada como despesa paga e a resposta traz os juros economizados
+// @Tags Empréstimos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do empréstimo"
+// @Param request body dto.LoanPrepaymentRequest true "Data, valor e efeito da amortização"
+// @Success 201 {object} dto.LoanPrepaymentResponse
+// @Failure 409 {object} map[string]string "Há amortização posterior ou parcela seguinte já paga"
+// @Failure 422 {object} map[string]string "Valor maior que o saldo devedor"
+// @Security BearerAuth
+// @Router /api/v1/loans/{id}/prepayments [post]
+func (h *LoanHandler) CreateLoanPrepaymentHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.LoanPrepaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain(id)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateLoanPrepayment(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrPrepaymentConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrPrepaymentExceedsBalance) || errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListLoanPrepaymentsHandler godoc
+// @Summary Lista as amortizações extras de um empréstimo
+// @Tags Empréstimos
+// @Produce json
+// @Param id path string true "ID do empréstimo"
+// @Success 200 {array} dto.LoanPrepaymentResponse
+// @Security BearerAuth
+// @Router /api/v1/loans/{id}/prepayments [get]
+func (h *LoanHandler) ListLoanPrepaymentsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListLoanPrepayments(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
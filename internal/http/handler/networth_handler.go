@@ -0,0 +1,463 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/domain"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NetWorthHandler struct {
+	service inbound.NetWorthService
+}
+
+func NewNetWorthHandler(service inbound.NetWorthService) *NetWorthHandler {
+	return &NetWorthHandler{service: service}
+}
+
+// NetWorthHandler godoc
+// @Summary Evolução do patrimônio
+// @Description Retorna o patrimônio no fim de cada período, na moeda base: saldos das contas, bens e dívidas, cada um pela avaliação mais recente até a data e convertido pela cotação dessa data. Sem filtros, mostra os últimos 12 meses mês a mês
+// @Tags Patrimônio
+// @Produce json
+// @Param period query string false "Período (daily, weekly, monthly, yearly)"
+// @Param start_date query string false "Data inicial (YYYY-MM-DD)"
+// @Param end_date query string false "Data final (YYYY-MM-DD)"
+// @Success 200 {array} dto.NetWorthByDate
+// @Failure 422 {object} map[string]string "Cotação não encontrada para a moeda de um item"
+// @Security BearerAuth
+// @Router /api/v1/networth [get]
+func (h *NetWorthHandler) NetWorthHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.NetWorthFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.NetWorth(ctx, ledgerID, flt)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		if errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// CreateAssetHandler godoc
+// @Summary Cria um bem ou uma dívida
+// @Description Cadastra um item do patrimônio fora das contas. class é asset (bem) ou liability (dívida); kind é property, vehicle, investment, loan ou other. Sem moeda, o item assume a moeda base
+// @Tags Patrimônio
+// @Accept json
+// @Produce json
+// @Param request body dto.AssetRequest true "Dados do item"
+// @Success 201 {object} dto.AssetResponse
+// @Security BearerAuth
+// @Router /api/v1/assets [post]
+func (h *NetWorthHandler) CreateAssetHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.AssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateAsset(ctx, ledgerID, *input)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetAssetByIDHandler godoc
+// @Summary Busca um bem ou dívida por ID
+// @Tags Patrimônio
+// @Produce json
+// @Param id path string true "ID do item"
+// @Success 200 {object} dto.AssetResponse
+// @Security BearerAuth
+// @Router /api/v1/assets/{id} [get]
+func (h *NetWorthHandler) GetAssetByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetAssetByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListAssetsHandler godoc
+// @Summary Lista bens e dívidas com paginação
+// @Tags Patrimônio
+// @Produce json
+// @Param search query string false "Buscar pelo nome"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: name)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.AssetResponse
+// @Security BearerAuth
+// @Router /api/v1/assets [get]
+func (h *NetWorthHandler) ListAssetsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"name":       true,
+		"class":      true,
+		"kind":       true,
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("name", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListAssets(ctx, ledgerID, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateAssetHandler godoc
+// @Summary Atualiza um bem ou dívida
+// @Tags Patrimônio
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do item"
+// @Param request body dto.AssetRequest true "Dados atualizados do item"
+// @Success 200 {object} dto.AssetResponse
+// @Security BearerAuth
+// @Router /api/v1/assets/{id} [put]
+func (h *NetWorthHandler) UpdateAssetHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.AssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateAsset(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteAssetHandler godoc
+// @Summary Remove um bem ou dívida
+// @Description Remove o item e todas as suas avaliações
+// @Tags Patrimônio
+// @Param id path string true "ID do item"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/assets/{id} [delete]
+func (h *NetWorthHandler) DeleteAssetHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteAssetByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateAssetValuationHandler godoc
+// @Summary Registra o valor de um bem ou dívida
+// @Description Grava o valor do item na data, na moeda do item, substituindo a avaliação já existente para a mesma data. Bens e dívidas são avaliados com valores positivos
+// @Tags Patrimônio
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do item"
+// @Param request body dto.ValuationRequest true "Data e valor"
+// @Success 201 {object} dto.ValuationResponse
+// @Security BearerAuth
+// @Router /api/v1/assets/{id}/valuations [post]
+func (h *NetWorthHandler) CreateAssetValuationHandler(c *gin.Context) {
+	h.createValuation(c, assetValuationTarget)
+}
+
+// ListAssetValuationsHandler godoc
+// @Summary Lista as avaliações de um bem ou dívida
+// @Tags Patrimônio
+// @Produce json
+// @Param id path string true "ID do item"
+// @Success 200 {array} dto.ValuationResponse
+// @Security BearerAuth
+// @Router /api/v1/assets/{id}/valuations [get]
+func (h *NetWorthHandler) ListAssetValuationsHandler(c *gin.Context) {
+	h.listValuations(c, assetValuationTarget)
+}
+
+// DeleteAssetValuationHandler godoc
+// @Summary Remove uma avaliação de um bem ou dívida
+// @Tags Patrimônio
+// @Param id path string true "ID do item"
+// @Param valuation_id path string true "ID da avaliação"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/assets/{id}/valuations/{valuation_id} [delete]
+func (h *NetWorthHandler) DeleteAssetValuationHandler(c *gin.Context) {
+	h.deleteValuation(c, assetValuationTarget)
+}
+
+// CreateAccountValuationHandler godoc
+// @Summary Registra o saldo de uma conta
+// @Description Grava o saldo da conta na data, na moeda da conta, substituindo o saldo já registrado para a mesma data. Saldos devedores, como o de um cartão de crédito, são informados negativos
+// @Tags Patrimônio
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da conta"
+// @Param request body dto.ValuationRequest true "Data e saldo"
+// @Success 201 {object} dto.ValuationResponse
+// @Security BearerAuth
+// @Router /api/v1/accounts/{id}/valuations [post]
+func (h *NetWorthHandler) CreateAccountValuationHandler(c *gin.Context) {
+	h.createValuation(c, accountValuationTarget)
+}
+
+// ListAccountValuationsHandler godoc
+// @Summary Lista os saldos registrados de uma conta
+// @Tags Patrimônio
+// @Produce json
+// @Param id path string true "ID da conta"
+// @Success 200 {array} dto.ValuationResponse
+// @Security BearerAuth
+// @Router /api/v1/accounts/{id}/valuations [get]
+func (h *NetWorthHandler) ListAccountValuationsHandler(c *gin.Context) {
+	h.listValuations(c, accountValuationTarget)
+}
+
+// DeleteAccountValuationHandler godoc
+// @Summary Remove um saldo registrado de uma conta
+// @Tags Patrimônio
+// @Param id path string true "ID da conta"
+// @Param valuation_id path string true "ID do saldo"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/accounts/{id}/valuations/{valuation_id} [delete]
+func (h *NetWorthHandler) DeleteAccountValuationHandler(c *gin.Context) {
+	h.deleteValuation(c, accountValuationTarget)
+}
+
+type valuationTargetFunc func(c *gin.Context) (domain.ValuationTarget, error)
+
+func assetValuationTarget(c *gin.Context) (domain.ValuationTarget, error) {
+	id, err := utils.ToUUID(c.Param("id"))
+	return domain.ValuationTarget{AssetID: &id}, err
+}
+
+func accountValuationTarget(c *gin.Context) (domain.ValuationTarget, error) {
+	id, err := utils.ToUUID(c.Param("id"))
+	return domain.ValuationTarget{AccountID: &id}, err
+}
+
+func (h *NetWorthHandler) createValuation(c *gin.Context, targetOf valuationTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, err := targetOf(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.ValuationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain(target)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpsertValuation(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+func (h *NetWorthHandler) listValuations(c *gin.Context, targetOf valuationTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, err := targetOf(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.ListValuations(ctx, ledgerID, target)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+func (h *NetWorthHandler) deleteValuation(c *gin.Context, targetOf valuationTargetFunc) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	target, err := targetOf(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("valuation_id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteValuationByID(ctx, ledgerID, target, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	service inbound.NotificationService
+}
+
+func NewNotificationHandler(service inbound.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// GetNotificationPreferenceHandler godoc
+// @Summary Retorna as preferências de avisos
+// @Description Retorna a antecedência dos lembretes, o horário de silêncio e os canais ativos. Quem nunca salvou preferências recebe os avisos só na caixa de entrada do app, 3 dias antes do vencimento
+// @Tags Notificações
+// @Produce json
+// @Success 200 {object} dto.NotificationPreferenceResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/preferences [get]
+func (h *NotificationHandler) GetNotificationPreferenceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.GetNotificationPreference(ctx, userID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// UpdateNotificationPreferenceHandler godoc
+// @Summary Salva as preferências de avisos
+// @Description Substitui as preferências. lead_days (0 a 30) é com quantos dias de antecedência chegam os lembretes de faturas e transações pendentes. Entre quiet_start e quiet_end (HH:MM no fuso timezone, podendo atravessar a meia-noite) e-mail e webhook esperam; a caixa de entrada do app recebe na hora. O e-mail vai para o endereço cadastrado no usuário. O webhook recebe um POST em JSON, assinado com HMAC-SHA256 em X-Frog-Signature quando há webhook_secret; sem webhook_secret o segredo atual é mantido enquanto a URL não muda
+// @Tags Notificações
+// @Accept json
+// @Produce json
+// @Param request body dto.NotificationPreferenceRequest true "Preferências de avisos"
+// @Success 200 {object} dto.NotificationPreferenceResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/preferences [put]
+func (h *NotificationHandler) UpdateNotificationPreferenceHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.NotificationPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateNotificationPreference(ctx, userID, *input)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListNotificationsHandler godoc
+// @Summary Lista os avisos da caixa de entrada
+// @Description Lista os avisos do app, dos mais recentes para os mais antigos. Os avisos são gerados quando uma importação termina, um orçamento passa do limite, uma fatura vence sem pagamento e pelos lembretes de vencimento
+// @Tags Notificações
+// @Produce json
+// @Param unread query bool false "true para só os não lidos, false para só os lidos"
+// @Param type query string false "Tipo do aviso (invoice_due, invoice_overdue, transaction_due, budget_alert, import_completed, anomaly)"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: created_at)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.NotificationResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications [get]
+func (h *NotificationHandler) ListNotificationsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.NotificationFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"created_at": true,
+		"read_at":    true,
+		"type":       true,
+	}
+
+	if err := pgn.ValidateOrderBy("created_at", config.OrderDesc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListNotifications(ctx, userID, flt, pgn)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+	c.JSON(http.StatusOK, response)
+}
+
+// CountUnreadNotificationsHandler godoc
+// @Summary Conta os avisos não lidos
+// @Tags Notificações
+// @Produce json
+// @Success 200 {object} dto.NotificationUnreadCountResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/unread-count [get]
+func (h *NotificationHandler) CountUnreadNotificationsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// MarkNotificationReadHandler godoc
+// @Summary Marca um aviso como lido
+// @Description Um aviso já lido mantém a data da primeira leitura
+// @Tags Notificações
+// @Produce json
+// @Param id path string true "ID do aviso"
+// @Success 200 {object} dto.NotificationResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/{id}/read [post]
+func (h *NotificationHandler) MarkNotificationReadHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.MarkNotificationRead(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// MarkAllNotificationsReadHandler godoc
+// @Summary Marca todos os avisos como lidos
+// @Tags Notificações
+// @Produce json
+// @Success 200 {object} dto.NotificationReadAllResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllNotificationsReadHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.MarkAllNotificationsRead(ctx, userID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayeeHandler struct {
+	service inbound.PayeeService
+}
+
+func NewPayeeHandler(service inbound.PayeeService) *PayeeHandler {
+	return &PayeeHandler{service: service}
+}
+
+// CreatePayeeHandler godoc
+// @Summary Cria um novo favorecido
+// @Description Cria um favorecido com nome e apelidos; favorecidos cujo nome normalizado é informado como apelido são incorporados a ele
+// @Tags Favorecidos
+// @Accept json
+// @Produce json
+// @Param request body dto.PayeeRequest true "Dados do favorecido"
+// @Success 201 {object} dto.PayeeResponse
+// @Security BearerAuth
+// @Router /api/v1/payees [post]
+func (h *PayeeHandler) CreatePayeeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.PayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreatePayee(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrPayeeConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetPayeeByIDHandler godoc
+// @Summary Busca um favorecido por ID
+// @Description Retorna os dados de um favorecido e os totais de suas transações no período informado
+// @Tags Favorecidos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do favorecido"
+// @Param start_date query string false "Data inicial dos totais"
+// @Param end_date query string false "Data final dos totais"
+// @Success 200 {object} dto.PayeeResponse
+// @Security BearerAuth
+// @Router /api/v1/payees/{id} [get]
+func (h *PayeeHandler) GetPayeeByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var flt dto.PayeeFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetPayeeByID(ctx, ledgerID, id, flt)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListPayeesHandler godoc
+// @Summary Lista favorecidos com totais
+// @Description Lista os favorecidos do usuário com a quantidade de transações, receitas e despesas no período; por padrão os que têm mais despesas aparecem primeiro
+// @Tags Favorecidos
+// @Accept json
+// @Produce json
+// @Param start_date query string false "Data inicial dos totais"
+// @Param end_date query string false "Data final dos totais"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: expense, transactions, name)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.PayeeResponse
+// @Security BearerAuth
+// @Router /api/v1/payees [get]
+func (h *PayeeHandler) ListPayeesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.PayeeFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":               true,
+		"name":             true,
+		"transactions":     true,
+		"income":           true,
+		"expense":          true,
+		"last_record_date": true,
+		"created_at":       true,
+		"updated_at":       true,
+	}
+
+	if err := pgn.ValidateOrderBy("expense", config.OrderDesc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListPayees(ctx, ledgerID, flt, pgn)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdatePayeeHandler godoc
+// @Summary Atualiza um favorecido existente
+// @Description Renomeia o favorecido e redefine seus apelidos; o nome normalizado anterior é mantido como apelido
+// @Tags Favorecidos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do favorecido"
+// @Param request body dto.PayeeRequest true "Dados atualizados do favorecido"
+// @Success 200 {object} dto.PayeeResponse
+// @Security BearerAuth
+// @Router /api/v1/payees/{id} [put]
+func (h *PayeeHandler) UpdatePayeeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.PayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdatePayee(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrPayeeConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeletePayeeHandler godoc
+// @Summary Remove um favorecido
+// @Description Exclui um favorecido com base no ID fornecido; suas transações ficam sem favorecido
+// @Tags Favorecidos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do favorecido"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/payees/{id} [delete]
+func (h *PayeeHandler) DeletePayeeHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	err = h.service.DeletePayeeByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
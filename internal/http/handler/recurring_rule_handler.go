@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecurringRuleHandler struct {
+	service inbound.RecurringRuleService
+}
+
+func NewRecurringRuleHandler(service inbound.RecurringRuleService) *RecurringRuleHandler {
+	return &RecurringRuleHandler{service: service}
+}
+
+// CreateRecurringRuleHandler godoc
+// @Summary Cria uma regra recorrente
+// @Description Cadastra um lançamento que se repete (weekly, monthly ou yearly) a partir de start_date até end_date, se houver. Nas regras mensais e anuais o dia que não existe no mês cai no último dia do mês. A previsão de fluxo de caixa projeta cada ocorrência na conta da regra; as transações lançadas com recurring_rule_id contam como ocorrências já realizadas. currency ausente assume a moeda base
+// @Tags Regras recorrentes
+// @Accept json
+// @Produce json
+// @Param request body dto.RecurringRuleRequest true "Dados da regra"
+// @Success 201 {object} dto.RecurringRuleResponse
+// @Security BearerAuth
+// @Router /api/v1/recurring-rules [post]
+func (h *RecurringRuleHandler) CreateRecurringRuleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.RecurringRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateRecurringRule(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetRecurringRuleByIDHandler godoc
+// @Summary Busca uma regra recorrente por ID
+// @Tags Regras recorrentes
+// @Produce json
+// @Param id path string true "ID da regra"
+// @Success 200 {object} dto.RecurringRuleResponse
+// @Security BearerAuth
+// @Router /api/v1/recurring-rules/{id} [get]
+func (h *RecurringRuleHandler) GetRecurringRuleByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetRecurringRuleByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListRecurringRulesHandler godoc
+// @Summary Lista regras recorrentes com paginação
+// @Tags Regras recorrentes
+// @Produce json
+// @Param search query string false "Buscar pelo título"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: start_date)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.RecurringRuleResponse
+// @Security BearerAuth
+// @Router /api/v1/recurring-rules [get]
+func (h *RecurringRuleHandler) ListRecurringRulesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"title":      true,
+		"amount":     true,
+		"frequency":  true,
+		"start_date": true,
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("start_date", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListRecurringRules(ctx, ledgerID, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateRecurringRuleHandler godoc
+// @Summary Atualiza uma regra recorrente
+// @Tags Regras recorrentes
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da regra"
+// @Param request body dto.RecurringRuleRequest true "Dados atualizados da regra"
+// @Success 200 {object} dto.RecurringRuleResponse
+// @Security BearerAuth
+// @Router /api/v1/recurring-rules/{id} [put]
+func (h *RecurringRuleHandler) UpdateRecurringRuleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.RecurringRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateRecurringRule(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteRecurringRuleHandler godoc
+// @Summary Remove uma regra recorrente
+// @Tags Regras recorrentes
+// @Param id path string true "ID da regra"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/recurring-rules/{id} [delete]
+func (h *RecurringRuleHandler) DeleteRecurringRuleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteRecurringRuleByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RuleHandler struct {
+	service inbound.RuleService
+}
+
+func NewRuleHandler(service inbound.RuleService) *RuleHandler {
+	return &RuleHandler{service: service}
+}
+
+// CreateRuleHandler godoc
+// @Summary Cria uma nova regra
+// @Description Cria uma regra de categorização. As regras são avaliadas por prioridade (menor primeiro) na criação de transações e a primeira que atender às condições aplica suas ações
+// @Tags Regras
+// @Accept json
+// @Produce json
+// @Param request body dto.RuleRequest true "Dados da regra"
+// @Success 201 {object} dto.RuleResponse
+// @Failure 422 {object} map[string]string "Categoria ou fatura não encontrada"
+// @Security BearerAuth
+// @Router /api/v1/rules [post]
+func (h *RuleHandler) CreateRuleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.RuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateRule(ctx, ledgerID, *input)
+	if err != nil {
+		c.Error(ruleReferenceError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetRuleByIDHandler godoc
+// @Summary Busca uma regra por ID
+// @Description Retorna os dados de uma regra com base no ID fornecido
+// @Tags Regras
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da regra"
+// @Success 200 {object} dto.RuleResponse
+// @Security BearerAuth
+// @Router /api/v1/rules/{id} [get]
+func (h *RuleHandler) GetRuleByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetRuleByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListRulesHandler godoc
+// @Summary Lista regras com filtros e paginação
+// @Description Lista todas as regras do usuário aplicando filtros e paginação
+// @Tags Regras
+// @Accept json
+// @Produce json
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: priority)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.RuleResponse
+// @Security BearerAuth
+// @Router /api/v1/rules [get]
+func (h *RuleHandler) ListRulesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"name":       true,
+		"rule_type":  true,
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("priority", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListRules(ctx, ledgerID, pgn)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateRuleHandler godoc
+// @Summary Atualiza uma regra existente
+// @Description Atualiza os dados de uma regra com base no ID fornecido e nos dados enviados no corpo da requisição
+// @Tags Regras
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da regra"
+// @Param request body dto.RuleRequest true "Dados atualizados da regra"
+// @Success 200 {object} dto.RuleResponse
+// @Failure 422 {object} map[string]string "Categoria ou fatura não encontrada"
+// @Security BearerAuth
+// @Router /api/v1/rules/{id} [put]
+func (h *RuleHandler) UpdateRuleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.RuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateRule(ctx, ledgerID, id, *input)
+	if err != nil {
+		c.Error(ruleReferenceError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteRuleHandler godoc
+// @Summary Remove uma regra
+// @Description Exclui uma regra com base no ID fornecido
+// @Tags Regras
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da regra"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/rules/{id} [delete]
+func (h *RuleHandler) DeleteRuleHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	err = h.service.DeleteRuleByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func ruleReferenceError(err error) *appError.AppError {
+	switch {
+	case errors.Is(err, appError.ErrCategoryNotFound), errors.Is(err, appError.ErrInvoiceNotFound):
+		return appError.NewAppError(http.StatusUnprocessableEntity, err)
+	case errors.Is(err, appError.ErrNotFound):
+		return appError.NewAppError(http.StatusNotFound, err)
+	default:
+		return appError.NewAppError(http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SharingHandler struct {
+	service inbound.SharingService
+}
+
+func NewSharingHandler(service inbound.SharingService) *SharingHandler {
+	return &SharingHandler{service: service}
+}
+
+// SetTransactionParticipantsHandler godoc
+// @Summary Divide uma despesa entre membros do livro
+// @Description Define quem pagou a despesa (paid_by_id, padrão: o usuário autenticado) e como ela é dividida. Em mode=equal o valor é dividido igualmente, em percentage cada participante informa percentage (a soma deve ser 100) e em exact cada participante informa amount (a soma deve ser o valor da transação). As partes ficam na moeda da transação e a divisão anterior é substituída
+// @Tags Divisão de despesas
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da transação"
+// @Param request body dto.TransactionParticipantsRequest true "Divisão da despesa"
+// @Success 200 {object} dto.TransactionParticipantsResponse
+// @Failure 422 {object} map[string]string "Partes não somam o valor, transação não é despesa ou participante fora do livro"
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/participants [put]
+func (h *SharingHandler) SetTransactionParticipantsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.TransactionParticipantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain(userID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.SetTransactionParticipants(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrShareMismatch) || errors.Is(err, appError.ErrShareNotExpense) || errors.Is(err, appError.ErrParticipantNotMember) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetTransactionParticipantsHandler godoc
+// @Summary Mostra a divisão de uma despesa
+// @Tags Divisão de despesas
+// @Produce json
+// @Param id path string true "ID da transação"
+// @Success 200 {object} dto.TransactionParticipantsResponse
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/participants [get]
+func (h *SharingHandler) GetTransactionParticipantsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetTransactionParticipants(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteTransactionParticipantsHandler godoc
+// @Summary Desfaz a divisão de uma despesa
+// @Tags Divisão de despesas
+// @Param id path string true "ID da transação"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/transactions/{id}/participants [delete]
+func (h *SharingHandler) DeleteTransactionParticipantsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteTransactionParticipants(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SharedBalancesHandler godoc
+// @Summary Saldos entre os membros do livro
+// @Description Mostra, na moeda base, quanto cada membro pagou e consumiu nas despesas divididas não canceladas e os acertos já registrados. net positivo é o que o membro tem a receber; payments é o menor conjunto de pagamentos que zera os saldos
+// @Tags Divisão de despesas
+// @Produce json
+// @Success 200 {object} dto.SharedBalancesResponse
+// @Security BearerAuth
+// @Router /api/v1/settlements/balances [get]
+func (h *SharingHandler) SharedBalancesHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	data, err := h.service.SharedBalances(ctx, ledgerID)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// SettleUpHandler godoc
+// @Summary Acerta as contas entre os membros do livro
+// @Description Registra como acertos os pagamentos sugeridos em /settlements/balances, zerando os saldos. Sem dívidas em aberto, nada é registrado
+// @Tags Divisão de despesas
+// @Accept json
+// @Produce json
+// @Param request body dto.SettleUpRequest false "Observação dos acertos"
+// @Success 201 {array} dto.SettlementResponse
+// @Security BearerAuth
+// @Router /api/v1/settlements/settle-up [post]
+func (h *SharingHandler) SettleUpHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.SettleUpRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+	}
+
+	data, err := h.service.SettleUp(ctx, ledgerID, req.Note)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// CreateSettlementHandler godoc
+// @Summary Registra um acerto entre membros do livro
+// @Description Registra que from_user_id pagou amount (na moeda base) a to_user_id, abatendo a dívida entre os dois
+// @Tags Divisão de despesas
+// @Accept json
+// @Produce json
+// @Param request body dto.SettlementRequest true "Dados do acerto"
+// @Success 201 {object} dto.SettlementResponse
+// @Failure 422 {object} map[string]string "Usuário fora do livro"
+// @Security BearerAuth
+// @Router /api/v1/settlements [post]
+func (h *SharingHandler) CreateSettlementHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.SettlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateSettlement(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrParticipantNotMember) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// ListSettlementsHandler godoc
+// @Summary Lista os acertos com paginação
+// @Tags Divisão de despesas
+// @Produce json
+// @Param user_id query string false "Acertos pagos ou recebidos pelo usuário"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: settled_at)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.SettlementResponse
+// @Security BearerAuth
+// @Router /api/v1/settlements [get]
+func (h *SharingHandler) ListSettlementsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.SettlementFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"amount":     true,
+		"settled_at": true,
+		"created_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("settled_at", config.OrderDesc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListSettlements(ctx, ledgerID, flt, pgn)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteSettlementHandler godoc
+// @Summary Remove um acerto
+// @Tags Divisão de despesas
+// @Param id path string true "ID do acerto"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/settlements/{id} [delete]
+func (h *SharingHandler) DeleteSettlementHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	if err := h.service.DeleteSettlementByID(ctx, ledgerID, id); err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
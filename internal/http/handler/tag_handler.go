@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/config"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils"
+	"frog-go/internal/utils/pagination"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler struct {
+	service inbound.TagService
+}
+
+func NewTagHandler(service inbound.TagService) *TagHandler {
+	return &TagHandler{service: service}
+}
+
+// CreateTagHandler godoc
+// @Summary Cria uma nova tag
+// @Description Cria uma tag para marcar transações independentemente da categoria
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param request body dto.TagRequest true "Dados da tag"
+// @Success 201 {object} dto.TagResponse
+// @Security BearerAuth
+// @Router /api/v1/tags [post]
+func (h *TagHandler) CreateTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.CreateTag(ctx, ledgerID, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrTagConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, data)
+}
+
+// GetTagByIDHandler godoc
+// @Summary Busca uma tag por ID
+// @Description Retorna os dados de uma tag com base no ID fornecido
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Success 200 {object} dto.TagResponse
+// @Security BearerAuth
+// @Router /api/v1/tags/{id} [get]
+func (h *TagHandler) GetTagByIDHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.GetTagByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ListTagsHandler godoc
+// @Summary Lista tags com filtros e paginação
+// @Description Lista todas as tags do usuário aplicando filtros e paginação
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param page query int false "Número da página"
+// @Param limit query int false "Limite por página"
+// @Param order_by query string false "Campo de ordenação (ex: name)"
+// @Param order query string false "Ordem (asc, desc)"
+// @Success 200 {array} dto.TagResponse
+// @Security BearerAuth
+// @Router /api/v1/tags [get]
+func (h *TagHandler) ListTagsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	pgn, err := pagination.NewPagination(c)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	validColumns := map[string]bool{
+		"id":         true,
+		"name":       true,
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	if err := pgn.ValidateOrderBy("name", config.OrderAsc, validColumns); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	response, total, err := h.service.ListTags(ctx, ledgerID, pgn)
+
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	pgn.SetPaginationHeaders(c, total)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateTagHandler godoc
+// @Summary Atualiza uma tag existente
+// @Description Atualiza os dados de uma tag com base no ID fornecido e nos dados enviados no corpo da requisição
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Param request body dto.TagRequest true "Dados atualizados da tag"
+// @Success 200 {object} dto.TagResponse
+// @Security BearerAuth
+// @Router /api/v1/tags/{id} [put]
+func (h *TagHandler) UpdateTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	var req dto.TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	input, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateTag(ctx, ledgerID, id, *input)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrTagConflict) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// DeleteTagHandler godoc
+// @Summary Remove uma tag
+// @Description Exclui uma tag com base no ID fornecido; ela é retirada das transações
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Success 204 "Sem conteúdo"
+// @Security BearerAuth
+// @Router /api/v1/tags/{id} [delete]
+func (h *TagHandler) DeleteTagHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	id, err := utils.ToUUID(c.Param("id"))
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	err = h.service.DeleteTagByID(ctx, ledgerID, id)
+	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+	"frog-go/internal/core/dto"
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/utilsctx"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TaxReportHandler struct {
+	service inbound.TaxReportService
+}
+
+func NewTaxReportHandler(service inbound.TaxReportService) *TaxReportHandler {
+	return &TaxReportHandler{service: service}
+}
+
+// TaxReportHandler godoc
+// @Summary Relatório do imposto de renda
+// @Description Resume o ano-calendário com os lançamentos pagos, na moeda base: rendimentos tributáveis e isentos e imposto retido (lançamentos do tipo tax) por fonte pagadora, e despesas dedutíveis (saúde, instrução e previdência) por grupo, categoria e favorecido, com o CPF/CNPJ cadastrado. O grupo vem do tax_group da categoria ou do ancestral mais próximo; receitas sem grupo contam como tributáveis. Sem ano, usa o ano anterior
+// @Tags Imposto de renda
+// @Produce json
+// @Param year query int false "Ano-calendário"
+// @Success 200 {object} dto.TaxReportResponse
+// @Security BearerAuth
+// @Router /api/v1/tax-report [get]
+func (h *TaxReportHandler) TaxReportHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.TaxReportFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.TaxReport(ctx, ledgerID, flt.Year)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// ExportTaxReportHandler godoc
+// @Summary Exporta o relatório do imposto de renda
+// @Description Gera o relatório do ano em xlsx (padrão, com abas de resumo e das fichas da declaração) ou csv (separado por ponto e vírgula, com vírgula decimal), com uma linha por valor a digitar no programa da Receita Federal
+// @Tags Imposto de renda
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce text/csv
+// @Param year query int false "Ano-calendário"
+// @Param format query string false "Formato do arquivo (xlsx, csv)"
+// @Success 200 {file} file
+// @Security BearerAuth
+// @Router /api/v1/tax-report/export [get]
+func (h *TaxReportHandler) ExportTaxReportHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var flt dto.TaxReportFilters
+	if err := c.ShouldBindQuery(&flt); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	file, err := h.service.ExportTaxReport(ctx, ledgerID, flt.Year, flt.Format)
+	if err != nil {
+		if errors.Is(err, appError.ErrBadRequest) {
+			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+			return
+		}
+		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		return
+	}
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": file.Filename})
+	c.Header("Content-Disposition", disposition)
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
@@ -24,7 +24,7 @@ func NewTransactionHandler(service inbound.TransactionService) *TransactionHandl
 
 // CreateTransactionHandler godoc
 // @Summary Cria uma nova transação
-// @Description Cria uma nova transação com os dados fornecidos no corpo da requisição
+// @Description Cria uma nova transação com os dados fornecidos no corpo da requisição. transfer_account_id marca a transação como transferência para uma conta do livro, contada como aporte nas metas dessa conta
 // @Tags Transações
 // @Accept json
 // @Produce json
@@ -34,9 +34,16 @@ func NewTransactionHandler(service inbound.TransactionService) *TransactionHandl
 // @Router /api/v1/transactions [post]
 func (h *TransactionHandler) CreateTransactionHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
 	userID, err := utilsctx.GetUserID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
 	}
 
 	var req dto.TransactionRequest
@@ -51,8 +58,16 @@ func (h *TransactionHandler) CreateTransactionHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.CreateTransaction(ctx, userID, *input)
+	data, err := h.service.CreateTransaction(ctx, ledgerID, userID, *input)
 	if err != nil {
+		if errors.Is(err, appError.ErrInvoiceNotOpen) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrTagNotFound) || errors.Is(err, appError.ErrInvoiceNotFound) || errors.Is(err, appError.ErrRecurringRuleNotFound) || errors.Is(err, appError.ErrAccountNotFound) || errors.Is(err, appError.ErrTransactionSkipped) || errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
 	}
@@ -72,7 +87,7 @@ func (h *TransactionHandler) CreateTransactionHandler(c *gin.Context) {
 // @Router /api/v1/transactions/{id} [get]
 func (h *TransactionHandler) GetTransactionByIDHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -83,7 +98,7 @@ func (h *TransactionHandler) GetTransactionByIDHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.GetTransactionByID(ctx, userID, id)
+	data, err := h.service.GetTransactionByID(ctx, ledgerID, id)
 	if err != nil {
 		if errors.Is(err, appError.ErrNotFound) {
 			c.Error(appError.NewAppError(http.StatusNotFound, err))
@@ -105,6 +120,9 @@ func (h *TransactionHandler) GetTransactionByIDHandler(c *gin.Context) {
 // @Param status query []string false "Filtrar por status"
 // @Param record_types query []string false "Filtrar por tipos de transação (income, expense)"
 // @Param category_id query []string false "Filtrar por categorias"
+// @Param payee_ids query []string false "Filtrar por favorecidos"
+// @Param tag_ids query []string false "Filtrar por tags"
+// @Param tag_match query string false "Combinação das tags: any (qualquer uma, padrão) ou all (todas)"
 // @Param min_amount query number false "Valor mínimo"
 // @Param max_amount query number false "Valor máximo"
 // @Param start_date query string false "Data inicial"
@@ -118,7 +136,7 @@ func (h *TransactionHandler) GetTransactionByIDHandler(c *gin.Context) {
 // @Router /api/v1/transactions [get]
 func (h *TransactionHandler) ListTransactionsHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -156,7 +174,7 @@ func (h *TransactionHandler) ListTransactionsHandler(c *gin.Context) {
 		return
 	}
 
-	response, total, err := h.service.ListTransactions(ctx, userID, flt, pgn)
+	response, total, err := h.service.ListTransactions(ctx, ledgerID, flt, pgn)
 
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
@@ -181,7 +199,7 @@ func (h *TransactionHandler) ListTransactionsHandler(c *gin.Context) {
 // @Router /api/v1/transactions/{id} [put]
 func (h *TransactionHandler) UpdateTransactionHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -204,8 +222,16 @@ func (h *TransactionHandler) UpdateTransactionHandler(c *gin.Context) {
 		return
 	}
 
-	data, err := h.service.UpdateTransaction(ctx, userID, id, *input)
+	data, err := h.service.UpdateTransaction(ctx, ledgerID, id, *input)
 	if err != nil {
+		if errors.Is(err, appError.ErrInvoiceNotOpen) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
+		if errors.Is(err, appError.ErrCategoryNotFound) || errors.Is(err, appError.ErrTagNotFound) || errors.Is(err, appError.ErrInvoiceNotFound) || errors.Is(err, appError.ErrRecurringRuleNotFound) || errors.Is(err, appError.ErrAccountNotFound) || errors.Is(err, appError.ErrSplitMismatch) || errors.Is(err, appError.ErrShareMismatch) || errors.Is(err, appError.ErrExchangeRateNotFound) {
+			c.Error(appError.NewAppError(http.StatusUnprocessableEntity, err))
+			return
+		}
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
 	}
@@ -215,19 +241,21 @@ func (h *TransactionHandler) UpdateTransactionHandler(c *gin.Context) {
 
 // DeleteTransactionHandler godoc
 // @Summary Remove uma transação
-// @Description Exclui uma transação com base no ID fornecido
+// @Description Exclui uma transação com base no ID fornecido. O valor dela sai da fatura; transações de faturas fechadas ou pagas não podem ser excluídas
 // @Tags Transações
 // @Accept json
 // @Produce json
 // @Param id path string true "ID da transação"
 // @Success 204 "Sem conteúdo"
+// @Failure 409 {object} map[string]string "Fatura fechada"
 // @Security BearerAuth
 // @Router /api/v1/transactions/{id} [delete]
 func (h *TransactionHandler) DeleteTransactionHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
 	}
 
 	id, err := utils.ToUUID(c.Param("id"))
@@ -236,8 +264,16 @@ func (h *TransactionHandler) DeleteTransactionHandler(c *gin.Context) {
 		return
 	}
 
-	err = h.service.DeleteTransactionByID(ctx, userID, id)
+	err = h.service.DeleteTransactionByID(ctx, ledgerID, id)
 	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
+		if errors.Is(err, appError.ErrInvoiceNotOpen) {
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+			return
+		}
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
 	}
@@ -254,12 +290,14 @@ func (h *TransactionHandler) DeleteTransactionHandler(c *gin.Context) {
 // @Param start_date query string false "Data inicial (YYYY-MM-DD)"
 // @Param end_date query string false "Data final (YYYY-MM-DD)"
 // @Param record_types query []string false "Tipos de transação (income, expense)"
+// @Param depth query int false "Profundidade da hierarquia; subcategorias mais profundas são somadas no ancestral (0 = raízes)"
+// @Param group_by query string false "Agrupamento adicional: tag inclui os totais por tag (uma transação conta em cada uma de suas tags)"
 // @Success 200 {object} dto.SummaryByDate
 // @Security BearerAuth
 // @Router /api/v1/transactions/summary [get]
 func (h *TransactionHandler) TransactionsSummaryHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -270,7 +308,7 @@ func (h *TransactionHandler) TransactionsSummaryHandler(c *gin.Context) {
 		return
 	}
 
-	response, err := h.service.TransactionsSummary(ctx, userID, flt)
+	response, err := h.service.TransactionsSummary(ctx, ledgerID, flt)
 
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
@@ -289,12 +327,14 @@ func (h *TransactionHandler) TransactionsSummaryHandler(c *gin.Context) {
 // @Param start_date query string false "Data inicial (YYYY-MM-DD)"
 // @Param end_date query string false "Data final (YYYY-MM-DD)"
 // @Param record_types query []string false "Tipos de transação (income, expense)"
+// @Param depth query int false "Profundidade da hierarquia; subcategorias mais profundas são somadas no ancestral (0 = raízes)"
+// @Param group_by query string false "Agrupamento adicional: tag inclui os totais por tag (uma transação conta em cada uma de suas tags)"
 // @Success 200 {object} dto.TransactionStatsSummary
 // @Security BearerAuth
 // @Router /api/v1/transactions/stats [get]
 func (h *TransactionHandler) TransactionsGeneralStatsHandler(c *gin.Context) {
 	ctx := c.Request.Context()
-	userID, err := utilsctx.GetUserID(ctx)
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
 	}
@@ -305,7 +345,7 @@ func (h *TransactionHandler) TransactionsGeneralStatsHandler(c *gin.Context) {
 		return
 	}
 
-	response, err := h.service.TransactionsGeneralStats(ctx, userID, flt)
+	response, err := h.service.TransactionsGeneralStats(ctx, ledgerID, flt)
 
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
@@ -25,8 +25,9 @@ type UploadHandler struct {
 // @Param invoice_id formData  string true   "ID da fatura (opcional)"
 // @Param model formData  string true   "Nome do modelo alvo (ex: Nubank)"
 // @Param action formData  string true   "Ação desejada (ex: create)"
-// @Success 202 {object} map[string]string "Arquivo recebido, processamento em andamento"
+// @Success 202 {object} map[string]string "Arquivo recebido, processamento em andamento. Retorna o job_id da importação"
 // @Failure 400 {object} map[string]string "Erro nos parâmetros ou no upload"
+// @Failure 413 {object} map[string]string "Arquivo maior que o permitido"
 // @Security BearerAuth
 // @Router /api/v1/upload [post]
 func NewUploadHandler(service inbound.UploadService) *UploadHandler {
@@ -35,6 +36,12 @@ func NewUploadHandler(service inbound.UploadService) *UploadHandler {
 
 func (h *UploadHandler) ProcessFileHandler(c *gin.Context) {
 	ctx := c.Request.Context()
+	ledgerID, err := utilsctx.GetLedgerID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
 	userID, err := utilsctx.GetUserID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
@@ -77,10 +84,14 @@ func (h *UploadHandler) ProcessFileHandler(c *gin.Context) {
 	}
 	defer file.Close()
 
-	if err := h.service.ImportFile(userID, model, action, invoiceID, file, fileHeader); err != nil {
-		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+	jobID, err := h.service.ImportFile(ctx, ledgerID, userID, model, action, invoiceID, file, fileHeader)
+	if err != nil {
+		c.Error(attachmentError(err))
 		return
 	}
 
-	c.JSON(http.StatusAccepted, gin.H{"message": "Arquivo recebido, processamento em andamento"})
+	c.JSON(http.StatusAccepted, gin.H{
+		"message": "Arquivo recebido, processamento em andamento",
+		"job_id":  jobID,
+	})
 }
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"errors"
+	"frog-go/internal/core/dto"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/core/ports/inbound"
 	"frog-go/internal/utils/utilsctx"
@@ -18,16 +19,28 @@ func NewUserHandler(service inbound.UserService) *UserHandler {
 	return &UserHandler{service: service}
 }
 
-// GET /api/v1/users/me
+// GetProfileHandler godoc
+// @Summary Retorna o usuário autenticado
+// @Description Retorna os dados do usuário, incluindo a moeda base usada nos totais
+// @Tags Usuários
+// @Produce json
+// @Success 200 {object} dto.UserResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me [get]
 func (h *UserHandler) GetProfileHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID, err := utilsctx.GetUserID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
 	}
 
 	data, err := h.service.GetUser(ctx, userID)
 	if err != nil {
+		if errors.Is(err, appError.ErrNotFound) {
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+			return
+		}
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
 	}
@@ -35,8 +48,18 @@ func (h *UserHandler) GetProfileHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, data)
 }
 
-// PUT /api/v1/users/me
-func (h *UserHandler) UpdateProfileHandler(c *gin.Context) {
+// UpdateBaseCurrencyHandler godoc
+// @Summary Define a moeda base do usuário
+// @Description Define a moeda para a qual estatísticas, resumos e faturas são convertidos. Só pode ser alterada antes do primeiro lançamento
+// @Tags Usuários
+// @Accept json
+// @Produce json
+// @Param request body dto.BaseCurrencyRequest true "Código ISO 4217 da moeda (ex: BRL, USD)"
+// @Success 200 {object} dto.UserResponse
+// @Failure 409 {object} map[string]string "Já existem transações ou faturas na moeda atual"
+// @Security BearerAuth
+// @Router /api/v1/users/me/base-currency [put]
+func (h *UserHandler) UpdateBaseCurrencyHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID, err := utilsctx.GetUserID(ctx)
 	if err != nil {
@@ -44,11 +67,54 @@ func (h *UserHandler) UpdateProfileHandler(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		Name     string `json:"name" binding:"omitempty"`
-		Username string `json:"username" binding:"omitempty"`
+	var req dto.BaseCurrencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	currency, err := req.ToDomain()
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		return
+	}
+
+	data, err := h.service.UpdateUserBaseCurrency(ctx, userID, currency)
+	if err != nil {
+		switch {
+		case errors.Is(err, appError.ErrBaseCurrencyLocked):
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+		case errors.Is(err, appError.ErrNotFound):
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+		default:
+			c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		}
+		return
 	}
 
+	c.JSON(http.StatusOK, data)
+}
+
+// UpdateProfileHandler godoc
+// @Summary Atualiza o nome e o username
+// @Description Campos vazios mantêm o valor atual
+// @Tags Usuários
+// @Accept json
+// @Produce json
+// @Param request body dto.UserProfileRequest true "Nome e username"
+// @Success 200 {object} dto.UserResponse
+// @Failure 409 {object} map[string]string "Username já está em uso"
+// @Security BearerAuth
+// @Router /api/v1/users/me [put]
+func (h *UserHandler) UpdateProfileHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, err := utilsctx.GetUserID(ctx)
+	if err != nil {
+		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
+	}
+
+	var req dto.UserProfileRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
 		return
@@ -56,26 +122,39 @@ func (h *UserHandler) UpdateProfileHandler(c *gin.Context) {
 
 	updatedUser, err := h.service.UpdateUserProfile(ctx, userID, req.Name, req.Username)
 	if err != nil {
-		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		switch {
+		case errors.Is(err, appError.ErrUserConflict):
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+		case errors.Is(err, appError.ErrNotFound):
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+		default:
+			c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		}
 		return
 	}
 
 	c.JSON(http.StatusOK, updatedUser)
 }
 
-// PATCH /api/v1/users/me/password
+// UpdatePasswordHandler godoc
+// @Summary Troca a senha
+// @Tags Usuários
+// @Accept json
+// @Produce json
+// @Param request body dto.UserPasswordRequest true "Senha atual e nova senha"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string "Senha atual incorreta"
+// @Security BearerAuth
+// @Router /api/v1/users/me/password [put]
 func (h *UserHandler) UpdatePasswordHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID, err := utilsctx.GetUserID(ctx)
 	if err != nil {
 		c.Error(appError.NewAppError(http.StatusUnauthorized, err))
+		return
 	}
 
-	var req struct {
-		OldPassword string `json:"old_password" binding:"required"`
-		NewPassword string `json:"new_password" binding:"required,min=6"`
-	}
-
+	var req dto.UserPasswordRequest
 	if err = c.ShouldBindJSON(&req); err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
 		return
@@ -86,6 +165,8 @@ func (h *UserHandler) UpdatePasswordHandler(c *gin.Context) {
 		switch {
 		case errors.Is(err, appError.ErrInvalidPassword):
 			c.Error(appError.NewAppError(http.StatusBadRequest, err))
+		case errors.Is(err, appError.ErrNotFound):
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
 		default:
 			c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		}
@@ -97,7 +178,17 @@ func (h *UserHandler) UpdatePasswordHandler(c *gin.Context) {
 	})
 }
 
-// PATCH /api/v1/users/me/email
+// UpdateEmailHandler godoc
+// @Summary Troca o e-mail
+// @Description O novo e-mail passa a receber os avisos por e-mail
+// @Tags Usuários
+// @Accept json
+// @Produce json
+// @Param request body dto.UserEmailRequest true "Novo e-mail"
+// @Success 200 {object} map[string]string
+// @Failure 409 {object} map[string]string "E-mail já está em uso"
+// @Security BearerAuth
+// @Router /api/v1/users/me/email [put]
 func (h *UserHandler) UpdateEmailHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID, err := utilsctx.GetUserID(ctx)
@@ -106,10 +197,7 @@ func (h *UserHandler) UpdateEmailHandler(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		NewEmail string `json:"new_email" binding:"required,email"`
-	}
-
+	var req dto.UserEmailRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Error(appError.NewAppError(http.StatusBadRequest, err))
 		return
@@ -117,7 +205,14 @@ func (h *UserHandler) UpdateEmailHandler(c *gin.Context) {
 
 	err = h.service.UpdateUserEmail(ctx, userID, req.NewEmail)
 	if err != nil {
-		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		switch {
+		case errors.Is(err, appError.ErrUserConflict):
+			c.Error(appError.NewAppError(http.StatusConflict, err))
+		case errors.Is(err, appError.ErrNotFound):
+			c.Error(appError.NewAppError(http.StatusNotFound, err))
+		default:
+			c.Error(appError.NewAppError(http.StatusInternalServerError, err))
+		}
 		return
 	}
 
@@ -126,7 +221,14 @@ func (h *UserHandler) UpdateEmailHandler(c *gin.Context) {
 	})
 }
 
-// DELETE /api/v1/users/me
+// DeactivateAccountHandler godoc
+// @Summary Desativa a conta
+// @Description Ainda não implementado: depende de revogar os tokens já emitidos
+// @Tags Usuários
+// @Produce json
+// @Failure 501 {object} map[string]string "Não implementado"
+// @Security BearerAuth
+// @Router /api/v1/users/me [delete]
 func (h *UserHandler) DeactivateAccountHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID, err := utilsctx.GetUserID(ctx)
@@ -137,6 +239,10 @@ func (h *UserHandler) DeactivateAccountHandler(c *gin.Context) {
 
 	err = h.service.DeactivateUserAccount(ctx, userID)
 	if err != nil {
+		if errors.Is(err, appError.ErrNotImplemented) {
+			c.Error(appError.NewAppError(http.StatusNotImplemented, err))
+			return
+		}
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
 	}
@@ -146,7 +252,14 @@ func (h *UserHandler) DeactivateAccountHandler(c *gin.Context) {
 	})
 }
 
-// POST /api/v1/users/logout
+// LogoutHandler godoc
+// @Summary Encerra a sessão
+// @Description Ainda não implementado: o token vale até expirar e basta descartá-lo no cliente
+// @Tags Usuários
+// @Produce json
+// @Failure 501 {object} map[string]string "Não implementado"
+// @Security BearerAuth
+// @Router /api/v1/users/logout [post]
 func (h *UserHandler) LogoutHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID, err := utilsctx.GetUserID(ctx)
@@ -157,6 +270,10 @@ func (h *UserHandler) LogoutHandler(c *gin.Context) {
 
 	err = h.service.LogoutUser(ctx, userID)
 	if err != nil {
+		if errors.Is(err, appError.ErrNotImplemented) {
+			c.Error(appError.NewAppError(http.StatusNotImplemented, err))
+			return
+		}
 		c.Error(appError.NewAppError(http.StatusInternalServerError, err))
 		return
 	}
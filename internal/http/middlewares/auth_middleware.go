@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"context"
+	"errors"
 	"frog-go/internal/core/domain"
 	appError "frog-go/internal/core/errors"
 	"frog-go/internal/utils/logger"
@@ -10,9 +11,17 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
-func AuthMiddleware(log *logger.Logger, validateToken func(string) (*domain.Claims, error)) gin.HandlerFunc {
+// LedgerHeader seleciona o livro ativo da requisição; sem ele, vale o livro pessoal.
+const LedgerHeader = "X-Ledger-ID"
+
+func AuthMiddleware(
+	log *logger.Logger,
+	validateToken func(string) (*domain.Claims, error),
+	resolveLedger func(ctx context.Context, userID uuid.UUID, ledgerID *uuid.UUID) (*domain.LedgerAccess, error),
+) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -48,8 +57,30 @@ func AuthMiddleware(log *logger.Logger, validateToken func(string) (*domain.Clai
 			return
 		}
 
-		// adiciona o userID no contexto
+		// adiciona o userID e o livro ativo no contexto; sem X-Ledger-ID, vale o livro pessoal
+		var ledgerID *uuid.UUID
+		if header := c.GetHeader(LedgerHeader); header != "" {
+			id, err := uuid.Parse(header)
+			if err != nil {
+				abortWithError(c, log, http.StatusBadRequest, appError.InvalidParam(LedgerHeader, err))
+				return
+			}
+			ledgerID = &id
+		}
+
+		access, err := resolveLedger(c.Request.Context(), claims.UserID, ledgerID)
+		if err != nil {
+			if errors.Is(err, appError.ErrNotFound) {
+				abortWithError(c, log, http.StatusForbidden, appError.ErrForbidden)
+				return
+			}
+			abortWithError(c, log, http.StatusInternalServerError, err)
+			return
+		}
+
 		ctx := context.WithValue(c.Request.Context(), utilsctx.UserIDKey, claims.UserID)
+		ctx = context.WithValue(ctx, utilsctx.LedgerIDKey, access.LedgerID)
+		ctx = context.WithValue(ctx, utilsctx.LedgerRoleKey, access.Role)
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
@@ -0,0 +1,39 @@
+package middlewares
+
+import (
+	appError "frog-go/internal/core/errors"
+	"frog-go/internal/utils/logger"
+	"frog-go/internal/utils/utilsctx"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LedgerWriteMiddleware barra as escritas de quem só pode ver o livro ativo. Vale apenas para as
+// rotas escopadas pelo livro; as do próprio usuário (perfil, notificações, convites) não
+// dependem do X-Ledger-ID e continuam liberadas para o leitor.
+func LedgerWriteMiddleware(log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isReadOnlyMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		role, err := utilsctx.GetLedgerRole(c.Request.Context())
+		if err != nil {
+			abortWithError(c, log, http.StatusUnauthorized, err)
+			return
+		}
+
+		if !role.CanWrite() {
+			abortWithError(c, log, http.StatusForbidden, appError.ErrForbidden)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
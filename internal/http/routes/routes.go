@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"frog-go/internal/core/ports/outbound/blobstore"
 	"frog-go/internal/core/ports/outbound/messagebus"
 	"frog-go/internal/core/ports/outbound/repository"
 	"frog-go/internal/core/service"
@@ -14,16 +15,18 @@ import (
 )
 
 type Router struct {
-	log  *logger.Logger
-	repo repository.Repository
-	mbus messagebus.MessageBus
+	log   *logger.Logger
+	repo  repository.Repository
+	mbus  messagebus.MessageBus
+	blobs blobstore.BlobStore
 }
 
-func NewRouter(log *logger.Logger, repo repository.Repository, mbus messagebus.MessageBus) *Router {
+func NewRouter(log *logger.Logger, repo repository.Repository, mbus messagebus.MessageBus, blobs blobstore.BlobStore) *Router {
 	return &Router{
-		log:  log,
-		repo: repo,
-		mbus: mbus,
+		log:   log,
+		repo:  repo,
+		mbus:  mbus,
+		blobs: blobs,
 	}
 }
 
@@ -48,26 +51,109 @@ func (r *Router) Setup(enableDebug bool) *gin.Engine {
 
 	registerAuthRoutes(engine.Group("/api/v1/auth"), authHandler)
 
-	v1.Use(middlewares.AuthMiddleware(r.log, authService.ValidateToken))
+	ledgerService := service.NewLedgerService(r.repo)
+	ledgerHandler := handler.NewLedgerHandler(ledgerService)
+
+	v1.Use(middlewares.AuthMiddleware(r.log, authService.ValidateToken, ledgerService.ResolveLedger))
 
 	engine.StaticFile("/favicon.ico", "./static/favicon.ico")
 	registerDocsRoutes(engine.Group("/docs/v1"))
 
+	// rotas escopadas pelo livro ativo: o leitor do livro só pode consultá-las
+	scoped := v1.Group("", middlewares.LedgerWriteMiddleware(r.log))
+
 	transactionService := service.NewTransactionService(r.repo)
 	transactionHandler := handler.NewTransactionHandler(transactionService)
-	registerTransactionRoutes(v1.Group("/transactions"), transactionHandler)
+	registerTransactionRoutes(scoped.Group("/transactions"), transactionHandler)
 
 	invoiceService := service.NewInvoiceService(r.repo)
 	invoiceHandler := handler.NewInvoiceHandler(invoiceService)
-	registerInvoiceRoutes(v1.Group("/invoices"), invoiceHandler)
+	registerInvoiceRoutes(scoped.Group("/invoices"), invoiceHandler)
+
+	accountService := service.NewAccountService(r.repo)
+	accountHandler := handler.NewAccountHandler(accountService)
+	registerAccountRoutes(scoped.Group("/accounts"), accountHandler)
+
+	ruleService := service.NewRuleService(r.repo)
+	ruleHandler := handler.NewRuleHandler(ruleService)
+	registerRuleRoutes(scoped.Group("/rules"), ruleHandler)
+
+	payeeService := service.NewPayeeService(r.repo)
+	payeeHandler := handler.NewPayeeHandler(payeeService)
+	registerPayeeRoutes(scoped.Group("/payees"), payeeHandler)
+
+	tagService := service.NewTagService(r.repo)
+	tagHandler := handler.NewTagHandler(tagService)
+	registerTagRoutes(scoped.Group("/tags"), tagHandler)
+
+	userHandler := handler.NewUserHandler(userService)
+	registerUserRoutes(v1.Group("/users"), userHandler)
+
+	exchangeRateService := service.NewExchangeRateService(r.repo)
+	exchangeRateHandler := handler.NewExchangeRateHandler(exchangeRateService)
+	registerExchangeRateRoutes(scoped.Group("/exchange-rates"), exchangeRateHandler)
+
+	budgetService := service.NewBudgetService(r.repo, r.mbus)
+	budgetHandler := handler.NewBudgetHandler(budgetService)
+	registerBudgetRoutes(scoped.Group("/budgets"), budgetHandler)
+
+	envelopeService := service.NewEnvelopeService(r.repo)
+	envelopeHandler := handler.NewEnvelopeHandler(envelopeService)
+	registerEnvelopeRoutes(scoped.Group("/envelopes"), envelopeHandler)
+
+	goalService := service.NewGoalService(r.repo)
+	goalHandler := handler.NewGoalHandler(goalService)
+	registerGoalRoutes(scoped.Group("/goals"), goalHandler)
+
+	registerLedgerRoutes(v1.Group("/ledgers"), ledgerHandler)
+
+	sharingService := service.NewSharingService(r.repo)
+	sharingHandler := handler.NewSharingHandler(sharingService)
+	registerSharingRoutes(scoped, sharingHandler)
+
+	netWorthService := service.NewNetWorthService(r.repo)
+	netWorthHandler := handler.NewNetWorthHandler(netWorthService)
+	registerNetWorthRoutes(scoped, netWorthHandler)
+
+	investmentService := service.NewInvestmentService(r.repo)
+	investmentHandler := handler.NewInvestmentHandler(investmentService)
+	registerInvestmentRoutes(scoped.Group("/investments"), investmentHandler)
+
+	loanService := service.NewLoanService(r.repo)
+	loanHandler := handler.NewLoanHandler(loanService)
+	registerLoanRoutes(scoped.Group("/loans"), loanHandler)
+
+	debtPlanService := service.NewDebtPlanService(r.repo)
+	debtPlanHandler := handler.NewDebtPlanHandler(debtPlanService)
+	registerDebtRoutes(scoped.Group("/debts"), debtPlanHandler)
+
+	cashFlowService := service.NewCashFlowService(r.repo)
+	cashFlowHandler := handler.NewCashFlowHandler(cashFlowService)
+	registerCashFlowRoutes(scoped.Group("/cash-flow"), cashFlowHandler)
+
+	recurringRuleService := service.NewRecurringRuleService(r.repo)
+	recurringRuleHandler := handler.NewRecurringRuleHandler(recurringRuleService)
+	registerRecurringRuleRoutes(scoped.Group("/recurring-rules"), recurringRuleHandler)
+
+	taxReportService := service.NewTaxReportService(r.repo)
+	taxReportHandler := handler.NewTaxReportHandler(taxReportService)
+	registerTaxReportRoutes(scoped.Group("/tax-report"), taxReportHandler)
+
+	notificationService := service.NewNotificationService(r.repo, nil)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	registerNotificationRoutes(v1.Group("/notifications"), notificationHandler)
 
 	categoryService := service.NewCategoryService(r.repo)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
-	registerCategoryRoutes(v1.Group("/categories"), categoryHandler)
+	registerCategoryRoutes(scoped.Group("/categories"), categoryHandler)
+
+	attachmentService := service.NewAttachmentService(r.repo, r.blobs)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService)
+	registerAttachmentRoutes(scoped, attachmentHandler)
 
-	uploadService := upload.NewUploadService(r.mbus)
+	uploadService := upload.NewUploadService(r.mbus, attachmentService)
 	uploadHander := handler.NewUploadHandler(uploadService)
-	registerUploadRoutes(v1.Group("/upload"), uploadHander)
+	registerUploadRoutes(scoped.Group("/upload"), uploadHander)
 
 	return engine
 }
@@ -112,16 +198,217 @@ func registerInvoiceRoutes(router *gin.RouterGroup, handler *handler.InvoiceHand
 	router.PUT("/:id", handler.UpdateInvoiceHandler)
 	router.DELETE("/:id", handler.DeleteInvoiceHandler)
 	router.GET("/:id/transactions", handler.ListInvoiceDebtsHandler)
+	router.POST("/:id/close", handler.CloseInvoiceHandler)
+	router.POST("/:id/pay", handler.PayInvoiceHandler)
+	router.GET("/:id/payments", handler.ListInvoicePaymentsHandler)
+	router.POST("/:id/payments", handler.CreateInvoicePaymentHandler)
+	router.DELETE("/:id/payments/:payment_id", handler.DeleteInvoicePaymentHandler)
+}
+
+func registerAccountRoutes(router *gin.RouterGroup, handler *handler.AccountHandler) {
+	router.POST("", handler.CreateAccountHandler)
+	router.GET("", handler.ListAccountsHandler)
+}
+
+func registerRuleRoutes(router *gin.RouterGroup, handler *handler.RuleHandler) {
+	router.POST("", handler.CreateRuleHandler)
+	router.GET("", handler.ListRulesHandler)
+	router.GET("/:id", handler.GetRuleByIDHandler)
+	router.PUT("/:id", handler.UpdateRuleHandler)
+	router.DELETE("/:id", handler.DeleteRuleHandler)
+}
+
+func registerUserRoutes(router *gin.RouterGroup, handler *handler.UserHandler) {
+	router.GET("/me", handler.GetProfileHandler)
+	router.PUT("/me", handler.UpdateProfileHandler)
+	router.DELETE("/me", handler.DeactivateAccountHandler)
+	router.PUT("/me/password", handler.UpdatePasswordHandler)
+	router.PUT("/me/email", handler.UpdateEmailHandler)
+	router.PUT("/me/base-currency", handler.UpdateBaseCurrencyHandler)
+	router.POST("/logout", handler.LogoutHandler)
+}
+
+func registerExchangeRateRoutes(router *gin.RouterGroup, handler *handler.ExchangeRateHandler) {
+	router.POST("", handler.UpsertExchangeRateHandler)
+	router.GET("", handler.ListExchangeRatesHandler)
+	router.POST("/import", handler.ImportExchangeRatesHandler)
+	router.DELETE("/:id", handler.DeleteExchangeRateHandler)
+}
+
+func registerBudgetRoutes(router *gin.RouterGroup, handler *handler.BudgetHandler) {
+	router.POST("", handler.CreateBudgetHandler)
+	router.GET("", handler.ListBudgetsHandler)
+	router.GET("/status", handler.BudgetsStatusHandler)
+	router.GET("/:id", handler.GetBudgetByIDHandler)
+	router.PUT("/:id", handler.UpdateBudgetHandler)
+	router.DELETE("/:id", handler.DeleteBudgetHandler)
+}
+
+func registerEnvelopeRoutes(router *gin.RouterGroup, handler *handler.EnvelopeHandler) {
+	router.GET("", handler.EnvelopesMonthHandler)
+	router.POST("/allocations", handler.CreateEnvelopeAllocationHandler)
+	router.GET("/allocations", handler.ListEnvelopeAllocationsHandler)
+	router.DELETE("/allocations/:id", handler.DeleteEnvelopeAllocationHandler)
+	router.POST("/moves", handler.MoveEnvelopeMoneyHandler)
+}
+
+func registerGoalRoutes(router *gin.RouterGroup, handler *handler.GoalHandler) {
+	router.POST("", handler.CreateGoalHandler)
+	router.GET("", handler.ListGoalsHandler)
+	router.GET("/progress", handler.GoalsProgressHandler)
+	router.GET("/:id", handler.GetGoalByIDHandler)
+	router.GET("/:id/progress", handler.GoalProgressHandler)
+	router.PUT("/:id", handler.UpdateGoalHandler)
+	router.DELETE("/:id", handler.DeleteGoalHandler)
+}
+
+func registerLedgerRoutes(router *gin.RouterGroup, handler *handler.LedgerHandler) {
+	router.POST("", handler.CreateLedgerHandler)
+	router.GET("", handler.ListLedgersHandler)
+	router.GET("/invitations", handler.ListReceivedLedgerInvitationsHandler)
+	router.POST("/invitations/:invitation_id/accept", handler.AcceptLedgerInvitationHandler)
+	router.POST("/invitations/:invitation_id/decline", handler.DeclineLedgerInvitationHandler)
+	router.PUT("/:id", handler.UpdateLedgerHandler)
+	router.GET("/:id/members", handler.ListLedgerMembersHandler)
+	router.PUT("/:id/members/:user_id", handler.UpdateLedgerMemberHandler)
+	router.DELETE("/:id/members/:user_id", handler.DeleteLedgerMemberHandler)
+	router.POST("/:id/invitations", handler.CreateLedgerInvitationHandler)
+	router.GET("/:id/invitations", handler.ListLedgerInvitationsHandler)
+	router.DELETE("/:id/invitations/:invitation_id", handler.RevokeLedgerInvitationHandler)
+}
+
+func registerSharingRoutes(router *gin.RouterGroup, handler *handler.SharingHandler) {
+	router.PUT("/transactions/:id/participants", handler.SetTransactionParticipantsHandler)
+	router.GET("/transactions/:id/participants", handler.GetTransactionParticipantsHandler)
+	router.DELETE("/transactions/:id/participants", handler.DeleteTransactionParticipantsHandler)
+
+	router.POST("/settlements", handler.CreateSettlementHandler)
+	router.GET("/settlements", handler.ListSettlementsHandler)
+	router.GET("/settlements/balances", handler.SharedBalancesHandler)
+	router.POST("/settlements/settle-up", handler.SettleUpHandler)
+	router.DELETE("/settlements/:id", handler.DeleteSettlementHandler)
+}
+
+func registerNetWorthRoutes(router *gin.RouterGroup, handler *handler.NetWorthHandler) {
+	router.GET("/networth", handler.NetWorthHandler)
+
+	router.POST("/assets", handler.CreateAssetHandler)
+	router.GET("/assets", handler.ListAssetsHandler)
+	router.GET("/assets/:id", handler.GetAssetByIDHandler)
+	router.PUT("/assets/:id", handler.UpdateAssetHandler)
+	router.DELETE("/assets/:id", handler.DeleteAssetHandler)
+	router.POST("/assets/:id/valuations", handler.CreateAssetValuationHandler)
+	router.GET("/assets/:id/valuations", handler.ListAssetValuationsHandler)
+	router.DELETE("/assets/:id/valuations/:valuation_id", handler.DeleteAssetValuationHandler)
+
+	router.POST("/accounts/:id/valuations", handler.CreateAccountValuationHandler)
+	router.GET("/accounts/:id/valuations", handler.ListAccountValuationsHandler)
+	router.DELETE("/accounts/:id/valuations/:valuation_id", handler.DeleteAccountValuationHandler)
+}
+
+func registerInvestmentRoutes(router *gin.RouterGroup, handler *handler.InvestmentHandler) {
+	router.GET("/portfolio", handler.PortfolioHandler)
+	router.POST("/quotes/import", handler.ImportInvestmentQuotesHandler)
+
+	router.POST("/holdings", handler.CreateHoldingHandler)
+	router.GET("/holdings", handler.ListHoldingsHandler)
+	router.GET("/holdings/:id", handler.GetHoldingByIDHandler)
+	router.PUT("/holdings/:id", handler.UpdateHoldingHandler)
+	router.DELETE("/holdings/:id", handler.DeleteHoldingHandler)
+	router.POST("/holdings/:id/trades", handler.CreateInvestmentTradeHandler)
+	router.GET("/holdings/:id/trades", handler.ListInvestmentTradesHandler)
+	router.DELETE("/holdings/:id/trades/:trade_id", handler.DeleteInvestmentTradeHandler)
+	router.POST("/holdings/:id/quotes", handler.UpsertInvestmentQuoteHandler)
+	router.GET("/holdings/:id/quotes", handler.ListInvestmentQuotesHandler)
+	router.DELETE("/holdings/:id/quotes/:quote_id", handler.DeleteInvestmentQuoteHandler)
+	router.POST("/holdings/:id/incomes", handler.CreateInvestmentIncomeHandler)
+	router.GET("/holdings/:id/incomes", handler.ListInvestmentIncomesHandler)
+	router.DELETE("/holdings/:id/incomes/:income_id", handler.DeleteInvestmentIncomeHandler)
+}
+
+func registerLoanRoutes(router *gin.RouterGroup, handler *handler.LoanHandler) {
+	router.POST("", handler.CreateLoanHandler)
+	router.GET("", handler.ListLoansHandler)
+	router.POST("/simulate", handler.SimulateLoanHandler)
+	router.GET("/:id", handler.GetLoanByIDHandler)
+	router.PUT("/:id", handler.UpdateLoanHandler)
+	router.DELETE("/:id", handler.DeleteLoanHandler)
+	router.GET("/:id/schedule", handler.LoanScheduleHandler)
+	router.POST("/:id/prepayments", handler.CreateLoanPrepaymentHandler)
+	router.GET("/:id/prepayments", handler.ListLoanPrepaymentsHandler)
+}
+
+func registerDebtRoutes(router *gin.RouterGroup, handler *handler.DebtPlanHandler) {
+	router.POST("/plan", handler.PlanDebtsHandler)
+}
+
+func registerCashFlowRoutes(router *gin.RouterGroup, handler *handler.CashFlowHandler) {
+	router.GET("/forecast", handler.CashFlowForecastHandler)
+}
+
+func registerRecurringRuleRoutes(router *gin.RouterGroup, handler *handler.RecurringRuleHandler) {
+	router.POST("", handler.CreateRecurringRuleHandler)
+	router.GET("", handler.ListRecurringRulesHandler)
+	router.GET("/:id", handler.GetRecurringRuleByIDHandler)
+	router.PUT("/:id", handler.UpdateRecurringRuleHandler)
+	router.DELETE("/:id", handler.DeleteRecurringRuleHandler)
+}
+
+func registerTaxReportRoutes(router *gin.RouterGroup, handler *handler.TaxReportHandler) {
+	router.GET("", handler.TaxReportHandler)
+	router.GET("/export", handler.ExportTaxReportHandler)
+}
+
+func registerNotificationRoutes(router *gin.RouterGroup, handler *handler.NotificationHandler) {
+	router.GET("", handler.ListNotificationsHandler)
+	router.GET("/unread-count", handler.CountUnreadNotificationsHandler)
+	router.POST("/read-all", handler.MarkAllNotificationsReadHandler)
+	router.POST("/:id/read", handler.MarkNotificationReadHandler)
+	router.GET("/preferences", handler.GetNotificationPreferenceHandler)
+	router.PUT("/preferences", handler.UpdateNotificationPreferenceHandler)
 }
 
 func registerCategoryRoutes(router *gin.RouterGroup, handler *handler.CategoryHandler) {
 	router.POST("", handler.CreateCategoryHandler)
 	router.GET("", handler.ListCategorysHandler)
+	router.GET("/tree", handler.ListCategoryTreeHandler)
+	router.GET("/suggest", handler.SuggestCategoryHandler)
 	router.GET("/:id", handler.GetCategoryByIDHandler)
 	router.PUT("/:id", handler.UpdateCategoryHandler)
 	router.DELETE("/:id", handler.DeleteCategoryHandler)
 }
 
+func registerPayeeRoutes(router *gin.RouterGroup, handler *handler.PayeeHandler) {
+	router.POST("", handler.CreatePayeeHandler)
+	router.GET("", handler.ListPayeesHandler)
+	router.GET("/:id", handler.GetPayeeByIDHandler)
+	router.PUT("/:id", handler.UpdatePayeeHandler)
+	router.DELETE("/:id", handler.DeletePayeeHandler)
+}
+
+func registerTagRoutes(router *gin.RouterGroup, handler *handler.TagHandler) {
+	router.POST("", handler.CreateTagHandler)
+	router.GET("", handler.ListTagsHandler)
+	router.GET("/:id", handler.GetTagByIDHandler)
+	router.PUT("/:id", handler.UpdateTagHandler)
+	router.DELETE("/:id", handler.DeleteTagHandler)
+}
+
+func registerAttachmentRoutes(router *gin.RouterGroup, handler *handler.AttachmentHandler) {
+	router.POST("/transactions/:id/attachments", handler.CreateTransactionAttachmentHandler)
+	router.GET("/transactions/:id/attachments", handler.ListTransactionAttachmentsHandler)
+	router.GET("/transactions/:id/attachments/:attachment_id", handler.DownloadTransactionAttachmentHandler)
+	router.DELETE("/transactions/:id/attachments/:attachment_id", handler.DeleteTransactionAttachmentHandler)
+
+	router.POST("/invoices/:id/attachments", handler.CreateInvoiceAttachmentHandler)
+	router.GET("/invoices/:id/attachments", handler.ListInvoiceAttachmentsHandler)
+	router.GET("/invoices/:id/attachments/:attachment_id", handler.DownloadInvoiceAttachmentHandler)
+	router.DELETE("/invoices/:id/attachments/:attachment_id", handler.DeleteInvoiceAttachmentHandler)
+
+	router.GET("/upload/:job_id/attachments", handler.ListImportAttachmentsHandler)
+	router.GET("/upload/:job_id/attachments/:attachment_id", handler.DownloadImportAttachmentHandler)
+}
+
 func registerUploadRoutes(router *gin.RouterGroup, handler *handler.UploadHandler) {
 	router.POST("", handler.ProcessFileHandler)
 }
@@ -1,6 +1,8 @@
 package mixins
 
 import (
+	"frog-go/internal/core/domain"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
@@ -9,15 +11,16 @@ import (
 type MoneyMixin struct {
 	mixin.Schema
 	Name    string
-	Default *float64
+	Default *domain.Money
 }
 
 func (m MoneyMixin) Fields() []ent.Field {
-	f := field.Float(m.Name).
-		SchemaType(map[string]string{"postgres": "decimal(10,2)"})
+	f := field.Int64(m.Name).
+		GoType(domain.Money(0)).
+		SchemaType(map[string]string{"postgres": "decimal(18,2)"})
 
 	if m.Default != nil {
-		f = f.Default(*m.Default)
+		f = f.Default(int64(*m.Default))
 	}
 
 	return []ent.Field{f}
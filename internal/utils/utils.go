@@ -19,6 +19,10 @@ func IntPtr(i int) *int {
 	return &i
 }
 
+func StringPtr(s string) *string {
+	return &s
+}
+
 func ToUint(s string) (uint, error) {
 	val, err := strconv.ParseUint(s, 10, 64)
 	if err != nil {
@@ -131,3 +135,8 @@ func FormatDuration(d time.Duration) string {
 func HashPassword(password string) ([]byte, error) {
 	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 }
+
+// CheckPassword confere a senha com o hash salvo.
+func CheckPassword(passwordHash string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)) == nil
+}
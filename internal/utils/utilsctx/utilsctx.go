@@ -2,6 +2,7 @@ package utilsctx
 
 import (
 	"context"
+	"frog-go/internal/core/domain"
 	appError "frog-go/internal/core/errors"
 
 	"github.com/google/uuid"
@@ -10,7 +11,12 @@ import (
 type contextKey string
 
 const (
+	// UserIDKey é o usuário autenticado, registrado como autor dos lançamentos.
 	UserIDKey contextKey = "userID"
+	// LedgerIDKey é o livro ativo da requisição, usado para escopar as consultas.
+	LedgerIDKey contextKey = "ledgerID"
+	// LedgerRoleKey é o papel do usuário no livro ativo.
+	LedgerRoleKey contextKey = "ledgerRole"
 )
 
 func GetUserID(ctx context.Context) (uuid.UUID, error) {
@@ -26,3 +32,23 @@ func GetUserID(ctx context.Context) (uuid.UUID, error) {
 
 	return id, nil
 }
+
+// GetLedgerID retorna o livro ativo: o informado em X-Ledger-ID ou o livro pessoal do usuário.
+func GetLedgerID(ctx context.Context) (uuid.UUID, error) {
+	id, ok := ctx.Value(LedgerIDKey).(uuid.UUID)
+	if !ok {
+		return uuid.Nil, appError.ErrLedgerNotFoundInCtx
+	}
+
+	return id, nil
+}
+
+// GetLedgerRole retorna o papel do usuário no livro ativo.
+func GetLedgerRole(ctx context.Context) (domain.LedgerRole, error) {
+	role, ok := ctx.Value(LedgerRoleKey).(domain.LedgerRole)
+	if !ok {
+		return "", appError.ErrLedgerNotFoundInCtx
+	}
+
+	return role, nil
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"frog-go/internal/core/ports/inbound"
+	"frog-go/internal/utils/logger"
+	"sync"
+	"time"
+)
+
+type Scheduler struct {
+	job inbound.Job
+	log *logger.Logger
+
+	stopChan chan struct{} // Canal para sinalizar parada segura
+	mu       sync.Mutex
+}
+
+func NewScheduler(
+	job inbound.Job,
+	log *logger.Logger,
+	stopChan chan struct{},
+) *Scheduler {
+	return &Scheduler{
+		job:      job,
+		log:      log,
+		stopChan: stopChan,
+		mu:       sync.Mutex{},
+	}
+}
+
+// Start executa o job imediatamente e depois a cada intervalo, até receber o sinal de parada.
+func (s *Scheduler) Start(name string, interval time.Duration, timeoutSeconds int) {
+	s.log.Start(
+		"Agendador iniciado... Job: %s | Intervalo: %s | Timeout: %ds",
+		name, interval, timeoutSeconds,
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.runJob(name, timeoutSeconds)
+
+	for {
+		select {
+		case <-s.stopChan:
+			s.log.Success("Agendador finalizado com sucesso.")
+			return
+		case <-ticker.C:
+			s.runJob(name, timeoutSeconds)
+		}
+	}
+}
+
+func (s *Scheduler) runJob(name string, timeoutSeconds int) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	if err := s.job.Run(ctx); err != nil {
+		s.log.Error("Erro ao executar job %s: %v", name, err)
+		return
+	}
+	s.log.Info("Job %s executado em %s", name, time.Since(start))
+}
+
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.stopChan:
+		// Se já foi fechado, não faz nada
+	default:
+		close(s.stopChan)
+	}
+}
@@ -0,0 +1,16 @@
+-- Create "accounts" table
+CREATE TABLE "public"."accounts" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "account_type" character varying NOT NULL DEFAULT 'checking',
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "accounts_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ALTER COLUMN "status" SET DEFAULT 'open', ADD COLUMN "closed_at" timestamptz NULL, ADD COLUMN "paid_at" timestamptz NULL, ADD COLUMN "payment_account_id" uuid NULL, ADD CONSTRAINT "invoices_accounts_payment_account" FOREIGN KEY ("payment_account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Migrate invoice statuses from the transaction status set
+UPDATE "public"."invoices" SET "status" = 'open' WHERE "status" = 'pending';
+UPDATE "public"."invoices" SET "paid_at" = "updated_at" WHERE "status" = 'paid';
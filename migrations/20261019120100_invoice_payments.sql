@@ -0,0 +1,21 @@
+-- Create "invoice_payments" table
+CREATE TABLE "public"."invoice_payments" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(10,2) NOT NULL,
+  "paid_at" timestamptz NOT NULL,
+  "kind" character varying NOT NULL DEFAULT 'payment',
+  "invoice_id" uuid NOT NULL,
+  "account_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "invoice_payments_accounts_account" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "invoice_payments_invoices_invoice" FOREIGN KEY ("invoice_id") REFERENCES "public"."invoices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "invoicepayment_invoice_id" to table: "invoice_payments"
+CREATE INDEX "invoicepayment_invoice_id" ON "public"."invoice_payments" ("invoice_id");
+-- Backfill payments for invoices already marked as paid
+INSERT INTO "public"."invoice_payments" ("id", "created_at", "updated_at", "amount", "paid_at", "kind", "invoice_id", "account_id")
+SELECT gen_random_uuid(), now(), now(), "amount", COALESCE("paid_at", "updated_at"), 'payment', "id", "payment_account_id"
+FROM "public"."invoices"
+WHERE "status" = 'paid' AND "amount" > 0;
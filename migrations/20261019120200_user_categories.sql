@@ -0,0 +1,19 @@
+-- Drop index "categories_name_key" from table: "categories"
+DROP INDEX "public"."categories_name_key";
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "user_id" uuid NULL;
+-- Copy the global categories to every existing user
+INSERT INTO "public"."categories" ("id", "created_at", "updated_at", "name", "description", "color", "suggested_percentage", "user_id")
+SELECT gen_random_uuid(), now(), now(), c."name", c."description", c."color", c."suggested_percentage", u."id"
+FROM "public"."categories" c CROSS JOIN "public"."users" u
+WHERE c."user_id" IS NULL;
+-- Point transactions to the copy owned by their user
+UPDATE "public"."transactions" t SET "category_id" = nc."id"
+FROM "public"."categories" oc, "public"."categories" nc
+WHERE t."category_id" = oc."id" AND oc."user_id" IS NULL AND nc."user_id" = t."user_id" AND nc."name" = oc."name";
+-- Remove the global categories
+DELETE FROM "public"."categories" WHERE "user_id" IS NULL;
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ALTER COLUMN "user_id" SET NOT NULL, ADD CONSTRAINT "categories_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "category_name_user_id" to table: "categories"
+CREATE UNIQUE INDEX "category_name_user_id" ON "public"."categories" ("name", "user_id");
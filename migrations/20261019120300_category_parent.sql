@@ -0,0 +1,4 @@
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "parent_id" uuid NULL, ADD CONSTRAINT "categories_categories_children" FOREIGN KEY ("parent_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "category_parent_id" to table: "categories"
+CREATE INDEX "category_parent_id" ON "public"."categories" ("parent_id");
@@ -0,0 +1,26 @@
+-- Create "rules" table
+CREATE TABLE "public"."rules" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "priority" bigint NOT NULL DEFAULT 0,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "title_contains" character varying NULL,
+  "title_regex" character varying NULL,
+  "amount_min" numeric(10,2) NULL,
+  "amount_max" numeric(10,2) NULL,
+  "record_type" character varying NULL,
+  "action_record_type" character varying NULL,
+  "action_status" character varying NULL,
+  "skip" boolean NOT NULL DEFAULT false,
+  "user_id" uuid NOT NULL,
+  "invoice_id" uuid NULL,
+  "category_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "rules_categories_category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "rules_invoices_invoice" FOREIGN KEY ("invoice_id") REFERENCES "public"."invoices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "rules_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "rule_priority_user_id" to table: "rules"
+CREATE INDEX "rule_priority_user_id" ON "public"."rules" ("priority", "user_id");
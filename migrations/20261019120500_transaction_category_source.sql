@@ -0,0 +1,2 @@
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "category_source" character varying NULL;
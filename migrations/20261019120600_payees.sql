@@ -0,0 +1,18 @@
+-- Create "payees" table
+CREATE TABLE "public"."payees" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "normalized_name" character varying NOT NULL,
+  "aliases" jsonb NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "payees_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "payee_normalized_name_user_id" to table: "payees"
+CREATE UNIQUE INDEX "payee_normalized_name_user_id" ON "public"."payees" ("normalized_name", "user_id");
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "payee_id" uuid NULL, ADD CONSTRAINT "transactions_payees_payee" FOREIGN KEY ("payee_id") REFERENCES "public"."payees" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "transaction_payee_id" to table: "transactions"
+CREATE INDEX "transaction_payee_id" ON "public"."transactions" ("payee_id");
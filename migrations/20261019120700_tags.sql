@@ -0,0 +1,21 @@
+-- Create "tags" table
+CREATE TABLE "public"."tags" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "color" character varying NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tags_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "tag_name_user_id" to table: "tags"
+CREATE UNIQUE INDEX "tag_name_user_id" ON "public"."tags" ("name", "user_id");
+-- Create "transaction_tags" table
+CREATE TABLE "public"."transaction_tags" (
+  "transaction_id" uuid NOT NULL,
+  "tag_id" uuid NOT NULL,
+  PRIMARY KEY ("transaction_id", "tag_id"),
+  CONSTRAINT "transaction_tags_tag_id" FOREIGN KEY ("tag_id") REFERENCES "public"."tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transaction_tags_transaction_id" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+-- Create "transaction_splits" table
+CREATE TABLE "public"."transaction_splits" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(10,2) NOT NULL,
+  "note" character varying NULL,
+  "transaction_id" uuid NOT NULL,
+  "category_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "transaction_splits_categories_category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "transaction_splits_transactions_transaction" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "transactionsplit_transaction_id" to table: "transaction_splits"
+CREATE INDEX "transactionsplit_transaction_id" ON "public"."transaction_splits" ("transaction_id");
+-- Create index "transactionsplit_category_id" to table: "transaction_splits"
+CREATE INDEX "transactionsplit_category_id" ON "public"."transaction_splits" ("category_id");
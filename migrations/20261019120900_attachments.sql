@@ -0,0 +1,26 @@
+-- Create "attachments" table
+CREATE TABLE "public"."attachments" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "filename" character varying NOT NULL,
+  "content_type" character varying NOT NULL,
+  "size" bigint NOT NULL,
+  "blob_key" character varying NOT NULL,
+  "import_job_id" uuid NULL,
+  "user_id" uuid NOT NULL,
+  "transaction_id" uuid NULL,
+  "invoice_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "attachments_invoices_invoice" FOREIGN KEY ("invoice_id") REFERENCES "public"."invoices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "attachments_transactions_transaction" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "attachments_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "attachment_blob_key" to table: "attachments"
+CREATE INDEX "attachment_blob_key" ON "public"."attachments" ("blob_key");
+-- Create index "attachment_import_job_id" to table: "attachments"
+CREATE INDEX "attachment_import_job_id" ON "public"."attachments" ("import_job_id");
+-- Create index "attachment_invoice_id" to table: "attachments"
+CREATE INDEX "attachment_invoice_id" ON "public"."attachments" ("invoice_id");
+-- Create index "attachment_transaction_id" to table: "attachments"
+CREATE INDEX "attachment_transaction_id" ON "public"."attachments" ("transaction_id");
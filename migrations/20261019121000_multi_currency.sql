@@ -0,0 +1,21 @@
+-- Modify "accounts" table
+ALTER TABLE "public"."accounts" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'BRL';
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'BRL', ADD COLUMN "exchange_rate" numeric(18,8) NOT NULL DEFAULT 1;
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "base_currency" character varying NOT NULL DEFAULT 'BRL';
+-- Create "exchange_rates" table
+CREATE TABLE "public"."exchange_rates" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "from_currency" character varying NOT NULL,
+  "to_currency" character varying NOT NULL,
+  "rate" numeric(18,8) NOT NULL,
+  "rate_date" date NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "exchange_rates_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "exchangerate_from_currency_to_currency_rate_date_user_id" to table: "exchange_rates"
+CREATE UNIQUE INDEX "exchangerate_from_currency_to_currency_rate_date_user_id" ON "public"."exchange_rates" ("from_currency", "to_currency", "rate_date", "user_id");
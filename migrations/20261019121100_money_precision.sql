@@ -0,0 +1,10 @@
+-- Modify "invoice_payments" table
+ALTER TABLE "public"."invoice_payments" ALTER COLUMN "amount" TYPE numeric(18,2);
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ALTER COLUMN "amount" TYPE numeric(18,2);
+-- Modify "rules" table
+ALTER TABLE "public"."rules" ALTER COLUMN "amount_min" TYPE numeric(18,2), ALTER COLUMN "amount_max" TYPE numeric(18,2);
+-- Modify "transaction_splits" table
+ALTER TABLE "public"."transaction_splits" ALTER COLUMN "amount" TYPE numeric(18,2);
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ALTER COLUMN "amount" TYPE numeric(18,2);
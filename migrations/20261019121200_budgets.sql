@@ -0,0 +1,18 @@
+-- Create "budgets" table
+CREATE TABLE "public"."budgets" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "month" date NULL,
+  "amount" numeric(18,2) NULL,
+  "percentage" bigint NULL,
+  "alerted_threshold" bigint NOT NULL DEFAULT 0,
+  "alerted_month" date NULL,
+  "user_id" uuid NOT NULL,
+  "category_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "budgets_categories_category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "budgets_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "budget_category_id_month_user_id" to table: "budgets"
+CREATE UNIQUE INDEX "budget_category_id_month_user_id" ON "public"."budgets" ("category_id", "month", "user_id");
@@ -0,0 +1,22 @@
+-- Create "envelope_allocations" table
+CREATE TABLE "public"."envelope_allocations" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(18,2) NOT NULL,
+  "month" date NOT NULL,
+  "kind" character varying NOT NULL,
+  "transfer_id" uuid NULL,
+  "note" character varying NULL,
+  "user_id" uuid NOT NULL,
+  "category_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "envelope_allocations_categories_category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "envelope_allocations_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "envelopeallocation_category_id" to table: "envelope_allocations"
+CREATE INDEX "envelopeallocation_category_id" ON "public"."envelope_allocations" ("category_id");
+-- Create index "envelopeallocation_month_user_id" to table: "envelope_allocations"
+CREATE INDEX "envelopeallocation_month_user_id" ON "public"."envelope_allocations" ("month", "user_id");
+-- Create index "envelopeallocation_transfer_id" to table: "envelope_allocations"
+CREATE INDEX "envelopeallocation_transfer_id" ON "public"."envelope_allocations" ("transfer_id");
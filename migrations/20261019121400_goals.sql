@@ -0,0 +1,19 @@
+-- Create "goals" table
+CREATE TABLE "public"."goals" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "target_amount" numeric(18,2) NOT NULL,
+  "initial_amount" numeric(18,2) NOT NULL DEFAULT 0,
+  "name" character varying NOT NULL,
+  "target_date" date NOT NULL,
+  "user_id" uuid NOT NULL,
+  "account_id" uuid NULL,
+  "category_id" uuid NULL,
+  "tag_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "goals_accounts_account" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "goals_categories_category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "goals_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "public"."tags" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "goals_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,49 @@
+-- Create "ledgers" table
+CREATE TABLE "public"."ledgers" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "owner_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "ledgers_users_owner" FOREIGN KEY ("owner_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "ledger_owner_id" to table: "ledgers"
+CREATE UNIQUE INDEX "ledger_owner_id" ON "public"."ledgers" ("owner_id");
+-- Create "ledger_invitations" table
+CREATE TABLE "public"."ledger_invitations" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "role" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "expires_at" timestamptz NOT NULL,
+  "responded_at" timestamptz NULL,
+  "ledger_id" uuid NOT NULL,
+  "invitee_id" uuid NOT NULL,
+  "invited_by_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "ledger_invitations_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "ledger_invitations_users_invited_by" FOREIGN KEY ("invited_by_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "ledger_invitations_users_invitee" FOREIGN KEY ("invitee_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "ledgerinvitation_invitee_id_status" to table: "ledger_invitations"
+CREATE INDEX "ledgerinvitation_invitee_id_status" ON "public"."ledger_invitations" ("invitee_id", "status");
+-- Create index "ledgerinvitation_ledger_id_status" to table: "ledger_invitations"
+CREATE INDEX "ledgerinvitation_ledger_id_status" ON "public"."ledger_invitations" ("ledger_id", "status");
+-- Create "ledger_members" table
+CREATE TABLE "public"."ledger_members" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "role" character varying NOT NULL,
+  "ledger_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "ledger_members_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "ledger_members_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "ledgermember_ledger_id_user_id" to table: "ledger_members"
+CREATE UNIQUE INDEX "ledgermember_ledger_id_user_id" ON "public"."ledger_members" ("ledger_id", "user_id");
+-- Create index "ledgermember_user_id" to table: "ledger_members"
+CREATE INDEX "ledgermember_user_id" ON "public"."ledger_members" ("user_id");
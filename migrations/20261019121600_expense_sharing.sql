@@ -0,0 +1,37 @@
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "share_mode" character varying NULL, ADD COLUMN "paid_by_id" uuid NULL, ADD CONSTRAINT "transactions_users_paid_by" FOREIGN KEY ("paid_by_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "settlements" table
+CREATE TABLE "public"."settlements" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(18,2) NOT NULL,
+  "settled_at" timestamptz NOT NULL,
+  "note" character varying NULL,
+  "user_id" uuid NOT NULL,
+  "from_user_id" uuid NOT NULL,
+  "to_user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "settlements_users_from_user" FOREIGN KEY ("from_user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "settlements_users_to_user" FOREIGN KEY ("to_user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "settlements_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "settlement_settled_at_user_id" to table: "settlements"
+CREATE INDEX "settlement_settled_at_user_id" ON "public"."settlements" ("settled_at", "user_id");
+-- Create "transaction_participants" table
+CREATE TABLE "public"."transaction_participants" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(18,2) NOT NULL,
+  "percentage" numeric(5,2) NULL,
+  "transaction_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "transaction_participants_transactions_transaction" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "transaction_participants_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "transactionparticipant_transaction_id_user_id" to table: "transaction_participants"
+CREATE UNIQUE INDEX "transactionparticipant_transaction_id_user_id" ON "public"."transaction_participants" ("transaction_id", "user_id");
+-- Create index "transactionparticipant_user_id" to table: "transaction_participants"
+CREATE INDEX "transactionparticipant_user_id" ON "public"."transaction_participants" ("user_id");
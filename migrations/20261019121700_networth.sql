@@ -0,0 +1,35 @@
+-- Create "assets" table
+CREATE TABLE "public"."assets" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "class" character varying NOT NULL DEFAULT 'asset',
+  "kind" character varying NOT NULL DEFAULT 'other',
+  "currency" character varying NOT NULL DEFAULT 'BRL',
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "assets_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create "valuations" table
+CREATE TABLE "public"."valuations" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(18,2) NOT NULL,
+  "value_date" date NOT NULL,
+  "note" character varying NULL,
+  "user_id" uuid NOT NULL,
+  "asset_id" uuid NULL,
+  "account_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "valuations_accounts_account" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "valuations_assets_asset" FOREIGN KEY ("asset_id") REFERENCES "public"."assets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "valuations_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "valuation_account_id_value_date" to table: "valuations"
+CREATE UNIQUE INDEX "valuation_account_id_value_date" ON "public"."valuations" ("account_id", "value_date");
+-- Create index "valuation_asset_id_value_date" to table: "valuations"
+CREATE UNIQUE INDEX "valuation_asset_id_value_date" ON "public"."valuations" ("asset_id", "value_date");
+-- Create index "valuation_value_date_user_id" to table: "valuations"
+CREATE INDEX "valuation_value_date_user_id" ON "public"."valuations" ("value_date", "user_id");
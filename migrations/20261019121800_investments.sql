@@ -0,0 +1,70 @@
+-- Create "holdings" table
+CREATE TABLE "public"."holdings" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "name" character varying NOT NULL,
+  "ticker" character varying NOT NULL,
+  "asset_class" character varying NOT NULL,
+  "currency" character varying NOT NULL DEFAULT 'BRL',
+  "user_id" uuid NOT NULL,
+  "account_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "holdings_accounts_account" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "holdings_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "holding_ticker_user_id" to table: "holdings"
+CREATE UNIQUE INDEX "holding_ticker_user_id" ON "public"."holdings" ("ticker", "user_id");
+-- Create "investment_incomes" table
+CREATE TABLE "public"."investment_incomes" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(18,2) NOT NULL,
+  "kind" character varying NOT NULL,
+  "payment_date" date NOT NULL,
+  "note" character varying NULL,
+  "user_id" uuid NOT NULL,
+  "holding_id" uuid NOT NULL,
+  "transaction_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "investment_incomes_holdings_holding" FOREIGN KEY ("holding_id") REFERENCES "public"."holdings" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "investment_incomes_transactions_transaction" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "investment_incomes_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "investmentincome_holding_id_payment_date" to table: "investment_incomes"
+CREATE INDEX "investmentincome_holding_id_payment_date" ON "public"."investment_incomes" ("holding_id", "payment_date");
+-- Create "investment_quotes" table
+CREATE TABLE "public"."investment_quotes" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "price" numeric(18,2) NOT NULL,
+  "quote_date" date NOT NULL,
+  "user_id" uuid NOT NULL,
+  "holding_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "investment_quotes_holdings_holding" FOREIGN KEY ("holding_id") REFERENCES "public"."holdings" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "investment_quotes_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "investmentquote_holding_id_quote_date" to table: "investment_quotes"
+CREATE UNIQUE INDEX "investmentquote_holding_id_quote_date" ON "public"."investment_quotes" ("holding_id", "quote_date");
+-- Create "investment_trades" table
+CREATE TABLE "public"."investment_trades" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "price" numeric(18,2) NOT NULL,
+  "fees" numeric(18,2) NOT NULL DEFAULT 0,
+  "kind" character varying NOT NULL,
+  "trade_date" date NOT NULL,
+  "quantity" numeric(24,8) NOT NULL,
+  "note" character varying NULL,
+  "user_id" uuid NOT NULL,
+  "holding_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "investment_trades_holdings_holding" FOREIGN KEY ("holding_id") REFERENCES "public"."holdings" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "investment_trades_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "investmenttrade_holding_id_trade_date" to table: "investment_trades"
+CREATE INDEX "investmenttrade_holding_id_trade_date" ON "public"."investment_trades" ("holding_id", "trade_date");
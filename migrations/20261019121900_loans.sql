@@ -0,0 +1,63 @@
+-- Create "loans" table
+CREATE TABLE "public"."loans" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "principal" numeric(18,2) NOT NULL,
+  "name" character varying NOT NULL,
+  "interest_rate" numeric(9,4) NOT NULL,
+  "rate_period" character varying NOT NULL DEFAULT 'monthly',
+  "term_months" bigint NOT NULL,
+  "system" character varying NOT NULL,
+  "first_payment_date" date NOT NULL,
+  "currency" character varying NOT NULL DEFAULT 'BRL',
+  "user_id" uuid NOT NULL,
+  "account_id" uuid NULL,
+  "interest_category_id" uuid NULL,
+  "principal_category_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "loans_accounts_account" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "loans_categories_interest_category" FOREIGN KEY ("interest_category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "loans_categories_principal_category" FOREIGN KEY ("principal_category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "loans_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create "loan_installments" table
+CREATE TABLE "public"."loan_installments" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "payment" numeric(18,2) NOT NULL,
+  "interest" numeric(18,2) NOT NULL,
+  "principal" numeric(18,2) NOT NULL,
+  "balance" numeric(18,2) NOT NULL,
+  "number" bigint NOT NULL,
+  "due_date" date NOT NULL,
+  "user_id" uuid NOT NULL,
+  "loan_id" uuid NOT NULL,
+  "transaction_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "loan_installments_loans_loan" FOREIGN KEY ("loan_id") REFERENCES "public"."loans" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "loan_installments_transactions_transaction" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "loan_installments_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "loaninstallment_loan_id_number" to table: "loan_installments"
+CREATE UNIQUE INDEX "loaninstallment_loan_id_number" ON "public"."loan_installments" ("loan_id", "number");
+-- Create "loan_prepayments" table
+CREATE TABLE "public"."loan_prepayments" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "amount" numeric(18,2) NOT NULL,
+  "interest_saved" numeric(18,2) NOT NULL,
+  "payment_date" date NOT NULL,
+  "mode" character varying NOT NULL,
+  "user_id" uuid NOT NULL,
+  "loan_id" uuid NOT NULL,
+  "transaction_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "loan_prepayments_loans_loan" FOREIGN KEY ("loan_id") REFERENCES "public"."loans" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "loan_prepayments_transactions_transaction" FOREIGN KEY ("transaction_id") REFERENCES "public"."transactions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "loan_prepayments_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "loanprepayment_loan_id_payment_date" to table: "loan_prepayments"
+CREATE INDEX "loanprepayment_loan_id_payment_date" ON "public"."loan_prepayments" ("loan_id", "payment_date");
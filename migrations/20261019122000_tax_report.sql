@@ -0,0 +1,4 @@
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "tax_group" character varying NULL;
+-- Modify "payees" table
+ALTER TABLE "public"."payees" ADD COLUMN "document" character varying NULL;
@@ -0,0 +1,59 @@
+-- Create "notification_deliveries" table
+CREATE TABLE "public"."notification_deliveries" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "kind" character varying NOT NULL,
+  "key" character varying NOT NULL,
+  "channel" character varying NOT NULL,
+  "reference_id" uuid NULL,
+  "title" character varying NOT NULL,
+  "body" character varying NOT NULL,
+  "payload" jsonb NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" character varying NULL,
+  "sent_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "notification_deliveries_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "notificationdelivery_key_channel_user_id" to table: "notification_deliveries"
+CREATE UNIQUE INDEX "notificationdelivery_key_channel_user_id" ON "public"."notification_deliveries" ("key", "channel", "user_id");
+-- Create index "notificationdelivery_status" to table: "notification_deliveries"
+CREATE INDEX "notificationdelivery_status" ON "public"."notification_deliveries" ("status");
+-- Create "notification_preferences" table
+CREATE TABLE "public"."notification_preferences" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "lead_days" bigint NOT NULL DEFAULT 3,
+  "quiet_start" character varying NULL,
+  "quiet_end" character varying NULL,
+  "timezone" character varying NOT NULL DEFAULT 'America/Sao_Paulo',
+  "email_enabled" boolean NOT NULL DEFAULT false,
+  "webhook_url" character varying NULL,
+  "webhook_secret" character varying NULL,
+  "inapp_enabled" boolean NOT NULL DEFAULT true,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "notification_preferences_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "notificationpreference_user_id" to table: "notification_preferences"
+CREATE UNIQUE INDEX "notificationpreference_user_id" ON "public"."notification_preferences" ("user_id");
+-- Create "notifications" table
+CREATE TABLE "public"."notifications" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "type" character varying NOT NULL,
+  "title" character varying NOT NULL,
+  "body" character varying NOT NULL,
+  "payload" jsonb NULL,
+  "read_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "notifications_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "notification_created_at_user_id" to table: "notifications"
+CREATE INDEX "notification_created_at_user_id" ON "public"."notifications" ("created_at", "user_id");
@@ -0,0 +1,14 @@
+-- Create "import_jobs" table
+CREATE TABLE "public"."import_jobs" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "filename" character varying NOT NULL,
+  "total" bigint NOT NULL,
+  "processed" bigint NOT NULL DEFAULT 0,
+  "failed" bigint NOT NULL DEFAULT 0,
+  "completed_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "import_jobs_users_user" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+-- Modify "notification_deliveries" table
+ALTER TABLE "public"."notification_deliveries" ADD COLUMN "not_before" timestamptz NULL;
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ADD COLUMN "key" character varying NULL;
+-- Backfill keys for existing notifications, which did not store the reminder key
+UPDATE "public"."notifications" SET "key" = "id"::text;
+ALTER TABLE "public"."notifications" ALTER COLUMN "key" SET NOT NULL;
+-- Create index "notification_key_user_id" to table: "notifications"
+CREATE UNIQUE INDEX "notification_key_user_id" ON "public"."notifications" ("key", "user_id");
@@ -0,0 +1,171 @@
+-- Modify "ledgers" table
+ALTER TABLE "public"."ledgers" ADD COLUMN "personal" boolean NOT NULL DEFAULT false, ADD COLUMN "category_model_version" bigint NOT NULL DEFAULT 0;
+-- Drop index "ledger_owner_id" from table: "ledgers"
+DROP INDEX "public"."ledger_owner_id";
+-- Create index "ledger_owner_id" to table: "ledgers"
+CREATE INDEX "ledger_owner_id" ON "public"."ledgers" ("owner_id");
+-- Each user had at most one ledger, which shared the user's own data: it becomes the personal ledger
+UPDATE "public"."ledgers" SET "personal" = true;
+-- Backfill a personal ledger for every user without one
+INSERT INTO "public"."ledgers" ("id", "created_at", "updated_at", "name", "personal", "owner_id")
+SELECT gen_random_uuid(), now(), now(), 'Pessoal', true, "u"."id"
+FROM "public"."users" AS "u"
+WHERE NOT EXISTS (SELECT 1 FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "u"."id");
+INSERT INTO "public"."ledger_members" ("id", "created_at", "updated_at", "role", "ledger_id", "user_id")
+SELECT gen_random_uuid(), now(), now(), 'owner', "l"."id", "l"."owner_id"
+FROM "public"."ledgers" AS "l"
+WHERE NOT EXISTS (SELECT 1 FROM "public"."ledger_members" AS "m" WHERE "m"."ledger_id" = "l"."id" AND "m"."user_id" = "l"."owner_id");
+-- Create index "ledger_owner_id_personal" to table: "ledgers"
+CREATE UNIQUE INDEX "ledger_owner_id_personal" ON "public"."ledgers" ("owner_id") WHERE personal;
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "category_model_version";
+-- Modify "accounts" table
+ALTER TABLE "public"."accounts" ADD COLUMN "ledger_id" uuid NULL, ADD COLUMN "created_by_id" uuid NULL;
+-- Move the rows of "accounts" to the personal ledger of their user
+UPDATE "public"."accounts" AS "t" SET "ledger_id" = "l"."id", "created_by_id" = "t"."user_id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."accounts" DROP CONSTRAINT "accounts_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "accounts_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "accounts_users_created_by" FOREIGN KEY ("created_by_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "assets" table
+ALTER TABLE "public"."assets" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "assets" to the personal ledger of their user
+UPDATE "public"."assets" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."assets" DROP CONSTRAINT "assets_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "assets_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "attachments" table
+ALTER TABLE "public"."attachments" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "attachments" to the personal ledger of their user
+UPDATE "public"."attachments" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."attachments" DROP CONSTRAINT "attachments_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "attachments_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "budgets" table
+ALTER TABLE "public"."budgets" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "budgets" to the personal ledger of their user
+UPDATE "public"."budgets" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "budget_category_id_month_user_id" from table: "budgets"
+DROP INDEX "public"."budget_category_id_month_user_id";
+ALTER TABLE "public"."budgets" DROP CONSTRAINT "budgets_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "budgets_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "budget_category_id_month_ledger_id" to table: "budgets"
+CREATE UNIQUE INDEX "budget_category_id_month_ledger_id" ON "public"."budgets" ("category_id", "month", "ledger_id");
+-- Modify "categories" table
+ALTER TABLE "public"."categories" ADD COLUMN "ledger_id" uuid NULL, ADD COLUMN "created_by_id" uuid NULL;
+-- Move the rows of "categories" to the personal ledger of their user
+UPDATE "public"."categories" AS "t" SET "ledger_id" = "l"."id", "created_by_id" = "t"."user_id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "category_name_user_id" from table: "categories"
+DROP INDEX "public"."category_name_user_id";
+ALTER TABLE "public"."categories" DROP CONSTRAINT "categories_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "categories_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "categories_users_created_by" FOREIGN KEY ("created_by_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "category_name_ledger_id" to table: "categories"
+CREATE UNIQUE INDEX "category_name_ledger_id" ON "public"."categories" ("name", "ledger_id");
+-- Modify "envelope_allocations" table
+ALTER TABLE "public"."envelope_allocations" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "envelope_allocations" to the personal ledger of their user
+UPDATE "public"."envelope_allocations" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "envelopeallocation_month_user_id" from table: "envelope_allocations"
+DROP INDEX "public"."envelopeallocation_month_user_id";
+ALTER TABLE "public"."envelope_allocations" DROP CONSTRAINT "envelope_allocations_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "envelope_allocations_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "envelopeallocation_month_ledger_id" to table: "envelope_allocations"
+CREATE INDEX "envelopeallocation_month_ledger_id" ON "public"."envelope_allocations" ("month", "ledger_id");
+-- Modify "exchange_rates" table
+ALTER TABLE "public"."exchange_rates" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "exchange_rates" to the personal ledger of their user
+UPDATE "public"."exchange_rates" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "exchangerate_from_currency_to_currency_rate_date_user_id" from table: "exchange_rates"
+DROP INDEX "public"."exchangerate_from_currency_to_currency_rate_date_user_id";
+ALTER TABLE "public"."exchange_rates" DROP CONSTRAINT "exchange_rates_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "exchange_rates_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "exchangerate_from_currency_to_currency_rate_date_ledger_id" to table: "exchange_rates"
+CREATE UNIQUE INDEX "exchangerate_from_currency_to_currency_rate_date_ledger_id" ON "public"."exchange_rates" ("from_currency", "to_currency", "rate_date", "ledger_id");
+-- Modify "goals" table
+ALTER TABLE "public"."goals" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "goals" to the personal ledger of their user
+UPDATE "public"."goals" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."goals" DROP CONSTRAINT "goals_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "goals_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "holdings" table
+ALTER TABLE "public"."holdings" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "holdings" to the personal ledger of their user
+UPDATE "public"."holdings" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "holding_ticker_user_id" from table: "holdings"
+DROP INDEX "public"."holding_ticker_user_id";
+ALTER TABLE "public"."holdings" DROP CONSTRAINT "holdings_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "holdings_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "holding_ticker_ledger_id" to table: "holdings"
+CREATE UNIQUE INDEX "holding_ticker_ledger_id" ON "public"."holdings" ("ticker", "ledger_id");
+-- Modify "investment_incomes" table
+ALTER TABLE "public"."investment_incomes" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "investment_incomes" to the personal ledger of their user
+UPDATE "public"."investment_incomes" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."investment_incomes" DROP CONSTRAINT "investment_incomes_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "investment_incomes_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "investment_quotes" table
+ALTER TABLE "public"."investment_quotes" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "investment_quotes" to the personal ledger of their user
+UPDATE "public"."investment_quotes" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."investment_quotes" DROP CONSTRAINT "investment_quotes_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "investment_quotes_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "investment_trades" table
+ALTER TABLE "public"."investment_trades" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "investment_trades" to the personal ledger of their user
+UPDATE "public"."investment_trades" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."investment_trades" DROP CONSTRAINT "investment_trades_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "investment_trades_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "invoices" table
+ALTER TABLE "public"."invoices" ADD COLUMN "ledger_id" uuid NULL, ADD COLUMN "created_by_id" uuid NULL;
+-- Move the rows of "invoices" to the personal ledger of their user
+UPDATE "public"."invoices" AS "t" SET "ledger_id" = "l"."id", "created_by_id" = "t"."user_id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."invoices" DROP CONSTRAINT "invoices_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "invoices_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "invoices_users_created_by" FOREIGN KEY ("created_by_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "loans" table
+ALTER TABLE "public"."loans" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "loans" to the personal ledger of their user
+UPDATE "public"."loans" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."loans" DROP CONSTRAINT "loans_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "loans_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "loan_installments" table
+ALTER TABLE "public"."loan_installments" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "loan_installments" to the personal ledger of their user
+UPDATE "public"."loan_installments" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."loan_installments" DROP CONSTRAINT "loan_installments_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "loan_installments_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "loan_prepayments" table
+ALTER TABLE "public"."loan_prepayments" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "loan_prepayments" to the personal ledger of their user
+UPDATE "public"."loan_prepayments" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."loan_prepayments" DROP CONSTRAINT "loan_prepayments_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "loan_prepayments_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "payees" table
+ALTER TABLE "public"."payees" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "payees" to the personal ledger of their user
+UPDATE "public"."payees" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "payee_normalized_name_user_id" from table: "payees"
+DROP INDEX "public"."payee_normalized_name_user_id";
+ALTER TABLE "public"."payees" DROP CONSTRAINT "payees_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "payees_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "payee_normalized_name_ledger_id" to table: "payees"
+CREATE UNIQUE INDEX "payee_normalized_name_ledger_id" ON "public"."payees" ("normalized_name", "ledger_id");
+-- Modify "rules" table
+ALTER TABLE "public"."rules" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "rules" to the personal ledger of their user
+UPDATE "public"."rules" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "rule_priority_user_id" from table: "rules"
+DROP INDEX "public"."rule_priority_user_id";
+ALTER TABLE "public"."rules" DROP CONSTRAINT "rules_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "rules_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "rule_priority_ledger_id" to table: "rules"
+CREATE INDEX "rule_priority_ledger_id" ON "public"."rules" ("priority", "ledger_id");
+-- Modify "settlements" table
+ALTER TABLE "public"."settlements" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "settlements" to the personal ledger of their user
+UPDATE "public"."settlements" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "settlement_settled_at_user_id" from table: "settlements"
+DROP INDEX "public"."settlement_settled_at_user_id";
+ALTER TABLE "public"."settlements" DROP CONSTRAINT "settlements_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "settlements_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "settlement_settled_at_ledger_id" to table: "settlements"
+CREATE INDEX "settlement_settled_at_ledger_id" ON "public"."settlements" ("settled_at", "ledger_id");
+-- Modify "tags" table
+ALTER TABLE "public"."tags" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "tags" to the personal ledger of their user
+UPDATE "public"."tags" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "tag_name_user_id" from table: "tags"
+DROP INDEX "public"."tag_name_user_id";
+ALTER TABLE "public"."tags" DROP CONSTRAINT "tags_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "tags_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "tag_name_ledger_id" to table: "tags"
+CREATE UNIQUE INDEX "tag_name_ledger_id" ON "public"."tags" ("name", "ledger_id");
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "ledger_id" uuid NULL, ADD COLUMN "created_by_id" uuid NULL;
+-- Move the rows of "transactions" to the personal ledger of their user
+UPDATE "public"."transactions" AS "t" SET "ledger_id" = "l"."id", "created_by_id" = "t"."user_id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+ALTER TABLE "public"."transactions" DROP CONSTRAINT "transactions_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "transactions_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "transactions_users_created_by" FOREIGN KEY ("created_by_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "valuations" table
+ALTER TABLE "public"."valuations" ADD COLUMN "ledger_id" uuid NULL;
+-- Move the rows of "valuations" to the personal ledger of their user
+UPDATE "public"."valuations" AS "t" SET "ledger_id" = "l"."id" FROM "public"."ledgers" AS "l" WHERE "l"."owner_id" = "t"."user_id" AND "l"."personal";
+-- Drop index "valuation_value_date_user_id" from table: "valuations"
+DROP INDEX "public"."valuation_value_date_user_id";
+ALTER TABLE "public"."valuations" DROP CONSTRAINT "valuations_users_user", DROP COLUMN "user_id", ALTER COLUMN "ledger_id" SET NOT NULL, ADD CONSTRAINT "valuations_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "valuation_value_date_ledger_id" to table: "valuations"
+CREATE INDEX "valuation_value_date_ledger_id" ON "public"."valuations" ("value_date", "ledger_id");
@@ -0,0 +1,24 @@
+-- Create "recurring_rules" table
+CREATE TABLE "public"."recurring_rules" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "record_type" character varying NOT NULL DEFAULT 'expense',
+  "amount" numeric(18,2) NOT NULL,
+  "title" character varying NOT NULL,
+  "currency" character varying NOT NULL DEFAULT 'BRL',
+  "frequency" character varying NOT NULL,
+  "start_date" date NOT NULL,
+  "end_date" date NULL,
+  "ledger_id" uuid NOT NULL,
+  "account_id" uuid NULL,
+  "category_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recurring_rules_accounts_account" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recurring_rules_categories_category" FOREIGN KEY ("category_id") REFERENCES "public"."categories" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "recurring_rules_ledgers_ledger" FOREIGN KEY ("ledger_id") REFERENCES "public"."ledgers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "installment_number" bigint NULL, ADD COLUMN "installment_count" bigint NULL, ADD COLUMN "recurring_rule_id" uuid NULL, ADD CONSTRAINT "transactions_recurring_rules_recurring_rule" FOREIGN KEY ("recurring_rule_id") REFERENCES "public"."recurring_rules" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "transaction_recurring_rule_id" to table: "transactions"
+CREATE INDEX "transaction_recurring_rule_id" ON "public"."transactions" ("recurring_rule_id");
@@ -0,0 +1,4 @@
+-- Modify "transactions" table
+ALTER TABLE "public"."transactions" ADD COLUMN "transfer_account_id" uuid NULL, ADD CONSTRAINT "transactions_accounts_transfer_account" FOREIGN KEY ("transfer_account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "transaction_transfer_account_id" to table: "transactions"
+CREATE INDEX "transaction_transfer_account_id" ON "public"."transactions" ("transfer_account_id");